@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/emitter"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/collector/file"
@@ -44,6 +45,10 @@ type filesOptions struct {
 	blobAddr string
 	// poll location
 	poll bool
+	// watch location for new and modified files
+	watch bool
+	// checkpoint file of the collected files
+	checkpoint string
 	// enable/disable message publish to queue
 	publishToQueue bool
 }
@@ -56,6 +61,10 @@ guaccollect files takes in a file path to ingest all documents that are found
 via the GUAC files collector. Ingestion to GUAC happens via an event stream (NATS)
 to allow for decoupling of the collectors from the ingestion into GUAC. 
 
+With --file-watch the path is watched and only new or modified files are
+collected. The content hashes of the collected files are recorded in the
+--file-checkpoint file, so that a restarted collector does not collect them again.
+
 Each collector collects the "document" and stores it in the blob store for further
 evaluation. The collector creates a CDEvent (https://cdevents.dev/) that is published via 
 the event stream. The downstream guacingest subscribes to the stream and retrieves the "document" from the blob store for 
@@ -72,6 +81,8 @@ you have access to read and write to the respective blob store.`,
 			viper.GetString("pubsub-addr"),
 			viper.GetString("blob-addr"),
 			viper.GetBool("service-poll"),
+			viper.GetBool("file-watch"),
+			viper.GetString("file-checkpoint"),
 			viper.GetBool("publish-to-queue"),
 			args)
 		if err != nil {
@@ -84,7 +95,9 @@ you have access to read and write to the respective blob store.`,
 		logger := logging.FromContext(ctx)
 
 		// Register collector
-		fileCollector := file.NewFileCollector(ctx, opts.path, opts.poll, 30*time.Second)
+		fileCollector := file.NewFileCollector(ctx, opts.path, opts.poll, 30*time.Second,
+			file.WithWatch(opts.watch),
+			file.WithCheckpoint(opts.checkpoint))
 		err = collector.RegisterDocumentCollector(fileCollector, file.FileCollector)
 		if err != nil {
 			logger.Fatalf("unable to register file collector: %v", err)
@@ -94,12 +107,14 @@ you have access to read and write to the respective blob store.`,
 	},
 }
 
-func validateFilesFlags(pubsubAddr, blobAddr string, poll bool, pubToQueue bool, args []string) (filesOptions, error) {
+func validateFilesFlags(pubsubAddr, blobAddr string, poll bool, watch bool, checkpoint string, pubToQueue bool, args []string) (filesOptions, error) {
 	var opts filesOptions
 
 	opts.pubsubAddr = pubsubAddr
 	opts.blobAddr = blobAddr
 	opts.poll = poll
+	opts.watch = watch
+	opts.checkpoint = checkpoint
 	opts.publishToQueue = pubToQueue
 
 	if len(args) != 1 {
@@ -194,5 +209,15 @@ func initializeNATsandCollector(ctx context.Context, pubsubAddr string, blobAddr
 }
 
 func init() {
+	set, err := cli.BuildFlags([]string{"file-watch", "file-checkpoint"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	filesCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(filesCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
 	rootCmd.AddCommand(filesCmd)
 }
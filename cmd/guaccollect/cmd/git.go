@@ -0,0 +1,167 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	git_collector "github.com/guacsec/guac/pkg/handler/collector/git"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type gitOptions struct {
+	// url of the git repository to collect
+	url string
+	// directory to clone the repository into
+	cloneDir string
+	// glob patterns of the files to collect
+	includes []string
+	// glob patterns of the files to skip
+	excludes []string
+	// collect the documents of each tagged release
+	tagHistory bool
+	// address for pubsub connection
+	pubsubAddr string
+	// address for blob store
+	blobAddr string
+	// poll location
+	poll bool
+	// enable/disable message publish to queue
+	publishToQueue bool
+}
+
+var gitCmd = &cobra.Command{
+	Use:   "git [flags] repo_url",
+	Short: "takes a git repository and collects the documents found in it to add to GUAC graph utilizing Nats pubsub and blob store",
+	Long: `
+guaccollect git clones a git repository and collects the documents found in it,
+such as SBOMs and attestations. The files to collect are selected with glob
+patterns on their path in the repository (--git-include and --git-exclude).
+With --git-tag-history the documents are also collected as they were at each
+tagged release. The commit and tag of each document are recorded, so that the
+packages of the ingested SBOMs are linked to the exact source revision.
+
+Ingestion to GUAC happens via an event stream (NATS) to allow for decoupling of
+the collectors from the ingestion into GUAC. Each collector collects the "document"
+and stores it in the blob store for further evaluation. The collector creates a
+CDEvent (https://cdevents.dev/) that is published via the event stream. The
+downstream guacingest subscribes to the stream and retrieves the "document" from
+the blob store for processing and ingestion.
+
+Examples:
+
+# collect the SPDX and CycloneDX SBOMs of all releases of a repository
+guaccollect git --git-include "**/*.spdx.json,**/*.cdx.json" --git-tag-history https://github.com/guacsec/guac`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateGitFlags(
+			viper.GetString("pubsub-addr"),
+			viper.GetString("blob-addr"),
+			viper.GetString("git-clone-dir"),
+			viper.GetString("git-include"),
+			viper.GetString("git-exclude"),
+			viper.GetBool("git-tag-history"),
+			viper.GetBool("service-poll"),
+			viper.GetBool("publish-to-queue"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		if opts.cloneDir == "" {
+			tmpDir, err := os.MkdirTemp("", "guac-git-")
+			if err != nil {
+				logger.Fatalf("unable to create clone directory: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+			opts.cloneDir = filepath.Join(tmpDir, "repo")
+		}
+
+		// Register collector
+		gitCollector, err := git_collector.NewGitDocumentCollector(ctx, opts.url, opts.cloneDir, opts.poll, 5*time.Minute,
+			git_collector.WithIncludes(opts.includes...),
+			git_collector.WithExcludes(opts.excludes...),
+			git_collector.WithTagHistory(opts.tagHistory))
+		if err != nil {
+			logger.Fatalf("unable to create git collector: %v", err)
+		}
+		err = collector.RegisterDocumentCollector(gitCollector, git_collector.CollectorGitDocument)
+		if err != nil {
+			logger.Fatalf("unable to register git collector: %v", err)
+		}
+
+		initializeNATsandCollector(ctx, opts.pubsubAddr, opts.blobAddr, opts.publishToQueue)
+	},
+}
+
+func validateGitFlags(pubsubAddr, blobAddr, cloneDir, includes, excludes string, tagHistory, poll, pubToQueue bool, args []string) (gitOptions, error) {
+	var opts gitOptions
+
+	opts.pubsubAddr = pubsubAddr
+	opts.blobAddr = blobAddr
+	opts.cloneDir = cloneDir
+	opts.includes = splitPatterns(includes)
+	opts.excludes = splitPatterns(excludes)
+	opts.tagHistory = tagHistory
+	opts.poll = poll
+	opts.publishToQueue = pubToQueue
+
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected positional argument for repo_url")
+	}
+	opts.url = args[0]
+
+	return opts, nil
+}
+
+// splitPatterns splits a comma-separated list of glob patterns.
+func splitPatterns(s string) []string {
+	var patterns []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"git-include", "git-exclude", "git-tag-history", "git-clone-dir"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	gitCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(gitCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	rootCmd.AddCommand(gitCmd)
+}
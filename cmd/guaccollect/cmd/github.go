@@ -23,7 +23,7 @@ import (
 	"time"
 
 	csubclient "github.com/guacsec/guac/pkg/collectsub/client"
-	"github.com/guacsec/guac/pkg/collectsub/datasource/csubsource"
+	"github.com/guacsec/guac/pkg/collectsub/collectsub"
 	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
 	"github.com/guacsec/guac/pkg/metrics"
 
@@ -207,7 +207,10 @@ func validateGithubFlags(
 		if err != nil {
 			return opts, err
 		}
-		opts.dataSource, err = csubsource.NewCsubDatasource(c, 10*time.Second)
+		opts.dataSource, err = newCsubDatasource(c, []*collectsub.CollectEntryFilter{
+			{Type: collectsub.CollectDataType_DATATYPE_GITHUB_RELEASE, Glob: "*"},
+			{Type: collectsub.CollectDataType_DATATYPE_GIT, Glob: "*"},
+		})
 		return opts, err
 	}
 
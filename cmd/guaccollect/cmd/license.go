@@ -120,7 +120,7 @@ you have access to read and write to the respective blob store.`,
 			logger.Fatalf("unable to register certifier: %v", err)
 		}
 
-		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))
 		httpClient := http.Client{Transport: transport}
 		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
@@ -24,8 +24,8 @@ import (
 
 	"github.com/guacsec/guac/pkg/cli"
 	csubclient "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/collectsub/collectsub"
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
-	"github.com/guacsec/guac/pkg/collectsub/datasource/csubsource"
 	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/collector/oci"
@@ -202,7 +202,9 @@ func validateOCIFlags(
 		if err != nil {
 			return opts, err
 		}
-		opts.dataSource, err = csubsource.NewCsubDatasource(c, 10*time.Second)
+		opts.dataSource, err = newCsubDatasource(c, []*collectsub.CollectEntryFilter{
+			{Type: collectsub.CollectDataType_DATATYPE_OCI, Glob: "*"},
+		})
 		return opts, err
 	}
 
@@ -260,7 +262,9 @@ func validateOCIRegistryFlags(
 		if err != nil {
 			return opts, err
 		}
-		opts.dataSource, err = csubsource.NewCsubDatasource(c, 10*time.Second)
+		opts.dataSource, err = newCsubDatasource(c, []*collectsub.CollectEntryFilter{
+			{Type: collectsub.CollectDataType_DATATYPE_OCI_REGISTRY, Glob: "*"},
+		})
 		return opts, err
 	}
 
@@ -0,0 +1,107 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/oci"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ociLayoutOptions struct {
+	// paths of the OCI image layout directories or tarballs
+	paths []string
+	// address for pubsub connection
+	pubsubAddr string
+	// address for blob store
+	blobAddr string
+	// enable/disable message publish to queue
+	publishToQueue bool
+}
+
+var ociLayoutCmd = &cobra.Command{
+	Use:   "oci-layout [flags] layout_path1 layout_path2...",
+	Short: "takes local OCI image layouts or image tarballs and collects the sbom and attestation attached to the images to add to GUAC graph utilizing Nats pubsub and blob store",
+	Long: `
+guaccollect oci-layout reads OCI image layout directories and tarballs, such as
+the ones written by "docker save" (docker 25 or later) or "regctl image export",
+without connecting to a registry. The sboms and attestations attached to the
+images, either as OCI referrers or with the cosign ".sbom" and ".att" tags, are
+collected with the digest of their image as subject.
+
+Ingestion to GUAC happens via an event stream (NATS) to allow for decoupling of
+the collectors from the ingestion into GUAC. Each collector collects the "document"
+and stores it in the blob store for further evaluation. The collector creates a
+CDEvent (https://cdevents.dev/) that is published via the event stream. The
+downstream guacingest subscribes to the stream and retrieves the "document" from
+the blob store for processing and ingestion.
+
+Examples:
+
+# collect the sboms and attestations of a saved image
+docker save -o image.tar ghcr.io/guacsec/guac:v1.0.0
+guaccollect oci-layout image.tar`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateOCILayoutFlags(
+			viper.GetString("pubsub-addr"),
+			viper.GetString("blob-addr"),
+			viper.GetBool("publish-to-queue"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		// Register collector
+		ociLayoutCollector := oci.NewOCILayoutCollector(ctx, opts.paths)
+		err = collector.RegisterDocumentCollector(ociLayoutCollector, oci.OCILayoutCollector)
+		if err != nil {
+			logger.Fatalf("unable to register oci layout collector: %v", err)
+		}
+
+		initializeNATsandCollector(ctx, opts.pubsubAddr, opts.blobAddr, opts.publishToQueue)
+	},
+}
+
+func validateOCILayoutFlags(pubsubAddr, blobAddr string, pubToQueue bool, args []string) (ociLayoutOptions, error) {
+	var opts ociLayoutOptions
+
+	opts.pubsubAddr = pubsubAddr
+	opts.blobAddr = blobAddr
+	opts.publishToQueue = pubToQueue
+
+	if len(args) < 1 {
+		return opts, fmt.Errorf("expected positional argument for layout_path")
+	}
+	opts.paths = args
+
+	return opts, nil
+}
+
+func init() {
+	rootCmd.AddCommand(ociLayoutCmd)
+}
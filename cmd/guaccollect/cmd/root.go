@@ -19,8 +19,13 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/collectsub/collectsub"
+	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/collectsub/datasource/csubsource"
 	"github.com/guacsec/guac/pkg/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -29,19 +34,23 @@ import (
 func init() {
 	cobra.OnInitialize(cli.InitConfig)
 
-	set, err := cli.BuildFlags([]string{
+	set, err := cli.BuildFlags(append([]string{
 		"pubsub-addr",
 		"blob-addr",
 		"csub-addr",
 		"csub-tls",
 		"csub-tls-skip-verify",
 		"use-csub",
+		"csub-lease",
+		"csub-lease-duration",
+		"csub-lease-max-entries",
+		"csub-requeue-after",
 		"service-poll",
 		"enable-prometheus",
 		"publish-to-queue",
 		"gql-addr",
 		"enable-otel",
-	})
+	}, cli.AuthFlags...))
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
@@ -63,6 +72,28 @@ var rootCmd = &cobra.Command{
 	Version: version.Version,
 }
 
+// newCsubDatasource creates the collect subscriber datasource of the
+// collectors, which leases its entries if csub-lease is set. Only the entries
+// matching the filters of the data types of the collector are leased.
+func newCsubDatasource(c client.Client, filters []*collectsub.CollectEntryFilter) (datasource.CollectSource, error) {
+	if !viper.GetBool("csub-lease") {
+		return csubsource.NewCsubDatasource(c, 10*time.Second)
+	}
+	leaseDuration, err := time.ParseDuration(viper.GetString("csub-lease-duration"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse csub-lease-duration: %w", err)
+	}
+	requeueAfter, err := time.ParseDuration(viper.GetString("csub-requeue-after"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse csub-requeue-after: %w", err)
+	}
+	return csubsource.NewLeasingCsubDatasource(c, 10*time.Second, filters, csubsource.LeaseOptions{
+		Duration:     leaseDuration,
+		MaxEntries:   viper.GetInt("csub-lease-max-entries"),
+		RequeueAfter: requeueAfter,
+	})
+}
+
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
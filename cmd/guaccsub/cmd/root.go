@@ -22,9 +22,13 @@ import (
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/collectsub/server"
+	"github.com/guacsec/guac/pkg/collectsub/server/db/boltdb"
+	"github.com/guacsec/guac/pkg/collectsub/server/db/simpledb"
+	"github.com/guacsec/guac/pkg/collectsub/server/db/types"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/version"
 
@@ -36,6 +40,8 @@ type csubOptions struct {
 	port        int
 	tlsCertFile string
 	tlsKeyFile  string
+	dbFile      string
+	dbRetention time.Duration
 }
 
 var rootCmd = &cobra.Command{
@@ -48,6 +54,8 @@ var rootCmd = &cobra.Command{
 			viper.GetInt("csub-listen-port"),
 			viper.GetString("csub-tls-cert-file"),
 			viper.GetString("csub-tls-key-file"),
+			viper.GetString("csub-db-file"),
+			viper.GetString("csub-db-retention"),
 		)
 
 		if err != nil {
@@ -59,8 +67,18 @@ var rootCmd = &cobra.Command{
 		ctx, cf := context.WithCancel(logging.WithLogger(context.Background()))
 		logger := logging.FromContext(ctx)
 
+		var csubDb types.CollectSubscriberDb
+		if opts.dbFile != "" {
+			csubDb, err = boltdb.NewBoltDb(ctx, opts.dbFile, opts.dbRetention)
+		} else {
+			csubDb, err = simpledb.NewSimpleDb()
+		}
+		if err != nil {
+			logger.Fatalf("unable to create csub database: %v", err)
+		}
+
 		// Start csub listening server
-		csubServer, err := server.NewServer(opts.port, opts.tlsCertFile, opts.tlsKeyFile)
+		csubServer, err := server.NewServer(csubDb, opts.port, opts.tlsCertFile, opts.tlsKeyFile)
 		if err != nil {
 			logger.Fatalf("unable to create csub server: %v", err)
 		}
@@ -80,14 +98,27 @@ var rootCmd = &cobra.Command{
 		logger.Infof("Signal received: %s, shutting down gracefully\n", s.String())
 		cf()
 		wg.Wait()
+		if err := csubDb.Close(); err != nil {
+			logger.Errorf("unable to close csub database: %v", err)
+		}
 	},
 }
 
-func validateCsubFlags(port int, tlsCertFile string, tlsKeyFile string) (csubOptions, error) {
+func validateCsubFlags(port int, tlsCertFile string, tlsKeyFile string, dbFile string, dbRetention string) (csubOptions, error) {
 	var opts csubOptions
 	opts.port = port
 	opts.tlsCertFile = tlsCertFile
 	opts.tlsKeyFile = tlsKeyFile
+	opts.dbFile = dbFile
+
+	retention, err := time.ParseDuration(dbRetention)
+	if err != nil {
+		return opts, fmt.Errorf("failed to parse csub-db-retention: %w", err)
+	}
+	if retention < 0 {
+		return opts, fmt.Errorf("csub-db-retention must not be negative")
+	}
+	opts.dbRetention = retention
 
 	return opts, nil
 }
@@ -95,7 +126,7 @@ func validateCsubFlags(port int, tlsCertFile string, tlsKeyFile string) (csubOpt
 func init() {
 	cobra.OnInitialize(cli.InitConfig)
 
-	set, err := cli.BuildFlags([]string{"csub-listen-port", "csub-tls-cert-file", "csub-tls-key-file"})
+	set, err := cli.BuildFlags([]string{"csub-listen-port", "csub-tls-cert-file", "csub-tls-key-file", "csub-db-file", "csub-db-retention"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
@@ -36,6 +36,16 @@ var flags = struct {
 	debug       bool
 	tracegql    bool
 	enableOtel  bool
+	grpcPort    int
+	blobAddr    string
+	authConfig  string
+
+	// graphQL query limits
+	maxComplexity int
+	listSize      int
+	maxDepth      int
+	queryTimeout  string
+	maxResultSize int
 }{}
 
 var rootCmd = &cobra.Command{
@@ -50,6 +60,14 @@ var rootCmd = &cobra.Command{
 		flags.debug = viper.GetBool("gql-debug")
 		flags.tracegql = viper.GetBool("gql-trace")
 		flags.enableOtel = viper.GetBool("enable-otel")
+		flags.grpcPort = viper.GetInt("gql-grpc-listen-port")
+		flags.blobAddr = viper.GetString("blob-addr")
+		flags.authConfig = viper.GetString("gql-auth-config")
+		flags.maxComplexity = viper.GetInt("gql-max-complexity")
+		flags.listSize = viper.GetInt("gql-list-size")
+		flags.maxDepth = viper.GetInt("gql-max-depth")
+		flags.queryTimeout = viper.GetString("gql-query-timeout")
+		flags.maxResultSize = viper.GetInt("gql-max-result-size")
 
 		startServer(cmd)
 	},
@@ -66,6 +84,14 @@ func init() {
 		"gql-debug",
 		"gql-backend",
 		"gql-trace",
+		"gql-grpc-listen-port",
+		"gql-auth-config",
+		"gql-max-complexity",
+		"gql-list-size",
+		"gql-max-depth",
+		"gql-query-timeout",
+		"gql-max-result-size",
+		"blob-addr",
 		"enable-prometheus",
 		"enable-otel",
 	})
@@ -17,6 +17,7 @@ package cmd
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net/http"
@@ -27,6 +28,7 @@ import (
 	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler/debug"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -39,7 +41,10 @@ import (
 	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
 	_ "github.com/guacsec/guac/pkg/assembler/backends/neo4j"
 	_ "github.com/guacsec/guac/pkg/assembler/backends/neptune"
+	grpc_server "github.com/guacsec/guac/pkg/assembler/grpc/server"
 	"github.com/guacsec/guac/pkg/assembler/server"
+	"github.com/guacsec/guac/pkg/auth"
+	"github.com/guacsec/guac/pkg/blob"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/metrics"
 	"github.com/guacsec/guac/pkg/version"
@@ -67,8 +72,60 @@ func startServer(cmd *cobra.Command) {
 		logger.Errorf("Error creating %v backend: %v", flags.backend, err)
 		os.Exit(1)
 	}
+	// Publish the ingested evidence to the GraphQL subscriptions, whether it
+	// is ingested through GraphQL or gRPC.
+	backend = backends.WithEvents(backend, backends.NewEvents())
+	if backends.EventsOf(backend) == nil {
+		logger.Infof("the %s backend does not support GraphQL subscriptions", flags.backend)
+	}
+
+	// The blob store is only needed to return document content, so the
+	// server still starts without it.
+	blobStore, err := blob.NewBlobStore(ctx, flags.blobAddr)
+	if err != nil {
+		logger.Warnf("unable to open blob store %s, document content will not be available: %v", flags.blobAddr, err)
+		blobStore = nil
+	}
+
+	limits, err := getLimits()
+	if err != nil {
+		logger.Fatalf("Error parsing the graphQL query limits: %v", err)
+	}
+	authenticator, clientTLS, err := setupAuth(ctx)
+	if err != nil {
+		logger.Fatalf("Error setting up authentication: %v", err)
+	}
+	var websocketInit transport.WebsocketInitFunc
+	if authenticator != nil {
+		websocketInit = auth.WebsocketInit(authenticator, tenantWebsocketInit(backend))
+	}
+	srv := server.GetGraphqlServer(ctx, backend, server.Options{
+		BlobStore:     blobStore,
+		Limits:        limits,
+		WebsocketInit: websocketInit,
+	})
+	srvHandler = srv
+	if authenticator != nil {
+		srv.Use(auth.Authorizer{})
+		srvHandler = auth.Middleware(authenticator)(tenantHandler(backend, srvHandler))
+	}
 
-	srv := server.GetGraphqlServer(ctx, backend)
+	grpcCtx, grpcCancel := context.WithCancel(ctx)
+	defer grpcCancel()
+	if flags.grpcPort != 0 {
+		grpcSrv, err := grpc_server.NewServer(backend, flags.grpcPort, flags.tlsCertFile, flags.tlsKeyFile)
+		if err != nil {
+			logger.Fatalf("Error creating gRPC server: %v", err)
+		}
+		if authenticator != nil {
+			grpcSrv.WithAuth(authenticator, clientTLS)
+		}
+		go func() {
+			if err := grpcSrv.Serve(grpcCtx); err != nil {
+				logger.Errorf("gRPC server finished with error: %v", err)
+			}
+		}()
+	}
 
 	metric, err := setupPrometheus(ctx, "guacgql")
 	if err != nil {
@@ -76,9 +133,7 @@ func startServer(cmd *cobra.Command) {
 	}
 
 	if metric != nil {
-		srvHandler = metric.MeasureGraphQLResponseDuration(srv)
-	} else {
-		srvHandler = srv
+		srvHandler = metric.MeasureGraphQLResponseDuration(srvHandler)
 	}
 
 	if flags.enableOtel {
@@ -114,7 +169,7 @@ func startServer(cmd *cobra.Command) {
 		logger.Infof("connect to %s://localhost:%d/ for GraphQL playground", proto, flags.port)
 	}
 
-	server := &http.Server{Addr: fmt.Sprintf(":%d", flags.port)}
+	server := &http.Server{Addr: fmt.Sprintf(":%d", flags.port), TLSConfig: clientTLS}
 	logger.Info("starting server")
 	go func() {
 		if proto == "https" {
@@ -127,6 +182,7 @@ func startServer(cmd *cobra.Command) {
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 	s := <-sigs
 	logger.Infof("Signal received: %s, shutting down gracefully\n", s.String())
+	grpcCancel()
 	done := make(chan bool, 1)
 	ctx, cf := context.WithCancel(ctx)
 	go func() {
@@ -159,6 +215,58 @@ func setupPrometheus(ctx context.Context, name string) (metrics.MetricCollector,
 	return m, nil
 }
 
+// setupAuth loads the authentication configuration, returning a nil
+// authenticator if authentication is disabled. The TLS configuration is nil
+// unless client certificates are accepted.
+func setupAuth(ctx context.Context) (auth.Authenticator, *tls.Config, error) {
+	logger := logging.FromContext(ctx)
+	if flags.authConfig == "" {
+		return nil, nil, nil
+	}
+	authenticator, clientTLS, err := auth.Load(flags.authConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	if flags.tlsCertFile == "" || flags.tlsKeyFile == "" {
+		if clientTLS != nil {
+			return nil, nil, errors.New("mtls authentication requires gql-tls-cert-file and gql-tls-key-file to be set")
+		}
+		logger.Warnf("authentication is enabled without TLS, credentials are sent in clear text unless TLS is terminated by a proxy")
+	}
+	return authenticator, clientTLS, nil
+}
+
+// tenantHandler scopes the requests to the tenant of the authenticated
+// caller, rejecting the tenants that the backend cannot keep apart.
+func tenantHandler(backend backends.Backend, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := withTenant(r.Context(), backend)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// tenantWebsocketInit does the same as tenantHandler for the websocket
+// connections authenticated by their connectionParams.
+func tenantWebsocketInit(backend backends.Backend) transport.WebsocketInitFunc {
+	return func(ctx context.Context, _ transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		ctx, err := withTenant(ctx, backend)
+		return ctx, nil, err
+	}
+}
+
+// withTenant returns a copy of ctx that carries the tenant of its principal,
+// or an error if the backend does not support it.
+func withTenant(ctx context.Context, backend backends.Backend) (context.Context, error) {
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		ctx = backends.WithTenant(ctx, p.Tenant)
+	}
+	return ctx, backends.CheckTenant(ctx, backend)
+}
+
 func validateFlags() error {
 	if !slices.Contains(backends.List(), flags.backend) {
 		return fmt.Errorf("invalid graphql backend specified: %v", flags.backend)
@@ -166,6 +274,21 @@ func validateFlags() error {
 	return nil
 }
 
+// getLimits returns the limits of the graphQL queries set by the flags.
+func getLimits() (server.Limits, error) {
+	queryTimeout, err := time.ParseDuration(flags.queryTimeout)
+	if err != nil {
+		return server.Limits{}, fmt.Errorf("failed to parse gql-query-timeout: %w", err)
+	}
+	return server.Limits{
+		MaxComplexity: flags.maxComplexity,
+		ListSize:      flags.listSize,
+		MaxDepth:      flags.maxDepth,
+		QueryTimeout:  queryTimeout,
+		MaxResultSize: flags.maxResultSize,
+	}, nil
+}
+
 func healthHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	_, _ = fmt.Fprint(w, "Server is healthy")
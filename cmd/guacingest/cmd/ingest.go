@@ -27,6 +27,10 @@ import (
 	"sync"
 	"syscall"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	grpc_client "github.com/guacsec/guac/pkg/assembler/grpc/client"
 	"github.com/guacsec/guac/pkg/blob"
 	"github.com/guacsec/guac/pkg/cli"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
@@ -34,10 +38,15 @@ import (
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/handler/processor/process"
 	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/ingestor/dlq"
+	"github.com/guacsec/guac/pkg/ingestor/ledger"
+	"github.com/guacsec/guac/pkg/ingestor/notify"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/metrics"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type options struct {
@@ -45,29 +54,36 @@ type options struct {
 	blobAddr                string
 	csubClientOptions       csub_client.CsubClientOptions
 	graphqlEndpoint         string
+	grpcClientOptions       grpc_client.GrpcClientOptions
 	headerFile              string
 	queryVulnOnIngestion    bool
 	queryLicenseOnIngestion bool
 	queryEOLOnIngestion     bool
 	queryDepsDevOnIngestion bool
 	enableOtel              bool
+	notifyConfig            string
+	// gocloud url of the bucket of the dead-letter queue, empty disables it
+	dlqAddr string
+	// optional gocloud url of the topic dead-lettered documents are published on
+	dlqPubsubAddr string
+	// gocloud url of the bucket of the ledger of ingested documents, empty disables it
+	ledgerAddr string
+	// ingest documents the ledger records as ingested
+	force bool
+}
+
+// ingester ingests documents through the graphQL or gRPC api.
+type ingester struct {
+	opts       options
+	transport  http.RoundTripper
+	csubClient csub_client.Client
+	grpcClient grpc_client.Client
+	notifier   *notify.Notifier
+	ledger     *ledger.Ledger
 }
 
 func ingest(cmd *cobra.Command, args []string) {
-	opts, err := validateFlags(
-		viper.GetString("pubsub-addr"),
-		viper.GetString("blob-addr"),
-		viper.GetString("csub-addr"),
-		viper.GetString("gql-addr"),
-		viper.GetString("header-file"),
-		viper.GetBool("csub-tls"),
-		viper.GetBool("csub-tls-skip-verify"),
-		viper.GetBool("add-vuln-on-ingest"),
-		viper.GetBool("add-license-on-ingest"),
-		viper.GetBool("add-eol-on-ingest"),
-		viper.GetBool("add-depsdev-on-ingest"),
-		viper.GetBool("enable-otel"),
-		args)
+	opts, err := validateFlagsFromViper(args)
 	if err != nil {
 		fmt.Printf("unable to validate flags: %v\n", err)
 		_ = cmd.Help()
@@ -76,7 +92,6 @@ func ingest(cmd *cobra.Command, args []string) {
 
 	ctx := logging.WithLogger(context.Background())
 	logger := logging.FromContext(ctx)
-	transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
 
 	if opts.enableOtel {
 		shutdown, err := metrics.SetupOTelSDK(ctx)
@@ -109,34 +124,27 @@ func ingest(cmd *cobra.Command, args []string) {
 	// initialize pubsub
 	pubsub := emitter.NewEmitterPubSub(ctx, opts.pubsubAddr)
 
-	// initialize collectsub client
-	csubClient, err := csub_client.NewClient(opts.csubClientOptions)
-	if err != nil {
-		logger.Errorf("collectsub client initialization failed with error: %v", err)
-		os.Exit(1)
+	ing, closeIngester := newIngester(ctx, opts)
+	defer closeIngester()
+
+	// initialize the dead-letter queue
+	var deadLetter process.DeadLetterQueue
+	if opts.dlqAddr != "" {
+		queue, err := dlq.Open(ctx, opts.dlqAddr, opts.dlqPubsubAddr)
+		if err != nil {
+			logger.Fatalf("unable to open dead-letter queue: %v", err)
+		}
+		defer func() {
+			if err := queue.Close(ctx); err != nil {
+				logger.Errorf("failed to close dead-letter queue: %v", err)
+			}
+		}()
+		deadLetter = queue
 	}
-	defer csubClient.Close()
 
 	ctx, cf := context.WithCancel(ctx)
 	emit := func(d *processor.Document) error {
-		if _, err := ingestor.Ingest(
-			ctx,
-			d,
-			opts.graphqlEndpoint,
-			transport,
-			csubClient,
-			opts.queryVulnOnIngestion,
-			opts.queryLicenseOnIngestion,
-			opts.queryEOLOnIngestion,
-			opts.queryDepsDevOnIngestion,
-		); err != nil {
-			var urlErr *url.Error
-			if errors.As(err, &urlErr) {
-				return fmt.Errorf("unable to ingest document due to connection error with graphQL %q : %w", d.SourceInformation.Source, urlErr)
-			}
-			d.ChildLogger.Errorf("unable to ingest document %q : %v", d.SourceInformation.Source, err)
-		}
-		return nil
+		return ing.ingest(ctx, d)
 	}
 
 	// Assuming that publisher and consumer are different processes.
@@ -145,7 +153,7 @@ func ingest(cmd *cobra.Command, args []string) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		if err := process.Subscribe(ctx, emit, blobStore, pubsub); err != nil {
+		if err := process.Subscribe(ctx, emit, blobStore, pubsub, deadLetter); err != nil {
 			logger.Errorf("processor ended with error: %v", err)
 			sigs <- syscall.SIGTERM
 		}
@@ -160,14 +168,145 @@ func ingest(cmd *cobra.Command, args []string) {
 	wg.Wait()
 }
 
+// newIngester connects to the collectsub server, and to the gRPC api if it is
+// used for ingestion. The returned func closes the clients.
+func newIngester(ctx context.Context, opts options) (*ingester, func()) {
+	logger := logging.FromContext(ctx)
+	ing := &ingester{
+		opts:      opts,
+		transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport)),
+	}
+
+	// initialize collectsub client
+	csubClient, err := csub_client.NewClient(opts.csubClientOptions)
+	if err != nil {
+		logger.Errorf("collectsub client initialization failed with error: %v", err)
+		os.Exit(1)
+	}
+	ing.csubClient = csubClient
+
+	// initialize the notifications of ingested predicates
+	if opts.notifyConfig != "" {
+		notifyConfig, err := notify.Load(opts.notifyConfig)
+		if err != nil {
+			logger.Fatalf("unable to load notification config: %v", err)
+		}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &http.Client{Transport: ing.transport})
+		ing.notifier, err = notify.New(notifyConfig, notify.WithPackageResolver(notify.GraphPackageResolver(gqlclient)))
+		if err != nil {
+			logger.Fatalf("notifier initialization failed with error: %v", err)
+		}
+	}
+
+	// use the gRPC api for ingestion if configured, otherwise graphQL
+	if opts.grpcClientOptions.Addr != "" {
+		ing.grpcClient, err = grpc_client.NewClient(opts.grpcClientOptions)
+		if err != nil {
+			logger.Errorf("gRPC client initialization failed with error: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	// skip the documents that were already ingested
+	if opts.ledgerAddr != "" {
+		ing.ledger, err = ledger.Open(ctx, opts.ledgerAddr)
+		if err != nil {
+			logger.Fatalf("unable to open ledger: %v", err)
+		}
+	}
+
+	return ing, func() {
+		if ing.ledger != nil {
+			if err := ing.ledger.Close(); err != nil {
+				logger.Errorf("failed to close ledger: %v", err)
+			}
+		}
+		if ing.grpcClient != nil {
+			ing.grpcClient.Close()
+		}
+		if ing.notifier != nil {
+			if err := ing.notifier.Close(); err != nil {
+				logger.Errorf("failed to close notifier: %v", err)
+			}
+		}
+		ing.csubClient.Close()
+	}
+}
+
+// ingest ingests the document. Connection errors with the api are returned
+// as is, other errors are *dlq.StageError. Only the documents that failed to
+// be processed or parsed are dead-lettered, the others are retried.
+func (i *ingester) ingest(ctx context.Context, d *processor.Document) error {
+	var assemblerFunc func([]assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error)
+	if i.grpcClient != nil {
+		assemblerFunc = grpc_client.GetBulkAssembler(ctx, d.ChildLogger, i.grpcClient)
+	} else {
+		assemblerFunc = ingestor.GetAssembler(ctx, d.ChildLogger, i.opts.graphqlEndpoint, i.transport)
+	}
+	assemblerFunc = i.notifier.WrapAssembler(ctx, d.SourceInformation, assemblerFunc)
+	if _, _, err := ingestor.IngestOnce(
+		ctx,
+		d,
+		i.ledger,
+		i.opts.force,
+		assemblerFunc,
+		i.csubClient,
+		i.opts.queryVulnOnIngestion,
+		i.opts.queryLicenseOnIngestion,
+		i.opts.queryEOLOnIngestion,
+		i.opts.queryDepsDevOnIngestion,
+	); err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return fmt.Errorf("unable to ingest document due to connection error with graphQL %q : %w", d.SourceInformation.Source, urlErr)
+		}
+		if status.Code(err) == codes.Unavailable {
+			return fmt.Errorf("unable to ingest document due to connection error with gRPC %q : %w", d.SourceInformation.Source, status.Convert(err).Err())
+		}
+		return err
+	}
+	return nil
+}
+
+// validateFlagsFromViper validates the flags shared by the guacingest commands.
+func validateFlagsFromViper(args []string) (options, error) {
+	return validateFlags(
+		viper.GetString("pubsub-addr"),
+		viper.GetString("blob-addr"),
+		viper.GetString("csub-addr"),
+		viper.GetString("gql-addr"),
+		viper.GetString("grpc-addr"),
+		viper.GetString("header-file"),
+		viper.GetBool("csub-tls"),
+		viper.GetBool("csub-tls-skip-verify"),
+		viper.GetBool("grpc-tls"),
+		viper.GetBool("grpc-tls-skip-verify"),
+		viper.GetBool("add-vuln-on-ingest"),
+		viper.GetBool("add-license-on-ingest"),
+		viper.GetBool("add-eol-on-ingest"),
+		viper.GetBool("add-depsdev-on-ingest"),
+		viper.GetBool("enable-otel"),
+		viper.GetString("notify-config"),
+		viper.GetString("dlq-addr"),
+		viper.GetString("dlq-pubsub-addr"),
+		viper.GetString("ledger-addr"),
+		viper.GetBool("force"),
+		args)
+}
+
 func validateFlags(
-	pubsubAddr, blobAddr, csubAddr, graphqlEndpoint, headerFile string,
+	pubsubAddr, blobAddr, csubAddr, graphqlEndpoint, grpcAddr, headerFile string,
 	csubTls, csubTlsSkipVerify bool,
+	grpcTls, grpcTlsSkipVerify bool,
 	queryVulnIngestion bool,
 	queryLicenseIngestion bool,
 	queryEOLIngestion bool,
 	queryDepsDevIngestion bool,
 	enableOtel bool,
+	notifyConfig string,
+	dlqAddr, dlqPubsubAddr string,
+	ledgerAddr string,
+	force bool,
 	args []string,
 ) (options, error) {
 	var opts options
@@ -179,12 +318,30 @@ func validateFlags(
 	}
 	opts.csubClientOptions = csubOpts
 	opts.graphqlEndpoint = graphqlEndpoint
+	opts.grpcClientOptions = grpc_client.GrpcClientOptions{
+		Addr:          grpcAddr,
+		Tls:           grpcTls,
+		TlsSkipVerify: grpcTlsSkipVerify,
+		// same credentials as for the GQL server
+		TokenFile:      viper.GetString("gql-token-file"),
+		ClientCertFile: viper.GetString("gql-client-cert-file"),
+		ClientKeyFile:  viper.GetString("gql-client-key-file"),
+		CAFile:         viper.GetString("gql-ca-file"),
+	}
 	opts.headerFile = headerFile
 	opts.queryVulnOnIngestion = queryVulnIngestion
 	opts.queryLicenseOnIngestion = queryLicenseIngestion
 	opts.queryEOLOnIngestion = queryEOLIngestion
 	opts.queryDepsDevOnIngestion = queryDepsDevIngestion
 	opts.enableOtel = enableOtel
+	opts.notifyConfig = notifyConfig
+	opts.dlqAddr = dlqAddr
+	opts.dlqPubsubAddr = dlqPubsubAddr
+	opts.ledgerAddr = ledgerAddr
+	opts.force = force
+	if dlqPubsubAddr != "" && dlqAddr == "" {
+		return opts, fmt.Errorf("dlq-pubsub-addr requires dlq-addr to be set")
+	}
 
 	return opts, nil
 }
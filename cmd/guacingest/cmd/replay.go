@@ -0,0 +1,153 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/dlq"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var replayCmd = &cobra.Command{
+	Use:   "replay [flags] [document-key...]",
+	Short: "re-ingest the documents of the dead-letter queue",
+	Long: `Re-ingest the documents recorded in the dead-letter queue (--dlq-addr), for example after
+fixing the parser that failed on them. The documents are read again from the blob store.
+  [document-key...] are the blob store keys of the documents to replay, all the documents of the queue
+are replayed if none is given.
+The documents that are ingested are removed from the queue, the others are kept with their new error.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateFlagsFromViper(args)
+		if err == nil && opts.dlqAddr == "" {
+			err = fmt.Errorf("dlq-addr must be set to replay the dead-letter queue")
+		}
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		blobStore, err := blob.NewBlobStore(ctx, opts.blobAddr)
+		if err != nil {
+			logger.Fatalf("unable to connect to blob store: %v", err)
+		}
+		deadLetter, err := dlq.Open(ctx, opts.dlqAddr, opts.dlqPubsubAddr)
+		if err != nil {
+			logger.Fatalf("unable to open dead-letter queue: %v", err)
+		}
+		defer func() {
+			if err := deadLetter.Close(ctx); err != nil {
+				logger.Errorf("failed to close dead-letter queue: %v", err)
+			}
+		}()
+		ing, closeIngester := newIngester(ctx, opts)
+		defer closeIngester()
+
+		entries, err := replayEntries(ctx, deadLetter, args)
+		if err != nil {
+			logger.Fatalf("unable to list dead-letter queue: %v", err)
+		}
+
+		var ingested, failed int
+		for _, e := range entries {
+			ok, err := replay(ctx, ing, blobStore, deadLetter, e)
+			if err != nil {
+				// connection errors would fail all the remaining documents too
+				logger.Errorf("replay stopped: %v", err)
+				break
+			}
+			if ok {
+				ingested++
+			} else {
+				failed++
+			}
+		}
+		logger.Infof("replayed %d of %d dead-lettered documents: %d ingested, %d failed again", ingested+failed, len(entries), ingested, failed)
+	},
+}
+
+// replayEntries returns the entries of the given keys, or all the entries of
+// the queue if there are none.
+func replayEntries(ctx context.Context, deadLetter *dlq.Queue, keys []string) ([]*dlq.Entry, error) {
+	if len(keys) == 0 {
+		return deadLetter.List(ctx)
+	}
+	var entries []*dlq.Entry
+	for _, k := range keys {
+		e, err := deadLetter.Get(ctx, k)
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			return nil, fmt.Errorf("document %s is not in the dead-letter queue", k)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// replay ingests the document of the entry again, and removes it from the
+// queue if it is ingested. Otherwise, the failure is recorded and false is
+// returned. Connection errors are returned without recording them.
+func replay(ctx context.Context, ing *ingester, blobStore *blob.BlobStore, deadLetter *dlq.Queue, e *dlq.Entry) (bool, error) {
+	logger := logging.FromContext(ctx).With(zap.String(logging.DocumentHash, e.Key))
+
+	record := func(stage dlq.Stage, cause error) (bool, error) {
+		logger.Errorf("replay failed at stage %s: %v", stage, cause)
+		if _, err := deadLetter.Put(ctx, e.Key, e.Source, stage, cause); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+
+	documentBytes, err := blobStore.Read(ctx, e.Key)
+	if err != nil {
+		return record(dlq.StageRead, err)
+	}
+	doc := processor.Document{}
+	if err := json.Unmarshal(documentBytes, &doc); err != nil {
+		return record(dlq.StageDecode, err)
+	}
+	doc.ChildLogger = logger
+
+	if err := ing.ingest(ctx, &doc); err != nil {
+		stage, ok := dlq.StageOf(err)
+		if !ok {
+			return false, err
+		}
+		return record(stage, err)
+	}
+	if err := deadLetter.Remove(ctx, e.Key); err != nil {
+		return true, err
+	}
+	logger.Infof("replayed document %q after %d failed attempt(s)", doc.SourceInformation.Source, e.Attempts)
+	return true, nil
+}
+
+func init() {
+	rootCmd.AddCommand(replayCmd)
+}
@@ -30,23 +30,32 @@ import (
 func init() {
 	cobra.OnInitialize(cli.InitConfig)
 
-	set, err := cli.BuildFlags([]string{
+	set, err := cli.BuildFlags(append([]string{
 		"pubsub-addr",
 		"blob-addr",
 		"csub-addr",
 		"gql-addr",
+		"grpc-addr",
+		"grpc-tls",
+		"grpc-tls-skip-verify",
 		"header-file",
 		"add-vuln-on-ingest",
 		"add-license-on-ingest",
 		"add-eol-on-ingest",
 		"enable-otel",
-	})
+		"notify-config",
+		"dlq-addr",
+		"dlq-pubsub-addr",
+		"ledger-addr",
+		"force",
+	}, cli.AuthFlags...))
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
 	}
-	rootCmd.Flags().AddFlagSet(set)
-	if err := viper.BindPFlags(rootCmd.Flags()); err != nil {
+	// persistent so that the replay command connects to the same services
+	rootCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(rootCmd.PersistentFlags()); err != nil {
 		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
 		os.Exit(1)
 	}
@@ -26,7 +26,9 @@ import (
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/cli/output"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -37,6 +39,7 @@ type queryBadOptions struct {
 	graphqlEndpoint string
 	headerFile      string
 	depth           int
+	output          output.Format
 }
 
 var queryBadCmd = &cobra.Command{
@@ -50,6 +53,7 @@ var queryBadCmd = &cobra.Command{
 			viper.GetString("gql-addr"),
 			viper.GetString("header-file"),
 			viper.GetInt("search-depth"),
+			viper.GetString("output"),
 		)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -57,7 +61,7 @@ var queryBadCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)}
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
 		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
 		certifyBadResponse, err := model.CertifyBad(ctx, gqlclient, model.CertifyBadSpec{})
@@ -65,6 +69,17 @@ var queryBadCmd = &cobra.Command{
 			logger.Fatalf("error querying for package: %v", err)
 		}
 
+		// the prompt needs a terminal, so reports include all the CertifyBad nodes
+		if opts.output != output.FormatTable {
+			var rows []table.Row
+			for _, certifyBad := range certifyBadResponse.CertifyBad {
+				rows = append(rows, table.Row{badLinkStr, certifyBad.Id, "justification: " + certifyBad.Justification})
+			}
+			writeRowsReport(ctx, gqlclient, opts.output, "query bad", "", nil,
+				"No CertifyBad nodes found!", rowsSection{rows: rows})
+			return
+		}
+
 		if len(certifyBadResponse.CertifyBad) == 0 {
 			fmt.Println("No CertifyBad nodes found!")
 			return
@@ -248,12 +263,18 @@ var queryBadCmd = &cobra.Command{
 	},
 }
 
-func validateQueryBadFlags(graphqlEndpoint, headerFile string, depth int) (queryBadOptions, error) {
+func validateQueryBadFlags(graphqlEndpoint, headerFile string, depth int, outputFormat string) (queryBadOptions, error) {
 	var opts queryBadOptions
 	opts.graphqlEndpoint = graphqlEndpoint
 	opts.headerFile = headerFile
 	opts.depth = depth
 
+	format, err := parseOutputFlag(outputFormat)
+	if err != nil {
+		return opts, err
+	}
+	opts.output = format
+
 	return opts, nil
 }
 
@@ -70,7 +70,7 @@ var certifyCmd = &cobra.Command{
 
 		ctx := logging.WithLogger(context.Background())
 		logger := logging.FromContext(ctx)
-		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))
 
 		assemblerFunc := ingestor.GetAssembler(ctx, logger, opts.graphqlEndpoint, transport)
 
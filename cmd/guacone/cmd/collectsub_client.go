@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 
@@ -144,27 +145,7 @@ var csubGetCollectEntriesCmd = &cobra.Command{
 		logger := logging.FromContext(ctx)
 		defer csubClient.Close()
 
-		var pbFilters []*collectsub.CollectEntryFilter
-		if len(args) > 0 && args[0] == "stdin" {
-
-			bytes, err := io.ReadAll(cmd.InOrStdin())
-			if err != nil {
-				logger.Fatalf("error reading input from STDIN: %v", err)
-			}
-
-			var filters []input.CollectEntryFilterInput
-			err = json.Unmarshal(bytes, &filters)
-			if err != nil {
-				logger.Fatalf("unmarshallign input: %v", err)
-			}
-
-			pbFilters = make([]*collectsub.CollectEntryFilter, len(filters))
-			for i, f := range filters {
-				pbFilters[i] = f.Convert()
-			}
-		} else {
-			pbFilters = getAllFilters
-		}
+		pbFilters := readCollectEntryFilters(ctx, cmd, args)
 
 		pbEntries, err := csubClient.GetCollectEntries(ctx, pbFilters)
 		if err != nil {
@@ -182,6 +163,142 @@ var csubGetCollectEntriesCmd = &cobra.Command{
 	},
 }
 
+// readCollectEntryFilters reads the filters from STDIN if the first argument is
+// "stdin", and returns filters matching all entries otherwise.
+func readCollectEntryFilters(ctx context.Context, cmd *cobra.Command, args []string) []*collectsub.CollectEntryFilter {
+	logger := logging.FromContext(ctx)
+	if len(args) == 0 || args[0] != "stdin" {
+		return getAllFilters
+	}
+
+	bytes, err := io.ReadAll(cmd.InOrStdin())
+	if err != nil {
+		logger.Fatalf("error reading input from STDIN: %v", err)
+	}
+
+	var filters []input.CollectEntryFilterInput
+	err = json.Unmarshal(bytes, &filters)
+	if err != nil {
+		logger.Fatalf("unmarshallign input: %v", err)
+	}
+
+	pbFilters := make([]*collectsub.CollectEntryFilter, len(filters))
+	for i, f := range filters {
+		pbFilters[i] = f.Convert()
+	}
+	return pbFilters
+}
+
+/*
+Examples:
+
+# remove an entry
+echo '[{"type":"DATATYPE_GIT", "value":"git+https://github.com/guacsec/guac"}]' | bin/guacone csub-client remove-collect-entries
+*/
+var csubRemoveCollectEntriesCmd = &cobra.Command{
+	Use:   "remove-collect-entries",
+	Short: "calls remove-collect-entries service",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, csubClient := setupCsubClient(cmd, args)
+		logger := logging.FromContext(ctx)
+		defer csubClient.Close()
+
+		bytes, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			logger.Fatalf("error reading input from STDIN: %v", err)
+		}
+		var entries []input.CollectEntryInput
+		err = json.Unmarshal(bytes, &entries)
+		if err != nil {
+			logger.Fatalf("unmarshalling input: %v", err)
+		}
+
+		pbEntries := make([]*collectsub.CollectEntry, len(entries))
+		for i, e := range entries {
+			pbEntries[i] = e.Convert()
+		}
+
+		removed, err := csubClient.RemoveCollectEntries(ctx, pbEntries)
+		if err != nil {
+			logger.Fatalf("call to RemoveCollectEntries failed: %v", err)
+		}
+		fmt.Printf("removed %d entries\n", removed)
+	},
+}
+
+/*
+Examples:
+
+# lease up to 10 entries of any type for 10 minutes
+guacone csub-client lease-collect-entries --csub-lease-max-entries 10 --csub-lease-duration 10m
+
+# use custom filters
+echo '[{"type":"DATATYPE_GIT", "value":"*"}]' | guacone csub-client lease-collect-entries stdin
+*/
+var csubLeaseCollectEntriesCmd = &cobra.Command{
+	Use:     "lease-collect-entries [all | stdin] (defaults to all)",
+	Short:   "calls lease-collect-entries service",
+	PreRunE: bindCommandFlags,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, csubClient := setupCsubClient(cmd, args)
+		logger := logging.FromContext(ctx)
+		defer csubClient.Close()
+
+		leaseDuration, err := time.ParseDuration(viper.GetString("csub-lease-duration"))
+		if err != nil {
+			fmt.Printf("failed to parse csub-lease-duration: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		pbFilters := readCollectEntryFilters(ctx, cmd, args)
+
+		leases, err := csubClient.LeaseCollectEntries(ctx, pbFilters, viper.GetInt("csub-lease-max-entries"), leaseDuration)
+		if err != nil {
+			logger.Fatalf("call to LeaseCollectEntries failed: %v", err)
+		}
+
+		for _, l := range leases {
+			out, err := json.Marshal(input.ConvertCollectEntryLease(l))
+			if err != nil {
+				logger.Fatalf("marshalling lease: %v", err)
+			}
+			fmt.Println(string(out))
+		}
+	},
+}
+
+/*
+Examples:
+
+# acknowledge two leases, leasing the entries again after a day
+guacone csub-client ack-collect-entries --csub-requeue-after 24h <lease-id> <lease-id>
+*/
+var csubAckCollectEntriesCmd = &cobra.Command{
+	Use:     "ack-collect-entries <lease-id>...",
+	Short:   "calls ack-collect-entries service",
+	Args:    cobra.MinimumNArgs(1),
+	PreRunE: bindCommandFlags,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, csubClient := setupCsubClient(cmd, args)
+		logger := logging.FromContext(ctx)
+		defer csubClient.Close()
+
+		requeueAfter, err := time.ParseDuration(viper.GetString("csub-requeue-after"))
+		if err != nil {
+			fmt.Printf("failed to parse csub-requeue-after: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		acknowledged, err := csubClient.AckCollectEntries(ctx, args, requeueAfter)
+		if err != nil {
+			logger.Fatalf("call to AckCollectEntries failed: %v", err)
+		}
+		fmt.Printf("acknowledged %d leases\n", acknowledged)
+	},
+}
+
 func init() {
 	set, err := cli.BuildFlags([]string{"csub-addr"})
 	if err != nil {
@@ -197,4 +314,21 @@ func init() {
 	rootCmd.AddCommand(csubClientCmd)
 	csubClientCmd.AddCommand(csubAddCollectEntriesCmd)
 	csubClientCmd.AddCommand(csubGetCollectEntriesCmd)
+	csubClientCmd.AddCommand(csubRemoveCollectEntriesCmd)
+
+	leaseSet, err := cli.BuildFlags([]string{"csub-lease-duration", "csub-lease-max-entries"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	csubLeaseCollectEntriesCmd.Flags().AddFlagSet(leaseSet)
+	csubClientCmd.AddCommand(csubLeaseCollectEntriesCmd)
+
+	ackSet, err := cli.BuildFlags([]string{"csub-requeue-after"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	csubAckCollectEntriesCmd.Flags().AddFlagSet(ackSet)
+	csubClientCmd.AddCommand(csubAckCollectEntriesCmd)
 }
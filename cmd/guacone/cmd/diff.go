@@ -0,0 +1,413 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/cli/output"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type diffOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	format          output.Format
+	before          string
+	after           string
+}
+
+// sbomDiffReport is the flattened form of an SbomDiff that is written by the
+// diff command, both as JSON and as tables.
+type sbomDiffReport struct {
+	Before          diffSBOM         `json:"before"`
+	After           diffSBOM         `json:"after"`
+	Packages        []diffPackage    `json:"packages"`
+	Artifacts       []diffArtifact   `json:"artifacts"`
+	Dependencies    []diffDependency `json:"dependencies"`
+	Vulnerabilities []diffVuln       `json:"vulnerabilities"`
+	Licenses        []diffLicense    `json:"licenses"`
+	Scorecards      []diffScorecard  `json:"scorecards"`
+}
+
+type diffSBOM struct {
+	ID         string    `json:"id"`
+	Subject    string    `json:"subject"`
+	URI        string    `json:"uri"`
+	KnownSince time.Time `json:"knownSince"`
+}
+
+type diffPackage struct {
+	Change        string `json:"change"`
+	Package       string `json:"package"`
+	BeforeVersion string `json:"beforeVersion,omitempty"`
+	AfterVersion  string `json:"afterVersion,omitempty"`
+}
+
+type diffArtifact struct {
+	Change   string `json:"change"`
+	Artifact string `json:"artifact"`
+}
+
+type diffDependency struct {
+	Change     string `json:"change"`
+	Package    string `json:"package"`
+	Dependency string `json:"dependency"`
+}
+
+type diffVuln struct {
+	Change          string `json:"change"`
+	VulnerabilityID string `json:"vulnerabilityID"`
+	Package         string `json:"package"`
+}
+
+type diffLicense struct {
+	Package        string   `json:"package"`
+	BeforeVersion  string   `json:"beforeVersion"`
+	AfterVersion   string   `json:"afterVersion"`
+	BeforeLicenses []string `json:"beforeLicenses"`
+	AfterLicenses  []string `json:"afterLicenses"`
+}
+
+type diffScorecard struct {
+	Change      string   `json:"change"`
+	Package     string   `json:"package"`
+	Source      string   `json:"source"`
+	BeforeScore *float64 `json:"beforeScore,omitempty"`
+	AfterScore  *float64 `json:"afterScore,omitempty"`
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff [flags] <before-id> <after-id>",
+	Short: "compare two SBOMs of a product",
+	Long: `The diff command compares two SBOMs, usually of two versions of the same product. It reports the added, removed
+and upgraded dependencies, the new and resolved vulnerabilities, the license changes and the scorecard deltas of the
+changed packages.
+
+Positional Arguments:
+  <before-id>    The ID of the HasSBOM node of the older SBOM, or of the artifact or package version it describes
+  <after-id>     The ID of the HasSBOM node of the newer SBOM, or of the artifact or package version it describes`,
+	PreRunE: bindCommandFlags,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateDiffFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("output"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		resp, err := model.SbomDiff(ctx, gqlclient, opts.before, opts.after)
+		if err != nil {
+			logger.Fatalf("error comparing the SBOMs: %v", err)
+		}
+		report := newSbomDiffReport(&resp.SbomDiff)
+
+		if opts.format == output.FormatJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(report); err != nil {
+				logger.Fatalf("error writing the diff: %v", err)
+			}
+			return
+		}
+		writeDiffTables(os.Stdout, report)
+	},
+}
+
+func validateDiffFlags(graphqlEndpoint, headerFile, outputFormat string, args []string) (diffOptions, error) {
+	var opts diffOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+
+	f, err := parseOutputFlag(outputFormat)
+	if err != nil {
+		return opts, err
+	}
+	if f != output.FormatTable && f != output.FormatJSON {
+		return opts, fmt.Errorf("unsupported output format for diff: %s", f)
+	}
+	opts.format = f
+
+	if len(args) != 2 {
+		return opts, fmt.Errorf("expected exactly two arguments: <before-id> <after-id>")
+	}
+	opts.before = args[0]
+	opts.after = args[1]
+	return opts, nil
+}
+
+func newSbomDiffReport(d *model.SbomDiffSbomDiff) *sbomDiffReport {
+	report := &sbomDiffReport{
+		Before:          newDiffSBOM(&d.Before.SbomDiffSBOM),
+		After:           newDiffSBOM(&d.After.SbomDiffSBOM),
+		Packages:        []diffPackage{},
+		Artifacts:       []diffArtifact{},
+		Dependencies:    []diffDependency{},
+		Vulnerabilities: []diffVuln{},
+		Licenses:        []diffLicense{},
+		Scorecards:      []diffScorecard{},
+	}
+
+	for _, p := range d.Packages {
+		entry := diffPackage{Change: string(p.Change)}
+		if p.Before != nil {
+			entry.Package = diffPackageName(&p.Before.AllPkgTree)
+			entry.BeforeVersion = diffPackageVersion(&p.Before.AllPkgTree)
+		}
+		if p.After != nil {
+			entry.Package = diffPackageName(&p.After.AllPkgTree)
+			entry.AfterVersion = diffPackageVersion(&p.After.AllPkgTree)
+		}
+		report.Packages = append(report.Packages, entry)
+	}
+
+	for _, a := range d.Artifacts {
+		report.Artifacts = append(report.Artifacts, diffArtifact{
+			Change:   string(a.Change),
+			Artifact: a.Artifact.Algorithm + ":" + a.Artifact.Digest,
+		})
+	}
+
+	for _, dep := range d.Dependencies {
+		report.Dependencies = append(report.Dependencies, diffDependency{
+			Change:     string(dep.Change),
+			Package:    helpers.AllPkgTreeToPurl(&dep.IsDependency.Package.AllPkgTree),
+			Dependency: helpers.AllPkgTreeToPurl(&dep.IsDependency.DependencyPackage.AllPkgTree),
+		})
+	}
+
+	for _, v := range d.Vulnerabilities {
+		for _, id := range v.CertifyVuln.Vulnerability.VulnerabilityIDs {
+			report.Vulnerabilities = append(report.Vulnerabilities, diffVuln{
+				Change:          string(v.Change),
+				VulnerabilityID: id.VulnerabilityID,
+				Package:         helpers.AllPkgTreeToPurl(&v.CertifyVuln.Package.AllPkgTree),
+			})
+		}
+	}
+
+	for _, l := range d.Licenses {
+		entry := diffLicense{
+			Package:        diffPackageName(&l.Before.AllPkgTree),
+			BeforeVersion:  diffPackageVersion(&l.Before.AllPkgTree),
+			AfterVersion:   diffPackageVersion(&l.After.AllPkgTree),
+			BeforeLicenses: []string{},
+			AfterLicenses:  []string{},
+		}
+		for _, legal := range l.BeforeLegals {
+			entry.BeforeLicenses = append(entry.BeforeLicenses, legalExpressions(&legal.AllCertifyLegalTree)...)
+		}
+		for _, legal := range l.AfterLegals {
+			entry.AfterLicenses = append(entry.AfterLicenses, legalExpressions(&legal.AllCertifyLegalTree)...)
+		}
+		entry.BeforeLicenses = sortedUnique(entry.BeforeLicenses)
+		entry.AfterLicenses = sortedUnique(entry.AfterLicenses)
+		report.Licenses = append(report.Licenses, entry)
+	}
+
+	for _, s := range d.Scorecards {
+		entry := diffScorecard{
+			Change:  string(s.Change),
+			Package: diffPackageName(&s.Package.AllPkgTree),
+		}
+		if s.Before != nil {
+			entry.Source = diffSourceName(&s.Before.Source.AllSourceTree)
+			score := s.Before.Scorecard.AggregateScore
+			entry.BeforeScore = &score
+		}
+		if s.After != nil {
+			entry.Source = diffSourceName(&s.After.Source.AllSourceTree)
+			score := s.After.Scorecard.AggregateScore
+			entry.AfterScore = &score
+		}
+		report.Scorecards = append(report.Scorecards, entry)
+	}
+
+	return report
+}
+
+func newDiffSBOM(s *model.SbomDiffSBOM) diffSBOM {
+	sbom := diffSBOM{ID: s.Id, URI: s.Uri, KnownSince: s.KnownSince}
+	switch subject := s.Subject.(type) {
+	case *model.SbomDiffSBOMSubjectPackage:
+		sbom.Subject = helpers.AllPkgTreeToPurl(&subject.AllPkgTree)
+	case *model.SbomDiffSBOMSubjectArtifact:
+		sbom.Subject = subject.Algorithm + ":" + subject.Digest
+	}
+	return sbom
+}
+
+// diffPackageName returns the purl of a package without its version, which
+// is what the before and after packages of a change have in common.
+func diffPackageName(p *model.AllPkgTree) string {
+	for _, ns := range p.Namespaces {
+		for _, n := range ns.Names {
+			return helpers.PkgToPurl(p.Type, ns.Namespace, n.Name, "", "", nil)
+		}
+	}
+	return ""
+}
+
+func diffPackageVersion(p *model.AllPkgTree) string {
+	for _, ns := range p.Namespaces {
+		for _, n := range ns.Names {
+			for _, v := range n.Versions {
+				return v.Version
+			}
+		}
+	}
+	return ""
+}
+
+func diffSourceName(s *model.AllSourceTree) string {
+	for _, ns := range s.Namespaces {
+		for _, n := range ns.Names {
+			return ns.Namespace + "/" + n.Name
+		}
+	}
+	return ""
+}
+
+func legalExpressions(l *model.AllCertifyLegalTree) []string {
+	var licenses []string
+	if l.DeclaredLicense != "" {
+		licenses = append(licenses, l.DeclaredLicense)
+	}
+	if l.DiscoveredLicense != "" {
+		licenses = append(licenses, l.DiscoveredLicense)
+	}
+	return licenses
+}
+
+func sortedUnique(values []string) []string {
+	sort.Strings(values)
+	unique := values[:0]
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+func writeDiffTables(w io.Writer, r *sbomDiffReport) {
+	fmt.Fprintf(w, "Comparing %s (%s) with %s (%s)\n", r.Before.Subject, r.Before.URI, r.After.Subject, r.After.URI)
+
+	empty := true
+	newTable := func(title string, header table.Row) table.Writer {
+		empty = false
+		t := table.NewWriter()
+		t.SetOutputMirror(w)
+		t.SetTitle(title)
+		t.AppendHeader(header)
+		return t
+	}
+
+	if len(r.Packages) > 0 {
+		t := newTable("Packages", table.Row{"Change", "Package", "Before", "After"})
+		for _, p := range r.Packages {
+			t.AppendRow(table.Row{p.Change, p.Package, p.BeforeVersion, p.AfterVersion})
+		}
+		t.Render()
+	}
+	if len(r.Artifacts) > 0 {
+		t := newTable("Artifacts", table.Row{"Change", "Artifact"})
+		for _, a := range r.Artifacts {
+			t.AppendRow(table.Row{a.Change, a.Artifact})
+		}
+		t.Render()
+	}
+	if len(r.Dependencies) > 0 {
+		t := newTable("Dependencies", table.Row{"Change", "Package", "Dependency"})
+		for _, d := range r.Dependencies {
+			t.AppendRow(table.Row{d.Change, d.Package, d.Dependency})
+		}
+		t.Render()
+	}
+	if len(r.Vulnerabilities) > 0 {
+		t := newTable("Vulnerabilities", table.Row{"Change", "Vulnerability ID", "Package"})
+		for _, v := range r.Vulnerabilities {
+			t.AppendRow(table.Row{v.Change, v.VulnerabilityID, v.Package})
+		}
+		t.Render()
+	}
+	if len(r.Licenses) > 0 {
+		t := newTable("Licenses", table.Row{"Package", "Before", "After"})
+		for _, l := range r.Licenses {
+			t.AppendRow(table.Row{
+				l.Package,
+				l.BeforeVersion + ": " + strings.Join(l.BeforeLicenses, ", "),
+				l.AfterVersion + ": " + strings.Join(l.AfterLicenses, ", "),
+			})
+		}
+		t.Render()
+	}
+	if len(r.Scorecards) > 0 {
+		t := newTable("Scorecards", table.Row{"Change", "Package", "Source", "Before", "After"})
+		for _, s := range r.Scorecards {
+			t.AppendRow(table.Row{s.Change, s.Package, s.Source, formatScore(s.BeforeScore), formatScore(s.AfterScore)})
+		}
+		t.Render()
+	}
+
+	if empty {
+		fmt.Fprintln(w, "No differences found!")
+	}
+}
+
+func formatScore(score *float64) string {
+	if score == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f", *score)
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"output"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	diffCmd.Flags().AddFlagSet(set)
+	diffCmd.Flags().Lookup("output").Usage = "format of the diff: table or json"
+
+	rootCmd.AddCommand(diffCmd)
+}
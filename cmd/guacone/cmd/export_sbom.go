@@ -0,0 +1,124 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/export/sbom"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type exportSBOMOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	format          sbom.Format
+	exportFile      string
+	subject         sbom.Subject
+}
+
+var exportSBOMCmd = &cobra.Command{
+	Use:   "sbom [flags] <type> <input>",
+	Short: "export an SBOM of a package or artifact",
+	Long: `The sbom command regenerates an SBOM for a package or artifact from the graph. It follows the software and
+dependencies included by the SBOMs of the subject, its dependencies and its occurrences, and adds the licenses,
+hashes and source locations known for each component. The document is written as SPDX 2.3 or CycloneDX 1.5 JSON.
+
+Positional Arguments:
+  <type>    Specify the input type: 'artifact' or 'purl'
+  <input>   The corresponding input based on the specified type, a purl or algorithm:digest`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateExportSBOMFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("sbom-format"),
+			viper.GetString("export-file"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		w, closeFn, err := exportWriter(opts.exportFile)
+		if err != nil {
+			logger.Fatalf("unable to create export file: %v", err)
+		}
+		if err := sbom.Export(ctx, gqlclient, opts.subject, opts.format, w); err != nil {
+			_ = closeFn()
+			logger.Fatalf("error exporting SBOM: %v", err)
+		}
+		if err := closeFn(); err != nil {
+			logger.Fatalf("unable to close export file: %v", err)
+		}
+	},
+}
+
+func validateExportSBOMFlags(graphqlEndpoint, headerFile, format, exportFile string, args []string) (exportSBOMOptions, error) {
+	var opts exportSBOMOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.exportFile = exportFile
+
+	f, err := sbom.ParseFormat(strings.ToLower(format))
+	if err != nil {
+		return opts, err
+	}
+	opts.format = f
+
+	if len(args) != 2 {
+		return opts, fmt.Errorf("expected exactly two arguments: <type> and <input>")
+	}
+	switch strings.ToLower(args[0]) {
+	case purlType:
+		opts.subject.Purl = args[1]
+	case artifactType:
+		opts.subject.Digest = args[1]
+	default:
+		return opts, fmt.Errorf("invalid input type %q, valid types are: %v", args[0], []string{purlType, artifactType})
+	}
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"sbom-format"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	exportSBOMCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(exportSBOMCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	exportCmd.AddCommand(exportSBOMCmd)
+}
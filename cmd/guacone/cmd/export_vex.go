@@ -0,0 +1,119 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/export/vex"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type exportVEXOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	format          vex.Format
+	author          string
+	exportFile      string
+	subject         vex.Subject
+}
+
+var exportVEXCmd = &cobra.Command{
+	Use:   "vex [flags] <purl>",
+	Short: "export a VEX document of a package",
+	Long: `The vex command generates a VEX document for a package from the VEX statements recorded in the graph for the
+package and its dependencies. Every vulnerability found by a scan that no statement covers yet is reported as
+under_investigation. The document is written as OpenVEX or CSAF 2.0 JSON.
+
+Positional Arguments:
+  <purl>    The purl of the package`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateExportVEXFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("vex-format"),
+			viper.GetString("vex-author"),
+			viper.GetString("export-file"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		w, closeFn, err := exportWriter(opts.exportFile)
+		if err != nil {
+			logger.Fatalf("unable to create export file: %v", err)
+		}
+		if err := vex.Export(ctx, gqlclient, opts.subject, opts.format, opts.author, w); err != nil {
+			_ = closeFn()
+			logger.Fatalf("error exporting VEX document: %v", err)
+		}
+		if err := closeFn(); err != nil {
+			logger.Fatalf("unable to close export file: %v", err)
+		}
+	},
+}
+
+func validateExportVEXFlags(graphqlEndpoint, headerFile, format, author, exportFile string, args []string) (exportVEXOptions, error) {
+	var opts exportVEXOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.author = author
+	opts.exportFile = exportFile
+
+	f, err := vex.ParseFormat(strings.ToLower(format))
+	if err != nil {
+		return opts, err
+	}
+	opts.format = f
+
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected exactly one argument: <purl>")
+	}
+	opts.subject.Purl = args[0]
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"vex-format", "vex-author"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	exportVEXCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(exportVEXCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	exportCmd.AddCommand(exportVEXCmd)
+}
@@ -21,9 +21,12 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/guacsec/guac/pkg/cli"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/handler/collector"
@@ -32,6 +35,8 @@ import (
 	"github.com/guacsec/guac/pkg/ingestor"
 	"github.com/guacsec/guac/pkg/ingestor/key"
 	"github.com/guacsec/guac/pkg/ingestor/key/inmemory"
+	"github.com/guacsec/guac/pkg/ingestor/ledger"
+	"github.com/guacsec/guac/pkg/ingestor/notify"
 	"github.com/guacsec/guac/pkg/ingestor/verifier"
 	"github.com/guacsec/guac/pkg/ingestor/verifier/sigstore_verifier"
 	"github.com/guacsec/guac/pkg/logging"
@@ -57,6 +62,16 @@ type fileOptions struct {
 	queryEOLOnIngestion     bool
 	queryDepsDevOnIngestion bool
 	enableOtel              bool
+	// subscription file of the notifications of ingested predicates
+	notifyConfig string
+	// watch the path for new and modified files until interrupted
+	watch bool
+	// checkpoint file of the collected files
+	checkpoint string
+	// ledger of the ingested documents, empty disables it
+	ledgerAddr string
+	// ingest documents the ledger records as ingested
+	force bool
 }
 
 var filesCmd = &cobra.Command{
@@ -76,6 +91,11 @@ var filesCmd = &cobra.Command{
 			viper.GetBool("add-eol-on-ingest"),
 			viper.GetBool("add-depsdev-on-ingest"),
 			viper.GetBool("enable-otel"),
+			viper.GetString("notify-config"),
+			viper.GetBool("file-watch"),
+			viper.GetString("file-checkpoint"),
+			viper.GetString("ledger-addr"),
+			viper.GetBool("force"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -85,7 +105,14 @@ var filesCmd = &cobra.Command{
 
 		ctx := logging.WithLogger(context.Background())
 		logger := logging.FromContext(ctx)
-		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))
+
+		if opts.watch {
+			// the watch runs until interrupted
+			var stop context.CancelFunc
+			ctx, stop = signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+		}
 
 		if opts.enableOtel {
 			shutdown, err := metrics.SetupOTelSDK(ctx)
@@ -125,7 +152,9 @@ var filesCmd = &cobra.Command{
 		}
 
 		// Register collector
-		fileCollector := file.NewFileCollector(ctx, opts.path, false, time.Second)
+		fileCollector := file.NewFileCollector(ctx, opts.path, false, time.Second,
+			file.WithWatch(opts.watch),
+			file.WithCheckpoint(opts.checkpoint))
 		err = collector.RegisterDocumentCollector(fileCollector, file.FileCollector)
 		if err != nil {
 			logger.Fatalf("unable to register file collector: %v", err)
@@ -140,36 +169,74 @@ var filesCmd = &cobra.Command{
 			defer csubClient.Close()
 		}
 
+		var notifier *notify.Notifier
+		if opts.notifyConfig != "" {
+			notifyConfig, err := notify.Load(opts.notifyConfig)
+			if err != nil {
+				logger.Fatalf("unable to load notification config: %v", err)
+			}
+			gqlclient := graphql.NewClient(opts.graphqlEndpoint, &http.Client{Transport: transport})
+			notifier, err = notify.New(notifyConfig, notify.WithPackageResolver(notify.GraphPackageResolver(gqlclient)))
+			if err != nil {
+				logger.Fatalf("notifier initialization failed with error: %v", err)
+			}
+			defer func() {
+				if err := notifier.Close(); err != nil {
+					logger.Errorf("failed to close notifier: %v", err)
+				}
+			}()
+		}
+
+		var ingestLedger *ledger.Ledger
+		if opts.ledgerAddr != "" {
+			ingestLedger, err = ledger.Open(ctx, opts.ledgerAddr)
+			if err != nil {
+				logger.Fatalf("unable to open ledger: %v", err)
+			}
+			defer func() {
+				if err := ingestLedger.Close(); err != nil {
+					logger.Errorf("failed to close ledger: %v", err)
+				}
+			}()
+		}
+
 		totalNum := 0
 		totalSuccess := 0
+		totalSkipped := 0
 		var filesWithErrors []string
 
 		gotErr := false
 
 		emit := func(d *processor.Document) error {
 			totalNum += 1
-			if _, err := ingestor.Ingest(
+			assemblerFunc := ingestor.GetAssembler(ctx, d.ChildLogger, opts.graphqlEndpoint, transport)
+			_, skipped, err := ingestor.IngestOnce(
 				ctx,
 				d,
-				opts.graphqlEndpoint,
-				transport,
+				ingestLedger,
+				opts.force,
+				notifier.WrapAssembler(ctx, d.SourceInformation, assemblerFunc),
 				csubClient,
 				opts.queryVulnOnIngestion,
 				opts.queryLicenseOnIngestion,
 				opts.queryEOLOnIngestion,
 				opts.queryDepsDevOnIngestion,
-			); err != nil {
+			)
+			if err != nil {
 				gotErr = true
 				filesWithErrors = append(filesWithErrors, d.SourceInformation.Source)
 				return fmt.Errorf("unable to ingest document: %w", err)
 			}
+			if skipped {
+				totalSkipped += 1
+			}
 			totalSuccess += 1
 			return nil
 		}
 
 		// Collect
 		errHandler := func(err error) bool {
-			if err == nil {
+			if err == nil || (opts.watch && errors.Is(err, context.Canceled)) {
 				logger.Info("collector ended gracefully")
 				return true
 			}
@@ -185,7 +252,7 @@ var filesCmd = &cobra.Command{
 			logger.Errorf("completed ingestion with error, %v of %v were successful - the following files did not ingest successfully:  %v",
 				totalSuccess, totalNum, strings.Join(filesWithErrors, " "))
 		} else {
-			logger.Infof("completed ingesting %v documents of %v", totalSuccess, totalNum)
+			logger.Infof("completed ingesting %v documents of %v (%v already ingested)", totalSuccess, totalNum, totalSkipped)
 		}
 	},
 }
@@ -197,12 +264,22 @@ func validateFilesFlags(keyPath, keyID, graphqlEndpoint, headerFile, csubAddr st
 	queryEOLIngestion bool,
 	queryDepsDevOnIngestion bool,
 	enableOtel bool,
+	notifyConfig string,
+	watch bool,
+	checkpoint string,
+	ledgerAddr string,
+	force bool,
 	args []string,
 ) (fileOptions, error) {
 	var opts fileOptions
 	opts.graphqlEndpoint = graphqlEndpoint
 	opts.headerFile = headerFile
 	opts.enableOtel = enableOtel
+	opts.notifyConfig = notifyConfig
+	opts.watch = watch
+	opts.checkpoint = checkpoint
+	opts.ledgerAddr = ledgerAddr
+	opts.force = force
 
 	if keyPath != "" {
 		if strings.HasSuffix(keyPath, "pem") {
@@ -237,6 +314,11 @@ func init() {
 		"verifier-key-path",
 		"verifier-key-id",
 		"enable-otel",
+		"notify-config",
+		"file-watch",
+		"file-checkpoint",
+		"ledger-addr",
+		"force",
 	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
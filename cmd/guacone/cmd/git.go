@@ -0,0 +1,246 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	git_collector "github.com/guacsec/guac/pkg/handler/collector/git"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type gitOptions struct {
+	// url of the git repository to collect
+	url string
+	// directory to clone the repository into
+	cloneDir string
+	// glob patterns of the files to collect
+	includes []string
+	// glob patterns of the files to skip
+	excludes []string
+	// collect the documents of each tagged release
+	tagHistory bool
+	// gql endpoint
+	graphqlEndpoint string
+	headerFile      string
+	// csub client options for identifier strings
+	csubClientOptions       csub_client.CsubClientOptions
+	queryVulnOnIngestion    bool
+	queryLicenseOnIngestion bool
+	queryEOLOnIngestion     bool
+	queryDepsDevOnIngestion bool
+}
+
+var gitCmd = &cobra.Command{
+	Use:   "git [flags] repo_url",
+	Short: "takes a git repository and ingests the documents found in it into the GUAC graph, this command talks directly to the graphQL endpoint",
+	Long: `
+Takes a git repository and ingests the documents found in it, such as SBOMs and
+attestations. The files to collect are selected with glob patterns on their
+path in the repository (--git-include and --git-exclude). With --git-tag-history
+the documents are also collected as they were at each tagged release. The
+packages of the ingested SBOMs are linked to the source revision they were
+collected from.
+
+Examples:
+
+# ingest the SPDX and CycloneDX SBOMs of all releases of a repository
+guacone collect git --git-include "**/*.spdx.json,**/*.cdx.json" --git-tag-history https://github.com/guacsec/guac`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateGitFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("csub-addr"),
+			viper.GetString("git-clone-dir"),
+			viper.GetString("git-include"),
+			viper.GetString("git-exclude"),
+			viper.GetBool("git-tag-history"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetBool("add-vuln-on-ingest"),
+			viper.GetBool("add-license-on-ingest"),
+			viper.GetBool("add-eol-on-ingest"),
+			viper.GetBool("add-depsdev-on-ingest"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))
+
+		if opts.cloneDir == "" {
+			tmpDir, err := os.MkdirTemp("", "guac-git-")
+			if err != nil {
+				logger.Fatalf("unable to create clone directory: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+			opts.cloneDir = filepath.Join(tmpDir, "repo")
+		}
+
+		// Register collector
+		gitCollector, err := git_collector.NewGitDocumentCollector(ctx, opts.url, opts.cloneDir, false, time.Second,
+			git_collector.WithIncludes(opts.includes...),
+			git_collector.WithExcludes(opts.excludes...),
+			git_collector.WithTagHistory(opts.tagHistory))
+		if err != nil {
+			logger.Fatalf("unable to create git collector: %v", err)
+		}
+		err = collector.RegisterDocumentCollector(gitCollector, git_collector.CollectorGitDocument)
+		if err != nil {
+			logger.Fatalf("unable to register git collector: %v", err)
+		}
+
+		// initialize collectsub client
+		csubClient, err := csub_client.NewClient(opts.csubClientOptions)
+		if err != nil {
+			logger.Infof("collectsub client initialization failed, this ingestion will not pull in any additional data through the collectsub service: %v", err)
+			csubClient = nil
+		} else {
+			defer csubClient.Close()
+		}
+
+		totalNum := 0
+		totalSuccess := 0
+		var filesWithErrors []string
+		// Set emit function to go through the entire pipeline
+		emit := func(d *processor.Document) error {
+			totalNum += 1
+			_, err := ingestor.Ingest(
+				ctx,
+				d,
+				opts.graphqlEndpoint,
+				transport,
+				csubClient,
+				opts.queryVulnOnIngestion,
+				opts.queryLicenseOnIngestion,
+				opts.queryEOLOnIngestion,
+				opts.queryDepsDevOnIngestion,
+			)
+			if err != nil {
+				filesWithErrors = append(filesWithErrors, d.SourceInformation.Source)
+				return fmt.Errorf("unable to ingest document: %w", err)
+			}
+			totalSuccess += 1
+			return nil
+		}
+
+		// Collect
+		errHandler := func(err error) bool {
+			if err == nil {
+				logger.Info("collector ended gracefully")
+				return true
+			}
+			logger.Errorf("collector ended with error: %v", err)
+			return false
+		}
+		if err := collector.Collect(ctx, emit, errHandler); err != nil {
+			logger.Errorf("collector exited with error: %v", err)
+		}
+
+		if len(filesWithErrors) > 0 {
+			logger.Errorf("completed ingestion with error, %v of %v were successful - the following files did not ingest successfully:  %v",
+				totalSuccess, totalNum, strings.Join(filesWithErrors, " "))
+		} else {
+			logger.Infof("completed ingesting %v documents of %v", totalSuccess, totalNum)
+		}
+	},
+}
+
+func validateGitFlags(
+	graphqlEndpoint,
+	headerFile,
+	csubAddr,
+	cloneDir,
+	includes,
+	excludes string,
+	tagHistory,
+	csubTls,
+	csubTlsSkipVerify,
+	queryVulnIngestion,
+	queryLicenseIngestion,
+	queryEOLIngestion,
+	queryDepsDevIngestion bool,
+	args []string,
+) (gitOptions, error) {
+	var opts gitOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.cloneDir = cloneDir
+	opts.includes = splitPatterns(includes)
+	opts.excludes = splitPatterns(excludes)
+	opts.tagHistory = tagHistory
+	opts.queryVulnOnIngestion = queryVulnIngestion
+	opts.queryLicenseOnIngestion = queryLicenseIngestion
+	opts.queryEOLOnIngestion = queryEOLIngestion
+	opts.queryDepsDevOnIngestion = queryDepsDevIngestion
+
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected positional argument for repo_url")
+	}
+	opts.url = args[0]
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+
+	return opts, nil
+}
+
+// splitPatterns splits a comma-separated list of glob patterns.
+func splitPatterns(s string) []string {
+	var patterns []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"git-include", "git-exclude", "git-tag-history", "git-clone-dir"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	gitCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(gitCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	collectCmd.AddCommand(gitCmd)
+}
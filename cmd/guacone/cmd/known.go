@@ -27,6 +27,7 @@ import (
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/cli/output"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
@@ -59,6 +60,7 @@ type queryKnownOptions struct {
 	subjectType string
 	// purl / source (<vcs_tool>+<transport>) / artifact (algorithm:digest)
 	subject string
+	output  output.Format
 }
 
 type neighbors struct {
@@ -96,6 +98,7 @@ var queryKnownCmd = &cobra.Command{
 		opts, err := validateQueryKnownFlags(
 			viper.GetString("gql-addr"),
 			viper.GetString("header-file"),
+			viper.GetString("output"),
 			args,
 		)
 		if err != nil {
@@ -104,15 +107,10 @@ var queryKnownCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)}
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
 		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
-		t := table.NewWriter()
-		tTemp := table.Table{}
-		tTemp.Render()
-		t.AppendHeader(rowHeader)
-
-		var path []string
+		var sections []knownSection
 		switch opts.subjectType {
 		case packageSubjectType:
 			pkgInput, err := helpers.PurlToPkg(opts.subject)
@@ -150,58 +148,28 @@ var queryKnownCmd = &cobra.Command{
 			if err != nil {
 				logger.Fatalf("error querying for package name neighbors: %v", err)
 			}
-			t.SetTitle("Package Name Nodes")
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgNameNeighbors, hasSrcAtStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgNameNeighbors, badLinkStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgNameNeighbors, goodLinkStr, packageSubjectType))
-			t.AppendSeparator()
-
-			path = append([]string{pkgResponse.Packages[0].Namespaces[0].Names[0].Id,
-				pkgResponse.Packages[0].Namespaces[0].Id,
-				pkgResponse.Packages[0].Id}, neighborsPath...)
-
-			fmt.Println(t.Render())
-			fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+			sections = append(sections, knownSection{
+				title: "Package Name Nodes",
+				groups: knownRows(ctx, gqlclient, pkgNameNeighbors, packageSubjectType,
+					hasSrcAtStr, badLinkStr, goodLinkStr),
+				path: append([]string{pkgResponse.Packages[0].Namespaces[0].Names[0].Id,
+					pkgResponse.Packages[0].Namespaces[0].Id,
+					pkgResponse.Packages[0].Id}, neighborsPath...),
+			})
 
 			pkgVersionNeighbors, neighborsPath, err := queryKnownNeighbors(ctx, gqlclient, pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id)
 			if err != nil {
 				logger.Fatalf("error querying for package version neighbors: %v", err)
 			}
-
-			// instantiate new table for package version nodes
-			t := table.NewWriter()
-			tTemp := table.Table{}
-			tTemp.Render()
-			t.AppendHeader(rowHeader)
-
-			t.SetTitle("Package Version Nodes")
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, hasSrcAtStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, occurrenceStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, certifyVulnStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, certifyLegalStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, hasSBOMStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, hasSLSAStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, vexLinkStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, pkgEqualStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, badLinkStr, packageSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, pkgVersionNeighbors, goodLinkStr, packageSubjectType))
-			path = append([]string{pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id,
-				pkgResponse.Packages[0].Namespaces[0].Names[0].Id, pkgResponse.Packages[0].Namespaces[0].Id,
-				pkgResponse.Packages[0].Id}, neighborsPath...)
-
-			fmt.Println(t.Render())
-			fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+			sections = append(sections, knownSection{
+				title: "Package Version Nodes",
+				groups: knownRows(ctx, gqlclient, pkgVersionNeighbors, packageSubjectType,
+					hasSrcAtStr, occurrenceStr, certifyVulnStr, certifyLegalStr, hasSBOMStr, hasSLSAStr,
+					vexLinkStr, pkgEqualStr, badLinkStr, goodLinkStr),
+				path: append([]string{pkgResponse.Packages[0].Namespaces[0].Names[0].Versions[0].Id,
+					pkgResponse.Packages[0].Namespaces[0].Names[0].Id, pkgResponse.Packages[0].Namespaces[0].Id,
+					pkgResponse.Packages[0].Id}, neighborsPath...),
+			})
 
 		case sourceSubjectType:
 			srcInput, err := helpers.VcsToSrc(opts.subject)
@@ -227,20 +195,12 @@ var queryKnownCmd = &cobra.Command{
 			if err != nil {
 				logger.Fatalf("error querying for source neighbors: %v", err)
 			}
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, sourceNeighbors, hasSrcAtStr, sourceSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, sourceNeighbors, occurrenceStr, sourceSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, sourceNeighbors, scorecardStr, sourceSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, sourceNeighbors, badLinkStr, sourceSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, sourceNeighbors, goodLinkStr, sourceSubjectType))
-			path = append([]string{srcResponse.Sources[0].Namespaces[0].Names[0].Id,
-				srcResponse.Sources[0].Namespaces[0].Id, srcResponse.Sources[0].Id}, neighborsPath...)
-
-			fmt.Println(t.Render())
-			fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+			sections = append(sections, knownSection{
+				groups: knownRows(ctx, gqlclient, sourceNeighbors, sourceSubjectType,
+					hasSrcAtStr, occurrenceStr, scorecardStr, badLinkStr, goodLinkStr),
+				path: append([]string{srcResponse.Sources[0].Namespaces[0].Names[0].Id,
+					srcResponse.Sources[0].Namespaces[0].Id, srcResponse.Sources[0].Id}, neighborsPath...),
+			})
 		case artifactSubjectType:
 			split := strings.Split(opts.subject, ":")
 			if len(split) != 2 {
@@ -262,29 +222,65 @@ var queryKnownCmd = &cobra.Command{
 			if err != nil {
 				logger.Fatalf("error querying for artifact neighbors: %v", err)
 			}
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, hashEqualStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, occurrenceStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, hasSBOMStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, hasSLSAStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, vexLinkStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, badLinkStr, artifactSubjectType))
-			t.AppendSeparator()
-			t.AppendRows(getOutputBasedOnNode(ctx, gqlclient, artifactNeighbors, goodLinkStr, artifactSubjectType))
-			path = append([]string{artifactResponse.Artifacts[0].Id}, neighborsPath...)
-
-			fmt.Println(t.Render())
-			fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+			sections = append(sections, knownSection{
+				groups: knownRows(ctx, gqlclient, artifactNeighbors, artifactSubjectType,
+					hashEqualStr, occurrenceStr, hasSBOMStr, hasSLSAStr, vexLinkStr, badLinkStr, goodLinkStr),
+				path: append([]string{artifactResponse.Artifacts[0].Id}, neighborsPath...),
+			})
 		default:
 			logger.Fatalf("expected type to be either a package, source or artifact")
 		}
+
+		if opts.output != output.FormatTable {
+			var path []string
+			var rowsSections []rowsSection
+			for _, section := range sections {
+				path = append(path, section.path...)
+				var rows []table.Row
+				for _, group := range section.groups {
+					rows = append(rows, group...)
+				}
+				rowsSections = append(rowsSections, rowsSection{title: section.title, rows: rows})
+			}
+			writeRowsReport(ctx, gqlclient, opts.output, "query known", opts.subject, path,
+				"No nodes found!", rowsSections...)
+			return
+		}
+
+		for _, section := range sections {
+			t := table.NewWriter()
+			t.AppendHeader(rowHeader)
+			if section.title != "" {
+				t.SetTitle(section.title)
+			}
+			for i, group := range section.groups {
+				if i > 0 {
+					t.AppendSeparator()
+				}
+				t.AppendRows(group)
+			}
+			fmt.Println(t.Render())
+			fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(section.path), `,`))
+		}
 	},
 }
 
+// knownSection is a table of the known command, with a group of rows for
+// each node type.
+type knownSection struct {
+	title  string
+	groups [][]table.Row
+	path   []string
+}
+
+func knownRows(ctx context.Context, gqlclient graphql.Client, collectedNeighbors *neighbors, subjectType string, nodeTypes ...string) [][]table.Row {
+	var groups [][]table.Row
+	for _, nodeType := range nodeTypes {
+		groups = append(groups, getOutputBasedOnNode(ctx, gqlclient, collectedNeighbors, nodeType, subjectType))
+	}
+	return groups
+}
+
 func queryKnownNeighbors(ctx context.Context, gqlclient graphql.Client, subjectQueryID string) (*neighbors, []string, error) {
 	collectedNeighbors := &neighbors{}
 	var path []string
@@ -487,11 +483,17 @@ func getAssociatedArtifact(ctx context.Context, gqlclient graphql.Client, occurr
 	return model.Neighbors(ctx, gqlclient, artifactResponse.Artifacts[0].Id, []model.Edge{edge})
 }
 
-func validateQueryKnownFlags(graphqlEndpoint, headerFile string, args []string) (queryKnownOptions, error) {
+func validateQueryKnownFlags(graphqlEndpoint, headerFile, outputFormat string, args []string) (queryKnownOptions, error) {
 	var opts queryKnownOptions
 	opts.graphqlEndpoint = graphqlEndpoint
 	opts.headerFile = headerFile
 
+	format, err := parseOutputFlag(outputFormat)
+	if err != nil {
+		return opts, err
+	}
+	opts.output = format
+
 	if len(args) != 2 {
 		return opts, fmt.Errorf("expected positional arguments for <type> <subject>")
 	}
@@ -0,0 +1,187 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/oci"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ociLayoutOptions struct {
+	// paths of the OCI image layout directories or tarballs
+	paths []string
+	// gql endpoint
+	graphqlEndpoint string
+	headerFile      string
+	// csub client options for identifier strings
+	csubClientOptions       csub_client.CsubClientOptions
+	queryVulnOnIngestion    bool
+	queryLicenseOnIngestion bool
+	queryEOLOnIngestion     bool
+	queryDepsDevOnIngestion bool
+}
+
+var ociLayoutCmd = &cobra.Command{
+	Use:   "oci-layout [flags] layout_path1 layout_path2...",
+	Short: "takes local OCI image layouts or image tarballs and ingests the sbom and attestation attached to the images into the GUAC graph, this command talks directly to the graphQL endpoint",
+	Long: `
+Takes OCI image layout directories and tarballs, such as the ones written by
+"docker save" (docker 25 or later) or "regctl image export", and ingests the
+sboms and attestations attached to the images without connecting to a registry.
+The documents are found as OCI referrers or with the cosign ".sbom" and ".att"
+tags, and the sboms are linked to the digest of their image.
+
+Examples:
+
+# ingest the sboms and attestations of a saved image
+docker save -o image.tar ghcr.io/guacsec/guac:v1.0.0
+guacone collect oci-layout image.tar`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateOCILayoutFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("csub-addr"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetBool("add-vuln-on-ingest"),
+			viper.GetBool("add-license-on-ingest"),
+			viper.GetBool("add-eol-on-ingest"),
+			viper.GetBool("add-depsdev-on-ingest"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))
+
+		// Register collector
+		ociLayoutCollector := oci.NewOCILayoutCollector(ctx, opts.paths)
+		err = collector.RegisterDocumentCollector(ociLayoutCollector, oci.OCILayoutCollector)
+		if err != nil {
+			logger.Fatalf("unable to register oci layout collector: %v", err)
+		}
+
+		// initialize collectsub client
+		csubClient, err := csub_client.NewClient(opts.csubClientOptions)
+		if err != nil {
+			logger.Infof("collectsub client initialization failed, this ingestion will not pull in any additional data through the collectsub service: %v", err)
+			csubClient = nil
+		} else {
+			defer csubClient.Close()
+		}
+
+		totalNum := 0
+		totalSuccess := 0
+		var filesWithErrors []string
+		// Set emit function to go through the entire pipeline
+		emit := func(d *processor.Document) error {
+			totalNum += 1
+			_, err := ingestor.Ingest(
+				ctx,
+				d,
+				opts.graphqlEndpoint,
+				transport,
+				csubClient,
+				opts.queryVulnOnIngestion,
+				opts.queryLicenseOnIngestion,
+				opts.queryEOLOnIngestion,
+				opts.queryDepsDevOnIngestion,
+			)
+			if err != nil {
+				filesWithErrors = append(filesWithErrors, d.SourceInformation.Source)
+				return fmt.Errorf("unable to ingest document: %w", err)
+			}
+			totalSuccess += 1
+			return nil
+		}
+
+		// Collect
+		errHandler := func(err error) bool {
+			if err == nil {
+				logger.Info("collector ended gracefully")
+				return true
+			}
+			logger.Errorf("collector ended with error: %v", err)
+			return false
+		}
+		if err := collector.Collect(ctx, emit, errHandler); err != nil {
+			logger.Errorf("collector exited with error: %v", err)
+		}
+
+		if len(filesWithErrors) > 0 {
+			logger.Errorf("completed ingestion with error, %v of %v were successful - the following files did not ingest successfully:  %v",
+				totalSuccess, totalNum, strings.Join(filesWithErrors, " "))
+		} else {
+			logger.Infof("completed ingesting %v documents of %v", totalSuccess, totalNum)
+		}
+	},
+}
+
+func validateOCILayoutFlags(
+	graphqlEndpoint,
+	headerFile,
+	csubAddr string,
+	csubTls,
+	csubTlsSkipVerify,
+	queryVulnIngestion,
+	queryLicenseIngestion,
+	queryEOLIngestion,
+	queryDepsDevIngestion bool,
+	args []string,
+) (ociLayoutOptions, error) {
+	var opts ociLayoutOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.queryVulnOnIngestion = queryVulnIngestion
+	opts.queryLicenseOnIngestion = queryLicenseIngestion
+	opts.queryEOLOnIngestion = queryEOLIngestion
+	opts.queryDepsDevOnIngestion = queryDepsDevIngestion
+
+	if len(args) < 1 {
+		return opts, fmt.Errorf("expected positional argument for layout_path")
+	}
+	opts.paths = args
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+
+	return opts, nil
+}
+
+func init() {
+	collectCmd.AddCommand(ociLayoutCmd)
+}
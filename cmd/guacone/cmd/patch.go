@@ -62,7 +62,7 @@ var queryPatchCmd = &cobra.Command{
 			logger.Fatalf("unable to validate flags: %s\n", err)
 		}
 
-		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)}
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
 		gqlClient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
 		var startID string
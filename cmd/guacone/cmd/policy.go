@@ -0,0 +1,199 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/cli/output"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type policyEvalOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	policy          *policy.Policy
+	subject         policy.Subject
+	output          output.Format
+}
+
+var (
+	colTitleRule       = "Rule"
+	colTitleRuleType   = "Type"
+	colTitleResult     = "Result"
+	colTitleViolation  = "Violation"
+	colTitleEvidence   = "Evidence"
+	policyRowHeader    = table.Row{colTitleRule, colTitleRuleType, colTitleResult, colTitleViolation, colTitleEvidence}
+	policyValidSubject = []string{purlType, artifactType}
+)
+
+var policyCmd = &cobra.Command{
+	Use:               "policy",
+	Short:             "Evaluates policies against the graph",
+	PersistentPreRunE: bindCommandFlags,
+}
+
+var policyEvalCmd = &cobra.Command{
+	Use:   "eval [flags] <type> <input>",
+	Short: "evaluate a policy against a package or artifact",
+	Long: `The eval command evaluates the rules of a policy file against a package or artifact and reports, for each
+rule, whether it passed and the IDs of the nodes that caused any violation. The command exits with a non-zero
+status if the policy does not pass.
+
+Positional Arguments:
+  <type>    Specify the input type: 'artifact' or 'purl'
+  <input>   The corresponding input based on the specified type, a purl or algorithm:digest`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validatePolicyEvalFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("policy-file"),
+			viper.GetString("output"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		result, err := policy.Evaluate(ctx, gqlclient, opts.policy, opts.subject)
+		if err != nil {
+			logger.Fatalf("error evaluating policy: %v", err)
+		}
+
+		if opts.output != output.FormatTable {
+			writeReport(ctx, gqlclient, opts.output, policyReport(result))
+			if !result.Pass {
+				os.Exit(1)
+			}
+			return
+		}
+
+		t := table.NewWriter()
+		t.AppendHeader(policyRowHeader)
+		for _, r := range result.Rules {
+			if r.Pass {
+				t.AppendRow(table.Row{r.Rule, r.Type, "pass", "", ""})
+				continue
+			}
+			for _, v := range r.Violations {
+				t.AppendRow(table.Row{r.Rule, r.Type, "fail", v.Message, strings.Join(v.Evidence, ",")})
+			}
+		}
+		fmt.Println(t.Render())
+
+		if !result.Pass {
+			fmt.Printf("Policy %q failed for %s\n", result.Policy, result.Subject)
+			os.Exit(1)
+		}
+		fmt.Printf("Policy %q passed for %s\n", result.Policy, result.Subject)
+	},
+}
+
+// policyReport converts the result of a policy to a report with a finding for
+// each passed rule and for each violation.
+func policyReport(result *policy.Result) *output.Report {
+	section := output.Section{Title: result.Policy, Findings: []output.Finding{}}
+	for _, r := range result.Rules {
+		if r.Pass {
+			section.Findings = append(section.Findings, output.Finding{
+				NodeType: r.Rule,
+				Info:     "type: " + string(r.Type),
+			})
+			continue
+		}
+		for _, v := range r.Violations {
+			section.Findings = append(section.Findings, output.Finding{
+				NodeType: r.Rule,
+				NodeID:   strings.Join(v.Evidence, ","),
+				Info:     "type: " + string(r.Type),
+				Failure:  v.Message,
+			})
+		}
+	}
+	return &output.Report{
+		Command:  "policy eval",
+		Subject:  result.Subject,
+		Sections: []output.Section{section},
+	}
+}
+
+func validatePolicyEvalFlags(graphqlEndpoint, headerFile, policyFile, outputFormat string, args []string) (policyEvalOptions, error) {
+	var opts policyEvalOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+
+	format, err := parseOutputFlag(outputFormat)
+	if err != nil {
+		return opts, err
+	}
+	opts.output = format
+
+	if policyFile == "" {
+		return opts, fmt.Errorf("a policy file must be specified with --policy-file")
+	}
+	p, err := policy.Load(policyFile)
+	if err != nil {
+		return opts, err
+	}
+	opts.policy = p
+
+	if len(args) != 2 {
+		return opts, fmt.Errorf("expected exactly two arguments: <type> and <input>")
+	}
+	switch strings.ToLower(args[0]) {
+	case purlType:
+		opts.subject.Purl = args[1]
+	case artifactType:
+		opts.subject.Digest = args[1]
+	default:
+		return opts, fmt.Errorf("invalid input type %q, valid types are: %v", args[0], policyValidSubject)
+	}
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"policy-file", "output"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	policyEvalCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(policyEvalCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	policyCmd.AddCommand(policyEvalCmd)
+	rootCmd.AddCommand(policyCmd)
+}
@@ -16,14 +16,97 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/cli/output"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var queryCmd = &cobra.Command{
-	Use:   "query",
-	Short: "Runs the query command against GraphQL",
+	Use:               "query",
+	Short:             "Runs the query command against GraphQL",
+	PersistentPreRunE: bindCommandFlags,
+}
+
+// bindCommandFlags binds the flags of the command that runs to viper. The
+// query subcommands share flag names, such as search-depth and output, and
+// binding them in init would leave only the last subcommand bound.
+func bindCommandFlags(cmd *cobra.Command, args []string) error {
+	return viper.BindPFlags(cmd.Flags())
+}
+
+// rowsSection is a titled group of "Node Type", "Node ID #" and "Additional
+// Information" rows, as rendered in the tables of the query commands.
+type rowsSection struct {
+	title string
+	rows  []table.Row
+}
+
+// writeRowsReport writes the rows of a query command to stdout in format f,
+// after looking up the nodes of the rows and the severity of their
+// vulnerabilities. message is reported instead when there are no rows.
+func writeRowsReport(ctx context.Context, gqlclient graphql.Client, f output.Format, command, subject string, path []string, message string, sections ...rowsSection) {
+	logger := logging.FromContext(ctx)
+	report := &output.Report{Command: command, Subject: subject}
+	for _, s := range sections {
+		findings, err := output.FindingsFromRows(ctx, gqlclient, s.rows)
+		if err != nil {
+			logger.Fatalf("error looking up the query results: %v", err)
+		}
+		if len(findings) > 0 {
+			report.Sections = append(report.Sections, output.Section{Title: s.title, Findings: findings})
+		}
+	}
+	if len(report.Sections) == 0 {
+		report.Message = message
+	}
+	if len(path) > 0 {
+		report.VisualizerURL = visualizerURL(path)
+	}
+	writeReport(ctx, gqlclient, f, report)
+}
+
+// writeReport writes report to stdout in format f, after adding the severity
+// of its vulnerabilities.
+func writeReport(ctx context.Context, gqlclient graphql.Client, f output.Format, report *output.Report) {
+	logger := logging.FromContext(ctx)
+	for _, s := range report.Sections {
+		if err := output.AddSeverities(ctx, gqlclient, s.Findings); err != nil {
+			logger.Fatalf("error querying the severity of the vulnerabilities: %v", err)
+		}
+	}
+	if err := output.Write(os.Stdout, f, report); err != nil {
+		logger.Fatalf("error writing the query results: %v", err)
+	}
+}
+
+func visualizerURL(path []string) string {
+	return "http://localhost:3000/?path=" + strings.Join(removeDuplicateValuesFromPath(path), `,`)
+}
+
+func parseOutputFlag(s string) (output.Format, error) {
+	return output.ParseFormat(strings.ToLower(s))
 }
 
 func init() {
+	set, err := cli.BuildFlags([]string{"output"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	queryCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(queryCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
 	rootCmd.AddCommand(queryCmd)
 }
@@ -0,0 +1,260 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/cli/output"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type queryReachableOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	depth           int
+	dependencyType  *model.DependencyType
+	inputType       string
+	searchString    string
+	output          output.Format
+}
+
+var (
+	colTitleVulnID         = "Vulnerability ID"
+	colTitleVulnPkg        = "Vulnerable Package"
+	colTitleDepth          = "Depth"
+	colTitleNotAffected    = "Not Affected (VEX)"
+	colTitleChain          = "Chain"
+	reachableRowHeader     = table.Row{colTitleVulnID, colTitleVulnPkg, colTitleDepth, colTitleNotAffected, colTitleChain}
+	reachableValidArgTypes = []string{purlType, artifactType}
+)
+
+var queryReachableCmd = &cobra.Command{
+	Use:   "reachable [flags] <type> <input>",
+	Short: "query the vulnerabilities reachable from a package or artifact",
+	Long: `The reachable command walks the dependency, occurrence and SBOM edges from a package or artifact and lists every
+vulnerability found along the way, with the shortest chain that reaches it and whether a VEX statement on that chain
+marks it as not affected.
+
+Positional Arguments:
+  <type>    Specify the input type: 'artifact' or 'purl'
+  <input>   The corresponding input based on the specified type, a purl or algorithm:digest`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateQueryReachableFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetInt("search-depth"),
+			viper.GetString("dependency-type"),
+			viper.GetString("output"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		root, err := reachableRoot(opts)
+		if err != nil {
+			logger.Fatalf("%v", err)
+		}
+
+		var maxDepth *int
+		if opts.depth > 0 {
+			maxDepth = &opts.depth
+		}
+		reachableResponse, err := model.FindReachableVulnerabilities(ctx, gqlclient, root, maxDepth, opts.dependencyType)
+		if err != nil {
+			logger.Fatalf("error querying for reachable vulnerabilities: %v", err)
+		}
+
+		if len(reachableResponse.FindReachableVulnerabilities) == 0 {
+			if opts.output != output.FormatTable {
+				writeReport(ctx, gqlclient, opts.output, &output.Report{
+					Command: "query reachable",
+					Subject: opts.searchString,
+					Message: "No reachable vulnerabilities found!",
+				})
+				return
+			}
+			fmt.Println("No reachable vulnerabilities found!")
+			return
+		}
+
+		t := table.NewWriter()
+		t.AppendHeader(reachableRowHeader)
+		var path []string
+		var findings []output.Finding
+		for _, reachable := range reachableResponse.FindReachableVulnerabilities {
+			certifyVuln := reachable.CertifyVuln
+			vulnIDs := []string{}
+			for _, id := range certifyVuln.Vulnerability.VulnerabilityIDs {
+				vulnIDs = append(vulnIDs, id.VulnerabilityID)
+			}
+			notAffected := "no"
+			if reachable.NotAffected {
+				notAffected = "yes"
+			}
+			var chain []string
+			for _, node := range reachable.Path {
+				switch n := node.(type) {
+				case *model.FindReachableVulnerabilitiesFindReachableVulnerabilitiesReachableVulnerabilityPathPackage:
+					chain = append(chain, helpers.AllPkgTreeToPurl(&n.AllPkgTree))
+					path = append(path, n.Namespaces[0].Names[0].Versions[0].Id, n.Namespaces[0].Names[0].Id,
+						n.Namespaces[0].Id, n.Id)
+				case *model.FindReachableVulnerabilitiesFindReachableVulnerabilitiesReachableVulnerabilityPathArtifact:
+					chain = append(chain, n.Algorithm+":"+n.Digest)
+					path = append(path, n.Id)
+				case *model.FindReachableVulnerabilitiesFindReachableVulnerabilitiesReachableVulnerabilityPathIsDependency:
+					path = append(path, n.Id)
+				case *model.FindReachableVulnerabilitiesFindReachableVulnerabilitiesReachableVulnerabilityPathIsOccurrence:
+					path = append(path, n.Id)
+				case *model.FindReachableVulnerabilitiesFindReachableVulnerabilitiesReachableVulnerabilityPathHasSBOM:
+					path = append(path, n.Id)
+				}
+			}
+			vulnPurl := helpers.AllPkgTreeToPurl(&certifyVuln.Package.AllPkgTree)
+			t.AppendRow(table.Row{
+				strings.Join(vulnIDs, ","),
+				vulnPurl,
+				reachable.Depth,
+				notAffected,
+				strings.Join(chain, " -> "),
+			})
+			path = append(path, certifyVuln.Id)
+
+			finding := output.Finding{
+				NodeType: certifyVulnStr,
+				NodeID:   certifyVuln.Id,
+				Info:     fmt.Sprintf("depth: %d, not affected (VEX): %s, chain: %s", reachable.Depth, notAffected, strings.Join(chain, " -> ")),
+				Subject:  vulnPurl,
+			}
+			if len(vulnIDs) > 0 {
+				finding.Vulnerability = vulnIDs[0]
+			}
+			if !reachable.NotAffected {
+				finding.Failure = fmt.Sprintf("%s reaches %s, which is affected by %s", opts.searchString, vulnPurl, strings.Join(vulnIDs, ","))
+			}
+			findings = append(findings, finding)
+		}
+
+		if opts.output != output.FormatTable {
+			writeReport(ctx, gqlclient, opts.output, &output.Report{
+				Command:       "query reachable",
+				Subject:       opts.searchString,
+				Sections:      []output.Section{{Findings: findings}},
+				VisualizerURL: visualizerURL(path),
+			})
+			return
+		}
+		fmt.Println(t.Render())
+		fmt.Printf("Visualizer url: http://localhost:3000/?path=%v\n", strings.Join(removeDuplicateValuesFromPath(path), `,`))
+	},
+}
+
+// reachableRoot converts the input of the reachable command into the root of
+// the query.
+func reachableRoot(opts queryReachableOptions) (model.PackageOrArtifactSpec, error) {
+	switch opts.inputType {
+	case artifactType:
+		split := strings.Split(opts.searchString, ":")
+		if len(split) != 2 {
+			return model.PackageOrArtifactSpec{}, fmt.Errorf("failed to parse artifact. Needs to be in algorithm:digest form")
+		}
+		return model.PackageOrArtifactSpec{
+			Artifact: &model.ArtifactSpec{
+				Algorithm: ptrfrom.String(strings.ToLower(split[0])),
+				Digest:    ptrfrom.String(strings.ToLower(split[1])),
+			},
+		}, nil
+	default:
+		pkgFilter, err := helpers.PurlToPkgFilter(opts.searchString)
+		if err != nil {
+			return model.PackageOrArtifactSpec{}, fmt.Errorf("failed to parse PURL: %w", err)
+		}
+		return model.PackageOrArtifactSpec{Package: &pkgFilter}, nil
+	}
+}
+
+func validateQueryReachableFlags(graphqlEndpoint, headerFile string, depth int, dependencyType, outputFormat string, args []string) (queryReachableOptions, error) {
+	var opts queryReachableOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.depth = depth
+
+	if dependencyType != "" {
+		depType := model.DependencyType(strings.ToUpper(dependencyType))
+		switch depType {
+		case model.DependencyTypeDirect, model.DependencyTypeIndirect, model.DependencyTypeUnknown:
+			opts.dependencyType = &depType
+		default:
+			return opts, fmt.Errorf("invalid dependency type %q, valid types are: direct, indirect, unknown", dependencyType)
+		}
+	}
+
+	format, err := parseOutputFlag(outputFormat)
+	if err != nil {
+		return opts, err
+	}
+	opts.output = format
+
+	if len(args) != 2 {
+		return opts, fmt.Errorf("expected exactly two arguments: <type> and <input>")
+	}
+	typeArg := strings.ToLower(args[0])
+	if !contains(reachableValidArgTypes, typeArg) {
+		return opts, fmt.Errorf("invalid input type %q, valid types are: %v", args[0], reachableValidArgTypes)
+	}
+	if depth < 0 {
+		return opts, fmt.Errorf("search depth must not be negative, got %d", depth)
+	}
+	opts.inputType = typeArg
+	opts.searchString = args[1]
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"search-depth", "dependency-type"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	queryReachableCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(queryReachableCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	queryCmd.AddCommand(queryReachableCmd)
+}
@@ -0,0 +1,193 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/ingestor/ledger"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type retractOptions struct {
+	// gql endpoint
+	graphqlEndpoint string
+	headerFile      string
+	// blob key of the document to retract
+	documentRef string
+	dryRun      bool
+	// ledger of the ingested documents, empty disables it
+	ledgerAddr string
+}
+
+var retractCmd = &cobra.Command{
+	Use:   "retract [flags] <document-key>",
+	Short: "Retract a document, removing all the evidence ingested from it.",
+	Long: `Retract a document that should not have been ingested, such as a bad SBOM.
+  <document-key> is the blob store key of the document, which is the documentRef of the evidence ingested from it.
+All the evidence with that documentRef is removed, along with the Document node and any packages, sources,
+artifacts, builders, vulnerabilities and licenses that no other evidence refers to.
+The document is also removed from the ledger at --ledger-addr, so that it is ingested again if it is collected again.
+Use --dry-run to list what would be removed first.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateRetractFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetBool("dry-run"),
+			viper.GetString("ledger-addr"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		resp, err := model.RetractDocument(ctx, gqlclient, opts.documentRef, opts.dryRun)
+		if err != nil {
+			logger.Fatalf("error retracting document %s: %v", opts.documentRef, err)
+		}
+		result := resp.RetractDocument
+
+		if opts.ledgerAddr != "" && !result.DryRun {
+			if err := forgetDocument(ctx, opts.ledgerAddr, opts.documentRef); err != nil {
+				logger.Fatalf("error removing document %s from the ledger: %v", opts.documentRef, err)
+			}
+		}
+
+		if result.Document == nil && len(result.Evidence) == 0 {
+			fmt.Printf("No document or evidence found for %s\n", opts.documentRef)
+			return
+		}
+
+		t := table.NewWriter()
+		t.AppendHeader(rowHeader)
+		if result.DryRun {
+			t.SetTitle("Would remove (dry run)")
+		} else {
+			t.SetTitle("Removed")
+		}
+		if result.Document != nil {
+			t.AppendRow(table.Row{"Document", result.Document.Id, "source: " + result.Document.Source})
+			t.AppendSeparator()
+		}
+		for _, e := range result.Evidence {
+			t.AppendRow(retractEvidenceRow(e))
+		}
+		if len(result.Orphans) > 0 {
+			t.AppendSeparator()
+		}
+		for _, o := range result.Orphans {
+			t.AppendRow(retractOrphanRow(o))
+		}
+		fmt.Println(t.Render())
+	},
+}
+
+func validateRetractFlags(graphqlEndpoint, headerFile string, dryRun bool, ledgerAddr string, args []string) (retractOptions, error) {
+	var opts retractOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.dryRun = dryRun
+	opts.ledgerAddr = ledgerAddr
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected positional argument for <document-key>")
+	}
+	opts.documentRef = args[0]
+	if opts.documentRef == "" {
+		return opts, fmt.Errorf("document-key cannot be an empty string")
+	}
+	return opts, nil
+}
+
+// forgetDocument removes the document from the ledger, so that it is not
+// skipped as already ingested when it is collected again.
+func forgetDocument(ctx context.Context, ledgerAddr, documentRef string) error {
+	l, err := ledger.Open(ctx, ledgerAddr)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	return l.Delete(ctx, documentRef)
+}
+
+func retractEvidenceRow(e model.RetractDocumentRetractDocumentRetractDocumentResultEvidenceNode) table.Row {
+	id := ""
+	if n, ok := e.(interface{ GetId() string }); ok {
+		id = n.GetId()
+	}
+	return table.Row{*e.GetTypename(), id, "evidence"}
+}
+
+func retractOrphanRow(o model.RetractDocumentRetractDocumentRetractDocumentResultOrphansNode) table.Row {
+	switch v := o.(type) {
+	case *model.RetractDocumentRetractDocumentRetractDocumentResultOrphansPackage:
+		name := v.Namespaces[0].Names[0]
+		id := name.Id
+		if len(name.Versions) > 0 {
+			id = name.Versions[0].Id
+		}
+		return table.Row{*v.Typename, id, helpers.AllPkgTreeToPurl(&v.AllPkgTree)}
+	case *model.RetractDocumentRetractDocumentRetractDocumentResultOrphansSource:
+		return table.Row{*v.Typename, v.Namespaces[0].Names[0].Id, v.Type + "+" + v.Namespaces[0].Namespace + "/" + v.Namespaces[0].Names[0].Name}
+	case *model.RetractDocumentRetractDocumentRetractDocumentResultOrphansArtifact:
+		return table.Row{*v.Typename, v.Id, v.Algorithm + ":" + v.Digest}
+	case *model.RetractDocumentRetractDocumentRetractDocumentResultOrphansBuilder:
+		return table.Row{*v.Typename, v.Id, v.Uri}
+	case *model.RetractDocumentRetractDocumentRetractDocumentResultOrphansVulnerability:
+		id := v.Id
+		info := v.Type
+		if len(v.VulnerabilityIDs) > 0 {
+			id = v.VulnerabilityIDs[0].Id
+			info = v.VulnerabilityIDs[0].VulnerabilityID
+		}
+		return table.Row{*v.Typename, id, info}
+	case *model.RetractDocumentRetractDocumentRetractDocumentResultOrphansLicense:
+		return table.Row{*v.Typename, v.Id, v.Name}
+	}
+	return table.Row{*o.GetTypename(), "", ""}
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"dry-run", "ledger-addr"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	retractCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(retractCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	rootCmd.AddCommand(retractCmd)
+}
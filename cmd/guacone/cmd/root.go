@@ -30,9 +30,9 @@ import (
 func init() {
 	cobra.OnInitialize(cli.InitConfig)
 
-	set, err := cli.BuildFlags([]string{"gql-addr", "header-file", "csub-addr", "csub-tls",
+	set, err := cli.BuildFlags(append([]string{"gql-addr", "header-file", "csub-addr", "csub-tls",
 		"csub-tls-skip-verify", "add-vuln-on-ingest", "add-license-on-ingest",
-		"add-eol-on-ingest", "add-depsdev-on-ingest"})
+		"add-eol-on-ingest", "add-depsdev-on-ingest"}, cli.AuthFlags...))
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
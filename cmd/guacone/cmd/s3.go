@@ -109,7 +109,7 @@ $ guacone collect s3 --s3-url http://localhost:9000 --s3-bucket guac-test --poll
 
 		ctx := logging.WithLogger(context.Background())
 		logger := logging.FromContext(ctx)
-		transport := cli.HTTPHeaderTransport(ctx, s3Opts.headerFile, http.DefaultTransport)
+		transport := cli.HTTPHeaderTransport(ctx, s3Opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))
 
 		signals := make(chan os.Signal, 1)
 		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
@@ -26,6 +26,7 @@ import (
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/cli/output"
 	"github.com/guacsec/guac/pkg/guacanalytics"
 	"github.com/guacsec/guac/pkg/logging"
 
@@ -52,6 +53,7 @@ type queryOptions struct {
 	depth           int
 	pathsToReturn   int
 	inputType       string
+	output          output.Format
 }
 
 var queryVulnCmd = &cobra.Command{
@@ -79,6 +81,7 @@ Positional Arguments:
 			viper.GetString("vuln-id"),
 			viper.GetInt("search-depth"),
 			viper.GetInt("num-path"),
+			viper.GetString("output"),
 			args,
 		)
 		if err != nil {
@@ -87,7 +90,7 @@ Positional Arguments:
 			os.Exit(1)
 		}
 
-		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)}
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
 		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
 		t := table.NewWriter()
@@ -180,6 +183,12 @@ func printVulnInfo(ctx context.Context, gqlclient graphql.Client, t table.Writer
 	paths = append(paths, depVulnPaths...)
 	tableRows = append(tableRows, depVulnTableRows...)
 
+	if opts.output != output.FormatTable {
+		writeRowsReport(ctx, gqlclient, opts.output, "query vuln", opts.searchString, paths,
+			"No path to vulnerabilities found!", rowsSection{rows: tableRows})
+		return
+	}
+
 	if len(paths) > 0 {
 		t.AppendRows(tableRows)
 		fmt.Println(t.Render())
@@ -277,6 +286,11 @@ func printVulnInfoByVulnID(ctx context.Context, gqlclient graphql.Client, t tabl
 	}
 
 	if len(vulnResponse.Vulnerabilities) == 0 {
+		if opts.output != output.FormatTable {
+			writeRowsReport(ctx, gqlclient, opts.output, "query vuln", opts.searchString, nil,
+				"Failed to identify vulnerability "+opts.vulnerabilityID)
+			return
+		}
 		fmt.Printf("Failed to identify vulnerability. Please ensure certifier has run by running guacone certifier osv\n")
 		return
 	}
@@ -337,6 +351,12 @@ func printVulnInfoByVulnID(ctx context.Context, gqlclient graphql.Client, t tabl
 		}
 	}
 
+	if opts.output != output.FormatTable {
+		writeRowsReport(ctx, gqlclient, opts.output, "query vuln", opts.searchString, path,
+			"No path to vulnerability ID found!", rowsSection{rows: tableRows})
+		return
+	}
+
 	if len(path) > 0 {
 		t.AppendRows(tableRows)
 		fmt.Println(t.Render())
@@ -542,7 +562,7 @@ func removeDuplicateValuesFromPath(path []string) []string {
 	return list
 }
 
-func validateQueryVulnFlags(graphqlEndpoint, headerFile, vulnID string, depth, path int, args []string) (queryOptions, error) {
+func validateQueryVulnFlags(graphqlEndpoint, headerFile, vulnID string, depth, path int, outputFormat string, args []string) (queryOptions, error) {
 	var opts queryOptions
 	opts.graphqlEndpoint = graphqlEndpoint
 	opts.headerFile = headerFile
@@ -550,6 +570,12 @@ func validateQueryVulnFlags(graphqlEndpoint, headerFile, vulnID string, depth, p
 	opts.depth = depth
 	opts.pathsToReturn = path
 
+	format, err := parseOutputFlag(outputFormat)
+	if err != nil {
+		return opts, err
+	}
+	opts.output = format
+
 	if len(args) > 0 {
 		validTypes := []string{artifactType, uriType, purlType}
 
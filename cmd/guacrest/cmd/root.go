@@ -37,6 +37,7 @@ var flags = struct {
 
 	tlsCertFile string
 	tlsKeyFile  string
+	authConfig  string
 
 	dbDirectConnection bool
 	dbDriver           string
@@ -58,6 +59,7 @@ var rootCmd = &cobra.Command{
 		flags.headerFile = viper.GetString("header-file")
 		flags.tlsCertFile = viper.GetString("rest-api-tls-cert-file")
 		flags.tlsKeyFile = viper.GetString("rest-api-tls-key-file")
+		flags.authConfig = viper.GetString("rest-api-auth-config")
 
 		flags.dbDriver = viper.GetString("db-driver")
 		flags.dbAddress = viper.GetString("db-address")
@@ -76,16 +78,17 @@ func Execute() {
 
 func init() {
 	cobra.OnInitialize(cli.InitConfig)
-	set, err := cli.BuildFlags([]string{
+	set, err := cli.BuildFlags(append([]string{
 		"gql-addr",
 		"header-file",
 		"rest-api-server-port",
 		"rest-api-tls-cert-file",
 		"rest-api-tls-key-file",
+		"rest-api-auth-config",
 
 		// configuration of direct database connection
 		"db-direct-connection",
-	})
+	}, cli.AuthFlags...))
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flags: %v", err)
 		os.Exit(1)
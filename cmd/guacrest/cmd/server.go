@@ -17,6 +17,7 @@ package cmd
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"os"
@@ -29,6 +30,7 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/backend"
+	"github.com/guacsec/guac/pkg/auth"
 	"github.com/guacsec/guac/pkg/cli"
 	gen "github.com/guacsec/guac/pkg/guacrest/generated"
 	"github.com/guacsec/guac/pkg/guacrest/server"
@@ -39,22 +41,52 @@ func startServer() {
 	ctx := logging.WithLogger(context.Background())
 	logger := logging.FromContext(ctx)
 
-	httpClient := &http.Client{Transport: cli.HTTPHeaderTransport(ctx, flags.headerFile, http.DefaultTransport)}
+	httpClient := &http.Client{Transport: cli.HTTPHeaderTransport(ctx, flags.headerFile, cli.HTTPAuthTransport(ctx, http.DefaultTransport))}
 	gqlClient := getGraphqlServerClientOrExit(ctx, httpClient)
 
 	restApiHandler := gen.Handler(gen.NewStrictHandler(getRestApiHandlerOrExit(ctx, gqlClient), nil))
 
+	proto := "http"
+	if flags.tlsCertFile != "" && flags.tlsKeyFile != "" {
+		proto = "https"
+	}
+
 	router := chi.NewRouter()
 	router.Use(server.AddLoggerToCtxMiddleware, server.LogRequestsMiddleware)
+	var clientTLS *tls.Config
+	if flags.authConfig != "" {
+		authConfig, err := auth.LoadConfig(flags.authConfig)
+		if err != nil {
+			logger.Fatalf("error setting up authentication: %v", err)
+		}
+		// the REST API queries the graph with its own credentials, so it
+		// cannot keep the data of the tenants of its callers apart
+		if authConfig.HasTenants() {
+			logger.Fatalf("rest-api-auth-config assigns tenants to callers, which the REST API does not support: " +
+				"run one REST API per tenant, with the GraphQL server credentials of that tenant")
+		}
+		authenticator, err := auth.NewAuthenticator(authConfig)
+		if err != nil {
+			logger.Fatalf("error setting up authentication: %v", err)
+		}
+		clientTLS, err = authConfig.ServerTLSConfig()
+		if err != nil {
+			logger.Fatalf("error setting up authentication: %v", err)
+		}
+		if proto != "https" {
+			if clientTLS != nil {
+				logger.Fatalf("mtls authentication requires rest-api-tls-cert-file and rest-api-tls-key-file to be set")
+			}
+			logger.Warnf("authentication is enabled without TLS, credentials are sent in clear text unless TLS is terminated by a proxy")
+		}
+		// all the endpoints are queries, so they only need the read role
+		router.Use(auth.Middleware(authenticator, "/healthz"), auth.RequireRole(auth.RoleRead, "/healthz"))
+	}
 	router.Mount("/", restApiHandler)
 	server := http.Server{
-		Addr:    fmt.Sprintf(":%d", flags.restAPIServerPort),
-		Handler: router,
-	}
-
-	proto := "http"
-	if flags.tlsCertFile != "" && flags.tlsKeyFile != "" {
-		proto = "https"
+		Addr:      fmt.Sprintf(":%d", flags.restAPIServerPort),
+		Handler:   router,
+		TLSConfig: clientTLS,
 	}
 
 	logger.Infof("connect to the server at %s://0.0.0.0:%d/", proto, flags.restAPIServerPort)
@@ -369,6 +369,50 @@ func TestCertifyBad(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "Query on AsOf",
+			InPkg: []*model.PkgInputSpec{testdata.P1},
+			Calls: []call{
+				{
+					Sub: model.PackageSourceOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					CB: &model.CertifyBadInputSpec{
+						Justification: "test justification one",
+						KnownSince:    curTime,
+						Origin:        "asOf origin",
+					},
+				},
+				{
+					Sub: model.PackageSourceOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					CB: &model.CertifyBadInputSpec{
+						Justification: "test justification two",
+						KnownSince:    timeAfterOneSecond,
+						Origin:        "asOf origin",
+					},
+				},
+			},
+			Query: &model.CertifyBadSpec{
+				AsOf:   ptrfrom.Time(curTime),
+				Origin: ptrfrom.String("asOf origin"),
+			},
+			ExpCB: []*model.CertifyBad{
+				{
+					Subject:       testdata.P1out,
+					Justification: "test justification one",
+					KnownSince:    curTime,
+					Origin:        "asOf origin",
+				},
+			},
+		},
 		{
 			Name:  "Query on Package",
 			InPkg: []*model.PkgInputSpec{testdata.P4},
@@ -1186,3 +1230,48 @@ func TestIngestCertifyBads(t *testing.T) {
 		})
 	}
 }
+
+func TestCertifyBadNoMatch(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	sub := model.PackageSourceOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}}
+	match := &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	if _, err := b.IngestCertifyBad(ctx, sub, match, model.CertifyBadInputSpec{Justification: "test justification"}); err != nil {
+		t.Fatalf("Could not ingest certifyBad: %v", err)
+	}
+	// without a subject the filter is checked against every certifyBad
+	got, err := b.CertifyBad(ctx, &model.CertifyBadSpec{Justification: ptrfrom.String("other justification")})
+	if err != nil {
+		t.Fatalf("CertifyBad() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("CertifyBad() = %v, want no results", got)
+	}
+}
+
+func TestCertifyBadIDAndFilter(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	sub := model.PackageSourceOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}}
+	match := &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	id, err := b.IngestCertifyBad(ctx, sub, match, model.CertifyBadInputSpec{Justification: "test justification"})
+	if err != nil {
+		t.Fatalf("Could not ingest certifyBad: %v", err)
+	}
+	// the subscriptions query each ingested node by ID with their filter
+	for justification, want := range map[string]int{"test justification": 1, "other justification": 0} {
+		got, err := b.CertifyBad(ctx, &model.CertifyBadSpec{ID: &id, Justification: &justification})
+		if err != nil {
+			t.Fatalf("CertifyBad() error = %v", err)
+		}
+		if len(got) != want {
+			t.Errorf("CertifyBad() with justification %q = %v, want %d results", justification, got, want)
+		}
+	}
+}
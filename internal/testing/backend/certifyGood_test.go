@@ -225,6 +225,50 @@ func TestCertifyGood(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "Query on AsOf",
+			InPkg: []*model.PkgInputSpec{testdata.P1},
+			Calls: []call{
+				{
+					Sub: model.PackageSourceOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					CG: &model.CertifyGoodInputSpec{
+						Justification: "test justification one",
+						KnownSince:    curTime,
+						Origin:        "asOf origin",
+					},
+				},
+				{
+					Sub: model.PackageSourceOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					CG: &model.CertifyGoodInputSpec{
+						Justification: "test justification two",
+						KnownSince:    timeAfterOneSecond,
+						Origin:        "asOf origin",
+					},
+				},
+			},
+			Query: &model.CertifyGoodSpec{
+				AsOf:   ptrfrom.Time(curTime),
+				Origin: ptrfrom.String("asOf origin"),
+			},
+			ExpCG: []*model.CertifyGood{
+				{
+					Subject:       testdata.P1out,
+					Justification: "test justification one",
+					KnownSince:    curTime,
+					Origin:        "asOf origin",
+				},
+			},
+		},
 		{
 			Name:  "Query on Package",
 			InPkg: []*model.PkgInputSpec{testdata.P1, testdata.P2},
@@ -1031,3 +1075,24 @@ func TestIngestCertifyGoods(t *testing.T) {
 		})
 	}
 }
+
+func TestCertifyGoodNoMatch(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	sub := model.PackageSourceOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}}
+	match := &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	if _, err := b.IngestCertifyGood(ctx, sub, match, model.CertifyGoodInputSpec{Justification: "test justification"}); err != nil {
+		t.Fatalf("Could not ingest certifyGood: %v", err)
+	}
+	// without a subject the filter is checked against every certifyGood
+	got, err := b.CertifyGood(ctx, &model.CertifyGoodSpec{Justification: ptrfrom.String("other justification")})
+	if err != nil {
+		t.Fatalf("CertifyGood() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("CertifyGood() = %v, want no results", got)
+	}
+}
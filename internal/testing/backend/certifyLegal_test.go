@@ -440,6 +440,41 @@ func TestLegal(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "Query on AsOf",
+			InPkg: []*model.PkgInputSpec{testdata.P1},
+			Calls: []call{
+				{
+					PkgSrc: model.PackageOrSourceInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Legal: &model.CertifyLegalInputSpec{
+						TimeScanned: testdata.T3,
+						Origin:      "asOf origin",
+					},
+				},
+				{
+					PkgSrc: model.PackageOrSourceInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Legal: &model.CertifyLegalInputSpec{
+						TimeScanned: testdata.T2,
+						Origin:      "asOf origin",
+					},
+				},
+			},
+			Query: &model.CertifyLegalSpec{
+				AsOf:   &testdata.T2,
+				Origin: ptrfrom.String("asOf origin"),
+			},
+			ExpLegal: []*model.CertifyLegal{
+				{
+					Subject:     testdata.P1out,
+					TimeScanned: testdata.T2,
+					Origin:      "asOf origin",
+				},
+			},
+		},
 		{
 			Name:  "Query multiple",
 			InPkg: []*model.PkgInputSpec{testdata.P1, testdata.P2, testdata.P3},
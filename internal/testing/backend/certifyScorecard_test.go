@@ -502,6 +502,49 @@ func TestCertifyScorecard(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "Query AsOf",
+			InSrc: []*model.SourceInputSpec{testdata.S1},
+			Calls: []call{
+				{
+					Src: testdata.S1,
+					SC: &model.ScorecardInputSpec{
+						AggregateScore:   1.5,
+						TimeScanned:      time.Unix(1e9, 0),
+						Origin:           "asOf origin",
+						ScorecardVersion: "123",
+						ScorecardCommit:  "abc",
+					},
+				},
+				{
+					Src: testdata.S1,
+					SC: &model.ScorecardInputSpec{
+						AggregateScore:   1.5,
+						TimeScanned:      testTime,
+						Origin:           "asOf origin",
+						ScorecardVersion: "123",
+						ScorecardCommit:  "abc",
+					},
+				},
+			},
+			Query: &model.CertifyScorecardSpec{
+				AsOf:   &testTime2,
+				Origin: ptrfrom.String("asOf origin"),
+			},
+			ExpSC: []*model.CertifyScorecard{
+				{
+					Source: testdata.S1out,
+					Scorecard: &model.Scorecard{
+						Checks:           []*model.ScorecardCheck{},
+						AggregateScore:   1.5,
+						TimeScanned:      testTime2,
+						Origin:           "asOf origin",
+						ScorecardVersion: "123",
+						ScorecardCommit:  "abc",
+					},
+				},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
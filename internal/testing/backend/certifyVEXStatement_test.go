@@ -697,6 +697,51 @@ func TestVEX(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:   "Query on AsOf",
+			InPkg:  []*model.PkgInputSpec{testdata.P1},
+			InVuln: []*model.VulnerabilityInputSpec{testdata.O1},
+			Calls: []call{
+				{
+					Sub: model.PackageOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Vuln: testdata.O1,
+					In: &model.VexStatementInputSpec{
+						VexJustification: "test justification",
+						KnownSince:       time.Unix(1e9, 0),
+						Origin:           "asOf origin",
+					},
+				},
+				{
+					Sub: model.PackageOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Vuln: testdata.O1,
+					In: &model.VexStatementInputSpec{
+						VexJustification: "test justification",
+						KnownSince:       testTime,
+						Origin:           "asOf origin",
+					},
+				},
+			},
+			Query: &model.CertifyVEXStatementSpec{
+				AsOf:   &testTime2,
+				Origin: ptrfrom.String("asOf origin"),
+			},
+			ExpVEX: []*model.CertifyVEXStatement{
+				{
+					Subject: testdata.P1out,
+					Vulnerability: &model.Vulnerability{
+						Type:             "osv",
+						VulnerabilityIDs: []*model.VulnerabilityID{testdata.O1out},
+					},
+					VexJustification: "test justification",
+					KnownSince:       testTime2,
+					Origin:           "asOf origin",
+				},
+			},
+		},
 		{
 			Name:   "Query on ID",
 			InPkg:  []*model.PkgInputSpec{testdata.P1},
@@ -948,6 +948,61 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:   "Query AsOf",
+			InVuln: []*model.VulnerabilityInputSpec{testdata.G1},
+			InPkg:  []*model.IDorPkgInput{{PackageInput: testdata.P2}},
+			Calls: []call{
+				{
+					Pkg:  testdata.P2,
+					Vuln: testdata.G1,
+					CertifyVuln: &model.ScanMetadataInput{
+						Collector:      "test collector",
+						Origin:         "asOf origin",
+						ScannerVersion: "v1.0.0",
+						ScannerURI:     "test scanner uri",
+						DbVersion:      "2023.08.01",
+						DbURI:          "test db uri",
+						TimeScanned:    testTime,
+					},
+				},
+				{
+					Pkg:  testdata.P2,
+					Vuln: testdata.G1,
+					CertifyVuln: &model.ScanMetadataInput{
+						Collector:      "test collector",
+						Origin:         "asOf origin",
+						ScannerVersion: "v1.0.0",
+						ScannerURI:     "test scanner uri",
+						DbVersion:      "2023.07.01",
+						DbURI:          "test db uri",
+						TimeScanned:    testTime2,
+					},
+				},
+			},
+			Query: &model.CertifyVulnSpec{
+				AsOf:   ptrfrom.Time(testTime2),
+				Origin: ptrfrom.String("asOf origin"),
+			},
+			ExpVuln: []*model.CertifyVuln{
+				{
+					Package: testdata.P2out,
+					Vulnerability: &model.Vulnerability{
+						Type:             "ghsa",
+						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
+					},
+					Metadata: &model.ScanMetadata{
+						Collector:      "test collector",
+						Origin:         "asOf origin",
+						ScannerVersion: "v1.0.0",
+						ScannerURI:     "test scanner uri",
+						DbVersion:      "2023.07.01",
+						DbURI:          "test db uri",
+						TimeScanned:    testTime2,
+					},
+				},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
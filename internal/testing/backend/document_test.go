@@ -0,0 +1,267 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var (
+	d1 = &model.DocumentInputSpec{
+		BlobKey:       "sha256_a1",
+		Sha256:        "a1",
+		DocumentType:  "SPDX",
+		Format:        "JSON",
+		Collector:     "FileCollector",
+		Source:        "file:///sbom.spdx.json",
+		ParserVersion: "v1.0.0",
+		IngestedAt:    time.Unix(1e9, 0).UTC(),
+	}
+	d1out = &model.Document{
+		BlobKey:       "sha256_a1",
+		Sha256:        "a1",
+		DocumentType:  "SPDX",
+		Format:        "JSON",
+		Collector:     "FileCollector",
+		Source:        "file:///sbom.spdx.json",
+		ParserVersion: "v1.0.0",
+		IngestedAt:    time.Unix(1e9, 0).UTC(),
+	}
+	d2 = &model.DocumentInputSpec{
+		BlobKey:       "sha256_b2",
+		Sha256:        "b2",
+		DocumentType:  "ITE6SLSA",
+		Format:        "JSON",
+		Collector:     "GithubCollector",
+		Source:        "https://github.com/guacsec/guac",
+		ParserVersion: "v1.0.0",
+		IngestedAt:    time.Unix(2e9, 0).UTC(),
+	}
+	d2out = &model.Document{
+		BlobKey:       "sha256_b2",
+		Sha256:        "b2",
+		DocumentType:  "ITE6SLSA",
+		Format:        "JSON",
+		Collector:     "GithubCollector",
+		Source:        "https://github.com/guacsec/guac",
+		ParserVersion: "v1.0.0",
+		IngestedAt:    time.Unix(2e9, 0).UTC(),
+	}
+)
+
+func lessDocument(a, b *model.Document) bool {
+	return a.BlobKey < b.BlobKey
+}
+
+func TestDocuments(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	tests := []struct {
+		Name        string
+		Ingests     []*model.DocumentInputSpec
+		IDInFilter  int
+		Query       *model.DocumentSpec
+		Exp         []*model.Document
+		ExpQueryErr bool
+	}{
+		{
+			Name:    "HappyPath",
+			Ingests: []*model.DocumentInputSpec{d1},
+			Query:   &model.DocumentSpec{},
+			Exp:     []*model.Document{d1out},
+		},
+		{
+			Name: "Duplicates keep the first ingestion",
+			Ingests: []*model.DocumentInputSpec{d1, {
+				BlobKey:       d1.BlobKey,
+				Sha256:        d1.Sha256,
+				DocumentType:  d1.DocumentType,
+				Format:        d1.Format,
+				Collector:     d1.Collector,
+				Source:        d1.Source,
+				ParserVersion: "v2.0.0",
+				IngestedAt:    time.Unix(3e9, 0).UTC(),
+			}},
+			Query: &model.DocumentSpec{},
+			Exp:   []*model.Document{d1out},
+		},
+		{
+			Name:       "Query by ID",
+			Ingests:    []*model.DocumentInputSpec{d1, d2},
+			IDInFilter: 2,
+			Query:      &model.DocumentSpec{},
+			Exp:        []*model.Document{d2out},
+		},
+		{
+			Name:    "Query by blob key",
+			Ingests: []*model.DocumentInputSpec{d1, d2},
+			Query: &model.DocumentSpec{
+				BlobKey: ptrfrom.String("sha256_b2"),
+			},
+			Exp: []*model.Document{d2out},
+		},
+		{
+			Name:    "Query by collector and type",
+			Ingests: []*model.DocumentInputSpec{d1, d2},
+			Query: &model.DocumentSpec{
+				Collector:    ptrfrom.String("FileCollector"),
+				DocumentType: ptrfrom.String("SPDX"),
+			},
+			Exp: []*model.Document{d1out},
+		},
+		{
+			Name:    "Query none",
+			Ingests: []*model.DocumentInputSpec{d1, d2},
+			Query: &model.DocumentSpec{
+				Sha256: ptrfrom.String("c3"),
+			},
+			Exp: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			for i, ingest := range tt.Ingests {
+				id, err := b.IngestDocument(ctx, *ingest)
+				if err != nil {
+					t.Fatalf("Could not ingest document: %v", err)
+				}
+				if (i + 1) == tt.IDInFilter {
+					tt.Query.ID = ptrfrom.String(id)
+				}
+			}
+			got, err := b.DocumentList(ctx, *tt.Query, nil, nil)
+			if (err != nil) != tt.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", tt.ExpQueryErr, err)
+			}
+			if err != nil {
+				return
+			}
+			var returnedObjects []*model.Document
+			if got != nil {
+				for _, obj := range got.Edges {
+					returnedObjects = append(returnedObjects, obj.Node)
+				}
+			}
+			if diff := cmp.Diff(tt.Exp, returnedObjects, commonOpts, cmpopts.SortSlices(lessDocument)); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIngestDocuments(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	ids, err := b.IngestDocuments(ctx, []*model.DocumentInputSpec{d1, d2, d1})
+	if err != nil {
+		t.Fatalf("Could not ingest documents: %v", err)
+	}
+	if len(ids) != 3 {
+		t.Fatalf("expected 3 ids, got %d", len(ids))
+	}
+	if ids[0] != ids[2] {
+		t.Errorf("expected duplicate documents to have the same id, got %q and %q", ids[0], ids[2])
+	}
+	got, err := b.Documents(ctx, &model.DocumentSpec{})
+	if err != nil {
+		t.Fatalf("Could not query documents: %v", err)
+	}
+	if diff := cmp.Diff([]*model.Document{d1out, d2out}, got, commonOpts, cmpopts.SortSlices(lessDocument)); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+}
+
+func TestDocumentNeighbors(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	subject := model.PackageSourceOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: testdata.A1}}
+	// evidence may be ingested before the document it was parsed from
+	linkedID, err := b.IngestCertifyBad(ctx, subject, nil, model.CertifyBadInputSpec{
+		Justification: "linked",
+		DocumentRef:   d1.BlobKey,
+	})
+	if err != nil {
+		t.Fatalf("Could not ingest certifyBad: %v", err)
+	}
+	if _, err := b.IngestCertifyBad(ctx, subject, nil, model.CertifyBadInputSpec{
+		Justification: "other",
+		DocumentRef:   d2.BlobKey,
+	}); err != nil {
+		t.Fatalf("Could not ingest certifyBad: %v", err)
+	}
+	docID, err := b.IngestDocument(ctx, *d1)
+	if err != nil {
+		t.Fatalf("Could not ingest document: %v", err)
+	}
+
+	fromDoc, err := b.Neighbors(ctx, docID, []model.Edge{model.EdgeDocumentCertifyBad})
+	if err != nil {
+		t.Fatalf("Neighbors() error = %v", err)
+	}
+	if len(fromDoc) != 1 || fromDoc[0].(*model.CertifyBad).Justification != "linked" {
+		t.Errorf("expected the linked certifyBad as the only document neighbor, got %+v", fromDoc)
+	}
+
+	fromEvidence, err := b.Neighbors(ctx, linkedID, []model.Edge{model.EdgeCertifyBadDocument})
+	if err != nil {
+		t.Fatalf("Neighbors() error = %v", err)
+	}
+	if diff := cmp.Diff([]model.Node{d1out}, fromEvidence, commonOpts); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+
+	filtered, err := b.Neighbors(ctx, linkedID, []model.Edge{model.EdgeCertifyBadArtifact})
+	if err != nil {
+		t.Fatalf("Neighbors() error = %v", err)
+	}
+	for _, n := range filtered {
+		if _, ok := n.(*model.Document); ok {
+			t.Errorf("document returned when CERTIFY_BAD_DOCUMENT was not allowed")
+		}
+	}
+
+	// the document is only returned when its edge is asked for, while the
+	// evidence of the document is returned by default
+	unfiltered, err := b.Neighbors(ctx, linkedID, nil)
+	if err != nil {
+		t.Fatalf("Neighbors() error = %v", err)
+	}
+	for _, n := range unfiltered {
+		if _, ok := n.(*model.Document); ok {
+			t.Errorf("document returned without CERTIFY_BAD_DOCUMENT in usingOnly")
+		}
+	}
+	fromDoc, err = b.Neighbors(ctx, docID, nil)
+	if err != nil {
+		t.Fatalf("Neighbors() error = %v", err)
+	}
+	if len(fromDoc) != 1 {
+		t.Errorf("expected the linked certifyBad as the only document neighbor, got %+v", fromDoc)
+	}
+}
@@ -0,0 +1,72 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	entbackend "github.com/guacsec/guac/pkg/assembler/backends/ent/backend"
+	"github.com/segmentio/ksuid"
+)
+
+// entSQLiteBE runs the ent backend on sqlite, with a new database file for
+// each test.
+type entSQLiteBE struct {
+	be  backends.Backend
+	dir string
+}
+
+func newEntSQLite() backend {
+	return &entSQLiteBE{}
+}
+
+func (m *entSQLiteBE) Setup() error {
+	dir, err := os.MkdirTemp("", "guac-ent-sqlite")
+	if err != nil {
+		return err
+	}
+	m.dir = dir
+	return m.setupNewDB()
+}
+
+func (m *entSQLiteBE) Get() backends.Backend {
+	return m.be
+}
+
+func (m *entSQLiteBE) Clear() error {
+	return m.setupNewDB()
+}
+
+func (m *entSQLiteBE) Cleanup() {
+	os.RemoveAll(m.dir)
+}
+
+func (m *entSQLiteBE) setupNewDB() error {
+	opts := &entbackend.BackendOptions{
+		DriverName:  "sqlite3",
+		Address:     "file:" + filepath.Join(m.dir, ksuid.New().String()+".db"),
+		Debug:       false,
+		AutoMigrate: true,
+	}
+	be, err := backends.Get("ent", context.Background(), opts)
+	m.be = be
+	return err
+}
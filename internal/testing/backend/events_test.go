@@ -0,0 +1,132 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(getGraphQLPreloadCtx())
+	defer cancel()
+	events := backends.NewEvents()
+	b := backends.WithEvents(setupTest(t), events)
+	if backends.EventsOf(b) == nil {
+		t.Fatalf("the backend does not report the evidence it creates")
+	}
+	vulns := events.Subscribe(ctx, backends.EvidenceCertifyVuln)
+	sboms := events.Subscribe(ctx, backends.EvidenceHasSBOM)
+
+	// the events are published before the ingestion returns
+	published := func(ch <-chan backends.Event) []string {
+		t.Helper()
+		var ids []string
+		for {
+			select {
+			case e := <-ch:
+				ids = append(ids, e.IDs...)
+			default:
+				return ids
+			}
+		}
+	}
+
+	pkg, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	vuln, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.V1})
+	if err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	art, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1})
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	pkgInput := &model.IDorPkgInput{PackageVersionID: &pkg.PackageVersionID}
+	vulnInput := &model.IDorVulnerabilityInput{VulnerabilityNodeID: &vuln.VulnerabilityNodeID}
+	scan := func(dbVersion string) *model.ScanMetadataInput {
+		return &model.ScanMetadataInput{TimeScanned: testTime, DbVersion: dbVersion, Collector: "test"}
+	}
+	sbom := func(uri string) *model.HasSBOMInputSpec {
+		return &model.HasSBOMInputSpec{URI: uri, KnownSince: testTime}
+	}
+	artInput := model.PackageOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactID: &art}}
+
+	for i := 0; i < 2; i++ {
+		id, err := b.IngestCertifyVuln(ctx, *pkgInput, *vulnInput, *scan("1"))
+		if err != nil {
+			t.Fatalf("Could not ingest certifyVuln: %v", err)
+		}
+		var want []string
+		if i == 0 {
+			want = []string{id}
+		}
+		if got := published(vulns); !slices.Equal(got, want) {
+			t.Errorf("ingestion %d of a certifyVuln published %v, want %v", i, got, want)
+		}
+	}
+	if _, err := b.IngestCertifyVulns(ctx,
+		[]*model.IDorPkgInput{pkgInput, pkgInput},
+		[]*model.IDorVulnerabilityInput{vulnInput, vulnInput},
+		[]*model.ScanMetadataInput{scan("1"), scan("2")}); err != nil {
+		t.Fatalf("Could not ingest certifyVulns: %v", err)
+	}
+	got := published(vulns)
+	if len(got) != 1 {
+		t.Fatalf("bulk ingestion of a new and an existing certifyVuln published %v, want the new one", got)
+	}
+	found, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &got[0]})
+	if err != nil || len(found) != 1 || found[0].Metadata.DbVersion != "2" {
+		t.Errorf("bulk ingestion published %s, want the certifyVuln of the new scan, found %v, %v", got[0], found, err)
+	}
+
+	for i := 0; i < 2; i++ {
+		id, err := b.IngestHasSbom(ctx, artInput, *sbom("first"), model.HasSBOMIncludesInputSpec{})
+		if err != nil {
+			t.Fatalf("Could not ingest hasSBOM: %v", err)
+		}
+		var want []string
+		if i == 0 {
+			want = []string{id}
+		}
+		if got := published(sboms); !slices.Equal(got, want) {
+			t.Errorf("ingestion %d of a hasSBOM published %v, want %v", i, got, want)
+		}
+	}
+	if _, err := b.IngestHasSBOMs(ctx,
+		model.PackageOrArtifactInputs{Artifacts: []*model.IDorArtifactInput{artInput.Artifact, artInput.Artifact}},
+		[]*model.HasSBOMInputSpec{sbom("first"), sbom("second")},
+		[]*model.HasSBOMIncludesInputSpec{{}, {}}); err != nil {
+		t.Fatalf("Could not ingest hasSBOMs: %v", err)
+	}
+	got = published(sboms)
+	if len(got) != 1 {
+		t.Fatalf("bulk ingestion of a new and an existing hasSBOM published %v, want the new one", got)
+	}
+	foundSBOMs, err := b.HasSBOM(ctx, &model.HasSBOMSpec{ID: &got[0]})
+	if err != nil || len(foundSBOMs) != 1 || foundSBOMs[0].URI != "second" {
+		t.Errorf("bulk ingestion published %s, want the hasSBOM of the new document, found %v, %v", got[0], foundSBOMs, err)
+	}
+}
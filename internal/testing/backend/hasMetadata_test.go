@@ -148,6 +148,68 @@ func TestHasMetadata(t *testing.T) {
 			},
 			ExpHM: nil,
 		},
+		{
+			Name:  "HappyPath check time asOf",
+			InPkg: []*model.PkgInputSpec{testdata.P1},
+			Calls: []call{
+				{
+					Sub: model.PackageSourceOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					HM: &model.HasMetadataInputSpec{
+						Key:           "key1",
+						Value:         "value1",
+						Timestamp:     time.Unix(1e9, 0),
+						Justification: "test justification",
+					},
+				},
+			},
+			Query: &model.HasMetadataSpec{
+				Key:           ptrfrom.String("key1"),
+				Value:         ptrfrom.String("value1"),
+				AsOf:          ptrfrom.Time(time.Unix(1e10, 0)),
+				Justification: ptrfrom.String("test justification"),
+			},
+			ExpHM: []*model.HasMetadata{
+				{
+					Subject:       testdata.P1out,
+					Key:           "key1",
+					Value:         "value1",
+					Timestamp:     time.Unix(1e9, 0),
+					Justification: "test justification",
+				},
+			},
+		},
+		{
+			Name:  "UnhappyPath check time asOf",
+			InPkg: []*model.PkgInputSpec{testdata.P1},
+			Calls: []call{
+				{
+					Sub: model.PackageSourceOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					HM: &model.HasMetadataInputSpec{
+						Key:           "key1",
+						Value:         "value1",
+						Timestamp:     time.Unix(1e9, 0),
+						Justification: "test justification",
+					},
+				},
+			},
+			Query: &model.HasMetadataSpec{
+				Key:           ptrfrom.String("key1"),
+				Value:         ptrfrom.String("value1"),
+				AsOf:          ptrfrom.Time(time.Unix(1e8, 0)),
+				Justification: ptrfrom.String("test justification"),
+			},
+			ExpHM: nil,
+		},
 		{
 			Name:  "HappyPath All Version",
 			InPkg: []*model.PkgInputSpec{testdata.P1},
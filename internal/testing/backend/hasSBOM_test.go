@@ -545,6 +545,44 @@ func TestHasSBOM(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "Query on AsOf",
+			InPkg: []*model.PkgInputSpec{testdata.P1},
+			Calls: []call{
+				{
+					Sub: model.PackageOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					HS: &model.HasSBOMInputSpec{
+						URI:        "test uri one",
+						KnownSince: curTime,
+						Origin:     "asOf origin",
+					},
+				},
+				{
+					Sub: model.PackageOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					HS: &model.HasSBOMInputSpec{
+						URI:        "test uri two",
+						KnownSince: timeAfterOneSecond,
+						Origin:     "asOf origin",
+					},
+				},
+			},
+			Query: &model.HasSBOMSpec{
+				AsOf:   ptrfrom.Time(curTime),
+				Origin: ptrfrom.String("asOf origin"),
+			},
+			ExpHS: []*model.HasSbom{
+				{
+					Subject:    testdata.P1out,
+					URI:        "test uri one",
+					KnownSince: curTime,
+					Origin:     "asOf origin",
+				},
+			},
+		},
 		{
 			Name:  "Query on Package",
 			InPkg: []*model.PkgInputSpec{testdata.P2, testdata.P4},
@@ -356,6 +356,48 @@ func TestHasSLSA(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "Query on AsOf",
+			InArt: []*model.ArtifactInputSpec{testdata.A1, testdata.A2},
+			InBld: []*model.BuilderInputSpec{testdata.B1},
+			Calls: []call{
+				{
+					Sub: testdata.A1,
+					BF:  []*model.IDorArtifactInput{&model.IDorArtifactInput{ArtifactInput: testdata.A2}},
+					BB:  testdata.B1,
+					SLSA: &model.SLSAInputSpec{
+						FinishedOn: &testTime2,
+					},
+				},
+				{
+					Sub: testdata.A1,
+					BF:  []*model.IDorArtifactInput{&model.IDorArtifactInput{ArtifactInput: testdata.A2}},
+					BB:  testdata.B1,
+					SLSA: &model.SLSAInputSpec{
+						FinishedOn: &testTime,
+					},
+				},
+				{
+					Sub:  testdata.A1,
+					BF:   []*model.IDorArtifactInput{&model.IDorArtifactInput{ArtifactInput: testdata.A2}},
+					BB:   testdata.B1,
+					SLSA: &model.SLSAInputSpec{},
+				},
+			},
+			Query: &model.HasSLSASpec{
+				AsOf: &testTime2,
+			},
+			ExpHS: []*model.HasSlsa{
+				{
+					Subject: testdata.A1out,
+					Slsa: &model.Slsa{
+						BuiltBy:    testdata.B1out,
+						BuiltFrom:  []*model.Artifact{testdata.A2out},
+						FinishedOn: &testTime2,
+					},
+				},
+			},
+		},
 		{
 			Name:  "Query on Subject",
 			InArt: []*model.ArtifactInputSpec{testdata.A1, testdata.A2, testdata.A3},
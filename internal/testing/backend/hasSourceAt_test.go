@@ -382,6 +382,47 @@ func TestHasSourceAt(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "Query on AsOf",
+			InPkg: []*model.PkgInputSpec{testdata.P1},
+			InSrc: []*model.SourceInputSpec{testdata.S1},
+			Calls: []call{
+				{
+					Pkg: testdata.P1,
+					Src: testdata.S1,
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					HSA: &model.HasSourceAtInputSpec{
+						KnownSince: time.Unix(1e9, 0),
+						Origin:     "asOf origin",
+					},
+				},
+				{
+					Pkg: testdata.P1,
+					Src: testdata.S1,
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					HSA: &model.HasSourceAtInputSpec{
+						KnownSince: testTime,
+						Origin:     "asOf origin",
+					},
+				},
+			},
+			Query: &model.HasSourceAtSpec{
+				AsOf:   &testTime2,
+				Origin: ptrfrom.String("asOf origin"),
+			},
+			ExpHSA: []*model.HasSourceAt{
+				{
+					Package:    testdata.P1out,
+					Source:     testdata.S1out,
+					KnownSince: testTime2,
+					Origin:     "asOf origin",
+				},
+			},
+		},
 		{
 			Name:  "Query Multiple",
 			InPkg: []*model.PkgInputSpec{testdata.P1, testdata.P2},
@@ -590,6 +590,29 @@ func lessPackageOrArtifact(a, b model.PackageOrArtifact) bool {
 	return cmpPackageOrArtifact(a, b) < 0
 }
 
+// sortNeighbors orders the packages and artifacts among neighbors, as backends
+// return the included software of an SBOM in no particular order. Other nodes
+// keep their order.
+var sortNeighbors = cmp.FilterValues(func(_, _ []model.Node) bool { return true }, cmpopts.SortSlices(lessNeighbor))
+
+func lessNeighbor(a, b model.Node) bool {
+	rank := func(n model.Node) int {
+		switch n.(type) {
+		case *model.Package:
+			return 0
+		case *model.Artifact:
+			return 1
+		default:
+			return 2
+		}
+	}
+	ra, rb := rank(a), rank(b)
+	if ra != rb || ra == 2 {
+		return ra < rb
+	}
+	return cmpPackageOrArtifact(a.(model.PackageOrArtifact), b.(model.PackageOrArtifact)) < 0
+}
+
 func cmpPackageOrArtifact(a, b model.PackageOrArtifact) int {
 	ap, oka := a.(*model.Package)
 	bp, okb := b.(*model.Package)
@@ -29,11 +29,12 @@ import (
 )
 
 const (
-	memmap = "memmap"
-	arango = "arango"
-	redis  = "redis"
-	ent    = "ent"
-	tikv   = "tikv"
+	memmap    = "memmap"
+	arango    = "arango"
+	redis     = "redis"
+	ent       = "ent"
+	entSQLite = "ent-sqlite"
+	tikv      = "tikv"
 )
 
 var skipMatrix = map[string]map[string]bool{
@@ -97,7 +98,7 @@ var skipMatrix = map[string]map[string]bool{
 	// redis order issues, tikv: not a valid VexStatus
 	"TestVEXBulkIngest": {arango: true, redis: true, tikv: true},
 	// redis order issues
-	"TestFindSoftware":  {redis: true, arango: true},
+	"TestFindSoftware": {redis: true, arango: true},
 	// remove these once its implemented for the other backends
 	"TestDeleteCertifyVuln":              {arango: true, memmap: true, redis: true, tikv: true},
 	"TestDeleteHasSBOM":                  {arango: true, memmap: true, redis: true, tikv: true},
@@ -107,6 +108,12 @@ var skipMatrix = map[string]map[string]bool{
 	"TestBatchQueryPkgIDCertifyLegal":    {arango: true, redis: true, tikv: true},
 	"TestBatchQuerySubjectPkgDependency": {arango: true, redis: true, tikv: true},
 	"TestBatchQueryDepPkgDependency":     {arango: true, redis: true, tikv: true},
+	// only the ent and keyvalue backends report the evidence they create
+	"TestEvents": {arango: true},
+	// only the ent backend stops reading the results over the limit
+	"TestPackagesResultLimit": {arango: true, memmap: true, redis: true, tikv: true},
+	// tenants are only implemented by the ent backend
+	"TestTenants": {arango: true, memmap: true, redis: true, tikv: true},
 }
 
 type backend interface {
@@ -117,11 +124,12 @@ type backend interface {
 }
 
 var testBackends = map[string]backend{
-	memmap: newMemMap(),
-	arango: newArango(),
-	redis:  newRedis(),
-	ent:    newEnt(),
-	tikv:   newTikv(),
+	memmap:    newMemMap(),
+	arango:    newArango(),
+	redis:     newRedis(),
+	ent:       newEnt(),
+	entSQLite: newEntSQLite(),
+	tikv:      newTikv(),
 }
 
 var currentBackend string
@@ -19,11 +19,13 @@ package backend_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -169,3 +171,20 @@ func TestIngestPackages(t *testing.T) {
 		})
 	}
 }
+
+func TestPackagesResultLimit(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	for _, pkg := range []*model.PkgInputSpec{testdata.P1, testdata.P4, {Type: "golang", Name: "x"}} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg}); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	if _, err := b.Packages(backends.WithResultLimit(ctx, 2), &model.PkgSpec{}); !errors.Is(err, backends.ErrResultLimit) {
+		t.Errorf("got error %v over the result limit, want %v", err, backends.ErrResultLimit)
+	}
+	got, err := b.Packages(backends.WithResultLimit(ctx, 3), &model.PkgSpec{})
+	if err != nil || len(got) != 3 {
+		t.Errorf("got %d packages and error %v within the result limit, want 3", len(got), err)
+	}
+}
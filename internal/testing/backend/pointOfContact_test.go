@@ -148,6 +148,68 @@ func TestPointOfContact(t *testing.T) {
 			},
 			ExpPoc: nil,
 		},
+		{
+			Name:  "HappyPath check time asOf",
+			InPkg: []*model.PkgInputSpec{testdata.P1},
+			Calls: []call{
+				{
+					Sub: model.PackageSourceOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					POC: &model.PointOfContactInputSpec{
+						Email:         "a@b.com",
+						Info:          "info1",
+						Since:         time.Unix(1e9, 0),
+						Justification: "test justification",
+					},
+				},
+			},
+			Query: &model.PointOfContactSpec{
+				Email:         ptrfrom.String("a@b.com"),
+				Info:          ptrfrom.String("info1"),
+				AsOf:          ptrfrom.Time(time.Unix(1e10, 0)),
+				Justification: ptrfrom.String("test justification"),
+			},
+			ExpPoc: []*model.PointOfContact{
+				{
+					Subject:       testdata.P1out,
+					Email:         "a@b.com",
+					Info:          "info1",
+					Since:         time.Unix(1e9, 0),
+					Justification: "test justification",
+				},
+			},
+		},
+		{
+			Name:  "UnhappyPath check time asOf",
+			InPkg: []*model.PkgInputSpec{testdata.P1},
+			Calls: []call{
+				{
+					Sub: model.PackageSourceOrArtifactInput{
+						Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+					},
+					Match: &model.MatchFlags{
+						Pkg: model.PkgMatchTypeSpecificVersion,
+					},
+					POC: &model.PointOfContactInputSpec{
+						Email:         "a@b.com",
+						Info:          "info1",
+						Since:         time.Unix(1e9, 0),
+						Justification: "test justification",
+					},
+				},
+			},
+			Query: &model.PointOfContactSpec{
+				Email:         ptrfrom.String("a@b.com"),
+				Info:          ptrfrom.String("info1"),
+				AsOf:          ptrfrom.Time(time.Unix(1e8, 0)),
+				Justification: ptrfrom.String("test justification"),
+			},
+			ExpPoc: nil,
+		},
 		{
 			Name:  "HappyPath All Version",
 			InPkg: []*model.PkgInputSpec{testdata.P1},
@@ -0,0 +1,169 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestRetractDocument(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	retracted := d1.BlobKey
+	kept := d2.BlobKey
+
+	// docA: an SBOM for P2 that includes P4, with a dependency and an
+	// occurrence. docB: evidence about A1 and P1 that must survive.
+	p2, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P2})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	p4, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P4})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	depID, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: testdata.P2}, model.IDorPkgInput{PackageInput: testdata.P4},
+		model.IsDependencyInputSpec{Justification: "bad sbom", DocumentRef: retracted})
+	if err != nil {
+		t.Fatalf("Could not ingest dependency: %v", err)
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: testdata.P2}},
+		model.IDorArtifactInput{ArtifactInput: testdata.A1}, model.IsOccurrenceInputSpec{Justification: "bad sbom", DocumentRef: retracted}); err != nil {
+		t.Fatalf("Could not ingest occurrence: %v", err)
+	}
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P2}},
+		model.HasSBOMInputSpec{URI: "https://example.com/bad.spdx.json", DocumentRef: retracted},
+		model.HasSBOMIncludesInputSpec{
+			Packages:     []string{p2.PackageVersionID, p4.PackageVersionID},
+			Dependencies: []string{depID},
+		}); err != nil {
+		t.Fatalf("Could not ingest hasSBOM: %v", err)
+	}
+	if _, err := b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: testdata.A1}}, nil,
+		model.CertifyBadInputSpec{Justification: "kept", DocumentRef: kept}); err != nil {
+		t.Fatalf("Could not ingest certifyBad: %v", err)
+	}
+	if _, err := b.IngestCertifyGood(ctx, model.PackageSourceOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}},
+		&model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		model.CertifyGoodInputSpec{Justification: "kept", DocumentRef: kept}); err != nil {
+		t.Fatalf("Could not ingest certifyGood: %v", err)
+	}
+	if _, err := b.IngestDocument(ctx, *d1); err != nil {
+		t.Fatalf("Could not ingest document: %v", err)
+	}
+
+	dryRun, err := b.RetractDocument(ctx, retracted, true)
+	if err != nil {
+		t.Fatalf("RetractDocument() dry run error = %v", err)
+	}
+	if !dryRun.DryRun || dryRun.Document == nil || len(dryRun.Evidence) != 3 || len(dryRun.Orphans) == 0 {
+		t.Fatalf("unexpected dry run result: %+v", dryRun)
+	}
+	for _, o := range dryRun.Orphans {
+		if _, ok := o.(*model.Package); !ok {
+			t.Errorf("expected only packages to be orphaned, got %T", o)
+		}
+	}
+	sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{DocumentRef: &retracted})
+	if err != nil {
+		t.Fatalf("Could not query hasSBOM: %v", err)
+	}
+	if len(sboms) != 1 {
+		t.Fatalf("dry run removed the hasSBOM")
+	}
+
+	got, err := b.RetractDocument(ctx, retracted, false)
+	if err != nil {
+		t.Fatalf("RetractDocument() error = %v", err)
+	}
+	if got.DryRun || len(got.Evidence) != 3 || len(got.Orphans) != len(dryRun.Orphans) {
+		t.Errorf("retract result does not match the dry run: %+v", got)
+	}
+
+	sboms, err = b.HasSBOM(ctx, &model.HasSBOMSpec{DocumentRef: &retracted})
+	if err != nil {
+		t.Fatalf("Could not query hasSBOM: %v", err)
+	}
+	deps, err := b.IsDependency(ctx, &model.IsDependencySpec{DocumentRef: &retracted})
+	if err != nil {
+		t.Fatalf("Could not query isDependency: %v", err)
+	}
+	occs, err := b.IsOccurrence(ctx, &model.IsOccurrenceSpec{DocumentRef: &retracted})
+	if err != nil {
+		t.Fatalf("Could not query isOccurrence: %v", err)
+	}
+	if len(sboms)+len(deps)+len(occs) != 0 {
+		t.Errorf("evidence of the retracted document remains: %d hasSBOM, %d isDependency, %d isOccurrence", len(sboms), len(deps), len(occs))
+	}
+	docs, err := b.Documents(ctx, &model.DocumentSpec{BlobKey: &retracted})
+	if err != nil {
+		t.Fatalf("Could not query documents: %v", err)
+	}
+	if len(docs) != 0 {
+		t.Errorf("retracted document remains: %+v", docs)
+	}
+
+	openssl, err := b.Packages(ctx, &model.PkgSpec{Name: ptrfrom.String("openssl")})
+	if err != nil {
+		t.Fatalf("Could not query packages: %v", err)
+	}
+	if len(openssl) != 0 {
+		t.Errorf("orphaned package remains: %+v", openssl)
+	}
+	tensorflow, err := b.Packages(ctx, &model.PkgSpec{Name: ptrfrom.String("tensorflow")})
+	if err != nil {
+		t.Fatalf("Could not query packages: %v", err)
+	}
+	if diff := cmp.Diff([]*model.Package{testdata.P1out}, tensorflow, commonOpts); diff != "" {
+		t.Errorf("Unexpected packages. (-want +got):\n%s", diff)
+	}
+	arts, err := b.Artifacts(ctx, &model.ArtifactSpec{Digest: &testdata.A1.Digest})
+	if err != nil {
+		t.Fatalf("Could not query artifacts: %v", err)
+	}
+	if len(arts) != 1 {
+		t.Errorf("artifact with remaining evidence was removed")
+	}
+	bads, err := b.CertifyBad(ctx, &model.CertifyBadSpec{DocumentRef: &kept})
+	if err != nil {
+		t.Fatalf("Could not query certifyBad: %v", err)
+	}
+	if len(bads) != 1 {
+		t.Errorf("evidence of another document was removed")
+	}
+
+	again, err := b.RetractDocument(ctx, retracted, false)
+	if err != nil {
+		t.Fatalf("RetractDocument() error = %v", err)
+	}
+	if again.Document != nil || len(again.Evidence) != 0 || len(again.Orphans) != 0 {
+		t.Errorf("expected retracting again to remove nothing, got %+v", again)
+	}
+}
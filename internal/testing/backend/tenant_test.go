@@ -0,0 +1,187 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestTenants(t *testing.T) {
+	global := context.Background()
+	ctxA := backends.WithTenant(global, "a")
+	ctxB := backends.WithTenant(global, "b")
+	tenants := map[string]context.Context{"a": ctxA, "b": ctxB}
+	b := setupTest(t)
+
+	// the vulnerability is public data, the package is shared by the tenants
+	// and the artifact is not: both tenants ingest them, with a scan of
+	// their own linking the package to the public vulnerability
+	vuln, err := b.IngestVulnerability(global, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.V1})
+	if err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	var pkgID string
+	artifacts := map[string]string{}
+	for tenant, ctx := range tenants {
+		pkg, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		if pkgID != "" && pkg.PackageVersionID != pkgID {
+			t.Errorf("tenants got the package version IDs %s and %s, want the shared one", pkgID, pkg.PackageVersionID)
+		}
+		pkgID = pkg.PackageVersionID
+		if artifacts[tenant], err = b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+			t.Fatalf("Could not ingest artifact: %v", err)
+		}
+		if _, err := b.IngestCertifyVuln(ctx,
+			model.IDorPkgInput{PackageVersionID: &pkg.PackageVersionID},
+			model.IDorVulnerabilityInput{VulnerabilityNodeID: &vuln.VulnerabilityNodeID},
+			model.ScanMetadataInput{TimeScanned: testTime, Collector: tenant, DocumentRef: "scan"}); err != nil {
+			t.Fatalf("Could not ingest certifyVuln: %v", err)
+		}
+	}
+	if artifacts["a"] == artifacts["b"] {
+		t.Fatalf("tenants got the same artifact ID %s", artifacts["a"])
+	}
+
+	packageVersionIDs := func(ctx context.Context) []string {
+		t.Helper()
+		res, err := b.Packages(ctx, &model.PkgSpec{})
+		if err != nil {
+			t.Fatalf("Packages() error = %v", err)
+		}
+		var ids []string
+		for _, p := range res {
+			for _, ns := range p.Namespaces {
+				for _, n := range ns.Names {
+					for _, v := range n.Versions {
+						ids = append(ids, v.ID)
+					}
+				}
+			}
+		}
+		return ids
+	}
+	artifactIDs := func(ctx context.Context) []string {
+		t.Helper()
+		res, err := b.Artifacts(ctx, &model.ArtifactSpec{})
+		if err != nil {
+			t.Fatalf("Artifacts() error = %v", err)
+		}
+		var ids []string
+		for _, a := range res {
+			ids = append(ids, a.ID)
+		}
+		return ids
+	}
+	scanners := func(ctx context.Context) []string {
+		t.Helper()
+		res, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{})
+		if err != nil {
+			t.Fatalf("CertifyVuln() error = %v", err)
+		}
+		var collectors []string
+		for _, cv := range res {
+			collectors = append(collectors, cv.Metadata.Collector)
+			if got := cv.Package.Namespaces[0].Names[0].Versions[0].ID; got != pkgID {
+				t.Errorf("certifyVuln links to package %s, want the shared %s", got, pkgID)
+			}
+			if got := cv.Vulnerability.VulnerabilityIDs[0].ID; got != vuln.VulnerabilityNodeID {
+				t.Errorf("certifyVuln links to vulnerability %s, want the global %s", got, vuln.VulnerabilityNodeID)
+			}
+		}
+		slices.Sort(collectors)
+		return collectors
+	}
+
+	// a certifier of the global tenant finds the package of the tenants, and
+	// its scan is seen by both
+	if got := packageVersionIDs(global); !slices.Equal(got, []string{pkgID}) {
+		t.Errorf("global tenant sees packages %v, want the shared %v", got, []string{pkgID})
+	}
+	if _, err := b.IngestCertifyVuln(global,
+		model.IDorPkgInput{PackageInput: testdata.P1},
+		model.IDorVulnerabilityInput{VulnerabilityInput: testdata.V1},
+		model.ScanMetadataInput{TimeScanned: testTime, Collector: "osv", DocumentRef: "osv"}); err != nil {
+		t.Fatalf("Could not ingest certifyVuln: %v", err)
+	}
+	if got := scanners(global); !slices.Equal(got, []string{"osv"}) {
+		t.Errorf("global tenant sees scans by %v, want %v", got, []string{"osv"})
+	}
+	if got := artifactIDs(global); len(got) != 0 {
+		t.Errorf("global tenant sees the artifacts of the tenants: %v", got)
+	}
+	for tenant, ctx := range tenants {
+		if got, want := scanners(ctx), []string{tenant, "osv"}; !slices.Equal(got, want) {
+			t.Errorf("tenant %s sees scans by %v, want %v", tenant, got, want)
+		}
+		if got := artifactIDs(ctx); !slices.Equal(got, []string{artifacts[tenant]}) {
+			t.Errorf("tenant %s sees artifacts %v, want %v", tenant, got, []string{artifacts[tenant]})
+		}
+		vulns, err := b.Vulnerabilities(ctx, &model.VulnerabilitySpec{Type: &testdata.V1.Type})
+		if err != nil {
+			t.Fatalf("Vulnerabilities() error = %v", err)
+		}
+		if len(vulns) != 1 {
+			t.Errorf("tenant %s sees %d vulnerabilities, want the global one", tenant, len(vulns))
+		}
+	}
+	if _, err := b.Node(ctxB, artifacts["a"]); err == nil {
+		t.Errorf("tenant b can look up the artifact of tenant a by ID")
+	}
+
+	// retracting the scan of tenant b leaves the other scans, the shared
+	// package and the public vulnerability in place
+	if _, err := b.RetractDocument(ctxB, "scan", false); err != nil {
+		t.Fatalf("RetractDocument() error = %v", err)
+	}
+	if got := scanners(ctxB); !slices.Equal(got, []string{"osv"}) {
+		t.Errorf("tenant b sees scans by %v after retracting its own, want %v", got, []string{"osv"})
+	}
+	if got := scanners(ctxA); !slices.Equal(got, []string{"a", "osv"}) {
+		t.Errorf("tenant a sees scans by %v after tenant b retracted its own, want %v", got, []string{"a", "osv"})
+	}
+	if got := packageVersionIDs(ctxB); !slices.Equal(got, []string{pkgID}) {
+		t.Errorf("tenant b sees packages %v after retracting its scan, want the shared %v", got, []string{pkgID})
+	}
+	if vulns, err := b.Vulnerabilities(global, &model.VulnerabilitySpec{Type: &testdata.V1.Type}); err != nil || len(vulns) != 1 {
+		t.Errorf("the global vulnerability was removed by a tenant: %v, %v", vulns, err)
+	}
+
+	// the shared package is removed once no evidence of any tenant refers
+	// to it
+	if _, err := b.RetractDocument(global, "osv", false); err != nil {
+		t.Fatalf("RetractDocument() error = %v", err)
+	}
+	if got := packageVersionIDs(global); !slices.Equal(got, []string{pkgID}) {
+		t.Errorf("global tenant removed the package still scanned by tenant a, sees %v", got)
+	}
+	if _, err := b.RetractDocument(ctxA, "scan", false); err != nil {
+		t.Fatalf("RetractDocument() error = %v", err)
+	}
+	if got := packageVersionIDs(global); len(got) != 0 {
+		t.Errorf("the orphaned shared package was not removed: %v", got)
+	}
+}
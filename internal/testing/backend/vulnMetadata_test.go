@@ -1005,6 +1005,52 @@ func TestIngestVulnMetadata(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:   "Query on AsOf",
+			InVuln: []*model.VulnerabilityInputSpec{testdata.G1},
+			Calls: []call{
+				{
+					Vuln: testdata.G1,
+					VulnMetadata: &model.VulnerabilityMetadataInputSpec{
+						ScoreType:  model.VulnerabilityScoreTypeEPSSv1,
+						ScoreValue: 0.5,
+						Timestamp:  testdata.T2,
+						Collector:  "test collector",
+						Origin:     "asOf origin",
+					},
+				},
+				{
+					Vuln: testdata.G1,
+					VulnMetadata: &model.VulnerabilityMetadataInputSpec{
+						ScoreType:  model.VulnerabilityScoreTypeEPSSv1,
+						ScoreValue: 0.95,
+						Timestamp:  testdata.T1,
+						Collector:  "test collector",
+						Origin:     "asOf origin",
+					},
+				},
+			},
+			Query: &model.VulnerabilityMetadataSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type: ptrfrom.String("ghsa"),
+				},
+				AsOf:   &testdata.T2,
+				Origin: ptrfrom.String("asOf origin"),
+			},
+			ExpVuln: []*model.VulnerabilityMetadata{
+				{
+					Vulnerability: &model.Vulnerability{
+						Type:             "ghsa",
+						VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
+					},
+					ScoreType:  model.VulnerabilityScoreTypeEPSSv1,
+					ScoreValue: 0.5,
+					Timestamp:  testdata.T2,
+					Collector:  "test collector",
+					Origin:     "asOf origin",
+				},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -63,6 +63,39 @@ const (
 	defaultHasSlsaCollector      = "test-collector"
 	defaultHasSlsaPredicateKey   = "test-predicate-key"
 	defaultHasSlsaPredicateValue = "test-predicate-value"
+
+	// CertifyVuln
+	defaultCertifyVulnOrigin    = "test-origin"
+	defaultCertifyVulnCollector = "test-collector"
+
+	// CertifyBad
+	defaultCertifyBadJustification = "test-justification"
+	defaultCertifyBadOrigin        = "test-origin"
+	defaultCertifyBadCollector     = "test-collector"
+
+	// HasSourceAt
+	defaultHasSourceAtJustification = "test-justification"
+	defaultHasSourceAtOrigin        = "test-origin"
+	defaultHasSourceAtCollector     = "test-collector"
+
+	// CertifyScorecard
+	defaultScorecardOrigin    = "test-origin"
+	defaultScorecardCollector = "test-collector"
+
+	// CertifyLegal
+	defaultCertifyLegalJustification = "test-justification"
+	defaultCertifyLegalOrigin        = "test-origin"
+	defaultCertifyLegalCollector     = "test-collector"
+	defaultLicenseListVersion        = "test-list-version"
+
+	// CertifyVEXStatement
+	defaultVexStatementOrigin    = "test-origin"
+	defaultVexStatementCollector = "test-collector"
+
+	// VulnEqual
+	defaultVulnEqualJustification = "test-justification"
+	defaultVulnEqualOrigin        = "test-origin"
+	defaultVulnEqualCollector     = "test-collector"
 )
 
 // GuacData Defines the Guac graph, to test clients of the Graphql server.
@@ -90,6 +123,12 @@ type GuacData struct {
 	HashEquals     []HashEqual
 	HasSlsas       []HasSlsa
 	CertifyVulns   []CertifyVuln
+	CertifyBads    []CertifyBad
+	HasSourceAts   []HasSourceAt
+	Scorecards     []Scorecard
+	CertifyLegals  []CertifyLegal
+	VexStatements  []VexStatement
+	VulnEquals     []VulnEqual
 
 	// Other graphql verbs still need to be added here
 }
@@ -133,6 +172,39 @@ type CertifyVuln struct {
 	Metadata      *gql.ScanMetadataInput // if nil, a default will be used
 }
 
+type VexStatement struct {
+	Subject       string                     // a previously ingested purl or digest
+	Vulnerability string                     // a previously ingested vulnerability
+	Spec          *gql.VexStatementInputSpec // if nil, a default NOT_AFFECTED statement will be used
+}
+
+type VulnEqual struct {
+	VulnerabilityA string                  // a previously ingested vulnerability
+	VulnerabilityB string                  // a previously ingested vulnerability
+	Spec           *gql.VulnEqualInputSpec // if nil, a default will be used
+}
+
+type CertifyBad struct {
+	Subject string                   // a previously ingested purl, digest or source
+	Spec    *gql.CertifyBadInputSpec // if nil, a default will be used
+}
+
+type HasSourceAt struct {
+	Package string                    // a previously ingested purl
+	Source  string                    // a previously ingested source
+	Spec    *gql.HasSourceAtInputSpec // if nil, a default will be used
+}
+
+type Scorecard struct {
+	Source         string // a previously ingested source
+	AggregateScore float64
+}
+
+type CertifyLegal struct {
+	Package          string   // a previously ingested purl
+	DeclaredLicenses []string // license names, which are ingested if needed
+}
+
 // maintains the ids of nouns, to use when ingesting verbs
 type nounIds struct {
 	PackageIds       map[string]string // map from purls to IDs of PackageName nodes
@@ -200,6 +272,30 @@ func Ingest(ctx context.Context, t *testing.T, gqlClient graphql.Client, data Gu
 		i.ingestCertifyVuln(ctx, t, gqlClient, certifyVuln)
 	}
 
+	for _, certifyBad := range data.CertifyBads {
+		i.ingestCertifyBad(ctx, t, gqlClient, certifyBad)
+	}
+
+	for _, hasSourceAt := range data.HasSourceAts {
+		i.ingestHasSourceAt(ctx, t, gqlClient, hasSourceAt)
+	}
+
+	for _, scorecard := range data.Scorecards {
+		i.ingestScorecard(ctx, t, gqlClient, scorecard)
+	}
+
+	for _, certifyLegal := range data.CertifyLegals {
+		i.ingestCertifyLegal(ctx, t, gqlClient, certifyLegal)
+	}
+
+	for _, vexStatement := range data.VexStatements {
+		i.ingestVexStatement(ctx, t, gqlClient, vexStatement)
+	}
+
+	for _, vulnEqual := range data.VulnEquals {
+		i.ingestVulnEqual(ctx, t, gqlClient, vulnEqual)
+	}
+
 	return i
 }
 
@@ -405,6 +501,14 @@ func (i nounIds) ingestHasSbom(ctx context.Context, t *testing.T, gqlClient grap
 	}
 }
 
+func pkgInputSpec(t *testing.T, purl string) *gql.PkgInputSpec {
+	spec, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		t.Fatalf("Could not create a package input spec from a purl: %s", err)
+	}
+	return spec
+}
+
 // Returns the ID of the version node in the package trie
 func ingestPackage(ctx context.Context, t *testing.T, gqlClient graphql.Client, purl string) string {
 	spec, err := helpers.PurlToPkg(purl)
@@ -437,6 +541,7 @@ func ingestSource(ctx context.Context, t *testing.T, gqlClient graphql.Client, n
 	spec := gql.SourceInputSpec{
 		Type:      defaultSourceType,
 		Namespace: defaultSourceNamespace,
+		Name:      name,
 	}
 	idorInputSpec := gql.IDorSourceInput{SourceInput: &spec}
 	res, err := gql.IngestSource(ctx, gqlClient, idorInputSpec)
@@ -480,7 +585,11 @@ func ingestVulnerability(ctx context.Context, t *testing.T, gqlClient graphql.Cl
 func (i nounIds) ingestCertifyVuln(ctx context.Context, t *testing.T, gqlClient graphql.Client, certifyVuln CertifyVuln) {
 	spec := certifyVuln.Metadata
 	if spec == nil {
-		spec = &gql.ScanMetadataInput{}
+		spec = &gql.ScanMetadataInput{
+			TimeScanned: time.Now(),
+			Origin:      defaultCertifyVulnOrigin,
+			Collector:   defaultCertifyVulnCollector,
+		}
 	}
 
 	packageId, ok := i.PackageIds[certifyVuln.Package]
@@ -500,3 +609,172 @@ func (i nounIds) ingestCertifyVuln(ctx context.Context, t *testing.T, gqlClient
 		t.Fatalf("Error ingesting CertifyVuln when setting up test: %s", err)
 	}
 }
+
+func (i nounIds) ingestVexStatement(ctx context.Context, t *testing.T, gqlClient graphql.Client, vexStatement VexStatement) {
+	spec := vexStatement.Spec
+	if spec == nil {
+		spec = &gql.VexStatementInputSpec{
+			Status:           gql.VexStatusNotAffected,
+			VexJustification: gql.VexJustificationComponentNotPresent,
+			Origin:           defaultVexStatementOrigin,
+			Collector:        defaultVexStatementCollector,
+			KnownSince:       time.Now(),
+		}
+	}
+
+	vulnerabilityId, ok := i.VulnerabilityIds[vexStatement.Vulnerability]
+	if !ok {
+		t.Fatalf("The vulnerability %s has not been ingested", vexStatement.Vulnerability)
+	}
+	vulnSpec := gql.IDorVulnerabilityInput{VulnerabilityNodeID: &vulnerabilityId}
+
+	var err error
+	// the subject can be either a package or an artifact
+	if v, ok := i.PackageIds[vexStatement.Subject]; ok {
+		_, err = gql.IngestCertifyVexPkg(ctx, gqlClient, gql.IDorPkgInput{PackageVersionID: &v}, vulnSpec, *spec)
+	} else if v, ok := i.ArtifactIds[vexStatement.Subject]; ok {
+		_, err = gql.IngestCertifyVexArtifact(ctx, gqlClient, gql.IDorArtifactInput{ArtifactID: &v}, vulnSpec, *spec)
+	} else {
+		t.Fatalf("The purl or digest %s has not been ingested", vexStatement.Subject)
+	}
+	if err != nil {
+		t.Fatalf("Error ingesting VEX statement when setting up test: %s", err)
+	}
+}
+
+func (i nounIds) ingestVulnEqual(ctx context.Context, t *testing.T, gqlClient graphql.Client, vulnEqual VulnEqual) {
+	spec := vulnEqual.Spec
+	if spec == nil {
+		spec = &gql.VulnEqualInputSpec{
+			Justification: defaultVulnEqualJustification,
+			Origin:        defaultVulnEqualOrigin,
+			Collector:     defaultVulnEqualCollector,
+		}
+	}
+
+	vulnA, ok := i.VulnerabilityIds[vulnEqual.VulnerabilityA]
+	if !ok {
+		t.Fatalf("The vulnerability %s has not been ingested", vulnEqual.VulnerabilityA)
+	}
+	vulnB, ok := i.VulnerabilityIds[vulnEqual.VulnerabilityB]
+	if !ok {
+		t.Fatalf("The vulnerability %s has not been ingested", vulnEqual.VulnerabilityB)
+	}
+
+	_, err := gql.IngestVulnEqual(ctx, gqlClient,
+		gql.IDorVulnerabilityInput{VulnerabilityNodeID: &vulnA},
+		gql.IDorVulnerabilityInput{VulnerabilityNodeID: &vulnB},
+		*spec)
+	if err != nil {
+		t.Fatalf("Error ingesting VulnEqual when setting up test: %s", err)
+	}
+}
+
+func (i nounIds) ingestCertifyBad(ctx context.Context, t *testing.T, gqlClient graphql.Client, certifyBad CertifyBad) {
+	spec := certifyBad.Spec
+	if spec == nil {
+		spec = &gql.CertifyBadInputSpec{
+			Justification: defaultCertifyBadJustification,
+			Origin:        defaultCertifyBadOrigin,
+			Collector:     defaultCertifyBadCollector,
+			KnownSince:    time.Now(),
+		}
+	}
+
+	var err error
+	// the subject can be a package, an artifact or a source
+	if _, ok := i.PackageIds[certifyBad.Subject]; ok {
+		// matching on a specific version needs the package input, and not only the version ID
+		pkgSpec := gql.IDorPkgInput{PackageInput: pkgInputSpec(t, certifyBad.Subject)}
+		_, err = gql.IngestCertifyBadPkg(ctx, gqlClient, pkgSpec, gql.MatchFlags{Pkg: gql.PkgMatchTypeSpecificVersion}, *spec)
+	} else if v, ok := i.ArtifactIds[certifyBad.Subject]; ok {
+		artifactSpec := gql.IDorArtifactInput{ArtifactID: &v}
+		_, err = gql.IngestCertifyBadArtifact(ctx, gqlClient, artifactSpec, *spec)
+	} else if v, ok := i.SourceIds[certifyBad.Subject]; ok {
+		sourceSpec := gql.IDorSourceInput{SourceNameID: &v}
+		_, err = gql.IngestCertifyBadSrc(ctx, gqlClient, sourceSpec, *spec)
+	} else {
+		t.Fatalf("The purl, digest or source %s has not been ingested", certifyBad.Subject)
+	}
+	if err != nil {
+		t.Fatalf("Error ingesting CertifyBad when setting up test: %s", err)
+	}
+}
+
+func (i nounIds) ingestHasSourceAt(ctx context.Context, t *testing.T, gqlClient graphql.Client, hasSourceAt HasSourceAt) {
+	spec := hasSourceAt.Spec
+	if spec == nil {
+		spec = &gql.HasSourceAtInputSpec{
+			Justification: defaultHasSourceAtJustification,
+			Origin:        defaultHasSourceAtOrigin,
+			Collector:     defaultHasSourceAtCollector,
+			KnownSince:    time.Now(),
+		}
+	}
+
+	if _, ok := i.PackageIds[hasSourceAt.Package]; !ok {
+		t.Fatalf("The purl %s has not been ingested", hasSourceAt.Package)
+	}
+	sourceId, ok := i.SourceIds[hasSourceAt.Source]
+	if !ok {
+		t.Fatalf("The source %s has not been ingested", hasSourceAt.Source)
+	}
+	pkgSpec := gql.IDorPkgInput{PackageInput: pkgInputSpec(t, hasSourceAt.Package)}
+	sourceSpec := gql.IDorSourceInput{SourceNameID: &sourceId}
+
+	_, err := gql.IngestHasSourceAt(ctx, gqlClient, pkgSpec, gql.MatchFlags{Pkg: gql.PkgMatchTypeSpecificVersion}, sourceSpec, *spec)
+	if err != nil {
+		t.Fatalf("Error ingesting HasSourceAt when setting up test: %s", err)
+	}
+}
+
+func (i nounIds) ingestScorecard(ctx context.Context, t *testing.T, gqlClient graphql.Client, scorecard Scorecard) {
+	sourceId, ok := i.SourceIds[scorecard.Source]
+	if !ok {
+		t.Fatalf("The source %s has not been ingested", scorecard.Source)
+	}
+	sourceSpec := gql.IDorSourceInput{SourceNameID: &sourceId}
+	spec := gql.ScorecardInputSpec{
+		Checks:         []gql.ScorecardCheckInputSpec{},
+		AggregateScore: scorecard.AggregateScore,
+		TimeScanned:    time.Now(),
+		Origin:         defaultScorecardOrigin,
+		Collector:      defaultScorecardCollector,
+	}
+
+	_, err := gql.IngestCertifyScorecard(ctx, gqlClient, sourceSpec, spec)
+	if err != nil {
+		t.Fatalf("Error ingesting Scorecard when setting up test: %s", err)
+	}
+}
+
+func (i nounIds) ingestCertifyLegal(ctx context.Context, t *testing.T, gqlClient graphql.Client, certifyLegal CertifyLegal) {
+	packageId, ok := i.PackageIds[certifyLegal.Package]
+	if !ok {
+		t.Fatalf("The purl %s has not been ingested", certifyLegal.Package)
+	}
+	pkgSpec := gql.IDorPkgInput{PackageVersionID: &packageId}
+
+	listVersion := defaultLicenseListVersion
+	declared := []gql.IDorLicenseInput{}
+	for _, name := range certifyLegal.DeclaredLicenses {
+		res, err := gql.IngestLicense(ctx, gqlClient, gql.IDorLicenseInput{LicenseInput: &gql.LicenseInputSpec{Name: name, ListVersion: &listVersion}})
+		if err != nil {
+			t.Fatalf("Error ingesting license when setting up test: %s", err)
+		}
+		id := res.GetIngestLicense()
+		declared = append(declared, gql.IDorLicenseInput{LicenseID: &id})
+	}
+	spec := gql.CertifyLegalInputSpec{
+		DeclaredLicense: strings.Join(certifyLegal.DeclaredLicenses, " AND "),
+		Justification:   defaultCertifyLegalJustification,
+		Origin:          defaultCertifyLegalOrigin,
+		Collector:       defaultCertifyLegalCollector,
+		TimeScanned:     time.Now(),
+	}
+
+	_, err := gql.IngestCertifyLegalPkg(ctx, gqlClient, pkgSpec, declared, []gql.IDorLicenseInput{}, spec)
+	if err != nil {
+		t.Fatalf("Error ingesting CertifyLegal when setting up test: %s", err)
+	}
+}
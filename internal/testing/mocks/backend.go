@@ -326,6 +326,36 @@ func (mr *MockBackendMockRecorder) Delete(ctx, node any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBackend)(nil).Delete), ctx, node)
 }
 
+// DocumentList mocks base method.
+func (m *MockBackend) DocumentList(ctx context.Context, documentSpec model.DocumentSpec, after *string, first *int) (*model.DocumentConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DocumentList", ctx, documentSpec, after, first)
+	ret0, _ := ret[0].(*model.DocumentConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DocumentList indicates an expected call of DocumentList.
+func (mr *MockBackendMockRecorder) DocumentList(ctx, documentSpec, after, first any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DocumentList", reflect.TypeOf((*MockBackend)(nil).DocumentList), ctx, documentSpec, after, first)
+}
+
+// Documents mocks base method.
+func (m *MockBackend) Documents(ctx context.Context, documentSpec *model.DocumentSpec) ([]*model.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Documents", ctx, documentSpec)
+	ret0, _ := ret[0].([]*model.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Documents indicates an expected call of Documents.
+func (mr *MockBackendMockRecorder) Documents(ctx, documentSpec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Documents", reflect.TypeOf((*MockBackend)(nil).Documents), ctx, documentSpec)
+}
+
 // FindPackagesThatNeedScanning mocks base method.
 func (m *MockBackend) FindPackagesThatNeedScanning(ctx context.Context, queryType model.QueryType, lastScan *int) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -761,6 +791,36 @@ func (mr *MockBackendMockRecorder) IngestDependency(ctx, pkg, depPkg, dependency
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestDependency", reflect.TypeOf((*MockBackend)(nil).IngestDependency), ctx, pkg, depPkg, dependency)
 }
 
+// IngestDocument mocks base method.
+func (m *MockBackend) IngestDocument(ctx context.Context, document model.DocumentInputSpec) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestDocument", ctx, document)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestDocument indicates an expected call of IngestDocument.
+func (mr *MockBackendMockRecorder) IngestDocument(ctx, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestDocument", reflect.TypeOf((*MockBackend)(nil).IngestDocument), ctx, document)
+}
+
+// IngestDocuments mocks base method.
+func (m *MockBackend) IngestDocuments(ctx context.Context, documents []*model.DocumentInputSpec) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestDocuments", ctx, documents)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestDocuments indicates an expected call of IngestDocuments.
+func (mr *MockBackendMockRecorder) IngestDocuments(ctx, documents any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestDocuments", reflect.TypeOf((*MockBackend)(nil).IngestDocuments), ctx, documents)
+}
+
 // IngestHasMetadata mocks base method.
 func (m *MockBackend) IngestHasMetadata(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, hasMetadata model.HasMetadataInputSpec) (string, error) {
 	m.ctrl.T.Helper()
@@ -1481,6 +1541,21 @@ func (mr *MockBackendMockRecorder) QueryPackagesListForScan(ctx, pkgIDs, after,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryPackagesListForScan", reflect.TypeOf((*MockBackend)(nil).QueryPackagesListForScan), ctx, pkgIDs, after, first)
 }
 
+// RetractDocument mocks base method.
+func (m *MockBackend) RetractDocument(ctx context.Context, documentRef string, dryRun bool) (*model.RetractDocumentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetractDocument", ctx, documentRef, dryRun)
+	ret0, _ := ret[0].(*model.RetractDocumentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetractDocument indicates an expected call of RetractDocument.
+func (mr *MockBackendMockRecorder) RetractDocument(ctx, documentRef, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetractDocument", reflect.TypeOf((*MockBackend)(nil).RetractDocument), ctx, documentRef, dryRun)
+}
+
 // Scorecards mocks base method.
 func (m *MockBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,145 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testdata
+
+import (
+	"io"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+// StreamCmpOpts compare the predicates of a parser with the chunks of its
+// streaming parser merged by MergeChunks. Unlike IngestPredicatesCmpOpts,
+// they sort the predicates by all their fields.
+var StreamCmpOpts = []cmp.Option{
+	sortByJSON[assembler.IsDependencyIngest](),
+	sortByJSON[assembler.IsOccurrenceIngest](),
+	sortByJSON[assembler.HasSBOMIngest](),
+	sortByJSON[assembler.CertifyLegalIngest](),
+	sortByJSON[assembler.HasMetadataIngest](),
+	sortByJSON[assembler.VexIngest](),
+	sortByJSON[assembler.CertifyVulnIngest](),
+	sortByJSON[assembler.VulnMetadataIngest](),
+}
+
+func sortByJSON[T any]() cmp.Option {
+	return cmp.Transformer("sortByJSON", func(in []T) []string {
+		var out []string
+		for _, v := range in {
+			b, _ := json.Marshal(v)
+			out = append(out, string(b))
+		}
+		slices.Sort(out)
+		return out
+	})
+}
+
+// MergeChunks merges the chunks emitted by a streaming parser, after
+// sorting the qualifiers of their packages, which PurlToPkg returns in map
+// order, and clearing the HasMetadata timestamps, which are the time of
+// parsing. The predicates of the parser must go through it too, as a single
+// chunk.
+func MergeChunks(chunks ...*assembler.IngestPredicates) *assembler.IngestPredicates {
+	merged := &assembler.IngestPredicates{}
+	for _, c := range chunks {
+		merged.IsDependency = append(merged.IsDependency, c.IsDependency...)
+		merged.IsOccurrence = append(merged.IsOccurrence, c.IsOccurrence...)
+		merged.HasSBOM = append(merged.HasSBOM, c.HasSBOM...)
+		merged.CertifyLegal = append(merged.CertifyLegal, c.CertifyLegal...)
+		merged.HasMetadata = append(merged.HasMetadata, c.HasMetadata...)
+		merged.Vex = append(merged.Vex, c.Vex...)
+		merged.CertifyVuln = append(merged.CertifyVuln, c.CertifyVuln...)
+		merged.VulnMetadata = append(merged.VulnMetadata, c.VulnMetadata...)
+	}
+
+	var pkgs []*generated.PkgInputSpec
+	for _, v := range merged.IsDependency {
+		pkgs = append(pkgs, v.Pkg, v.DepPkg)
+	}
+	for _, v := range merged.IsOccurrence {
+		pkgs = append(pkgs, v.Pkg)
+	}
+	for _, v := range merged.HasSBOM {
+		pkgs = append(pkgs, v.Pkg)
+	}
+	for _, v := range merged.CertifyLegal {
+		pkgs = append(pkgs, v.Pkg)
+	}
+	for _, v := range merged.HasMetadata {
+		pkgs = append(pkgs, v.Pkg)
+		v.HasMetadata.Timestamp = time.Time{}
+	}
+	for _, v := range merged.Vex {
+		pkgs = append(pkgs, v.Pkg)
+	}
+	for _, v := range merged.CertifyVuln {
+		pkgs = append(pkgs, v.Pkg)
+	}
+	for _, pkg := range pkgs {
+		if pkg != nil {
+			slices.SortFunc(pkg.Qualifiers, func(a, b generated.PackageQualifierInputSpec) int {
+				return strings.Compare(a.Key, b.Key)
+			})
+		}
+	}
+	return merged
+}
+
+// LiveHeap returns the size of the live heap, after a garbage collection, for
+// the tests and benchmarks of streaming parsers to measure the memory they
+// hold at once. It collects twice, as the first collection only moves the
+// pooled objects, such as the buffers of encoding/json, to the victim caches
+// of their sync.Pool.
+func LiveHeap() uint64 {
+	runtime.GC()
+	runtime.GC()
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return m.HeapAlloc
+}
+
+// OpenWriter returns the Open function of a processor.Document whose blob is
+// written by write as it is read, so that the tests of streaming parsers can
+// parse documents too large to be held in memory.
+func OpenWriter(write func(w io.Writer) error) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) {
+		r, w := io.Pipe()
+		go func() {
+			w.CloseWithError(write(w))
+		}()
+		return r, nil
+	}
+}
+
+// WrittenSize returns the number of bytes write writes.
+func WrittenSize(write func(w io.Writer) error) (int64, error) {
+	var c countingWriter
+	err := write(&c)
+	return int64(c), err
+}
+
+type countingWriter int64
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	*c += countingWriter(len(p))
+	return len(p), nil
+}
@@ -47,6 +47,10 @@ type IngestPredicates struct {
 	VulnMetadata     []VulnMetadataIngest     `json:"vulnMetadata,omitempty"`
 	HasMetadata      []HasMetadataIngest      `json:"hasMetadata,omitempty"`
 	CertifyLegal     []CertifyLegalIngest     `json:"certifyLegal,omitempty"`
+
+	// Documents records the provenance of the documents the predicates
+	// were parsed from. They are linked to the evidence via DocumentRef.
+	Documents []generated.DocumentInputSpec `json:"documents,omitempty"`
 }
 
 type CertifyScorecardIngest struct {
@@ -198,6 +198,10 @@ func getBackend(ctx context.Context, args backends.BackendArgs) (backends.Backen
 		if err != nil {
 			return nil, fmt.Errorf("failed to get create missing edge collections: %w", err)
 		}
+		err = createMissingVertexCollection(ctx, graph, orphanVertexCollections)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get create missing vertex collections: %w", err)
+		}
 
 		err = compareAndCreateIndexes(ctx, db)
 		if err != nil {
@@ -205,7 +209,7 @@ func getBackend(ctx context.Context, args backends.BackendArgs) (backends.Backen
 		}
 
 	} else {
-		err := createGraph(ctx, db, arangoGraph, edgeDefinitions)
+		err := createGraph(ctx, db, arangoGraph, edgeDefinitions, orphanVertexCollections)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create graph: %w", err)
 		}
@@ -496,9 +500,10 @@ func noMatch(filter *string, value string) bool {
 	return false
 }
 
-func createGraph(ctx context.Context, db driver.Database, graphName string, edgeDefinitions []driver.EdgeDefinition) error {
+func createGraph(ctx context.Context, db driver.Database, graphName string, edgeDefinitions []driver.EdgeDefinition, orphanVertexCollections []string) error {
 	options := &driver.CreateGraphOptions{
-		EdgeDefinitions: edgeDefinitions,
+		EdgeDefinitions:         edgeDefinitions,
+		OrphanVertexCollections: orphanVertexCollections,
 	}
 	_, err := db.CreateGraphV2(ctx, graphName, options)
 	if err != nil {
@@ -547,6 +552,23 @@ func createMissingEdgeCollection(ctx context.Context, graph driver.Graph, edgeDe
 	return nil
 }
 
+func createMissingVertexCollection(ctx context.Context, graph driver.Graph, vertexCollections []string) error {
+	for _, vertexCollection := range vertexCollections {
+		exists, err := graph.VertexCollectionExists(ctx, vertexCollection)
+		if err != nil {
+			return fmt.Errorf("error while checking if vertex collection: %s exists :: %v", vertexCollection, err)
+		}
+
+		if !exists {
+			_, err := graph.CreateVertexCollection(ctx, vertexCollection)
+			if err != nil {
+				return fmt.Errorf("error while creating vertex collection: %s :: %v", vertexCollection, err)
+			}
+		}
+	}
+	return nil
+}
+
 func getCollectionIndexMap() map[string][]index {
 	collectionIndexMap := make(map[string][]index)
 
@@ -606,6 +628,10 @@ func getCollectionIndexMap() map[string][]index {
 		initIndex("byNameGuacKey", []string{"guacKey"}, true),
 	}
 
+	collectionIndexMap[documentsStr] = []index{
+		initIndex("byBlobKey", []string{"blobKey"}, true),
+	}
+
 	collectionIndexMap[isDependenciesStr] = []index{
 		initIndex("byPkgIDDepPkgIDOrigin", []string{"packageID", "depPackageID", "origin", docRef}, false),
 	}
@@ -0,0 +1,327 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// documentEdges maps each evidence collection to the edges between the
+// evidence and its document. The evidence is linked to its document by its
+// documentRef, which is the blobKey of the document.
+var documentEdges = map[string]struct{ toDocument, fromDocument model.Edge }{
+	certifyBadsStr:    {model.EdgeCertifyBadDocument, model.EdgeDocumentCertifyBad},
+	certifyGoodsStr:   {model.EdgeCertifyGoodDocument, model.EdgeDocumentCertifyGood},
+	certifyLegalsStr:  {model.EdgeCertifyLegalDocument, model.EdgeDocumentCertifyLegal},
+	scorecardStr:      {model.EdgeCertifyScorecardDocument, model.EdgeDocumentCertifyScorecard},
+	certifyVEXsStr:    {model.EdgeCertifyVexStatementDocument, model.EdgeDocumentCertifyVexStatement},
+	certifyVulnsStr:   {model.EdgeCertifyVulnDocument, model.EdgeDocumentCertifyVuln},
+	hashEqualsStr:     {model.EdgeHashEqualDocument, model.EdgeDocumentHashEqual},
+	hasMetadataStr:    {model.EdgeHasMetadataDocument, model.EdgeDocumentHasMetadata},
+	hasSBOMsStr:       {model.EdgeHasSbomDocument, model.EdgeDocumentHasSbom},
+	hasSLSAsStr:       {model.EdgeHasSlsaDocument, model.EdgeDocumentHasSlsa},
+	hasSourceAtsStr:   {model.EdgeHasSourceAtDocument, model.EdgeDocumentHasSourceAt},
+	isDependenciesStr: {model.EdgeIsDependencyDocument, model.EdgeDocumentIsDependency},
+	isOccurrencesStr:  {model.EdgeIsOccurrenceDocument, model.EdgeDocumentIsOccurrence},
+	pkgEqualsStr:      {model.EdgePkgEqualDocument, model.EdgeDocumentPkgEqual},
+	pointOfContactStr: {model.EdgePointOfContactDocument, model.EdgeDocumentPointOfContact},
+	vulnEqualsStr:     {model.EdgeVulnEqualDocument, model.EdgeDocumentVulnEqual},
+	vulnMetadataStr:   {model.EdgeVulnMetadataDocument, model.EdgeDocumentVulnMetadata},
+}
+
+// evidenceCollections returns the evidence collections in a stable order.
+func evidenceCollections() []string {
+	colls := make([]string, 0, len(documentEdges))
+	for coll := range documentEdges {
+		colls = append(colls, coll)
+	}
+	slices.Sort(colls)
+	return colls
+}
+
+const documentReturn = `RETURN {
+  "id": doc._id,
+  "blobKey": doc.blobKey,
+  "sha256": doc.sha256,
+  "documentType": doc.documentType,
+  "format": doc.format,
+  "collector": doc.collector,
+  "source": doc.source,
+  "parserVersion": doc.parserVersion,
+  "ingestedAt": doc.ingestedAt
+}`
+
+func (c *arangoClient) DocumentList(ctx context.Context, documentSpec model.DocumentSpec, after *string, first *int) (*model.DocumentConnection, error) {
+	values := map[string]any{}
+	aqb := setDocumentMatchValues(&documentSpec, values)
+	aqb.query.WriteString("\nSORT doc._key")
+	aqb.query.WriteString("\n")
+	aqb.query.WriteString(documentReturn)
+
+	cursor, err := executeQueryWithRetry(ctx, c.db, aqb.string(), values, "DocumentList")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for documents: %w", err)
+	}
+	defer cursor.Close()
+
+	documents, err := getDocuments(ctx, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if after != nil {
+		i := slices.IndexFunc(documents, func(d *model.Document) bool { return d.ID == *after })
+		if i < 0 {
+			return nil, fmt.Errorf("invalid cursor: %s", *after)
+		}
+		documents = documents[i+1:]
+	}
+	totalCount := len(documents)
+	hasNextPage := false
+	if first != nil && len(documents) > *first {
+		documents = documents[:*first]
+		hasNextPage = true
+	}
+	if len(documents) == 0 {
+		return nil, nil
+	}
+
+	edges := make([]*model.DocumentEdge, 0, len(documents))
+	for _, d := range documents {
+		edges = append(edges, &model.DocumentEdge{
+			Cursor: d.ID,
+			Node:   d,
+		})
+	}
+	return &model.DocumentConnection{
+		TotalCount: totalCount,
+		PageInfo: &model.PageInfo{
+			HasNextPage: hasNextPage,
+			StartCursor: ptrfrom.String(edges[0].Node.ID),
+			EndCursor:   ptrfrom.String(edges[len(edges)-1].Node.ID),
+		},
+		Edges: edges,
+	}, nil
+}
+
+func (c *arangoClient) Documents(ctx context.Context, documentSpec *model.DocumentSpec) ([]*model.Document, error) {
+	values := map[string]any{}
+	aqb := setDocumentMatchValues(documentSpec, values)
+	aqb.query.WriteString("\n")
+	aqb.query.WriteString(documentReturn)
+
+	cursor, err := executeQueryWithRetry(ctx, c.db, aqb.string(), values, "Documents")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for documents: %w", err)
+	}
+	defer cursor.Close()
+
+	return getDocuments(ctx, cursor)
+}
+
+func setDocumentMatchValues(documentSpec *model.DocumentSpec, queryValues map[string]any) *arangoQueryBuilder {
+	aqb := newForQuery(documentsStr, "doc")
+	if documentSpec != nil {
+		if documentSpec.ID != nil {
+			aqb.filter("doc", "_id", "==", "@id")
+			queryValues["id"] = *documentSpec.ID
+		}
+		if documentSpec.BlobKey != nil {
+			aqb.filter("doc", "blobKey", "==", "@blobKey")
+			queryValues["blobKey"] = *documentSpec.BlobKey
+		}
+		if documentSpec.Sha256 != nil {
+			aqb.filter("doc", "sha256", "==", "@sha256")
+			queryValues["sha256"] = *documentSpec.Sha256
+		}
+		if documentSpec.DocumentType != nil {
+			aqb.filter("doc", "documentType", "==", "@documentType")
+			queryValues["documentType"] = *documentSpec.DocumentType
+		}
+		if documentSpec.Format != nil {
+			aqb.filter("doc", "format", "==", "@format")
+			queryValues["format"] = *documentSpec.Format
+		}
+		if documentSpec.Collector != nil {
+			aqb.filter("doc", "collector", "==", "@collector")
+			queryValues["collector"] = *documentSpec.Collector
+		}
+		if documentSpec.Source != nil {
+			aqb.filter("doc", "source", "==", "@source")
+			queryValues["source"] = *documentSpec.Source
+		}
+		if documentSpec.ParserVersion != nil {
+			aqb.filter("doc", "parserVersion", "==", "@parserVersion")
+			queryValues["parserVersion"] = *documentSpec.ParserVersion
+		}
+	}
+	return aqb
+}
+
+func getDocumentQueryValues(document *model.DocumentInputSpec) map[string]any {
+	values := map[string]any{}
+	values["blobKey"] = document.BlobKey
+	values["sha256"] = document.Sha256
+	values["documentType"] = document.DocumentType
+	values["format"] = document.Format
+	values["collector"] = document.Collector
+	values["source"] = document.Source
+	values["parserVersion"] = document.ParserVersion
+	values["ingestedAt"] = document.IngestedAt.UTC()
+	return values
+}
+
+func (c *arangoClient) IngestDocuments(ctx context.Context, documents []*model.DocumentInputSpec) ([]string, error) {
+	// Documents are ingested one at a time, so that the same blobKey
+	// appearing twice in the list returns the same ID.
+	var documentIDs []string
+	for _, document := range documents {
+		id, err := c.IngestDocument(ctx, *document)
+		if err != nil {
+			return nil, err
+		}
+		documentIDs = append(documentIDs, id)
+	}
+	return documentIDs, nil
+}
+
+func (c *arangoClient) IngestDocument(ctx context.Context, document model.DocumentInputSpec) (string, error) {
+	query := `
+UPSERT { blobKey:@blobKey }
+INSERT { blobKey:@blobKey, sha256:@sha256, documentType:@documentType, format:@format, collector:@collector, source:@source, parserVersion:@parserVersion, ingestedAt:@ingestedAt }
+UPDATE {} IN documents OPTIONS { indexHint: "byBlobKey" }
+LET doc = NEW
+` + documentReturn
+
+	cursor, err := executeQueryWithRetry(ctx, c.db, query, getDocumentQueryValues(&document), "IngestDocument")
+	if err != nil {
+		return "", fmt.Errorf("failed to ingest document: %w", err)
+	}
+	defer cursor.Close()
+
+	createdDocuments, err := getDocuments(ctx, cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to get documents from arango cursor: %w", err)
+	}
+	if len(createdDocuments) == 1 {
+		return createdDocuments[0].ID, nil
+	} else {
+		return "", fmt.Errorf("number of documents ingested is greater than one")
+	}
+}
+
+func getDocuments(ctx context.Context, cursor driver.Cursor) ([]*model.Document, error) {
+	var documents []*model.Document
+	for {
+		var doc *model.Document
+		_, err := cursor.ReadDocument(ctx, &doc)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			} else {
+				return nil, fmt.Errorf("failed to get document from cursor: %w", err)
+			}
+		} else {
+			documents = append(documents, doc)
+		}
+	}
+	return documents, nil
+}
+
+func (c *arangoClient) getDocumentByID(ctx context.Context, documentID string) (*model.Document, error) {
+	documents, err := c.Documents(ctx, &model.DocumentSpec{ID: &documentID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document by ID for: %s, with error: %w", documentID, err)
+	}
+	if len(documents) != 1 {
+		return nil, fmt.Errorf("number of document nodes found for ID: %s is not one", documentID)
+	}
+	return documents[0], nil
+}
+
+// documentNeighbors returns the evidence ingested from a document.
+func (c *arangoClient) documentNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]string, error) {
+	doc, err := c.getDocumentByID(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	out := []string{}
+	for _, coll := range evidenceCollections() {
+		if !allowedEdges[documentEdges[coll].fromDocument] {
+			continue
+		}
+		values := map[string]any{}
+		arangoQueryBuilder := newForQuery(coll, "evidence")
+		arangoQueryBuilder.filter("evidence", docRef, "==", "@documentRef")
+		values["documentRef"] = doc.BlobKey
+		arangoQueryBuilder.query.WriteString("\nRETURN { neighbor: evidence._id }")
+
+		foundIDs, err := c.getNeighborIDFromCursor(ctx, arangoQueryBuilder, values, "documentNeighbors - "+coll)
+		if err != nil {
+			return out, fmt.Errorf("failed to get neighbors for node ID: %s from arango cursor with error: %w", nodeID, err)
+		}
+		out = append(out, foundIDs...)
+	}
+	return out, nil
+}
+
+// evidenceDocumentNeighbors returns the document an evidence node was
+// ingested from, if that document has been ingested.
+func (c *arangoClient) evidenceDocumentNeighbors(ctx context.Context, coll string, nodeID string, allowedEdges edgeMap) ([]string, error) {
+	edges, ok := documentEdges[coll]
+	if !ok || !allowedEdges[edges.toDocument] {
+		return nil, nil
+	}
+	values := map[string]any{}
+	arangoQueryBuilder := newForQuery(coll, "evidence")
+	arangoQueryBuilder.filter("evidence", "_id", "==", "@id")
+	values["id"] = nodeID
+	arangoQueryBuilder.query.WriteString("\n")
+	arangoQueryBuilder.query.WriteString(fmt.Sprintf("FOR doc IN %s", documentsStr))
+	arangoQueryBuilder.filter("doc", "blobKey", "==", "evidence."+docRef)
+	arangoQueryBuilder.query.WriteString("\nRETURN { neighbor: doc._id }")
+
+	foundIDs, err := c.getNeighborIDFromCursor(ctx, arangoQueryBuilder, values, "evidenceDocumentNeighbors - "+coll)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get neighbors for node ID: %s from arango cursor with error: %w", nodeID, err)
+	}
+	return foundIDs, nil
+}
+
+// evidenceIDsByRef returns the IDs of the evidence ingested from a document.
+func (c *arangoClient) evidenceIDsByRef(ctx context.Context, documentRef string) ([]string, error) {
+	var out []string
+	for _, coll := range evidenceCollections() {
+		values := map[string]any{}
+		arangoQueryBuilder := newForQuery(coll, "evidence")
+		arangoQueryBuilder.filter("evidence", docRef, "==", "@documentRef")
+		values["documentRef"] = documentRef
+		arangoQueryBuilder.query.WriteString("\nRETURN { neighbor: evidence._id }")
+
+		foundIDs, err := c.getNeighborIDFromCursor(ctx, arangoQueryBuilder, values, "evidenceIDsByRef - "+coll)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the evidence of document: %s with error: %w", documentRef, err)
+		}
+		out = append(out, foundIDs...)
+	}
+	return out, nil
+}
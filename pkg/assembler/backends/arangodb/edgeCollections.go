@@ -152,8 +152,16 @@ const (
 	certifyLegalDeclaredLicensesEdgesStr   string = "certifyLegalDeclaredLicensesEdges"
 	certifyLegalDiscoveredLicensesEdgesStr string = "certifyLegalDiscoveredLicensesEdges"
 	certifyLegalsStr                       string = "certifyLegals"
+
+	// documents collection, not linked to the evidence by edges as the
+	// evidence can be ingested before its document
+	documentsStr string = "documents"
 )
 
+// orphanVertexCollections are the vertex collections of the graph that are
+// not part of any edge definition.
+var orphanVertexCollections = []string{documentsStr}
+
 var mapEdgeToArangoEdgeCollection = map[model.Edge][]string{
 	model.EdgeArtifactCertifyBad:               {certifyBadArtEdgesStr},
 	model.EdgeArtifactCertifyGood:              {certifyGoodArtEdgesStr},
@@ -36,6 +36,13 @@ func processUsingOnly(usingOnly []model.Edge) edgeMap {
 	for _, edge := range allowedEdges {
 		m[edge] = true
 	}
+	if len(usingOnly) == 0 {
+		// the documents of the evidence are only neighbors when asked for, so
+		// that the default neighbors of the evidence stay the same
+		for _, e := range documentEdges {
+			delete(m, e.toDocument)
+		}
+	}
 	return m
 }
 
@@ -283,9 +290,19 @@ func (c *arangoClient) Neighbors(ctx context.Context, nodeID string, usingOnly [
 		if err != nil {
 			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
+	case documentsStr:
+		neighborsID, err = c.documentNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
+		if err != nil {
+			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+		}
 	default:
 		return nil, fmt.Errorf("unknown ID for node query: %s", nodeID)
 	}
+	documentIDs, err := c.evidenceDocumentNeighbors(ctx, idSplit[0], nodeID, processUsingOnly(usingOnly))
+	if err != nil {
+		return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+	}
+	neighborsID = append(neighborsID, documentIDs...)
 	return c.Nodes(ctx, neighborsID)
 }
 
@@ -341,6 +358,8 @@ func (c *arangoClient) Node(ctx context.Context, nodeID string) (model.Node, err
 		return c.buildVulnEqualByID(ctx, nodeID, nil)
 	case vulnMetadataStr:
 		return c.buildVulnerabilityMetadataByID(ctx, nodeID, nil)
+	case documentsStr:
+		return c.getDocumentByID(ctx, nodeID)
 	default:
 		return nil, fmt.Errorf("unknown ID for node query: %s", nodeID)
 	}
@@ -0,0 +1,258 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// softwareCollections are the collections of the software tree nodes, which
+// are garbage collected when a document is retracted and no evidence is left
+// referring to them.
+var softwareCollections = map[string]bool{
+	pkgTypesStr:        true,
+	pkgNamespacesStr:   true,
+	pkgNamesStr:        true,
+	pkgVersionsStr:     true,
+	srcTypesStr:        true,
+	srcNamespacesStr:   true,
+	srcNamesStr:        true,
+	artifactsStr:       true,
+	buildersStr:        true,
+	vulnTypesStr:       true,
+	vulnerabilitiesStr: true,
+	licensesStr:        true,
+}
+
+// parentEdgeCollections link the software tree nodes to their parents. A
+// node is not kept by the edge from its parent, but the parent may become an
+// orphan once the node is removed.
+var parentEdgeCollections = map[string]bool{
+	pkgHasNamespaceStr:        true,
+	pkgHasNameStr:             true,
+	pkgHasVersionStr:          true,
+	srcHasNamespaceStr:        true,
+	srcHasNameStr:             true,
+	vulnHasVulnerabilityIDStr: true,
+}
+
+func (c *arangoClient) RetractDocument(ctx context.Context, documentRef string, dryRun bool) (*model.RetractDocumentResult, error) {
+	documents, err := c.Documents(ctx, &model.DocumentSpec{BlobKey: &documentRef})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %s with error: %w", documentRef, err)
+	}
+
+	evidenceIDs, err := c.evidenceIDsByRef(ctx, documentRef)
+	if err != nil {
+		return nil, err
+	}
+	orphanIDs, err := c.retractOrphans(ctx, evidenceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The result is built before anything is removed, as building the model
+	// nodes needs the nodes they link to.
+	result := &model.RetractDocumentResult{
+		DocumentRef: documentRef,
+		DryRun:      dryRun,
+		Evidence:    []model.Node{},
+		Orphans:     []model.Node{},
+	}
+	if len(documents) == 1 {
+		result.Document = documents[0]
+	}
+	evidence, err := c.Nodes(ctx, evidenceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the evidence of document: %s with error: %w", documentRef, err)
+	}
+	result.Evidence = append(result.Evidence, evidence...)
+	orphans, err := c.Nodes(ctx, orphanIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the orphans of document: %s with error: %w", documentRef, err)
+	}
+	result.Orphans = append(result.Orphans, orphans...)
+	if dryRun {
+		return result, nil
+	}
+
+	removed := slices.Concat(evidenceIDs, orphanIDs)
+	if err := c.unlinkIncludedInSBOMs(ctx, removed); err != nil {
+		return nil, err
+	}
+	removed = append(removed, documentIDs(documents)...)
+	if err := c.removeVertices(ctx, removed); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func documentIDs(documents []*model.Document) []string {
+	var ids []string
+	for _, d := range documents {
+		ids = append(ids, d.ID)
+	}
+	return ids
+}
+
+// retractOrphans returns the software tree nodes that would be left without
+// any edge other than the one from their parent once the evidence is
+// removed. It does not modify the database.
+func (c *arangoClient) retractOrphans(ctx context.Context, evidenceIDs []string) ([]string, error) {
+	removed := map[string]bool{}
+	for _, id := range evidenceIDs {
+		removed[id] = true
+	}
+
+	var queue []string
+	for _, id := range evidenceIDs {
+		edges, err := c.vertexEdges(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range edges {
+			queue = append(queue, e.Neighbor)
+		}
+	}
+
+	var orphans []string
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if removed[id] || !softwareCollections[strings.Split(id, "/")[0]] {
+			continue
+		}
+		edges, err := c.vertexEdges(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		orphan := true
+		var parents []string
+		for _, e := range edges {
+			if e.Inbound && parentEdgeCollections[strings.Split(e.Edge, "/")[0]] {
+				parents = append(parents, e.Neighbor)
+				continue
+			}
+			if !removed[e.Neighbor] {
+				orphan = false
+				break
+			}
+		}
+		if !orphan {
+			continue
+		}
+		removed[id] = true
+		orphans = append(orphans, id)
+		// the parent of an orphan may now be an orphan too
+		queue = append(queue, parents...)
+	}
+	return orphans, nil
+}
+
+type vertexEdge struct {
+	Neighbor string `json:"neighbor"`
+	Edge     string `json:"edge"`
+	Inbound  bool   `json:"inbound"`
+}
+
+// vertexEdges returns all the edges of the graph to or from a vertex.
+func (c *arangoClient) vertexEdges(ctx context.Context, id string) ([]vertexEdge, error) {
+	query := `
+FOR v, e IN 1..1 ANY @id GRAPH @graph
+RETURN { "neighbor": v._id, "edge": e._id, "inbound": e._to == @id }`
+
+	values := map[string]any{}
+	values["id"] = id
+	values["graph"] = arangoGraph
+
+	cursor, err := executeQueryWithRetry(ctx, c.db, query, values, "vertexEdges")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the edges of node ID: %s with error: %w", id, err)
+	}
+	defer cursor.Close()
+
+	var edges []vertexEdge
+	for {
+		var e vertexEdge
+		_, err := cursor.ReadDocument(ctx, &e)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			} else {
+				return nil, fmt.Errorf("failed to get edge from cursor: %w", err)
+			}
+		} else {
+			edges = append(edges, e)
+		}
+	}
+	return edges, nil
+}
+
+// unlinkIncludedInSBOMs removes the given IDs from the included software,
+// dependencies and occurrences of the remaining SBOMs. The edges to them are
+// removed with the nodes.
+func (c *arangoClient) unlinkIncludedInSBOMs(ctx context.Context, ids []string) error {
+	query := `
+FOR sbom IN hasSBOMs
+  FILTER sbom._id NOT IN @ids
+  LET software = NOT_NULL(sbom.includedSoftware, [])
+  LET dependencies = NOT_NULL(sbom.includedDependencies, [])
+  LET occurrences = NOT_NULL(sbom.includesOccurrences, [])
+  FILTER LENGTH(INTERSECTION(UNION(software, dependencies, occurrences), @ids)) > 0
+  UPDATE sbom WITH {
+    includedSoftware: software[* FILTER CURRENT NOT IN @ids],
+    includedDependencies: dependencies[* FILTER CURRENT NOT IN @ids],
+    includesOccurrences: occurrences[* FILTER CURRENT NOT IN @ids]
+  } IN hasSBOMs`
+
+	values := map[string]any{}
+	values["ids"] = ids
+
+	cursor, err := executeQueryWithRetry(ctx, c.db, query, values, "unlinkIncludedInSBOMs")
+	if err != nil {
+		return fmt.Errorf("failed to unlink retracted nodes from SBOMs: %w", err)
+	}
+	return cursor.Close()
+}
+
+// removeVertices removes the nodes through the graph, which also removes
+// the edges to and from them.
+func (c *arangoClient) removeVertices(ctx context.Context, ids []string) error {
+	graph, err := c.db.Graph(ctx, arangoGraph)
+	if err != nil {
+		return fmt.Errorf("failed to get graph with error: %w", err)
+	}
+	for _, id := range ids {
+		idSplit := strings.Split(id, "/")
+		if len(idSplit) != 2 {
+			return fmt.Errorf("invalid ID: %s", id)
+		}
+		vertexCollection, err := graph.VertexCollection(ctx, idSplit[0])
+		if err != nil {
+			return fmt.Errorf("failed to get vertex collection: %s with error: %w", idSplit[0], err)
+		}
+		if _, err := vertexCollection.RemoveDocument(ctx, idSplit[1]); err != nil && !driver.IsNotFoundGeneral(err) {
+			return fmt.Errorf("failed to remove node ID: %s with error: %w", id, err)
+		}
+	}
+	return nil
+}
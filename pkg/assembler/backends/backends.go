@@ -78,6 +78,10 @@ type Backend interface {
 	VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error)
 	VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error)
 
+	// Retrieval read-only queries for ingested documents
+	Documents(ctx context.Context, documentSpec *model.DocumentSpec) ([]*model.Document, error)
+	DocumentList(ctx context.Context, documentSpec model.DocumentSpec, after *string, first *int) (*model.DocumentConnection, error)
+
 	// Mutations for software trees (read-write queries)
 	IngestArtifact(ctx context.Context, artifact *model.IDorArtifactInput) (string, error)
 	IngestArtifacts(ctx context.Context, artifacts []*model.IDorArtifactInput) ([]string, error)
@@ -128,8 +132,16 @@ type Backend interface {
 	IngestVulnerabilityMetadata(ctx context.Context, vulnerability model.IDorVulnerabilityInput, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) (string, error)
 	IngestBulkVulnerabilityMetadata(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityMetadataList []*model.VulnerabilityMetadataInputSpec) ([]string, error)
 
+	// Mutations for ingested documents (read-write queries)
+	IngestDocument(ctx context.Context, document model.DocumentInputSpec) (string, error)
+	IngestDocuments(ctx context.Context, documents []*model.DocumentInputSpec) ([]string, error)
+
 	// Delete Node and all relationships attached to it
 	Delete(ctx context.Context, node string) (bool, error)
+	// RetractDocument removes all evidence ingested from the document with
+	// the given blob key, the document itself and the software tree nodes
+	// left orphaned. If dryRun is set, nothing is removed.
+	RetractDocument(ctx context.Context, documentRef string, dryRun bool) (*model.RetractDocumentResult, error)
 
 	// Topological queries: queries where node connectivity matters more than node type
 	Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error)
@@ -17,6 +17,8 @@ type Artifact struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// Algorithm holds the value of the "algorithm" field.
 	Algorithm string `json:"algorithm,omitempty"`
 	// Digest holds the value of the "digest" field.
@@ -174,7 +176,7 @@ func (*Artifact) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case artifact.FieldAlgorithm, artifact.FieldDigest:
+		case artifact.FieldTenant, artifact.FieldAlgorithm, artifact.FieldDigest:
 			values[i] = new(sql.NullString)
 		case artifact.FieldID:
 			values[i] = new(uuid.UUID)
@@ -199,6 +201,12 @@ func (a *Artifact) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				a.ID = *value
 			}
+		case artifact.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				a.Tenant = value.String
+			}
 		case artifact.FieldAlgorithm:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
@@ -302,6 +310,9 @@ func (a *Artifact) String() string {
 	var builder strings.Builder
 	builder.WriteString("Artifact(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(a.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("algorithm=")
 	builder.WriteString(a.Algorithm)
 	builder.WriteString(", ")
@@ -13,6 +13,8 @@ const (
 	Label = "artifact"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldAlgorithm holds the string denoting the algorithm field in the database.
 	FieldAlgorithm = "algorithm"
 	// FieldDigest holds the string denoting the digest field in the database.
@@ -119,6 +121,7 @@ const (
 // Columns holds all SQL columns for artifact fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldAlgorithm,
 	FieldDigest,
 }
@@ -143,6 +146,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -155,6 +160,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByAlgorithm orders the results by the algorithm field.
 func ByAlgorithm(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAlgorithm, opts...).ToFunc()
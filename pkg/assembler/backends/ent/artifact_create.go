@@ -31,6 +31,20 @@ type ArtifactCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (ac *ArtifactCreate) SetTenant(s string) *ArtifactCreate {
+	ac.mutation.SetTenant(s)
+	return ac
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (ac *ArtifactCreate) SetNillableTenant(s *string) *ArtifactCreate {
+	if s != nil {
+		ac.SetTenant(*s)
+	}
+	return ac
+}
+
 // SetAlgorithm sets the "algorithm" field.
 func (ac *ArtifactCreate) SetAlgorithm(s string) *ArtifactCreate {
 	ac.mutation.SetAlgorithm(s)
@@ -257,6 +271,10 @@ func (ac *ArtifactCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ac *ArtifactCreate) defaults() {
+	if _, ok := ac.mutation.Tenant(); !ok {
+		v := artifact.DefaultTenant
+		ac.mutation.SetTenant(v)
+	}
 	if _, ok := ac.mutation.ID(); !ok {
 		v := artifact.DefaultID()
 		ac.mutation.SetID(v)
@@ -265,6 +283,9 @@ func (ac *ArtifactCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ac *ArtifactCreate) check() error {
+	if _, ok := ac.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Artifact.tenant"`)}
+	}
 	if _, ok := ac.mutation.Algorithm(); !ok {
 		return &ValidationError{Name: "algorithm", err: errors.New(`ent: missing required field "Artifact.algorithm"`)}
 	}
@@ -307,6 +328,10 @@ func (ac *ArtifactCreate) createSpec() (*Artifact, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := ac.mutation.Tenant(); ok {
+		_spec.SetField(artifact.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := ac.mutation.Algorithm(); ok {
 		_spec.SetField(artifact.FieldAlgorithm, field.TypeString, value)
 		_node.Algorithm = value
@@ -498,7 +523,7 @@ func (ac *ArtifactCreate) createSpec() (*Artifact, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Artifact.Create().
-//		SetAlgorithm(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -507,7 +532,7 @@ func (ac *ArtifactCreate) createSpec() (*Artifact, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.ArtifactUpsert) {
-//			SetAlgorithm(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (ac *ArtifactCreate) OnConflict(opts ...sql.ConflictOption) *ArtifactUpsertOne {
@@ -584,6 +609,9 @@ func (u *ArtifactUpsertOne) UpdateNewValues() *ArtifactUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(artifact.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(artifact.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -779,7 +807,7 @@ func (acb *ArtifactCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.ArtifactUpsert) {
-//			SetAlgorithm(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (acb *ArtifactCreateBulk) OnConflict(opts ...sql.ConflictOption) *ArtifactUpsertBulk {
@@ -826,6 +854,9 @@ func (u *ArtifactUpsertBulk) UpdateNewValues() *ArtifactUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(artifact.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(artifact.FieldTenant)
+			}
 		}
 	}))
 	return u
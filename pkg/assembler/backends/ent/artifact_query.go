@@ -670,12 +670,12 @@ func (aq *ArtifactQuery) WithIncludedInSboms(opts ...func(*BillOfMaterialsQuery)
 // Example:
 //
 //	var v []struct {
-//		Algorithm string `json:"algorithm,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Artifact.Query().
-//		GroupBy(artifact.FieldAlgorithm).
+//		GroupBy(artifact.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (aq *ArtifactQuery) GroupBy(field string, fields ...string) *ArtifactGroupBy {
@@ -693,11 +693,11 @@ func (aq *ArtifactQuery) GroupBy(field string, fields ...string) *ArtifactGroupB
 // Example:
 //
 //	var v []struct {
-//		Algorithm string `json:"algorithm,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Artifact.Query().
-//		Select(artifact.FieldAlgorithm).
+//		Select(artifact.FieldTenant).
 //		Scan(ctx, &v)
 func (aq *ArtifactQuery) Select(fields ...string) *ArtifactSelect {
 	aq.ctx.Fields = append(aq.ctx.Fields, fields...)
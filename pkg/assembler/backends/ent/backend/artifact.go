@@ -22,7 +22,6 @@ import (
 	"strings"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -102,7 +101,7 @@ func (b *EntBackend) Artifacts(ctx context.Context, artifactSpec *model.Artifact
 	}
 	query := b.client.Artifact.Query().
 		Where(artifactQueryPredicates(artifactSpec))
-	artifacts, err := query.All(ctx)
+	artifacts, err := allWithinLimit(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed artifact query with error: %w", err)
 	}
@@ -164,7 +163,7 @@ func upsertBulkArtifact(ctx context.Context, tx *ent.Tx, artInputs []*model.IDor
 		creates := make([]*ent.ArtifactCreate, len(artifacts))
 		for i, art := range artifacts {
 			artInput := art
-			artifactID := generateUUIDKey([]byte(helpers.GetKey[*model.ArtifactInputSpec, string](artInput.ArtifactInput, helpers.ArtifactServerKey)))
+			artifactID := generateUUIDKey(ctx, []byte(helpers.GetKey[*model.ArtifactInputSpec, string](artInput.ArtifactInput, helpers.ArtifactServerKey)))
 			creates[i] = generateArtifactCreate(tx, &artifactID, artInput)
 
 			ids = append(ids, artifactID.String())
@@ -172,7 +171,7 @@ func upsertBulkArtifact(ctx context.Context, tx *ent.Tx, artInputs []*model.IDor
 
 		err := tx.Artifact.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(artConflictColumns()...),
+				tenantConflictColumns(artConflictColumns()...),
 			).
 			DoNothing().
 			Exec(ctx)
@@ -193,11 +192,11 @@ func generateArtifactCreate(tx *ent.Tx, artifactID *uuid.UUID, art *model.IDorAr
 
 func upsertArtifact(ctx context.Context, tx *ent.Tx, art *model.IDorArtifactInput) (*string, error) {
 
-	artifactID := generateUUIDKey([]byte(helpers.GetKey[*model.ArtifactInputSpec, string](art.ArtifactInput, helpers.ArtifactServerKey)))
+	artifactID := generateUUIDKey(ctx, []byte(helpers.GetKey[*model.ArtifactInputSpec, string](art.ArtifactInput, helpers.ArtifactServerKey)))
 	insert := generateArtifactCreate(tx, &artifactID, art)
 	err := insert.
 		OnConflict(
-			sql.ConflictColumns(artConflictColumns()...),
+			tenantConflictColumns(artConflictColumns()...),
 		).
 		DoNothing().
 		Exec(ctx)
@@ -21,7 +21,6 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -100,7 +99,7 @@ func (b *EntBackend) Builders(ctx context.Context, builderSpec *model.BuilderSpe
 	query := b.client.Builder.Query().
 		Where(builderQueryPredicate(builderSpec))
 
-	builders, err := query.All(ctx)
+	builders, err := allWithinLimit(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed builder query with error: %w", err)
 	}
@@ -162,7 +161,7 @@ func upsertBulkBuilder(ctx context.Context, tx *ent.Tx, buildInputs []*model.IDo
 		creates := make([]*ent.BuilderCreate, len(builders))
 		for i, build := range builders {
 			b := build
-			builderID := generateUUIDKey([]byte(b.BuilderInput.URI))
+			builderID := generateUUIDKey(ctx, []byte(b.BuilderInput.URI))
 			creates[i] = generateBuilderCreate(tx, &builderID, b.BuilderInput)
 
 			ids = append(ids, builderID.String())
@@ -170,7 +169,7 @@ func upsertBulkBuilder(ctx context.Context, tx *ent.Tx, buildInputs []*model.IDo
 
 		err := tx.Builder.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(builder.FieldURI),
+				tenantConflictColumns(builder.FieldURI),
 			).
 			DoNothing().
 			Exec(ctx)
@@ -189,12 +188,12 @@ func generateBuilderCreate(tx *ent.Tx, builderID *uuid.UUID, build *model.Builde
 }
 
 func upsertBuilder(ctx context.Context, tx *ent.Tx, spec *model.BuilderInputSpec) (*string, error) {
-	builderID := generateUUIDKey([]byte(spec.URI))
+	builderID := generateUUIDKey(ctx, []byte(spec.URI))
 	insert := generateBuilderCreate(tx, &builderID, spec)
 
 	err := insert.
 		OnConflict(
-			sql.ConflictColumns(builder.FieldURI),
+			tenantConflictColumns(builder.FieldURI),
 		).
 		DoNothing().
 		Exec(ctx)
@@ -120,8 +120,7 @@ func (b *EntBackend) CertifyBad(ctx context.Context, filter *model.CertifyBadSpe
 	certQuery := b.client.Certification.Query().
 		Where(queryCertifications(certification.TypeBAD, filter))
 
-	records, err := getCertificationObject(certQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getCertificationObject(certQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed certifyBad query with error: %w", err)
 	}
@@ -192,8 +191,7 @@ func (b *EntBackend) CertifyGood(ctx context.Context, filter *model.CertifyGoodS
 	certQuery := b.client.Certification.Query().
 		Where(queryCertifications(certification.TypeGOOD, (*model.CertifyBadSpec)(filter)))
 
-	records, err := getCertificationObject(certQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getCertificationObject(certQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed certifyGood query with error: %w", err)
 	}
@@ -218,6 +216,7 @@ func queryCertifications(typ certification.Type, filter *model.CertifyBadSpec) p
 		optionalPredicate(filter.Origin, certification.OriginEQ),
 		optionalPredicate(filter.Justification, certification.JustificationEQ),
 		optionalPredicate(filter.KnownSince, certification.KnownSinceEQ),
+		optionalPredicate(filter.AsOf, certification.KnownSinceLTE),
 		optionalPredicate(filter.DocumentRef, certification.DocumentRef),
 	}
 
@@ -322,6 +321,8 @@ func certifyConflictColumns() []string {
 }
 
 func upsertCertification[T certificationInputSpec](ctx context.Context, tx *ent.Tx, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, spec T) (*string, error) {
+	// conflictWhere MUST match the IndexWhere() defined on the index we plan to use for this query,
+	// term for term and in the same order, as sqlite does not infer the index otherwise
 	var conflictWhere *sql.Predicate
 
 	conflictColumns := certifyConflictColumns()
@@ -330,38 +331,38 @@ func upsertCertification[T certificationInputSpec](ctx context.Context, tx *ent.
 	case subject.Artifact != nil:
 		conflictColumns = append(conflictColumns, certification.FieldArtifactID)
 		conflictWhere = sql.And(
-			sql.NotNull(certification.FieldArtifactID),
-			sql.IsNull(certification.FieldPackageNameID),
-			sql.IsNull(certification.FieldPackageVersionID),
 			sql.IsNull(certification.FieldSourceID),
+			sql.IsNull(certification.FieldPackageVersionID),
+			sql.IsNull(certification.FieldPackageNameID),
+			sql.NotNull(certification.FieldArtifactID),
 		)
 
 	case subject.Package != nil:
 		if pkgMatchType.Pkg == model.PkgMatchTypeSpecificVersion {
 			conflictColumns = append(conflictColumns, certification.FieldPackageVersionID)
 			conflictWhere = sql.And(
-				sql.IsNull(certification.FieldArtifactID),
+				sql.IsNull(certification.FieldSourceID),
 				sql.NotNull(certification.FieldPackageVersionID),
 				sql.IsNull(certification.FieldPackageNameID),
-				sql.IsNull(certification.FieldSourceID),
+				sql.IsNull(certification.FieldArtifactID),
 			)
 		} else {
 			conflictColumns = append(conflictColumns, certification.FieldPackageNameID)
 			conflictWhere = sql.And(
-				sql.IsNull(certification.FieldArtifactID),
+				sql.IsNull(certification.FieldSourceID),
 				sql.IsNull(certification.FieldPackageVersionID),
 				sql.NotNull(certification.FieldPackageNameID),
-				sql.IsNull(certification.FieldSourceID),
+				sql.IsNull(certification.FieldArtifactID),
 			)
 		}
 
 	case subject.Source != nil:
 		conflictColumns = append(conflictColumns, certification.FieldSourceID)
 		conflictWhere = sql.And(
-			sql.IsNull(certification.FieldArtifactID),
+			sql.NotNull(certification.FieldSourceID),
 			sql.IsNull(certification.FieldPackageVersionID),
 			sql.IsNull(certification.FieldPackageNameID),
-			sql.NotNull(certification.FieldSourceID),
+			sql.IsNull(certification.FieldArtifactID),
 		)
 	}
 
@@ -382,8 +383,12 @@ func upsertCertification[T certificationInputSpec](ctx context.Context, tx *ent.
 		return nil, fmt.Errorf("unknown spec: %+T", v)
 	}
 
+	// the upsert keeps the ID of the node that already exists
+	newID := uuid.New()
+	insert.SetID(newID)
+
 	if id, err := insert.OnConflict(
-		sql.ConflictColumns(conflictColumns...),
+		tenantConflictColumns(conflictColumns...),
 		sql.ConflictWhere(conflictWhere),
 	).
 		Ignore().
@@ -391,6 +396,9 @@ func upsertCertification[T certificationInputSpec](ctx context.Context, tx *ent.
 
 		return nil, errors.Wrap(err, "upsert certify legal node")
 	} else {
+		if _, bad := any(spec).(model.CertifyBadInputSpec); bad && id == newID {
+			reportCreated(ctx, certifyBadGlobalID(id.String()))
+		}
 		return ptrfrom.String(id.String()), nil
 	}
 }
@@ -506,38 +514,38 @@ func upsertBulkCertification[T certificationInputSpec](ctx context.Context, tx *
 	case len(subjects.Artifacts) > 0:
 		conflictColumns = append(conflictColumns, certification.FieldArtifactID)
 		conflictWhere = sql.And(
-			sql.NotNull(certification.FieldArtifactID),
-			sql.IsNull(certification.FieldPackageNameID),
-			sql.IsNull(certification.FieldPackageVersionID),
 			sql.IsNull(certification.FieldSourceID),
+			sql.IsNull(certification.FieldPackageVersionID),
+			sql.IsNull(certification.FieldPackageNameID),
+			sql.NotNull(certification.FieldArtifactID),
 		)
 
 	case len(subjects.Packages) > 0:
 		if pkgMatchType.Pkg == model.PkgMatchTypeSpecificVersion {
 			conflictColumns = append(conflictColumns, certification.FieldPackageVersionID)
 			conflictWhere = sql.And(
-				sql.IsNull(certification.FieldArtifactID),
+				sql.IsNull(certification.FieldSourceID),
 				sql.NotNull(certification.FieldPackageVersionID),
 				sql.IsNull(certification.FieldPackageNameID),
-				sql.IsNull(certification.FieldSourceID),
+				sql.IsNull(certification.FieldArtifactID),
 			)
 		} else {
 			conflictColumns = append(conflictColumns, certification.FieldPackageNameID)
 			conflictWhere = sql.And(
-				sql.IsNull(certification.FieldArtifactID),
+				sql.IsNull(certification.FieldSourceID),
 				sql.IsNull(certification.FieldPackageVersionID),
 				sql.NotNull(certification.FieldPackageNameID),
-				sql.IsNull(certification.FieldSourceID),
+				sql.IsNull(certification.FieldArtifactID),
 			)
 		}
 
 	case len(subjects.Sources) > 0:
 		conflictColumns = append(conflictColumns, certification.FieldSourceID)
 		conflictWhere = sql.And(
-			sql.IsNull(certification.FieldArtifactID),
+			sql.NotNull(certification.FieldSourceID),
 			sql.IsNull(certification.FieldPackageVersionID),
 			sql.IsNull(certification.FieldPackageNameID),
-			sql.NotNull(certification.FieldSourceID),
+			sql.IsNull(certification.FieldArtifactID),
 		)
 	}
 
@@ -548,6 +556,7 @@ func upsertBulkCertification[T certificationInputSpec](ctx context.Context, tx *
 		index := 0
 		for _, certifyBads := range batches {
 			creates := make([]*ent.CertificationCreate, len(certifyBads))
+			newIDs := make([]uuid.UUID, len(certifyBads))
 			for i, cb := range certifyBads {
 				cb := cb
 				var err error
@@ -568,12 +577,14 @@ func upsertBulkCertification[T certificationInputSpec](ctx context.Context, tx *
 						return nil, gqlerror.Errorf("generateCertifyCreate :: %s", err)
 					}
 				}
+				newIDs[i] = uuid.New()
+				creates[i].SetID(newIDs[i])
 				index++
 			}
 
 			err := tx.Certification.CreateBulk(creates...).
 				OnConflict(
-					sql.ConflictColumns(conflictColumns...),
+					tenantConflictColumns(conflictColumns...),
 					sql.ConflictWhere(conflictWhere),
 				).
 				DoNothing().
@@ -581,6 +592,13 @@ func upsertBulkCertification[T certificationInputSpec](ctx context.Context, tx *
 			if err != nil && err != stdsql.ErrNoRows {
 				return nil, errors.Wrap(err, "bulk upsert certifyBad node")
 			}
+
+			// the nodes that already exist are not inserted
+			created, err := tx.Certification.Query().Where(certification.IDIn(newIDs...)).IDs(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "query created certifyBad nodes")
+			}
+			reportCreated(ctx, bulkCertifyBadGlobalID(uuidStrings(created))...)
 		}
 	case []*model.CertifyGoodInputSpec:
 		batches := chunk(certifies, MaxBatchSize)
@@ -612,7 +630,7 @@ func upsertBulkCertification[T certificationInputSpec](ctx context.Context, tx *
 
 			err := tx.Certification.CreateBulk(creates...).
 				OnConflict(
-					sql.ConflictColumns(conflictColumns...),
+					tenantConflictColumns(conflictColumns...),
 					sql.ConflictWhere(conflictWhere),
 				).
 				DoNothing().
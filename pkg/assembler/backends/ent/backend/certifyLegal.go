@@ -102,8 +102,7 @@ func (b *EntBackend) CertifyLegal(ctx context.Context, spec *model.CertifyLegalS
 	certLegalQuery := b.client.CertifyLegal.Query().
 		Where(certifyLegalQuery(*spec))
 
-	records, err := getCertifyLegalObject(certLegalQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getCertifyLegalObject(certLegalQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed certifyLegal query with error: %w", err)
 	}
@@ -182,7 +181,7 @@ func (b *EntBackend) IngestCertifyLegal(ctx context.Context, subject model.Packa
 
 		if id, err := certifyLegalCreate.
 			OnConflict(
-				sql.ConflictColumns(certifyLegalConflictColumns...),
+				tenantConflictColumns(certifyLegalConflictColumns...),
 				sql.ConflictWhere(conflictWhere),
 			).
 			UpdateNewValues().
@@ -293,7 +292,7 @@ func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.Certi
 			pkgVersionID = pv.ID
 		}
 		certifyLegalCreate.SetPackageID(pkgVersionID)
-		certifyLegalID, err := guacCertifyLegalKey(ptrfrom.String(pkgVersionID.String()), nil, sortedDeclaredLicenseHash, sortedDiscoveredLicenseHash, cl)
+		certifyLegalID, err := guacCertifyLegalKey(ctx, ptrfrom.String(pkgVersionID.String()), nil, sortedDeclaredLicenseHash, sortedDiscoveredLicenseHash, cl)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create certifyLegal uuid with error: %w", err)
 		}
@@ -323,7 +322,7 @@ func generateCertifyLegalCreate(ctx context.Context, tx *ent.Tx, cl *model.Certi
 			sourceID = srcID
 		}
 		certifyLegalCreate.SetSourceID(sourceID)
-		certifyLegalID, err := guacCertifyLegalKey(nil, ptrfrom.String(sourceID.String()), sortedDeclaredLicenseHash, sortedDiscoveredLicenseHash, cl)
+		certifyLegalID, err := guacCertifyLegalKey(ctx, nil, ptrfrom.String(sourceID.String()), sortedDeclaredLicenseHash, sortedDiscoveredLicenseHash, cl)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create certifyLegal uuid with error: %w", err)
 		}
@@ -399,7 +398,7 @@ func upsertBulkCertifyLegal(ctx context.Context, tx *ent.Tx, subjects model.Pack
 
 		err := tx.CertifyLegal.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(certifyLegalConflictColumns...),
+				tenantConflictColumns(certifyLegalConflictColumns...),
 				sql.ConflictWhere(conflictWhere),
 			).
 			UpdateNewValues().
@@ -420,6 +419,7 @@ func certifyLegalQuery(filter model.CertifyLegalSpec) predicate.CertifyLegal {
 		optionalPredicate(filter.Attribution, certifylegal.Attribution),
 		optionalPredicate(filter.Justification, certifylegal.JustificationEqualFold),
 		optionalPredicate(filter.TimeScanned, certifylegal.TimeScannedEQ),
+		optionalPredicate(filter.AsOf, certifylegal.TimeScannedLTE),
 		optionalPredicate(filter.Origin, certifylegal.OriginEqualFold),
 		optionalPredicate(filter.Collector, certifylegal.CollectorEqualFold),
 		optionalPredicate(filter.DocumentRef, certifylegal.DocumentRefEQ),
@@ -477,7 +477,7 @@ func canonicalCertifyLegalString(cl *model.CertifyLegalInputSpec) string {
 // when ingesting multiple edges otherwise you get "violates foreign key constraint" as it creates
 // a new ID for certifyLegal node (even when already ingested) that it maps to the edge and fails the look up. This only occurs when using UUID with
 // "Default" func to generate a new UUID
-func guacCertifyLegalKey(pkgVersionID *string, srcNameID *string, declaredLicenseHash, discoveredLicenseHash string, clInput *model.CertifyLegalInputSpec) (*uuid.UUID, error) {
+func guacCertifyLegalKey(ctx context.Context, pkgVersionID *string, srcNameID *string, declaredLicenseHash, discoveredLicenseHash string, clInput *model.CertifyLegalInputSpec) (*uuid.UUID, error) {
 	var subjectID string
 	if pkgVersionID != nil {
 		subjectID = *pkgVersionID
@@ -489,7 +489,7 @@ func guacCertifyLegalKey(pkgVersionID *string, srcNameID *string, declaredLicens
 
 	clIDString := fmt.Sprintf("%s::%s::%s::%s?", subjectID, declaredLicenseHash, discoveredLicenseHash, canonicalCertifyLegalString(clInput))
 
-	clID := generateUUIDKey([]byte(clIDString))
+	clID := generateUUIDKey(ctx, []byte(clIDString))
 	return &clID, nil
 }
 
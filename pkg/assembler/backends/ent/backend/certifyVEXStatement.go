@@ -64,16 +64,10 @@ func (b *EntBackend) IngestVEXStatement(ctx context.Context, subject model.Packa
 
 		if subject.Package != nil {
 			conflictColumns = append(conflictColumns, certifyvex.FieldPackageID)
-			conflictWhere = sql.And(
-				sql.NotNull(certifyvex.FieldPackageID),
-				sql.IsNull(certifyvex.FieldArtifactID),
-			)
+			conflictWhere = sql.IsNull(certifyvex.FieldArtifactID)
 		} else if subject.Artifact != nil {
 			conflictColumns = append(conflictColumns, certifyvex.FieldArtifactID)
-			conflictWhere = sql.And(
-				sql.IsNull(certifyvex.FieldPackageID),
-				sql.NotNull(certifyvex.FieldArtifactID),
-			)
+			conflictWhere = sql.IsNull(certifyvex.FieldPackageID)
 		} else {
 			return nil, Errorf("%v :: %s", funcName, "subject must be either a package or artifact")
 		}
@@ -83,9 +77,13 @@ func (b *EntBackend) IngestVEXStatement(ctx context.Context, subject model.Packa
 			return nil, gqlerror.Errorf("generateVexCreate :: %s", err)
 		}
 
+		// the upsert keeps the ID of the node that already exists
+		newID := uuid.New()
+		insert.SetID(newID)
+
 		if id, err := insert.
 			OnConflict(
-				sql.ConflictColumns(conflictColumns...),
+				tenantConflictColumns(conflictColumns...),
 				sql.ConflictWhere(conflictWhere),
 			).
 			Ignore().
@@ -94,6 +92,9 @@ func (b *EntBackend) IngestVEXStatement(ctx context.Context, subject model.Packa
 			return nil, errors.Wrap(err, "upsert certify vex statement node")
 
 		} else {
+			if id == newID {
+				reportCreated(ctx, certifyVEXGlobalID(id.String()))
+			}
 			return ptrfrom.String(id.String()), nil
 		}
 	})
@@ -215,16 +216,10 @@ func upsertBulkVEX(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArti
 
 	if len(subjects.Packages) > 0 {
 		conflictColumns = append(conflictColumns, certifyvex.FieldPackageID)
-		conflictWhere = sql.And(
-			sql.NotNull(certifyvex.FieldPackageID),
-			sql.IsNull(certifyvex.FieldArtifactID),
-		)
+		conflictWhere = sql.IsNull(certifyvex.FieldArtifactID)
 	} else if len(subjects.Artifacts) > 0 {
 		conflictColumns = append(conflictColumns, certifyvex.FieldArtifactID)
-		conflictWhere = sql.And(
-			sql.IsNull(certifyvex.FieldPackageID),
-			sql.NotNull(certifyvex.FieldArtifactID),
-		)
+		conflictWhere = sql.IsNull(certifyvex.FieldPackageID)
 	}
 
 	batches := chunk(vexStatements, MaxBatchSize)
@@ -232,6 +227,7 @@ func upsertBulkVEX(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArti
 	index := 0
 	for _, vexs := range batches {
 		creates := make([]*ent.CertifyVexCreate, len(vexs))
+		newIDs := make([]uuid.UUID, len(vexs))
 		for i, vex := range vexs {
 			vex := vex
 			var err error
@@ -246,12 +242,14 @@ func upsertBulkVEX(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArti
 					return nil, gqlerror.Errorf("generateVexCreate :: %s", err)
 				}
 			}
+			newIDs[i] = uuid.New()
+			creates[i].SetID(newIDs[i])
 			index++
 		}
 
 		err := tx.CertifyVex.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(conflictColumns...),
+				tenantConflictColumns(conflictColumns...),
 				sql.ConflictWhere(conflictWhere),
 			).
 			DoNothing().
@@ -259,6 +257,13 @@ func upsertBulkVEX(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArti
 		if err != nil && err != stdsql.ErrNoRows {
 			return nil, errors.Wrap(err, "bulk upsert certifyVex node")
 		}
+
+		// the nodes that already exist are not inserted
+		created, err := tx.CertifyVex.Query().Where(certifyvex.IDIn(newIDs...)).IDs(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "query created certifyVex nodes")
+		}
+		reportCreated(ctx, bulkCertifyVEXGlobalID(uuidStrings(created))...)
 	}
 
 	return &ids, nil
@@ -327,8 +332,7 @@ func (b *EntBackend) CertifyVEXStatement(ctx context.Context, spec *model.Certif
 	vexQuery := b.client.CertifyVex.Query().
 		Where(certifyVexPredicate(*spec))
 
-	records, err := getVEXObject(vexQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getVEXObject(vexQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed CertifyVEXStatement query with error: %w", err)
 	}
@@ -368,6 +372,7 @@ func certifyVexPredicate(filter model.CertifyVEXStatementSpec) predicate.Certify
 	predicates := []predicate.CertifyVex{
 		optionalPredicate(filter.ID, IDEQ),
 		optionalPredicate(filter.KnownSince, certifyvex.KnownSinceEQ),
+		optionalPredicate(filter.AsOf, certifyvex.KnownSinceLTE),
 		optionalPredicate(filter.Statement, certifyvex.StatementEQ),
 		optionalPredicate(filter.StatusNotes, certifyvex.StatusNotesEQ),
 		optionalPredicate(filter.Collector, certifyvex.CollectorEQ),
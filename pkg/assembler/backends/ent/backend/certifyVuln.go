@@ -20,7 +20,6 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -81,14 +80,21 @@ func (b *EntBackend) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInp
 			return nil, gqlerror.Errorf("generateCertifyVulnCreate :: %s", err)
 		}
 
+		// the upsert keeps the ID of the node that already exists
+		newID := uuid.New()
+		insert.SetID(newID)
+
 		if id, err := insert.
 			OnConflict(
-				sql.ConflictColumns(conflictColumns...),
+				tenantConflictColumns(conflictColumns...),
 			).
 			UpdateNewValues().
 			ID(ctx); err != nil {
 			return nil, errors.Wrap(err, "upsert certify Vuln statement node")
 		} else {
+			if id == newID {
+				reportCreated(ctx, certifyVulnGlobalID(id.String()))
+			}
 			return ptrfrom.String(id.String()), nil
 		}
 	})
@@ -167,7 +173,7 @@ func generateCertifyVulnCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorP
 	}
 	certifyVulnCreate.SetPackageID(pkgVersionID)
 
-	certifyVulnKey := guacCertifyVulnKey(pkgVersionID.String(), vulnID.String(), certifyVuln)
+	certifyVulnKey := guacCertifyVulnKey(ctx, pkgVersionID.String(), vulnID.String(), certifyVuln)
 
 	if _, exists := seen[certifyVulnKey.String()]; !exists {
 		seen[certifyVulnKey.String()] = true
@@ -195,9 +201,9 @@ func canonicalCertifyVulnString(cv *model.ScanMetadataInput) string {
 
 // guacCertifyVulnKey generates an uuid based on the hash of the inputspec and inputs.
 // This is used to ensure that no duplicates are added into bulk ingestion causing a failure
-func guacCertifyVulnKey(pkgVersionID string, vulnID string, cvInput *model.ScanMetadataInput) uuid.UUID {
+func guacCertifyVulnKey(ctx context.Context, pkgVersionID string, vulnID string, cvInput *model.ScanMetadataInput) uuid.UUID {
 	clIDString := fmt.Sprintf("%s::%s::%s?", pkgVersionID, vulnID, canonicalCertifyVulnString(cvInput))
-	clID := generateUUIDKey([]byte(clIDString))
+	clID := generateUUIDKey(ctx, []byte(clIDString))
 	return clID
 }
 
@@ -211,6 +217,7 @@ func upsertBulkCertifyVuln(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorPk
 	index := 0
 	for _, vulns := range batches {
 		var creates []*ent.CertifyVulnCreate
+		var newIDs []uuid.UUID
 		seen := make(map[string]bool)
 		for _, vuln := range vulns {
 			vuln := vuln
@@ -219,20 +226,28 @@ func upsertBulkCertifyVuln(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorPk
 				return nil, gqlerror.Errorf("generateCertifyVulnCreate :: %s", err)
 			}
 			if cv != nil {
-				creates = append(creates, cv)
+				newIDs = append(newIDs, uuid.New())
+				creates = append(creates, cv.SetID(newIDs[len(newIDs)-1]))
 			}
 			index++
 		}
 
 		err := tx.CertifyVuln.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(conflictColumns...),
+				tenantConflictColumns(conflictColumns...),
 			).
 			UpdateNewValues().
 			Exec(ctx)
 		if err != nil {
 			return nil, errors.Wrap(err, "bulk upsert certifyVuln node")
 		}
+
+		// the upsert keeps the IDs of the nodes that already exist
+		created, err := tx.CertifyVuln.Query().Where(certifyvuln.IDIn(newIDs...)).IDs(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "query created certifyVuln nodes")
+		}
+		reportCreated(ctx, bulkCertifyVulnGlobalID(uuidStrings(created))...)
 	}
 
 	return &ids, nil
@@ -300,8 +315,7 @@ func (b *EntBackend) CertifyVuln(ctx context.Context, spec *model.CertifyVulnSpe
 	certVulnQuery := b.client.CertifyVuln.Query().
 		Where(certifyVulnPredicate(*spec))
 
-	records, err := getCertVulnObject(certVulnQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getCertVulnObject(certVulnQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed certifyVuln query with error: %w", err)
 	}
@@ -319,6 +333,7 @@ func certifyVulnPredicate(spec model.CertifyVulnSpec) predicate.CertifyVuln {
 		optionalPredicate(spec.ScannerURI, certifyvuln.ScannerURIEQ),
 		optionalPredicate(spec.ScannerVersion, certifyvuln.ScannerVersionEQ),
 		optionalPredicate(spec.TimeScanned, certifyvuln.TimeScannedEQ),
+		optionalPredicate(spec.AsOf, certifyvuln.TimeScannedLTE),
 		optionalPredicate(spec.DocumentRef, certifyvuln.DocumentRefEQ),
 	}
 
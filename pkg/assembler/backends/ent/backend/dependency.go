@@ -21,7 +21,6 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -103,8 +102,7 @@ func (b *EntBackend) IsDependency(ctx context.Context, spec *model.IsDependencyS
 	isDepQuery := b.client.Dependency.Query().
 		Where(isDependencyQuery(spec))
 
-	deps, err := getIsDepObject(isDepQuery).
-		All(ctx)
+	deps, err := allWithinLimit(ctx, getIsDepObject(isDepQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed isDependency query with error: %w", err)
 	}
@@ -191,7 +189,7 @@ func upsertBulkDependencies(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorP
 
 		err := tx.Dependency.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(conflictColumns...),
+				tenantConflictColumns(conflictColumns...),
 			).
 			DoNothing().
 			Exec(ctx)
@@ -255,7 +253,7 @@ func generateDependencyCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPk
 	}
 	dependencyCreate.SetDependentPackageVersionID(depPkgVersionID)
 
-	isDependencyID, err := guacDependencyKey(ptrfrom.String(pkgVersionID.String()), ptrfrom.String(depPkgVersionID.String()), *dep)
+	isDependencyID, err := guacDependencyKey(ctx, ptrfrom.String(pkgVersionID.String()), ptrfrom.String(depPkgVersionID.String()), *dep)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create isDependency uuid with error: %w", err)
 	}
@@ -279,7 +277,7 @@ func (b *EntBackend) IngestDependency(ctx context.Context, pkg model.IDorPkgInpu
 
 		if id, err := insert.
 			OnConflict(
-				sql.ConflictColumns(conflictColumns...),
+				tenantConflictColumns(conflictColumns...),
 			).
 			Ignore().
 			ID(ctx); err != nil {
@@ -346,7 +344,7 @@ func canonicalDependencyString(dep model.IsDependencyInputSpec) string {
 	return fmt.Sprintf("%s::%s::%s::%s:%s", dep.DependencyType.String(), dep.Justification, dep.Origin, dep.Collector, dep.DocumentRef)
 }
 
-func guacDependencyKey(pkgVersionID *string, depPkgVersionID *string, dep model.IsDependencyInputSpec) (*uuid.UUID, error) {
+func guacDependencyKey(ctx context.Context, pkgVersionID *string, depPkgVersionID *string, dep model.IsDependencyInputSpec) (*uuid.UUID, error) {
 	if depPkgVersionID == nil {
 		return nil, fmt.Errorf("packageVersion ID not specified in IDorPkgInput")
 	}
@@ -357,7 +355,7 @@ func guacDependencyKey(pkgVersionID *string, depPkgVersionID *string, dep model.
 
 	depIDString := fmt.Sprintf("%s::%s::%s?", *pkgVersionID, *depPkgVersionID, canonicalDependencyString(dep))
 
-	depID := generateUUIDKey([]byte(depIDString))
+	depID := generateUUIDKey(ctx, []byte(depIDString))
 	return &depID, nil
 }
 
@@ -0,0 +1,405 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+
+	"entgo.io/contrib/entgql"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/document"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/slsaattestation"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// documentEdges maps each evidence node type to the edge from the evidence
+// to the document it was ingested from.
+var documentEdges = map[string]model.Edge{
+	certifyBadString:            model.EdgeCertifyBadDocument,
+	certifyGoodString:           model.EdgeCertifyGoodDocument,
+	certifylegal.Table:          model.EdgeCertifyLegalDocument,
+	certifyscorecard.Table:      model.EdgeCertifyScorecardDocument,
+	certifyvex.Table:            model.EdgeCertifyVexStatementDocument,
+	certifyvuln.Table:           model.EdgeCertifyVulnDocument,
+	hashequal.Table:             model.EdgeHashEqualDocument,
+	hasmetadata.Table:           model.EdgeHasMetadataDocument,
+	billofmaterials.Table:       model.EdgeHasSbomDocument,
+	slsaattestation.Table:       model.EdgeHasSlsaDocument,
+	hassourceat.Table:           model.EdgeHasSourceAtDocument,
+	dependency.Table:            model.EdgeIsDependencyDocument,
+	occurrence.Table:            model.EdgeIsOccurrenceDocument,
+	pkgequal.Table:              model.EdgePkgEqualDocument,
+	pointofcontact.Table:        model.EdgePointOfContactDocument,
+	vulnequal.Table:             model.EdgeVulnEqualDocument,
+	vulnerabilitymetadata.Table: model.EdgeVulnMetadataDocument,
+}
+
+func documentGlobalID(id string) string {
+	return toGlobalID(document.Table, id)
+}
+
+func bulkDocumentGlobalID(ids []string) []string {
+	return toGlobalIDs(document.Table, ids)
+}
+
+func (b *EntBackend) IngestDocuments(ctx context.Context, documents []*model.DocumentInputSpec) ([]string, error) {
+	funcName := "IngestDocuments"
+	ids, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*[]string, error) {
+		client := ent.TxFromContext(ctx)
+		slc, err := upsertBulkDocument(ctx, client, documents)
+		if err != nil {
+			return nil, err
+		}
+		return slc, nil
+	})
+	if txErr != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+	}
+
+	return bulkDocumentGlobalID(*ids), nil
+}
+
+func (b *EntBackend) IngestDocument(ctx context.Context, documentInput model.DocumentInputSpec) (string, error) {
+	record, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*string, error) {
+		client := ent.TxFromContext(ctx)
+		documentID, err := upsertDocument(ctx, client, documentInput)
+		if err != nil {
+			return nil, err
+		}
+
+		return documentID, nil
+	})
+	if txErr != nil {
+		return "", txErr
+	}
+
+	return documentGlobalID(*record), nil
+}
+
+func (b *EntBackend) DocumentList(ctx context.Context, spec model.DocumentSpec, after *string, first *int) (*model.DocumentConnection, error) {
+	var afterCursor *entgql.Cursor[uuid.UUID]
+
+	if after != nil {
+		globalID := fromGlobalID(*after)
+		if globalID.nodeType != document.Table {
+			return nil, fmt.Errorf("after cursor is not type document but type: %s", globalID.nodeType)
+		}
+		afterUUID, err := uuid.Parse(globalID.id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse global ID with error: %w", err)
+		}
+		afterCursor = &ent.Cursor{ID: afterUUID}
+	}
+
+	documentConn, err := b.client.Document.Query().
+		Where(documentQuery(spec)).
+		Paginate(ctx, afterCursor, first, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed document query with error: %w", err)
+	}
+
+	// if not found return nil
+	if documentConn == nil || documentConn.PageInfo.StartCursor == nil {
+		return nil, nil
+	}
+
+	var edges []*model.DocumentEdge
+	for _, edge := range documentConn.Edges {
+		edges = append(edges, &model.DocumentEdge{
+			Cursor: documentGlobalID(edge.Cursor.ID.String()),
+			Node:   toModelDocument(edge.Node),
+		})
+	}
+
+	return &model.DocumentConnection{
+		TotalCount: documentConn.TotalCount,
+		PageInfo: &model.PageInfo{
+			HasNextPage: documentConn.PageInfo.HasNextPage,
+			StartCursor: ptrfrom.String(documentGlobalID(documentConn.PageInfo.StartCursor.ID.String())),
+			EndCursor:   ptrfrom.String(documentGlobalID(documentConn.PageInfo.EndCursor.ID.String())),
+		},
+		Edges: edges,
+	}, nil
+}
+
+func (b *EntBackend) Documents(ctx context.Context, filter *model.DocumentSpec) ([]*model.Document, error) {
+	if filter == nil {
+		filter = &model.DocumentSpec{}
+	}
+	records, err := allWithinLimit(ctx, b.client.Document.Query().
+		Where(documentQuery(*filter)))
+	if err != nil {
+		return nil, fmt.Errorf("failed document query with error: %w", err)
+	}
+	return collect(records, toModelDocument), nil
+}
+
+func generateDocumentCreate(tx *ent.Tx, documentID *uuid.UUID, docInput *model.DocumentInputSpec) *ent.DocumentCreate {
+	return tx.Document.Create().
+		SetID(*documentID).
+		SetBlobKey(docInput.BlobKey).
+		SetSha256(docInput.Sha256).
+		SetDocumentType(docInput.DocumentType).
+		SetFormat(docInput.Format).
+		SetCollector(docInput.Collector).
+		SetSource(docInput.Source).
+		SetParserVersion(docInput.ParserVersion).
+		SetIngestedAt(docInput.IngestedAt.UTC())
+}
+
+func upsertBulkDocument(ctx context.Context, tx *ent.Tx, documentInputs []*model.DocumentInputSpec) (*[]string, error) {
+	batches := chunk(documentInputs, MaxBatchSize)
+	ids := make([]string, 0)
+
+	for _, documents := range batches {
+		creates := make([]*ent.DocumentCreate, len(documents))
+		for i, doc := range documents {
+			documentID := generateUUIDKey(ctx, []byte(doc.BlobKey))
+			creates[i] = generateDocumentCreate(tx, &documentID, doc)
+			ids = append(ids, documentID.String())
+		}
+
+		err := tx.Document.CreateBulk(creates...).
+			OnConflict(
+				tenantConflictColumns(document.FieldBlobKey),
+			).
+			DoNothing().
+			Exec(ctx)
+		if err != nil && err != stdsql.ErrNoRows {
+			return nil, errors.Wrap(err, "bulk upsert document node")
+		}
+	}
+
+	return &ids, nil
+}
+
+func upsertDocument(ctx context.Context, tx *ent.Tx, spec model.DocumentInputSpec) (*string, error) {
+	documentID := generateUUIDKey(ctx, []byte(spec.BlobKey))
+	err := generateDocumentCreate(tx, &documentID, &spec).
+		OnConflict(
+			tenantConflictColumns(document.FieldBlobKey),
+		).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && err != stdsql.ErrNoRows {
+		return nil, errors.Wrap(err, "upsert document node")
+	}
+	return ptrfrom.String(documentID.String()), nil
+}
+
+func documentQuery(filter model.DocumentSpec) predicate.Document {
+	return document.And(
+		optionalPredicate(filter.ID, IDEQ),
+		optionalPredicate(filter.BlobKey, document.BlobKeyEQ),
+		optionalPredicate(filter.Sha256, document.Sha256EQ),
+		optionalPredicate(filter.DocumentType, document.DocumentTypeEQ),
+		optionalPredicate(filter.Format, document.FormatEQ),
+		optionalPredicate(filter.Collector, document.CollectorEQ),
+		optionalPredicate(filter.Source, document.SourceEQ),
+		optionalPredicate(filter.ParserVersion, document.ParserVersionEQ),
+	)
+}
+
+func toModelDocument(d *ent.Document) *model.Document {
+	return &model.Document{
+		ID:            documentGlobalID(d.ID.String()),
+		BlobKey:       d.BlobKey,
+		Sha256:        d.Sha256,
+		DocumentType:  d.DocumentType,
+		Format:        d.Format,
+		Collector:     d.Collector,
+		Source:        d.Source,
+		ParserVersion: d.ParserVersion,
+		IngestedAt:    d.IngestedAt,
+	}
+}
+
+// documentNeighbors returns the evidence ingested from a document, found
+// through the documentRef of the evidence.
+func (b *EntBackend) documentNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]model.Node, error) {
+	docs, err := b.Documents(ctx, &model.DocumentSpec{ID: &nodeID})
+	if err != nil {
+		return nil, err
+	}
+	if len(docs) != 1 {
+		return nil, fmt.Errorf("ID returned %d Document nodes %s", len(docs), nodeID)
+	}
+	return b.documentEvidence(ctx, docs[0].BlobKey, allowedEdges)
+}
+
+// documentEvidence returns the evidence whose documentRef is ref, limited to
+// the allowed DOCUMENT_* edges.
+func (b *EntBackend) documentEvidence(ctx context.Context, documentRef string, allowedEdges edgeMap) ([]model.Node, error) {
+	ref := &documentRef
+	queries := []struct {
+		edge  model.Edge
+		query func() ([]model.Node, error)
+	}{
+		{model.EdgeDocumentCertifyBad, func() ([]model.Node, error) {
+			return toNodes(b.CertifyBad(ctx, &model.CertifyBadSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentCertifyGood, func() ([]model.Node, error) {
+			return toNodes(b.CertifyGood(ctx, &model.CertifyGoodSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentCertifyLegal, func() ([]model.Node, error) {
+			return toNodes(b.CertifyLegal(ctx, &model.CertifyLegalSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentCertifyScorecard, func() ([]model.Node, error) {
+			return toNodes(b.Scorecards(ctx, &model.CertifyScorecardSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentCertifyVexStatement, func() ([]model.Node, error) {
+			return toNodes(b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentCertifyVuln, func() ([]model.Node, error) {
+			return toNodes(b.CertifyVuln(ctx, &model.CertifyVulnSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentHashEqual, func() ([]model.Node, error) {
+			return toNodes(b.HashEqual(ctx, &model.HashEqualSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentHasMetadata, func() ([]model.Node, error) {
+			return toNodes(b.HasMetadata(ctx, &model.HasMetadataSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentHasSbom, func() ([]model.Node, error) {
+			return toNodes(b.HasSBOM(ctx, &model.HasSBOMSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentHasSlsa, func() ([]model.Node, error) {
+			return toNodes(b.HasSlsa(ctx, &model.HasSLSASpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentHasSourceAt, func() ([]model.Node, error) {
+			return toNodes(b.HasSourceAt(ctx, &model.HasSourceAtSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentIsDependency, func() ([]model.Node, error) {
+			return toNodes(b.IsDependency(ctx, &model.IsDependencySpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentIsOccurrence, func() ([]model.Node, error) {
+			return toNodes(b.IsOccurrence(ctx, &model.IsOccurrenceSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentPkgEqual, func() ([]model.Node, error) {
+			return toNodes(b.PkgEqual(ctx, &model.PkgEqualSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentPointOfContact, func() ([]model.Node, error) {
+			return toNodes(b.PointOfContact(ctx, &model.PointOfContactSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentVulnEqual, func() ([]model.Node, error) {
+			return toNodes(b.VulnEqual(ctx, &model.VulnEqualSpec{DocumentRef: ref}))
+		}},
+		{model.EdgeDocumentVulnMetadata, func() ([]model.Node, error) {
+			return toNodes(b.VulnerabilityMetadata(ctx, &model.VulnerabilityMetadataSpec{DocumentRef: ref}))
+		}},
+	}
+	var out []model.Node
+	for _, q := range queries {
+		if !allowedEdges[q.edge] {
+			continue
+		}
+		nodes, err := q.query()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s evidence for document %s: %w", q.edge, documentRef, err)
+		}
+		out = append(out, nodes...)
+	}
+	return out, nil
+}
+
+// evidenceDocumentNeighbors returns the document an evidence node was
+// ingested from, if that document has been ingested.
+func (b *EntBackend) evidenceDocumentNeighbors(ctx context.Context, nodeType, nodeID string, allowedEdges edgeMap) ([]model.Node, error) {
+	edge, ok := documentEdges[nodeType]
+	if !ok || !allowedEdges[edge] {
+		return nil, nil
+	}
+	evidence, err := b.Node(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	ref := documentRef(evidence)
+	if ref == "" {
+		return nil, nil
+	}
+	docs, err := b.Documents(ctx, &model.DocumentSpec{BlobKey: &ref})
+	if err != nil {
+		return nil, err
+	}
+	return collect(docs, func(d *model.Document) model.Node { return d }), nil
+}
+
+func documentRef(node model.Node) string {
+	switch v := node.(type) {
+	case *model.CertifyBad:
+		return v.DocumentRef
+	case *model.CertifyGood:
+		return v.DocumentRef
+	case *model.CertifyLegal:
+		return v.DocumentRef
+	case *model.CertifyScorecard:
+		return v.Scorecard.DocumentRef
+	case *model.CertifyVEXStatement:
+		return v.DocumentRef
+	case *model.CertifyVuln:
+		return v.Metadata.DocumentRef
+	case *model.HashEqual:
+		return v.DocumentRef
+	case *model.HasMetadata:
+		return v.DocumentRef
+	case *model.HasSbom:
+		return v.DocumentRef
+	case *model.HasSlsa:
+		return v.Slsa.DocumentRef
+	case *model.HasSourceAt:
+		return v.DocumentRef
+	case *model.IsDependency:
+		return v.DocumentRef
+	case *model.IsOccurrence:
+		return v.DocumentRef
+	case *model.PkgEqual:
+		return v.DocumentRef
+	case *model.PointOfContact:
+		return v.DocumentRef
+	case *model.VulnEqual:
+		return v.DocumentRef
+	case *model.VulnerabilityMetadata:
+		return v.DocumentRef
+	}
+	return ""
+}
+
+func toNodes[T model.Node](items []T, err error) ([]model.Node, error) {
+	if err != nil {
+		return nil, err
+	}
+	return collect(items, func(item T) model.Node { return item }), nil
+}
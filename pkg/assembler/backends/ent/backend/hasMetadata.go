@@ -102,8 +102,7 @@ func (b *EntBackend) HasMetadata(ctx context.Context, filter *model.HasMetadataS
 	hmQuery := b.client.HasMetadata.Query().
 		Where(hasMetadataPredicate(filter))
 
-	records, err := getHasMetadataObject(hmQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getHasMetadataObject(hmQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed hasMetadata query with error: %w", err)
 	}
@@ -157,6 +156,7 @@ func hasMetadataPredicate(filter *model.HasMetadataSpec) predicate.HasMetadata {
 		optionalPredicate(filter.Origin, hasmetadata.OriginEQ),
 		optionalPredicate(filter.Collector, hasmetadata.CollectorEQ),
 		optionalPredicate(filter.DocumentRef, hasmetadata.DocumentRefEQ),
+		optionalPredicate(filter.AsOf, hasmetadata.TimestampLTE),
 	}
 	if filter.Since != nil {
 		timeSince := *filter.Since
@@ -217,38 +217,38 @@ func upsertHasMetadata(ctx context.Context, tx *ent.Tx, subject model.PackageSou
 	case subject.Artifact != nil:
 		conflictColumns = append(conflictColumns, hasmetadata.FieldArtifactID)
 		conflictWhere = sql.And(
-			sql.NotNull(hasmetadata.FieldArtifactID),
-			sql.IsNull(hasmetadata.FieldPackageNameID),
-			sql.IsNull(hasmetadata.FieldPackageVersionID),
 			sql.IsNull(hasmetadata.FieldSourceID),
+			sql.IsNull(hasmetadata.FieldPackageVersionID),
+			sql.IsNull(hasmetadata.FieldPackageNameID),
+			sql.NotNull(hasmetadata.FieldArtifactID),
 		)
 
 	case subject.Package != nil:
 		if pkgMatchType.Pkg == model.PkgMatchTypeSpecificVersion {
 			conflictColumns = append(conflictColumns, hasmetadata.FieldPackageVersionID)
 			conflictWhere = sql.And(
-				sql.IsNull(hasmetadata.FieldArtifactID),
+				sql.IsNull(hasmetadata.FieldSourceID),
 				sql.NotNull(hasmetadata.FieldPackageVersionID),
 				sql.IsNull(hasmetadata.FieldPackageNameID),
-				sql.IsNull(hasmetadata.FieldSourceID),
+				sql.IsNull(hasmetadata.FieldArtifactID),
 			)
 		} else {
 			conflictColumns = append(conflictColumns, hasmetadata.FieldPackageNameID)
 			conflictWhere = sql.And(
-				sql.IsNull(hasmetadata.FieldArtifactID),
+				sql.IsNull(hasmetadata.FieldSourceID),
 				sql.IsNull(hasmetadata.FieldPackageVersionID),
 				sql.NotNull(hasmetadata.FieldPackageNameID),
-				sql.IsNull(hasmetadata.FieldSourceID),
+				sql.IsNull(hasmetadata.FieldArtifactID),
 			)
 		}
 
 	case subject.Source != nil:
 		conflictColumns = append(conflictColumns, hasmetadata.FieldSourceID)
 		conflictWhere = sql.And(
-			sql.IsNull(hasmetadata.FieldArtifactID),
+			sql.NotNull(hasmetadata.FieldSourceID),
 			sql.IsNull(hasmetadata.FieldPackageVersionID),
 			sql.IsNull(hasmetadata.FieldPackageNameID),
-			sql.NotNull(hasmetadata.FieldSourceID),
+			sql.IsNull(hasmetadata.FieldArtifactID),
 		)
 	}
 
@@ -258,7 +258,7 @@ func upsertHasMetadata(ctx context.Context, tx *ent.Tx, subject model.PackageSou
 	}
 
 	if id, err := insert.OnConflict(
-		sql.ConflictColumns(conflictColumns...),
+		tenantConflictColumns(conflictColumns...),
 		sql.ConflictWhere(conflictWhere),
 	).
 		Ignore().
@@ -370,38 +370,38 @@ func upsertBulkHasMetadata(ctx context.Context, tx *ent.Tx, subjects model.Packa
 	case len(subjects.Artifacts) > 0:
 		conflictColumns = append(conflictColumns, hasmetadata.FieldArtifactID)
 		conflictWhere = sql.And(
-			sql.NotNull(hasmetadata.FieldArtifactID),
-			sql.IsNull(hasmetadata.FieldPackageNameID),
-			sql.IsNull(hasmetadata.FieldPackageVersionID),
 			sql.IsNull(hasmetadata.FieldSourceID),
+			sql.IsNull(hasmetadata.FieldPackageVersionID),
+			sql.IsNull(hasmetadata.FieldPackageNameID),
+			sql.NotNull(hasmetadata.FieldArtifactID),
 		)
 
 	case len(subjects.Packages) > 0:
 		if pkgMatchType.Pkg == model.PkgMatchTypeSpecificVersion {
 			conflictColumns = append(conflictColumns, hasmetadata.FieldPackageVersionID)
 			conflictWhere = sql.And(
-				sql.IsNull(hasmetadata.FieldArtifactID),
+				sql.IsNull(hasmetadata.FieldSourceID),
 				sql.NotNull(hasmetadata.FieldPackageVersionID),
 				sql.IsNull(hasmetadata.FieldPackageNameID),
-				sql.IsNull(hasmetadata.FieldSourceID),
+				sql.IsNull(hasmetadata.FieldArtifactID),
 			)
 		} else {
 			conflictColumns = append(conflictColumns, hasmetadata.FieldPackageNameID)
 			conflictWhere = sql.And(
-				sql.IsNull(hasmetadata.FieldArtifactID),
+				sql.IsNull(hasmetadata.FieldSourceID),
 				sql.IsNull(hasmetadata.FieldPackageVersionID),
 				sql.NotNull(hasmetadata.FieldPackageNameID),
-				sql.IsNull(hasmetadata.FieldSourceID),
+				sql.IsNull(hasmetadata.FieldArtifactID),
 			)
 		}
 
 	case len(subjects.Sources) > 0:
 		conflictColumns = append(conflictColumns, hasmetadata.FieldSourceID)
 		conflictWhere = sql.And(
-			sql.IsNull(hasmetadata.FieldArtifactID),
+			sql.NotNull(hasmetadata.FieldSourceID),
 			sql.IsNull(hasmetadata.FieldPackageVersionID),
 			sql.IsNull(hasmetadata.FieldPackageNameID),
-			sql.NotNull(hasmetadata.FieldSourceID),
+			sql.IsNull(hasmetadata.FieldArtifactID),
 		)
 	}
 
@@ -435,7 +435,7 @@ func upsertBulkHasMetadata(ctx context.Context, tx *ent.Tx, subjects model.Packa
 
 		err := tx.HasMetadata.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(conflictColumns...),
+				tenantConflictColumns(conflictColumns...),
 				sql.ConflictWhere(conflictWhere),
 			).
 			DoNothing().
@@ -24,7 +24,6 @@ import (
 	"sort"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -110,8 +109,7 @@ func (b *EntBackend) HashEqual(ctx context.Context, spec *model.HashEqualSpec) (
 	heQuery := b.client.HashEqual.Query().
 		Where(hashEqualQueryPredicates(spec))
 
-	records, err := getHashEqualObject(heQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getHashEqualObject(heQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed hashEqual query with error: %w", err)
 	}
@@ -209,7 +207,7 @@ func upsertBulkHashEqual(ctx context.Context, tx *ent.Tx, artifacts []*model.IDo
 
 		err := tx.HashEqual.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(hasEqualConflictColumns()...),
+				tenantConflictColumns(hasEqualConflictColumns()...),
 			).
 			DoNothing().
 			Exec(ctx)
@@ -274,7 +272,7 @@ func generateHashEqualCreate(ctx context.Context, tx *ent.Tx, artifactA *model.I
 	sortedArtifactHash := hashArtifacts(sortedArtifacts)
 	hashEqualCreate.SetArtifactsHash(sortedArtifactHash)
 
-	hashEqualID, err := guacHashEqualKey(sortedArtifactHash, he)
+	hashEqualID, err := guacHashEqualKey(ctx, sortedArtifactHash, he)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create hashEqual uuid with error: %w", err)
 	}
@@ -292,7 +290,7 @@ func upsertHashEqual(ctx context.Context, tx *ent.Tx, artifactA model.IDorArtifa
 
 	if id, err := hashEqualCreate.
 		OnConflict(
-			sql.ConflictColumns(
+			tenantConflictColumns(
 				hasEqualConflictColumns()...,
 			),
 		).
@@ -341,10 +339,10 @@ func canonicalHashEqualString(he *model.HashEqualInputSpec) string {
 // when ingesting multiple edges otherwise you get "violates foreign key constraint" as it creates
 // a new ID for hashEqual node (even when already ingested) that it maps to the edge and fails the look up. This only occurs when using UUID with
 // "Default" func to generate a new UUID
-func guacHashEqualKey(sortedArtHash string, heInput *model.HashEqualInputSpec) (*uuid.UUID, error) {
+func guacHashEqualKey(ctx context.Context, sortedArtHash string, heInput *model.HashEqualInputSpec) (*uuid.UUID, error) {
 	heIDString := fmt.Sprintf("%s::%s?", sortedArtHash, canonicalHashEqualString(heInput))
 
-	heID := generateUUIDKey([]byte(heIDString))
+	heID := generateUUIDKey(ctx, []byte(heIDString))
 	return &heID, nil
 }
 
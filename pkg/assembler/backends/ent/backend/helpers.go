@@ -16,12 +16,14 @@
 package backend
 
 import (
+	"context"
 	"crypto/sha256"
 	"fmt"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
@@ -168,6 +170,35 @@ func toLowerPtr(s *string) *string {
 	return &lower
 }
 
+// allWithinLimit returns the results of the query, reading at most one more
+// than the result limit of ctx, if any, so that a query over the limit fails
+// without reading all its rows.
+func allWithinLimit[T any, Q interface {
+	Limit(int) Q
+	All(context.Context) ([]T, error)
+}](ctx context.Context, q Q) ([]T, error) {
+	n, ok := backends.ResultLimit(ctx)
+	if !ok {
+		return q.All(ctx)
+	}
+	rows, err := q.Limit(n + 1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) > n {
+		return nil, backends.ErrResultLimit
+	}
+	return rows, nil
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	return strs
+}
+
 func chunk[T any](collection []T, size int) [][]T {
 	if size <= 0 {
 		panic("Second parameter must be greater than 0")
@@ -194,9 +225,23 @@ func chunk[T any](collection []T, size int) [][]T {
 // generateUUIDKey is used to generate the ID based on the sha256 hash of the content of the inputSpec that is passed in.
 // For example, for artifact it would be
 // artifactID := uuid.NewHash(sha256.New(), uuid.NameSpaceDNS, []byte(helpers.GetKey[*model.ArtifactInputSpec, string](artInput.ArtifactInput, helpers.ArtifactServerKey)), 5)
-// where the data is generated by converting the artifactInputSpec into a canonicalized key
-func generateUUIDKey(data []byte) uuid.UUID {
-	return uuid.NewHash(sha256.New(), uuid.NameSpaceDNS, data, 5)
+// where the data is generated by converting the artifactInputSpec into a canonicalized key.
+// The IDs of the nodes of a tenant other than the global tenant are hashed in
+// a namespace of their own, so that tenants get distinct IDs for the same data.
+// The IDs of the shared nouns, which are in the global tenant, are generated
+// with generateSharedUUIDKey.
+func generateUUIDKey(ctx context.Context, data []byte) uuid.UUID {
+	namespace := uuid.NameSpaceDNS
+	if tenant := backends.TenantFromContext(ctx); tenant != backends.GlobalTenant {
+		namespace = uuid.NewHash(sha256.New(), uuid.NameSpaceDNS, []byte(tenant), 5)
+	}
+	return uuid.NewHash(sha256.New(), namespace, data, 5)
+}
+
+// generateSharedUUIDKey is generateUUIDKey for the shared nouns, see
+// sharedNouns, which have the same ID for all the tenants.
+func generateSharedUUIDKey(data []byte) uuid.UUID {
+	return generateUUIDKey(context.Background(), data)
 }
 
 func getIDfromNode(node model.Node) (string, error) {
@@ -265,6 +310,8 @@ func getIDfromNode(node model.Node) (string, error) {
 		return v.ID, nil
 	case *model.VulnerabilityMetadata:
 		return v.ID, nil
+	case *model.Document:
+		return v.ID, nil
 	default:
 		return "", fmt.Errorf("unknown type: %v", v)
 	}
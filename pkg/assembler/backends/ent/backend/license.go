@@ -23,7 +23,6 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -142,9 +141,8 @@ func (b *EntBackend) Licenses(ctx context.Context, filter *model.LicenseSpec) ([
 }
 
 func getLicenses(ctx context.Context, client *ent.Client, filter model.LicenseSpec) ([]*ent.License, error) {
-	results, err := client.License.Query().
-		Where(licenseQuery(filter)).
-		All(ctx)
+	results, err := allWithinLimit(ctx, client.License.Query().
+		Where(licenseQuery(filter)))
 	if err != nil {
 		return nil, fmt.Errorf("failed license query with error: %w", err)
 	}
@@ -159,14 +157,14 @@ func upsertBulkLicense(ctx context.Context, tx *ent.Tx, licenseInputs []*model.I
 		creates := make([]*ent.LicenseCreate, len(licenses))
 		for i, lic := range licenses {
 			l := lic
-			licenseID := generateUUIDKey([]byte(helpers.GetKey[*model.LicenseInputSpec, string](l.LicenseInput, helpers.LicenseServerKey)))
+			licenseID := generateUUIDKey(ctx, []byte(helpers.GetKey[*model.LicenseInputSpec, string](l.LicenseInput, helpers.LicenseServerKey)))
 			creates[i] = generateLicenseCreate(tx, &licenseID, l.LicenseInput)
 			ids = append(ids, licenseID.String())
 		}
 
 		err := tx.License.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(
+				tenantConflictColumns(
 					license.FieldName,
 					license.FieldInlineHash,
 					license.FieldListVersionHash,
@@ -203,11 +201,11 @@ func generateLicenseCreate(tx *ent.Tx, licenseID *uuid.UUID, licInput *model.Lic
 }
 
 func upsertLicense(ctx context.Context, tx *ent.Tx, spec model.LicenseInputSpec) (*string, error) {
-	licenseID := generateUUIDKey([]byte(helpers.GetKey[*model.LicenseInputSpec, string](&spec, helpers.LicenseServerKey)))
+	licenseID := generateUUIDKey(ctx, []byte(helpers.GetKey[*model.LicenseInputSpec, string](&spec, helpers.LicenseServerKey)))
 	insert := generateLicenseCreate(tx, &licenseID, &spec)
 	err := insert.
 		OnConflict(
-			sql.ConflictColumns(
+			tenantConflictColumns(
 				license.FieldName,
 				license.FieldInlineHash,
 				license.FieldListVersionHash,
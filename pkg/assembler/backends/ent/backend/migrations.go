@@ -18,10 +18,13 @@ package backend
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect"
 	dialectsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
 	"github.com/XSAM/otelsql"
 
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -30,6 +33,16 @@ import (
 	"github.com/guacsec/guac/pkg/logging"
 )
 
+// sqliteDefaults are the connection parameters that the ent backend needs
+// from sqlite. Foreign keys are disabled by default in sqlite, and the busy
+// timeout and write-ahead log let concurrent requests wait for each other
+// instead of failing with "database is locked".
+var sqliteDefaults = map[string]string{
+	"_fk":           "1",
+	"_busy_timeout": "10000",
+	"_journal_mode": "WAL",
+}
+
 type BackendOptions struct {
 	DriverName  string
 	Address     string
@@ -41,11 +54,15 @@ type BackendOptions struct {
 }
 
 // GetReadOnlyClient sets up the ent backend and returns a read-only client.
+// Its queries are scoped to the tenant of the context, like those of the
+// backend, so they only see the global tenant unless the context carries
+// another one.
 func GetReadOnlyClient(ctx context.Context, options *BackendOptions) (*ent.Client, error) {
 	client, err := SetupBackend(ctx, options)
 	if err != nil {
 		return nil, err
 	}
+	useTenants(client)
 	// https://entgo.io/docs/hooks/#mutation
 	client.Use(hook.Reject(
 		ent.OpCreate | ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne,
@@ -61,13 +78,24 @@ func SetupBackend(ctx context.Context, options *BackendOptions) (*ent.Client, er
 	if options.DriverName != "" {
 		driver = options.DriverName
 	}
+	address := options.Address
+
+	// a postgres connection string never starts with "file:", so such an
+	// address selects sqlite even with the default driver
+	if driver == dialect.Postgres && strings.HasPrefix(address, "file:") {
+		driver = dialect.SQLite
+	}
 
-	if driver != dialect.Postgres {
-		// TODO: Passively import preferred driver packages for MySQL and Sqlite
-		return nil, fmt.Errorf("only postgres is supported at this time")
+	switch driver {
+	case dialect.Postgres:
+	case dialect.SQLite:
+		address = sqliteAddress(address)
+	default:
+		// TODO: Passively import preferred driver packages for MySQL
+		return nil, fmt.Errorf("only postgres and sqlite3 are supported at this time")
 	}
 
-	db, err := otelsql.Open(driver, options.Address)
+	db, err := otelsql.Open(driver, address)
 	if err != nil {
 		return nil, fmt.Errorf("error opening db: %w", err)
 	}
@@ -83,13 +111,17 @@ func SetupBackend(ctx context.Context, options *BackendOptions) (*ent.Client, er
 	client := ent.NewClient(ent.Driver(dialectsql.OpenDB(driver, db)))
 
 	if options.AutoMigrate {
-		// Run db migrations
-		err = client.Schema.Create(
-			ctx,
-			migrate.WithGlobalUniqueID(true),
+		// Run db migrations. The IDs are UUIDs, and global unique IDs keep
+		// their ranges in sqlite_sequence, which sqlite only creates for
+		// AUTOINCREMENT tables.
+		migrateOpts := []schema.MigrateOption{
 			migrate.WithDropIndex(true),
 			migrate.WithDropColumn(true),
-		)
+		}
+		if driver != dialect.SQLite {
+			migrateOpts = append(migrateOpts, migrate.WithGlobalUniqueID(true))
+		}
+		err = client.Schema.Create(ctx, migrateOpts...)
 		if err != nil {
 			return nil, fmt.Errorf("error creating ent schema: %w", err)
 		}
@@ -101,3 +133,20 @@ func SetupBackend(ctx context.Context, options *BackendOptions) (*ent.Client, er
 
 	return client, nil
 }
+
+// sqliteAddress adds the sqliteDefaults that are not set to a sqlite
+// connection string such as "file:guac.db".
+func sqliteAddress(address string) string {
+	path, rawQuery, _ := strings.Cut(address, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		// let the driver report the malformed address
+		return address
+	}
+	for k, v := range sqliteDefaults {
+		if !query.Has(k) {
+			query.Set(k, v)
+		}
+	}
+	return path + "?" + query.Encode()
+}
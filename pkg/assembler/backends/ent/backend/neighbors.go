@@ -30,6 +30,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/document"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -324,10 +325,19 @@ func (b *EntBackend) Neighbors(ctx context.Context, nodeID string, usingOnly []m
 		if err != nil {
 			return []model.Node{}, fmt.Errorf("failed to get neighbors with id: %s with error: %w", nodeID, err)
 		}
+	case document.Table:
+		neighbors, err = b.documentNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
+		if err != nil {
+			return []model.Node{}, fmt.Errorf("failed to get document neighbors with id: %s with error: %w", nodeID, err)
+		}
 	default:
 		return nil, fmt.Errorf("unknown ID for neighbors query: %s", nodeID)
 	}
-	return neighbors, nil
+	docNeighbors, err := b.evidenceDocumentNeighbors(ctx, foundGlobalID.nodeType, nodeID, processUsingOnly(usingOnly))
+	if err != nil {
+		return []model.Node{}, fmt.Errorf("failed to get document of evidence with id: %s with error: %w", nodeID, err)
+	}
+	return append(neighbors, docNeighbors...), nil
 }
 
 func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error) {
@@ -592,6 +602,15 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 			return nil, fmt.Errorf("ID returned multiple VulnerabilityMetadata nodes %s", foundGlobalID.id)
 		}
 		return vms[0], nil
+	case document.Table:
+		docs, err := b.Documents(ctx, &model.DocumentSpec{ID: ptrfrom.String(foundGlobalID.id)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query for Document via ID: %s, with error: %w", foundGlobalID.id, err)
+		}
+		if len(docs) != 1 {
+			return nil, fmt.Errorf("ID returned multiple Document nodes %s", foundGlobalID.id)
+		}
+		return docs[0], nil
 	default:
 		log.Printf("Unknown node type: %s", foundGlobalID.nodeType)
 	}
@@ -621,5 +640,12 @@ func processUsingOnly(usingOnly []model.Edge) edgeMap {
 	for _, edge := range allowedEdges {
 		m[edge] = true
 	}
+	if len(usingOnly) == 0 {
+		// the documents of the evidence are only neighbors when asked for, so
+		// that the default neighbors of the evidence stay the same
+		for _, e := range documentEdges {
+			delete(m, e)
+		}
+	}
 	return m
 }
@@ -102,8 +102,7 @@ func (b *EntBackend) IsOccurrence(ctx context.Context, query *model.IsOccurrence
 	occurQuery := b.client.Occurrence.Query().
 		Where(isOccurrenceQuery(query))
 
-	records, err := getOccurrenceObject(occurQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getOccurrenceObject(occurQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed isOccurrence query with error: %w", err)
 	}
@@ -224,7 +223,7 @@ func upsertBulkOccurrences(ctx context.Context, tx *ent.Tx, subjects model.Packa
 
 		err := tx.Occurrence.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(occurrenceConflictColumns...),
+				tenantConflictColumns(occurrenceConflictColumns...),
 				sql.ConflictWhere(conflictWhere),
 			).
 			DoNothing().
@@ -287,7 +286,7 @@ func generateOccurrenceCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPk
 		occurrenceCreate.SetPackageID(pkgVersionID)
 
 		var err error
-		isOccurrenceID, err = guacOccurrenceKey(ptrfrom.String(pkgVersionID.String()), nil, ptrfrom.String(artID.String()), *occur)
+		isOccurrenceID, err = guacOccurrenceKey(ctx, ptrfrom.String(pkgVersionID.String()), nil, ptrfrom.String(artID.String()), *occur)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to create occurrence uuid with error: %w", err)
 		}
@@ -311,7 +310,7 @@ func generateOccurrenceCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPk
 		occurrenceCreate.SetSourceID(sourceID)
 
 		var err error
-		isOccurrenceID, err = guacOccurrenceKey(nil, ptrfrom.String(sourceID.String()), ptrfrom.String(artID.String()), *occur)
+		isOccurrenceID, err = guacOccurrenceKey(ctx, nil, ptrfrom.String(sourceID.String()), ptrfrom.String(artID.String()), *occur)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to create occurrence uuid with error: %w", err)
 		}
@@ -360,7 +359,7 @@ func (b *EntBackend) IngestOccurrence(ctx context.Context,
 
 		if id, err := insert.
 			OnConflict(
-				sql.ConflictColumns(occurrenceConflictColumns...),
+				tenantConflictColumns(occurrenceConflictColumns...),
 				sql.ConflictWhere(conflictWhere),
 			).
 			Ignore().
@@ -429,7 +428,7 @@ func canonicalOccurrenceString(occur model.IsOccurrenceInputSpec) string {
 	return fmt.Sprintf("%s::%s::%s:%s", occur.Justification, occur.Origin, occur.Collector, occur.DocumentRef)
 }
 
-func guacOccurrenceKey(pkgVersionID *string, srcNameID *string, artID *string, occur model.IsOccurrenceInputSpec) (*uuid.UUID, error) {
+func guacOccurrenceKey(ctx context.Context, pkgVersionID *string, srcNameID *string, artID *string, occur model.IsOccurrenceInputSpec) (*uuid.UUID, error) {
 	var subjectID string
 	if pkgVersionID != nil {
 		subjectID = *pkgVersionID
@@ -445,7 +444,7 @@ func guacOccurrenceKey(pkgVersionID *string, srcNameID *string, artID *string, o
 
 	occurIDString := fmt.Sprintf("%s::%s::%s?", subjectID, *artID, canonicalOccurrenceString(occur))
 
-	occurID := generateUUIDKey([]byte(occurIDString))
+	occurID := generateUUIDKey(ctx, []byte(occurIDString))
 	return &occurID, nil
 }
 
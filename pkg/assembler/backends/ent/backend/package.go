@@ -25,7 +25,6 @@ import (
 	"strings"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -120,10 +119,9 @@ func (b *EntBackend) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*m
 		pkgSpec = &model.PkgSpec{}
 	}
 
-	pkgs, err := b.client.PackageVersion.Query().
+	pkgs, err := allWithinLimit(ctx, b.client.PackageVersion.Query().
 		Where(packageQueryPredicates(pkgSpec)).
-		WithName(func(q *ent.PackageNameQuery) {}).
-		All(ctx)
+		WithName(func(q *ent.PackageNameQuery) {}))
 	if err != nil {
 		return nil, fmt.Errorf("failed package query with error: %w", err)
 	}
@@ -221,8 +219,8 @@ func upsertBulkPackage(ctx context.Context, tx *ent.Tx, pkgInputs []*model.IDorP
 		for i, pkg := range pkgs {
 			pkgInput := pkg
 			pkgIDs := helpers.GetKey[*model.PkgInputSpec, helpers.PkgIds](pkgInput.PackageInput, helpers.PkgServerKey)
-			pkgNameID := generateUUIDKey([]byte(pkgIDs.NameId))
-			pkgVersionID := generateUUIDKey([]byte(pkgIDs.VersionId))
+			pkgNameID := generateSharedUUIDKey([]byte(pkgIDs.NameId))
+			pkgVersionID := generateSharedUUIDKey([]byte(pkgIDs.VersionId))
 
 			// Deduplicate PackageName creates within the batch to avoid
 			// "ON CONFLICT DO UPDATE command cannot affect row a second time"
@@ -241,7 +239,7 @@ func upsertBulkPackage(ctx context.Context, tx *ent.Tx, pkgInputs []*model.IDorP
 
 		if err := tx.PackageName.CreateBulk(pkgNameCreates...).
 			OnConflict(
-				sql.ConflictColumns(packagename.FieldName, packagename.FieldNamespace, packagename.FieldType),
+				tenantConflictColumns(packagename.FieldName, packagename.FieldNamespace, packagename.FieldType),
 			).
 			UpdateNewValues().
 			Exec(ctx); err != nil {
@@ -251,7 +249,7 @@ func upsertBulkPackage(ctx context.Context, tx *ent.Tx, pkgInputs []*model.IDorP
 
 		if err := tx.PackageVersion.CreateBulk(pkgVersionCreates...).
 			OnConflict(
-				sql.ConflictColumns(
+				tenantConflictColumns(
 					packageversion.FieldHash,
 					packageversion.FieldNameID,
 				),
@@ -278,13 +276,13 @@ func upsertBulkPackage(ctx context.Context, tx *ent.Tx, pkgInputs []*model.IDorP
 // It is used in multiple places, so we extract it to a function.
 func upsertPackage(ctx context.Context, tx *ent.Tx, pkg model.IDorPkgInput) (*model.PackageIDs, error) {
 	pkgIDs := helpers.GetKey[*model.PkgInputSpec, helpers.PkgIds](pkg.PackageInput, helpers.PkgServerKey)
-	pkgNameID := generateUUIDKey([]byte(pkgIDs.NameId))
-	pkgVersionID := generateUUIDKey([]byte(pkgIDs.VersionId))
+	pkgNameID := generateSharedUUIDKey([]byte(pkgIDs.NameId))
+	pkgVersionID := generateSharedUUIDKey([]byte(pkgIDs.VersionId))
 
 	pkgNameCreate := generatePackageNameCreate(tx, &pkgNameID, &pkg)
 
 	if err := pkgNameCreate.
-		OnConflict(sql.ConflictColumns(packagename.FieldName, packagename.FieldNamespace, packagename.FieldType)).
+		OnConflict(tenantConflictColumns(packagename.FieldName, packagename.FieldNamespace, packagename.FieldType)).
 		UpdateNewValues().
 		Exec(ctx); err != nil {
 
@@ -295,7 +293,7 @@ func upsertPackage(ctx context.Context, tx *ent.Tx, pkg model.IDorPkgInput) (*mo
 
 	if err := pkgVersionCreate.
 		OnConflict(
-			sql.ConflictColumns(
+			tenantConflictColumns(
 				packageversion.FieldHash,
 				packageversion.FieldNameID,
 			),
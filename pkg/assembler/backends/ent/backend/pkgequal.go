@@ -24,7 +24,6 @@ import (
 	"sort"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -109,8 +108,7 @@ func (b *EntBackend) PkgEqual(ctx context.Context, spec *model.PkgEqualSpec) ([]
 	peQuery := b.client.PkgEqual.Query().
 		Where(pkgEqualQueryPredicates(spec))
 
-	records, err := getPkgEqualObject(peQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getPkgEqualObject(peQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed pkgEqual query with error: %w", err)
 	}
@@ -186,7 +184,7 @@ func upsertBulkPkgEquals(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorPkgI
 
 		err := tx.PkgEqual.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(pkgEqualConflictColumns()...),
+				tenantConflictColumns(pkgEqualConflictColumns()...),
 			).
 			DoNothing().
 			Exec(ctx)
@@ -252,7 +250,7 @@ func generatePkgEqualCreate(ctx context.Context, tx *ent.Tx, pkgA *model.IDorPkg
 
 	pkgEqalCreate.SetPackagesHash(sortedPkgHash)
 
-	pkgEqualID, err := guacPkgEqualKey(sortedPkgHash, pkgEqualInput)
+	pkgEqualID, err := guacPkgEqualKey(ctx, sortedPkgHash, pkgEqualInput)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pkgEqual uuid with error: %w", err)
 	}
@@ -269,7 +267,7 @@ func upsertPackageEqual(ctx context.Context, tx *ent.Tx, pkgA model.IDorPkgInput
 	}
 	if id, err := pkgEqualCreate.
 		OnConflict(
-			sql.ConflictColumns(pkgEqualConflictColumns()...),
+			tenantConflictColumns(pkgEqualConflictColumns()...),
 		).
 		Ignore().
 		ID(ctx); err != nil {
@@ -337,10 +335,10 @@ func canonicalPkgEqualString(pe *model.PkgEqualInputSpec) string {
 // when ingesting multiple edges otherwise you get "violates foreign key constraint" as it creates
 // a new ID for pkgEqual node (even when already ingested) that it maps to the edge and fails the look up. This only occurs when using UUID with
 // "Default" func to generate a new UUID
-func guacPkgEqualKey(sortedPkgHash string, peInput *model.PkgEqualInputSpec) (*uuid.UUID, error) {
+func guacPkgEqualKey(ctx context.Context, sortedPkgHash string, peInput *model.PkgEqualInputSpec) (*uuid.UUID, error) {
 	peIDString := fmt.Sprintf("%s::%s?", sortedPkgHash, canonicalPkgEqualString(peInput))
 
-	peID := generateUUIDKey([]byte(peIDString))
+	peID := generateUUIDKey(ctx, []byte(peIDString))
 	return &peID, nil
 }
 
@@ -102,8 +102,7 @@ func (b *EntBackend) PointOfContact(ctx context.Context, filter *model.PointOfCo
 	pocQuery := b.client.PointOfContact.Query().
 		Where(pointOfContactPredicate(filter))
 
-	records, err := getPointOfContactObject(pocQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getPointOfContactObject(pocQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed PointOfContact query with error: %w", err)
 	}
@@ -154,6 +153,7 @@ func pointOfContactPredicate(filter *model.PointOfContactSpec) predicate.PointOf
 		optionalPredicate(filter.Email, pointofcontact.EmailEqualFold),
 		optionalPredicate(filter.Info, pointofcontact.InfoEqualFold),
 		optionalPredicate(filter.Since, pointofcontact.SinceGTE),
+		optionalPredicate(filter.AsOf, pointofcontact.SinceLTE),
 		optionalPredicate(filter.Justification, pointofcontact.JustificationEQ),
 		optionalPredicate(filter.Origin, pointofcontact.OriginEQ),
 		optionalPredicate(filter.Collector, pointofcontact.CollectorEQ),
@@ -214,36 +214,36 @@ func upsertBulkPointOfContact(ctx context.Context, tx *ent.Tx, subjects model.Pa
 	case len(subjects.Artifacts) > 0:
 		conflictColumns = append(conflictColumns, pointofcontact.FieldArtifactID)
 		conflictWhere = sql.And(
-			sql.NotNull(pointofcontact.FieldArtifactID),
-			sql.IsNull(pointofcontact.FieldPackageNameID),
-			sql.IsNull(pointofcontact.FieldPackageVersionID),
 			sql.IsNull(pointofcontact.FieldSourceID),
+			sql.IsNull(pointofcontact.FieldPackageVersionID),
+			sql.IsNull(pointofcontact.FieldPackageNameID),
+			sql.NotNull(pointofcontact.FieldArtifactID),
 		)
 	case len(subjects.Packages) > 0:
 		if pkgMatchType.Pkg == model.PkgMatchTypeSpecificVersion {
 			conflictColumns = append(conflictColumns, pointofcontact.FieldPackageVersionID)
 			conflictWhere = sql.And(
-				sql.IsNull(pointofcontact.FieldArtifactID),
+				sql.IsNull(pointofcontact.FieldSourceID),
 				sql.NotNull(pointofcontact.FieldPackageVersionID),
 				sql.IsNull(pointofcontact.FieldPackageNameID),
-				sql.IsNull(pointofcontact.FieldSourceID),
+				sql.IsNull(pointofcontact.FieldArtifactID),
 			)
 		} else {
 			conflictColumns = append(conflictColumns, pointofcontact.FieldPackageNameID)
 			conflictWhere = sql.And(
-				sql.IsNull(pointofcontact.FieldArtifactID),
+				sql.IsNull(pointofcontact.FieldSourceID),
 				sql.IsNull(pointofcontact.FieldPackageVersionID),
 				sql.NotNull(pointofcontact.FieldPackageNameID),
-				sql.IsNull(pointofcontact.FieldSourceID),
+				sql.IsNull(pointofcontact.FieldArtifactID),
 			)
 		}
 	case len(subjects.Sources) > 0:
 		conflictColumns = append(conflictColumns, pointofcontact.FieldSourceID)
 		conflictWhere = sql.And(
-			sql.IsNull(pointofcontact.FieldArtifactID),
+			sql.NotNull(pointofcontact.FieldSourceID),
 			sql.IsNull(pointofcontact.FieldPackageVersionID),
 			sql.IsNull(pointofcontact.FieldPackageNameID),
-			sql.NotNull(pointofcontact.FieldSourceID),
+			sql.IsNull(pointofcontact.FieldArtifactID),
 		)
 	}
 
@@ -278,7 +278,7 @@ func upsertBulkPointOfContact(ctx context.Context, tx *ent.Tx, subjects model.Pa
 
 		err := tx.PointOfContact.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(conflictColumns...),
+				tenantConflictColumns(conflictColumns...),
 				sql.ConflictWhere(conflictWhere),
 			).
 			DoNothing().
@@ -390,37 +390,37 @@ func upsertPointOfContact(ctx context.Context, tx *ent.Tx, subject model.Package
 	case subject.Artifact != nil:
 		conflictColumns = append(conflictColumns, pointofcontact.FieldArtifactID)
 		conflictWhere = sql.And(
-			sql.NotNull(pointofcontact.FieldArtifactID),
-			sql.IsNull(pointofcontact.FieldPackageNameID),
-			sql.IsNull(pointofcontact.FieldPackageVersionID),
 			sql.IsNull(pointofcontact.FieldSourceID),
+			sql.IsNull(pointofcontact.FieldPackageVersionID),
+			sql.IsNull(pointofcontact.FieldPackageNameID),
+			sql.NotNull(pointofcontact.FieldArtifactID),
 		)
 
 	case subject.Package != nil:
 		if pkgMatchType.Pkg == model.PkgMatchTypeSpecificVersion {
 			conflictColumns = append(conflictColumns, pointofcontact.FieldPackageVersionID)
 			conflictWhere = sql.And(
-				sql.IsNull(pointofcontact.FieldArtifactID),
+				sql.IsNull(pointofcontact.FieldSourceID),
 				sql.NotNull(pointofcontact.FieldPackageVersionID),
 				sql.IsNull(pointofcontact.FieldPackageNameID),
-				sql.IsNull(pointofcontact.FieldSourceID),
+				sql.IsNull(pointofcontact.FieldArtifactID),
 			)
 		} else {
 			conflictColumns = append(conflictColumns, pointofcontact.FieldPackageNameID)
 			conflictWhere = sql.And(
-				sql.IsNull(pointofcontact.FieldArtifactID),
+				sql.IsNull(pointofcontact.FieldSourceID),
 				sql.IsNull(pointofcontact.FieldPackageVersionID),
 				sql.NotNull(pointofcontact.FieldPackageNameID),
-				sql.IsNull(pointofcontact.FieldSourceID),
+				sql.IsNull(pointofcontact.FieldArtifactID),
 			)
 		}
 	case subject.Source != nil:
 		conflictColumns = append(conflictColumns, pointofcontact.FieldSourceID)
 		conflictWhere = sql.And(
-			sql.IsNull(pointofcontact.FieldArtifactID),
+			sql.NotNull(pointofcontact.FieldSourceID),
 			sql.IsNull(pointofcontact.FieldPackageVersionID),
 			sql.IsNull(pointofcontact.FieldPackageNameID),
-			sql.NotNull(pointofcontact.FieldSourceID),
+			sql.IsNull(pointofcontact.FieldArtifactID),
 		)
 	}
 
@@ -429,7 +429,7 @@ func upsertPointOfContact(ctx context.Context, tx *ent.Tx, subject model.Package
 		return nil, gqlerror.Errorf("generatePointOfContactCreate :: %s", err)
 	}
 	if id, err := insert.OnConflict(
-		sql.ConflictColumns(conflictColumns...),
+		tenantConflictColumns(conflictColumns...),
 		sql.ConflictWhere(conflictWhere),
 	).
 		Ignore().
@@ -0,0 +1,355 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/document"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/license"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/slsaattestation"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errRetractDryRun rolls back the retract transaction once the orphans of a
+// dry run have been found.
+var errRetractDryRun = errors.New("retract dry run")
+
+func (b *EntBackend) RetractDocument(ctx context.Context, documentRef string, dryRun bool) (*model.RetractDocumentResult, error) {
+	funcName := "RetractDocument"
+	evidence, err := b.documentEvidence(ctx, documentRef, processUsingOnly(nil))
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+	docs, err := b.Documents(ctx, &model.DocumentSpec{BlobKey: &documentRef})
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+
+	var orphans []model.Node
+	_, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*string, error) {
+		tx := ent.TxFromContext(ctx)
+		var err error
+		orphans, err = retractDocument(ctx, tx, documentRef)
+		if err != nil {
+			return nil, err
+		}
+		if dryRun {
+			return nil, errRetractDryRun
+		}
+		return nil, nil
+	})
+	if txErr != nil && !errors.Is(txErr, errRetractDryRun) {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+	}
+
+	result := &model.RetractDocumentResult{
+		DocumentRef: documentRef,
+		DryRun:      dryRun,
+		Evidence:    append([]model.Node{}, evidence...),
+		Orphans:     append([]model.Node{}, orphans...),
+	}
+	if len(docs) == 1 {
+		result.Document = docs[0]
+	}
+	return result, nil
+}
+
+// retractDocument deletes the evidence with the given documentRef and the
+// software tree nodes that no remaining evidence refers to, and returns the
+// deleted software nodes.
+func retractDocument(ctx context.Context, tx *ent.Tx, documentRef string) ([]model.Node, error) {
+	ref := &documentRef
+
+	// find the software nodes referenced by the evidence before it is gone
+	pkgVersionIDs, err := tx.PackageVersion.Query().Where(packageVersionEvidence(ref)).IDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query package versions")
+	}
+	pkgNameIDs, err := tx.PackageName.Query().Where(packageNameEvidence(ref)).IDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query package names")
+	}
+	srcNameIDs, err := tx.SourceName.Query().Where(sourceNameEvidence(ref)).IDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query source names")
+	}
+	artifactIDs, err := tx.Artifact.Query().Where(artifactEvidence(ref)).IDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query artifacts")
+	}
+	builderIDs, err := tx.Builder.Query().Where(builderEvidence(ref)).IDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query builders")
+	}
+	vulnIDs, err := tx.VulnerabilityID.Query().Where(vulnerabilityEvidence(ref)).IDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query vulnerabilities")
+	}
+	licenseIDs, err := tx.License.Query().Where(licenseEvidence(ref)).IDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query licenses")
+	}
+
+	if err := deleteDocumentEvidence(ctx, tx, documentRef); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Document.Delete().Where(document.BlobKeyEQ(documentRef)).Exec(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to delete document")
+	}
+
+	var orphans []model.Node
+
+	orphanPkgVersions := packageversion.And(packageversion.IDIn(pkgVersionIDs...), packageversion.Not(packageVersionEvidence(nil)))
+	pkgVersions, err := tx.PackageVersion.Query().
+		Where(orphanPkgVersions).
+		WithName(func(q *ent.PackageNameQuery) {}).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query orphaned package versions")
+	}
+	for _, pv := range pkgVersions {
+		orphans = append(orphans, toModelPackage(backReferencePackageVersion(pv)))
+		// a package name loses a version, so it may be orphaned too
+		pkgNameIDs = append(pkgNameIDs, pv.NameID)
+	}
+	if _, err := tx.PackageVersion.Delete().Where(orphanPkgVersions).Exec(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to delete orphaned package versions")
+	}
+
+	orphanPkgNames := packagename.And(packagename.IDIn(pkgNameIDs...), packagename.Not(packageNameEvidence(nil)), packagename.Not(packagename.HasVersions()))
+	pkgNames, err := tx.PackageName.Query().
+		Where(orphanPkgNames).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query orphaned package names")
+	}
+	for _, pn := range pkgNames {
+		orphans = append(orphans, toModelPackage(backReferencePackageName(pn)))
+	}
+	if _, err := tx.PackageName.Delete().Where(orphanPkgNames).Exec(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to delete orphaned package names")
+	}
+
+	orphanSrcNames := sourcename.And(sourcename.IDIn(srcNameIDs...), sourcename.Not(sourceNameEvidence(nil)))
+	srcNames, err := tx.SourceName.Query().
+		Where(orphanSrcNames).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query orphaned source names")
+	}
+	for _, sn := range srcNames {
+		orphans = append(orphans, toModelSource(sn))
+	}
+	if _, err := tx.SourceName.Delete().Where(orphanSrcNames).Exec(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to delete orphaned source names")
+	}
+
+	orphanArtifacts := artifact.And(artifact.IDIn(artifactIDs...), artifact.Not(artifactEvidence(nil)))
+	artifacts, err := tx.Artifact.Query().
+		Where(orphanArtifacts).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query orphaned artifacts")
+	}
+	for _, a := range artifacts {
+		orphans = append(orphans, toModelArtifact(a))
+	}
+	if _, err := tx.Artifact.Delete().Where(orphanArtifacts).Exec(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to delete orphaned artifacts")
+	}
+
+	orphanBuilders := builder.And(builder.IDIn(builderIDs...), builder.Not(builderEvidence(nil)))
+	builders, err := tx.Builder.Query().
+		Where(orphanBuilders).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query orphaned builders")
+	}
+	for _, bld := range builders {
+		orphans = append(orphans, toModelBuilder(bld))
+	}
+	if _, err := tx.Builder.Delete().Where(orphanBuilders).Exec(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to delete orphaned builders")
+	}
+
+	orphanVulns := vulnerabilityid.And(vulnerabilityid.IDIn(vulnIDs...), vulnerabilityid.Not(vulnerabilityEvidence(nil)))
+	vulns, err := tx.VulnerabilityID.Query().
+		Where(orphanVulns).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query orphaned vulnerabilities")
+	}
+	for _, v := range vulns {
+		orphans = append(orphans, toModelVulnerabilityFromVulnerabilityID(v))
+	}
+	if _, err := tx.VulnerabilityID.Delete().Where(orphanVulns).Exec(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to delete orphaned vulnerabilities")
+	}
+
+	orphanLicenses := license.And(license.IDIn(licenseIDs...), license.Not(licenseEvidence(nil)))
+	licenses, err := tx.License.Query().
+		Where(orphanLicenses).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query orphaned licenses")
+	}
+	for _, l := range licenses {
+		orphans = append(orphans, toModelLicense(l))
+	}
+	if _, err := tx.License.Delete().Where(orphanLicenses).Exec(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to delete orphaned licenses")
+	}
+
+	return orphans, nil
+}
+
+// deleteDocumentEvidence deletes every evidence node with the given
+// documentRef. The join tables of the many to many edges cascade.
+func deleteDocumentEvidence(ctx context.Context, tx *ent.Tx, documentRef string) error {
+	deletes := []struct {
+		table string
+		exec  func(context.Context) (int, error)
+	}{
+		{billofmaterials.Table, tx.BillOfMaterials.Delete().Where(billofmaterials.DocumentRefEQ(documentRef)).Exec},
+		{certification.Table, tx.Certification.Delete().Where(certification.DocumentRefEQ(documentRef)).Exec},
+		{certifylegal.Table, tx.CertifyLegal.Delete().Where(certifylegal.DocumentRefEQ(documentRef)).Exec},
+		{certifyscorecard.Table, tx.CertifyScorecard.Delete().Where(certifyscorecard.DocumentRefEQ(documentRef)).Exec},
+		{certifyvex.Table, tx.CertifyVex.Delete().Where(certifyvex.DocumentRefEQ(documentRef)).Exec},
+		{certifyvuln.Table, tx.CertifyVuln.Delete().Where(certifyvuln.DocumentRefEQ(documentRef)).Exec},
+		{dependency.Table, tx.Dependency.Delete().Where(dependency.DocumentRefEQ(documentRef)).Exec},
+		{hashequal.Table, tx.HashEqual.Delete().Where(hashequal.DocumentRefEQ(documentRef)).Exec},
+		{hasmetadata.Table, tx.HasMetadata.Delete().Where(hasmetadata.DocumentRefEQ(documentRef)).Exec},
+		{hassourceat.Table, tx.HasSourceAt.Delete().Where(hassourceat.DocumentRefEQ(documentRef)).Exec},
+		{occurrence.Table, tx.Occurrence.Delete().Where(occurrence.DocumentRefEQ(documentRef)).Exec},
+		{pkgequal.Table, tx.PkgEqual.Delete().Where(pkgequal.DocumentRefEQ(documentRef)).Exec},
+		{pointofcontact.Table, tx.PointOfContact.Delete().Where(pointofcontact.DocumentRefEQ(documentRef)).Exec},
+		{slsaattestation.Table, tx.SLSAAttestation.Delete().Where(slsaattestation.DocumentRefEQ(documentRef)).Exec},
+		{vulnequal.Table, tx.VulnEqual.Delete().Where(vulnequal.DocumentRefEQ(documentRef)).Exec},
+		{vulnerabilitymetadata.Table, tx.VulnerabilityMetadata.Delete().Where(vulnerabilitymetadata.DocumentRefEQ(documentRef)).Exec},
+	}
+	for _, d := range deletes {
+		if _, err := d.exec(ctx); err != nil {
+			return errors.Wrapf(err, "failed to delete %s evidence", d.table)
+		}
+	}
+	return nil
+}
+
+// The *Evidence predicates match the software nodes that are referenced by
+// evidence, limited to evidence ingested from documentRef if it is set.
+
+func packageVersionEvidence(documentRef *string) predicate.PackageVersion {
+	return packageversion.Or(
+		packageversion.HasOccurrencesWith(optionalPredicate(documentRef, occurrence.DocumentRefEQ)),
+		packageversion.HasSbomWith(optionalPredicate(documentRef, billofmaterials.DocumentRefEQ)),
+		packageversion.HasVulnWith(optionalPredicate(documentRef, certifyvuln.DocumentRefEQ)),
+		packageversion.HasVexWith(optionalPredicate(documentRef, certifyvex.DocumentRefEQ)),
+		packageversion.HasHasSourceAtWith(optionalPredicate(documentRef, hassourceat.DocumentRefEQ)),
+		packageversion.HasCertificationWith(optionalPredicate(documentRef, certification.DocumentRefEQ)),
+		packageversion.HasMetadataWith(optionalPredicate(documentRef, hasmetadata.DocumentRefEQ)),
+		packageversion.HasDependencyWith(optionalPredicate(documentRef, dependency.DocumentRefEQ)),
+		packageversion.HasDependencySubjectWith(optionalPredicate(documentRef, dependency.DocumentRefEQ)),
+		packageversion.HasIncludedInSbomsWith(optionalPredicate(documentRef, billofmaterials.DocumentRefEQ)),
+		packageversion.HasPkgEqualPkgAWith(optionalPredicate(documentRef, pkgequal.DocumentRefEQ)),
+		packageversion.HasPkgEqualPkgBWith(optionalPredicate(documentRef, pkgequal.DocumentRefEQ)),
+		packageversion.HasPocWith(optionalPredicate(documentRef, pointofcontact.DocumentRefEQ)),
+		packageversion.HasCertifyLegalWith(optionalPredicate(documentRef, certifylegal.DocumentRefEQ)),
+	)
+}
+
+func packageNameEvidence(documentRef *string) predicate.PackageName {
+	return packagename.Or(
+		packagename.HasHasSourceAtWith(optionalPredicate(documentRef, hassourceat.DocumentRefEQ)),
+		packagename.HasCertificationWith(optionalPredicate(documentRef, certification.DocumentRefEQ)),
+		packagename.HasMetadataWith(optionalPredicate(documentRef, hasmetadata.DocumentRefEQ)),
+		packagename.HasPocWith(optionalPredicate(documentRef, pointofcontact.DocumentRefEQ)),
+	)
+}
+
+func sourceNameEvidence(documentRef *string) predicate.SourceName {
+	return sourcename.Or(
+		sourcename.HasOccurrencesWith(optionalPredicate(documentRef, occurrence.DocumentRefEQ)),
+		sourcename.HasHasSourceAtWith(optionalPredicate(documentRef, hassourceat.DocumentRefEQ)),
+		sourcename.HasScorecardWith(optionalPredicate(documentRef, certifyscorecard.DocumentRefEQ)),
+		sourcename.HasCertificationWith(optionalPredicate(documentRef, certification.DocumentRefEQ)),
+		sourcename.HasMetadataWith(optionalPredicate(documentRef, hasmetadata.DocumentRefEQ)),
+		sourcename.HasPocWith(optionalPredicate(documentRef, pointofcontact.DocumentRefEQ)),
+		sourcename.HasCertifyLegalWith(optionalPredicate(documentRef, certifylegal.DocumentRefEQ)),
+	)
+}
+
+func artifactEvidence(documentRef *string) predicate.Artifact {
+	return artifact.Or(
+		artifact.HasOccurrencesWith(optionalPredicate(documentRef, occurrence.DocumentRefEQ)),
+		artifact.HasSbomWith(optionalPredicate(documentRef, billofmaterials.DocumentRefEQ)),
+		artifact.HasAttestationsWith(optionalPredicate(documentRef, slsaattestation.DocumentRefEQ)),
+		artifact.HasAttestationsSubjectWith(optionalPredicate(documentRef, slsaattestation.DocumentRefEQ)),
+		artifact.HasHashEqualArtAWith(optionalPredicate(documentRef, hashequal.DocumentRefEQ)),
+		artifact.HasHashEqualArtBWith(optionalPredicate(documentRef, hashequal.DocumentRefEQ)),
+		artifact.HasVexWith(optionalPredicate(documentRef, certifyvex.DocumentRefEQ)),
+		artifact.HasCertificationWith(optionalPredicate(documentRef, certification.DocumentRefEQ)),
+		artifact.HasMetadataWith(optionalPredicate(documentRef, hasmetadata.DocumentRefEQ)),
+		artifact.HasPocWith(optionalPredicate(documentRef, pointofcontact.DocumentRefEQ)),
+		artifact.HasIncludedInSbomsWith(optionalPredicate(documentRef, billofmaterials.DocumentRefEQ)),
+	)
+}
+
+func builderEvidence(documentRef *string) predicate.Builder {
+	return builder.HasSlsaAttestationsWith(optionalPredicate(documentRef, slsaattestation.DocumentRefEQ))
+}
+
+func vulnerabilityEvidence(documentRef *string) predicate.VulnerabilityID {
+	return vulnerabilityid.Or(
+		vulnerabilityid.HasVulnEqualVulnAWith(optionalPredicate(documentRef, vulnequal.DocumentRefEQ)),
+		vulnerabilityid.HasVulnEqualVulnBWith(optionalPredicate(documentRef, vulnequal.DocumentRefEQ)),
+		vulnerabilityid.HasMetadataWith(optionalPredicate(documentRef, vulnerabilitymetadata.DocumentRefEQ)),
+		vulnerabilityid.HasCertifyVulnWith(optionalPredicate(documentRef, certifyvuln.DocumentRefEQ)),
+		vulnerabilityid.HasVexWith(optionalPredicate(documentRef, certifyvex.DocumentRefEQ)),
+	)
+}
+
+func licenseEvidence(documentRef *string) predicate.License {
+	return license.Or(
+		license.HasDeclaredInCertifyLegalsWith(optionalPredicate(documentRef, certifylegal.DocumentRefEQ)),
+		license.HasDiscoveredInCertifyLegalsWith(optionalPredicate(documentRef, certifylegal.DocumentRefEQ)),
+	)
+}
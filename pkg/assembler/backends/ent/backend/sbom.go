@@ -367,8 +367,7 @@ func (b *EntBackend) HasSBOM(ctx context.Context, spec *model.HasSBOMSpec) ([]*m
 	sbomQuery := b.client.BillOfMaterials.Query().
 		Where(hasSBOMQuery(*spec))
 
-	records, err := getSBOMObjectWithIncludes(sbomQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getSBOMObjectWithIncludes(sbomQuery))
 	if err != nil {
 		return nil, errors.Wrap(err, funcName)
 	}
@@ -386,6 +385,7 @@ func hasSBOMQuery(spec model.HasSBOMSpec) predicate.BillOfMaterials {
 		optionalPredicate(spec.DownloadLocation, billofmaterials.DownloadLocationEQ),
 		optionalPredicate(spec.Origin, billofmaterials.OriginEQ),
 		optionalPredicate(spec.KnownSince, billofmaterials.KnownSinceEQ),
+		optionalPredicate(spec.AsOf, billofmaterials.KnownSinceLTE),
 		optionalPredicate(spec.DocumentRef, billofmaterials.DocumentRefEQ),
 	}
 
@@ -566,6 +566,7 @@ func upsertBulkSBOM(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArt
 	index := 0
 	for _, hss := range batches {
 		creates := make([]*ent.BillOfMaterialsCreate, len(hss))
+		var batchIDs []uuid.UUID
 		for i, sbom := range hss {
 			sbom := sbom
 			var err error
@@ -633,6 +634,7 @@ func upsertBulkSBOM(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArt
 
 			if hasSBOMNodeID, exist := creates[i].Mutation().ID(); exist {
 				listOfSBOMIDs = append(listOfSBOMIDs, hasSBOMNodeID)
+				batchIDs = append(batchIDs, hasSBOMNodeID)
 				withIncludePackageIDs[hasSBOMNodeID] = sortedPkgUUIDs
 				withIncludeArtifactsIDs[hasSBOMNodeID] = sortedArtUUIDs
 				withIncludeDependencyIDs[hasSBOMNodeID] = sortedIsDepUUIDs
@@ -643,9 +645,20 @@ func upsertBulkSBOM(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArt
 			index++
 		}
 
-		err := tx.BillOfMaterials.CreateBulk(creates...).
+		// the IDs of the hasSBOM nodes are derived from their content, so the
+		// nodes that already exist are found before the insert
+		existing, err := tx.BillOfMaterials.Query().Where(billofmaterials.IDIn(batchIDs...)).IDs(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "query existing hasSBOM nodes")
+		}
+		found := make(map[uuid.UUID]bool, len(existing))
+		for _, id := range existing {
+			found[id] = true
+		}
+
+		err = tx.BillOfMaterials.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(conflictColumns...),
+				tenantConflictColumns(conflictColumns...),
 				sql.ConflictWhere(conflictWhere),
 			).
 			DoNothing().
@@ -654,6 +667,12 @@ func upsertBulkSBOM(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArt
 			// err "no rows in select set" appear when ingesting and the node already exists. This is non-error produced by "DoNothing"
 			return nil, errors.Wrap(err, "upsert hasSBOM node")
 		}
+		for _, id := range batchIDs {
+			if !found[id] {
+				found[id] = true
+				reportCreated(ctx, hasSBOMGlobalID(id.String()))
+			}
+		}
 
 		for _, id := range listOfSBOMIDs {
 			if err := updateHasSBOMWithIncludePackageIDs(ctx, tx.Client(), id, withIncludePackageIDs[id]); err != nil {
@@ -752,9 +771,9 @@ func upsertHasSBOM(ctx context.Context, tx *ent.Tx, subject model.PackageOrArtif
 		return nil, gqlerror.Errorf("generateSLSACreate :: %s", err)
 	}
 
-	if _, err := sbomCreate.
+	if id, err := sbomCreate.
 		OnConflict(
-			sql.ConflictColumns(conflictColumns...),
+			tenantConflictColumns(conflictColumns...),
 			sql.ConflictWhere(conflictWhere),
 		).
 		DoNothing().
@@ -763,6 +782,8 @@ func upsertHasSBOM(ctx context.Context, tx *ent.Tx, subject model.PackageOrArtif
 		if err != stdsql.ErrNoRows {
 			return nil, errors.Wrap(err, "upsert hasSBOM node")
 		}
+	} else {
+		reportCreated(ctx, hasSBOMGlobalID(id.String()))
 	}
 
 	var id uuid.UUID
@@ -857,7 +878,7 @@ func generateSBOMCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput
 			}
 			pkgVersionID = pv.ID
 		}
-		hasSBOMID, err := guacHasSBOMKey(ptrfrom.String(pkgVersionID.String()), nil, sortedPkgHash, sortedArtHash, sortedDepHash, sortedOccurHash, hasSBOM)
+		hasSBOMID, err := guacHasSBOMKey(ctx, ptrfrom.String(pkgVersionID.String()), nil, sortedPkgHash, sortedArtHash, sortedDepHash, sortedOccurHash, hasSBOM)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create hasSBOM uuid with error: %w", err)
 		}
@@ -879,7 +900,7 @@ func generateSBOMCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput
 			}
 			artID = foundArt.ID
 		}
-		hasSBOMID, err := guacHasSBOMKey(nil, ptrfrom.String(artID.String()), sortedPkgHash, sortedArtHash, sortedDepHash, sortedOccurHash, hasSBOM)
+		hasSBOMID, err := guacHasSBOMKey(ctx, nil, ptrfrom.String(artID.String()), sortedPkgHash, sortedArtHash, sortedDepHash, sortedOccurHash, hasSBOM)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create hasSBOM uuid with error: %w", err)
 		}
@@ -960,7 +981,7 @@ func canonicalHasSBOMString(hasSBOM *model.HasSBOMInputSpec) string {
 // when ingesting multiple edges otherwise you get "violates foreign key constraint" as it creates
 // a new ID for hasSBOM node (even when already ingested) that it maps to the edge and fails the look up. This only occurs when using UUID with
 // "Default" func to generate a new UUID
-func guacHasSBOMKey(pkgVersionID *string, artID *string, includedPkgHash, includedArtHash, includedDepHash, includedOccurHash string,
+func guacHasSBOMKey(ctx context.Context, pkgVersionID *string, artID *string, includedPkgHash, includedArtHash, includedDepHash, includedOccurHash string,
 	hasSBOM *model.HasSBOMInputSpec) (*uuid.UUID, error) {
 
 	var subjectID string
@@ -973,7 +994,7 @@ func guacHasSBOMKey(pkgVersionID *string, artID *string, includedPkgHash, includ
 	}
 	hsIDString := fmt.Sprintf("%s::%s::%s::%s::%s::%s?", subjectID, includedPkgHash, includedArtHash, includedDepHash, includedOccurHash, canonicalHasSBOMString(hasSBOM))
 
-	hsID := generateUUIDKey([]byte(hsIDString))
+	hsID := generateUUIDKey(ctx, []byte(hsIDString))
 	return &hsID, nil
 }
 
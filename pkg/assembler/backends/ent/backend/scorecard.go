@@ -25,7 +25,6 @@ import (
 	"strconv"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -107,8 +106,7 @@ func (b *EntBackend) Scorecards(ctx context.Context, filter *model.CertifyScorec
 	scorecardQuery := b.client.CertifyScorecard.Query().
 		Where(certifyScorecardQuery(filter))
 
-	records, err := getScorecardObject(scorecardQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getScorecardObject(scorecardQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed scorecard query with error: %w", err)
 	}
@@ -131,6 +129,7 @@ func certifyScorecardQuery(filter *model.CertifyScorecardSpec) predicate.Certify
 		optionalPredicate(filter.ID, IDEQ),
 		optionalPredicate(filter.AggregateScore, certifyscorecard.AggregateScoreEQ),
 		optionalPredicate(filter.TimeScanned, certifyscorecard.TimeScannedEQ),
+		optionalPredicate(filter.AsOf, certifyscorecard.TimeScannedLTE),
 		optionalPredicate(filter.ScorecardVersion, certifyscorecard.ScorecardVersionEQ),
 		optionalPredicate(filter.ScorecardCommit, certifyscorecard.ScorecardCommitEqualFold),
 		optionalPredicate(filter.Origin, certifyscorecard.OriginEQ),
@@ -273,7 +272,7 @@ func upsertBulkScorecard(ctx context.Context, tx *ent.Tx, sources []*model.IDorS
 
 		err := tx.CertifyScorecard.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(scorecardConflictColumns()...),
+				tenantConflictColumns(scorecardConflictColumns()...),
 			).
 			DoNothing().
 			Exec(ctx)
@@ -293,7 +292,7 @@ func upsertScorecard(ctx context.Context, tx *ent.Tx, source model.IDorSourceInp
 	}
 	if id, err := scorecardCreate.
 		OnConflict(
-			sql.ConflictColumns(scorecardConflictColumns()...),
+			tenantConflictColumns(scorecardConflictColumns()...),
 		).
 		Ignore().
 		ID(ctx); err != nil {
@@ -36,6 +36,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/sourcename"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/mattn/go-sqlite3"
 )
 
 const (
@@ -126,8 +127,8 @@ func notGUACTypePackagePredicates() predicate.PackageVersion {
 
 func (b *EntBackend) FindPackagesThatNeedScanning(ctx context.Context, queryType model.QueryType, lastScan *int) ([]string, error) {
 	var pkgLatestScan []struct {
-		ID             uuid.UUID `json:"id"`
-		LastScanTimeDB time.Time `json:"max"`
+		ID             uuid.UUID     `json:"id"`
+		LastScanTimeDB aggregateTime `json:"max"`
 	}
 
 	if queryType == model.QueryTypeVulnerability {
@@ -187,7 +188,7 @@ func (b *EntBackend) FindPackagesThatNeedScanning(ctx context.Context, queryType
 	} else {
 		lastScanTime := time.Now().Add(time.Duration(-*lastScan) * time.Hour).UTC()
 		for _, record := range pkgLatestScan {
-			if record.LastScanTimeDB.Before(lastScanTime) {
+			if record.LastScanTimeDB.Time.Before(lastScanTime) {
 				packagesThatNeedScanning = append(packagesThatNeedScanning, record.ID.String()) // Add the package ID
 			}
 		}
@@ -195,6 +196,38 @@ func (b *EntBackend) FindPackagesThatNeedScanning(ctx context.Context, queryType
 	return packagesThatNeedScanning, nil
 }
 
+// aggregateTime scans the result of an aggregate over a time column. Sqlite
+// has no time type, so it returns such results as text.
+type aggregateTime struct {
+	time.Time
+}
+
+func (t *aggregateTime) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		t.Time = time.Time{}
+	case time.Time:
+		t.Time = v
+	case string:
+		return t.parse(v)
+	case []byte:
+		return t.parse(string(v))
+	default:
+		return fmt.Errorf("unsupported type %T for time aggregate", value)
+	}
+	return nil
+}
+
+func (t *aggregateTime) parse(s string) error {
+	for _, layout := range sqlite3.SQLiteTimestampFormats {
+		if parsed, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to parse time aggregate %q", s)
+}
+
 func (b *EntBackend) QueryPackagesListForScan(ctx context.Context, pkgIDs []string, after *string, first *int) (*model.PackageConnection, error) {
 	var afterCursor *entgql.Cursor[uuid.UUID]
 
@@ -322,12 +355,12 @@ func (b *EntBackend) BatchQueryPkgIDCertifyVuln(ctx context.Context, pkgIDs []st
 	aggPredicates = append(aggPredicates, certifyvuln.PackageIDIn(queryList...))
 
 	var collectedCertVuln []*model.CertifyVuln
-	certVulnConn, err := b.client.CertifyVuln.Query().
+	certVulnConn, err := allWithinLimit(ctx, b.client.CertifyVuln.Query().
 		Where(certifyvuln.And(aggPredicates...)).
 		WithVulnerability(func(query *ent.VulnerabilityIDQuery) {}).
 		WithPackage(func(q *ent.PackageVersionQuery) {
 			q.WithName(func(q *ent.PackageNameQuery) {})
-		}).All(ctx)
+		}))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed certifyVuln query based on package IDs with error: %w", err)
@@ -361,13 +394,13 @@ func (b *EntBackend) BatchQueryPkgIDCertifyLegal(ctx context.Context, pkgIDs []s
 
 	var collectedCertLegal []*model.CertifyLegal
 
-	certLegalConn, err := b.client.CertifyLegal.Query().
+	certLegalConn, err := allWithinLimit(ctx, b.client.CertifyLegal.Query().
 		Where(certifylegal.And(aggPredicates...)).
 		WithPackage(func(q *ent.PackageVersionQuery) {
 			q.WithName(func(q *ent.PackageNameQuery) {})
 		}).
 		WithDeclaredLicenses().
-		WithDiscoveredLicenses().All(ctx)
+		WithDiscoveredLicenses())
 
 	if err != nil {
 		return nil, fmt.Errorf("failed certifyLegal query based on package IDs with error: %w", err)
@@ -398,10 +431,10 @@ func (b *EntBackend) BatchQuerySubjectPkgDependency(ctx context.Context, pkgIDs
 		queryList = append(queryList, convertedID)
 	}
 
-	idDepConn, err := b.client.Dependency.Query().
+	idDepConn, err := allWithinLimit(ctx, b.client.Dependency.Query().
 		Where(dependency.PackageIDIn(queryList...)).
 		WithPackage(withPackageVersionTree()).
-		WithDependentPackageVersion(withPackageVersionTree()).All(ctx)
+		WithDependentPackageVersion(withPackageVersionTree()))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed isDependency subject query based on package IDs with error: %w", err)
@@ -432,10 +465,10 @@ func (b *EntBackend) BatchQueryDepPkgDependency(ctx context.Context, pkgIDs []st
 		queryList = append(queryList, convertedID)
 	}
 
-	idDepConn, err := b.client.Dependency.Query().
+	idDepConn, err := allWithinLimit(ctx, b.client.Dependency.Query().
 		Where(dependency.DependentPackageVersionIDIn(queryList...)).
 		WithPackage(withPackageVersionTree()).
-		WithDependentPackageVersion(withPackageVersionTree()).All(ctx)
+		WithDependentPackageVersion(withPackageVersionTree()))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed isDependency subject query based on package IDs with error: %w", err)
@@ -25,7 +25,6 @@ import (
 	"time"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -108,8 +107,7 @@ func (b *EntBackend) HasSlsa(ctx context.Context, spec *model.HasSLSASpec) ([]*m
 	slsaQuery := b.client.SLSAAttestation.Query().
 		Where(hasSLSAQuery(*spec))
 
-	records, err := getSLSAObject(slsaQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getSLSAObject(slsaQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed hasSLSA query with error: %w", err)
 	}
@@ -128,6 +126,12 @@ func hasSLSAQuery(spec model.HasSLSASpec) predicate.SLSAAttestation {
 		optionalPredicate(spec.FinishedOn, slsaattestation.FinishedOnEQ),
 		optionalPredicate(spec.StartedOn, slsaattestation.StartedOnEQ),
 	}
+	if spec.AsOf != nil {
+		// a missing finishedOn is stored as the default time
+		predicates = append(predicates,
+			slsaattestation.FinishedOnLTE(*spec.AsOf),
+			slsaattestation.FinishedOnNEQ(setDefaultTime(nil)))
+	}
 
 	if spec.BuiltBy != nil {
 		if spec.BuiltBy.ID != nil {
@@ -243,7 +247,7 @@ func upsertBulkSLSA(ctx context.Context, tx *ent.Tx, subjects []*model.IDorArtif
 
 		err := tx.SLSAAttestation.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(slsaConflictColumns()...),
+				tenantConflictColumns(slsaConflictColumns()...),
 			).
 			DoNothing().
 			Exec(ctx)
@@ -347,7 +351,7 @@ func generateSLSACreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArti
 		slsaCreate.SetBuiltFromHash(builtFromHash)
 	}
 
-	slsaID, err := guacSLSAKey(ptrfrom.String(subjectArtifactID.String()), builtFromHash, ptrfrom.String(buildID.String()), slsa)
+	slsaID, err := guacSLSAKey(ctx, ptrfrom.String(subjectArtifactID.String()), builtFromHash, ptrfrom.String(buildID.String()), slsa)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create slsa uuid with error: %w", err)
 	}
@@ -366,7 +370,7 @@ func upsertSLSA(ctx context.Context, tx *ent.Tx, subject model.IDorArtifactInput
 
 	if id, err := slsaCreate.
 		OnConflict(
-			sql.ConflictColumns(slsaConflictColumns()...),
+			tenantConflictColumns(slsaConflictColumns()...),
 		).
 		Ignore().
 		ID(ctx); err != nil {
@@ -480,10 +484,10 @@ func canonicalSLSAString(slsa model.SLSAInputSpec) string {
 // when ingesting multiple edges otherwise you get "violates foreign key constraint" as it creates
 // a new ID for slsa node (even when already ingested) that it maps to the edge and fails the look up. This only occurs when using UUID with
 // "Default" func to generate a new UUID
-func guacSLSAKey(subjectID *string, builtFromHash string, builderID *string, slsa *model.SLSAInputSpec) (*uuid.UUID, error) {
+func guacSLSAKey(ctx context.Context, subjectID *string, builtFromHash string, builderID *string, slsa *model.SLSAInputSpec) (*uuid.UUID, error) {
 	depIDString := fmt.Sprintf("%s::%s::%s::%s?", *subjectID, builtFromHash, *builderID, canonicalSLSAString(*slsa))
 
-	depID := generateUUIDKey([]byte(depIDString))
+	depID := generateUUIDKey(ctx, []byte(depIDString))
 	return &depID, nil
 }
 
@@ -125,8 +125,7 @@ func (b *EntBackend) HasSourceAt(ctx context.Context, filter *model.HasSourceAtS
 	hasSourceAtQuery := b.client.HasSourceAt.Query().
 		Where(hasSourceAtQuery(*filter))
 
-	records, err := getHasSourceAtObject(hasSourceAtQuery).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getHasSourceAtObject(hasSourceAtQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed hasSourceAt query with error: %w", err)
 	}
@@ -142,6 +141,7 @@ func hasSourceAtQuery(filter model.HasSourceAtSpec) predicate.HasSourceAt {
 		optionalPredicate(filter.DocumentRef, hassourceat.DocumentRefEQ),
 		optionalPredicate(filter.Justification, hassourceat.JustificationEQ),
 		optionalPredicate(filter.KnownSince, hassourceat.KnownSinceEQ),
+		optionalPredicate(filter.AsOf, hassourceat.KnownSinceLTE),
 	}
 
 	if filter.Package != nil {
@@ -224,7 +224,7 @@ func upsertBulkHasSourceAts(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorP
 
 	if pkgMatchType.Pkg == model.PkgMatchTypeAllVersions {
 		conflictColumns = append(conflictColumns, hassourceat.FieldPackageNameID)
-		conflictWhere = sql.And(sql.IsNull(hassourceat.FieldPackageVersionID), sql.NotNull(hassourceat.FieldPackageNameID))
+		conflictWhere = sql.And(sql.NotNull(hassourceat.FieldPackageNameID), sql.IsNull(hassourceat.FieldPackageVersionID))
 	} else {
 		conflictColumns = append(conflictColumns, hassourceat.FieldPackageVersionID)
 		conflictWhere = sql.And(sql.NotNull(hassourceat.FieldPackageVersionID), sql.IsNull(hassourceat.FieldPackageNameID))
@@ -248,7 +248,7 @@ func upsertBulkHasSourceAts(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorP
 
 		err := tx.HasSourceAt.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(conflictColumns...),
+				tenantConflictColumns(conflictColumns...),
 				sql.ConflictWhere(conflictWhere),
 			).
 			DoNothing().
@@ -342,7 +342,7 @@ func upsertHasSourceAt(ctx context.Context, tx *ent.Tx, pkg model.IDorPkgInput,
 
 	if pkgMatchType.Pkg == model.PkgMatchTypeAllVersions {
 		conflictColumns = append(conflictColumns, hassourceat.FieldPackageNameID)
-		conflictWhere = sql.And(sql.IsNull(hassourceat.FieldPackageVersionID), sql.NotNull(hassourceat.FieldPackageNameID))
+		conflictWhere = sql.And(sql.NotNull(hassourceat.FieldPackageNameID), sql.IsNull(hassourceat.FieldPackageVersionID))
 	} else {
 		conflictColumns = append(conflictColumns, hassourceat.FieldPackageVersionID)
 		conflictWhere = sql.And(sql.NotNull(hassourceat.FieldPackageVersionID), sql.IsNull(hassourceat.FieldPackageNameID))
@@ -353,7 +353,7 @@ func upsertHasSourceAt(ctx context.Context, tx *ent.Tx, pkg model.IDorPkgInput,
 		return nil, gqlerror.Errorf("generateHasSourceAtCreate :: %s", err)
 	}
 	id, err := insert.OnConflict(
-		sql.ConflictColumns(conflictColumns...),
+		tenantConflictColumns(conflictColumns...),
 		sql.ConflictWhere(conflictWhere),
 	).
 		Ignore().
@@ -458,9 +458,8 @@ func (b *EntBackend) Sources(ctx context.Context, filter *model.SourceSpec) ([]*
 	if filter == nil {
 		filter = &model.SourceSpec{}
 	}
-	records, err := b.client.SourceName.Query().
-		Where(sourceQuery(filter)).
-		All(ctx)
+	records, err := allWithinLimit(ctx, b.client.SourceName.Query().
+		Where(sourceQuery(filter)))
 	if err != nil {
 		return nil, fmt.Errorf("failed sources query with error: %w", err)
 	}
@@ -514,7 +513,7 @@ func upsertBulkSource(ctx context.Context, tx *ent.Tx, srcInputs []*model.IDorSo
 		for i, src := range srcs {
 			s := src
 			srcIDs := helpers.GetKey[*model.SourceInputSpec, helpers.SrcIds](s.SourceInput, helpers.SrcServerKey)
-			srcNameID := generateUUIDKey([]byte(srcIDs.NameId))
+			srcNameID := generateSharedUUIDKey([]byte(srcIDs.NameId))
 
 			srcNameCreates[i] = generateSourceNameCreate(tx, &srcNameID, s)
 			srcNameIDs = append(srcNameIDs, srcNameID.String())
@@ -524,7 +523,7 @@ func upsertBulkSource(ctx context.Context, tx *ent.Tx, srcInputs []*model.IDorSo
 
 		if err := tx.SourceName.CreateBulk(srcNameCreates...).
 			OnConflict(
-				sql.ConflictColumns(
+				tenantConflictColumns(
 					sourcename.FieldType,
 					sourcename.FieldNamespace,
 					sourcename.FieldName,
@@ -588,12 +587,12 @@ func generateSourceNameCreate(tx *ent.Tx, srcNameID *uuid.UUID, srcInput *model.
 
 func upsertSource(ctx context.Context, tx *ent.Tx, src model.IDorSourceInput) (*model.SourceIDs, error) {
 	srcIDs := helpers.GetKey[*model.SourceInputSpec, helpers.SrcIds](src.SourceInput, helpers.SrcServerKey)
-	srcNameID := generateUUIDKey([]byte(srcIDs.NameId))
+	srcNameID := generateSharedUUIDKey([]byte(srcIDs.NameId))
 
 	create := generateSourceNameCreate(tx, &srcNameID, &src)
 	err := create.
 		OnConflict(
-			sql.ConflictColumns(
+			tenantConflictColumns(
 				sourcename.FieldType,
 				sourcename.FieldNamespace,
 				sourcename.FieldName,
@@ -0,0 +1,62 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package backend
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// TestSQLiteSmoke checks that a sqlite file can be migrated, written and
+// read back after it is reopened. The full backend suite runs on sqlite with
+// the integration tag.
+func TestSQLiteSmoke(t *testing.T) {
+	ctx := context.Background()
+	address := "file:" + filepath.Join(t.TempDir(), "guac.db")
+	open := func(autoMigrate bool) *EntBackend {
+		t.Helper()
+		client, err := SetupBackend(ctx, &BackendOptions{Address: address, AutoMigrate: autoMigrate})
+		if err != nil {
+			t.Fatalf("SetupBackend() error = %v", err)
+		}
+		t.Cleanup(func() { client.Close() })
+		be, err := GetBackend(client)
+		if err != nil {
+			t.Fatalf("GetBackend() error = %v", err)
+		}
+		return be.(*EntBackend)
+	}
+
+	pkg := &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("github.com/guacsec"), Name: "guac", Version: ptrfrom.String("1.0.0")}
+	if _, err := open(true).IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg}); err != nil {
+		t.Fatalf("IngestPackage() error = %v", err)
+	}
+
+	got, err := open(false).Packages(ctx, &model.PkgSpec{Name: &pkg.Name})
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if len(got) != 1 || len(got[0].Namespaces) != 1 || len(got[0].Namespaces[0].Names) != 1 ||
+		len(got[0].Namespaces[0].Names[0].Versions) != 1 || got[0].Namespaces[0].Names[0].Versions[0].Version != *pkg.Version {
+		t.Errorf("Packages() = %v, want the ingested package", got)
+	}
+}
@@ -0,0 +1,114 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/intercept"
+)
+
+// tenantField is the column that every ent schema gets from
+// schema.TenantMixin.
+const tenantField = "tenant"
+
+// SupportsTenants implements backends.MultiTenant. Every node and evidence
+// is tagged with the tenant of the caller that ingested it, except the
+// shared nouns, see sharedNouns, which are always in the global tenant.
+//
+// Queries see the nodes of the caller's tenant and of the global tenant.
+// The transactions that ingest and delete data only see the nodes of the
+// caller's tenant, and the shared nouns, so that ingestion never links to,
+// updates or removes the nodes of another tenant by looking them up, and a
+// lookup never finds both the copy of a tenant and the global copy of a node.
+func (b *EntBackend) SupportsTenants() bool {
+	return true
+}
+
+// sharedNouns are the types of the nouns that all the tenants share, rather
+// than each getting its own copy: packages, sources and vulnerabilities,
+// which are public names. The certifiers of the global tenant find the
+// shared nouns ingested by any tenant, and their evidence about them, such
+// as vulnerability scans, is seen by every tenant. The evidence that a
+// tenant links to them, such as its SBOMs, is still only seen by the tenant.
+// A shared noun is only removed when no evidence of any tenant refers to it.
+var sharedNouns = map[string]bool{
+	ent.TypePackageName:     true,
+	ent.TypePackageVersion:  true,
+	ent.TypeSourceName:      true,
+	ent.TypeVulnerabilityID: true,
+}
+
+// nodeTenant returns the tenant of the nodes of type typ that the caller
+// of ctx ingests.
+func nodeTenant(ctx context.Context, typ string) string {
+	if sharedNouns[typ] {
+		return backends.GlobalTenant
+	}
+	return backends.TenantFromContext(ctx)
+}
+
+// useTenants registers the hooks that tag the created nodes with the tenant
+// of the caller and restrict updates and deletes to it, and the traverser
+// that scopes all the queries, including edge traversals, to it.
+func useTenants(client *ent.Client) {
+	client.Use(tenantHook)
+	client.Intercept(intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+		q.WhereP(tenantPredicate(ctx, q.Type()))
+		return nil
+	}))
+}
+
+func tenantHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		tenant := nodeTenant(ctx, m.Type())
+		if m.Op().Is(ent.OpCreate) {
+			if err := m.SetField(tenantField, tenant); err != nil {
+				return nil, fmt.Errorf("failed to set the tenant of %s: %w", m.Type(), err)
+			}
+			return next.Mutate(ctx, m)
+		}
+		w, ok := m.(interface {
+			WhereP(...func(*sql.Selector))
+		})
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		w.WhereP(sql.FieldEQ(tenantField, tenant))
+		return next.Mutate(ctx, m)
+	})
+}
+
+// tenantPredicate selects the nodes of type typ that the caller can see,
+// see SupportsTenants.
+func tenantPredicate(ctx context.Context, typ string) func(*sql.Selector) {
+	tenant := nodeTenant(ctx, typ)
+	if tenant == backends.GlobalTenant || ent.TxFromContext(ctx) != nil {
+		return sql.FieldEQ(tenantField, tenant)
+	}
+	return sql.FieldIn(tenantField, tenant, backends.GlobalTenant)
+}
+
+// tenantConflictColumns returns the conflict target of an upsert on a unique
+// index, which always includes the tenant.
+func tenantConflictColumns(columns ...string) sql.ConflictOption {
+	return sql.ConflictColumns(append(slices.Clone(columns), tenantField)...)
+}
@@ -19,9 +19,21 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 )
 
+type createdKey struct{}
+
+// reportCreated records the global IDs of the evidence created by the
+// transaction of ctx, which WithinTX reports to backends.ReportCreated once
+// the transaction is committed.
+func reportCreated(ctx context.Context, ids ...string) {
+	if created, ok := ctx.Value(createdKey{}).(*[]string); ok {
+		*created = append(*created, ids...)
+	}
+}
+
 func WithinTX[T any](ctx context.Context, entClient *ent.Client, exec func(ctx context.Context) (*T, error)) (*T, error) {
 	if entClient == nil {
 		return nil, Errorf("%v ::  %s", "WithinTX", "ent client is not initialized")
@@ -34,7 +46,8 @@ func WithinTX[T any](ctx context.Context, entClient *ent.Client, exec func(ctx c
 		return nil, err
 	}
 
-	ctx = ent.NewTxContext(ctx, tx)
+	var created []string
+	txCtx := context.WithValue(ent.NewTxContext(ctx, tx), createdKey{}, &created)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -43,7 +56,7 @@ func WithinTX[T any](ctx context.Context, entClient *ent.Client, exec func(ctx c
 		}
 	}()
 
-	result, err := exec(ctx)
+	result, err := exec(txCtx)
 	if err != nil {
 		_ = tx.Rollback()
 		return nil, err
@@ -52,6 +65,7 @@ func WithinTX[T any](ctx context.Context, entClient *ent.Client, exec func(ctx c
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
+	backends.ReportCreated(ctx, created...)
 
 	return result, nil
 }
@@ -24,7 +24,6 @@ import (
 	"sort"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -110,8 +109,7 @@ func (b *EntBackend) VulnEqual(ctx context.Context, filter *model.VulnEqualSpec)
 	veQuery := b.client.VulnEqual.Query().
 		Where(vulnEqualQuery(filter))
 
-	query, err := getVulnEqualObject(veQuery).
-		All(ctx)
+	query, err := allWithinLimit(ctx, getVulnEqualObject(veQuery))
 	if err != nil {
 		return nil, fmt.Errorf("failed vulnEqual query with error: %w", err)
 	}
@@ -224,7 +222,7 @@ func upsertBulkVulnEquals(ctx context.Context, tx *ent.Tx, vulnerabilities []*mo
 
 		err := tx.VulnEqual.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(vulnEqualConflictColumns()...),
+				tenantConflictColumns(vulnEqualConflictColumns()...),
 			).
 			DoNothing().
 			Exec(ctx)
@@ -300,7 +298,7 @@ func generateVulnEqualCreate(ctx context.Context, tx *ent.Tx, vulnerability *mod
 
 	vulnEqualCreate.SetVulnerabilitiesHash(sortedVulnerabilitiesHash)
 
-	vulnEqualID, err := guacVulnEqualKey(sortedVulnerabilitiesHash, ve)
+	vulnEqualID, err := guacVulnEqualKey(ctx, sortedVulnerabilitiesHash, ve)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create vulnEqual uuid with error: %w", err)
 	}
@@ -318,7 +316,7 @@ func upsertVulnEquals(ctx context.Context, tx *ent.Tx, vulnerability model.IDorV
 
 	if id, err := vulnEqualCreate.
 		OnConflict(
-			sql.ConflictColumns(vulnEqualConflictColumns()...),
+			tenantConflictColumns(vulnEqualConflictColumns()...),
 		).
 		Ignore().
 		ID(ctx); err != nil {
@@ -373,10 +371,10 @@ func canonicalVulnEqualString(ve *model.VulnEqualInputSpec) string {
 // when ingesting multiple edges otherwise you get "violates foreign key constraint" as it creates
 // a new ID for vulnEqual node (even when already ingested) that it maps to the edge and fails the look up. This only occurs when using UUID with
 // "Default" func to generate a new UUID
-func guacVulnEqualKey(sortedVulnHash string, veInput *model.VulnEqualInputSpec) (*uuid.UUID, error) {
+func guacVulnEqualKey(ctx context.Context, sortedVulnHash string, veInput *model.VulnEqualInputSpec) (*uuid.UUID, error) {
 	veIDString := fmt.Sprintf("%s::%s?", sortedVulnHash, canonicalVulnEqualString(veInput))
 
-	veID := generateUUIDKey([]byte(veIDString))
+	veID := generateUUIDKey(ctx, []byte(veIDString))
 	return &veID, nil
 }
 
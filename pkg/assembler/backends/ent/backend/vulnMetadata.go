@@ -21,7 +21,6 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -113,8 +112,7 @@ func (b *EntBackend) VulnerabilityMetadata(ctx context.Context, filter *model.Vu
 	vmConn := b.client.VulnerabilityMetadata.Query().
 		Where(vulnMetadataPred)
 
-	records, err := getVulnMetadataObject(vmConn).
-		All(ctx)
+	records, err := allWithinLimit(ctx, getVulnMetadataObject(vmConn))
 	if err != nil {
 		return nil, fmt.Errorf("failed vulnMetadata query with error: %w", err)
 	}
@@ -160,6 +158,7 @@ func vulnerabilityMetadataPredicate(filter *model.VulnerabilityMetadataSpec) (pr
 	predicates := []predicate.VulnerabilityMetadata{
 		optionalPredicate(filter.ID, IDEQ),
 		optionalPredicate(filter.Timestamp, vulnerabilitymetadata.TimestampGTE),
+		optionalPredicate(filter.AsOf, vulnerabilitymetadata.TimestampLTE),
 		optionalPredicate(filter.Origin, vulnerabilitymetadata.OriginEQ),
 		optionalPredicate(filter.Collector, vulnerabilitymetadata.CollectorEQ),
 		optionalPredicate(filter.DocumentRef, vulnerabilitymetadata.DocumentRefEQ),
@@ -240,7 +239,7 @@ func upsertBulkVulnerabilityMetadata(ctx context.Context, tx *ent.Tx, vulnerabil
 
 		err := tx.VulnerabilityMetadata.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(vulnMetaConflictColumns()...),
+				tenantConflictColumns(vulnMetaConflictColumns()...),
 			).
 			DoNothing().
 			Exec(ctx)
@@ -300,7 +299,7 @@ func upsertVulnerabilityMetadata(ctx context.Context, tx *ent.Tx, vulnerability
 
 	}
 	if id, err := insert.OnConflict(
-		sql.ConflictColumns(vulnMetaConflictColumns()...),
+		tenantConflictColumns(vulnMetaConflictColumns()...),
 	).
 		Ignore().
 		ID(ctx); err != nil {
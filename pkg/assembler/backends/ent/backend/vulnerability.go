@@ -22,7 +22,6 @@ import (
 	"strings"
 
 	"entgo.io/contrib/entgql"
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
@@ -148,9 +147,8 @@ func (b *EntBackend) Vulnerabilities(ctx context.Context, filter *model.Vulnerab
 
 func getVulnerabilities(ctx context.Context, client *ent.Client, filter model.VulnerabilitySpec) (ent.VulnerabilityIDs, error) {
 
-	results, err := client.VulnerabilityID.Query().
-		Where(vulnerabilityQueryPredicates(filter)...).
-		All(ctx)
+	results, err := allWithinLimit(ctx, client.VulnerabilityID.Query().
+		Where(vulnerabilityQueryPredicates(filter)...))
 	if err != nil {
 		return nil, fmt.Errorf("failed vulnerability query with error: %w", err)
 	}
@@ -202,7 +200,7 @@ func upsertBulkVulnerability(ctx context.Context, tx *ent.Tx, vulnInputs []*mode
 		for i, vuln := range vulns {
 			v := vuln
 			vulnIDs := helpers.GetKey[*model.VulnerabilityInputSpec, helpers.VulnIds](v.VulnerabilityInput, helpers.VulnServerKey)
-			vulnID := generateUUIDKey([]byte(vulnIDs.VulnerabilityID))
+			vulnID := generateSharedUUIDKey([]byte(vulnIDs.VulnerabilityID))
 			creates[i] = generateVulnerabilityIDCreate(tx, &vulnID, v)
 
 			ids = append(ids, model.VulnerabilityIDs{
@@ -212,7 +210,7 @@ func upsertBulkVulnerability(ctx context.Context, tx *ent.Tx, vulnInputs []*mode
 
 		err := tx.VulnerabilityID.CreateBulk(creates...).
 			OnConflict(
-				sql.ConflictColumns(vulnerabilityid.FieldType, vulnerabilityid.FieldVulnerabilityID),
+				tenantConflictColumns(vulnerabilityid.FieldType, vulnerabilityid.FieldVulnerabilityID),
 			).
 			DoNothing().
 			Exec(ctx)
@@ -233,11 +231,11 @@ func generateVulnerabilityIDCreate(tx *ent.Tx, vulnID *uuid.UUID, vulnInput *mod
 
 func upsertVulnerability(ctx context.Context, tx *ent.Tx, spec model.IDorVulnerabilityInput) (*model.VulnerabilityIDs, error) {
 	vulnIDs := helpers.GetKey[*model.VulnerabilityInputSpec, helpers.VulnIds](spec.VulnerabilityInput, helpers.VulnServerKey)
-	vulnID := generateUUIDKey([]byte(vulnIDs.VulnerabilityID))
+	vulnID := generateSharedUUIDKey([]byte(vulnIDs.VulnerabilityID))
 
 	create := generateVulnerabilityIDCreate(tx, &vulnID, &spec)
 	err := create.
-		OnConflict(sql.ConflictColumns(vulnerabilityid.FieldType, vulnerabilityid.FieldVulnerabilityID)).
+		OnConflict(tenantConflictColumns(vulnerabilityid.FieldType, vulnerabilityid.FieldVulnerabilityID)).
 		DoNothing().
 		Exec(ctx)
 
@@ -20,6 +20,8 @@ type BillOfMaterials struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// PackageID holds the value of the "package_id" field.
 	PackageID *uuid.UUID `json:"package_id,omitempty"`
 	// ArtifactID holds the value of the "artifact_id" field.
@@ -145,7 +147,7 @@ func (*BillOfMaterials) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case billofmaterials.FieldPackageID, billofmaterials.FieldArtifactID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case billofmaterials.FieldURI, billofmaterials.FieldAlgorithm, billofmaterials.FieldDigest, billofmaterials.FieldDownloadLocation, billofmaterials.FieldOrigin, billofmaterials.FieldCollector, billofmaterials.FieldDocumentRef, billofmaterials.FieldIncludedPackagesHash, billofmaterials.FieldIncludedArtifactsHash, billofmaterials.FieldIncludedDependenciesHash, billofmaterials.FieldIncludedOccurrencesHash:
+		case billofmaterials.FieldTenant, billofmaterials.FieldURI, billofmaterials.FieldAlgorithm, billofmaterials.FieldDigest, billofmaterials.FieldDownloadLocation, billofmaterials.FieldOrigin, billofmaterials.FieldCollector, billofmaterials.FieldDocumentRef, billofmaterials.FieldIncludedPackagesHash, billofmaterials.FieldIncludedArtifactsHash, billofmaterials.FieldIncludedDependenciesHash, billofmaterials.FieldIncludedOccurrencesHash:
 			values[i] = new(sql.NullString)
 		case billofmaterials.FieldKnownSince:
 			values[i] = new(sql.NullTime)
@@ -172,6 +174,12 @@ func (bom *BillOfMaterials) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				bom.ID = *value
 			}
+		case billofmaterials.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				bom.Tenant = value.String
+			}
 		case billofmaterials.FieldPackageID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field package_id", values[i])
@@ -324,6 +332,9 @@ func (bom *BillOfMaterials) String() string {
 	var builder strings.Builder
 	builder.WriteString("BillOfMaterials(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", bom.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(bom.Tenant)
+	builder.WriteString(", ")
 	if v := bom.PackageID; v != nil {
 		builder.WriteString("package_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
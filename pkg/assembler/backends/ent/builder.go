@@ -17,6 +17,8 @@ type Builder struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// The URI of the builder, used as a unique identifier in the graph query
 	URI string `json:"uri,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -52,7 +54,7 @@ func (*Builder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case builder.FieldURI:
+		case builder.FieldTenant, builder.FieldURI:
 			values[i] = new(sql.NullString)
 		case builder.FieldID:
 			values[i] = new(uuid.UUID)
@@ -77,6 +79,12 @@ func (b *Builder) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				b.ID = *value
 			}
+		case builder.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				b.Tenant = value.String
+			}
 		case builder.FieldURI:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field uri", values[i])
@@ -124,6 +132,9 @@ func (b *Builder) String() string {
 	var builder strings.Builder
 	builder.WriteString("Builder(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(b.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("uri=")
 	builder.WriteString(b.URI)
 	builder.WriteByte(')')
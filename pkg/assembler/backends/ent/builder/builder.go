@@ -13,6 +13,8 @@ const (
 	Label = "builder"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldURI holds the string denoting the uri field in the database.
 	FieldURI = "uri"
 	// EdgeSlsaAttestations holds the string denoting the slsa_attestations edge name in mutations.
@@ -31,6 +33,7 @@ const (
 // Columns holds all SQL columns for builder fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldURI,
 }
 
@@ -45,6 +48,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -57,6 +62,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByURI orders the results by the uri field.
 func ByURI(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldURI, opts...).ToFunc()
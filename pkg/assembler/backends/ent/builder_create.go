@@ -24,6 +24,20 @@ type BuilderCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (bc *BuilderCreate) SetTenant(s string) *BuilderCreate {
+	bc.mutation.SetTenant(s)
+	return bc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (bc *BuilderCreate) SetNillableTenant(s *string) *BuilderCreate {
+	if s != nil {
+		bc.SetTenant(*s)
+	}
+	return bc
+}
+
 // SetURI sets the "uri" field.
 func (bc *BuilderCreate) SetURI(s string) *BuilderCreate {
 	bc.mutation.SetURI(s)
@@ -94,6 +108,10 @@ func (bc *BuilderCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (bc *BuilderCreate) defaults() {
+	if _, ok := bc.mutation.Tenant(); !ok {
+		v := builder.DefaultTenant
+		bc.mutation.SetTenant(v)
+	}
 	if _, ok := bc.mutation.ID(); !ok {
 		v := builder.DefaultID()
 		bc.mutation.SetID(v)
@@ -102,6 +120,9 @@ func (bc *BuilderCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (bc *BuilderCreate) check() error {
+	if _, ok := bc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Builder.tenant"`)}
+	}
 	if _, ok := bc.mutation.URI(); !ok {
 		return &ValidationError{Name: "uri", err: errors.New(`ent: missing required field "Builder.uri"`)}
 	}
@@ -141,6 +162,10 @@ func (bc *BuilderCreate) createSpec() (*Builder, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := bc.mutation.Tenant(); ok {
+		_spec.SetField(builder.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := bc.mutation.URI(); ok {
 		_spec.SetField(builder.FieldURI, field.TypeString, value)
 		_node.URI = value
@@ -168,7 +193,7 @@ func (bc *BuilderCreate) createSpec() (*Builder, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Builder.Create().
-//		SetURI(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -177,7 +202,7 @@ func (bc *BuilderCreate) createSpec() (*Builder, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.BuilderUpsert) {
-//			SetURI(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (bc *BuilderCreate) OnConflict(opts ...sql.ConflictOption) *BuilderUpsertOne {
@@ -230,6 +255,9 @@ func (u *BuilderUpsertOne) UpdateNewValues() *BuilderUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(builder.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(builder.FieldTenant)
+		}
 		if _, exists := u.create.mutation.URI(); exists {
 			s.SetIgnore(builder.FieldURI)
 		}
@@ -400,7 +428,7 @@ func (bcb *BuilderCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.BuilderUpsert) {
-//			SetURI(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (bcb *BuilderCreateBulk) OnConflict(opts ...sql.ConflictOption) *BuilderUpsertBulk {
@@ -447,6 +475,9 @@ func (u *BuilderUpsertBulk) UpdateNewValues() *BuilderUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(builder.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(builder.FieldTenant)
+			}
 			if _, exists := b.mutation.URI(); exists {
 				s.SetIgnore(builder.FieldURI)
 			}
@@ -303,12 +303,12 @@ func (bq *BuilderQuery) WithSlsaAttestations(opts ...func(*SLSAAttestationQuery)
 // Example:
 //
 //	var v []struct {
-//		URI string `json:"uri,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Builder.Query().
-//		GroupBy(builder.FieldURI).
+//		GroupBy(builder.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (bq *BuilderQuery) GroupBy(field string, fields ...string) *BuilderGroupBy {
@@ -326,11 +326,11 @@ func (bq *BuilderQuery) GroupBy(field string, fields ...string) *BuilderGroupBy
 // Example:
 //
 //	var v []struct {
-//		URI string `json:"uri,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Builder.Query().
-//		Select(builder.FieldURI).
+//		Select(builder.FieldTenant).
 //		Scan(ctx, &v)
 func (bq *BuilderQuery) Select(fields ...string) *BuilderSelect {
 	bq.ctx.Fields = append(bq.ctx.Fields, fields...)
@@ -20,6 +20,8 @@ type CertifyLegal struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// PackageID holds the value of the "package_id" field.
 	PackageID *uuid.UUID `json:"package_id,omitempty"`
 	// SourceID holds the value of the "source_id" field.
@@ -117,7 +119,7 @@ func (*CertifyLegal) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case certifylegal.FieldPackageID, certifylegal.FieldSourceID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case certifylegal.FieldDeclaredLicense, certifylegal.FieldDiscoveredLicense, certifylegal.FieldAttribution, certifylegal.FieldJustification, certifylegal.FieldOrigin, certifylegal.FieldCollector, certifylegal.FieldDocumentRef, certifylegal.FieldDeclaredLicensesHash, certifylegal.FieldDiscoveredLicensesHash:
+		case certifylegal.FieldTenant, certifylegal.FieldDeclaredLicense, certifylegal.FieldDiscoveredLicense, certifylegal.FieldAttribution, certifylegal.FieldJustification, certifylegal.FieldOrigin, certifylegal.FieldCollector, certifylegal.FieldDocumentRef, certifylegal.FieldDeclaredLicensesHash, certifylegal.FieldDiscoveredLicensesHash:
 			values[i] = new(sql.NullString)
 		case certifylegal.FieldTimeScanned:
 			values[i] = new(sql.NullTime)
@@ -144,6 +146,12 @@ func (cl *CertifyLegal) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				cl.ID = *value
 			}
+		case certifylegal.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				cl.Tenant = value.String
+			}
 		case certifylegal.FieldPackageID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field package_id", values[i])
@@ -274,6 +282,9 @@ func (cl *CertifyLegal) String() string {
 	var builder strings.Builder
 	builder.WriteString("CertifyLegal(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", cl.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(cl.Tenant)
+	builder.WriteString(", ")
 	if v := cl.PackageID; v != nil {
 		builder.WriteString("package_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -411,12 +411,12 @@ func (clq *CertifyLegalQuery) WithDiscoveredLicenses(opts ...func(*LicenseQuery)
 // Example:
 //
 //	var v []struct {
-//		PackageID uuid.UUID `json:"package_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.CertifyLegal.Query().
-//		GroupBy(certifylegal.FieldPackageID).
+//		GroupBy(certifylegal.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (clq *CertifyLegalQuery) GroupBy(field string, fields ...string) *CertifyLegalGroupBy {
@@ -434,11 +434,11 @@ func (clq *CertifyLegalQuery) GroupBy(field string, fields ...string) *CertifyLe
 // Example:
 //
 //	var v []struct {
-//		PackageID uuid.UUID `json:"package_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.CertifyLegal.Query().
-//		Select(certifylegal.FieldPackageID).
+//		Select(certifylegal.FieldTenant).
 //		Scan(ctx, &v)
 func (clq *CertifyLegalQuery) Select(fields ...string) *CertifyLegalSelect {
 	clq.ctx.Fields = append(clq.ctx.Fields, fields...)
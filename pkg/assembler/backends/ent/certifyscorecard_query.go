@@ -301,12 +301,12 @@ func (csq *CertifyScorecardQuery) WithSource(opts ...func(*SourceNameQuery)) *Ce
 // Example:
 //
 //	var v []struct {
-//		SourceID uuid.UUID `json:"source_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.CertifyScorecard.Query().
-//		GroupBy(certifyscorecard.FieldSourceID).
+//		GroupBy(certifyscorecard.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (csq *CertifyScorecardQuery) GroupBy(field string, fields ...string) *CertifyScorecardGroupBy {
@@ -324,11 +324,11 @@ func (csq *CertifyScorecardQuery) GroupBy(field string, fields ...string) *Certi
 // Example:
 //
 //	var v []struct {
-//		SourceID uuid.UUID `json:"source_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.CertifyScorecard.Query().
-//		Select(certifyscorecard.FieldSourceID).
+//		Select(certifyscorecard.FieldTenant).
 //		Scan(ctx, &v)
 func (csq *CertifyScorecardQuery) Select(fields ...string) *CertifyScorecardSelect {
 	csq.ctx.Fields = append(csq.ctx.Fields, fields...)
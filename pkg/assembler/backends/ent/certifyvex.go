@@ -21,6 +21,8 @@ type CertifyVex struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// PackageID holds the value of the "package_id" field.
 	PackageID *uuid.UUID `json:"package_id,omitempty"`
 	// ArtifactID holds the value of the "artifact_id" field.
@@ -104,7 +106,7 @@ func (*CertifyVex) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case certifyvex.FieldPackageID, certifyvex.FieldArtifactID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case certifyvex.FieldStatus, certifyvex.FieldStatement, certifyvex.FieldStatusNotes, certifyvex.FieldJustification, certifyvex.FieldOrigin, certifyvex.FieldCollector, certifyvex.FieldDocumentRef:
+		case certifyvex.FieldTenant, certifyvex.FieldStatus, certifyvex.FieldStatement, certifyvex.FieldStatusNotes, certifyvex.FieldJustification, certifyvex.FieldOrigin, certifyvex.FieldCollector, certifyvex.FieldDocumentRef:
 			values[i] = new(sql.NullString)
 		case certifyvex.FieldKnownSince:
 			values[i] = new(sql.NullTime)
@@ -131,6 +133,12 @@ func (cv *CertifyVex) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				cv.ID = *value
 			}
+		case certifyvex.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				cv.Tenant = value.String
+			}
 		case certifyvex.FieldPackageID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field package_id", values[i])
@@ -250,6 +258,9 @@ func (cv *CertifyVex) String() string {
 	var builder strings.Builder
 	builder.WriteString("CertifyVex(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", cv.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(cv.Tenant)
+	builder.WriteString(", ")
 	if v := cv.PackageID; v != nil {
 		builder.WriteString("package_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -373,12 +373,12 @@ func (cvq *CertifyVexQuery) WithVulnerability(opts ...func(*VulnerabilityIDQuery
 // Example:
 //
 //	var v []struct {
-//		PackageID uuid.UUID `json:"package_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.CertifyVex.Query().
-//		GroupBy(certifyvex.FieldPackageID).
+//		GroupBy(certifyvex.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (cvq *CertifyVexQuery) GroupBy(field string, fields ...string) *CertifyVexGroupBy {
@@ -396,11 +396,11 @@ func (cvq *CertifyVexQuery) GroupBy(field string, fields ...string) *CertifyVexG
 // Example:
 //
 //	var v []struct {
-//		PackageID uuid.UUID `json:"package_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.CertifyVex.Query().
-//		Select(certifyvex.FieldPackageID).
+//		Select(certifyvex.FieldTenant).
 //		Scan(ctx, &v)
 func (cvq *CertifyVexQuery) Select(fields ...string) *CertifyVexSelect {
 	cvq.ctx.Fields = append(cvq.ctx.Fields, fields...)
@@ -20,6 +20,8 @@ type CertifyVuln struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// VulnerabilityID holds the value of the "vulnerability_id" field.
 	VulnerabilityID uuid.UUID `json:"vulnerability_id,omitempty"`
 	// PackageID holds the value of the "package_id" field.
@@ -86,7 +88,7 @@ func (*CertifyVuln) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case certifyvuln.FieldDbURI, certifyvuln.FieldDbVersion, certifyvuln.FieldScannerURI, certifyvuln.FieldScannerVersion, certifyvuln.FieldOrigin, certifyvuln.FieldCollector, certifyvuln.FieldDocumentRef:
+		case certifyvuln.FieldTenant, certifyvuln.FieldDbURI, certifyvuln.FieldDbVersion, certifyvuln.FieldScannerURI, certifyvuln.FieldScannerVersion, certifyvuln.FieldOrigin, certifyvuln.FieldCollector, certifyvuln.FieldDocumentRef:
 			values[i] = new(sql.NullString)
 		case certifyvuln.FieldTimeScanned:
 			values[i] = new(sql.NullTime)
@@ -113,6 +115,12 @@ func (cv *CertifyVuln) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				cv.ID = *value
 			}
+		case certifyvuln.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				cv.Tenant = value.String
+			}
 		case certifyvuln.FieldVulnerabilityID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field vulnerability_id", values[i])
@@ -219,6 +227,9 @@ func (cv *CertifyVuln) String() string {
 	var builder strings.Builder
 	builder.WriteString("CertifyVuln(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", cv.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(cv.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("vulnerability_id=")
 	builder.WriteString(fmt.Sprintf("%v", cv.VulnerabilityID))
 	builder.WriteString(", ")
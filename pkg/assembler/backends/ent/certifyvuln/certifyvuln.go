@@ -13,6 +13,8 @@ const (
 	Label = "certify_vuln"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldVulnerabilityID holds the string denoting the vulnerability_id field in the database.
 	FieldVulnerabilityID = "vulnerability_id"
 	// FieldPackageID holds the string denoting the package_id field in the database.
@@ -58,6 +60,7 @@ const (
 // Columns holds all SQL columns for certifyvuln fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldVulnerabilityID,
 	FieldPackageID,
 	FieldTimeScanned,
@@ -81,6 +84,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -93,6 +98,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByVulnerabilityID orders the results by the vulnerability_id field.
 func ByVulnerabilityID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVulnerabilityID, opts...).ToFunc()
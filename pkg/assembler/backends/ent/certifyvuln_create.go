@@ -26,6 +26,20 @@ type CertifyVulnCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (cvc *CertifyVulnCreate) SetTenant(s string) *CertifyVulnCreate {
+	cvc.mutation.SetTenant(s)
+	return cvc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (cvc *CertifyVulnCreate) SetNillableTenant(s *string) *CertifyVulnCreate {
+	if s != nil {
+		cvc.SetTenant(*s)
+	}
+	return cvc
+}
+
 // SetVulnerabilityID sets the "vulnerability_id" field.
 func (cvc *CertifyVulnCreate) SetVulnerabilityID(u uuid.UUID) *CertifyVulnCreate {
 	cvc.mutation.SetVulnerabilityID(u)
@@ -145,6 +159,10 @@ func (cvc *CertifyVulnCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cvc *CertifyVulnCreate) defaults() {
+	if _, ok := cvc.mutation.Tenant(); !ok {
+		v := certifyvuln.DefaultTenant
+		cvc.mutation.SetTenant(v)
+	}
 	if _, ok := cvc.mutation.ID(); !ok {
 		v := certifyvuln.DefaultID()
 		cvc.mutation.SetID(v)
@@ -153,6 +171,9 @@ func (cvc *CertifyVulnCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (cvc *CertifyVulnCreate) check() error {
+	if _, ok := cvc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "CertifyVuln.tenant"`)}
+	}
 	if _, ok := cvc.mutation.VulnerabilityID(); !ok {
 		return &ValidationError{Name: "vulnerability_id", err: errors.New(`ent: missing required field "CertifyVuln.vulnerability_id"`)}
 	}
@@ -225,6 +246,10 @@ func (cvc *CertifyVulnCreate) createSpec() (*CertifyVuln, *sqlgraph.CreateSpec)
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := cvc.mutation.Tenant(); ok {
+		_spec.SetField(certifyvuln.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := cvc.mutation.TimeScanned(); ok {
 		_spec.SetField(certifyvuln.FieldTimeScanned, field.TypeTime, value)
 		_node.TimeScanned = value
@@ -298,7 +323,7 @@ func (cvc *CertifyVulnCreate) createSpec() (*CertifyVuln, *sqlgraph.CreateSpec)
 // of the `INSERT` statement. For example:
 //
 //	client.CertifyVuln.Create().
-//		SetVulnerabilityID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -307,7 +332,7 @@ func (cvc *CertifyVulnCreate) createSpec() (*CertifyVuln, *sqlgraph.CreateSpec)
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.CertifyVulnUpsert) {
-//			SetVulnerabilityID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (cvc *CertifyVulnCreate) OnConflict(opts ...sql.ConflictOption) *CertifyVulnUpsertOne {
@@ -480,6 +505,9 @@ func (u *CertifyVulnUpsertOne) UpdateNewValues() *CertifyVulnUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(certifyvuln.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(certifyvuln.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -787,7 +815,7 @@ func (cvcb *CertifyVulnCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.CertifyVulnUpsert) {
-//			SetVulnerabilityID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (cvcb *CertifyVulnCreateBulk) OnConflict(opts ...sql.ConflictOption) *CertifyVulnUpsertBulk {
@@ -834,6 +862,9 @@ func (u *CertifyVulnUpsertBulk) UpdateNewValues() *CertifyVulnUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(certifyvuln.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(certifyvuln.FieldTenant)
+			}
 		}
 	}))
 	return u
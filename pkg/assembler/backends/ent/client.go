@@ -25,6 +25,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/document"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -64,6 +65,8 @@ type Client struct {
 	CertifyVuln *CertifyVulnClient
 	// Dependency is the client for interacting with the Dependency builders.
 	Dependency *DependencyClient
+	// Document is the client for interacting with the Document builders.
+	Document *DocumentClient
 	// HasMetadata is the client for interacting with the HasMetadata builders.
 	HasMetadata *HasMetadataClient
 	// HasSourceAt is the client for interacting with the HasSourceAt builders.
@@ -112,6 +115,7 @@ func (c *Client) init() {
 	c.CertifyVex = NewCertifyVexClient(c.config)
 	c.CertifyVuln = NewCertifyVulnClient(c.config)
 	c.Dependency = NewDependencyClient(c.config)
+	c.Document = NewDocumentClient(c.config)
 	c.HasMetadata = NewHasMetadataClient(c.config)
 	c.HasSourceAt = NewHasSourceAtClient(c.config)
 	c.HashEqual = NewHashEqualClient(c.config)
@@ -227,6 +231,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		CertifyVex:            NewCertifyVexClient(cfg),
 		CertifyVuln:           NewCertifyVulnClient(cfg),
 		Dependency:            NewDependencyClient(cfg),
+		Document:              NewDocumentClient(cfg),
 		HasMetadata:           NewHasMetadataClient(cfg),
 		HasSourceAt:           NewHasSourceAtClient(cfg),
 		HashEqual:             NewHashEqualClient(cfg),
@@ -269,6 +274,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		CertifyVex:            NewCertifyVexClient(cfg),
 		CertifyVuln:           NewCertifyVulnClient(cfg),
 		Dependency:            NewDependencyClient(cfg),
+		Document:              NewDocumentClient(cfg),
 		HasMetadata:           NewHasMetadataClient(cfg),
 		HasSourceAt:           NewHasSourceAtClient(cfg),
 		HashEqual:             NewHashEqualClient(cfg),
@@ -313,10 +319,11 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.Artifact, c.BillOfMaterials, c.Builder, c.Certification, c.CertifyLegal,
-		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Dependency, c.HasMetadata,
-		c.HasSourceAt, c.HashEqual, c.License, c.Occurrence, c.PackageName,
-		c.PackageVersion, c.PkgEqual, c.PointOfContact, c.SLSAAttestation,
-		c.SourceName, c.VulnEqual, c.VulnerabilityID, c.VulnerabilityMetadata,
+		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Dependency, c.Document,
+		c.HasMetadata, c.HasSourceAt, c.HashEqual, c.License, c.Occurrence,
+		c.PackageName, c.PackageVersion, c.PkgEqual, c.PointOfContact,
+		c.SLSAAttestation, c.SourceName, c.VulnEqual, c.VulnerabilityID,
+		c.VulnerabilityMetadata,
 	} {
 		n.Use(hooks...)
 	}
@@ -327,10 +334,11 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.Artifact, c.BillOfMaterials, c.Builder, c.Certification, c.CertifyLegal,
-		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Dependency, c.HasMetadata,
-		c.HasSourceAt, c.HashEqual, c.License, c.Occurrence, c.PackageName,
-		c.PackageVersion, c.PkgEqual, c.PointOfContact, c.SLSAAttestation,
-		c.SourceName, c.VulnEqual, c.VulnerabilityID, c.VulnerabilityMetadata,
+		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Dependency, c.Document,
+		c.HasMetadata, c.HasSourceAt, c.HashEqual, c.License, c.Occurrence,
+		c.PackageName, c.PackageVersion, c.PkgEqual, c.PointOfContact,
+		c.SLSAAttestation, c.SourceName, c.VulnEqual, c.VulnerabilityID,
+		c.VulnerabilityMetadata,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -357,6 +365,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.CertifyVuln.mutate(ctx, m)
 	case *DependencyMutation:
 		return c.Dependency.mutate(ctx, m)
+	case *DocumentMutation:
+		return c.Document.mutate(ctx, m)
 	case *HasMetadataMutation:
 		return c.HasMetadata.mutate(ctx, m)
 	case *HasSourceAtMutation:
@@ -2147,6 +2157,139 @@ func (c *DependencyClient) mutate(ctx context.Context, m *DependencyMutation) (V
 	}
 }
 
+// DocumentClient is a client for the Document schema.
+type DocumentClient struct {
+	config
+}
+
+// NewDocumentClient returns a client for the Document from the given config.
+func NewDocumentClient(c config) *DocumentClient {
+	return &DocumentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `document.Hooks(f(g(h())))`.
+func (c *DocumentClient) Use(hooks ...Hook) {
+	c.hooks.Document = append(c.hooks.Document, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `document.Intercept(f(g(h())))`.
+func (c *DocumentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Document = append(c.inters.Document, interceptors...)
+}
+
+// Create returns a builder for creating a Document entity.
+func (c *DocumentClient) Create() *DocumentCreate {
+	mutation := newDocumentMutation(c.config, OpCreate)
+	return &DocumentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Document entities.
+func (c *DocumentClient) CreateBulk(builders ...*DocumentCreate) *DocumentCreateBulk {
+	return &DocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocumentClient) MapCreateBulk(slice any, setFunc func(*DocumentCreate, int)) *DocumentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocumentCreateBulk{err: fmt.Errorf("calling to DocumentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocumentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Document.
+func (c *DocumentClient) Update() *DocumentUpdate {
+	mutation := newDocumentMutation(c.config, OpUpdate)
+	return &DocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DocumentClient) UpdateOne(d *Document) *DocumentUpdateOne {
+	mutation := newDocumentMutation(c.config, OpUpdateOne, withDocument(d))
+	return &DocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocumentClient) UpdateOneID(id uuid.UUID) *DocumentUpdateOne {
+	mutation := newDocumentMutation(c.config, OpUpdateOne, withDocumentID(id))
+	return &DocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Document.
+func (c *DocumentClient) Delete() *DocumentDelete {
+	mutation := newDocumentMutation(c.config, OpDelete)
+	return &DocumentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocumentClient) DeleteOne(d *Document) *DocumentDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocumentClient) DeleteOneID(id uuid.UUID) *DocumentDeleteOne {
+	builder := c.Delete().Where(document.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocumentDeleteOne{builder}
+}
+
+// Query returns a query builder for Document.
+func (c *DocumentClient) Query() *DocumentQuery {
+	return &DocumentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocument},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Document entity by its id.
+func (c *DocumentClient) Get(ctx context.Context, id uuid.UUID) (*Document, error) {
+	return c.Query().Where(document.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocumentClient) GetX(ctx context.Context, id uuid.UUID) *Document {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentClient) Hooks() []Hook {
+	return c.hooks.Document
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentClient) Interceptors() []Interceptor {
+	return c.inters.Document
+}
+
+func (c *DocumentClient) mutate(ctx context.Context, m *DocumentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocumentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Document mutation op: %q", m.Op())
+	}
+}
+
 // HasMetadataClient is a client for the HasMetadata schema.
 type HasMetadataClient struct {
 	config
@@ -4957,14 +5100,14 @@ func (c *VulnerabilityMetadataClient) mutate(ctx context.Context, m *Vulnerabili
 type (
 	hooks struct {
 		Artifact, BillOfMaterials, Builder, Certification, CertifyLegal,
-		CertifyScorecard, CertifyVex, CertifyVuln, Dependency, HasMetadata,
+		CertifyScorecard, CertifyVex, CertifyVuln, Dependency, Document, HasMetadata,
 		HasSourceAt, HashEqual, License, Occurrence, PackageName, PackageVersion,
 		PkgEqual, PointOfContact, SLSAAttestation, SourceName, VulnEqual,
 		VulnerabilityID, VulnerabilityMetadata []ent.Hook
 	}
 	inters struct {
 		Artifact, BillOfMaterials, Builder, Certification, CertifyLegal,
-		CertifyScorecard, CertifyVex, CertifyVuln, Dependency, HasMetadata,
+		CertifyScorecard, CertifyVex, CertifyVuln, Dependency, Document, HasMetadata,
 		HasSourceAt, HashEqual, License, Occurrence, PackageName, PackageVersion,
 		PkgEqual, PointOfContact, SLSAAttestation, SourceName, VulnEqual,
 		VulnerabilityID, VulnerabilityMetadata []ent.Interceptor
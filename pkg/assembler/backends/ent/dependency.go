@@ -18,6 +18,8 @@ type Dependency struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// PackageID holds the value of the "package_id" field.
 	PackageID uuid.UUID `json:"package_id,omitempty"`
 	// DependentPackageVersionID holds the value of the "dependent_package_version_id" field.
@@ -91,7 +93,7 @@ func (*Dependency) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case dependency.FieldDependencyType, dependency.FieldJustification, dependency.FieldOrigin, dependency.FieldCollector, dependency.FieldDocumentRef:
+		case dependency.FieldTenant, dependency.FieldDependencyType, dependency.FieldJustification, dependency.FieldOrigin, dependency.FieldCollector, dependency.FieldDocumentRef:
 			values[i] = new(sql.NullString)
 		case dependency.FieldID, dependency.FieldPackageID, dependency.FieldDependentPackageVersionID:
 			values[i] = new(uuid.UUID)
@@ -116,6 +118,12 @@ func (d *Dependency) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				d.ID = *value
 			}
+		case dependency.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				d.Tenant = value.String
+			}
 		case dependency.FieldPackageID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field package_id", values[i])
@@ -209,6 +217,9 @@ func (d *Dependency) String() string {
 	var builder strings.Builder
 	builder.WriteString("Dependency(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(d.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("package_id=")
 	builder.WriteString(fmt.Sprintf("%v", d.PackageID))
 	builder.WriteString(", ")
@@ -25,6 +25,20 @@ type DependencyCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (dc *DependencyCreate) SetTenant(s string) *DependencyCreate {
+	dc.mutation.SetTenant(s)
+	return dc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (dc *DependencyCreate) SetNillableTenant(s *string) *DependencyCreate {
+	if s != nil {
+		dc.SetTenant(*s)
+	}
+	return dc
+}
+
 // SetPackageID sets the "package_id" field.
 func (dc *DependencyCreate) SetPackageID(u uuid.UUID) *DependencyCreate {
 	dc.mutation.SetPackageID(u)
@@ -141,6 +155,10 @@ func (dc *DependencyCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (dc *DependencyCreate) defaults() {
+	if _, ok := dc.mutation.Tenant(); !ok {
+		v := dependency.DefaultTenant
+		dc.mutation.SetTenant(v)
+	}
 	if _, ok := dc.mutation.ID(); !ok {
 		v := dependency.DefaultID()
 		dc.mutation.SetID(v)
@@ -149,6 +167,9 @@ func (dc *DependencyCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (dc *DependencyCreate) check() error {
+	if _, ok := dc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Dependency.tenant"`)}
+	}
 	if _, ok := dc.mutation.PackageID(); !ok {
 		return &ValidationError{Name: "package_id", err: errors.New(`ent: missing required field "Dependency.package_id"`)}
 	}
@@ -217,6 +238,10 @@ func (dc *DependencyCreate) createSpec() (*Dependency, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := dc.mutation.Tenant(); ok {
+		_spec.SetField(dependency.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := dc.mutation.DependencyType(); ok {
 		_spec.SetField(dependency.FieldDependencyType, field.TypeEnum, value)
 		_node.DependencyType = value
@@ -294,7 +319,7 @@ func (dc *DependencyCreate) createSpec() (*Dependency, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Dependency.Create().
-//		SetPackageID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -303,7 +328,7 @@ func (dc *DependencyCreate) createSpec() (*Dependency, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.DependencyUpsert) {
-//			SetPackageID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (dc *DependencyCreate) OnConflict(opts ...sql.ConflictOption) *DependencyUpsertOne {
@@ -440,6 +465,9 @@ func (u *DependencyUpsertOne) UpdateNewValues() *DependencyUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(dependency.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(dependency.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -705,7 +733,7 @@ func (dcb *DependencyCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.DependencyUpsert) {
-//			SetPackageID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (dcb *DependencyCreateBulk) OnConflict(opts ...sql.ConflictOption) *DependencyUpsertBulk {
@@ -752,6 +780,9 @@ func (u *DependencyUpsertBulk) UpdateNewValues() *DependencyUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(dependency.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(dependency.FieldTenant)
+			}
 		}
 	}))
 	return u
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/document"
+)
+
+// Document is the model entity for the Document schema.
+type Document struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
+	// Blob store key of the document, matching document_ref on evidence
+	BlobKey string `json:"blob_key,omitempty"`
+	// Sha256 holds the value of the "sha256" field.
+	Sha256 string `json:"sha256,omitempty"`
+	// DocumentType holds the value of the "document_type" field.
+	DocumentType string `json:"document_type,omitempty"`
+	// Format holds the value of the "format" field.
+	Format string `json:"format,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// ParserVersion holds the value of the "parser_version" field.
+	ParserVersion string `json:"parser_version,omitempty"`
+	// IngestedAt holds the value of the "ingested_at" field.
+	IngestedAt   time.Time `json:"ingested_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Document) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case document.FieldTenant, document.FieldBlobKey, document.FieldSha256, document.FieldDocumentType, document.FieldFormat, document.FieldCollector, document.FieldSource, document.FieldParserVersion:
+			values[i] = new(sql.NullString)
+		case document.FieldIngestedAt:
+			values[i] = new(sql.NullTime)
+		case document.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Document fields.
+func (d *Document) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case document.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				d.ID = *value
+			}
+		case document.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				d.Tenant = value.String
+			}
+		case document.FieldBlobKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blob_key", values[i])
+			} else if value.Valid {
+				d.BlobKey = value.String
+			}
+		case document.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				d.Sha256 = value.String
+			}
+		case document.FieldDocumentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_type", values[i])
+			} else if value.Valid {
+				d.DocumentType = value.String
+			}
+		case document.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				d.Format = value.String
+			}
+		case document.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				d.Collector = value.String
+			}
+		case document.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				d.Source = value.String
+			}
+		case document.FieldParserVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parser_version", values[i])
+			} else if value.Valid {
+				d.ParserVersion = value.String
+			}
+		case document.FieldIngestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ingested_at", values[i])
+			} else if value.Valid {
+				d.IngestedAt = value.Time
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Document.
+// This includes values selected through modifiers, order, etc.
+func (d *Document) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Document.
+// Note that you need to call Document.Unwrap() before calling this method if this Document
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Document) Update() *DocumentUpdateOne {
+	return NewDocumentClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Document entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Document) Unwrap() *Document {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Document is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Document) String() string {
+	var builder strings.Builder
+	builder.WriteString("Document(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(d.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("blob_key=")
+	builder.WriteString(d.BlobKey)
+	builder.WriteString(", ")
+	builder.WriteString("sha256=")
+	builder.WriteString(d.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("document_type=")
+	builder.WriteString(d.DocumentType)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(d.Format)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(d.Collector)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(d.Source)
+	builder.WriteString(", ")
+	builder.WriteString("parser_version=")
+	builder.WriteString(d.ParserVersion)
+	builder.WriteString(", ")
+	builder.WriteString("ingested_at=")
+	builder.WriteString(d.IngestedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Documents is a parsable slice of Document.
+type Documents []*Document
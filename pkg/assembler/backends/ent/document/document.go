@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package document
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the document type in the database.
+	Label = "document"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldBlobKey holds the string denoting the blob_key field in the database.
+	FieldBlobKey = "blob_key"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
+	// FieldDocumentType holds the string denoting the document_type field in the database.
+	FieldDocumentType = "document_type"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldParserVersion holds the string denoting the parser_version field in the database.
+	FieldParserVersion = "parser_version"
+	// FieldIngestedAt holds the string denoting the ingested_at field in the database.
+	FieldIngestedAt = "ingested_at"
+	// Table holds the table name of the document in the database.
+	Table = "documents"
+)
+
+// Columns holds all SQL columns for document fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldBlobKey,
+	FieldSha256,
+	FieldDocumentType,
+	FieldFormat,
+	FieldCollector,
+	FieldSource,
+	FieldParserVersion,
+	FieldIngestedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// BlobKeyValidator is a validator for the "blob_key" field. It is called by the builders before save.
+	BlobKeyValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Document queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByBlobKey orders the results by the blob_key field.
+func ByBlobKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlobKey, opts...).ToFunc()
+}
+
+// BySha256 orders the results by the sha256 field.
+func BySha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSha256, opts...).ToFunc()
+}
+
+// ByDocumentType orders the results by the document_type field.
+func ByDocumentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentType, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByCollector orders the results by the collector field.
+func ByCollector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollector, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByParserVersion orders the results by the parser_version field.
+func ByParserVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParserVersion, opts...).ToFunc()
+}
+
+// ByIngestedAt orders the results by the ingested_at field.
+func ByIngestedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIngestedAt, opts...).ToFunc()
+}
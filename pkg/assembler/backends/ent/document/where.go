@@ -0,0 +1,676 @@
+// Code generated by ent, DO NOT EDIT.
+
+package document
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldTenant, v))
+}
+
+// BlobKey applies equality check predicate on the "blob_key" field. It's identical to BlobKeyEQ.
+func BlobKey(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldBlobKey, v))
+}
+
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldSha256, v))
+}
+
+// DocumentType applies equality check predicate on the "document_type" field. It's identical to DocumentTypeEQ.
+func DocumentType(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldDocumentType, v))
+}
+
+// Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
+func Format(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldFormat, v))
+}
+
+// Collector applies equality check predicate on the "collector" field. It's identical to CollectorEQ.
+func Collector(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldCollector, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldSource, v))
+}
+
+// ParserVersion applies equality check predicate on the "parser_version" field. It's identical to ParserVersionEQ.
+func ParserVersion(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldParserVersion, v))
+}
+
+// IngestedAt applies equality check predicate on the "ingested_at" field. It's identical to IngestedAtEQ.
+func IngestedAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldIngestedAt, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// BlobKeyEQ applies the EQ predicate on the "blob_key" field.
+func BlobKeyEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldBlobKey, v))
+}
+
+// BlobKeyNEQ applies the NEQ predicate on the "blob_key" field.
+func BlobKeyNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldBlobKey, v))
+}
+
+// BlobKeyIn applies the In predicate on the "blob_key" field.
+func BlobKeyIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldBlobKey, vs...))
+}
+
+// BlobKeyNotIn applies the NotIn predicate on the "blob_key" field.
+func BlobKeyNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldBlobKey, vs...))
+}
+
+// BlobKeyGT applies the GT predicate on the "blob_key" field.
+func BlobKeyGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldBlobKey, v))
+}
+
+// BlobKeyGTE applies the GTE predicate on the "blob_key" field.
+func BlobKeyGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldBlobKey, v))
+}
+
+// BlobKeyLT applies the LT predicate on the "blob_key" field.
+func BlobKeyLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldBlobKey, v))
+}
+
+// BlobKeyLTE applies the LTE predicate on the "blob_key" field.
+func BlobKeyLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldBlobKey, v))
+}
+
+// BlobKeyContains applies the Contains predicate on the "blob_key" field.
+func BlobKeyContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldBlobKey, v))
+}
+
+// BlobKeyHasPrefix applies the HasPrefix predicate on the "blob_key" field.
+func BlobKeyHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldBlobKey, v))
+}
+
+// BlobKeyHasSuffix applies the HasSuffix predicate on the "blob_key" field.
+func BlobKeyHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldBlobKey, v))
+}
+
+// BlobKeyEqualFold applies the EqualFold predicate on the "blob_key" field.
+func BlobKeyEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldBlobKey, v))
+}
+
+// BlobKeyContainsFold applies the ContainsFold predicate on the "blob_key" field.
+func BlobKeyContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldBlobKey, v))
+}
+
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldSha256, v))
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldSha256, v))
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldSha256, vs...))
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldSha256, vs...))
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldSha256, v))
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldSha256, v))
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldSha256, v))
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldSha256, v))
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldSha256, v))
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldSha256, v))
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldSha256, v))
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldSha256, v))
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldSha256, v))
+}
+
+// DocumentTypeEQ applies the EQ predicate on the "document_type" field.
+func DocumentTypeEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldDocumentType, v))
+}
+
+// DocumentTypeNEQ applies the NEQ predicate on the "document_type" field.
+func DocumentTypeNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldDocumentType, v))
+}
+
+// DocumentTypeIn applies the In predicate on the "document_type" field.
+func DocumentTypeIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldDocumentType, vs...))
+}
+
+// DocumentTypeNotIn applies the NotIn predicate on the "document_type" field.
+func DocumentTypeNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldDocumentType, vs...))
+}
+
+// DocumentTypeGT applies the GT predicate on the "document_type" field.
+func DocumentTypeGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldDocumentType, v))
+}
+
+// DocumentTypeGTE applies the GTE predicate on the "document_type" field.
+func DocumentTypeGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldDocumentType, v))
+}
+
+// DocumentTypeLT applies the LT predicate on the "document_type" field.
+func DocumentTypeLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldDocumentType, v))
+}
+
+// DocumentTypeLTE applies the LTE predicate on the "document_type" field.
+func DocumentTypeLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldDocumentType, v))
+}
+
+// DocumentTypeContains applies the Contains predicate on the "document_type" field.
+func DocumentTypeContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldDocumentType, v))
+}
+
+// DocumentTypeHasPrefix applies the HasPrefix predicate on the "document_type" field.
+func DocumentTypeHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldDocumentType, v))
+}
+
+// DocumentTypeHasSuffix applies the HasSuffix predicate on the "document_type" field.
+func DocumentTypeHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldDocumentType, v))
+}
+
+// DocumentTypeEqualFold applies the EqualFold predicate on the "document_type" field.
+func DocumentTypeEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldDocumentType, v))
+}
+
+// DocumentTypeContainsFold applies the ContainsFold predicate on the "document_type" field.
+func DocumentTypeContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldDocumentType, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatGT applies the GT predicate on the "format" field.
+func FormatGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldFormat, v))
+}
+
+// FormatGTE applies the GTE predicate on the "format" field.
+func FormatGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldFormat, v))
+}
+
+// FormatLT applies the LT predicate on the "format" field.
+func FormatLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldFormat, v))
+}
+
+// FormatLTE applies the LTE predicate on the "format" field.
+func FormatLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldFormat, v))
+}
+
+// FormatContains applies the Contains predicate on the "format" field.
+func FormatContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldFormat, v))
+}
+
+// FormatHasPrefix applies the HasPrefix predicate on the "format" field.
+func FormatHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldFormat, v))
+}
+
+// FormatHasSuffix applies the HasSuffix predicate on the "format" field.
+func FormatHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldFormat, v))
+}
+
+// FormatEqualFold applies the EqualFold predicate on the "format" field.
+func FormatEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldFormat, v))
+}
+
+// FormatContainsFold applies the ContainsFold predicate on the "format" field.
+func FormatContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldFormat, v))
+}
+
+// CollectorEQ applies the EQ predicate on the "collector" field.
+func CollectorEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldCollector, v))
+}
+
+// CollectorNEQ applies the NEQ predicate on the "collector" field.
+func CollectorNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldCollector, v))
+}
+
+// CollectorIn applies the In predicate on the "collector" field.
+func CollectorIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldCollector, vs...))
+}
+
+// CollectorNotIn applies the NotIn predicate on the "collector" field.
+func CollectorNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldCollector, vs...))
+}
+
+// CollectorGT applies the GT predicate on the "collector" field.
+func CollectorGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldCollector, v))
+}
+
+// CollectorGTE applies the GTE predicate on the "collector" field.
+func CollectorGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldCollector, v))
+}
+
+// CollectorLT applies the LT predicate on the "collector" field.
+func CollectorLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldCollector, v))
+}
+
+// CollectorLTE applies the LTE predicate on the "collector" field.
+func CollectorLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldCollector, v))
+}
+
+// CollectorContains applies the Contains predicate on the "collector" field.
+func CollectorContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldCollector, v))
+}
+
+// CollectorHasPrefix applies the HasPrefix predicate on the "collector" field.
+func CollectorHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldCollector, v))
+}
+
+// CollectorHasSuffix applies the HasSuffix predicate on the "collector" field.
+func CollectorHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldCollector, v))
+}
+
+// CollectorEqualFold applies the EqualFold predicate on the "collector" field.
+func CollectorEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldCollector, v))
+}
+
+// CollectorContainsFold applies the ContainsFold predicate on the "collector" field.
+func CollectorContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldCollector, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldSource, v))
+}
+
+// ParserVersionEQ applies the EQ predicate on the "parser_version" field.
+func ParserVersionEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldParserVersion, v))
+}
+
+// ParserVersionNEQ applies the NEQ predicate on the "parser_version" field.
+func ParserVersionNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldParserVersion, v))
+}
+
+// ParserVersionIn applies the In predicate on the "parser_version" field.
+func ParserVersionIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldParserVersion, vs...))
+}
+
+// ParserVersionNotIn applies the NotIn predicate on the "parser_version" field.
+func ParserVersionNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldParserVersion, vs...))
+}
+
+// ParserVersionGT applies the GT predicate on the "parser_version" field.
+func ParserVersionGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldParserVersion, v))
+}
+
+// ParserVersionGTE applies the GTE predicate on the "parser_version" field.
+func ParserVersionGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldParserVersion, v))
+}
+
+// ParserVersionLT applies the LT predicate on the "parser_version" field.
+func ParserVersionLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldParserVersion, v))
+}
+
+// ParserVersionLTE applies the LTE predicate on the "parser_version" field.
+func ParserVersionLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldParserVersion, v))
+}
+
+// ParserVersionContains applies the Contains predicate on the "parser_version" field.
+func ParserVersionContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldParserVersion, v))
+}
+
+// ParserVersionHasPrefix applies the HasPrefix predicate on the "parser_version" field.
+func ParserVersionHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldParserVersion, v))
+}
+
+// ParserVersionHasSuffix applies the HasSuffix predicate on the "parser_version" field.
+func ParserVersionHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldParserVersion, v))
+}
+
+// ParserVersionEqualFold applies the EqualFold predicate on the "parser_version" field.
+func ParserVersionEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldParserVersion, v))
+}
+
+// ParserVersionContainsFold applies the ContainsFold predicate on the "parser_version" field.
+func ParserVersionContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldParserVersion, v))
+}
+
+// IngestedAtEQ applies the EQ predicate on the "ingested_at" field.
+func IngestedAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldIngestedAt, v))
+}
+
+// IngestedAtNEQ applies the NEQ predicate on the "ingested_at" field.
+func IngestedAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldIngestedAt, v))
+}
+
+// IngestedAtIn applies the In predicate on the "ingested_at" field.
+func IngestedAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldIngestedAt, vs...))
+}
+
+// IngestedAtNotIn applies the NotIn predicate on the "ingested_at" field.
+func IngestedAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldIngestedAt, vs...))
+}
+
+// IngestedAtGT applies the GT predicate on the "ingested_at" field.
+func IngestedAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldIngestedAt, v))
+}
+
+// IngestedAtGTE applies the GTE predicate on the "ingested_at" field.
+func IngestedAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldIngestedAt, v))
+}
+
+// IngestedAtLT applies the LT predicate on the "ingested_at" field.
+func IngestedAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldIngestedAt, v))
+}
+
+// IngestedAtLTE applies the LTE predicate on the "ingested_at" field.
+func IngestedAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldIngestedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Document) predicate.Document {
+	return predicate.Document(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Document) predicate.Document {
+	return predicate.Document(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Document) predicate.Document {
+	return predicate.Document(sql.NotPredicates(p))
+}
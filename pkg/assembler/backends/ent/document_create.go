@@ -0,0 +1,916 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/document"
+)
+
+// DocumentCreate is the builder for creating a Document entity.
+type DocumentCreate struct {
+	config
+	mutation *DocumentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenant sets the "tenant" field.
+func (dc *DocumentCreate) SetTenant(s string) *DocumentCreate {
+	dc.mutation.SetTenant(s)
+	return dc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableTenant(s *string) *DocumentCreate {
+	if s != nil {
+		dc.SetTenant(*s)
+	}
+	return dc
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (dc *DocumentCreate) SetBlobKey(s string) *DocumentCreate {
+	dc.mutation.SetBlobKey(s)
+	return dc
+}
+
+// SetSha256 sets the "sha256" field.
+func (dc *DocumentCreate) SetSha256(s string) *DocumentCreate {
+	dc.mutation.SetSha256(s)
+	return dc
+}
+
+// SetDocumentType sets the "document_type" field.
+func (dc *DocumentCreate) SetDocumentType(s string) *DocumentCreate {
+	dc.mutation.SetDocumentType(s)
+	return dc
+}
+
+// SetFormat sets the "format" field.
+func (dc *DocumentCreate) SetFormat(s string) *DocumentCreate {
+	dc.mutation.SetFormat(s)
+	return dc
+}
+
+// SetCollector sets the "collector" field.
+func (dc *DocumentCreate) SetCollector(s string) *DocumentCreate {
+	dc.mutation.SetCollector(s)
+	return dc
+}
+
+// SetSource sets the "source" field.
+func (dc *DocumentCreate) SetSource(s string) *DocumentCreate {
+	dc.mutation.SetSource(s)
+	return dc
+}
+
+// SetParserVersion sets the "parser_version" field.
+func (dc *DocumentCreate) SetParserVersion(s string) *DocumentCreate {
+	dc.mutation.SetParserVersion(s)
+	return dc
+}
+
+// SetIngestedAt sets the "ingested_at" field.
+func (dc *DocumentCreate) SetIngestedAt(t time.Time) *DocumentCreate {
+	dc.mutation.SetIngestedAt(t)
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DocumentCreate) SetID(u uuid.UUID) *DocumentCreate {
+	dc.mutation.SetID(u)
+	return dc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableID(u *uuid.UUID) *DocumentCreate {
+	if u != nil {
+		dc.SetID(*u)
+	}
+	return dc
+}
+
+// Mutation returns the DocumentMutation object of the builder.
+func (dc *DocumentCreate) Mutation() *DocumentMutation {
+	return dc.mutation
+}
+
+// Save creates the Document in the database.
+func (dc *DocumentCreate) Save(ctx context.Context) (*Document, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DocumentCreate) SaveX(ctx context.Context) *Document {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DocumentCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DocumentCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DocumentCreate) defaults() {
+	if _, ok := dc.mutation.Tenant(); !ok {
+		v := document.DefaultTenant
+		dc.mutation.SetTenant(v)
+	}
+	if _, ok := dc.mutation.ID(); !ok {
+		v := document.DefaultID()
+		dc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DocumentCreate) check() error {
+	if _, ok := dc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Document.tenant"`)}
+	}
+	if _, ok := dc.mutation.BlobKey(); !ok {
+		return &ValidationError{Name: "blob_key", err: errors.New(`ent: missing required field "Document.blob_key"`)}
+	}
+	if v, ok := dc.mutation.BlobKey(); ok {
+		if err := document.BlobKeyValidator(v); err != nil {
+			return &ValidationError{Name: "blob_key", err: fmt.Errorf(`ent: validator failed for field "Document.blob_key": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Sha256(); !ok {
+		return &ValidationError{Name: "sha256", err: errors.New(`ent: missing required field "Document.sha256"`)}
+	}
+	if _, ok := dc.mutation.DocumentType(); !ok {
+		return &ValidationError{Name: "document_type", err: errors.New(`ent: missing required field "Document.document_type"`)}
+	}
+	if _, ok := dc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "Document.format"`)}
+	}
+	if _, ok := dc.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`ent: missing required field "Document.collector"`)}
+	}
+	if _, ok := dc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Document.source"`)}
+	}
+	if _, ok := dc.mutation.ParserVersion(); !ok {
+		return &ValidationError{Name: "parser_version", err: errors.New(`ent: missing required field "Document.parser_version"`)}
+	}
+	if _, ok := dc.mutation.IngestedAt(); !ok {
+		return &ValidationError{Name: "ingested_at", err: errors.New(`ent: missing required field "Document.ingested_at"`)}
+	}
+	return nil
+}
+
+func (dc *DocumentCreate) sqlSave(ctx context.Context) (*Document, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Document{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(document.Table, sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dc.conflict
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dc.mutation.Tenant(); ok {
+		_spec.SetField(document.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := dc.mutation.BlobKey(); ok {
+		_spec.SetField(document.FieldBlobKey, field.TypeString, value)
+		_node.BlobKey = value
+	}
+	if value, ok := dc.mutation.Sha256(); ok {
+		_spec.SetField(document.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := dc.mutation.DocumentType(); ok {
+		_spec.SetField(document.FieldDocumentType, field.TypeString, value)
+		_node.DocumentType = value
+	}
+	if value, ok := dc.mutation.Format(); ok {
+		_spec.SetField(document.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := dc.mutation.Collector(); ok {
+		_spec.SetField(document.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if value, ok := dc.mutation.Source(); ok {
+		_spec.SetField(document.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := dc.mutation.ParserVersion(); ok {
+		_spec.SetField(document.FieldParserVersion, field.TypeString, value)
+		_node.ParserVersion = value
+	}
+	if value, ok := dc.mutation.IngestedAt(); ok {
+		_spec.SetField(document.FieldIngestedAt, field.TypeTime, value)
+		_node.IngestedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Document.Create().
+//		SetTenant(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentUpsert) {
+//			SetTenant(v+v).
+//		}).
+//		Exec(ctx)
+func (dc *DocumentCreate) OnConflict(opts ...sql.ConflictOption) *DocumentUpsertOne {
+	dc.conflict = opts
+	return &DocumentUpsertOne{
+		create: dc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Document.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dc *DocumentCreate) OnConflictColumns(columns ...string) *DocumentUpsertOne {
+	dc.conflict = append(dc.conflict, sql.ConflictColumns(columns...))
+	return &DocumentUpsertOne{
+		create: dc,
+	}
+}
+
+type (
+	// DocumentUpsertOne is the builder for "upsert"-ing
+	//  one Document node.
+	DocumentUpsertOne struct {
+		create *DocumentCreate
+	}
+
+	// DocumentUpsert is the "OnConflict" setter.
+	DocumentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetBlobKey sets the "blob_key" field.
+func (u *DocumentUpsert) SetBlobKey(v string) *DocumentUpsert {
+	u.Set(document.FieldBlobKey, v)
+	return u
+}
+
+// UpdateBlobKey sets the "blob_key" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateBlobKey() *DocumentUpsert {
+	u.SetExcluded(document.FieldBlobKey)
+	return u
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *DocumentUpsert) SetSha256(v string) *DocumentUpsert {
+	u.Set(document.FieldSha256, v)
+	return u
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateSha256() *DocumentUpsert {
+	u.SetExcluded(document.FieldSha256)
+	return u
+}
+
+// SetDocumentType sets the "document_type" field.
+func (u *DocumentUpsert) SetDocumentType(v string) *DocumentUpsert {
+	u.Set(document.FieldDocumentType, v)
+	return u
+}
+
+// UpdateDocumentType sets the "document_type" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateDocumentType() *DocumentUpsert {
+	u.SetExcluded(document.FieldDocumentType)
+	return u
+}
+
+// SetFormat sets the "format" field.
+func (u *DocumentUpsert) SetFormat(v string) *DocumentUpsert {
+	u.Set(document.FieldFormat, v)
+	return u
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateFormat() *DocumentUpsert {
+	u.SetExcluded(document.FieldFormat)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *DocumentUpsert) SetCollector(v string) *DocumentUpsert {
+	u.Set(document.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateCollector() *DocumentUpsert {
+	u.SetExcluded(document.FieldCollector)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *DocumentUpsert) SetSource(v string) *DocumentUpsert {
+	u.Set(document.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateSource() *DocumentUpsert {
+	u.SetExcluded(document.FieldSource)
+	return u
+}
+
+// SetParserVersion sets the "parser_version" field.
+func (u *DocumentUpsert) SetParserVersion(v string) *DocumentUpsert {
+	u.Set(document.FieldParserVersion, v)
+	return u
+}
+
+// UpdateParserVersion sets the "parser_version" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateParserVersion() *DocumentUpsert {
+	u.SetExcluded(document.FieldParserVersion)
+	return u
+}
+
+// SetIngestedAt sets the "ingested_at" field.
+func (u *DocumentUpsert) SetIngestedAt(v time.Time) *DocumentUpsert {
+	u.Set(document.FieldIngestedAt, v)
+	return u
+}
+
+// UpdateIngestedAt sets the "ingested_at" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateIngestedAt() *DocumentUpsert {
+	u.SetExcluded(document.FieldIngestedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Document.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(document.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentUpsertOne) UpdateNewValues() *DocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(document.FieldID)
+		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(document.FieldTenant)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Document.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DocumentUpsertOne) Ignore() *DocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentUpsertOne) DoNothing() *DocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentCreate.OnConflict
+// documentation for more info.
+func (u *DocumentUpsertOne) Update(set func(*DocumentUpsert)) *DocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (u *DocumentUpsertOne) SetBlobKey(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetBlobKey(v)
+	})
+}
+
+// UpdateBlobKey sets the "blob_key" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateBlobKey() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateBlobKey()
+	})
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *DocumentUpsertOne) SetSha256(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetSha256(v)
+	})
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateSha256() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateSha256()
+	})
+}
+
+// SetDocumentType sets the "document_type" field.
+func (u *DocumentUpsertOne) SetDocumentType(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetDocumentType(v)
+	})
+}
+
+// UpdateDocumentType sets the "document_type" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateDocumentType() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateDocumentType()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *DocumentUpsertOne) SetFormat(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateFormat() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *DocumentUpsertOne) SetCollector(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateCollector() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *DocumentUpsertOne) SetSource(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateSource() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetParserVersion sets the "parser_version" field.
+func (u *DocumentUpsertOne) SetParserVersion(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetParserVersion(v)
+	})
+}
+
+// UpdateParserVersion sets the "parser_version" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateParserVersion() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateParserVersion()
+	})
+}
+
+// SetIngestedAt sets the "ingested_at" field.
+func (u *DocumentUpsertOne) SetIngestedAt(v time.Time) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetIngestedAt(v)
+	})
+}
+
+// UpdateIngestedAt sets the "ingested_at" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateIngestedAt() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateIngestedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DocumentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DocumentUpsertOne.ID is not supported by MySQL driver. Use DocumentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DocumentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DocumentCreateBulk is the builder for creating many Document entities in bulk.
+type DocumentCreateBulk struct {
+	config
+	err      error
+	builders []*DocumentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Document entities in the database.
+func (dcb *DocumentCreateBulk) Save(ctx context.Context) ([]*Document, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Document, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DocumentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DocumentCreateBulk) SaveX(ctx context.Context) []*Document {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DocumentCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DocumentCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Document.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentUpsert) {
+//			SetTenant(v+v).
+//		}).
+//		Exec(ctx)
+func (dcb *DocumentCreateBulk) OnConflict(opts ...sql.ConflictOption) *DocumentUpsertBulk {
+	dcb.conflict = opts
+	return &DocumentUpsertBulk{
+		create: dcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Document.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dcb *DocumentCreateBulk) OnConflictColumns(columns ...string) *DocumentUpsertBulk {
+	dcb.conflict = append(dcb.conflict, sql.ConflictColumns(columns...))
+	return &DocumentUpsertBulk{
+		create: dcb,
+	}
+}
+
+// DocumentUpsertBulk is the builder for "upsert"-ing
+// a bulk of Document nodes.
+type DocumentUpsertBulk struct {
+	create *DocumentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Document.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(document.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentUpsertBulk) UpdateNewValues() *DocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(document.FieldID)
+			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(document.FieldTenant)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Document.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DocumentUpsertBulk) Ignore() *DocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentUpsertBulk) DoNothing() *DocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentCreateBulk.OnConflict
+// documentation for more info.
+func (u *DocumentUpsertBulk) Update(set func(*DocumentUpsert)) *DocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (u *DocumentUpsertBulk) SetBlobKey(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetBlobKey(v)
+	})
+}
+
+// UpdateBlobKey sets the "blob_key" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateBlobKey() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateBlobKey()
+	})
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *DocumentUpsertBulk) SetSha256(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetSha256(v)
+	})
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateSha256() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateSha256()
+	})
+}
+
+// SetDocumentType sets the "document_type" field.
+func (u *DocumentUpsertBulk) SetDocumentType(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetDocumentType(v)
+	})
+}
+
+// UpdateDocumentType sets the "document_type" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateDocumentType() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateDocumentType()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *DocumentUpsertBulk) SetFormat(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateFormat() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *DocumentUpsertBulk) SetCollector(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateCollector() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *DocumentUpsertBulk) SetSource(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateSource() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetParserVersion sets the "parser_version" field.
+func (u *DocumentUpsertBulk) SetParserVersion(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetParserVersion(v)
+	})
+}
+
+// UpdateParserVersion sets the "parser_version" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateParserVersion() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateParserVersion()
+	})
+}
+
+// SetIngestedAt sets the "ingested_at" field.
+func (u *DocumentUpsertBulk) SetIngestedAt(v time.Time) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetIngestedAt(v)
+	})
+}
+
+// UpdateIngestedAt sets the "ingested_at" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateIngestedAt() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateIngestedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DocumentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
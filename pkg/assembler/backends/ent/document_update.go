@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/document"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// DocumentUpdate is the builder for updating Document entities.
+type DocumentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DocumentMutation
+}
+
+// Where appends a list predicates to the DocumentUpdate builder.
+func (du *DocumentUpdate) Where(ps ...predicate.Document) *DocumentUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (du *DocumentUpdate) SetBlobKey(s string) *DocumentUpdate {
+	du.mutation.SetBlobKey(s)
+	return du
+}
+
+// SetNillableBlobKey sets the "blob_key" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableBlobKey(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetBlobKey(*s)
+	}
+	return du
+}
+
+// SetSha256 sets the "sha256" field.
+func (du *DocumentUpdate) SetSha256(s string) *DocumentUpdate {
+	du.mutation.SetSha256(s)
+	return du
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableSha256(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetSha256(*s)
+	}
+	return du
+}
+
+// SetDocumentType sets the "document_type" field.
+func (du *DocumentUpdate) SetDocumentType(s string) *DocumentUpdate {
+	du.mutation.SetDocumentType(s)
+	return du
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableDocumentType(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetDocumentType(*s)
+	}
+	return du
+}
+
+// SetFormat sets the "format" field.
+func (du *DocumentUpdate) SetFormat(s string) *DocumentUpdate {
+	du.mutation.SetFormat(s)
+	return du
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableFormat(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetFormat(*s)
+	}
+	return du
+}
+
+// SetCollector sets the "collector" field.
+func (du *DocumentUpdate) SetCollector(s string) *DocumentUpdate {
+	du.mutation.SetCollector(s)
+	return du
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableCollector(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetCollector(*s)
+	}
+	return du
+}
+
+// SetSource sets the "source" field.
+func (du *DocumentUpdate) SetSource(s string) *DocumentUpdate {
+	du.mutation.SetSource(s)
+	return du
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableSource(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetSource(*s)
+	}
+	return du
+}
+
+// SetParserVersion sets the "parser_version" field.
+func (du *DocumentUpdate) SetParserVersion(s string) *DocumentUpdate {
+	du.mutation.SetParserVersion(s)
+	return du
+}
+
+// SetNillableParserVersion sets the "parser_version" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableParserVersion(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetParserVersion(*s)
+	}
+	return du
+}
+
+// SetIngestedAt sets the "ingested_at" field.
+func (du *DocumentUpdate) SetIngestedAt(t time.Time) *DocumentUpdate {
+	du.mutation.SetIngestedAt(t)
+	return du
+}
+
+// SetNillableIngestedAt sets the "ingested_at" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableIngestedAt(t *time.Time) *DocumentUpdate {
+	if t != nil {
+		du.SetIngestedAt(*t)
+	}
+	return du
+}
+
+// Mutation returns the DocumentMutation object of the builder.
+func (du *DocumentUpdate) Mutation() *DocumentMutation {
+	return du.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DocumentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DocumentUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DocumentUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DocumentUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DocumentUpdate) check() error {
+	if v, ok := du.mutation.BlobKey(); ok {
+		if err := document.BlobKeyValidator(v); err != nil {
+			return &ValidationError{Name: "blob_key", err: fmt.Errorf(`ent: validator failed for field "Document.blob_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (du *DocumentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(document.Table, document.Columns, sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.BlobKey(); ok {
+		_spec.SetField(document.FieldBlobKey, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Sha256(); ok {
+		_spec.SetField(document.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := du.mutation.DocumentType(); ok {
+		_spec.SetField(document.FieldDocumentType, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Format(); ok {
+		_spec.SetField(document.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Collector(); ok {
+		_spec.SetField(document.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Source(); ok {
+		_spec.SetField(document.FieldSource, field.TypeString, value)
+	}
+	if value, ok := du.mutation.ParserVersion(); ok {
+		_spec.SetField(document.FieldParserVersion, field.TypeString, value)
+	}
+	if value, ok := du.mutation.IngestedAt(); ok {
+		_spec.SetField(document.FieldIngestedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{document.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DocumentUpdateOne is the builder for updating a single Document entity.
+type DocumentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DocumentMutation
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (duo *DocumentUpdateOne) SetBlobKey(s string) *DocumentUpdateOne {
+	duo.mutation.SetBlobKey(s)
+	return duo
+}
+
+// SetNillableBlobKey sets the "blob_key" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableBlobKey(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetBlobKey(*s)
+	}
+	return duo
+}
+
+// SetSha256 sets the "sha256" field.
+func (duo *DocumentUpdateOne) SetSha256(s string) *DocumentUpdateOne {
+	duo.mutation.SetSha256(s)
+	return duo
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableSha256(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetSha256(*s)
+	}
+	return duo
+}
+
+// SetDocumentType sets the "document_type" field.
+func (duo *DocumentUpdateOne) SetDocumentType(s string) *DocumentUpdateOne {
+	duo.mutation.SetDocumentType(s)
+	return duo
+}
+
+// SetNillableDocumentType sets the "document_type" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableDocumentType(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetDocumentType(*s)
+	}
+	return duo
+}
+
+// SetFormat sets the "format" field.
+func (duo *DocumentUpdateOne) SetFormat(s string) *DocumentUpdateOne {
+	duo.mutation.SetFormat(s)
+	return duo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableFormat(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetFormat(*s)
+	}
+	return duo
+}
+
+// SetCollector sets the "collector" field.
+func (duo *DocumentUpdateOne) SetCollector(s string) *DocumentUpdateOne {
+	duo.mutation.SetCollector(s)
+	return duo
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableCollector(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetCollector(*s)
+	}
+	return duo
+}
+
+// SetSource sets the "source" field.
+func (duo *DocumentUpdateOne) SetSource(s string) *DocumentUpdateOne {
+	duo.mutation.SetSource(s)
+	return duo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableSource(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetSource(*s)
+	}
+	return duo
+}
+
+// SetParserVersion sets the "parser_version" field.
+func (duo *DocumentUpdateOne) SetParserVersion(s string) *DocumentUpdateOne {
+	duo.mutation.SetParserVersion(s)
+	return duo
+}
+
+// SetNillableParserVersion sets the "parser_version" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableParserVersion(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetParserVersion(*s)
+	}
+	return duo
+}
+
+// SetIngestedAt sets the "ingested_at" field.
+func (duo *DocumentUpdateOne) SetIngestedAt(t time.Time) *DocumentUpdateOne {
+	duo.mutation.SetIngestedAt(t)
+	return duo
+}
+
+// SetNillableIngestedAt sets the "ingested_at" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableIngestedAt(t *time.Time) *DocumentUpdateOne {
+	if t != nil {
+		duo.SetIngestedAt(*t)
+	}
+	return duo
+}
+
+// Mutation returns the DocumentMutation object of the builder.
+func (duo *DocumentUpdateOne) Mutation() *DocumentMutation {
+	return duo.mutation
+}
+
+// Where appends a list predicates to the DocumentUpdate builder.
+func (duo *DocumentUpdateOne) Where(ps ...predicate.Document) *DocumentUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DocumentUpdateOne) Select(field string, fields ...string) *DocumentUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Document entity.
+func (duo *DocumentUpdateOne) Save(ctx context.Context) (*Document, error) {
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DocumentUpdateOne) SaveX(ctx context.Context) *Document {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DocumentUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DocumentUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DocumentUpdateOne) check() error {
+	if v, ok := duo.mutation.BlobKey(); ok {
+		if err := document.BlobKeyValidator(v); err != nil {
+			return &ValidationError{Name: "blob_key", err: fmt.Errorf(`ent: validator failed for field "Document.blob_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (duo *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(document.Table, document.Columns, sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Document.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, document.FieldID)
+		for _, f := range fields {
+			if !document.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != document.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.BlobKey(); ok {
+		_spec.SetField(document.FieldBlobKey, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Sha256(); ok {
+		_spec.SetField(document.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.DocumentType(); ok {
+		_spec.SetField(document.FieldDocumentType, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Format(); ok {
+		_spec.SetField(document.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Collector(); ok {
+		_spec.SetField(document.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Source(); ok {
+		_spec.SetField(document.FieldSource, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.ParserVersion(); ok {
+		_spec.SetField(document.FieldParserVersion, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.IngestedAt(); ok {
+		_spec.SetField(document.FieldIngestedAt, field.TypeTime, value)
+	}
+	_node = &Document{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{document.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/document"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -195,6 +196,11 @@ func (a *ArtifactQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 			a.WithNamedIncludedInSboms(alias, func(wq *BillOfMaterialsQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[artifact.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, artifact.FieldTenant)
+				fieldSeen[artifact.FieldTenant] = struct{}{}
+			}
 		case "algorithm":
 			if _, ok := fieldSeen[artifact.FieldAlgorithm]; !ok {
 				selectedFields = append(selectedFields, artifact.FieldAlgorithm)
@@ -346,6 +352,11 @@ func (bom *BillOfMaterialsQuery) collectField(ctx context.Context, oneNode bool,
 			bom.WithNamedIncludedOccurrences(alias, func(wq *OccurrenceQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[billofmaterials.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, billofmaterials.FieldTenant)
+				fieldSeen[billofmaterials.FieldTenant] = struct{}{}
+			}
 		case "packageID":
 			if _, ok := fieldSeen[billofmaterials.FieldPackageID]; !ok {
 				selectedFields = append(selectedFields, billofmaterials.FieldPackageID)
@@ -488,6 +499,11 @@ func (b *BuilderQuery) collectField(ctx context.Context, oneNode bool, opCtx *gr
 			b.WithNamedSlsaAttestations(alias, func(wq *SLSAAttestationQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[builder.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, builder.FieldTenant)
+				fieldSeen[builder.FieldTenant] = struct{}{}
+			}
 		case "uri":
 			if _, ok := fieldSeen[builder.FieldURI]; !ok {
 				selectedFields = append(selectedFields, builder.FieldURI)
@@ -612,6 +628,11 @@ func (c *CertificationQuery) collectField(ctx context.Context, oneNode bool, opC
 				selectedFields = append(selectedFields, certification.FieldArtifactID)
 				fieldSeen[certification.FieldArtifactID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[certification.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certification.FieldTenant)
+				fieldSeen[certification.FieldTenant] = struct{}{}
+			}
 		case "sourceID":
 			if _, ok := fieldSeen[certification.FieldSourceID]; !ok {
 				selectedFields = append(selectedFields, certification.FieldSourceID)
@@ -777,6 +798,11 @@ func (cl *CertifyLegalQuery) collectField(ctx context.Context, oneNode bool, opC
 			cl.WithNamedDiscoveredLicenses(alias, func(wq *LicenseQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[certifylegal.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certifylegal.FieldTenant)
+				fieldSeen[certifylegal.FieldTenant] = struct{}{}
+			}
 		case "packageID":
 			if _, ok := fieldSeen[certifylegal.FieldPackageID]; !ok {
 				selectedFields = append(selectedFields, certifylegal.FieldPackageID)
@@ -911,6 +937,11 @@ func (cs *CertifyScorecardQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, certifyscorecard.FieldSourceID)
 				fieldSeen[certifyscorecard.FieldSourceID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[certifyscorecard.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certifyscorecard.FieldTenant)
+				fieldSeen[certifyscorecard.FieldTenant] = struct{}{}
+			}
 		case "sourceID":
 			if _, ok := fieldSeen[certifyscorecard.FieldSourceID]; !ok {
 				selectedFields = append(selectedFields, certifyscorecard.FieldSourceID)
@@ -1065,6 +1096,11 @@ func (cv *CertifyVexQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				selectedFields = append(selectedFields, certifyvex.FieldVulnerabilityID)
 				fieldSeen[certifyvex.FieldVulnerabilityID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[certifyvex.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certifyvex.FieldTenant)
+				fieldSeen[certifyvex.FieldTenant] = struct{}{}
+			}
 		case "packageID":
 			if _, ok := fieldSeen[certifyvex.FieldPackageID]; !ok {
 				selectedFields = append(selectedFields, certifyvex.FieldPackageID)
@@ -1209,6 +1245,11 @@ func (cv *CertifyVulnQuery) collectField(ctx context.Context, oneNode bool, opCt
 				selectedFields = append(selectedFields, certifyvuln.FieldPackageID)
 				fieldSeen[certifyvuln.FieldPackageID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[certifyvuln.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certifyvuln.FieldTenant)
+				fieldSeen[certifyvuln.FieldTenant] = struct{}{}
+			}
 		case "vulnerabilityID":
 			if _, ok := fieldSeen[certifyvuln.FieldVulnerabilityID]; !ok {
 				selectedFields = append(selectedFields, certifyvuln.FieldVulnerabilityID)
@@ -1361,6 +1402,11 @@ func (d *DependencyQuery) collectField(ctx context.Context, oneNode bool, opCtx
 			d.WithNamedIncludedInSboms(alias, func(wq *BillOfMaterialsQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[dependency.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, dependency.FieldTenant)
+				fieldSeen[dependency.FieldTenant] = struct{}{}
+			}
 		case "packageID":
 			if _, ok := fieldSeen[dependency.FieldPackageID]; !ok {
 				selectedFields = append(selectedFields, dependency.FieldPackageID)
@@ -1434,6 +1480,110 @@ func newDependencyPaginateArgs(rv map[string]any) *dependencyPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (d *DocumentQuery) CollectFields(ctx context.Context, satisfies ...string) (*DocumentQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return d, nil
+	}
+	if err := d.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *DocumentQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(document.Columns))
+		selectedFields = []string{document.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "tenant":
+			if _, ok := fieldSeen[document.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, document.FieldTenant)
+				fieldSeen[document.FieldTenant] = struct{}{}
+			}
+		case "blobKey":
+			if _, ok := fieldSeen[document.FieldBlobKey]; !ok {
+				selectedFields = append(selectedFields, document.FieldBlobKey)
+				fieldSeen[document.FieldBlobKey] = struct{}{}
+			}
+		case "sha256":
+			if _, ok := fieldSeen[document.FieldSha256]; !ok {
+				selectedFields = append(selectedFields, document.FieldSha256)
+				fieldSeen[document.FieldSha256] = struct{}{}
+			}
+		case "documentType":
+			if _, ok := fieldSeen[document.FieldDocumentType]; !ok {
+				selectedFields = append(selectedFields, document.FieldDocumentType)
+				fieldSeen[document.FieldDocumentType] = struct{}{}
+			}
+		case "format":
+			if _, ok := fieldSeen[document.FieldFormat]; !ok {
+				selectedFields = append(selectedFields, document.FieldFormat)
+				fieldSeen[document.FieldFormat] = struct{}{}
+			}
+		case "collector":
+			if _, ok := fieldSeen[document.FieldCollector]; !ok {
+				selectedFields = append(selectedFields, document.FieldCollector)
+				fieldSeen[document.FieldCollector] = struct{}{}
+			}
+		case "source":
+			if _, ok := fieldSeen[document.FieldSource]; !ok {
+				selectedFields = append(selectedFields, document.FieldSource)
+				fieldSeen[document.FieldSource] = struct{}{}
+			}
+		case "parserVersion":
+			if _, ok := fieldSeen[document.FieldParserVersion]; !ok {
+				selectedFields = append(selectedFields, document.FieldParserVersion)
+				fieldSeen[document.FieldParserVersion] = struct{}{}
+			}
+		case "ingestedAt":
+			if _, ok := fieldSeen[document.FieldIngestedAt]; !ok {
+				selectedFields = append(selectedFields, document.FieldIngestedAt)
+				fieldSeen[document.FieldIngestedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		d.Select(selectedFields...)
+	}
+	return nil
+}
+
+type documentPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []DocumentPaginateOption
+}
+
+func newDocumentPaginateArgs(rv map[string]any) *documentPaginateArgs {
+	args := &documentPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (hm *HasMetadataQuery) CollectFields(ctx context.Context, satisfies ...string) (*HasMetadataQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -1515,6 +1665,11 @@ func (hm *HasMetadataQuery) collectField(ctx context.Context, oneNode bool, opCt
 				selectedFields = append(selectedFields, hasmetadata.FieldArtifactID)
 				fieldSeen[hasmetadata.FieldArtifactID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[hasmetadata.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, hasmetadata.FieldTenant)
+				fieldSeen[hasmetadata.FieldTenant] = struct{}{}
+			}
 		case "sourceID":
 			if _, ok := fieldSeen[hasmetadata.FieldSourceID]; !ok {
 				selectedFields = append(selectedFields, hasmetadata.FieldSourceID)
@@ -1674,6 +1829,11 @@ func (hsa *HasSourceAtQuery) collectField(ctx context.Context, oneNode bool, opC
 				selectedFields = append(selectedFields, hassourceat.FieldSourceID)
 				fieldSeen[hassourceat.FieldSourceID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[hassourceat.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, hassourceat.FieldTenant)
+				fieldSeen[hassourceat.FieldTenant] = struct{}{}
+			}
 		case "packageVersionID":
 			if _, ok := fieldSeen[hassourceat.FieldPackageVersionID]; !ok {
 				selectedFields = append(selectedFields, hassourceat.FieldPackageVersionID)
@@ -1803,6 +1963,11 @@ func (he *HashEqualQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				selectedFields = append(selectedFields, hashequal.FieldEqualArtID)
 				fieldSeen[hashequal.FieldEqualArtID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[hashequal.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, hashequal.FieldTenant)
+				fieldSeen[hashequal.FieldTenant] = struct{}{}
+			}
 		case "artID":
 			if _, ok := fieldSeen[hashequal.FieldArtID]; !ok {
 				selectedFields = append(selectedFields, hashequal.FieldArtID)
@@ -1923,6 +2088,11 @@ func (l *LicenseQuery) collectField(ctx context.Context, oneNode bool, opCtx *gr
 			l.WithNamedDiscoveredInCertifyLegals(alias, func(wq *CertifyLegalQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[license.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, license.FieldTenant)
+				fieldSeen[license.FieldTenant] = struct{}{}
+			}
 		case "name":
 			if _, ok := fieldSeen[license.FieldName]; !ok {
 				selectedFields = append(selectedFields, license.FieldName)
@@ -2065,6 +2235,11 @@ func (o *OccurrenceQuery) collectField(ctx context.Context, oneNode bool, opCtx
 			o.WithNamedIncludedInSboms(alias, func(wq *BillOfMaterialsQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[occurrence.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, occurrence.FieldTenant)
+				fieldSeen[occurrence.FieldTenant] = struct{}{}
+			}
 		case "artifactID":
 			if _, ok := fieldSeen[occurrence.FieldArtifactID]; !ok {
 				selectedFields = append(selectedFields, occurrence.FieldArtifactID)
@@ -2224,6 +2399,11 @@ func (pn *PackageNameQuery) collectField(ctx context.Context, oneNode bool, opCt
 			pn.WithNamedPoc(alias, func(wq *PointOfContactQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[packagename.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, packagename.FieldTenant)
+				fieldSeen[packagename.FieldTenant] = struct{}{}
+			}
 		case "type":
 			if _, ok := fieldSeen[packagename.FieldType]; !ok {
 				selectedFields = append(selectedFields, packagename.FieldType)
@@ -2495,6 +2675,11 @@ func (pv *PackageVersionQuery) collectField(ctx context.Context, oneNode bool, o
 			pv.WithNamedCertifyLegal(alias, func(wq *CertifyLegalQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[packageversion.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, packageversion.FieldTenant)
+				fieldSeen[packageversion.FieldTenant] = struct{}{}
+			}
 		case "nameID":
 			if _, ok := fieldSeen[packageversion.FieldNameID]; !ok {
 				selectedFields = append(selectedFields, packageversion.FieldNameID)
@@ -2609,6 +2794,11 @@ func (pe *PkgEqualQuery) collectField(ctx context.Context, oneNode bool, opCtx *
 				selectedFields = append(selectedFields, pkgequal.FieldEqualPkgID)
 				fieldSeen[pkgequal.FieldEqualPkgID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[pkgequal.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, pkgequal.FieldTenant)
+				fieldSeen[pkgequal.FieldTenant] = struct{}{}
+			}
 		case "pkgID":
 			if _, ok := fieldSeen[pkgequal.FieldPkgID]; !ok {
 				selectedFields = append(selectedFields, pkgequal.FieldPkgID)
@@ -2763,6 +2953,11 @@ func (poc *PointOfContactQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, pointofcontact.FieldArtifactID)
 				fieldSeen[pointofcontact.FieldArtifactID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[pointofcontact.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, pointofcontact.FieldTenant)
+				fieldSeen[pointofcontact.FieldTenant] = struct{}{}
+			}
 		case "sourceID":
 			if _, ok := fieldSeen[pointofcontact.FieldSourceID]; !ok {
 				selectedFields = append(selectedFields, pointofcontact.FieldSourceID)
@@ -2920,6 +3115,11 @@ func (sa *SLSAAttestationQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, slsaattestation.FieldSubjectID)
 				fieldSeen[slsaattestation.FieldSubjectID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[slsaattestation.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, slsaattestation.FieldTenant)
+				fieldSeen[slsaattestation.FieldTenant] = struct{}{}
+			}
 		case "buildType":
 			if _, ok := fieldSeen[slsaattestation.FieldBuildType]; !ok {
 				selectedFields = append(selectedFields, slsaattestation.FieldBuildType)
@@ -3125,6 +3325,11 @@ func (sn *SourceNameQuery) collectField(ctx context.Context, oneNode bool, opCtx
 			sn.WithNamedCertifyLegal(alias, func(wq *CertifyLegalQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[sourcename.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, sourcename.FieldTenant)
+				fieldSeen[sourcename.FieldTenant] = struct{}{}
+			}
 		case "type":
 			if _, ok := fieldSeen[sourcename.FieldType]; !ok {
 				selectedFields = append(selectedFields, sourcename.FieldType)
@@ -3239,6 +3444,11 @@ func (ve *VulnEqualQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				selectedFields = append(selectedFields, vulnequal.FieldEqualVulnID)
 				fieldSeen[vulnequal.FieldEqualVulnID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[vulnequal.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, vulnequal.FieldTenant)
+				fieldSeen[vulnequal.FieldTenant] = struct{}{}
+			}
 		case "vulnID":
 			if _, ok := fieldSeen[vulnequal.FieldVulnID]; !ok {
 				selectedFields = append(selectedFields, vulnequal.FieldVulnID)
@@ -3398,6 +3608,11 @@ func (vi *VulnerabilityIDQuery) collectField(ctx context.Context, oneNode bool,
 			vi.WithNamedVex(alias, func(wq *CertifyVexQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[vulnerabilityid.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityid.FieldTenant)
+				fieldSeen[vulnerabilityid.FieldTenant] = struct{}{}
+			}
 		case "vulnerabilityID":
 			if _, ok := fieldSeen[vulnerabilityid.FieldVulnerabilityID]; !ok {
 				selectedFields = append(selectedFields, vulnerabilityid.FieldVulnerabilityID)
@@ -3482,6 +3697,11 @@ func (vm *VulnerabilityMetadataQuery) collectField(ctx context.Context, oneNode
 				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldVulnerabilityIDID)
 				fieldSeen[vulnerabilitymetadata.FieldVulnerabilityIDID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[vulnerabilitymetadata.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldTenant)
+				fieldSeen[vulnerabilitymetadata.FieldTenant] = struct{}{}
+			}
 		case "vulnerabilityIDID":
 			if _, ok := fieldSeen[vulnerabilitymetadata.FieldVulnerabilityIDID]; !ok {
 				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldVulnerabilityIDID)
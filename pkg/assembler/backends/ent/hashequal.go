@@ -18,6 +18,8 @@ type HashEqual struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// ArtID holds the value of the "art_id" field.
 	ArtID uuid.UUID `json:"art_id,omitempty"`
 	// EqualArtID holds the value of the "equal_art_id" field.
@@ -78,7 +80,7 @@ func (*HashEqual) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case hashequal.FieldOrigin, hashequal.FieldCollector, hashequal.FieldJustification, hashequal.FieldDocumentRef, hashequal.FieldArtifactsHash:
+		case hashequal.FieldTenant, hashequal.FieldOrigin, hashequal.FieldCollector, hashequal.FieldJustification, hashequal.FieldDocumentRef, hashequal.FieldArtifactsHash:
 			values[i] = new(sql.NullString)
 		case hashequal.FieldID, hashequal.FieldArtID, hashequal.FieldEqualArtID:
 			values[i] = new(uuid.UUID)
@@ -103,6 +105,12 @@ func (he *HashEqual) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				he.ID = *value
 			}
+		case hashequal.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				he.Tenant = value.String
+			}
 		case hashequal.FieldArtID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field art_id", values[i])
@@ -191,6 +199,9 @@ func (he *HashEqual) String() string {
 	var builder strings.Builder
 	builder.WriteString("HashEqual(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", he.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(he.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("art_id=")
 	builder.WriteString(fmt.Sprintf("%v", he.ArtID))
 	builder.WriteString(", ")
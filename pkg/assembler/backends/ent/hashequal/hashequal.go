@@ -13,6 +13,8 @@ const (
 	Label = "hash_equal"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldArtID holds the string denoting the art_id field in the database.
 	FieldArtID = "art_id"
 	// FieldEqualArtID holds the string denoting the equal_art_id field in the database.
@@ -52,6 +54,7 @@ const (
 // Columns holds all SQL columns for hashequal fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldArtID,
 	FieldEqualArtID,
 	FieldOrigin,
@@ -72,6 +75,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -84,6 +89,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByArtID orders the results by the art_id field.
 func ByArtID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldArtID, opts...).ToFunc()
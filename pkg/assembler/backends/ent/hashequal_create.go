@@ -24,6 +24,20 @@ type HashEqualCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (hec *HashEqualCreate) SetTenant(s string) *HashEqualCreate {
+	hec.mutation.SetTenant(s)
+	return hec
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (hec *HashEqualCreate) SetNillableTenant(s *string) *HashEqualCreate {
+	if s != nil {
+		hec.SetTenant(*s)
+	}
+	return hec
+}
+
 // SetArtID sets the "art_id" field.
 func (hec *HashEqualCreate) SetArtID(u uuid.UUID) *HashEqualCreate {
 	hec.mutation.SetArtID(u)
@@ -137,6 +151,10 @@ func (hec *HashEqualCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (hec *HashEqualCreate) defaults() {
+	if _, ok := hec.mutation.Tenant(); !ok {
+		v := hashequal.DefaultTenant
+		hec.mutation.SetTenant(v)
+	}
 	if _, ok := hec.mutation.ID(); !ok {
 		v := hashequal.DefaultID()
 		hec.mutation.SetID(v)
@@ -145,6 +163,9 @@ func (hec *HashEqualCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (hec *HashEqualCreate) check() error {
+	if _, ok := hec.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "HashEqual.tenant"`)}
+	}
 	if _, ok := hec.mutation.ArtID(); !ok {
 		return &ValidationError{Name: "art_id", err: errors.New(`ent: missing required field "HashEqual.art_id"`)}
 	}
@@ -208,6 +229,10 @@ func (hec *HashEqualCreate) createSpec() (*HashEqual, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := hec.mutation.Tenant(); ok {
+		_spec.SetField(hashequal.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := hec.mutation.Origin(); ok {
 		_spec.SetField(hashequal.FieldOrigin, field.TypeString, value)
 		_node.Origin = value
@@ -269,7 +294,7 @@ func (hec *HashEqualCreate) createSpec() (*HashEqual, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.HashEqual.Create().
-//		SetArtID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -278,7 +303,7 @@ func (hec *HashEqualCreate) createSpec() (*HashEqual, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.HashEqualUpsert) {
-//			SetArtID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (hec *HashEqualCreate) OnConflict(opts ...sql.ConflictOption) *HashEqualUpsertOne {
@@ -415,6 +440,9 @@ func (u *HashEqualUpsertOne) UpdateNewValues() *HashEqualUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(hashequal.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(hashequal.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -680,7 +708,7 @@ func (hecb *HashEqualCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.HashEqualUpsert) {
-//			SetArtID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (hecb *HashEqualCreateBulk) OnConflict(opts ...sql.ConflictOption) *HashEqualUpsertBulk {
@@ -727,6 +755,9 @@ func (u *HashEqualUpsertBulk) UpdateNewValues() *HashEqualUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(hashequal.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(hashequal.FieldTenant)
+			}
 		}
 	}))
 	return u
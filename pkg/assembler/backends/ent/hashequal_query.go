@@ -336,12 +336,12 @@ func (heq *HashEqualQuery) WithArtifactB(opts ...func(*ArtifactQuery)) *HashEqua
 // Example:
 //
 //	var v []struct {
-//		ArtID uuid.UUID `json:"art_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.HashEqual.Query().
-//		GroupBy(hashequal.FieldArtID).
+//		GroupBy(hashequal.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (heq *HashEqualQuery) GroupBy(field string, fields ...string) *HashEqualGroupBy {
@@ -359,11 +359,11 @@ func (heq *HashEqualQuery) GroupBy(field string, fields ...string) *HashEqualGro
 // Example:
 //
 //	var v []struct {
-//		ArtID uuid.UUID `json:"art_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.HashEqual.Query().
-//		Select(hashequal.FieldArtID).
+//		Select(hashequal.FieldTenant).
 //		Scan(ctx, &v)
 func (heq *HashEqualQuery) Select(fields ...string) *HashEqualSelect {
 	heq.ctx.Fields = append(heq.ctx.Fields, fields...)
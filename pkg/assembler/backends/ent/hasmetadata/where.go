@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.HasMetadata {
 	return predicate.HasMetadata(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldEQ(FieldTenant, v))
+}
+
 // SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
 func SourceID(v uuid.UUID) predicate.HasMetadata {
 	return predicate.HasMetadata(sql.FieldEQ(FieldSourceID, v))
@@ -111,6 +116,71 @@ func DocumentRef(v string) predicate.HasMetadata {
 	return predicate.HasMetadata(sql.FieldEQ(FieldDocumentRef, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.HasMetadata {
+	return predicate.HasMetadata(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // SourceIDEQ applies the EQ predicate on the "source_id" field.
 func SourceIDEQ(v uuid.UUID) predicate.HasMetadata {
 	return predicate.HasMetadata(sql.FieldEQ(FieldSourceID, v))
@@ -409,12 +409,12 @@ func (hmq *HasMetadataQuery) WithArtifact(opts ...func(*ArtifactQuery)) *HasMeta
 // Example:
 //
 //	var v []struct {
-//		SourceID uuid.UUID `json:"source_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.HasMetadata.Query().
-//		GroupBy(hasmetadata.FieldSourceID).
+//		GroupBy(hasmetadata.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (hmq *HasMetadataQuery) GroupBy(field string, fields ...string) *HasMetadataGroupBy {
@@ -432,11 +432,11 @@ func (hmq *HasMetadataQuery) GroupBy(field string, fields ...string) *HasMetadat
 // Example:
 //
 //	var v []struct {
-//		SourceID uuid.UUID `json:"source_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.HasMetadata.Query().
-//		Select(hasmetadata.FieldSourceID).
+//		Select(hasmetadata.FieldTenant).
 //		Scan(ctx, &v)
 func (hmq *HasMetadataQuery) Select(fields ...string) *HasMetadataSelect {
 	hmq.ctx.Fields = append(hmq.ctx.Fields, fields...)
@@ -21,6 +21,8 @@ type HasSourceAt struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// PackageVersionID holds the value of the "package_version_id" field.
 	PackageVersionID *uuid.UUID `json:"package_version_id,omitempty"`
 	// PackageNameID holds the value of the "package_name_id" field.
@@ -98,7 +100,7 @@ func (*HasSourceAt) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case hassourceat.FieldPackageVersionID, hassourceat.FieldPackageNameID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case hassourceat.FieldJustification, hassourceat.FieldOrigin, hassourceat.FieldCollector, hassourceat.FieldDocumentRef:
+		case hassourceat.FieldTenant, hassourceat.FieldJustification, hassourceat.FieldOrigin, hassourceat.FieldCollector, hassourceat.FieldDocumentRef:
 			values[i] = new(sql.NullString)
 		case hassourceat.FieldKnownSince:
 			values[i] = new(sql.NullTime)
@@ -125,6 +127,12 @@ func (hsa *HasSourceAt) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				hsa.ID = *value
 			}
+		case hassourceat.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				hsa.Tenant = value.String
+			}
 		case hassourceat.FieldPackageVersionID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field package_version_id", values[i])
@@ -226,6 +234,9 @@ func (hsa *HasSourceAt) String() string {
 	var builder strings.Builder
 	builder.WriteString("HasSourceAt(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", hsa.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(hsa.Tenant)
+	builder.WriteString(", ")
 	if v := hsa.PackageVersionID; v != nil {
 		builder.WriteString("package_version_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -17,6 +17,8 @@ type License struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Inline holds the value of the "inline" field.
@@ -72,7 +74,7 @@ func (*License) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case license.FieldName, license.FieldInline, license.FieldListVersion, license.FieldInlineHash, license.FieldListVersionHash:
+		case license.FieldTenant, license.FieldName, license.FieldInline, license.FieldListVersion, license.FieldInlineHash, license.FieldListVersionHash:
 			values[i] = new(sql.NullString)
 		case license.FieldID:
 			values[i] = new(uuid.UUID)
@@ -97,6 +99,12 @@ func (l *License) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				l.ID = *value
 			}
+		case license.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				l.Tenant = value.String
+			}
 		case license.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -173,6 +181,9 @@ func (l *License) String() string {
 	var builder strings.Builder
 	builder.WriteString("License(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(l.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(l.Name)
 	builder.WriteString(", ")
@@ -24,6 +24,20 @@ type LicenseCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (lc *LicenseCreate) SetTenant(s string) *LicenseCreate {
+	lc.mutation.SetTenant(s)
+	return lc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (lc *LicenseCreate) SetNillableTenant(s *string) *LicenseCreate {
+	if s != nil {
+		lc.SetTenant(*s)
+	}
+	return lc
+}
+
 // SetName sets the "name" field.
 func (lc *LicenseCreate) SetName(s string) *LicenseCreate {
 	lc.mutation.SetName(s)
@@ -165,6 +179,10 @@ func (lc *LicenseCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (lc *LicenseCreate) defaults() {
+	if _, ok := lc.mutation.Tenant(); !ok {
+		v := license.DefaultTenant
+		lc.mutation.SetTenant(v)
+	}
 	if _, ok := lc.mutation.ID(); !ok {
 		v := license.DefaultID()
 		lc.mutation.SetID(v)
@@ -173,6 +191,9 @@ func (lc *LicenseCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (lc *LicenseCreate) check() error {
+	if _, ok := lc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "License.tenant"`)}
+	}
 	if _, ok := lc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "License.name"`)}
 	}
@@ -217,6 +238,10 @@ func (lc *LicenseCreate) createSpec() (*License, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := lc.mutation.Tenant(); ok {
+		_spec.SetField(license.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := lc.mutation.Name(); ok {
 		_spec.SetField(license.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -276,7 +301,7 @@ func (lc *LicenseCreate) createSpec() (*License, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.License.Create().
-//		SetName(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -285,7 +310,7 @@ func (lc *LicenseCreate) createSpec() (*License, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.LicenseUpsert) {
-//			SetName(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (lc *LicenseCreate) OnConflict(opts ...sql.ConflictOption) *LicenseUpsertOne {
@@ -422,6 +447,9 @@ func (u *LicenseUpsertOne) UpdateNewValues() *LicenseUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(license.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(license.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -687,7 +715,7 @@ func (lcb *LicenseCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.LicenseUpsert) {
-//			SetName(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (lcb *LicenseCreateBulk) OnConflict(opts ...sql.ConflictOption) *LicenseUpsertBulk {
@@ -734,6 +762,9 @@ func (u *LicenseUpsertBulk) UpdateNewValues() *LicenseUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(license.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(license.FieldTenant)
+			}
 		}
 	}))
 	return u
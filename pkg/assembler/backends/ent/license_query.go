@@ -339,12 +339,12 @@ func (lq *LicenseQuery) WithDiscoveredInCertifyLegals(opts ...func(*CertifyLegal
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.License.Query().
-//		GroupBy(license.FieldName).
+//		GroupBy(license.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (lq *LicenseQuery) GroupBy(field string, fields ...string) *LicenseGroupBy {
@@ -362,11 +362,11 @@ func (lq *LicenseQuery) GroupBy(field string, fields ...string) *LicenseGroupBy
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.License.Query().
-//		Select(license.FieldName).
+//		Select(license.FieldTenant).
 //		Scan(ctx, &v)
 func (lq *LicenseQuery) Select(fields ...string) *LicenseSelect {
 	lq.ctx.Fields = append(lq.ctx.Fields, fields...)
@@ -0,0 +1,4 @@
+-- Create "documents" table
+CREATE TABLE "documents" ("id" uuid NOT NULL, "blob_key" character varying NOT NULL, "sha256" character varying NOT NULL, "document_type" character varying NOT NULL, "format" character varying NOT NULL, "collector" character varying NOT NULL, "source" character varying NOT NULL, "parser_version" character varying NOT NULL, "ingested_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "document_blob_key" to table: "documents"
+CREATE UNIQUE INDEX "document_blob_key" ON "documents" ("blob_key");
@@ -0,0 +1,254 @@
+-- Modify "artifacts" table
+ALTER TABLE "artifacts" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "artifact_algorithm_digest" from table: "artifacts"
+DROP INDEX "artifact_algorithm_digest";
+-- Create index "artifact_algorithm_digest_tenant" to table: "artifacts"
+CREATE UNIQUE INDEX "artifact_algorithm_digest_tenant" ON "artifacts" ("algorithm", "digest", "tenant");
+-- Create index "artifact_tenant" to table: "artifacts"
+CREATE INDEX "artifact_tenant" ON "artifacts" ("tenant");
+-- Modify "bill_of_materials" table
+ALTER TABLE "bill_of_materials" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "sbom_artifact_id" from table: "bill_of_materials"
+DROP INDEX "sbom_artifact_id";
+-- Drop index "sbom_package_id" from table: "bill_of_materials"
+DROP INDEX "sbom_package_id";
+-- Create index "billofmaterials_tenant" to table: "bill_of_materials"
+CREATE INDEX "billofmaterials_tenant" ON "bill_of_materials" ("tenant");
+-- Create index "sbom_artifact_id" to table: "bill_of_materials"
+CREATE UNIQUE INDEX "sbom_artifact_id" ON "bill_of_materials" ("algorithm", "digest", "uri", "download_location", "known_since", "included_packages_hash", "included_artifacts_hash", "included_dependencies_hash", "included_occurrences_hash", "origin", "collector", "document_ref", "tenant", "artifact_id") WHERE ((package_id IS NULL) AND (artifact_id IS NOT NULL));
+-- Create index "sbom_package_id" to table: "bill_of_materials"
+CREATE UNIQUE INDEX "sbom_package_id" ON "bill_of_materials" ("algorithm", "digest", "uri", "download_location", "known_since", "included_packages_hash", "included_artifacts_hash", "included_dependencies_hash", "included_occurrences_hash", "origin", "collector", "document_ref", "tenant", "package_id") WHERE ((package_id IS NOT NULL) AND (artifact_id IS NULL));
+-- Modify "builders" table
+ALTER TABLE "builders" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "builder_uri" from table: "builders"
+DROP INDEX "builder_uri";
+-- Drop index "builders_uri_key" from table: "builders"
+DROP INDEX "builders_uri_key";
+-- Create index "builder_tenant" to table: "builders"
+CREATE INDEX "builder_tenant" ON "builders" ("tenant");
+-- Create index "builder_uri_tenant" to table: "builders"
+CREATE UNIQUE INDEX "builder_uri_tenant" ON "builders" ("uri", "tenant");
+-- Modify "certifications" table
+ALTER TABLE "certifications" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "certification_type_justificati_050322ac123e59b56b741a07ea26df53" from table: "certifications"
+DROP INDEX "certification_type_justificati_050322ac123e59b56b741a07ea26df53";
+-- Drop index "certification_type_justificati_a82272635ecbc67a1e08d7bed937351b" from table: "certifications"
+DROP INDEX "certification_type_justificati_a82272635ecbc67a1e08d7bed937351b";
+-- Drop index "certification_type_justificati_c6d282e2f14094c4106b3d2b0ac979f4" from table: "certifications"
+DROP INDEX "certification_type_justificati_c6d282e2f14094c4106b3d2b0ac979f4";
+-- Drop index "certification_type_justificati_e71e1f69147e5d0ef7f614cb12b42373" from table: "certifications"
+DROP INDEX "certification_type_justificati_e71e1f69147e5d0ef7f614cb12b42373";
+-- Create index "certification_tenant" to table: "certifications"
+CREATE INDEX "certification_tenant" ON "certifications" ("tenant");
+-- Create index "certification_type_justificati_5918d9ad95057993ea9ed952fab6c6c6" to table: "certifications"
+CREATE UNIQUE INDEX "certification_type_justificati_5918d9ad95057993ea9ed952fab6c6c6" ON "certifications" ("type", "justification", "origin", "collector", "source_id", "known_since", "document_ref", "tenant") WHERE ((source_id IS NOT NULL) AND (package_version_id IS NULL) AND (package_name_id IS NULL) AND (artifact_id IS NULL));
+-- Create index "certification_type_justificati_7a6646d5fe909f734c901902c4c84d90" to table: "certifications"
+CREATE UNIQUE INDEX "certification_type_justificati_7a6646d5fe909f734c901902c4c84d90" ON "certifications" ("type", "justification", "origin", "collector", "package_name_id", "known_since", "document_ref", "tenant") WHERE ((source_id IS NULL) AND (package_version_id IS NULL) AND (package_name_id IS NOT NULL) AND (artifact_id IS NULL));
+-- Create index "certification_type_justificati_7c02337bc99182d758caba58a5d7c251" to table: "certifications"
+CREATE UNIQUE INDEX "certification_type_justificati_7c02337bc99182d758caba58a5d7c251" ON "certifications" ("type", "justification", "origin", "collector", "package_version_id", "known_since", "document_ref", "tenant") WHERE ((source_id IS NULL) AND (package_version_id IS NOT NULL) AND (package_name_id IS NULL) AND (artifact_id IS NULL));
+-- Create index "certification_type_justificati_e8edececb366c526ab21c9215e573cf8" to table: "certifications"
+CREATE UNIQUE INDEX "certification_type_justificati_e8edececb366c526ab21c9215e573cf8" ON "certifications" ("type", "justification", "origin", "collector", "artifact_id", "known_since", "document_ref", "tenant") WHERE ((source_id IS NULL) AND (package_version_id IS NULL) AND (package_name_id IS NULL) AND (artifact_id IS NOT NULL));
+-- Modify "certify_legals" table
+ALTER TABLE "certify_legals" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "cl_pkg_id" from table: "certify_legals"
+DROP INDEX "cl_pkg_id";
+-- Drop index "cl_source_id" from table: "certify_legals"
+DROP INDEX "cl_source_id";
+-- Create index "certifylegal_tenant" to table: "certify_legals"
+CREATE INDEX "certifylegal_tenant" ON "certify_legals" ("tenant");
+-- Create index "cl_pkg_id" to table: "certify_legals"
+CREATE UNIQUE INDEX "cl_pkg_id" ON "certify_legals" ("declared_license", "justification", "origin", "collector", "declared_licenses_hash", "discovered_licenses_hash", "package_id", "tenant") WHERE ((package_id IS NOT NULL) AND (source_id IS NULL));
+-- Create index "cl_source_id" to table: "certify_legals"
+CREATE UNIQUE INDEX "cl_source_id" ON "certify_legals" ("declared_license", "justification", "origin", "collector", "declared_licenses_hash", "discovered_licenses_hash", "source_id", "tenant") WHERE ((package_id IS NULL) AND (source_id IS NOT NULL));
+-- Modify "certify_scorecards" table
+ALTER TABLE "certify_scorecards" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "certifyscorecard_source_id_ori_508fb6b816b5bf996fa51b0da953b7d1" from table: "certify_scorecards"
+DROP INDEX "certifyscorecard_source_id_ori_508fb6b816b5bf996fa51b0da953b7d1";
+-- Create index "certifyscorecard_source_id_ori_afebb63f24e224d6e8ff55007d081fdb" to table: "certify_scorecards"
+CREATE UNIQUE INDEX "certifyscorecard_source_id_ori_afebb63f24e224d6e8ff55007d081fdb" ON "certify_scorecards" ("source_id", "origin", "collector", "scorecard_version", "scorecard_commit", "aggregate_score", "time_scanned", "checks_hash", "document_ref", "tenant");
+-- Create index "certifyscorecard_tenant" to table: "certify_scorecards"
+CREATE INDEX "certifyscorecard_tenant" ON "certify_scorecards" ("tenant");
+-- Modify "certify_vexes" table
+ALTER TABLE "certify_vexes" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "vex_artifact_id" from table: "certify_vexes"
+DROP INDEX "vex_artifact_id";
+-- Drop index "vex_package_id" from table: "certify_vexes"
+DROP INDEX "vex_package_id";
+-- Create index "certifyvex_tenant" to table: "certify_vexes"
+CREATE INDEX "certifyvex_tenant" ON "certify_vexes" ("tenant");
+-- Create index "vex_artifact_id" to table: "certify_vexes"
+CREATE UNIQUE INDEX "vex_artifact_id" ON "certify_vexes" ("known_since", "justification", "status", "origin", "collector", "document_ref", "tenant", "vulnerability_id", "package_id") WHERE (artifact_id IS NULL);
+-- Create index "vex_package_id" to table: "certify_vexes"
+CREATE UNIQUE INDEX "vex_package_id" ON "certify_vexes" ("known_since", "justification", "status", "origin", "collector", "document_ref", "tenant", "vulnerability_id", "artifact_id") WHERE (package_id IS NULL);
+-- Modify "certify_vulns" table
+ALTER TABLE "certify_vulns" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "certifyvuln_package_id_vulnera_77eeb86290f40a475550cafdc6ff7168" from table: "certify_vulns"
+DROP INDEX "certifyvuln_package_id_vulnera_77eeb86290f40a475550cafdc6ff7168";
+-- Create index "certifyvuln_package_id_vulnera_bfb88651341d68935233cfdbc6dc6aac" to table: "certify_vulns"
+CREATE UNIQUE INDEX "certifyvuln_package_id_vulnera_bfb88651341d68935233cfdbc6dc6aac" ON "certify_vulns" ("package_id", "vulnerability_id", "collector", "scanner_uri", "scanner_version", "origin", "db_uri", "db_version", "tenant");
+-- Create index "certifyvuln_tenant" to table: "certify_vulns"
+CREATE INDEX "certifyvuln_tenant" ON "certify_vulns" ("tenant");
+-- Modify "dependencies" table
+ALTER TABLE "dependencies" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "dependency_dependency_type_jus_2ed522bad5bbd1bbb0f36367067b1146" from table: "dependencies"
+DROP INDEX "dependency_dependency_type_jus_2ed522bad5bbd1bbb0f36367067b1146";
+-- Create index "dependency_dependency_type_jus_70379be95cb60feae8d0e10d6a476b1c" to table: "dependencies"
+CREATE UNIQUE INDEX "dependency_dependency_type_jus_70379be95cb60feae8d0e10d6a476b1c" ON "dependencies" ("dependency_type", "justification", "origin", "collector", "document_ref", "package_id", "dependent_package_version_id", "tenant");
+-- Create index "dependency_tenant" to table: "dependencies"
+CREATE INDEX "dependency_tenant" ON "dependencies" ("tenant");
+-- Modify "documents" table
+ALTER TABLE "documents" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "document_blob_key" from table: "documents"
+DROP INDEX "document_blob_key";
+-- Create index "document_blob_key_tenant" to table: "documents"
+CREATE UNIQUE INDEX "document_blob_key_tenant" ON "documents" ("blob_key", "tenant");
+-- Create index "document_tenant" to table: "documents"
+CREATE INDEX "document_tenant" ON "documents" ("tenant");
+-- Modify "has_metadata" table
+ALTER TABLE "has_metadata" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "has_metadata_artifact_id" from table: "has_metadata"
+DROP INDEX "has_metadata_artifact_id";
+-- Drop index "has_metadata_package_name_id" from table: "has_metadata"
+DROP INDEX "has_metadata_package_name_id";
+-- Drop index "has_metadata_package_version_id" from table: "has_metadata"
+DROP INDEX "has_metadata_package_version_id";
+-- Drop index "has_metadata_source_id" from table: "has_metadata"
+DROP INDEX "has_metadata_source_id";
+-- Create index "has_metadata_artifact_id" to table: "has_metadata"
+CREATE UNIQUE INDEX "has_metadata_artifact_id" ON "has_metadata" ("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "artifact_id", "tenant") WHERE ((source_id IS NULL) AND (package_version_id IS NULL) AND (package_name_id IS NULL) AND (artifact_id IS NOT NULL));
+-- Create index "has_metadata_package_name_id" to table: "has_metadata"
+CREATE UNIQUE INDEX "has_metadata_package_name_id" ON "has_metadata" ("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "package_name_id", "tenant") WHERE ((source_id IS NULL) AND (package_version_id IS NULL) AND (package_name_id IS NOT NULL) AND (artifact_id IS NULL));
+-- Create index "has_metadata_package_version_id" to table: "has_metadata"
+CREATE UNIQUE INDEX "has_metadata_package_version_id" ON "has_metadata" ("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "package_version_id", "tenant") WHERE ((source_id IS NULL) AND (package_version_id IS NOT NULL) AND (package_name_id IS NULL) AND (artifact_id IS NULL));
+-- Create index "has_metadata_source_id" to table: "has_metadata"
+CREATE UNIQUE INDEX "has_metadata_source_id" ON "has_metadata" ("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "source_id", "tenant") WHERE ((source_id IS NOT NULL) AND (package_version_id IS NULL) AND (package_name_id IS NULL) AND (artifact_id IS NULL));
+-- Create index "hasmetadata_tenant" to table: "has_metadata"
+CREATE INDEX "hasmetadata_tenant" ON "has_metadata" ("tenant");
+-- Modify "has_source_ats" table
+ALTER TABLE "has_source_ats" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "hassourceat_source_id_package__3f3922781897bf9d4f13a387d2ee1087" from table: "has_source_ats"
+DROP INDEX "hassourceat_source_id_package__3f3922781897bf9d4f13a387d2ee1087";
+-- Drop index "hassourceat_source_id_package__568bc11d70ea247e8b9260aa5f8db55d" from table: "has_source_ats"
+DROP INDEX "hassourceat_source_id_package__568bc11d70ea247e8b9260aa5f8db55d";
+-- Create index "hassourceat_source_id_package__8a587548ab81cc41b8c6578d95f54054" to table: "has_source_ats"
+CREATE UNIQUE INDEX "hassourceat_source_id_package__8a587548ab81cc41b8c6578d95f54054" ON "has_source_ats" ("source_id", "package_version_id", "justification", "origin", "collector", "known_since", "document_ref", "tenant") WHERE ((package_version_id IS NOT NULL) AND (package_name_id IS NULL));
+-- Create index "hassourceat_source_id_package__eab7343ae96227ae867e73948ffc924b" to table: "has_source_ats"
+CREATE UNIQUE INDEX "hassourceat_source_id_package__eab7343ae96227ae867e73948ffc924b" ON "has_source_ats" ("source_id", "package_name_id", "justification", "origin", "collector", "known_since", "document_ref", "tenant") WHERE ((package_name_id IS NOT NULL) AND (package_version_id IS NULL));
+-- Create index "hassourceat_tenant" to table: "has_source_ats"
+CREATE INDEX "hassourceat_tenant" ON "has_source_ats" ("tenant");
+-- Modify "hash_equals" table
+ALTER TABLE "hash_equals" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "hashequal_art_id_equal_art_id__b59aed1c2db16430ebb5ff1773c11d79" from table: "hash_equals"
+DROP INDEX "hashequal_art_id_equal_art_id__b59aed1c2db16430ebb5ff1773c11d79";
+-- Create index "hashequal_art_id_equal_art_id__37b6c0c9acf7e30884b792a1dec10828" to table: "hash_equals"
+CREATE UNIQUE INDEX "hashequal_art_id_equal_art_id__37b6c0c9acf7e30884b792a1dec10828" ON "hash_equals" ("art_id", "equal_art_id", "artifacts_hash", "origin", "justification", "collector", "document_ref", "tenant");
+-- Create index "hashequal_tenant" to table: "hash_equals"
+CREATE INDEX "hashequal_tenant" ON "hash_equals" ("tenant");
+-- Modify "licenses" table
+ALTER TABLE "licenses" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "license_name_inline_hash_list_version_hash" from table: "licenses"
+DROP INDEX "license_name_inline_hash_list_version_hash";
+-- Create index "license_name_inline_hash_list_version_hash_tenant" to table: "licenses"
+CREATE UNIQUE INDEX "license_name_inline_hash_list_version_hash_tenant" ON "licenses" ("name", "inline_hash", "list_version_hash", "tenant");
+-- Create index "license_tenant" to table: "licenses"
+CREATE INDEX "license_tenant" ON "licenses" ("tenant");
+-- Modify "occurrences" table
+ALTER TABLE "occurrences" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "occurrence_package_id" from table: "occurrences"
+DROP INDEX "occurrence_package_id";
+-- Drop index "occurrence_source_id" from table: "occurrences"
+DROP INDEX "occurrence_source_id";
+-- Create index "occurrence_package_id" to table: "occurrences"
+CREATE UNIQUE INDEX "occurrence_package_id" ON "occurrences" ("justification", "origin", "collector", "document_ref", "tenant", "artifact_id", "package_id") WHERE ((package_id IS NOT NULL) AND (source_id IS NULL));
+-- Create index "occurrence_source_id" to table: "occurrences"
+CREATE UNIQUE INDEX "occurrence_source_id" ON "occurrences" ("justification", "origin", "collector", "document_ref", "tenant", "artifact_id", "source_id") WHERE ((package_id IS NULL) AND (source_id IS NOT NULL));
+-- Create index "occurrence_tenant" to table: "occurrences"
+CREATE INDEX "occurrence_tenant" ON "occurrences" ("tenant");
+-- Modify "package_names" table
+ALTER TABLE "package_names" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "packagename_name_namespace_type" from table: "package_names"
+DROP INDEX "packagename_name_namespace_type";
+-- Create index "packagename_name_namespace_type_tenant" to table: "package_names"
+CREATE UNIQUE INDEX "packagename_name_namespace_type_tenant" ON "package_names" ("name", "namespace", "type", "tenant");
+-- Create index "packagename_tenant" to table: "package_names"
+CREATE INDEX "packagename_tenant" ON "package_names" ("tenant");
+-- Modify "package_versions" table
+ALTER TABLE "package_versions" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "packageversion_hash_name_id" from table: "package_versions"
+DROP INDEX "packageversion_hash_name_id";
+-- Drop index "packageversion_version_subpath_qualifiers_name_id" from table: "package_versions"
+DROP INDEX "packageversion_version_subpath_qualifiers_name_id";
+-- Create index "packageversion_hash_tenant_name_id" to table: "package_versions"
+CREATE UNIQUE INDEX "packageversion_hash_tenant_name_id" ON "package_versions" ("hash", "tenant", "name_id");
+-- Create index "packageversion_tenant" to table: "package_versions"
+CREATE INDEX "packageversion_tenant" ON "package_versions" ("tenant");
+-- Create index "packageversion_version_subpath_qualifiers_tenant_name_id" to table: "package_versions"
+CREATE UNIQUE INDEX "packageversion_version_subpath_qualifiers_tenant_name_id" ON "package_versions" ("version", "subpath", "qualifiers", "tenant", "name_id");
+-- Modify "pkg_equals" table
+ALTER TABLE "pkg_equals" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "pkgequal_pkg_id_equal_pkg_id_p_f643240e18bf980c4dcfa27572edf71a" from table: "pkg_equals"
+DROP INDEX "pkgequal_pkg_id_equal_pkg_id_p_f643240e18bf980c4dcfa27572edf71a";
+-- Create index "pkgequal_pkg_id_equal_pkg_id_p_806f476ead2696788fd789ccb633556b" to table: "pkg_equals"
+CREATE UNIQUE INDEX "pkgequal_pkg_id_equal_pkg_id_p_806f476ead2696788fd789ccb633556b" ON "pkg_equals" ("pkg_id", "equal_pkg_id", "packages_hash", "origin", "justification", "collector", "document_ref", "tenant");
+-- Create index "pkgequal_tenant" to table: "pkg_equals"
+CREATE INDEX "pkgequal_tenant" ON "pkg_equals" ("tenant");
+-- Modify "point_of_contacts" table
+ALTER TABLE "point_of_contacts" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "poc_artifact_id" from table: "point_of_contacts"
+DROP INDEX "poc_artifact_id";
+-- Drop index "poc_package_name_id" from table: "point_of_contacts"
+DROP INDEX "poc_package_name_id";
+-- Drop index "poc_package_version_id" from table: "point_of_contacts"
+DROP INDEX "poc_package_version_id";
+-- Drop index "poc_source_id" from table: "point_of_contacts"
+DROP INDEX "poc_source_id";
+-- Create index "poc_artifact_id" to table: "point_of_contacts"
+CREATE UNIQUE INDEX "poc_artifact_id" ON "point_of_contacts" ("since", "email", "info", "justification", "origin", "collector", "document_ref", "artifact_id", "tenant") WHERE ((source_id IS NULL) AND (package_version_id IS NULL) AND (package_name_id IS NULL) AND (artifact_id IS NOT NULL));
+-- Create index "poc_package_name_id" to table: "point_of_contacts"
+CREATE UNIQUE INDEX "poc_package_name_id" ON "point_of_contacts" ("since", "email", "info", "justification", "origin", "collector", "document_ref", "package_name_id", "tenant") WHERE ((source_id IS NULL) AND (package_version_id IS NULL) AND (package_name_id IS NOT NULL) AND (artifact_id IS NULL));
+-- Create index "poc_package_version_id" to table: "point_of_contacts"
+CREATE UNIQUE INDEX "poc_package_version_id" ON "point_of_contacts" ("since", "email", "info", "justification", "origin", "collector", "document_ref", "package_version_id", "tenant") WHERE ((source_id IS NULL) AND (package_version_id IS NOT NULL) AND (package_name_id IS NULL) AND (artifact_id IS NULL));
+-- Create index "poc_source_id" to table: "point_of_contacts"
+CREATE UNIQUE INDEX "poc_source_id" ON "point_of_contacts" ("since", "email", "info", "justification", "origin", "collector", "document_ref", "source_id", "tenant") WHERE ((source_id IS NOT NULL) AND (package_version_id IS NULL) AND (package_name_id IS NULL) AND (artifact_id IS NULL));
+-- Create index "pointofcontact_tenant" to table: "point_of_contacts"
+CREATE INDEX "pointofcontact_tenant" ON "point_of_contacts" ("tenant");
+-- Modify "slsa_attestations" table
+ALTER TABLE "slsa_attestations" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "slsaattestation_subject_id_ori_c12d4f9a94b2524558ac44ae3d65a07c" from table: "slsa_attestations"
+DROP INDEX "slsaattestation_subject_id_ori_c12d4f9a94b2524558ac44ae3d65a07c";
+-- Create index "slsaattestation_subject_id_ori_5deb9e87a17c3f899162e3f3dff6e4db" to table: "slsa_attestations"
+CREATE UNIQUE INDEX "slsaattestation_subject_id_ori_5deb9e87a17c3f899162e3f3dff6e4db" ON "slsa_attestations" ("subject_id", "origin", "collector", "document_ref", "build_type", "slsa_version", "built_by_id", "built_from_hash", "started_on", "finished_on", "tenant");
+-- Create index "slsaattestation_tenant" to table: "slsa_attestations"
+CREATE INDEX "slsaattestation_tenant" ON "slsa_attestations" ("tenant");
+-- Modify "source_names" table
+ALTER TABLE "source_names" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "sourcename_type_namespace_name_commit_tag" from table: "source_names"
+DROP INDEX "sourcename_type_namespace_name_commit_tag";
+-- Create index "sourcename_tenant" to table: "source_names"
+CREATE INDEX "sourcename_tenant" ON "source_names" ("tenant");
+-- Create index "sourcename_type_namespace_name_commit_tag_tenant" to table: "source_names"
+CREATE UNIQUE INDEX "sourcename_type_namespace_name_commit_tag_tenant" ON "source_names" ("type", "namespace", "name", "commit", "tag", "tenant");
+-- Modify "vuln_equals" table
+ALTER TABLE "vuln_equals" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "vulnequal_vuln_id_equal_vuln_i_67baeaab87be2e7cbf2595bd0c907077" from table: "vuln_equals"
+DROP INDEX "vulnequal_vuln_id_equal_vuln_i_67baeaab87be2e7cbf2595bd0c907077";
+-- Create index "vulnequal_tenant" to table: "vuln_equals"
+CREATE INDEX "vulnequal_tenant" ON "vuln_equals" ("tenant");
+-- Create index "vulnequal_vuln_id_equal_vuln_i_3b8945a1babc442583e7032511c1f9b6" to table: "vuln_equals"
+CREATE UNIQUE INDEX "vulnequal_vuln_id_equal_vuln_i_3b8945a1babc442583e7032511c1f9b6" ON "vuln_equals" ("vuln_id", "equal_vuln_id", "vulnerabilities_hash", "justification", "origin", "collector", "document_ref", "tenant");
+-- Modify "vulnerability_ids" table
+ALTER TABLE "vulnerability_ids" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "vulnerabilityid_vulnerability_id_type" from table: "vulnerability_ids"
+DROP INDEX "vulnerabilityid_vulnerability_id_type";
+-- Create index "vulnerabilityid_tenant" to table: "vulnerability_ids"
+CREATE INDEX "vulnerabilityid_tenant" ON "vulnerability_ids" ("tenant");
+-- Create index "vulnerabilityid_vulnerability_id_type_tenant" to table: "vulnerability_ids"
+CREATE UNIQUE INDEX "vulnerabilityid_vulnerability_id_type_tenant" ON "vulnerability_ids" ("vulnerability_id", "type", "tenant");
+-- Modify "vulnerability_metadata" table
+ALTER TABLE "vulnerability_metadata" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Drop index "vulnerabilitymetadata_vulnerab_925c5bef552e5cc97592b157d457801a" from table: "vulnerability_metadata"
+DROP INDEX "vulnerabilitymetadata_vulnerab_925c5bef552e5cc97592b157d457801a";
+-- Create index "vulnerabilitymetadata_tenant" to table: "vulnerability_metadata"
+CREATE INDEX "vulnerabilitymetadata_tenant" ON "vulnerability_metadata" ("tenant");
+-- Create index "vulnerabilitymetadata_vulnerab_72a736ee1f604c604e0f5ce37826a94a" to table: "vulnerability_metadata"
+CREATE UNIQUE INDEX "vulnerabilitymetadata_vulnerab_72a736ee1f604c604e0f5ce37826a94a" ON "vulnerability_metadata" ("vulnerability_id_id", "score_type", "score_value", "timestamp", "origin", "collector", "document_ref", "tenant");
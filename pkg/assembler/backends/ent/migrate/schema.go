@@ -12,6 +12,7 @@ var (
 	// ArtifactsColumns holds the columns for the "artifacts" table.
 	ArtifactsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "algorithm", Type: field.TypeString},
 		{Name: "digest", Type: field.TypeString},
 	}
@@ -22,15 +23,21 @@ var (
 		PrimaryKey: []*schema.Column{ArtifactsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "artifact_algorithm_digest",
+				Name:    "artifact_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{ArtifactsColumns[1]},
+			},
+			{
+				Name:    "artifact_algorithm_digest_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{ArtifactsColumns[1], ArtifactsColumns[2]},
+				Columns: []*schema.Column{ArtifactsColumns[2], ArtifactsColumns[3], ArtifactsColumns[1]},
 			},
 		},
 	}
 	// BillOfMaterialsColumns holds the columns for the "bill_of_materials" table.
 	BillOfMaterialsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "uri", Type: field.TypeString},
 		{Name: "algorithm", Type: field.TypeString},
 		{Name: "digest", Type: field.TypeString},
@@ -54,22 +61,27 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "bill_of_materials_package_versions_package",
-				Columns:    []*schema.Column{BillOfMaterialsColumns[13]},
+				Columns:    []*schema.Column{BillOfMaterialsColumns[14]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "bill_of_materials_artifacts_artifact",
-				Columns:    []*schema.Column{BillOfMaterialsColumns[14]},
+				Columns:    []*schema.Column{BillOfMaterialsColumns[15]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "billofmaterials_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{BillOfMaterialsColumns[1]},
+			},
 			{
 				Name:    "sbom_package_id",
 				Unique:  true,
-				Columns: []*schema.Column{BillOfMaterialsColumns[2], BillOfMaterialsColumns[3], BillOfMaterialsColumns[1], BillOfMaterialsColumns[4], BillOfMaterialsColumns[8], BillOfMaterialsColumns[9], BillOfMaterialsColumns[10], BillOfMaterialsColumns[11], BillOfMaterialsColumns[12], BillOfMaterialsColumns[5], BillOfMaterialsColumns[6], BillOfMaterialsColumns[7], BillOfMaterialsColumns[13]},
+				Columns: []*schema.Column{BillOfMaterialsColumns[3], BillOfMaterialsColumns[4], BillOfMaterialsColumns[2], BillOfMaterialsColumns[5], BillOfMaterialsColumns[9], BillOfMaterialsColumns[10], BillOfMaterialsColumns[11], BillOfMaterialsColumns[12], BillOfMaterialsColumns[13], BillOfMaterialsColumns[6], BillOfMaterialsColumns[7], BillOfMaterialsColumns[8], BillOfMaterialsColumns[1], BillOfMaterialsColumns[14]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -77,7 +89,7 @@ var (
 			{
 				Name:    "sbom_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{BillOfMaterialsColumns[2], BillOfMaterialsColumns[3], BillOfMaterialsColumns[1], BillOfMaterialsColumns[4], BillOfMaterialsColumns[8], BillOfMaterialsColumns[9], BillOfMaterialsColumns[10], BillOfMaterialsColumns[11], BillOfMaterialsColumns[12], BillOfMaterialsColumns[5], BillOfMaterialsColumns[6], BillOfMaterialsColumns[7], BillOfMaterialsColumns[14]},
+				Columns: []*schema.Column{BillOfMaterialsColumns[3], BillOfMaterialsColumns[4], BillOfMaterialsColumns[2], BillOfMaterialsColumns[5], BillOfMaterialsColumns[9], BillOfMaterialsColumns[10], BillOfMaterialsColumns[11], BillOfMaterialsColumns[12], BillOfMaterialsColumns[13], BillOfMaterialsColumns[6], BillOfMaterialsColumns[7], BillOfMaterialsColumns[8], BillOfMaterialsColumns[1], BillOfMaterialsColumns[15]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -85,7 +97,7 @@ var (
 			{
 				Name:    "billofmaterials_package_id",
 				Unique:  false,
-				Columns: []*schema.Column{BillOfMaterialsColumns[13]},
+				Columns: []*schema.Column{BillOfMaterialsColumns[14]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -93,7 +105,7 @@ var (
 			{
 				Name:    "billofmaterials_artifact_id",
 				Unique:  false,
-				Columns: []*schema.Column{BillOfMaterialsColumns[14]},
+				Columns: []*schema.Column{BillOfMaterialsColumns[15]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -103,7 +115,8 @@ var (
 	// BuildersColumns holds the columns for the "builders" table.
 	BuildersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
-		{Name: "uri", Type: field.TypeString, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
+		{Name: "uri", Type: field.TypeString},
 	}
 	// BuildersTable holds the schema information for the "builders" table.
 	BuildersTable = &schema.Table{
@@ -112,15 +125,21 @@ var (
 		PrimaryKey: []*schema.Column{BuildersColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "builder_uri",
-				Unique:  true,
+				Name:    "builder_tenant",
+				Unique:  false,
 				Columns: []*schema.Column{BuildersColumns[1]},
 			},
+			{
+				Name:    "builder_uri_tenant",
+				Unique:  true,
+				Columns: []*schema.Column{BuildersColumns[2], BuildersColumns[1]},
+			},
 		},
 	}
 	// CertificationsColumns holds the columns for the "certifications" table.
 	CertificationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"GOOD", "BAD"}, Default: "GOOD"},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "known_since", Type: field.TypeTime},
@@ -140,58 +159,63 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certifications_source_names_source",
-				Columns:    []*schema.Column{CertificationsColumns[7]},
+				Columns:    []*schema.Column{CertificationsColumns[8]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certifications_package_versions_package_version",
-				Columns:    []*schema.Column{CertificationsColumns[8]},
+				Columns:    []*schema.Column{CertificationsColumns[9]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certifications_package_names_all_versions",
-				Columns:    []*schema.Column{CertificationsColumns[9]},
+				Columns:    []*schema.Column{CertificationsColumns[10]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certifications_artifacts_artifact",
-				Columns:    []*schema.Column{CertificationsColumns[10]},
+				Columns:    []*schema.Column{CertificationsColumns[11]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "certification_type_justification_origin_collector_source_id_known_since_document_ref",
+				Name:    "certification_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertificationsColumns[1]},
+			},
+			{
+				Name:    "certification_type_justification_origin_collector_source_id_known_since_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[4], CertificationsColumns[5], CertificationsColumns[7], CertificationsColumns[3], CertificationsColumns[6]},
+				Columns: []*schema.Column{CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[5], CertificationsColumns[6], CertificationsColumns[8], CertificationsColumns[4], CertificationsColumns[7], CertificationsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
 			},
 			{
-				Name:    "certification_type_justification_origin_collector_package_version_id_known_since_document_ref",
+				Name:    "certification_type_justification_origin_collector_package_version_id_known_since_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[4], CertificationsColumns[5], CertificationsColumns[8], CertificationsColumns[3], CertificationsColumns[6]},
+				Columns: []*schema.Column{CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[5], CertificationsColumns[6], CertificationsColumns[9], CertificationsColumns[4], CertificationsColumns[7], CertificationsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
 			},
 			{
-				Name:    "certification_type_justification_origin_collector_package_name_id_known_since_document_ref",
+				Name:    "certification_type_justification_origin_collector_package_name_id_known_since_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[4], CertificationsColumns[5], CertificationsColumns[9], CertificationsColumns[3], CertificationsColumns[6]},
+				Columns: []*schema.Column{CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[5], CertificationsColumns[6], CertificationsColumns[10], CertificationsColumns[4], CertificationsColumns[7], CertificationsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL",
 				},
 			},
 			{
-				Name:    "certification_type_justification_origin_collector_artifact_id_known_since_document_ref",
+				Name:    "certification_type_justification_origin_collector_artifact_id_known_since_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[4], CertificationsColumns[5], CertificationsColumns[10], CertificationsColumns[3], CertificationsColumns[6]},
+				Columns: []*schema.Column{CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[5], CertificationsColumns[6], CertificationsColumns[11], CertificationsColumns[4], CertificationsColumns[7], CertificationsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -201,6 +225,7 @@ var (
 	// CertifyLegalsColumns holds the columns for the "certify_legals" table.
 	CertifyLegalsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "declared_license", Type: field.TypeString},
 		{Name: "discovered_license", Type: field.TypeString},
 		{Name: "attribution", Type: field.TypeString},
@@ -222,22 +247,27 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_legals_package_versions_package",
-				Columns:    []*schema.Column{CertifyLegalsColumns[11]},
+				Columns:    []*schema.Column{CertifyLegalsColumns[12]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certify_legals_source_names_source",
-				Columns:    []*schema.Column{CertifyLegalsColumns[12]},
+				Columns:    []*schema.Column{CertifyLegalsColumns[13]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "certifylegal_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyLegalsColumns[1]},
+			},
 			{
 				Name:    "cl_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyLegalsColumns[1], CertifyLegalsColumns[4], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[9], CertifyLegalsColumns[10], CertifyLegalsColumns[12]},
+				Columns: []*schema.Column{CertifyLegalsColumns[2], CertifyLegalsColumns[5], CertifyLegalsColumns[7], CertifyLegalsColumns[8], CertifyLegalsColumns[10], CertifyLegalsColumns[11], CertifyLegalsColumns[13], CertifyLegalsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND source_id IS NOT NULL",
 				},
@@ -245,7 +275,7 @@ var (
 			{
 				Name:    "cl_pkg_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyLegalsColumns[1], CertifyLegalsColumns[4], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[9], CertifyLegalsColumns[10], CertifyLegalsColumns[11]},
+				Columns: []*schema.Column{CertifyLegalsColumns[2], CertifyLegalsColumns[5], CertifyLegalsColumns[7], CertifyLegalsColumns[8], CertifyLegalsColumns[10], CertifyLegalsColumns[11], CertifyLegalsColumns[12], CertifyLegalsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -253,7 +283,7 @@ var (
 			{
 				Name:    "certifylegal_package_id",
 				Unique:  false,
-				Columns: []*schema.Column{CertifyLegalsColumns[11]},
+				Columns: []*schema.Column{CertifyLegalsColumns[12]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -261,7 +291,7 @@ var (
 			{
 				Name:    "certifylegal_package_id_declared_licenses_hash_discovered_licenses_hash_time_scanned",
 				Unique:  false,
-				Columns: []*schema.Column{CertifyLegalsColumns[11], CertifyLegalsColumns[9], CertifyLegalsColumns[10], CertifyLegalsColumns[5]},
+				Columns: []*schema.Column{CertifyLegalsColumns[12], CertifyLegalsColumns[10], CertifyLegalsColumns[11], CertifyLegalsColumns[6]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -271,6 +301,7 @@ var (
 	// CertifyScorecardsColumns holds the columns for the "certify_scorecards" table.
 	CertifyScorecardsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "checks", Type: field.TypeJSON},
 		{Name: "aggregate_score", Type: field.TypeFloat64, Default: 0},
 		{Name: "time_scanned", Type: field.TypeTime},
@@ -290,22 +321,28 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_scorecards_source_names_source",
-				Columns:    []*schema.Column{CertifyScorecardsColumns[10]},
+				Columns:    []*schema.Column{CertifyScorecardsColumns[11]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "certifyscorecard_source_id_origin_collector_scorecard_version_scorecard_commit_aggregate_score_time_scanned_checks_hash_document_ref",
+				Name:    "certifyscorecard_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyScorecardsColumns[1]},
+			},
+			{
+				Name:    "certifyscorecard_source_id_origin_collector_scorecard_version_scorecard_commit_aggregate_score_time_scanned_checks_hash_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyScorecardsColumns[10], CertifyScorecardsColumns[6], CertifyScorecardsColumns[7], CertifyScorecardsColumns[4], CertifyScorecardsColumns[5], CertifyScorecardsColumns[2], CertifyScorecardsColumns[3], CertifyScorecardsColumns[9], CertifyScorecardsColumns[8]},
+				Columns: []*schema.Column{CertifyScorecardsColumns[11], CertifyScorecardsColumns[7], CertifyScorecardsColumns[8], CertifyScorecardsColumns[5], CertifyScorecardsColumns[6], CertifyScorecardsColumns[3], CertifyScorecardsColumns[4], CertifyScorecardsColumns[10], CertifyScorecardsColumns[9], CertifyScorecardsColumns[1]},
 			},
 		},
 	}
 	// CertifyVexesColumns holds the columns for the "certify_vexes" table.
 	CertifyVexesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "known_since", Type: field.TypeTime},
 		{Name: "status", Type: field.TypeString},
 		{Name: "statement", Type: field.TypeString},
@@ -326,28 +363,33 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_vexes_package_versions_package",
-				Columns:    []*schema.Column{CertifyVexesColumns[9]},
+				Columns:    []*schema.Column{CertifyVexesColumns[10]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certify_vexes_artifacts_artifact",
-				Columns:    []*schema.Column{CertifyVexesColumns[10]},
+				Columns:    []*schema.Column{CertifyVexesColumns[11]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certify_vexes_vulnerability_ids_vulnerability",
-				Columns:    []*schema.Column{CertifyVexesColumns[11]},
+				Columns:    []*schema.Column{CertifyVexesColumns[12]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "certifyvex_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyVexesColumns[1]},
+			},
 			{
 				Name:    "vex_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyVexesColumns[1], CertifyVexesColumns[5], CertifyVexesColumns[2], CertifyVexesColumns[6], CertifyVexesColumns[7], CertifyVexesColumns[8], CertifyVexesColumns[11], CertifyVexesColumns[9]},
+				Columns: []*schema.Column{CertifyVexesColumns[2], CertifyVexesColumns[6], CertifyVexesColumns[3], CertifyVexesColumns[7], CertifyVexesColumns[8], CertifyVexesColumns[9], CertifyVexesColumns[1], CertifyVexesColumns[12], CertifyVexesColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "artifact_id IS NULL",
 				},
@@ -355,7 +397,7 @@ var (
 			{
 				Name:    "vex_package_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyVexesColumns[1], CertifyVexesColumns[5], CertifyVexesColumns[2], CertifyVexesColumns[6], CertifyVexesColumns[7], CertifyVexesColumns[8], CertifyVexesColumns[11], CertifyVexesColumns[10]},
+				Columns: []*schema.Column{CertifyVexesColumns[2], CertifyVexesColumns[6], CertifyVexesColumns[3], CertifyVexesColumns[7], CertifyVexesColumns[8], CertifyVexesColumns[9], CertifyVexesColumns[1], CertifyVexesColumns[12], CertifyVexesColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL",
 				},
@@ -365,6 +407,7 @@ var (
 	// CertifyVulnsColumns holds the columns for the "certify_vulns" table.
 	CertifyVulnsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "time_scanned", Type: field.TypeTime},
 		{Name: "db_uri", Type: field.TypeString},
 		{Name: "db_version", Type: field.TypeString},
@@ -384,43 +427,49 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_vulns_vulnerability_ids_vulnerability",
-				Columns:    []*schema.Column{CertifyVulnsColumns[9]},
+				Columns:    []*schema.Column{CertifyVulnsColumns[10]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "certify_vulns_package_versions_package",
-				Columns:    []*schema.Column{CertifyVulnsColumns[10]},
+				Columns:    []*schema.Column{CertifyVulnsColumns[11]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "certifyvuln_package_id_vulnerability_id_collector_scanner_uri_scanner_version_origin_db_uri_db_version",
+				Name:    "certifyvuln_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyVulnsColumns[1]},
+			},
+			{
+				Name:    "certifyvuln_package_id_vulnerability_id_collector_scanner_uri_scanner_version_origin_db_uri_db_version_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyVulnsColumns[10], CertifyVulnsColumns[9], CertifyVulnsColumns[7], CertifyVulnsColumns[4], CertifyVulnsColumns[5], CertifyVulnsColumns[6], CertifyVulnsColumns[2], CertifyVulnsColumns[3]},
+				Columns: []*schema.Column{CertifyVulnsColumns[11], CertifyVulnsColumns[10], CertifyVulnsColumns[8], CertifyVulnsColumns[5], CertifyVulnsColumns[6], CertifyVulnsColumns[7], CertifyVulnsColumns[3], CertifyVulnsColumns[4], CertifyVulnsColumns[1]},
 			},
 			{
 				Name:    "certifyvuln_package_id",
 				Unique:  false,
-				Columns: []*schema.Column{CertifyVulnsColumns[10]},
+				Columns: []*schema.Column{CertifyVulnsColumns[11]},
 			},
 			{
 				Name:    "certifyvuln_vulnerability_id",
 				Unique:  false,
-				Columns: []*schema.Column{CertifyVulnsColumns[9]},
+				Columns: []*schema.Column{CertifyVulnsColumns[10]},
 			},
 			{
 				Name:    "certifyvuln_vulnerability_id_package_id_time_scanned",
 				Unique:  false,
-				Columns: []*schema.Column{CertifyVulnsColumns[9], CertifyVulnsColumns[10], CertifyVulnsColumns[1]},
+				Columns: []*schema.Column{CertifyVulnsColumns[10], CertifyVulnsColumns[11], CertifyVulnsColumns[2]},
 			},
 		},
 	}
 	// DependenciesColumns holds the columns for the "dependencies" table.
 	DependenciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "dependency_type", Type: field.TypeEnum, Enums: []string{"DIRECT", "INDIRECT", "UNKNOWN"}},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
@@ -437,38 +486,75 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "dependencies_package_versions_package",
-				Columns:    []*schema.Column{DependenciesColumns[6]},
+				Columns:    []*schema.Column{DependenciesColumns[7]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "dependencies_package_versions_dependent_package_version",
-				Columns:    []*schema.Column{DependenciesColumns[7]},
+				Columns:    []*schema.Column{DependenciesColumns[8]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "dependency_dependency_type_justification_origin_collector_document_ref_package_id_dependent_package_version_id",
+				Name:    "dependency_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{DependenciesColumns[1]},
+			},
+			{
+				Name:    "dependency_dependency_type_justification_origin_collector_document_ref_package_id_dependent_package_version_id_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{DependenciesColumns[1], DependenciesColumns[2], DependenciesColumns[3], DependenciesColumns[4], DependenciesColumns[5], DependenciesColumns[6], DependenciesColumns[7]},
+				Columns: []*schema.Column{DependenciesColumns[2], DependenciesColumns[3], DependenciesColumns[4], DependenciesColumns[5], DependenciesColumns[6], DependenciesColumns[7], DependenciesColumns[8], DependenciesColumns[1]},
 			},
 			{
 				Name:    "dependency_package_id",
 				Unique:  false,
-				Columns: []*schema.Column{DependenciesColumns[6]},
+				Columns: []*schema.Column{DependenciesColumns[7]},
 			},
 			{
 				Name:    "dependency_dependent_package_version_id",
 				Unique:  false,
-				Columns: []*schema.Column{DependenciesColumns[7]},
+				Columns: []*schema.Column{DependenciesColumns[8]},
+			},
+		},
+	}
+	// DocumentsColumns holds the columns for the "documents" table.
+	DocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
+		{Name: "blob_key", Type: field.TypeString},
+		{Name: "sha256", Type: field.TypeString},
+		{Name: "document_type", Type: field.TypeString},
+		{Name: "format", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString},
+		{Name: "parser_version", Type: field.TypeString},
+		{Name: "ingested_at", Type: field.TypeTime},
+	}
+	// DocumentsTable holds the schema information for the "documents" table.
+	DocumentsTable = &schema.Table{
+		Name:       "documents",
+		Columns:    DocumentsColumns,
+		PrimaryKey: []*schema.Column{DocumentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "document_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{DocumentsColumns[1]},
+			},
+			{
+				Name:    "document_blob_key_tenant",
+				Unique:  true,
+				Columns: []*schema.Column{DocumentsColumns[2], DocumentsColumns[1]},
 			},
 		},
 	}
 	// HasMetadataColumns holds the columns for the "has_metadata" table.
 	HasMetadataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "timestamp", Type: field.TypeTime},
 		{Name: "key", Type: field.TypeString},
 		{Name: "value", Type: field.TypeString},
@@ -489,34 +575,39 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "has_metadata_source_names_source",
-				Columns:    []*schema.Column{HasMetadataColumns[8]},
+				Columns:    []*schema.Column{HasMetadataColumns[9]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_metadata_package_versions_package_version",
-				Columns:    []*schema.Column{HasMetadataColumns[9]},
+				Columns:    []*schema.Column{HasMetadataColumns[10]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_metadata_package_names_all_versions",
-				Columns:    []*schema.Column{HasMetadataColumns[10]},
+				Columns:    []*schema.Column{HasMetadataColumns[11]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_metadata_artifacts_artifact",
-				Columns:    []*schema.Column{HasMetadataColumns[11]},
+				Columns:    []*schema.Column{HasMetadataColumns[12]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "hasmetadata_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HasMetadataColumns[1]},
+			},
 			{
 				Name:    "has_metadata_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[1], HasMetadataColumns[7], HasMetadataColumns[8]},
+				Columns: []*schema.Column{HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[7], HasMetadataColumns[2], HasMetadataColumns[8], HasMetadataColumns[9], HasMetadataColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -524,7 +615,7 @@ var (
 			{
 				Name:    "has_metadata_package_version_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[1], HasMetadataColumns[7], HasMetadataColumns[9]},
+				Columns: []*schema.Column{HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[7], HasMetadataColumns[2], HasMetadataColumns[8], HasMetadataColumns[10], HasMetadataColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -532,7 +623,7 @@ var (
 			{
 				Name:    "has_metadata_package_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[1], HasMetadataColumns[7], HasMetadataColumns[10]},
+				Columns: []*schema.Column{HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[7], HasMetadataColumns[2], HasMetadataColumns[8], HasMetadataColumns[11], HasMetadataColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -540,7 +631,7 @@ var (
 			{
 				Name:    "has_metadata_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[1], HasMetadataColumns[7], HasMetadataColumns[11]},
+				Columns: []*schema.Column{HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[7], HasMetadataColumns[2], HasMetadataColumns[8], HasMetadataColumns[12], HasMetadataColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -550,6 +641,7 @@ var (
 	// HasSourceAtsColumns holds the columns for the "has_source_ats" table.
 	HasSourceAtsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "known_since", Type: field.TypeTime},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
@@ -567,36 +659,41 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "has_source_ats_package_versions_package_version",
-				Columns:    []*schema.Column{HasSourceAtsColumns[6]},
+				Columns:    []*schema.Column{HasSourceAtsColumns[7]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_source_ats_package_names_all_versions",
-				Columns:    []*schema.Column{HasSourceAtsColumns[7]},
+				Columns:    []*schema.Column{HasSourceAtsColumns[8]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_source_ats_source_names_source",
-				Columns:    []*schema.Column{HasSourceAtsColumns[8]},
+				Columns:    []*schema.Column{HasSourceAtsColumns[9]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "hassourceat_source_id_package_version_id_justification_origin_collector_known_since_document_ref",
+				Name:    "hassourceat_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HasSourceAtsColumns[1]},
+			},
+			{
+				Name:    "hassourceat_source_id_package_version_id_justification_origin_collector_known_since_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{HasSourceAtsColumns[8], HasSourceAtsColumns[6], HasSourceAtsColumns[2], HasSourceAtsColumns[3], HasSourceAtsColumns[4], HasSourceAtsColumns[1], HasSourceAtsColumns[5]},
+				Columns: []*schema.Column{HasSourceAtsColumns[9], HasSourceAtsColumns[7], HasSourceAtsColumns[3], HasSourceAtsColumns[4], HasSourceAtsColumns[5], HasSourceAtsColumns[2], HasSourceAtsColumns[6], HasSourceAtsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_version_id IS NOT NULL AND package_name_id IS NULL",
 				},
 			},
 			{
-				Name:    "hassourceat_source_id_package_name_id_justification_origin_collector_known_since_document_ref",
+				Name:    "hassourceat_source_id_package_name_id_justification_origin_collector_known_since_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{HasSourceAtsColumns[8], HasSourceAtsColumns[7], HasSourceAtsColumns[2], HasSourceAtsColumns[3], HasSourceAtsColumns[4], HasSourceAtsColumns[1], HasSourceAtsColumns[5]},
+				Columns: []*schema.Column{HasSourceAtsColumns[9], HasSourceAtsColumns[8], HasSourceAtsColumns[3], HasSourceAtsColumns[4], HasSourceAtsColumns[5], HasSourceAtsColumns[2], HasSourceAtsColumns[6], HasSourceAtsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_name_id IS NOT NULL AND package_version_id IS NULL",
 				},
@@ -606,6 +703,7 @@ var (
 	// HashEqualsColumns holds the columns for the "hash_equals" table.
 	HashEqualsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
 		{Name: "justification", Type: field.TypeString},
@@ -622,28 +720,34 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "hash_equals_artifacts_artifact_a",
-				Columns:    []*schema.Column{HashEqualsColumns[6]},
+				Columns:    []*schema.Column{HashEqualsColumns[7]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "hash_equals_artifacts_artifact_b",
-				Columns:    []*schema.Column{HashEqualsColumns[7]},
+				Columns:    []*schema.Column{HashEqualsColumns[8]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "hashequal_art_id_equal_art_id_artifacts_hash_origin_justification_collector_document_ref",
+				Name:    "hashequal_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HashEqualsColumns[1]},
+			},
+			{
+				Name:    "hashequal_art_id_equal_art_id_artifacts_hash_origin_justification_collector_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{HashEqualsColumns[6], HashEqualsColumns[7], HashEqualsColumns[5], HashEqualsColumns[1], HashEqualsColumns[3], HashEqualsColumns[2], HashEqualsColumns[4]},
+				Columns: []*schema.Column{HashEqualsColumns[7], HashEqualsColumns[8], HashEqualsColumns[6], HashEqualsColumns[2], HashEqualsColumns[4], HashEqualsColumns[3], HashEqualsColumns[5], HashEqualsColumns[1]},
 			},
 		},
 	}
 	// LicensesColumns holds the columns for the "licenses" table.
 	LicensesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "name", Type: field.TypeString},
 		{Name: "inline", Type: field.TypeString, Nullable: true},
 		{Name: "list_version", Type: field.TypeString, Nullable: true},
@@ -657,15 +761,21 @@ var (
 		PrimaryKey: []*schema.Column{LicensesColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "license_name_inline_hash_list_version_hash",
+				Name:    "license_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{LicensesColumns[1]},
+			},
+			{
+				Name:    "license_name_inline_hash_list_version_hash_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{LicensesColumns[1], LicensesColumns[4], LicensesColumns[5]},
+				Columns: []*schema.Column{LicensesColumns[2], LicensesColumns[5], LicensesColumns[6], LicensesColumns[1]},
 			},
 		},
 	}
 	// OccurrencesColumns holds the columns for the "occurrences" table.
 	OccurrencesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
@@ -682,28 +792,33 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "occurrences_artifacts_artifact",
-				Columns:    []*schema.Column{OccurrencesColumns[5]},
+				Columns:    []*schema.Column{OccurrencesColumns[6]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "occurrences_package_versions_package",
-				Columns:    []*schema.Column{OccurrencesColumns[6]},
+				Columns:    []*schema.Column{OccurrencesColumns[7]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "occurrences_source_names_source",
-				Columns:    []*schema.Column{OccurrencesColumns[7]},
+				Columns:    []*schema.Column{OccurrencesColumns[8]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "occurrence_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{OccurrencesColumns[1]},
+			},
 			{
 				Name:    "occurrence_package_id",
 				Unique:  true,
-				Columns: []*schema.Column{OccurrencesColumns[1], OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[4], OccurrencesColumns[5], OccurrencesColumns[6]},
+				Columns: []*schema.Column{OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[4], OccurrencesColumns[5], OccurrencesColumns[1], OccurrencesColumns[6], OccurrencesColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -711,7 +826,7 @@ var (
 			{
 				Name:    "occurrence_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{OccurrencesColumns[1], OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[4], OccurrencesColumns[5], OccurrencesColumns[7]},
+				Columns: []*schema.Column{OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[4], OccurrencesColumns[5], OccurrencesColumns[1], OccurrencesColumns[6], OccurrencesColumns[8]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND source_id IS NOT NULL",
 				},
@@ -719,7 +834,7 @@ var (
 			{
 				Name:    "query_occurrence_package_id",
 				Unique:  false,
-				Columns: []*schema.Column{OccurrencesColumns[6]},
+				Columns: []*schema.Column{OccurrencesColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -727,13 +842,14 @@ var (
 			{
 				Name:    "occurrence_artifact_id",
 				Unique:  false,
-				Columns: []*schema.Column{OccurrencesColumns[5]},
+				Columns: []*schema.Column{OccurrencesColumns[6]},
 			},
 		},
 	}
 	// PackageNamesColumns holds the columns for the "package_names" table.
 	PackageNamesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "type", Type: field.TypeString},
 		{Name: "namespace", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
@@ -745,15 +861,21 @@ var (
 		PrimaryKey: []*schema.Column{PackageNamesColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "packagename_name_namespace_type",
+				Name:    "packagename_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{PackageNamesColumns[1]},
+			},
+			{
+				Name:    "packagename_name_namespace_type_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{PackageNamesColumns[3], PackageNamesColumns[2], PackageNamesColumns[1]},
+				Columns: []*schema.Column{PackageNamesColumns[4], PackageNamesColumns[3], PackageNamesColumns[2], PackageNamesColumns[1]},
 			},
 		},
 	}
 	// PackageVersionsColumns holds the columns for the "package_versions" table.
 	PackageVersionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "version", Type: field.TypeString, Default: ""},
 		{Name: "subpath", Type: field.TypeString, Default: ""},
 		{Name: "qualifiers", Type: field.TypeJSON, Nullable: true},
@@ -768,21 +890,26 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "package_versions_package_names_versions",
-				Columns:    []*schema.Column{PackageVersionsColumns[5]},
+				Columns:    []*schema.Column{PackageVersionsColumns[6]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "packageversion_hash_name_id",
+				Name:    "packageversion_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{PackageVersionsColumns[1]},
+			},
+			{
+				Name:    "packageversion_hash_tenant_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{PackageVersionsColumns[4], PackageVersionsColumns[5]},
+				Columns: []*schema.Column{PackageVersionsColumns[5], PackageVersionsColumns[1], PackageVersionsColumns[6]},
 			},
 			{
 				Name:    "packageversion_qualifiers",
 				Unique:  false,
-				Columns: []*schema.Column{PackageVersionsColumns[3]},
+				Columns: []*schema.Column{PackageVersionsColumns[4]},
 				Annotation: &entsql.IndexAnnotation{
 					Types: map[string]string{
 						"postgres": "GIN",
@@ -790,15 +917,16 @@ var (
 				},
 			},
 			{
-				Name:    "packageversion_version_subpath_qualifiers_name_id",
+				Name:    "packageversion_version_subpath_qualifiers_tenant_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{PackageVersionsColumns[1], PackageVersionsColumns[2], PackageVersionsColumns[3], PackageVersionsColumns[5]},
+				Columns: []*schema.Column{PackageVersionsColumns[2], PackageVersionsColumns[3], PackageVersionsColumns[4], PackageVersionsColumns[1], PackageVersionsColumns[6]},
 			},
 		},
 	}
 	// PkgEqualsColumns holds the columns for the "pkg_equals" table.
 	PkgEqualsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
 		{Name: "document_ref", Type: field.TypeString},
@@ -815,28 +943,34 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "pkg_equals_package_versions_package_a",
-				Columns:    []*schema.Column{PkgEqualsColumns[6]},
+				Columns:    []*schema.Column{PkgEqualsColumns[7]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "pkg_equals_package_versions_package_b",
-				Columns:    []*schema.Column{PkgEqualsColumns[7]},
+				Columns:    []*schema.Column{PkgEqualsColumns[8]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "pkgequal_pkg_id_equal_pkg_id_packages_hash_origin_justification_collector_document_ref",
+				Name:    "pkgequal_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{PkgEqualsColumns[1]},
+			},
+			{
+				Name:    "pkgequal_pkg_id_equal_pkg_id_packages_hash_origin_justification_collector_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{PkgEqualsColumns[6], PkgEqualsColumns[7], PkgEqualsColumns[5], PkgEqualsColumns[1], PkgEqualsColumns[4], PkgEqualsColumns[2], PkgEqualsColumns[3]},
+				Columns: []*schema.Column{PkgEqualsColumns[7], PkgEqualsColumns[8], PkgEqualsColumns[6], PkgEqualsColumns[2], PkgEqualsColumns[5], PkgEqualsColumns[3], PkgEqualsColumns[4], PkgEqualsColumns[1]},
 			},
 		},
 	}
 	// PointOfContactsColumns holds the columns for the "point_of_contacts" table.
 	PointOfContactsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "email", Type: field.TypeString},
 		{Name: "info", Type: field.TypeString},
 		{Name: "since", Type: field.TypeTime},
@@ -857,34 +991,39 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "point_of_contacts_source_names_source",
-				Columns:    []*schema.Column{PointOfContactsColumns[8]},
+				Columns:    []*schema.Column{PointOfContactsColumns[9]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "point_of_contacts_package_versions_package_version",
-				Columns:    []*schema.Column{PointOfContactsColumns[9]},
+				Columns:    []*schema.Column{PointOfContactsColumns[10]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "point_of_contacts_package_names_all_versions",
-				Columns:    []*schema.Column{PointOfContactsColumns[10]},
+				Columns:    []*schema.Column{PointOfContactsColumns[11]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "point_of_contacts_artifacts_artifact",
-				Columns:    []*schema.Column{PointOfContactsColumns[11]},
+				Columns:    []*schema.Column{PointOfContactsColumns[12]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "pointofcontact_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{PointOfContactsColumns[1]},
+			},
 			{
 				Name:    "poc_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[8]},
+				Columns: []*schema.Column{PointOfContactsColumns[4], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[8], PointOfContactsColumns[9], PointOfContactsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -892,7 +1031,7 @@ var (
 			{
 				Name:    "poc_package_version_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[9]},
+				Columns: []*schema.Column{PointOfContactsColumns[4], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[8], PointOfContactsColumns[10], PointOfContactsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -900,7 +1039,7 @@ var (
 			{
 				Name:    "poc_package_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[10]},
+				Columns: []*schema.Column{PointOfContactsColumns[4], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[8], PointOfContactsColumns[11], PointOfContactsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -908,7 +1047,7 @@ var (
 			{
 				Name:    "poc_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[11]},
+				Columns: []*schema.Column{PointOfContactsColumns[4], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[8], PointOfContactsColumns[12], PointOfContactsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -918,6 +1057,7 @@ var (
 	// SlsaAttestationsColumns holds the columns for the "slsa_attestations" table.
 	SlsaAttestationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "build_type", Type: field.TypeString},
 		{Name: "slsa_predicate", Type: field.TypeJSON, Nullable: true},
 		{Name: "slsa_version", Type: field.TypeString},
@@ -938,28 +1078,34 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "slsa_attestations_builders_built_by",
-				Columns:    []*schema.Column{SlsaAttestationsColumns[10]},
+				Columns:    []*schema.Column{SlsaAttestationsColumns[11]},
 				RefColumns: []*schema.Column{BuildersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "slsa_attestations_artifacts_subject",
-				Columns:    []*schema.Column{SlsaAttestationsColumns[11]},
+				Columns:    []*schema.Column{SlsaAttestationsColumns[12]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "slsaattestation_subject_id_origin_collector_document_ref_build_type_slsa_version_built_by_id_built_from_hash_started_on_finished_on",
+				Name:    "slsaattestation_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{SlsaAttestationsColumns[1]},
+			},
+			{
+				Name:    "slsaattestation_subject_id_origin_collector_document_ref_build_type_slsa_version_built_by_id_built_from_hash_started_on_finished_on_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{SlsaAttestationsColumns[11], SlsaAttestationsColumns[6], SlsaAttestationsColumns[7], SlsaAttestationsColumns[8], SlsaAttestationsColumns[1], SlsaAttestationsColumns[3], SlsaAttestationsColumns[10], SlsaAttestationsColumns[9], SlsaAttestationsColumns[4], SlsaAttestationsColumns[5]},
+				Columns: []*schema.Column{SlsaAttestationsColumns[12], SlsaAttestationsColumns[7], SlsaAttestationsColumns[8], SlsaAttestationsColumns[9], SlsaAttestationsColumns[2], SlsaAttestationsColumns[4], SlsaAttestationsColumns[11], SlsaAttestationsColumns[10], SlsaAttestationsColumns[5], SlsaAttestationsColumns[6], SlsaAttestationsColumns[1]},
 			},
 		},
 	}
 	// SourceNamesColumns holds the columns for the "source_names" table.
 	SourceNamesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "type", Type: field.TypeString},
 		{Name: "namespace", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
@@ -973,15 +1119,21 @@ var (
 		PrimaryKey: []*schema.Column{SourceNamesColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "sourcename_type_namespace_name_commit_tag",
+				Name:    "sourcename_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{SourceNamesColumns[1]},
+			},
+			{
+				Name:    "sourcename_type_namespace_name_commit_tag_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{SourceNamesColumns[1], SourceNamesColumns[2], SourceNamesColumns[3], SourceNamesColumns[4], SourceNamesColumns[5]},
+				Columns: []*schema.Column{SourceNamesColumns[2], SourceNamesColumns[3], SourceNamesColumns[4], SourceNamesColumns[5], SourceNamesColumns[6], SourceNamesColumns[1]},
 			},
 		},
 	}
 	// VulnEqualsColumns holds the columns for the "vuln_equals" table.
 	VulnEqualsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
@@ -998,28 +1150,34 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "vuln_equals_vulnerability_ids_vulnerability_a",
-				Columns:    []*schema.Column{VulnEqualsColumns[6]},
+				Columns:    []*schema.Column{VulnEqualsColumns[7]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "vuln_equals_vulnerability_ids_vulnerability_b",
-				Columns:    []*schema.Column{VulnEqualsColumns[7]},
+				Columns:    []*schema.Column{VulnEqualsColumns[8]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "vulnequal_vuln_id_equal_vuln_id_vulnerabilities_hash_justification_origin_collector_document_ref",
+				Name:    "vulnequal_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{VulnEqualsColumns[1]},
+			},
+			{
+				Name:    "vulnequal_vuln_id_equal_vuln_id_vulnerabilities_hash_justification_origin_collector_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{VulnEqualsColumns[6], VulnEqualsColumns[7], VulnEqualsColumns[5], VulnEqualsColumns[1], VulnEqualsColumns[2], VulnEqualsColumns[3], VulnEqualsColumns[4]},
+				Columns: []*schema.Column{VulnEqualsColumns[7], VulnEqualsColumns[8], VulnEqualsColumns[6], VulnEqualsColumns[2], VulnEqualsColumns[3], VulnEqualsColumns[4], VulnEqualsColumns[5], VulnEqualsColumns[1]},
 			},
 		},
 	}
 	// VulnerabilityIdsColumns holds the columns for the "vulnerability_ids" table.
 	VulnerabilityIdsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "vulnerability_id", Type: field.TypeString},
 		{Name: "type", Type: field.TypeString},
 	}
@@ -1030,20 +1188,26 @@ var (
 		PrimaryKey: []*schema.Column{VulnerabilityIdsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "vulnerabilityid_vulnerability_id_type",
+				Name:    "vulnerabilityid_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{VulnerabilityIdsColumns[1]},
+			},
+			{
+				Name:    "vulnerabilityid_vulnerability_id_type_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{VulnerabilityIdsColumns[1], VulnerabilityIdsColumns[2]},
+				Columns: []*schema.Column{VulnerabilityIdsColumns[2], VulnerabilityIdsColumns[3], VulnerabilityIdsColumns[1]},
 			},
 			{
 				Name:    "vulnerabilityid_type",
 				Unique:  false,
-				Columns: []*schema.Column{VulnerabilityIdsColumns[2]},
+				Columns: []*schema.Column{VulnerabilityIdsColumns[3]},
 			},
 		},
 	}
 	// VulnerabilityMetadataColumns holds the columns for the "vulnerability_metadata" table.
 	VulnerabilityMetadataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "score_type", Type: field.TypeEnum, Enums: []string{"CVSSv2", "CVSSv3", "EPSSv1", "EPSSv2", "CVSSv31", "CVSSv4", "OWASP", "SSVC"}},
 		{Name: "score_value", Type: field.TypeFloat64},
 		{Name: "timestamp", Type: field.TypeTime},
@@ -1060,16 +1224,21 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "vulnerability_metadata_vulnerability_ids_vulnerability_id",
-				Columns:    []*schema.Column{VulnerabilityMetadataColumns[7]},
+				Columns:    []*schema.Column{VulnerabilityMetadataColumns[8]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "vulnerabilitymetadata_vulnerability_id_id_score_type_score_value_timestamp_origin_collector_document_ref",
+				Name:    "vulnerabilitymetadata_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{VulnerabilityMetadataColumns[1]},
+			},
+			{
+				Name:    "vulnerabilitymetadata_vulnerability_id_id_score_type_score_value_timestamp_origin_collector_document_ref_tenant",
 				Unique:  true,
-				Columns: []*schema.Column{VulnerabilityMetadataColumns[7], VulnerabilityMetadataColumns[1], VulnerabilityMetadataColumns[2], VulnerabilityMetadataColumns[3], VulnerabilityMetadataColumns[4], VulnerabilityMetadataColumns[5], VulnerabilityMetadataColumns[6]},
+				Columns: []*schema.Column{VulnerabilityMetadataColumns[8], VulnerabilityMetadataColumns[2], VulnerabilityMetadataColumns[3], VulnerabilityMetadataColumns[4], VulnerabilityMetadataColumns[5], VulnerabilityMetadataColumns[6], VulnerabilityMetadataColumns[7], VulnerabilityMetadataColumns[1]},
 			},
 		},
 	}
@@ -1259,6 +1428,7 @@ var (
 		CertifyVexesTable,
 		CertifyVulnsTable,
 		DependenciesTable,
+		DocumentsTable,
 		HasMetadataTable,
 		HasSourceAtsTable,
 		HashEqualsTable,
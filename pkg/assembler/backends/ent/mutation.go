@@ -21,6 +21,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/document"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -57,6 +58,7 @@ const (
 	TypeCertifyVex            = "CertifyVex"
 	TypeCertifyVuln           = "CertifyVuln"
 	TypeDependency            = "Dependency"
+	TypeDocument              = "Document"
 	TypeHasMetadata           = "HasMetadata"
 	TypeHasSourceAt           = "HasSourceAt"
 	TypeHashEqual             = "HashEqual"
@@ -79,6 +81,7 @@ type ArtifactMutation struct {
 	op                          Op
 	typ                         string
 	id                          *uuid.UUID
+	tenant                      *string
 	algorithm                   *string
 	digest                      *string
 	clearedFields               map[string]struct{}
@@ -224,6 +227,42 @@ func (m *ArtifactMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *ArtifactMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *ArtifactMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Artifact entity.
+// If the Artifact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArtifactMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *ArtifactMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetAlgorithm sets the "algorithm" field.
 func (m *ArtifactMutation) SetAlgorithm(s string) {
 	m.algorithm = &s
@@ -924,7 +963,10 @@ func (m *ArtifactMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ArtifactMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, artifact.FieldTenant)
+	}
 	if m.algorithm != nil {
 		fields = append(fields, artifact.FieldAlgorithm)
 	}
@@ -939,6 +981,8 @@ func (m *ArtifactMutation) Fields() []string {
 // schema.
 func (m *ArtifactMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case artifact.FieldTenant:
+		return m.Tenant()
 	case artifact.FieldAlgorithm:
 		return m.Algorithm()
 	case artifact.FieldDigest:
@@ -952,6 +996,8 @@ func (m *ArtifactMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *ArtifactMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case artifact.FieldTenant:
+		return m.OldTenant(ctx)
 	case artifact.FieldAlgorithm:
 		return m.OldAlgorithm(ctx)
 	case artifact.FieldDigest:
@@ -965,6 +1011,13 @@ func (m *ArtifactMutation) OldField(ctx context.Context, name string) (ent.Value
 // type.
 func (m *ArtifactMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case artifact.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case artifact.FieldAlgorithm:
 		v, ok := value.(string)
 		if !ok {
@@ -1028,6 +1081,9 @@ func (m *ArtifactMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *ArtifactMutation) ResetField(name string) error {
 	switch name {
+	case artifact.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case artifact.FieldAlgorithm:
 		m.ResetAlgorithm()
 		return nil
@@ -1388,6 +1444,7 @@ type BillOfMaterialsMutation struct {
 	op                                 Op
 	typ                                string
 	id                                 *uuid.UUID
+	tenant                             *string
 	uri                                *string
 	algorithm                          *string
 	digest                             *string
@@ -1526,6 +1583,42 @@ func (m *BillOfMaterialsMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *BillOfMaterialsMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *BillOfMaterialsMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the BillOfMaterials entity.
+// If the BillOfMaterials object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BillOfMaterialsMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *BillOfMaterialsMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetPackageID sets the "package_id" field.
 func (m *BillOfMaterialsMutation) SetPackageID(u uuid.UUID) {
 	m._package = &u
@@ -2360,7 +2453,10 @@ func (m *BillOfMaterialsMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BillOfMaterialsMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+	fields := make([]string, 0, 15)
+	if m.tenant != nil {
+		fields = append(fields, billofmaterials.FieldTenant)
+	}
 	if m._package != nil {
 		fields = append(fields, billofmaterials.FieldPackageID)
 	}
@@ -2411,6 +2507,8 @@ func (m *BillOfMaterialsMutation) Fields() []string {
 // schema.
 func (m *BillOfMaterialsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case billofmaterials.FieldTenant:
+		return m.Tenant()
 	case billofmaterials.FieldPackageID:
 		return m.PackageID()
 	case billofmaterials.FieldArtifactID:
@@ -2448,6 +2546,8 @@ func (m *BillOfMaterialsMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *BillOfMaterialsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case billofmaterials.FieldTenant:
+		return m.OldTenant(ctx)
 	case billofmaterials.FieldPackageID:
 		return m.OldPackageID(ctx)
 	case billofmaterials.FieldArtifactID:
@@ -2485,6 +2585,13 @@ func (m *BillOfMaterialsMutation) OldField(ctx context.Context, name string) (en
 // type.
 func (m *BillOfMaterialsMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case billofmaterials.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case billofmaterials.FieldPackageID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -2647,6 +2754,9 @@ func (m *BillOfMaterialsMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *BillOfMaterialsMutation) ResetField(name string) error {
 	switch name {
+	case billofmaterials.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case billofmaterials.FieldPackageID:
 		m.ResetPackageID()
 		return nil
@@ -2897,6 +3007,7 @@ type BuilderMutation struct {
 	op                       Op
 	typ                      string
 	id                       *uuid.UUID
+	tenant                   *string
 	uri                      *string
 	clearedFields            map[string]struct{}
 	slsa_attestations        map[uuid.UUID]struct{}
@@ -3011,6 +3122,42 @@ func (m *BuilderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *BuilderMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *BuilderMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Builder entity.
+// If the Builder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuilderMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *BuilderMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetURI sets the "uri" field.
 func (m *BuilderMutation) SetURI(s string) {
 	m.uri = &s
@@ -3135,7 +3282,10 @@ func (m *BuilderMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BuilderMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+	fields := make([]string, 0, 2)
+	if m.tenant != nil {
+		fields = append(fields, builder.FieldTenant)
+	}
 	if m.uri != nil {
 		fields = append(fields, builder.FieldURI)
 	}
@@ -3147,6 +3297,8 @@ func (m *BuilderMutation) Fields() []string {
 // schema.
 func (m *BuilderMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case builder.FieldTenant:
+		return m.Tenant()
 	case builder.FieldURI:
 		return m.URI()
 	}
@@ -3158,6 +3310,8 @@ func (m *BuilderMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *BuilderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case builder.FieldTenant:
+		return m.OldTenant(ctx)
 	case builder.FieldURI:
 		return m.OldURI(ctx)
 	}
@@ -3169,6 +3323,13 @@ func (m *BuilderMutation) OldField(ctx context.Context, name string) (ent.Value,
 // type.
 func (m *BuilderMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case builder.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case builder.FieldURI:
 		v, ok := value.(string)
 		if !ok {
@@ -3225,6 +3386,9 @@ func (m *BuilderMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *BuilderMutation) ResetField(name string) error {
 	switch name {
+	case builder.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case builder.FieldURI:
 		m.ResetURI()
 		return nil
@@ -3322,6 +3486,7 @@ type CertificationMutation struct {
 	op                     Op
 	typ                    string
 	id                     *uuid.UUID
+	tenant                 *string
 	_type                  *certification.Type
 	justification          *string
 	known_since            *time.Time
@@ -3446,6 +3611,42 @@ func (m *CertificationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *CertificationMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *CertificationMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Certification entity.
+// If the Certification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertificationMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *CertificationMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetSourceID sets the "source_id" field.
 func (m *CertificationMutation) SetSourceID(u uuid.UUID) {
 	m.source = &u
@@ -4013,7 +4214,10 @@ func (m *CertificationMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CertificationMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 11)
+	if m.tenant != nil {
+		fields = append(fields, certification.FieldTenant)
+	}
 	if m.source != nil {
 		fields = append(fields, certification.FieldSourceID)
 	}
@@ -4052,6 +4256,8 @@ func (m *CertificationMutation) Fields() []string {
 // schema.
 func (m *CertificationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case certification.FieldTenant:
+		return m.Tenant()
 	case certification.FieldSourceID:
 		return m.SourceID()
 	case certification.FieldPackageVersionID:
@@ -4081,6 +4287,8 @@ func (m *CertificationMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *CertificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case certification.FieldTenant:
+		return m.OldTenant(ctx)
 	case certification.FieldSourceID:
 		return m.OldSourceID(ctx)
 	case certification.FieldPackageVersionID:
@@ -4110,6 +4318,13 @@ func (m *CertificationMutation) OldField(ctx context.Context, name string) (ent.
 // type.
 func (m *CertificationMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case certification.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case certification.FieldSourceID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -4256,6 +4471,9 @@ func (m *CertificationMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *CertificationMutation) ResetField(name string) error {
 	switch name {
+	case certification.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case certification.FieldSourceID:
 		m.ResetSourceID()
 		return nil
@@ -4424,6 +4642,7 @@ type CertifyLegalMutation struct {
 	op                         Op
 	typ                        string
 	id                         *uuid.UUID
+	tenant                     *string
 	declared_license           *string
 	discovered_license         *string
 	attribution                *string
@@ -4554,6 +4773,42 @@ func (m *CertifyLegalMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *CertifyLegalMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *CertifyLegalMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the CertifyLegal entity.
+// If the CertifyLegal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyLegalMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *CertifyLegalMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetPackageID sets the "package_id" field.
 func (m *CertifyLegalMutation) SetPackageID(u uuid.UUID) {
 	m._package = &u
@@ -5208,7 +5463,10 @@ func (m *CertifyLegalMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CertifyLegalMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+	fields := make([]string, 0, 13)
+	if m.tenant != nil {
+		fields = append(fields, certifylegal.FieldTenant)
+	}
 	if m._package != nil {
 		fields = append(fields, certifylegal.FieldPackageID)
 	}
@@ -5253,6 +5511,8 @@ func (m *CertifyLegalMutation) Fields() []string {
 // schema.
 func (m *CertifyLegalMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case certifylegal.FieldTenant:
+		return m.Tenant()
 	case certifylegal.FieldPackageID:
 		return m.PackageID()
 	case certifylegal.FieldSourceID:
@@ -5286,6 +5546,8 @@ func (m *CertifyLegalMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *CertifyLegalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case certifylegal.FieldTenant:
+		return m.OldTenant(ctx)
 	case certifylegal.FieldPackageID:
 		return m.OldPackageID(ctx)
 	case certifylegal.FieldSourceID:
@@ -5319,6 +5581,13 @@ func (m *CertifyLegalMutation) OldField(ctx context.Context, name string) (ent.V
 // type.
 func (m *CertifyLegalMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case certifylegal.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case certifylegal.FieldPackageID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -5467,6 +5736,9 @@ func (m *CertifyLegalMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *CertifyLegalMutation) ResetField(name string) error {
 	switch name {
+	case certifylegal.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case certifylegal.FieldPackageID:
 		m.ResetPackageID()
 		return nil
@@ -5659,6 +5931,7 @@ type CertifyScorecardMutation struct {
 	op                 Op
 	typ                string
 	id                 *uuid.UUID
+	tenant             *string
 	checks             *[]*model.ScorecardCheck
 	appendchecks       []*model.ScorecardCheck
 	aggregate_score    *float64
@@ -5782,6 +6055,42 @@ func (m *CertifyScorecardMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *CertifyScorecardMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *CertifyScorecardMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the CertifyScorecard entity.
+// If the CertifyScorecard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyScorecardMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *CertifyScorecardMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetSourceID sets the "source_id" field.
 func (m *CertifyScorecardMutation) SetSourceID(u uuid.UUID) {
 	m.source = &u
@@ -6238,7 +6547,10 @@ func (m *CertifyScorecardMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CertifyScorecardMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 11)
+	if m.tenant != nil {
+		fields = append(fields, certifyscorecard.FieldTenant)
+	}
 	if m.source != nil {
 		fields = append(fields, certifyscorecard.FieldSourceID)
 	}
@@ -6277,6 +6589,8 @@ func (m *CertifyScorecardMutation) Fields() []string {
 // schema.
 func (m *CertifyScorecardMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case certifyscorecard.FieldTenant:
+		return m.Tenant()
 	case certifyscorecard.FieldSourceID:
 		return m.SourceID()
 	case certifyscorecard.FieldChecks:
@@ -6306,6 +6620,8 @@ func (m *CertifyScorecardMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *CertifyScorecardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case certifyscorecard.FieldTenant:
+		return m.OldTenant(ctx)
 	case certifyscorecard.FieldSourceID:
 		return m.OldSourceID(ctx)
 	case certifyscorecard.FieldChecks:
@@ -6335,6 +6651,13 @@ func (m *CertifyScorecardMutation) OldField(ctx context.Context, name string) (e
 // type.
 func (m *CertifyScorecardMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case certifyscorecard.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case certifyscorecard.FieldSourceID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -6469,6 +6792,9 @@ func (m *CertifyScorecardMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *CertifyScorecardMutation) ResetField(name string) error {
 	switch name {
+	case certifyscorecard.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case certifyscorecard.FieldSourceID:
 		m.ResetSourceID()
 		return nil
@@ -6583,6 +6909,7 @@ type CertifyVexMutation struct {
 	op                   Op
 	typ                  string
 	id                   *uuid.UUID
+	tenant               *string
 	known_since          *time.Time
 	status               *string
 	statement            *string
@@ -6707,6 +7034,42 @@ func (m *CertifyVexMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *CertifyVexMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *CertifyVexMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the CertifyVex entity.
+// If the CertifyVex object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVexMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *CertifyVexMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetPackageID sets the "package_id" field.
 func (m *CertifyVexMutation) SetPackageID(u uuid.UUID) {
 	m._package = &u
@@ -7244,7 +7607,10 @@ func (m *CertifyVexMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CertifyVexMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
+	if m.tenant != nil {
+		fields = append(fields, certifyvex.FieldTenant)
+	}
 	if m._package != nil {
 		fields = append(fields, certifyvex.FieldPackageID)
 	}
@@ -7286,6 +7652,8 @@ func (m *CertifyVexMutation) Fields() []string {
 // schema.
 func (m *CertifyVexMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case certifyvex.FieldTenant:
+		return m.Tenant()
 	case certifyvex.FieldPackageID:
 		return m.PackageID()
 	case certifyvex.FieldArtifactID:
@@ -7317,6 +7685,8 @@ func (m *CertifyVexMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *CertifyVexMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case certifyvex.FieldTenant:
+		return m.OldTenant(ctx)
 	case certifyvex.FieldPackageID:
 		return m.OldPackageID(ctx)
 	case certifyvex.FieldArtifactID:
@@ -7348,6 +7718,13 @@ func (m *CertifyVexMutation) OldField(ctx context.Context, name string) (ent.Val
 // type.
 func (m *CertifyVexMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case certifyvex.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case certifyvex.FieldPackageID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -7489,6 +7866,9 @@ func (m *CertifyVexMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *CertifyVexMutation) ResetField(name string) error {
 	switch name {
+	case certifyvex.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case certifyvex.FieldPackageID:
 		m.ResetPackageID()
 		return nil
@@ -7642,6 +8022,7 @@ type CertifyVulnMutation struct {
 	op                   Op
 	typ                  string
 	id                   *uuid.UUID
+	tenant               *string
 	time_scanned         *time.Time
 	db_uri               *string
 	db_version           *string
@@ -7764,6 +8145,42 @@ func (m *CertifyVulnMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *CertifyVulnMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *CertifyVulnMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the CertifyVuln entity.
+// If the CertifyVuln object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CertifyVulnMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *CertifyVulnMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetVulnerabilityID sets the "vulnerability_id" field.
 func (m *CertifyVulnMutation) SetVulnerabilityID(u uuid.UUID) {
 	m.vulnerability = &u
@@ -8212,7 +8629,10 @@ func (m *CertifyVulnMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CertifyVulnMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 11)
+	if m.tenant != nil {
+		fields = append(fields, certifyvuln.FieldTenant)
+	}
 	if m.vulnerability != nil {
 		fields = append(fields, certifyvuln.FieldVulnerabilityID)
 	}
@@ -8251,6 +8671,8 @@ func (m *CertifyVulnMutation) Fields() []string {
 // schema.
 func (m *CertifyVulnMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case certifyvuln.FieldTenant:
+		return m.Tenant()
 	case certifyvuln.FieldVulnerabilityID:
 		return m.VulnerabilityID()
 	case certifyvuln.FieldPackageID:
@@ -8280,6 +8702,8 @@ func (m *CertifyVulnMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *CertifyVulnMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case certifyvuln.FieldTenant:
+		return m.OldTenant(ctx)
 	case certifyvuln.FieldVulnerabilityID:
 		return m.OldVulnerabilityID(ctx)
 	case certifyvuln.FieldPackageID:
@@ -8309,6 +8733,13 @@ func (m *CertifyVulnMutation) OldField(ctx context.Context, name string) (ent.Va
 // type.
 func (m *CertifyVulnMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case certifyvuln.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case certifyvuln.FieldVulnerabilityID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -8428,6 +8859,9 @@ func (m *CertifyVulnMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *CertifyVulnMutation) ResetField(name string) error {
 	switch name {
+	case certifyvuln.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case certifyvuln.FieldVulnerabilityID:
 		m.ResetVulnerabilityID()
 		return nil
@@ -8560,6 +8994,7 @@ type DependencyMutation struct {
 	op                               Op
 	typ                              string
 	id                               *uuid.UUID
+	tenant                           *string
 	dependency_type                  *dependency.DependencyType
 	justification                    *string
 	origin                           *string
@@ -8682,6 +9117,42 @@ func (m *DependencyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *DependencyMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *DependencyMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Dependency entity.
+// If the Dependency object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DependencyMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *DependencyMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetPackageID sets the "package_id" field.
 func (m *DependencyMutation) SetPackageID(u uuid.UUID) {
 	m._package = &u
@@ -9076,7 +9547,10 @@ func (m *DependencyMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *DependencyMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 8)
+	if m.tenant != nil {
+		fields = append(fields, dependency.FieldTenant)
+	}
 	if m._package != nil {
 		fields = append(fields, dependency.FieldPackageID)
 	}
@@ -9106,6 +9580,8 @@ func (m *DependencyMutation) Fields() []string {
 // schema.
 func (m *DependencyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case dependency.FieldTenant:
+		return m.Tenant()
 	case dependency.FieldPackageID:
 		return m.PackageID()
 	case dependency.FieldDependentPackageVersionID:
@@ -9129,6 +9605,8 @@ func (m *DependencyMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *DependencyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case dependency.FieldTenant:
+		return m.OldTenant(ctx)
 	case dependency.FieldPackageID:
 		return m.OldPackageID(ctx)
 	case dependency.FieldDependentPackageVersionID:
@@ -9152,6 +9630,13 @@ func (m *DependencyMutation) OldField(ctx context.Context, name string) (ent.Val
 // type.
 func (m *DependencyMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case dependency.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case dependency.FieldPackageID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -9242,157 +9727,924 @@ func (m *DependencyMutation) FieldCleared(name string) bool {
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DependencyMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Dependency nullable field %s", name)
+func (m *DependencyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Dependency nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DependencyMutation) ResetField(name string) error {
+	switch name {
+	case dependency.FieldTenant:
+		m.ResetTenant()
+		return nil
+	case dependency.FieldPackageID:
+		m.ResetPackageID()
+		return nil
+	case dependency.FieldDependentPackageVersionID:
+		m.ResetDependentPackageVersionID()
+		return nil
+	case dependency.FieldDependencyType:
+		m.ResetDependencyType()
+		return nil
+	case dependency.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case dependency.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case dependency.FieldCollector:
+		m.ResetCollector()
+		return nil
+	case dependency.FieldDocumentRef:
+		m.ResetDocumentRef()
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DependencyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m._package != nil {
+		edges = append(edges, dependency.EdgePackage)
+	}
+	if m.dependent_package_version != nil {
+		edges = append(edges, dependency.EdgeDependentPackageVersion)
+	}
+	if m.included_in_sboms != nil {
+		edges = append(edges, dependency.EdgeIncludedInSboms)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DependencyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case dependency.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	case dependency.EdgeDependentPackageVersion:
+		if id := m.dependent_package_version; id != nil {
+			return []ent.Value{*id}
+		}
+	case dependency.EdgeIncludedInSboms:
+		ids := make([]ent.Value, 0, len(m.included_in_sboms))
+		for id := range m.included_in_sboms {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DependencyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedincluded_in_sboms != nil {
+		edges = append(edges, dependency.EdgeIncludedInSboms)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DependencyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case dependency.EdgeIncludedInSboms:
+		ids := make([]ent.Value, 0, len(m.removedincluded_in_sboms))
+		for id := range m.removedincluded_in_sboms {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DependencyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleared_package {
+		edges = append(edges, dependency.EdgePackage)
+	}
+	if m.cleareddependent_package_version {
+		edges = append(edges, dependency.EdgeDependentPackageVersion)
+	}
+	if m.clearedincluded_in_sboms {
+		edges = append(edges, dependency.EdgeIncludedInSboms)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DependencyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case dependency.EdgePackage:
+		return m.cleared_package
+	case dependency.EdgeDependentPackageVersion:
+		return m.cleareddependent_package_version
+	case dependency.EdgeIncludedInSboms:
+		return m.clearedincluded_in_sboms
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DependencyMutation) ClearEdge(name string) error {
+	switch name {
+	case dependency.EdgePackage:
+		m.ClearPackage()
+		return nil
+	case dependency.EdgeDependentPackageVersion:
+		m.ClearDependentPackageVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DependencyMutation) ResetEdge(name string) error {
+	switch name {
+	case dependency.EdgePackage:
+		m.ResetPackage()
+		return nil
+	case dependency.EdgeDependentPackageVersion:
+		m.ResetDependentPackageVersion()
+		return nil
+	case dependency.EdgeIncludedInSboms:
+		m.ResetIncludedInSboms()
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency edge %s", name)
+}
+
+// DocumentMutation represents an operation that mutates the Document nodes in the graph.
+type DocumentMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	tenant         *string
+	blob_key       *string
+	sha256         *string
+	document_type  *string
+	format         *string
+	collector      *string
+	source         *string
+	parser_version *string
+	ingested_at    *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Document, error)
+	predicates     []predicate.Document
+}
+
+var _ ent.Mutation = (*DocumentMutation)(nil)
+
+// documentOption allows management of the mutation configuration using functional options.
+type documentOption func(*DocumentMutation)
+
+// newDocumentMutation creates new mutation for the Document entity.
+func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
+	m := &DocumentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDocument,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDocumentID sets the ID field of the mutation.
+func withDocumentID(id uuid.UUID) documentOption {
+	return func(m *DocumentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Document
+		)
+		m.oldValue = func(ctx context.Context) (*Document, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Document.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDocument sets the old Document of the mutation.
+func withDocument(node *Document) documentOption {
+	return func(m *DocumentMutation) {
+		m.oldValue = func(context.Context) (*Document, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DocumentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DocumentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Document entities.
+func (m *DocumentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DocumentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DocumentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenant sets the "tenant" field.
+func (m *DocumentMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *DocumentMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *DocumentMutation) ResetTenant() {
+	m.tenant = nil
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (m *DocumentMutation) SetBlobKey(s string) {
+	m.blob_key = &s
+}
+
+// BlobKey returns the value of the "blob_key" field in the mutation.
+func (m *DocumentMutation) BlobKey() (r string, exists bool) {
+	v := m.blob_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlobKey returns the old "blob_key" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldBlobKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlobKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlobKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlobKey: %w", err)
+	}
+	return oldValue.BlobKey, nil
+}
+
+// ResetBlobKey resets all changes to the "blob_key" field.
+func (m *DocumentMutation) ResetBlobKey() {
+	m.blob_key = nil
+}
+
+// SetSha256 sets the "sha256" field.
+func (m *DocumentMutation) SetSha256(s string) {
+	m.sha256 = &s
+}
+
+// Sha256 returns the value of the "sha256" field in the mutation.
+func (m *DocumentMutation) Sha256() (r string, exists bool) {
+	v := m.sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSha256 returns the old "sha256" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSha256: %w", err)
+	}
+	return oldValue.Sha256, nil
+}
+
+// ResetSha256 resets all changes to the "sha256" field.
+func (m *DocumentMutation) ResetSha256() {
+	m.sha256 = nil
+}
+
+// SetDocumentType sets the "document_type" field.
+func (m *DocumentMutation) SetDocumentType(s string) {
+	m.document_type = &s
+}
+
+// DocumentType returns the value of the "document_type" field in the mutation.
+func (m *DocumentMutation) DocumentType() (r string, exists bool) {
+	v := m.document_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentType returns the old "document_type" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldDocumentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentType: %w", err)
+	}
+	return oldValue.DocumentType, nil
+}
+
+// ResetDocumentType resets all changes to the "document_type" field.
+func (m *DocumentMutation) ResetDocumentType() {
+	m.document_type = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *DocumentMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *DocumentMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *DocumentMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *DocumentMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *DocumentMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *DocumentMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// SetSource sets the "source" field.
+func (m *DocumentMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *DocumentMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *DocumentMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetParserVersion sets the "parser_version" field.
+func (m *DocumentMutation) SetParserVersion(s string) {
+	m.parser_version = &s
+}
+
+// ParserVersion returns the value of the "parser_version" field in the mutation.
+func (m *DocumentMutation) ParserVersion() (r string, exists bool) {
+	v := m.parser_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParserVersion returns the old "parser_version" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldParserVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParserVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParserVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParserVersion: %w", err)
+	}
+	return oldValue.ParserVersion, nil
+}
+
+// ResetParserVersion resets all changes to the "parser_version" field.
+func (m *DocumentMutation) ResetParserVersion() {
+	m.parser_version = nil
+}
+
+// SetIngestedAt sets the "ingested_at" field.
+func (m *DocumentMutation) SetIngestedAt(t time.Time) {
+	m.ingested_at = &t
+}
+
+// IngestedAt returns the value of the "ingested_at" field in the mutation.
+func (m *DocumentMutation) IngestedAt() (r time.Time, exists bool) {
+	v := m.ingested_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIngestedAt returns the old "ingested_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldIngestedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIngestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIngestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIngestedAt: %w", err)
+	}
+	return oldValue.IngestedAt, nil
+}
+
+// ResetIngestedAt resets all changes to the "ingested_at" field.
+func (m *DocumentMutation) ResetIngestedAt() {
+	m.ingested_at = nil
+}
+
+// Where appends a list predicates to the DocumentMutation builder.
+func (m *DocumentMutation) Where(ps ...predicate.Document) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DocumentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Document, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DocumentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DocumentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Document).
+func (m *DocumentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DocumentMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.tenant != nil {
+		fields = append(fields, document.FieldTenant)
+	}
+	if m.blob_key != nil {
+		fields = append(fields, document.FieldBlobKey)
+	}
+	if m.sha256 != nil {
+		fields = append(fields, document.FieldSha256)
+	}
+	if m.document_type != nil {
+		fields = append(fields, document.FieldDocumentType)
+	}
+	if m.format != nil {
+		fields = append(fields, document.FieldFormat)
+	}
+	if m.collector != nil {
+		fields = append(fields, document.FieldCollector)
+	}
+	if m.source != nil {
+		fields = append(fields, document.FieldSource)
+	}
+	if m.parser_version != nil {
+		fields = append(fields, document.FieldParserVersion)
+	}
+	if m.ingested_at != nil {
+		fields = append(fields, document.FieldIngestedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case document.FieldTenant:
+		return m.Tenant()
+	case document.FieldBlobKey:
+		return m.BlobKey()
+	case document.FieldSha256:
+		return m.Sha256()
+	case document.FieldDocumentType:
+		return m.DocumentType()
+	case document.FieldFormat:
+		return m.Format()
+	case document.FieldCollector:
+		return m.Collector()
+	case document.FieldSource:
+		return m.Source()
+	case document.FieldParserVersion:
+		return m.ParserVersion()
+	case document.FieldIngestedAt:
+		return m.IngestedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case document.FieldTenant:
+		return m.OldTenant(ctx)
+	case document.FieldBlobKey:
+		return m.OldBlobKey(ctx)
+	case document.FieldSha256:
+		return m.OldSha256(ctx)
+	case document.FieldDocumentType:
+		return m.OldDocumentType(ctx)
+	case document.FieldFormat:
+		return m.OldFormat(ctx)
+	case document.FieldCollector:
+		return m.OldCollector(ctx)
+	case document.FieldSource:
+		return m.OldSource(ctx)
+	case document.FieldParserVersion:
+		return m.OldParserVersion(ctx)
+	case document.FieldIngestedAt:
+		return m.OldIngestedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Document field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case document.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
+	case document.FieldBlobKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlobKey(v)
+		return nil
+	case document.FieldSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSha256(v)
+		return nil
+	case document.FieldDocumentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentType(v)
+		return nil
+	case document.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case document.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	case document.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case document.FieldParserVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParserVersion(v)
+		return nil
+	case document.FieldIngestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIngestedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Document field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocumentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Document numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DocumentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DocumentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DocumentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Document nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DependencyMutation) ResetField(name string) error {
+func (m *DocumentMutation) ResetField(name string) error {
 	switch name {
-	case dependency.FieldPackageID:
-		m.ResetPackageID()
+	case document.FieldTenant:
+		m.ResetTenant()
 		return nil
-	case dependency.FieldDependentPackageVersionID:
-		m.ResetDependentPackageVersionID()
+	case document.FieldBlobKey:
+		m.ResetBlobKey()
 		return nil
-	case dependency.FieldDependencyType:
-		m.ResetDependencyType()
+	case document.FieldSha256:
+		m.ResetSha256()
 		return nil
-	case dependency.FieldJustification:
-		m.ResetJustification()
+	case document.FieldDocumentType:
+		m.ResetDocumentType()
 		return nil
-	case dependency.FieldOrigin:
-		m.ResetOrigin()
+	case document.FieldFormat:
+		m.ResetFormat()
 		return nil
-	case dependency.FieldCollector:
+	case document.FieldCollector:
 		m.ResetCollector()
 		return nil
-	case dependency.FieldDocumentRef:
-		m.ResetDocumentRef()
+	case document.FieldSource:
+		m.ResetSource()
+		return nil
+	case document.FieldParserVersion:
+		m.ResetParserVersion()
+		return nil
+	case document.FieldIngestedAt:
+		m.ResetIngestedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Dependency field %s", name)
+	return fmt.Errorf("unknown Document field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DependencyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m._package != nil {
-		edges = append(edges, dependency.EdgePackage)
-	}
-	if m.dependent_package_version != nil {
-		edges = append(edges, dependency.EdgeDependentPackageVersion)
-	}
-	if m.included_in_sboms != nil {
-		edges = append(edges, dependency.EdgeIncludedInSboms)
-	}
+func (m *DocumentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DependencyMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case dependency.EdgePackage:
-		if id := m._package; id != nil {
-			return []ent.Value{*id}
-		}
-	case dependency.EdgeDependentPackageVersion:
-		if id := m.dependent_package_version; id != nil {
-			return []ent.Value{*id}
-		}
-	case dependency.EdgeIncludedInSboms:
-		ids := make([]ent.Value, 0, len(m.included_in_sboms))
-		for id := range m.included_in_sboms {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DependencyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedincluded_in_sboms != nil {
-		edges = append(edges, dependency.EdgeIncludedInSboms)
-	}
+func (m *DocumentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DependencyMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case dependency.EdgeIncludedInSboms:
-		ids := make([]ent.Value, 0, len(m.removedincluded_in_sboms))
-		for id := range m.removedincluded_in_sboms {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DependencyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleared_package {
-		edges = append(edges, dependency.EdgePackage)
-	}
-	if m.cleareddependent_package_version {
-		edges = append(edges, dependency.EdgeDependentPackageVersion)
-	}
-	if m.clearedincluded_in_sboms {
-		edges = append(edges, dependency.EdgeIncludedInSboms)
-	}
+func (m *DocumentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DependencyMutation) EdgeCleared(name string) bool {
-	switch name {
-	case dependency.EdgePackage:
-		return m.cleared_package
-	case dependency.EdgeDependentPackageVersion:
-		return m.cleareddependent_package_version
-	case dependency.EdgeIncludedInSboms:
-		return m.clearedincluded_in_sboms
-	}
+func (m *DocumentMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DependencyMutation) ClearEdge(name string) error {
-	switch name {
-	case dependency.EdgePackage:
-		m.ClearPackage()
-		return nil
-	case dependency.EdgeDependentPackageVersion:
-		m.ClearDependentPackageVersion()
-		return nil
-	}
-	return fmt.Errorf("unknown Dependency unique edge %s", name)
+func (m *DocumentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Document unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DependencyMutation) ResetEdge(name string) error {
-	switch name {
-	case dependency.EdgePackage:
-		m.ResetPackage()
-		return nil
-	case dependency.EdgeDependentPackageVersion:
-		m.ResetDependentPackageVersion()
-		return nil
-	case dependency.EdgeIncludedInSboms:
-		m.ResetIncludedInSboms()
-		return nil
-	}
-	return fmt.Errorf("unknown Dependency edge %s", name)
+func (m *DocumentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Document edge %s", name)
 }
 
 // HasMetadataMutation represents an operation that mutates the HasMetadata nodes in the graph.
@@ -9401,6 +10653,7 @@ type HasMetadataMutation struct {
 	op                     Op
 	typ                    string
 	id                     *uuid.UUID
+	tenant                 *string
 	timestamp              *time.Time
 	key                    *string
 	value                  *string
@@ -9526,6 +10779,42 @@ func (m *HasMetadataMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *HasMetadataMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *HasMetadataMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the HasMetadata entity.
+// If the HasMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasMetadataMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *HasMetadataMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetSourceID sets the "source_id" field.
 func (m *HasMetadataMutation) SetSourceID(u uuid.UUID) {
 	m.source = &u
@@ -10129,7 +11418,10 @@ func (m *HasMetadataMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *HasMetadataMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
+	if m.tenant != nil {
+		fields = append(fields, hasmetadata.FieldTenant)
+	}
 	if m.source != nil {
 		fields = append(fields, hasmetadata.FieldSourceID)
 	}
@@ -10171,6 +11463,8 @@ func (m *HasMetadataMutation) Fields() []string {
 // schema.
 func (m *HasMetadataMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case hasmetadata.FieldTenant:
+		return m.Tenant()
 	case hasmetadata.FieldSourceID:
 		return m.SourceID()
 	case hasmetadata.FieldPackageVersionID:
@@ -10202,6 +11496,8 @@ func (m *HasMetadataMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *HasMetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case hasmetadata.FieldTenant:
+		return m.OldTenant(ctx)
 	case hasmetadata.FieldSourceID:
 		return m.OldSourceID(ctx)
 	case hasmetadata.FieldPackageVersionID:
@@ -10233,6 +11529,13 @@ func (m *HasMetadataMutation) OldField(ctx context.Context, name string) (ent.Va
 // type.
 func (m *HasMetadataMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case hasmetadata.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case hasmetadata.FieldSourceID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -10386,6 +11689,9 @@ func (m *HasMetadataMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *HasMetadataMutation) ResetField(name string) error {
 	switch name {
+	case hasmetadata.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case hasmetadata.FieldSourceID:
 		m.ResetSourceID()
 		return nil
@@ -10557,6 +11863,7 @@ type HasSourceAtMutation struct {
 	op                     Op
 	typ                    string
 	id                     *uuid.UUID
+	tenant                 *string
 	known_since            *time.Time
 	justification          *string
 	origin                 *string
@@ -10678,6 +11985,42 @@ func (m *HasSourceAtMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *HasSourceAtMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *HasSourceAtMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the HasSourceAt entity.
+// If the HasSourceAt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasSourceAtMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *HasSourceAtMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetPackageVersionID sets the "package_version_id" field.
 func (m *HasSourceAtMutation) SetPackageVersionID(u uuid.UUID) {
 	m.package_version = &u
@@ -11120,7 +12463,10 @@ func (m *HasSourceAtMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *HasSourceAtMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 9)
+	if m.tenant != nil {
+		fields = append(fields, hassourceat.FieldTenant)
+	}
 	if m.package_version != nil {
 		fields = append(fields, hassourceat.FieldPackageVersionID)
 	}
@@ -11153,6 +12499,8 @@ func (m *HasSourceAtMutation) Fields() []string {
 // schema.
 func (m *HasSourceAtMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case hassourceat.FieldTenant:
+		return m.Tenant()
 	case hassourceat.FieldPackageVersionID:
 		return m.PackageVersionID()
 	case hassourceat.FieldPackageNameID:
@@ -11178,6 +12526,8 @@ func (m *HasSourceAtMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *HasSourceAtMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case hassourceat.FieldTenant:
+		return m.OldTenant(ctx)
 	case hassourceat.FieldPackageVersionID:
 		return m.OldPackageVersionID(ctx)
 	case hassourceat.FieldPackageNameID:
@@ -11203,6 +12553,13 @@ func (m *HasSourceAtMutation) OldField(ctx context.Context, name string) (ent.Va
 // type.
 func (m *HasSourceAtMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case hassourceat.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case hassourceat.FieldPackageVersionID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -11323,6 +12680,9 @@ func (m *HasSourceAtMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *HasSourceAtMutation) ResetField(name string) error {
 	switch name {
+	case hassourceat.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case hassourceat.FieldPackageVersionID:
 		m.ResetPackageVersionID()
 		return nil
@@ -11467,6 +12827,7 @@ type HashEqualMutation struct {
 	op                Op
 	typ               string
 	id                *uuid.UUID
+	tenant            *string
 	origin            *string
 	collector         *string
 	justification     *string
@@ -11586,6 +12947,42 @@ func (m *HashEqualMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *HashEqualMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *HashEqualMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the HashEqual entity.
+// If the HashEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HashEqualMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *HashEqualMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetArtID sets the "art_id" field.
 func (m *HashEqualMutation) SetArtID(u uuid.UUID) {
 	m.artifact_a = &u
@@ -11952,7 +13349,10 @@ func (m *HashEqualMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *HashEqualMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 8)
+	if m.tenant != nil {
+		fields = append(fields, hashequal.FieldTenant)
+	}
 	if m.artifact_a != nil {
 		fields = append(fields, hashequal.FieldArtID)
 	}
@@ -11982,6 +13382,8 @@ func (m *HashEqualMutation) Fields() []string {
 // schema.
 func (m *HashEqualMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case hashequal.FieldTenant:
+		return m.Tenant()
 	case hashequal.FieldArtID:
 		return m.ArtID()
 	case hashequal.FieldEqualArtID:
@@ -12005,6 +13407,8 @@ func (m *HashEqualMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *HashEqualMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case hashequal.FieldTenant:
+		return m.OldTenant(ctx)
 	case hashequal.FieldArtID:
 		return m.OldArtID(ctx)
 	case hashequal.FieldEqualArtID:
@@ -12028,6 +13432,13 @@ func (m *HashEqualMutation) OldField(ctx context.Context, name string) (ent.Valu
 // type.
 func (m *HashEqualMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case hashequal.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case hashequal.FieldArtID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -12126,6 +13537,9 @@ func (m *HashEqualMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *HashEqualMutation) ResetField(name string) error {
 	switch name {
+	case hashequal.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case hashequal.FieldArtID:
 		m.ResetArtID()
 		return nil
@@ -12249,6 +13663,7 @@ type LicenseMutation struct {
 	op                                  Op
 	typ                                 string
 	id                                  *uuid.UUID
+	tenant                              *string
 	name                                *string
 	inline                              *string
 	list_version                        *string
@@ -12370,6 +13785,42 @@ func (m *LicenseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *LicenseMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *LicenseMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the License entity.
+// If the License object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LicenseMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *LicenseMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetName sets the "name" field.
 func (m *LicenseMutation) SetName(s string) {
 	m.name = &s
@@ -12744,7 +14195,10 @@ func (m *LicenseMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *LicenseMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 6)
+	if m.tenant != nil {
+		fields = append(fields, license.FieldTenant)
+	}
 	if m.name != nil {
 		fields = append(fields, license.FieldName)
 	}
@@ -12768,6 +14222,8 @@ func (m *LicenseMutation) Fields() []string {
 // schema.
 func (m *LicenseMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case license.FieldTenant:
+		return m.Tenant()
 	case license.FieldName:
 		return m.Name()
 	case license.FieldInline:
@@ -12787,6 +14243,8 @@ func (m *LicenseMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *LicenseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case license.FieldTenant:
+		return m.OldTenant(ctx)
 	case license.FieldName:
 		return m.OldName(ctx)
 	case license.FieldInline:
@@ -12806,6 +14264,13 @@ func (m *LicenseMutation) OldField(ctx context.Context, name string) (ent.Value,
 // type.
 func (m *LicenseMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case license.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case license.FieldName:
 		v, ok := value.(string)
 		if !ok {
@@ -12917,6 +14382,9 @@ func (m *LicenseMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *LicenseMutation) ResetField(name string) error {
 	switch name {
+	case license.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case license.FieldName:
 		m.ResetName()
 		return nil
@@ -13052,6 +14520,7 @@ type OccurrenceMutation struct {
 	op                       Op
 	typ                      string
 	id                       *uuid.UUID
+	tenant                   *string
 	justification            *string
 	origin                   *string
 	collector                *string
@@ -13175,6 +14644,42 @@ func (m *OccurrenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *OccurrenceMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *OccurrenceMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the Occurrence entity.
+// If the Occurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccurrenceMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *OccurrenceMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetArtifactID sets the "artifact_id" field.
 func (m *OccurrenceMutation) SetArtifactID(u uuid.UUID) {
 	m.artifact = &u
@@ -13622,7 +15127,10 @@ func (m *OccurrenceMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *OccurrenceMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 8)
+	if m.tenant != nil {
+		fields = append(fields, occurrence.FieldTenant)
+	}
 	if m.artifact != nil {
 		fields = append(fields, occurrence.FieldArtifactID)
 	}
@@ -13652,6 +15160,8 @@ func (m *OccurrenceMutation) Fields() []string {
 // schema.
 func (m *OccurrenceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case occurrence.FieldTenant:
+		return m.Tenant()
 	case occurrence.FieldArtifactID:
 		return m.ArtifactID()
 	case occurrence.FieldJustification:
@@ -13675,6 +15185,8 @@ func (m *OccurrenceMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *OccurrenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case occurrence.FieldTenant:
+		return m.OldTenant(ctx)
 	case occurrence.FieldArtifactID:
 		return m.OldArtifactID(ctx)
 	case occurrence.FieldJustification:
@@ -13698,6 +15210,13 @@ func (m *OccurrenceMutation) OldField(ctx context.Context, name string) (ent.Val
 // type.
 func (m *OccurrenceMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case occurrence.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case occurrence.FieldArtifactID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -13811,6 +15330,9 @@ func (m *OccurrenceMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *OccurrenceMutation) ResetField(name string) error {
 	switch name {
+	case occurrence.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case occurrence.FieldArtifactID:
 		m.ResetArtifactID()
 		return nil
@@ -13980,6 +15502,7 @@ type PackageNameMutation struct {
 	op                   Op
 	typ                  string
 	id                   *uuid.UUID
+	tenant               *string
 	_type                *string
 	namespace            *string
 	name                 *string
@@ -14108,6 +15631,42 @@ func (m *PackageNameMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *PackageNameMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *PackageNameMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the PackageName entity.
+// If the PackageName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageNameMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *PackageNameMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetType sets the "type" field.
 func (m *PackageNameMutation) SetType(s string) {
 	m._type = &s
@@ -14520,7 +16079,10 @@ func (m *PackageNameMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PackageNameMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 4)
+	if m.tenant != nil {
+		fields = append(fields, packagename.FieldTenant)
+	}
 	if m._type != nil {
 		fields = append(fields, packagename.FieldType)
 	}
@@ -14538,6 +16100,8 @@ func (m *PackageNameMutation) Fields() []string {
 // schema.
 func (m *PackageNameMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case packagename.FieldTenant:
+		return m.Tenant()
 	case packagename.FieldType:
 		return m.GetType()
 	case packagename.FieldNamespace:
@@ -14553,6 +16117,8 @@ func (m *PackageNameMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *PackageNameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case packagename.FieldTenant:
+		return m.OldTenant(ctx)
 	case packagename.FieldType:
 		return m.OldType(ctx)
 	case packagename.FieldNamespace:
@@ -14568,6 +16134,13 @@ func (m *PackageNameMutation) OldField(ctx context.Context, name string) (ent.Va
 // type.
 func (m *PackageNameMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case packagename.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case packagename.FieldType:
 		v, ok := value.(string)
 		if !ok {
@@ -14638,6 +16211,9 @@ func (m *PackageNameMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *PackageNameMutation) ResetField(name string) error {
 	switch name {
+	case packagename.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case packagename.FieldType:
 		m.ResetType()
 		return nil
@@ -14845,6 +16421,7 @@ type PackageVersionMutation struct {
 	op                        Op
 	typ                       string
 	id                        *uuid.UUID
+	tenant                    *string
 	version                   *string
 	subpath                   *string
 	qualifiers                *[]model.PackageQualifier
@@ -15004,6 +16581,42 @@ func (m *PackageVersionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *PackageVersionMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *PackageVersionMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the PackageVersion entity.
+// If the PackageVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PackageVersionMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *PackageVersionMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetNameID sets the "name_id" field.
 func (m *PackageVersionMutation) SetNameID(u uuid.UUID) {
 	m.name = &u
@@ -16030,7 +17643,10 @@ func (m *PackageVersionMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PackageVersionMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 6)
+	if m.tenant != nil {
+		fields = append(fields, packageversion.FieldTenant)
+	}
 	if m.name != nil {
 		fields = append(fields, packageversion.FieldNameID)
 	}
@@ -16054,6 +17670,8 @@ func (m *PackageVersionMutation) Fields() []string {
 // schema.
 func (m *PackageVersionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case packageversion.FieldTenant:
+		return m.Tenant()
 	case packageversion.FieldNameID:
 		return m.NameID()
 	case packageversion.FieldVersion:
@@ -16073,6 +17691,8 @@ func (m *PackageVersionMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *PackageVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case packageversion.FieldTenant:
+		return m.OldTenant(ctx)
 	case packageversion.FieldNameID:
 		return m.OldNameID(ctx)
 	case packageversion.FieldVersion:
@@ -16092,6 +17712,13 @@ func (m *PackageVersionMutation) OldField(ctx context.Context, name string) (ent
 // type.
 func (m *PackageVersionMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case packageversion.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case packageversion.FieldNameID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -16185,6 +17812,9 @@ func (m *PackageVersionMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *PackageVersionMutation) ResetField(name string) error {
 	switch name {
+	case packageversion.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case packageversion.FieldNameID:
 		m.ResetNameID()
 		return nil
@@ -16650,6 +18280,7 @@ type PkgEqualMutation struct {
 	op               Op
 	typ              string
 	id               *uuid.UUID
+	tenant           *string
 	origin           *string
 	collector        *string
 	document_ref     *string
@@ -16769,6 +18400,42 @@ func (m *PkgEqualMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *PkgEqualMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *PkgEqualMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the PkgEqual entity.
+// If the PkgEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PkgEqualMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *PkgEqualMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetPkgID sets the "pkg_id" field.
 func (m *PkgEqualMutation) SetPkgID(u uuid.UUID) {
 	m.package_a = &u
@@ -17135,7 +18802,10 @@ func (m *PkgEqualMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PkgEqualMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 8)
+	if m.tenant != nil {
+		fields = append(fields, pkgequal.FieldTenant)
+	}
 	if m.package_a != nil {
 		fields = append(fields, pkgequal.FieldPkgID)
 	}
@@ -17165,6 +18835,8 @@ func (m *PkgEqualMutation) Fields() []string {
 // schema.
 func (m *PkgEqualMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case pkgequal.FieldTenant:
+		return m.Tenant()
 	case pkgequal.FieldPkgID:
 		return m.PkgID()
 	case pkgequal.FieldEqualPkgID:
@@ -17188,6 +18860,8 @@ func (m *PkgEqualMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *PkgEqualMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case pkgequal.FieldTenant:
+		return m.OldTenant(ctx)
 	case pkgequal.FieldPkgID:
 		return m.OldPkgID(ctx)
 	case pkgequal.FieldEqualPkgID:
@@ -17211,6 +18885,13 @@ func (m *PkgEqualMutation) OldField(ctx context.Context, name string) (ent.Value
 // type.
 func (m *PkgEqualMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case pkgequal.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case pkgequal.FieldPkgID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -17309,6 +18990,9 @@ func (m *PkgEqualMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *PkgEqualMutation) ResetField(name string) error {
 	switch name {
+	case pkgequal.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case pkgequal.FieldPkgID:
 		m.ResetPkgID()
 		return nil
@@ -17432,6 +19116,7 @@ type PointOfContactMutation struct {
 	op                     Op
 	typ                    string
 	id                     *uuid.UUID
+	tenant                 *string
 	email                  *string
 	info                   *string
 	since                  *time.Time
@@ -17557,6 +19242,42 @@ func (m *PointOfContactMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *PointOfContactMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *PointOfContactMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the PointOfContact entity.
+// If the PointOfContact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfContactMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *PointOfContactMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetSourceID sets the "source_id" field.
 func (m *PointOfContactMutation) SetSourceID(u uuid.UUID) {
 	m.source = &u
@@ -18160,7 +19881,10 @@ func (m *PointOfContactMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PointOfContactMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
+	if m.tenant != nil {
+		fields = append(fields, pointofcontact.FieldTenant)
+	}
 	if m.source != nil {
 		fields = append(fields, pointofcontact.FieldSourceID)
 	}
@@ -18202,6 +19926,8 @@ func (m *PointOfContactMutation) Fields() []string {
 // schema.
 func (m *PointOfContactMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case pointofcontact.FieldTenant:
+		return m.Tenant()
 	case pointofcontact.FieldSourceID:
 		return m.SourceID()
 	case pointofcontact.FieldPackageVersionID:
@@ -18233,6 +19959,8 @@ func (m *PointOfContactMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *PointOfContactMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case pointofcontact.FieldTenant:
+		return m.OldTenant(ctx)
 	case pointofcontact.FieldSourceID:
 		return m.OldSourceID(ctx)
 	case pointofcontact.FieldPackageVersionID:
@@ -18264,6 +19992,13 @@ func (m *PointOfContactMutation) OldField(ctx context.Context, name string) (ent
 // type.
 func (m *PointOfContactMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case pointofcontact.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case pointofcontact.FieldSourceID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -18417,6 +20152,9 @@ func (m *PointOfContactMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *PointOfContactMutation) ResetField(name string) error {
 	switch name {
+	case pointofcontact.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case pointofcontact.FieldSourceID:
 		m.ResetSourceID()
 		return nil
@@ -18588,6 +20326,7 @@ type SLSAAttestationMutation struct {
 	op                   Op
 	typ                  string
 	id                   *uuid.UUID
+	tenant               *string
 	build_type           *string
 	slsa_predicate       *[]*model.SLSAPredicate
 	appendslsa_predicate []*model.SLSAPredicate
@@ -18715,6 +20454,42 @@ func (m *SLSAAttestationMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *SLSAAttestationMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *SLSAAttestationMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the SLSAAttestation entity.
+// If the SLSAAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SLSAAttestationMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *SLSAAttestationMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetBuildType sets the "build_type" field.
 func (m *SLSAAttestationMutation) SetBuildType(s string) {
 	m.build_type = &s
@@ -19282,7 +21057,10 @@ func (m *SLSAAttestationMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SLSAAttestationMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
+	if m.tenant != nil {
+		fields = append(fields, slsaattestation.FieldTenant)
+	}
 	if m.build_type != nil {
 		fields = append(fields, slsaattestation.FieldBuildType)
 	}
@@ -19324,6 +21102,8 @@ func (m *SLSAAttestationMutation) Fields() []string {
 // schema.
 func (m *SLSAAttestationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case slsaattestation.FieldTenant:
+		return m.Tenant()
 	case slsaattestation.FieldBuildType:
 		return m.BuildType()
 	case slsaattestation.FieldBuiltByID:
@@ -19355,6 +21135,8 @@ func (m *SLSAAttestationMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *SLSAAttestationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case slsaattestation.FieldTenant:
+		return m.OldTenant(ctx)
 	case slsaattestation.FieldBuildType:
 		return m.OldBuildType(ctx)
 	case slsaattestation.FieldBuiltByID:
@@ -19386,6 +21168,13 @@ func (m *SLSAAttestationMutation) OldField(ctx context.Context, name string) (en
 // type.
 func (m *SLSAAttestationMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case slsaattestation.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case slsaattestation.FieldBuildType:
 		v, ok := value.(string)
 		if !ok {
@@ -19521,6 +21310,9 @@ func (m *SLSAAttestationMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *SLSAAttestationMutation) ResetField(name string) error {
 	switch name {
+	case slsaattestation.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case slsaattestation.FieldBuildType:
 		m.ResetBuildType()
 		return nil
@@ -19684,6 +21476,7 @@ type SourceNameMutation struct {
 	op                   Op
 	typ                  string
 	id                   *uuid.UUID
+	tenant               *string
 	_type                *string
 	namespace            *string
 	name                 *string
@@ -19820,6 +21613,42 @@ func (m *SourceNameMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *SourceNameMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *SourceNameMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the SourceName entity.
+// If the SourceName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceNameMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *SourceNameMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetType sets the "type" field.
 func (m *SourceNameMutation) SetType(s string) {
 	m._type = &s
@@ -20438,7 +22267,10 @@ func (m *SourceNameMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SourceNameMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 6)
+	if m.tenant != nil {
+		fields = append(fields, sourcename.FieldTenant)
+	}
 	if m._type != nil {
 		fields = append(fields, sourcename.FieldType)
 	}
@@ -20462,6 +22294,8 @@ func (m *SourceNameMutation) Fields() []string {
 // schema.
 func (m *SourceNameMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case sourcename.FieldTenant:
+		return m.Tenant()
 	case sourcename.FieldType:
 		return m.GetType()
 	case sourcename.FieldNamespace:
@@ -20481,6 +22315,8 @@ func (m *SourceNameMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *SourceNameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case sourcename.FieldTenant:
+		return m.OldTenant(ctx)
 	case sourcename.FieldType:
 		return m.OldType(ctx)
 	case sourcename.FieldNamespace:
@@ -20500,6 +22336,13 @@ func (m *SourceNameMutation) OldField(ctx context.Context, name string) (ent.Val
 // type.
 func (m *SourceNameMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case sourcename.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case sourcename.FieldType:
 		v, ok := value.(string)
 		if !ok {
@@ -20599,6 +22442,9 @@ func (m *SourceNameMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *SourceNameMutation) ResetField(name string) error {
 	switch name {
+	case sourcename.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case sourcename.FieldType:
 		m.ResetType()
 		return nil
@@ -20864,6 +22710,7 @@ type VulnEqualMutation struct {
 	op                     Op
 	typ                    string
 	id                     *uuid.UUID
+	tenant                 *string
 	justification          *string
 	origin                 *string
 	collector              *string
@@ -20983,6 +22830,42 @@ func (m *VulnEqualMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *VulnEqualMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *VulnEqualMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the VulnEqual entity.
+// If the VulnEqual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnEqualMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *VulnEqualMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetVulnID sets the "vuln_id" field.
 func (m *VulnEqualMutation) SetVulnID(u uuid.UUID) {
 	m.vulnerability_a = &u
@@ -21349,7 +23232,10 @@ func (m *VulnEqualMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VulnEqualMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 8)
+	if m.tenant != nil {
+		fields = append(fields, vulnequal.FieldTenant)
+	}
 	if m.vulnerability_a != nil {
 		fields = append(fields, vulnequal.FieldVulnID)
 	}
@@ -21379,6 +23265,8 @@ func (m *VulnEqualMutation) Fields() []string {
 // schema.
 func (m *VulnEqualMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case vulnequal.FieldTenant:
+		return m.Tenant()
 	case vulnequal.FieldVulnID:
 		return m.VulnID()
 	case vulnequal.FieldEqualVulnID:
@@ -21402,6 +23290,8 @@ func (m *VulnEqualMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *VulnEqualMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case vulnequal.FieldTenant:
+		return m.OldTenant(ctx)
 	case vulnequal.FieldVulnID:
 		return m.OldVulnID(ctx)
 	case vulnequal.FieldEqualVulnID:
@@ -21425,6 +23315,13 @@ func (m *VulnEqualMutation) OldField(ctx context.Context, name string) (ent.Valu
 // type.
 func (m *VulnEqualMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case vulnequal.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case vulnequal.FieldVulnID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -21523,6 +23420,9 @@ func (m *VulnEqualMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *VulnEqualMutation) ResetField(name string) error {
 	switch name {
+	case vulnequal.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case vulnequal.FieldVulnID:
 		m.ResetVulnID()
 		return nil
@@ -21646,6 +23546,7 @@ type VulnerabilityIDMutation struct {
 	op                       Op
 	typ                      string
 	id                       *uuid.UUID
+	tenant                   *string
 	vulnerability_id         *string
 	_type                    *string
 	clearedFields            map[string]struct{}
@@ -21773,6 +23674,42 @@ func (m *VulnerabilityIDMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *VulnerabilityIDMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *VulnerabilityIDMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the VulnerabilityID entity.
+// If the VulnerabilityID object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnerabilityIDMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *VulnerabilityIDMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetVulnerabilityID sets the "vulnerability_id" field.
 func (m *VulnerabilityIDMutation) SetVulnerabilityID(s string) {
 	m.vulnerability_id = &s
@@ -22149,7 +24086,10 @@ func (m *VulnerabilityIDMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VulnerabilityIDMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 3)
+	if m.tenant != nil {
+		fields = append(fields, vulnerabilityid.FieldTenant)
+	}
 	if m.vulnerability_id != nil {
 		fields = append(fields, vulnerabilityid.FieldVulnerabilityID)
 	}
@@ -22164,6 +24104,8 @@ func (m *VulnerabilityIDMutation) Fields() []string {
 // schema.
 func (m *VulnerabilityIDMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case vulnerabilityid.FieldTenant:
+		return m.Tenant()
 	case vulnerabilityid.FieldVulnerabilityID:
 		return m.VulnerabilityID()
 	case vulnerabilityid.FieldType:
@@ -22177,6 +24119,8 @@ func (m *VulnerabilityIDMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *VulnerabilityIDMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case vulnerabilityid.FieldTenant:
+		return m.OldTenant(ctx)
 	case vulnerabilityid.FieldVulnerabilityID:
 		return m.OldVulnerabilityID(ctx)
 	case vulnerabilityid.FieldType:
@@ -22190,6 +24134,13 @@ func (m *VulnerabilityIDMutation) OldField(ctx context.Context, name string) (en
 // type.
 func (m *VulnerabilityIDMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case vulnerabilityid.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case vulnerabilityid.FieldVulnerabilityID:
 		v, ok := value.(string)
 		if !ok {
@@ -22253,6 +24204,9 @@ func (m *VulnerabilityIDMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *VulnerabilityIDMutation) ResetField(name string) error {
 	switch name {
+	case vulnerabilityid.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case vulnerabilityid.FieldVulnerabilityID:
 		m.ResetVulnerabilityID()
 		return nil
@@ -22457,6 +24411,7 @@ type VulnerabilityMetadataMutation struct {
 	op                      Op
 	typ                     string
 	id                      *uuid.UUID
+	tenant                  *string
 	score_type              *vulnerabilitymetadata.ScoreType
 	score_value             *float64
 	addscore_value          *float64
@@ -22576,6 +24531,42 @@ func (m *VulnerabilityMetadataMutation) IDs(ctx context.Context) ([]uuid.UUID, e
 	}
 }
 
+// SetTenant sets the "tenant" field.
+func (m *VulnerabilityMetadataMutation) SetTenant(s string) {
+	m.tenant = &s
+}
+
+// Tenant returns the value of the "tenant" field in the mutation.
+func (m *VulnerabilityMetadataMutation) Tenant() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenant returns the old "tenant" field's value of the VulnerabilityMetadata entity.
+// If the VulnerabilityMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnerabilityMetadataMutation) OldTenant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenant: %w", err)
+	}
+	return oldValue.Tenant, nil
+}
+
+// ResetTenant resets all changes to the "tenant" field.
+func (m *VulnerabilityMetadataMutation) ResetTenant() {
+	m.tenant = nil
+}
+
 // SetVulnerabilityIDID sets the "vulnerability_id_id" field.
 func (m *VulnerabilityMetadataMutation) SetVulnerabilityIDID(u uuid.UUID) {
 	m.vulnerability_id = &u
@@ -22909,7 +24900,10 @@ func (m *VulnerabilityMetadataMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VulnerabilityMetadataMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 8)
+	if m.tenant != nil {
+		fields = append(fields, vulnerabilitymetadata.FieldTenant)
+	}
 	if m.vulnerability_id != nil {
 		fields = append(fields, vulnerabilitymetadata.FieldVulnerabilityIDID)
 	}
@@ -22939,6 +24933,8 @@ func (m *VulnerabilityMetadataMutation) Fields() []string {
 // schema.
 func (m *VulnerabilityMetadataMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case vulnerabilitymetadata.FieldTenant:
+		return m.Tenant()
 	case vulnerabilitymetadata.FieldVulnerabilityIDID:
 		return m.VulnerabilityIDID()
 	case vulnerabilitymetadata.FieldScoreType:
@@ -22962,6 +24958,8 @@ func (m *VulnerabilityMetadataMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *VulnerabilityMetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case vulnerabilitymetadata.FieldTenant:
+		return m.OldTenant(ctx)
 	case vulnerabilitymetadata.FieldVulnerabilityIDID:
 		return m.OldVulnerabilityIDID(ctx)
 	case vulnerabilitymetadata.FieldScoreType:
@@ -22985,6 +24983,13 @@ func (m *VulnerabilityMetadataMutation) OldField(ctx context.Context, name strin
 // type.
 func (m *VulnerabilityMetadataMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case vulnerabilitymetadata.FieldTenant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenant(v)
+		return nil
 	case vulnerabilitymetadata.FieldVulnerabilityIDID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -23098,6 +25103,9 @@ func (m *VulnerabilityMetadataMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *VulnerabilityMetadataMutation) ResetField(name string) error {
 	switch name {
+	case vulnerabilitymetadata.FieldTenant:
+		m.ResetTenant()
+		return nil
 	case vulnerabilitymetadata.FieldVulnerabilityIDID:
 		m.ResetVulnerabilityIDID()
 		return nil
@@ -54,6 +54,11 @@ func IDLTE(id uuid.UUID) predicate.Occurrence {
 	return predicate.Occurrence(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldEQ(FieldTenant, v))
+}
+
 // ArtifactID applies equality check predicate on the "artifact_id" field. It's identical to ArtifactIDEQ.
 func ArtifactID(v uuid.UUID) predicate.Occurrence {
 	return predicate.Occurrence(sql.FieldEQ(FieldArtifactID, v))
@@ -89,6 +94,71 @@ func PackageID(v uuid.UUID) predicate.Occurrence {
 	return predicate.Occurrence(sql.FieldEQ(FieldPackageID, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.Occurrence {
+	return predicate.Occurrence(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // ArtifactIDEQ applies the EQ predicate on the "artifact_id" field.
 func ArtifactIDEQ(v uuid.UUID) predicate.Occurrence {
 	return predicate.Occurrence(sql.FieldEQ(FieldArtifactID, v))
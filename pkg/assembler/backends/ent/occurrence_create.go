@@ -27,6 +27,20 @@ type OccurrenceCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (oc *OccurrenceCreate) SetTenant(s string) *OccurrenceCreate {
+	oc.mutation.SetTenant(s)
+	return oc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (oc *OccurrenceCreate) SetNillableTenant(s *string) *OccurrenceCreate {
+	if s != nil {
+		oc.SetTenant(*s)
+	}
+	return oc
+}
+
 // SetArtifactID sets the "artifact_id" field.
 func (oc *OccurrenceCreate) SetArtifactID(u uuid.UUID) *OccurrenceCreate {
 	oc.mutation.SetArtifactID(u)
@@ -164,6 +178,10 @@ func (oc *OccurrenceCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (oc *OccurrenceCreate) defaults() {
+	if _, ok := oc.mutation.Tenant(); !ok {
+		v := occurrence.DefaultTenant
+		oc.mutation.SetTenant(v)
+	}
 	if _, ok := oc.mutation.ID(); !ok {
 		v := occurrence.DefaultID()
 		oc.mutation.SetID(v)
@@ -172,6 +190,9 @@ func (oc *OccurrenceCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (oc *OccurrenceCreate) check() error {
+	if _, ok := oc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Occurrence.tenant"`)}
+	}
 	if _, ok := oc.mutation.ArtifactID(); !ok {
 		return &ValidationError{Name: "artifact_id", err: errors.New(`ent: missing required field "Occurrence.artifact_id"`)}
 	}
@@ -226,6 +247,10 @@ func (oc *OccurrenceCreate) createSpec() (*Occurrence, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := oc.mutation.Tenant(); ok {
+		_spec.SetField(occurrence.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := oc.mutation.Justification(); ok {
 		_spec.SetField(occurrence.FieldJustification, field.TypeString, value)
 		_node.Justification = value
@@ -316,7 +341,7 @@ func (oc *OccurrenceCreate) createSpec() (*Occurrence, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Occurrence.Create().
-//		SetArtifactID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -325,7 +350,7 @@ func (oc *OccurrenceCreate) createSpec() (*Occurrence, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.OccurrenceUpsert) {
-//			SetArtifactID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (oc *OccurrenceCreate) OnConflict(opts ...sql.ConflictOption) *OccurrenceUpsertOne {
@@ -474,6 +499,9 @@ func (u *OccurrenceUpsertOne) UpdateNewValues() *OccurrenceUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(occurrence.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(occurrence.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -753,7 +781,7 @@ func (ocb *OccurrenceCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.OccurrenceUpsert) {
-//			SetArtifactID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (ocb *OccurrenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *OccurrenceUpsertBulk {
@@ -800,6 +828,9 @@ func (u *OccurrenceUpsertBulk) UpdateNewValues() *OccurrenceUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(occurrence.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(occurrence.FieldTenant)
+			}
 		}
 	}))
 	return u
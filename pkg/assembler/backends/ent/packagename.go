@@ -17,6 +17,8 @@ type PackageName struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// This node matches a pkg:<type> partial pURL
 	Type string `json:"type,omitempty"`
 	// In the pURL representation, each PackageNamespace matches the pkg:<type>/<namespace>/ partial pURL
@@ -104,7 +106,7 @@ func (*PackageName) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case packagename.FieldType, packagename.FieldNamespace, packagename.FieldName:
+		case packagename.FieldTenant, packagename.FieldType, packagename.FieldNamespace, packagename.FieldName:
 			values[i] = new(sql.NullString)
 		case packagename.FieldID:
 			values[i] = new(uuid.UUID)
@@ -129,6 +131,12 @@ func (pn *PackageName) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				pn.ID = *value
 			}
+		case packagename.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				pn.Tenant = value.String
+			}
 		case packagename.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -208,6 +216,9 @@ func (pn *PackageName) String() string {
 	var builder strings.Builder
 	builder.WriteString("PackageName(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pn.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(pn.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(pn.Type)
 	builder.WriteString(", ")
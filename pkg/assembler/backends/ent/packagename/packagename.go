@@ -13,6 +13,8 @@ const (
 	Label = "package_name"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldNamespace holds the string denoting the namespace field in the database.
@@ -71,6 +73,7 @@ const (
 // Columns holds all SQL columns for packagename fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldType,
 	FieldNamespace,
 	FieldName,
@@ -87,6 +90,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	TypeValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -103,6 +108,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
@@ -28,6 +28,20 @@ type PackageNameCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (pnc *PackageNameCreate) SetTenant(s string) *PackageNameCreate {
+	pnc.mutation.SetTenant(s)
+	return pnc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (pnc *PackageNameCreate) SetNillableTenant(s *string) *PackageNameCreate {
+	if s != nil {
+		pnc.SetTenant(*s)
+	}
+	return pnc
+}
+
 // SetType sets the "type" field.
 func (pnc *PackageNameCreate) SetType(s string) *PackageNameCreate {
 	pnc.mutation.SetType(s)
@@ -170,6 +184,10 @@ func (pnc *PackageNameCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pnc *PackageNameCreate) defaults() {
+	if _, ok := pnc.mutation.Tenant(); !ok {
+		v := packagename.DefaultTenant
+		pnc.mutation.SetTenant(v)
+	}
 	if _, ok := pnc.mutation.ID(); !ok {
 		v := packagename.DefaultID()
 		pnc.mutation.SetID(v)
@@ -178,6 +196,9 @@ func (pnc *PackageNameCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pnc *PackageNameCreate) check() error {
+	if _, ok := pnc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "PackageName.tenant"`)}
+	}
 	if _, ok := pnc.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "PackageName.type"`)}
 	}
@@ -233,6 +254,10 @@ func (pnc *PackageNameCreate) createSpec() (*PackageName, *sqlgraph.CreateSpec)
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := pnc.mutation.Tenant(); ok {
+		_spec.SetField(packagename.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := pnc.mutation.GetType(); ok {
 		_spec.SetField(packagename.FieldType, field.TypeString, value)
 		_node.Type = value
@@ -332,7 +357,7 @@ func (pnc *PackageNameCreate) createSpec() (*PackageName, *sqlgraph.CreateSpec)
 // of the `INSERT` statement. For example:
 //
 //	client.PackageName.Create().
-//		SetType(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -341,7 +366,7 @@ func (pnc *PackageNameCreate) createSpec() (*PackageName, *sqlgraph.CreateSpec)
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.PackageNameUpsert) {
-//			SetType(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (pnc *PackageNameCreate) OnConflict(opts ...sql.ConflictOption) *PackageNameUpsertOne {
@@ -430,6 +455,9 @@ func (u *PackageNameUpsertOne) UpdateNewValues() *PackageNameUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(packagename.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(packagename.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -639,7 +667,7 @@ func (pncb *PackageNameCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.PackageNameUpsert) {
-//			SetType(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (pncb *PackageNameCreateBulk) OnConflict(opts ...sql.ConflictOption) *PackageNameUpsertBulk {
@@ -686,6 +714,9 @@ func (u *PackageNameUpsertBulk) UpdateNewValues() *PackageNameUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(packagename.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(packagename.FieldTenant)
+			}
 		}
 	}))
 	return u
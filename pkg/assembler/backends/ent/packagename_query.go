@@ -451,12 +451,12 @@ func (pnq *PackageNameQuery) WithPoc(opts ...func(*PointOfContactQuery)) *Packag
 // Example:
 //
 //	var v []struct {
-//		Type string `json:"type,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.PackageName.Query().
-//		GroupBy(packagename.FieldType).
+//		GroupBy(packagename.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pnq *PackageNameQuery) GroupBy(field string, fields ...string) *PackageNameGroupBy {
@@ -474,11 +474,11 @@ func (pnq *PackageNameQuery) GroupBy(field string, fields ...string) *PackageNam
 // Example:
 //
 //	var v []struct {
-//		Type string `json:"type,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.PackageName.Query().
-//		Select(packagename.FieldType).
+//		Select(packagename.FieldTenant).
 //		Scan(ctx, &v)
 func (pnq *PackageNameQuery) Select(fields ...string) *PackageNameSelect {
 	pnq.ctx.Fields = append(pnq.ctx.Fields, fields...)
@@ -20,6 +20,8 @@ type PackageVersion struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// NameID holds the value of the "name_id" field.
 	NameID uuid.UUID `json:"name_id,omitempty"`
 	// Version holds the value of the "version" field.
@@ -234,7 +236,7 @@ func (*PackageVersion) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case packageversion.FieldQualifiers:
 			values[i] = new([]byte)
-		case packageversion.FieldVersion, packageversion.FieldSubpath, packageversion.FieldHash:
+		case packageversion.FieldTenant, packageversion.FieldVersion, packageversion.FieldSubpath, packageversion.FieldHash:
 			values[i] = new(sql.NullString)
 		case packageversion.FieldID, packageversion.FieldNameID:
 			values[i] = new(uuid.UUID)
@@ -259,6 +261,12 @@ func (pv *PackageVersion) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				pv.ID = *value
 			}
+		case packageversion.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				pv.Tenant = value.String
+			}
 		case packageversion.FieldNameID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field name_id", values[i])
@@ -402,6 +410,9 @@ func (pv *PackageVersion) String() string {
 	var builder strings.Builder
 	builder.WriteString("PackageVersion(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pv.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(pv.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("name_id=")
 	builder.WriteString(fmt.Sprintf("%v", pv.NameID))
 	builder.WriteString(", ")
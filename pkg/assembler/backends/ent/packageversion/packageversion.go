@@ -13,6 +13,8 @@ const (
 	Label = "package_version"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldNameID holds the string denoting the name_id field in the database.
 	FieldNameID = "name_id"
 	// FieldVersion holds the string denoting the version field in the database.
@@ -163,6 +165,7 @@ const (
 // Columns holds all SQL columns for packageversion fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldNameID,
 	FieldVersion,
 	FieldSubpath,
@@ -187,6 +190,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultVersion holds the default value on creation for the "version" field.
 	DefaultVersion string
 	// DefaultSubpath holds the default value on creation for the "subpath" field.
@@ -203,6 +208,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByNameID orders the results by the name_id field.
 func ByNameID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNameID, opts...).ToFunc()
@@ -1,6 +1,7 @@
 package packageversion
 
 import (
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
@@ -31,7 +32,7 @@ func QualifiersWithKeys(key string, keys ...string) func(*sql.Selector) {
 	}
 
 	return func(s *sql.Selector) {
-		s.Where(sqljson.ValueContains(FieldQualifiers, queryStruct))
+		s.Where(qualifiersContain(s.Dialect(), queryStruct))
 	}
 }
 
@@ -40,10 +41,35 @@ func QualifiersContains(key, value string) func(*sql.Selector) {
 	queryStruct := []qualifier{{Key: key, Value: value}}
 
 	return func(s *sql.Selector) {
-		s.Where(sqljson.ValueContains(FieldQualifiers, queryStruct))
+		s.Where(qualifiersContain(s.Dialect(), queryStruct))
 	}
 }
 
+// qualifiersContain checks that the qualifiers array contains all of the given
+// qualifiers, ignoring their empty fields. Postgres and MySQL do it with JSON
+// containment, while sqlite has to look for each of them in the array.
+func qualifiersContain(d string, qualifiers []qualifier) *sql.Predicate {
+	if d != dialect.SQLite {
+		return sqljson.ValueContains(FieldQualifiers, qualifiers)
+	}
+	return sql.P(func(b *sql.Builder) {
+		for i, q := range qualifiers {
+			if i > 0 {
+				b.WriteString(" AND ")
+			}
+			b.WriteString("EXISTS").Wrap(func(b *sql.Builder) {
+				b.WriteString("SELECT * FROM JSON_EACH").Wrap(func(b *sql.Builder) {
+					b.Ident(FieldQualifiers)
+				})
+				b.WriteString(" WHERE JSON_EXTRACT(").Ident("value").WriteString(", '$.key')").WriteOp(sql.OpEQ).Arg(q.Key)
+				if q.Value != "" {
+					b.WriteString(" AND JSON_EXTRACT(").Ident("value").WriteString(", '$.value')").WriteOp(sql.OpEQ).Arg(q.Value)
+				}
+			})
+		}
+	})
+}
+
 // QualifiersSizeMatch checks the size of the qualifiers array is the expected one
 func QualifiersSizeMatch(size int) func(*sql.Selector) {
 	return func(s *sql.Selector) {
@@ -36,6 +36,20 @@ type PackageVersionCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (pvc *PackageVersionCreate) SetTenant(s string) *PackageVersionCreate {
+	pvc.mutation.SetTenant(s)
+	return pvc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (pvc *PackageVersionCreate) SetNillableTenant(s *string) *PackageVersionCreate {
+	if s != nil {
+		pvc.SetTenant(*s)
+	}
+	return pvc
+}
+
 // SetNameID sets the "name_id" field.
 func (pvc *PackageVersionCreate) SetNameID(u uuid.UUID) *PackageVersionCreate {
 	pvc.mutation.SetNameID(u)
@@ -346,6 +360,10 @@ func (pvc *PackageVersionCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pvc *PackageVersionCreate) defaults() {
+	if _, ok := pvc.mutation.Tenant(); !ok {
+		v := packageversion.DefaultTenant
+		pvc.mutation.SetTenant(v)
+	}
 	if _, ok := pvc.mutation.Version(); !ok {
 		v := packageversion.DefaultVersion
 		pvc.mutation.SetVersion(v)
@@ -362,6 +380,9 @@ func (pvc *PackageVersionCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pvc *PackageVersionCreate) check() error {
+	if _, ok := pvc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "PackageVersion.tenant"`)}
+	}
 	if _, ok := pvc.mutation.NameID(); !ok {
 		return &ValidationError{Name: "name_id", err: errors.New(`ent: missing required field "PackageVersion.name_id"`)}
 	}
@@ -413,6 +434,10 @@ func (pvc *PackageVersionCreate) createSpec() (*PackageVersion, *sqlgraph.Create
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := pvc.mutation.Tenant(); ok {
+		_spec.SetField(packageversion.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := pvc.mutation.Version(); ok {
 		_spec.SetField(packageversion.FieldVersion, field.TypeString, value)
 		_node.Version = value
@@ -677,7 +702,7 @@ func (pvc *PackageVersionCreate) createSpec() (*PackageVersion, *sqlgraph.Create
 // of the `INSERT` statement. For example:
 //
 //	client.PackageVersion.Create().
-//		SetNameID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -686,7 +711,7 @@ func (pvc *PackageVersionCreate) createSpec() (*PackageVersion, *sqlgraph.Create
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.PackageVersionUpsert) {
-//			SetNameID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (pvc *PackageVersionCreate) OnConflict(opts ...sql.ConflictOption) *PackageVersionUpsertOne {
@@ -805,6 +830,9 @@ func (u *PackageVersionUpsertOne) UpdateNewValues() *PackageVersionUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(packageversion.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(packageversion.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -1049,7 +1077,7 @@ func (pvcb *PackageVersionCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.PackageVersionUpsert) {
-//			SetNameID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (pvcb *PackageVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PackageVersionUpsertBulk {
@@ -1096,6 +1124,9 @@ func (u *PackageVersionUpsertBulk) UpdateNewValues() *PackageVersionUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(packageversion.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(packageversion.FieldTenant)
+			}
 		}
 	}))
 	return u
@@ -817,12 +817,12 @@ func (pvq *PackageVersionQuery) WithCertifyLegal(opts ...func(*CertifyLegalQuery
 // Example:
 //
 //	var v []struct {
-//		NameID uuid.UUID `json:"name_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.PackageVersion.Query().
-//		GroupBy(packageversion.FieldNameID).
+//		GroupBy(packageversion.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pvq *PackageVersionQuery) GroupBy(field string, fields ...string) *PackageVersionGroupBy {
@@ -840,11 +840,11 @@ func (pvq *PackageVersionQuery) GroupBy(field string, fields ...string) *Package
 // Example:
 //
 //	var v []struct {
-//		NameID uuid.UUID `json:"name_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.PackageVersion.Query().
-//		Select(packageversion.FieldNameID).
+//		Select(packageversion.FieldTenant).
 //		Scan(ctx, &v)
 func (pvq *PackageVersionQuery) Select(fields ...string) *PackageVersionSelect {
 	pvq.ctx.Fields = append(pvq.ctx.Fields, fields...)
@@ -18,6 +18,8 @@ type PkgEqual struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// PkgID holds the value of the "pkg_id" field.
 	PkgID uuid.UUID `json:"pkg_id,omitempty"`
 	// EqualPkgID holds the value of the "equal_pkg_id" field.
@@ -78,7 +80,7 @@ func (*PkgEqual) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case pkgequal.FieldOrigin, pkgequal.FieldCollector, pkgequal.FieldDocumentRef, pkgequal.FieldJustification, pkgequal.FieldPackagesHash:
+		case pkgequal.FieldTenant, pkgequal.FieldOrigin, pkgequal.FieldCollector, pkgequal.FieldDocumentRef, pkgequal.FieldJustification, pkgequal.FieldPackagesHash:
 			values[i] = new(sql.NullString)
 		case pkgequal.FieldID, pkgequal.FieldPkgID, pkgequal.FieldEqualPkgID:
 			values[i] = new(uuid.UUID)
@@ -103,6 +105,12 @@ func (pe *PkgEqual) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				pe.ID = *value
 			}
+		case pkgequal.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				pe.Tenant = value.String
+			}
 		case pkgequal.FieldPkgID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field pkg_id", values[i])
@@ -191,6 +199,9 @@ func (pe *PkgEqual) String() string {
 	var builder strings.Builder
 	builder.WriteString("PkgEqual(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pe.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(pe.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("pkg_id=")
 	builder.WriteString(fmt.Sprintf("%v", pe.PkgID))
 	builder.WriteString(", ")
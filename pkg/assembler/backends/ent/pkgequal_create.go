@@ -24,6 +24,20 @@ type PkgEqualCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (pec *PkgEqualCreate) SetTenant(s string) *PkgEqualCreate {
+	pec.mutation.SetTenant(s)
+	return pec
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (pec *PkgEqualCreate) SetNillableTenant(s *string) *PkgEqualCreate {
+	if s != nil {
+		pec.SetTenant(*s)
+	}
+	return pec
+}
+
 // SetPkgID sets the "pkg_id" field.
 func (pec *PkgEqualCreate) SetPkgID(u uuid.UUID) *PkgEqualCreate {
 	pec.mutation.SetPkgID(u)
@@ -137,6 +151,10 @@ func (pec *PkgEqualCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pec *PkgEqualCreate) defaults() {
+	if _, ok := pec.mutation.Tenant(); !ok {
+		v := pkgequal.DefaultTenant
+		pec.mutation.SetTenant(v)
+	}
 	if _, ok := pec.mutation.ID(); !ok {
 		v := pkgequal.DefaultID()
 		pec.mutation.SetID(v)
@@ -145,6 +163,9 @@ func (pec *PkgEqualCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pec *PkgEqualCreate) check() error {
+	if _, ok := pec.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "PkgEqual.tenant"`)}
+	}
 	if _, ok := pec.mutation.PkgID(); !ok {
 		return &ValidationError{Name: "pkg_id", err: errors.New(`ent: missing required field "PkgEqual.pkg_id"`)}
 	}
@@ -208,6 +229,10 @@ func (pec *PkgEqualCreate) createSpec() (*PkgEqual, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := pec.mutation.Tenant(); ok {
+		_spec.SetField(pkgequal.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := pec.mutation.Origin(); ok {
 		_spec.SetField(pkgequal.FieldOrigin, field.TypeString, value)
 		_node.Origin = value
@@ -269,7 +294,7 @@ func (pec *PkgEqualCreate) createSpec() (*PkgEqual, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.PkgEqual.Create().
-//		SetPkgID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -278,7 +303,7 @@ func (pec *PkgEqualCreate) createSpec() (*PkgEqual, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.PkgEqualUpsert) {
-//			SetPkgID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (pec *PkgEqualCreate) OnConflict(opts ...sql.ConflictOption) *PkgEqualUpsertOne {
@@ -415,6 +440,9 @@ func (u *PkgEqualUpsertOne) UpdateNewValues() *PkgEqualUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(pkgequal.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(pkgequal.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -680,7 +708,7 @@ func (pecb *PkgEqualCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.PkgEqualUpsert) {
-//			SetPkgID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (pecb *PkgEqualCreateBulk) OnConflict(opts ...sql.ConflictOption) *PkgEqualUpsertBulk {
@@ -727,6 +755,9 @@ func (u *PkgEqualUpsertBulk) UpdateNewValues() *PkgEqualUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(pkgequal.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(pkgequal.FieldTenant)
+			}
 		}
 	}))
 	return u
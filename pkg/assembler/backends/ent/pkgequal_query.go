@@ -336,12 +336,12 @@ func (peq *PkgEqualQuery) WithPackageB(opts ...func(*PackageVersionQuery)) *PkgE
 // Example:
 //
 //	var v []struct {
-//		PkgID uuid.UUID `json:"pkg_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.PkgEqual.Query().
-//		GroupBy(pkgequal.FieldPkgID).
+//		GroupBy(pkgequal.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (peq *PkgEqualQuery) GroupBy(field string, fields ...string) *PkgEqualGroupBy {
@@ -359,11 +359,11 @@ func (peq *PkgEqualQuery) GroupBy(field string, fields ...string) *PkgEqualGroup
 // Example:
 //
 //	var v []struct {
-//		PkgID uuid.UUID `json:"pkg_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.PkgEqual.Query().
-//		Select(pkgequal.FieldPkgID).
+//		Select(pkgequal.FieldTenant).
 //		Scan(ctx, &v)
 func (peq *PkgEqualQuery) Select(fields ...string) *PkgEqualSelect {
 	peq.ctx.Fields = append(peq.ctx.Fields, fields...)
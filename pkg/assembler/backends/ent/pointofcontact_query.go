@@ -409,12 +409,12 @@ func (pocq *PointOfContactQuery) WithArtifact(opts ...func(*ArtifactQuery)) *Poi
 // Example:
 //
 //	var v []struct {
-//		SourceID uuid.UUID `json:"source_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.PointOfContact.Query().
-//		GroupBy(pointofcontact.FieldSourceID).
+//		GroupBy(pointofcontact.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pocq *PointOfContactQuery) GroupBy(field string, fields ...string) *PointOfContactGroupBy {
@@ -432,11 +432,11 @@ func (pocq *PointOfContactQuery) GroupBy(field string, fields ...string) *PointO
 // Example:
 //
 //	var v []struct {
-//		SourceID uuid.UUID `json:"source_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.PointOfContact.Query().
-//		Select(pointofcontact.FieldSourceID).
+//		Select(pointofcontact.FieldTenant).
 //		Scan(ctx, &v)
 func (pocq *PointOfContactQuery) Select(fields ...string) *PointOfContactSelect {
 	pocq.ctx.Fields = append(pocq.ctx.Fields, fields...)
@@ -15,6 +15,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/document"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -36,38 +37,80 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	artifactMixin := schema.Artifact{}.Mixin()
+	artifactMixinFields0 := artifactMixin[0].Fields()
+	_ = artifactMixinFields0
 	artifactFields := schema.Artifact{}.Fields()
 	_ = artifactFields
+	// artifactDescTenant is the schema descriptor for tenant field.
+	artifactDescTenant := artifactMixinFields0[0].Descriptor()
+	// artifact.DefaultTenant holds the default value on creation for the tenant field.
+	artifact.DefaultTenant = artifactDescTenant.Default.(string)
 	// artifactDescID is the schema descriptor for id field.
 	artifactDescID := artifactFields[0].Descriptor()
 	// artifact.DefaultID holds the default value on creation for the id field.
 	artifact.DefaultID = artifactDescID.Default.(func() uuid.UUID)
+	billofmaterialsMixin := schema.BillOfMaterials{}.Mixin()
+	billofmaterialsMixinFields0 := billofmaterialsMixin[0].Fields()
+	_ = billofmaterialsMixinFields0
 	billofmaterialsFields := schema.BillOfMaterials{}.Fields()
 	_ = billofmaterialsFields
+	// billofmaterialsDescTenant is the schema descriptor for tenant field.
+	billofmaterialsDescTenant := billofmaterialsMixinFields0[0].Descriptor()
+	// billofmaterials.DefaultTenant holds the default value on creation for the tenant field.
+	billofmaterials.DefaultTenant = billofmaterialsDescTenant.Default.(string)
 	// billofmaterialsDescID is the schema descriptor for id field.
 	billofmaterialsDescID := billofmaterialsFields[0].Descriptor()
 	// billofmaterials.DefaultID holds the default value on creation for the id field.
 	billofmaterials.DefaultID = billofmaterialsDescID.Default.(func() uuid.UUID)
+	builderMixin := schema.Builder{}.Mixin()
+	builderMixinFields0 := builderMixin[0].Fields()
+	_ = builderMixinFields0
 	builderFields := schema.Builder{}.Fields()
 	_ = builderFields
+	// builderDescTenant is the schema descriptor for tenant field.
+	builderDescTenant := builderMixinFields0[0].Descriptor()
+	// builder.DefaultTenant holds the default value on creation for the tenant field.
+	builder.DefaultTenant = builderDescTenant.Default.(string)
 	// builderDescID is the schema descriptor for id field.
 	builderDescID := builderFields[0].Descriptor()
 	// builder.DefaultID holds the default value on creation for the id field.
 	builder.DefaultID = builderDescID.Default.(func() uuid.UUID)
+	certificationMixin := schema.Certification{}.Mixin()
+	certificationMixinFields0 := certificationMixin[0].Fields()
+	_ = certificationMixinFields0
 	certificationFields := schema.Certification{}.Fields()
 	_ = certificationFields
+	// certificationDescTenant is the schema descriptor for tenant field.
+	certificationDescTenant := certificationMixinFields0[0].Descriptor()
+	// certification.DefaultTenant holds the default value on creation for the tenant field.
+	certification.DefaultTenant = certificationDescTenant.Default.(string)
 	// certificationDescID is the schema descriptor for id field.
 	certificationDescID := certificationFields[0].Descriptor()
 	// certification.DefaultID holds the default value on creation for the id field.
 	certification.DefaultID = certificationDescID.Default.(func() uuid.UUID)
+	certifylegalMixin := schema.CertifyLegal{}.Mixin()
+	certifylegalMixinFields0 := certifylegalMixin[0].Fields()
+	_ = certifylegalMixinFields0
 	certifylegalFields := schema.CertifyLegal{}.Fields()
 	_ = certifylegalFields
+	// certifylegalDescTenant is the schema descriptor for tenant field.
+	certifylegalDescTenant := certifylegalMixinFields0[0].Descriptor()
+	// certifylegal.DefaultTenant holds the default value on creation for the tenant field.
+	certifylegal.DefaultTenant = certifylegalDescTenant.Default.(string)
 	// certifylegalDescID is the schema descriptor for id field.
 	certifylegalDescID := certifylegalFields[0].Descriptor()
 	// certifylegal.DefaultID holds the default value on creation for the id field.
 	certifylegal.DefaultID = certifylegalDescID.Default.(func() uuid.UUID)
+	certifyscorecardMixin := schema.CertifyScorecard{}.Mixin()
+	certifyscorecardMixinFields0 := certifyscorecardMixin[0].Fields()
+	_ = certifyscorecardMixinFields0
 	certifyscorecardFields := schema.CertifyScorecard{}.Fields()
 	_ = certifyscorecardFields
+	// certifyscorecardDescTenant is the schema descriptor for tenant field.
+	certifyscorecardDescTenant := certifyscorecardMixinFields0[0].Descriptor()
+	// certifyscorecard.DefaultTenant holds the default value on creation for the tenant field.
+	certifyscorecard.DefaultTenant = certifyscorecardDescTenant.Default.(string)
 	// certifyscorecardDescAggregateScore is the schema descriptor for aggregate_score field.
 	certifyscorecardDescAggregateScore := certifyscorecardFields[3].Descriptor()
 	// certifyscorecard.DefaultAggregateScore holds the default value on creation for the aggregate_score field.
@@ -80,44 +123,110 @@ func init() {
 	certifyscorecardDescID := certifyscorecardFields[0].Descriptor()
 	// certifyscorecard.DefaultID holds the default value on creation for the id field.
 	certifyscorecard.DefaultID = certifyscorecardDescID.Default.(func() uuid.UUID)
+	certifyvexMixin := schema.CertifyVex{}.Mixin()
+	certifyvexMixinFields0 := certifyvexMixin[0].Fields()
+	_ = certifyvexMixinFields0
 	certifyvexFields := schema.CertifyVex{}.Fields()
 	_ = certifyvexFields
+	// certifyvexDescTenant is the schema descriptor for tenant field.
+	certifyvexDescTenant := certifyvexMixinFields0[0].Descriptor()
+	// certifyvex.DefaultTenant holds the default value on creation for the tenant field.
+	certifyvex.DefaultTenant = certifyvexDescTenant.Default.(string)
 	// certifyvexDescID is the schema descriptor for id field.
 	certifyvexDescID := certifyvexFields[0].Descriptor()
 	// certifyvex.DefaultID holds the default value on creation for the id field.
 	certifyvex.DefaultID = certifyvexDescID.Default.(func() uuid.UUID)
+	certifyvulnMixin := schema.CertifyVuln{}.Mixin()
+	certifyvulnMixinFields0 := certifyvulnMixin[0].Fields()
+	_ = certifyvulnMixinFields0
 	certifyvulnFields := schema.CertifyVuln{}.Fields()
 	_ = certifyvulnFields
+	// certifyvulnDescTenant is the schema descriptor for tenant field.
+	certifyvulnDescTenant := certifyvulnMixinFields0[0].Descriptor()
+	// certifyvuln.DefaultTenant holds the default value on creation for the tenant field.
+	certifyvuln.DefaultTenant = certifyvulnDescTenant.Default.(string)
 	// certifyvulnDescID is the schema descriptor for id field.
 	certifyvulnDescID := certifyvulnFields[0].Descriptor()
 	// certifyvuln.DefaultID holds the default value on creation for the id field.
 	certifyvuln.DefaultID = certifyvulnDescID.Default.(func() uuid.UUID)
+	dependencyMixin := schema.Dependency{}.Mixin()
+	dependencyMixinFields0 := dependencyMixin[0].Fields()
+	_ = dependencyMixinFields0
 	dependencyFields := schema.Dependency{}.Fields()
 	_ = dependencyFields
+	// dependencyDescTenant is the schema descriptor for tenant field.
+	dependencyDescTenant := dependencyMixinFields0[0].Descriptor()
+	// dependency.DefaultTenant holds the default value on creation for the tenant field.
+	dependency.DefaultTenant = dependencyDescTenant.Default.(string)
 	// dependencyDescID is the schema descriptor for id field.
 	dependencyDescID := dependencyFields[0].Descriptor()
 	// dependency.DefaultID holds the default value on creation for the id field.
 	dependency.DefaultID = dependencyDescID.Default.(func() uuid.UUID)
+	documentMixin := schema.Document{}.Mixin()
+	documentMixinFields0 := documentMixin[0].Fields()
+	_ = documentMixinFields0
+	documentFields := schema.Document{}.Fields()
+	_ = documentFields
+	// documentDescTenant is the schema descriptor for tenant field.
+	documentDescTenant := documentMixinFields0[0].Descriptor()
+	// document.DefaultTenant holds the default value on creation for the tenant field.
+	document.DefaultTenant = documentDescTenant.Default.(string)
+	// documentDescBlobKey is the schema descriptor for blob_key field.
+	documentDescBlobKey := documentFields[1].Descriptor()
+	// document.BlobKeyValidator is a validator for the "blob_key" field. It is called by the builders before save.
+	document.BlobKeyValidator = documentDescBlobKey.Validators[0].(func(string) error)
+	// documentDescID is the schema descriptor for id field.
+	documentDescID := documentFields[0].Descriptor()
+	// document.DefaultID holds the default value on creation for the id field.
+	document.DefaultID = documentDescID.Default.(func() uuid.UUID)
+	hasmetadataMixin := schema.HasMetadata{}.Mixin()
+	hasmetadataMixinFields0 := hasmetadataMixin[0].Fields()
+	_ = hasmetadataMixinFields0
 	hasmetadataFields := schema.HasMetadata{}.Fields()
 	_ = hasmetadataFields
+	// hasmetadataDescTenant is the schema descriptor for tenant field.
+	hasmetadataDescTenant := hasmetadataMixinFields0[0].Descriptor()
+	// hasmetadata.DefaultTenant holds the default value on creation for the tenant field.
+	hasmetadata.DefaultTenant = hasmetadataDescTenant.Default.(string)
 	// hasmetadataDescID is the schema descriptor for id field.
 	hasmetadataDescID := hasmetadataFields[0].Descriptor()
 	// hasmetadata.DefaultID holds the default value on creation for the id field.
 	hasmetadata.DefaultID = hasmetadataDescID.Default.(func() uuid.UUID)
+	hassourceatMixin := schema.HasSourceAt{}.Mixin()
+	hassourceatMixinFields0 := hassourceatMixin[0].Fields()
+	_ = hassourceatMixinFields0
 	hassourceatFields := schema.HasSourceAt{}.Fields()
 	_ = hassourceatFields
+	// hassourceatDescTenant is the schema descriptor for tenant field.
+	hassourceatDescTenant := hassourceatMixinFields0[0].Descriptor()
+	// hassourceat.DefaultTenant holds the default value on creation for the tenant field.
+	hassourceat.DefaultTenant = hassourceatDescTenant.Default.(string)
 	// hassourceatDescID is the schema descriptor for id field.
 	hassourceatDescID := hassourceatFields[0].Descriptor()
 	// hassourceat.DefaultID holds the default value on creation for the id field.
 	hassourceat.DefaultID = hassourceatDescID.Default.(func() uuid.UUID)
+	hashequalMixin := schema.HashEqual{}.Mixin()
+	hashequalMixinFields0 := hashequalMixin[0].Fields()
+	_ = hashequalMixinFields0
 	hashequalFields := schema.HashEqual{}.Fields()
 	_ = hashequalFields
+	// hashequalDescTenant is the schema descriptor for tenant field.
+	hashequalDescTenant := hashequalMixinFields0[0].Descriptor()
+	// hashequal.DefaultTenant holds the default value on creation for the tenant field.
+	hashequal.DefaultTenant = hashequalDescTenant.Default.(string)
 	// hashequalDescID is the schema descriptor for id field.
 	hashequalDescID := hashequalFields[0].Descriptor()
 	// hashequal.DefaultID holds the default value on creation for the id field.
 	hashequal.DefaultID = hashequalDescID.Default.(func() uuid.UUID)
+	licenseMixin := schema.License{}.Mixin()
+	licenseMixinFields0 := licenseMixin[0].Fields()
+	_ = licenseMixinFields0
 	licenseFields := schema.License{}.Fields()
 	_ = licenseFields
+	// licenseDescTenant is the schema descriptor for tenant field.
+	licenseDescTenant := licenseMixinFields0[0].Descriptor()
+	// license.DefaultTenant holds the default value on creation for the tenant field.
+	license.DefaultTenant = licenseDescTenant.Default.(string)
 	// licenseDescName is the schema descriptor for name field.
 	licenseDescName := licenseFields[1].Descriptor()
 	// license.NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -126,14 +235,28 @@ func init() {
 	licenseDescID := licenseFields[0].Descriptor()
 	// license.DefaultID holds the default value on creation for the id field.
 	license.DefaultID = licenseDescID.Default.(func() uuid.UUID)
+	occurrenceMixin := schema.Occurrence{}.Mixin()
+	occurrenceMixinFields0 := occurrenceMixin[0].Fields()
+	_ = occurrenceMixinFields0
 	occurrenceFields := schema.Occurrence{}.Fields()
 	_ = occurrenceFields
+	// occurrenceDescTenant is the schema descriptor for tenant field.
+	occurrenceDescTenant := occurrenceMixinFields0[0].Descriptor()
+	// occurrence.DefaultTenant holds the default value on creation for the tenant field.
+	occurrence.DefaultTenant = occurrenceDescTenant.Default.(string)
 	// occurrenceDescID is the schema descriptor for id field.
 	occurrenceDescID := occurrenceFields[0].Descriptor()
 	// occurrence.DefaultID holds the default value on creation for the id field.
 	occurrence.DefaultID = occurrenceDescID.Default.(func() uuid.UUID)
+	packagenameMixin := schema.PackageName{}.Mixin()
+	packagenameMixinFields0 := packagenameMixin[0].Fields()
+	_ = packagenameMixinFields0
 	packagenameFields := schema.PackageName{}.Fields()
 	_ = packagenameFields
+	// packagenameDescTenant is the schema descriptor for tenant field.
+	packagenameDescTenant := packagenameMixinFields0[0].Descriptor()
+	// packagename.DefaultTenant holds the default value on creation for the tenant field.
+	packagename.DefaultTenant = packagenameDescTenant.Default.(string)
 	// packagenameDescType is the schema descriptor for type field.
 	packagenameDescType := packagenameFields[1].Descriptor()
 	// packagename.TypeValidator is a validator for the "type" field. It is called by the builders before save.
@@ -146,8 +269,15 @@ func init() {
 	packagenameDescID := packagenameFields[0].Descriptor()
 	// packagename.DefaultID holds the default value on creation for the id field.
 	packagename.DefaultID = packagenameDescID.Default.(func() uuid.UUID)
+	packageversionMixin := schema.PackageVersion{}.Mixin()
+	packageversionMixinFields0 := packageversionMixin[0].Fields()
+	_ = packageversionMixinFields0
 	packageversionFields := schema.PackageVersion{}.Fields()
 	_ = packageversionFields
+	// packageversionDescTenant is the schema descriptor for tenant field.
+	packageversionDescTenant := packageversionMixinFields0[0].Descriptor()
+	// packageversion.DefaultTenant holds the default value on creation for the tenant field.
+	packageversion.DefaultTenant = packageversionDescTenant.Default.(string)
 	// packageversionDescVersion is the schema descriptor for version field.
 	packageversionDescVersion := packageversionFields[2].Descriptor()
 	// packageversion.DefaultVersion holds the default value on creation for the version field.
@@ -160,38 +290,80 @@ func init() {
 	packageversionDescID := packageversionFields[0].Descriptor()
 	// packageversion.DefaultID holds the default value on creation for the id field.
 	packageversion.DefaultID = packageversionDescID.Default.(func() uuid.UUID)
+	pkgequalMixin := schema.PkgEqual{}.Mixin()
+	pkgequalMixinFields0 := pkgequalMixin[0].Fields()
+	_ = pkgequalMixinFields0
 	pkgequalFields := schema.PkgEqual{}.Fields()
 	_ = pkgequalFields
+	// pkgequalDescTenant is the schema descriptor for tenant field.
+	pkgequalDescTenant := pkgequalMixinFields0[0].Descriptor()
+	// pkgequal.DefaultTenant holds the default value on creation for the tenant field.
+	pkgequal.DefaultTenant = pkgequalDescTenant.Default.(string)
 	// pkgequalDescID is the schema descriptor for id field.
 	pkgequalDescID := pkgequalFields[0].Descriptor()
 	// pkgequal.DefaultID holds the default value on creation for the id field.
 	pkgequal.DefaultID = pkgequalDescID.Default.(func() uuid.UUID)
+	pointofcontactMixin := schema.PointOfContact{}.Mixin()
+	pointofcontactMixinFields0 := pointofcontactMixin[0].Fields()
+	_ = pointofcontactMixinFields0
 	pointofcontactFields := schema.PointOfContact{}.Fields()
 	_ = pointofcontactFields
+	// pointofcontactDescTenant is the schema descriptor for tenant field.
+	pointofcontactDescTenant := pointofcontactMixinFields0[0].Descriptor()
+	// pointofcontact.DefaultTenant holds the default value on creation for the tenant field.
+	pointofcontact.DefaultTenant = pointofcontactDescTenant.Default.(string)
 	// pointofcontactDescID is the schema descriptor for id field.
 	pointofcontactDescID := pointofcontactFields[0].Descriptor()
 	// pointofcontact.DefaultID holds the default value on creation for the id field.
 	pointofcontact.DefaultID = pointofcontactDescID.Default.(func() uuid.UUID)
+	slsaattestationMixin := schema.SLSAAttestation{}.Mixin()
+	slsaattestationMixinFields0 := slsaattestationMixin[0].Fields()
+	_ = slsaattestationMixinFields0
 	slsaattestationFields := schema.SLSAAttestation{}.Fields()
 	_ = slsaattestationFields
+	// slsaattestationDescTenant is the schema descriptor for tenant field.
+	slsaattestationDescTenant := slsaattestationMixinFields0[0].Descriptor()
+	// slsaattestation.DefaultTenant holds the default value on creation for the tenant field.
+	slsaattestation.DefaultTenant = slsaattestationDescTenant.Default.(string)
 	// slsaattestationDescID is the schema descriptor for id field.
 	slsaattestationDescID := slsaattestationFields[0].Descriptor()
 	// slsaattestation.DefaultID holds the default value on creation for the id field.
 	slsaattestation.DefaultID = slsaattestationDescID.Default.(func() uuid.UUID)
+	sourcenameMixin := schema.SourceName{}.Mixin()
+	sourcenameMixinFields0 := sourcenameMixin[0].Fields()
+	_ = sourcenameMixinFields0
 	sourcenameFields := schema.SourceName{}.Fields()
 	_ = sourcenameFields
+	// sourcenameDescTenant is the schema descriptor for tenant field.
+	sourcenameDescTenant := sourcenameMixinFields0[0].Descriptor()
+	// sourcename.DefaultTenant holds the default value on creation for the tenant field.
+	sourcename.DefaultTenant = sourcenameDescTenant.Default.(string)
 	// sourcenameDescID is the schema descriptor for id field.
 	sourcenameDescID := sourcenameFields[0].Descriptor()
 	// sourcename.DefaultID holds the default value on creation for the id field.
 	sourcename.DefaultID = sourcenameDescID.Default.(func() uuid.UUID)
+	vulnequalMixin := schema.VulnEqual{}.Mixin()
+	vulnequalMixinFields0 := vulnequalMixin[0].Fields()
+	_ = vulnequalMixinFields0
 	vulnequalFields := schema.VulnEqual{}.Fields()
 	_ = vulnequalFields
+	// vulnequalDescTenant is the schema descriptor for tenant field.
+	vulnequalDescTenant := vulnequalMixinFields0[0].Descriptor()
+	// vulnequal.DefaultTenant holds the default value on creation for the tenant field.
+	vulnequal.DefaultTenant = vulnequalDescTenant.Default.(string)
 	// vulnequalDescID is the schema descriptor for id field.
 	vulnequalDescID := vulnequalFields[0].Descriptor()
 	// vulnequal.DefaultID holds the default value on creation for the id field.
 	vulnequal.DefaultID = vulnequalDescID.Default.(func() uuid.UUID)
+	vulnerabilityidMixin := schema.VulnerabilityID{}.Mixin()
+	vulnerabilityidMixinFields0 := vulnerabilityidMixin[0].Fields()
+	_ = vulnerabilityidMixinFields0
 	vulnerabilityidFields := schema.VulnerabilityID{}.Fields()
 	_ = vulnerabilityidFields
+	// vulnerabilityidDescTenant is the schema descriptor for tenant field.
+	vulnerabilityidDescTenant := vulnerabilityidMixinFields0[0].Descriptor()
+	// vulnerabilityid.DefaultTenant holds the default value on creation for the tenant field.
+	vulnerabilityid.DefaultTenant = vulnerabilityidDescTenant.Default.(string)
 	// vulnerabilityidDescType is the schema descriptor for type field.
 	vulnerabilityidDescType := vulnerabilityidFields[2].Descriptor()
 	// vulnerabilityid.TypeValidator is a validator for the "type" field. It is called by the builders before save.
@@ -200,8 +372,15 @@ func init() {
 	vulnerabilityidDescID := vulnerabilityidFields[0].Descriptor()
 	// vulnerabilityid.DefaultID holds the default value on creation for the id field.
 	vulnerabilityid.DefaultID = vulnerabilityidDescID.Default.(func() uuid.UUID)
+	vulnerabilitymetadataMixin := schema.VulnerabilityMetadata{}.Mixin()
+	vulnerabilitymetadataMixinFields0 := vulnerabilitymetadataMixin[0].Fields()
+	_ = vulnerabilitymetadataMixinFields0
 	vulnerabilitymetadataFields := schema.VulnerabilityMetadata{}.Fields()
 	_ = vulnerabilitymetadataFields
+	// vulnerabilitymetadataDescTenant is the schema descriptor for tenant field.
+	vulnerabilitymetadataDescTenant := vulnerabilitymetadataMixinFields0[0].Descriptor()
+	// vulnerabilitymetadata.DefaultTenant holds the default value on creation for the tenant field.
+	vulnerabilitymetadata.DefaultTenant = vulnerabilitymetadataDescTenant.Default.(string)
 	// vulnerabilitymetadataDescID is the schema descriptor for id field.
 	vulnerabilitymetadataDescID := vulnerabilitymetadataFields[0].Descriptor()
 	// vulnerabilitymetadata.DefaultID holds the default value on creation for the id field.
@@ -36,6 +36,11 @@ type Artifact struct {
 	ent.Schema
 }
 
+// Mixin of the Artifact.
+func (Artifact) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the Artifact.
 func (Artifact) Fields() []ent.Field {
 	return []ent.Field{
@@ -72,6 +77,6 @@ func (Artifact) Edges() []ent.Edge {
 // to query all artifacts using a specific algorithm.
 func (Artifact) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("algorithm", "digest").Unique(),
+		index.Fields("algorithm", "digest", "tenant").Unique(),
 	}
 }
@@ -28,6 +28,11 @@ type Builder struct {
 	ent.Schema
 }
 
+// Mixin of the Builder.
+func (Builder) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the Builder.
 func (Builder) Fields() []ent.Field {
 	return []ent.Field{
@@ -35,7 +40,7 @@ func (Builder) Fields() []ent.Field {
 			Default(getUUIDv7).
 			Unique().
 			Immutable(),
-		field.String("uri").Unique().Immutable().Comment("The URI of the builder, used as a unique identifier in the graph query"),
+		field.String("uri").Immutable().Comment("The URI of the builder, used as a unique identifier in the graph query"),
 	}
 }
 
@@ -49,6 +54,6 @@ func (Builder) Edges() []ent.Edge {
 // Indexes of the BuilderNode.
 func (Builder) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("uri").Unique(),
+		index.Fields("uri", "tenant").Unique(),
 	}
 }
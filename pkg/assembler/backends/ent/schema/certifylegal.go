@@ -29,6 +29,11 @@ type CertifyLegal struct {
 	ent.Schema
 }
 
+// Mixin of the CertifyLegal.
+func (CertifyLegal) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the CertifyLegal.
 func (CertifyLegal) Fields() []ent.Field {
 	return []ent.Field{
@@ -64,11 +69,11 @@ func (CertifyLegal) Edges() []ent.Edge {
 func (CertifyLegal) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("declared_license", "justification",
-			"origin", "collector", "declared_licenses_hash", "discovered_licenses_hash", "source_id").
+			"origin", "collector", "declared_licenses_hash", "discovered_licenses_hash", "source_id", "tenant").
 			Unique().
 			Annotations(entsql.IndexWhere("package_id IS NULL AND source_id IS NOT NULL")).StorageKey("cl_source_id"),
 		index.Fields("declared_license", "justification",
-			"origin", "collector", "declared_licenses_hash", "discovered_licenses_hash", "package_id").
+			"origin", "collector", "declared_licenses_hash", "discovered_licenses_hash", "package_id", "tenant").
 			Unique().
 			Annotations(entsql.IndexWhere("package_id IS NOT NULL AND source_id IS NULL")).StorageKey("cl_pkg_id"),
 		index.Fields("package_id").Annotations(entsql.IndexWhere("package_id IS NOT NULL AND source_id IS NULL")),                                                                       // query when subject is package ID
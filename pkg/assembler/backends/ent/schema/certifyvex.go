@@ -29,6 +29,11 @@ type CertifyVex struct {
 	ent.Schema
 }
 
+// Mixin of the CertifyVex.
+func (CertifyVex) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the VEX.
 func (CertifyVex) Fields() []ent.Field {
 	return []ent.Field{
@@ -62,9 +67,9 @@ func (CertifyVex) Edges() []ent.Edge {
 // Indexes of the VEX.
 func (CertifyVex) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("known_since", "justification", "status", "origin", "collector", "document_ref").
+		index.Fields("known_since", "justification", "status", "origin", "collector", "document_ref", "tenant").
 			Edges("vulnerability", "package").Unique().Annotations(entsql.IndexWhere("artifact_id IS NULL")).StorageKey("vex_artifact_id"),
-		index.Fields("known_since", "justification", "status", "origin", "collector", "document_ref").
+		index.Fields("known_since", "justification", "status", "origin", "collector", "document_ref", "tenant").
 			Edges("vulnerability", "artifact").Unique().Annotations(entsql.IndexWhere("package_id IS NULL")).StorageKey("vex_package_id"),
 	}
 }
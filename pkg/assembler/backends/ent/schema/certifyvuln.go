@@ -29,6 +29,11 @@ type CertifyVuln struct {
 	ent.Schema
 }
 
+// Mixin of the CertifyVuln.
+func (CertifyVuln) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the Vulnerability.
 func (CertifyVuln) Fields() []ent.Field {
 	return []ent.Field{
@@ -60,7 +65,7 @@ func (CertifyVuln) Edges() []ent.Edge {
 // Indexes of the Vulnerability.
 func (CertifyVuln) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("package_id", "vulnerability_id", "collector", "scanner_uri", "scanner_version", "origin", "db_uri", "db_version").
+		index.Fields("package_id", "vulnerability_id", "collector", "scanner_uri", "scanner_version", "origin", "db_uri", "db_version", "tenant").
 			Unique(),
 		index.Fields("package_id"),                                     // speed up frequently run queries to check when CV nodes affect certain package IDs
 		index.Fields("vulnerability_id"),                               // speed up frequently run queries to check when CV nodes have a vulnerability
@@ -38,6 +38,11 @@ func (Dependency) Annotations() []schema.Annotation {
 	}
 }
 
+// Mixin of the Dependency.
+func (Dependency) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the Dependency.
 func (Dependency) Fields() []ent.Field {
 	return []ent.Field{
@@ -73,7 +78,7 @@ func (Dependency) Edges() []ent.Edge {
 // Indexes of the Dependency.
 func (Dependency) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("dependency_type", "justification", "origin", "collector", "document_ref", "package_id", "dependent_package_version_id").Unique(),
+		index.Fields("dependency_type", "justification", "origin", "collector", "document_ref", "package_id", "dependent_package_version_id", "tenant").Unique(),
 		index.Fields("package_id"),                   // speed up frequently run queries to check for deps with a certain package ID
 		index.Fields("dependent_package_version_id"), // query via the dependent package ID
 	}
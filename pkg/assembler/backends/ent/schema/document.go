@@ -0,0 +1,63 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Document holds the schema definition for the Document entity.
+type Document struct {
+	ent.Schema
+}
+
+// Mixin of the Document.
+func (Document) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
+// Fields of the Document.
+func (Document) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(getUUIDv7).
+			Unique().
+			Immutable(),
+		field.String("blob_key").NotEmpty().Comment("Blob store key of the document, matching document_ref on evidence"),
+		field.String("sha256"),
+		field.String("document_type"),
+		field.String("format"),
+		field.String("collector"),
+		field.String("source"),
+		field.String("parser_version"),
+		field.Time("ingested_at"),
+	}
+}
+
+// Edges of the Document.
+func (Document) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Document.
+func (Document) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("blob_key", "tenant").Unique(),
+	}
+}
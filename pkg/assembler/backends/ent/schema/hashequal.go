@@ -28,6 +28,11 @@ type HashEqual struct {
 	ent.Schema
 }
 
+// Mixin of the HashEqual.
+func (HashEqual) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the HashEqual.
 func (HashEqual) Fields() []ent.Field {
 	return []ent.Field{
@@ -56,6 +61,6 @@ func (HashEqual) Edges() []ent.Edge {
 // Indexes of the HashEqual.
 func (HashEqual) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("art_id", "equal_art_id", "artifacts_hash", "origin", "justification", "collector", "document_ref").Unique(),
+		index.Fields("art_id", "equal_art_id", "artifacts_hash", "origin", "justification", "collector", "document_ref", "tenant").Unique(),
 	}
 }
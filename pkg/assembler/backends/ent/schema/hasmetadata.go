@@ -29,6 +29,11 @@ type HasMetadata struct {
 	ent.Schema
 }
 
+// Mixin of the HasMetadata.
+func (HasMetadata) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the HasMetadata.
 func (HasMetadata) Fields() []ent.Field {
 	return []ent.Field{
@@ -62,16 +67,16 @@ func (HasMetadata) Edges() []ent.Edge {
 
 func (HasMetadata) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "source_id").Unique().
+		index.Fields("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "source_id", "tenant").Unique().
 			Annotations(entsql.IndexWhere("source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL")).
 			StorageKey("has_metadata_source_id"),
-		index.Fields("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "package_version_id").Unique().
+		index.Fields("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "package_version_id", "tenant").Unique().
 			Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL")).
 			StorageKey("has_metadata_package_version_id"),
-		index.Fields("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "package_name_id").Unique().
+		index.Fields("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "package_name_id", "tenant").Unique().
 			Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL")).
 			StorageKey("has_metadata_package_name_id"),
-		index.Fields("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "artifact_id").Unique().
+		index.Fields("key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "artifact_id", "tenant").Unique().
 			Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL")).
 			StorageKey("has_metadata_artifact_id"),
 	}
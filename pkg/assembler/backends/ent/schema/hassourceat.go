@@ -29,6 +29,11 @@ type HasSourceAt struct {
 	ent.Schema
 }
 
+// Mixin of the HasSourceAt.
+func (HasSourceAt) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the HasSourceAt.
 func (HasSourceAt) Fields() []ent.Field {
 	return []ent.Field{
@@ -58,7 +63,7 @@ func (HasSourceAt) Edges() []ent.Edge {
 
 func (HasSourceAt) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("source_id", "package_version_id", "justification", "origin", "collector", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("package_version_id IS NOT NULL AND package_name_id IS NULL")),
-		index.Fields("source_id", "package_name_id", "justification", "origin", "collector", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("package_name_id IS NOT NULL AND package_version_id IS NULL")),
+		index.Fields("source_id", "package_version_id", "justification", "origin", "collector", "known_since", "document_ref", "tenant").Unique().Annotations(entsql.IndexWhere("package_version_id IS NOT NULL AND package_name_id IS NULL")),
+		index.Fields("source_id", "package_name_id", "justification", "origin", "collector", "known_since", "document_ref", "tenant").Unique().Annotations(entsql.IndexWhere("package_name_id IS NOT NULL AND package_version_id IS NULL")),
 	}
 }
@@ -28,6 +28,11 @@ type License struct {
 	ent.Schema
 }
 
+// Mixin of the License.
+func (License) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the License.
 func (License) Fields() []ent.Field {
 	return []ent.Field{
@@ -54,7 +59,7 @@ func (License) Edges() []ent.Edge {
 // Indexes of the License.
 func (License) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("name", "inline_hash", "list_version_hash").Unique(),
+		index.Fields("name", "inline_hash", "list_version_hash", "tenant").Unique(),
 		// index.Fields("name", "list_version").Unique().Annotations(entsql.IndexWhere("inline IS NULL AND list_version IS NOT NULL")),
 		// index.Fields("name", "inline").Unique().Annotations(entsql.IndexWhere("inline IS NOT NULL AND list_version IS NULL")),
 	}
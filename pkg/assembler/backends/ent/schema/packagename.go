@@ -29,6 +29,11 @@ type PackageName struct {
 	ent.Schema
 }
 
+// Mixin of the PackageName.
+func (PackageName) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the PackageName.
 func (PackageName) Fields() []ent.Field {
 	return []ent.Field{
@@ -56,6 +61,6 @@ func (PackageName) Edges() []ent.Edge {
 // Indexes of the PackageName.
 func (PackageName) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("name", "namespace", "type").Unique(),
+		index.Fields("name", "namespace", "type", "tenant").Unique(),
 	}
 }
@@ -32,6 +32,11 @@ type PackageVersion struct {
 	ent.Schema
 }
 
+// Mixin of the PackageVersion.
+func (PackageVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the PackageVersion.
 func (PackageVersion) Fields() []ent.Field {
 	return []ent.Field{
@@ -71,10 +76,10 @@ func (PackageVersion) Edges() []ent.Edge {
 // Indexes of the PackageVersion.
 func (PackageVersion) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("hash").Edges("name").Unique(),
+		index.Fields("hash", "tenant").Edges("name").Unique(),
 		index.Fields("qualifiers").Annotations(
 			entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"}),
 		),
-		index.Fields("version", "subpath", "qualifiers").Edges("name").Unique(),
+		index.Fields("version", "subpath", "qualifiers", "tenant").Edges("name").Unique(),
 	}
 }
@@ -34,6 +34,11 @@ type PkgEqual struct {
 // 	}
 // }
 
+// Mixin of the PkgEqual.
+func (PkgEqual) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the PkgEqual.
 func (PkgEqual) Fields() []ent.Field {
 	return []ent.Field{
@@ -62,6 +67,6 @@ func (PkgEqual) Edges() []ent.Edge {
 // Indexes of the PkgEqual.
 func (PkgEqual) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("pkg_id", "equal_pkg_id", "packages_hash", "origin", "justification", "collector", "document_ref").Unique(),
+		index.Fields("pkg_id", "equal_pkg_id", "packages_hash", "origin", "justification", "collector", "document_ref", "tenant").Unique(),
 	}
 }
@@ -38,6 +38,11 @@ func (SLSAAttestation) Annotations() []schema.Annotation {
 	}
 }
 
+// Mixin of the SLSAAttestation.
+func (SLSAAttestation) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the SLSA.
 func (SLSAAttestation) Fields() []ent.Field {
 	return []ent.Field{
@@ -73,6 +78,6 @@ func (SLSAAttestation) Edges() []ent.Edge {
 func (SLSAAttestation) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("subject_id", "origin", "collector", "document_ref", "build_type",
-			"slsa_version", "built_by_id", "built_from_hash", "started_on", "finished_on").Unique(),
+			"slsa_version", "built_by_id", "built_from_hash", "started_on", "finished_on", "tenant").Unique(),
 	}
 }
@@ -28,6 +28,11 @@ type SourceName struct {
 	ent.Schema
 }
 
+// Mixin of the SourceName.
+func (SourceName) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the SourceName.
 func (SourceName) Fields() []ent.Field {
 	return []ent.Field{
@@ -59,6 +64,6 @@ func (SourceName) Edges() []ent.Edge {
 // Indexes of the SourceName.
 func (SourceName) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("type", "namespace", "name", "commit", "tag").Unique(),
+		index.Fields("type", "namespace", "name", "commit", "tag", "tenant").Unique(),
 	}
 }
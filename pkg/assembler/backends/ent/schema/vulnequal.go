@@ -28,6 +28,11 @@ type VulnEqual struct {
 	ent.Schema
 }
 
+// Mixin of the VulnEqual.
+func (VulnEqual) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the VulnEqual.
 func (VulnEqual) Fields() []ent.Field {
 	return []ent.Field{
@@ -56,6 +61,6 @@ func (VulnEqual) Edges() []ent.Edge {
 // Indexes of the VulnEqual.
 func (VulnEqual) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("vuln_id", "equal_vuln_id", "vulnerabilities_hash", "justification", "origin", "collector", "document_ref").Unique(),
+		index.Fields("vuln_id", "equal_vuln_id", "vulnerabilities_hash", "justification", "origin", "collector", "document_ref", "tenant").Unique(),
 	}
 }
@@ -28,6 +28,11 @@ type VulnerabilityID struct {
 	ent.Schema
 }
 
+// Mixin of the VulnerabilityID.
+func (VulnerabilityID) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the VulnerabilityID.
 func (VulnerabilityID) Fields() []ent.Field {
 	return []ent.Field{
@@ -54,7 +59,7 @@ func (VulnerabilityID) Edges() []ent.Edge {
 // Indexes of the VulnerabilityID.
 func (VulnerabilityID) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("vulnerability_id", "type").Unique(),
+		index.Fields("vulnerability_id", "type", "tenant").Unique(),
 		index.Fields("type"), // speed up frequently run queries to check when vuln type is not novuln
 	}
 }
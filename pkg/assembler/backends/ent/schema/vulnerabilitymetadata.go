@@ -29,6 +29,11 @@ type VulnerabilityMetadata struct {
 	ent.Schema
 }
 
+// Mixin of the VulnerabilityMetadata.
+func (VulnerabilityMetadata) Mixin() []ent.Mixin {
+	return []ent.Mixin{TenantMixin{}}
+}
+
 // Fields of the VulnerabilityMetadata.
 func (VulnerabilityMetadata) Fields() []ent.Field {
 	var scoreTypeValues []string
@@ -60,6 +65,6 @@ func (VulnerabilityMetadata) Edges() []ent.Edge {
 // Indexes of the VulnerabilityMetadata.
 func (VulnerabilityMetadata) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("vulnerability_id_id", "score_type", "score_value", "timestamp", "origin", "collector", "document_ref").Unique(),
+		index.Fields("vulnerability_id_id", "score_type", "score_value", "timestamp", "origin", "collector", "document_ref", "tenant").Unique(),
 	}
 }
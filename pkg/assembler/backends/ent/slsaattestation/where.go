@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.SLSAAttestation {
 	return predicate.SLSAAttestation(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
 // BuildType applies equality check predicate on the "build_type" field. It's identical to BuildTypeEQ.
 func BuildType(v string) predicate.SLSAAttestation {
 	return predicate.SLSAAttestation(sql.FieldEQ(FieldBuildType, v))
@@ -106,6 +111,71 @@ func BuiltFromHash(v string) predicate.SLSAAttestation {
 	return predicate.SLSAAttestation(sql.FieldEQ(FieldBuiltFromHash, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // BuildTypeEQ applies the EQ predicate on the "build_type" field.
 func BuildTypeEQ(v string) predicate.SLSAAttestation {
 	return predicate.SLSAAttestation(sql.FieldEQ(FieldBuildType, v))
@@ -27,6 +27,20 @@ type SLSAAttestationCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (sac *SLSAAttestationCreate) SetTenant(s string) *SLSAAttestationCreate {
+	sac.mutation.SetTenant(s)
+	return sac
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (sac *SLSAAttestationCreate) SetNillableTenant(s *string) *SLSAAttestationCreate {
+	if s != nil {
+		sac.SetTenant(*s)
+	}
+	return sac
+}
+
 // SetBuildType sets the "build_type" field.
 func (sac *SLSAAttestationCreate) SetBuildType(s string) *SLSAAttestationCreate {
 	sac.mutation.SetBuildType(s)
@@ -167,6 +181,10 @@ func (sac *SLSAAttestationCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (sac *SLSAAttestationCreate) defaults() {
+	if _, ok := sac.mutation.Tenant(); !ok {
+		v := slsaattestation.DefaultTenant
+		sac.mutation.SetTenant(v)
+	}
 	if _, ok := sac.mutation.ID(); !ok {
 		v := slsaattestation.DefaultID()
 		sac.mutation.SetID(v)
@@ -175,6 +193,9 @@ func (sac *SLSAAttestationCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (sac *SLSAAttestationCreate) check() error {
+	if _, ok := sac.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "SLSAAttestation.tenant"`)}
+	}
 	if _, ok := sac.mutation.BuildType(); !ok {
 		return &ValidationError{Name: "build_type", err: errors.New(`ent: missing required field "SLSAAttestation.build_type"`)}
 	}
@@ -247,6 +268,10 @@ func (sac *SLSAAttestationCreate) createSpec() (*SLSAAttestation, *sqlgraph.Crea
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := sac.mutation.Tenant(); ok {
+		_spec.SetField(slsaattestation.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := sac.mutation.BuildType(); ok {
 		_spec.SetField(slsaattestation.FieldBuildType, field.TypeString, value)
 		_node.BuildType = value
@@ -340,7 +365,7 @@ func (sac *SLSAAttestationCreate) createSpec() (*SLSAAttestation, *sqlgraph.Crea
 // of the `INSERT` statement. For example:
 //
 //	client.SLSAAttestation.Create().
-//		SetBuildType(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -349,7 +374,7 @@ func (sac *SLSAAttestationCreate) createSpec() (*SLSAAttestation, *sqlgraph.Crea
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.SLSAAttestationUpsert) {
-//			SetBuildType(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (sac *SLSAAttestationCreate) OnConflict(opts ...sql.ConflictOption) *SLSAAttestationUpsertOne {
@@ -540,6 +565,9 @@ func (u *SLSAAttestationUpsertOne) UpdateNewValues() *SLSAAttestationUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(slsaattestation.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(slsaattestation.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -868,7 +896,7 @@ func (sacb *SLSAAttestationCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.SLSAAttestationUpsert) {
-//			SetBuildType(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (sacb *SLSAAttestationCreateBulk) OnConflict(opts ...sql.ConflictOption) *SLSAAttestationUpsertBulk {
@@ -915,6 +943,9 @@ func (u *SLSAAttestationUpsertBulk) UpdateNewValues() *SLSAAttestationUpsertBulk
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(slsaattestation.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(slsaattestation.FieldTenant)
+			}
 		}
 	}))
 	return u
@@ -17,6 +17,8 @@ type SourceName struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// Type holds the value of the "type" field.
 	Type string `json:"type,omitempty"`
 	// Namespace holds the value of the "namespace" field.
@@ -132,7 +134,7 @@ func (*SourceName) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case sourcename.FieldType, sourcename.FieldNamespace, sourcename.FieldName, sourcename.FieldCommit, sourcename.FieldTag:
+		case sourcename.FieldTenant, sourcename.FieldType, sourcename.FieldNamespace, sourcename.FieldName, sourcename.FieldCommit, sourcename.FieldTag:
 			values[i] = new(sql.NullString)
 		case sourcename.FieldID:
 			values[i] = new(uuid.UUID)
@@ -157,6 +159,12 @@ func (sn *SourceName) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				sn.ID = *value
 			}
+		case sourcename.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				sn.Tenant = value.String
+			}
 		case sourcename.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -258,6 +266,9 @@ func (sn *SourceName) String() string {
 	var builder strings.Builder
 	builder.WriteString("SourceName(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", sn.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(sn.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(sn.Type)
 	builder.WriteString(", ")
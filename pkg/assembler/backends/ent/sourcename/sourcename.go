@@ -13,6 +13,8 @@ const (
 	Label = "source_name"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldNamespace holds the string denoting the namespace field in the database.
@@ -93,6 +95,7 @@ const (
 // Columns holds all SQL columns for sourcename fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldType,
 	FieldNamespace,
 	FieldName,
@@ -111,6 +114,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -123,6 +128,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
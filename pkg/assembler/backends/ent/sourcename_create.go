@@ -30,6 +30,20 @@ type SourceNameCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (snc *SourceNameCreate) SetTenant(s string) *SourceNameCreate {
+	snc.mutation.SetTenant(s)
+	return snc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (snc *SourceNameCreate) SetNillableTenant(s *string) *SourceNameCreate {
+	if s != nil {
+		snc.SetTenant(*s)
+	}
+	return snc
+}
+
 // SetType sets the "type" field.
 func (snc *SourceNameCreate) SetType(s string) *SourceNameCreate {
 	snc.mutation.SetType(s)
@@ -230,6 +244,10 @@ func (snc *SourceNameCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (snc *SourceNameCreate) defaults() {
+	if _, ok := snc.mutation.Tenant(); !ok {
+		v := sourcename.DefaultTenant
+		snc.mutation.SetTenant(v)
+	}
 	if _, ok := snc.mutation.ID(); !ok {
 		v := sourcename.DefaultID()
 		snc.mutation.SetID(v)
@@ -238,6 +256,9 @@ func (snc *SourceNameCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (snc *SourceNameCreate) check() error {
+	if _, ok := snc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "SourceName.tenant"`)}
+	}
 	if _, ok := snc.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "SourceName.type"`)}
 	}
@@ -283,6 +304,10 @@ func (snc *SourceNameCreate) createSpec() (*SourceName, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := snc.mutation.Tenant(); ok {
+		_spec.SetField(sourcename.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := snc.mutation.GetType(); ok {
 		_spec.SetField(sourcename.FieldType, field.TypeString, value)
 		_node.Type = value
@@ -422,7 +447,7 @@ func (snc *SourceNameCreate) createSpec() (*SourceName, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.SourceName.Create().
-//		SetType(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -431,7 +456,7 @@ func (snc *SourceNameCreate) createSpec() (*SourceName, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.SourceNameUpsert) {
-//			SetType(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (snc *SourceNameCreate) OnConflict(opts ...sql.ConflictOption) *SourceNameUpsertOne {
@@ -556,6 +581,9 @@ func (u *SourceNameUpsertOne) UpdateNewValues() *SourceNameUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(sourcename.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(sourcename.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -807,7 +835,7 @@ func (sncb *SourceNameCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.SourceNameUpsert) {
-//			SetType(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (sncb *SourceNameCreateBulk) OnConflict(opts ...sql.ConflictOption) *SourceNameUpsertBulk {
@@ -854,6 +882,9 @@ func (u *SourceNameUpsertBulk) UpdateNewValues() *SourceNameUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(sourcename.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(sourcename.FieldTenant)
+			}
 		}
 	}))
 	return u
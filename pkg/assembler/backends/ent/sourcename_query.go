@@ -525,12 +525,12 @@ func (snq *SourceNameQuery) WithCertifyLegal(opts ...func(*CertifyLegalQuery)) *
 // Example:
 //
 //	var v []struct {
-//		Type string `json:"type,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.SourceName.Query().
-//		GroupBy(sourcename.FieldType).
+//		GroupBy(sourcename.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (snq *SourceNameQuery) GroupBy(field string, fields ...string) *SourceNameGroupBy {
@@ -548,11 +548,11 @@ func (snq *SourceNameQuery) GroupBy(field string, fields ...string) *SourceNameG
 // Example:
 //
 //	var v []struct {
-//		Type string `json:"type,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.SourceName.Query().
-//		Select(sourcename.FieldType).
+//		Select(sourcename.FieldTenant).
 //		Scan(ctx, &v)
 func (snq *SourceNameQuery) Select(fields ...string) *SourceNameSelect {
 	snq.ctx.Fields = append(snq.ctx.Fields, fields...)
@@ -18,6 +18,8 @@ type VulnEqual struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// VulnID holds the value of the "vuln_id" field.
 	VulnID uuid.UUID `json:"vuln_id,omitempty"`
 	// EqualVulnID holds the value of the "equal_vuln_id" field.
@@ -78,7 +80,7 @@ func (*VulnEqual) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case vulnequal.FieldJustification, vulnequal.FieldOrigin, vulnequal.FieldCollector, vulnequal.FieldDocumentRef, vulnequal.FieldVulnerabilitiesHash:
+		case vulnequal.FieldTenant, vulnequal.FieldJustification, vulnequal.FieldOrigin, vulnequal.FieldCollector, vulnequal.FieldDocumentRef, vulnequal.FieldVulnerabilitiesHash:
 			values[i] = new(sql.NullString)
 		case vulnequal.FieldID, vulnequal.FieldVulnID, vulnequal.FieldEqualVulnID:
 			values[i] = new(uuid.UUID)
@@ -103,6 +105,12 @@ func (ve *VulnEqual) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				ve.ID = *value
 			}
+		case vulnequal.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				ve.Tenant = value.String
+			}
 		case vulnequal.FieldVulnID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field vuln_id", values[i])
@@ -191,6 +199,9 @@ func (ve *VulnEqual) String() string {
 	var builder strings.Builder
 	builder.WriteString("VulnEqual(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", ve.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(ve.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("vuln_id=")
 	builder.WriteString(fmt.Sprintf("%v", ve.VulnID))
 	builder.WriteString(", ")
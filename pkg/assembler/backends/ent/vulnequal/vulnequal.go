@@ -13,6 +13,8 @@ const (
 	Label = "vuln_equal"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldVulnID holds the string denoting the vuln_id field in the database.
 	FieldVulnID = "vuln_id"
 	// FieldEqualVulnID holds the string denoting the equal_vuln_id field in the database.
@@ -52,6 +54,7 @@ const (
 // Columns holds all SQL columns for vulnequal fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldVulnID,
 	FieldEqualVulnID,
 	FieldJustification,
@@ -72,6 +75,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -84,6 +89,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByVulnID orders the results by the vuln_id field.
 func ByVulnID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVulnID, opts...).ToFunc()
@@ -54,6 +54,11 @@ func IDLTE(id uuid.UUID) predicate.VulnEqual {
 	return predicate.VulnEqual(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldEQ(FieldTenant, v))
+}
+
 // VulnID applies equality check predicate on the "vuln_id" field. It's identical to VulnIDEQ.
 func VulnID(v uuid.UUID) predicate.VulnEqual {
 	return predicate.VulnEqual(sql.FieldEQ(FieldVulnID, v))
@@ -89,6 +94,71 @@ func VulnerabilitiesHash(v string) predicate.VulnEqual {
 	return predicate.VulnEqual(sql.FieldEQ(FieldVulnerabilitiesHash, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.VulnEqual {
+	return predicate.VulnEqual(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // VulnIDEQ applies the EQ predicate on the "vuln_id" field.
 func VulnIDEQ(v uuid.UUID) predicate.VulnEqual {
 	return predicate.VulnEqual(sql.FieldEQ(FieldVulnID, v))
@@ -24,6 +24,20 @@ type VulnEqualCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (vec *VulnEqualCreate) SetTenant(s string) *VulnEqualCreate {
+	vec.mutation.SetTenant(s)
+	return vec
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (vec *VulnEqualCreate) SetNillableTenant(s *string) *VulnEqualCreate {
+	if s != nil {
+		vec.SetTenant(*s)
+	}
+	return vec
+}
+
 // SetVulnID sets the "vuln_id" field.
 func (vec *VulnEqualCreate) SetVulnID(u uuid.UUID) *VulnEqualCreate {
 	vec.mutation.SetVulnID(u)
@@ -137,6 +151,10 @@ func (vec *VulnEqualCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (vec *VulnEqualCreate) defaults() {
+	if _, ok := vec.mutation.Tenant(); !ok {
+		v := vulnequal.DefaultTenant
+		vec.mutation.SetTenant(v)
+	}
 	if _, ok := vec.mutation.ID(); !ok {
 		v := vulnequal.DefaultID()
 		vec.mutation.SetID(v)
@@ -145,6 +163,9 @@ func (vec *VulnEqualCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (vec *VulnEqualCreate) check() error {
+	if _, ok := vec.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "VulnEqual.tenant"`)}
+	}
 	if _, ok := vec.mutation.VulnID(); !ok {
 		return &ValidationError{Name: "vuln_id", err: errors.New(`ent: missing required field "VulnEqual.vuln_id"`)}
 	}
@@ -208,6 +229,10 @@ func (vec *VulnEqualCreate) createSpec() (*VulnEqual, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := vec.mutation.Tenant(); ok {
+		_spec.SetField(vulnequal.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := vec.mutation.Justification(); ok {
 		_spec.SetField(vulnequal.FieldJustification, field.TypeString, value)
 		_node.Justification = value
@@ -269,7 +294,7 @@ func (vec *VulnEqualCreate) createSpec() (*VulnEqual, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.VulnEqual.Create().
-//		SetVulnID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -278,7 +303,7 @@ func (vec *VulnEqualCreate) createSpec() (*VulnEqual, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.VulnEqualUpsert) {
-//			SetVulnID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (vec *VulnEqualCreate) OnConflict(opts ...sql.ConflictOption) *VulnEqualUpsertOne {
@@ -415,6 +440,9 @@ func (u *VulnEqualUpsertOne) UpdateNewValues() *VulnEqualUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(vulnequal.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(vulnequal.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -680,7 +708,7 @@ func (vecb *VulnEqualCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.VulnEqualUpsert) {
-//			SetVulnID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (vecb *VulnEqualCreateBulk) OnConflict(opts ...sql.ConflictOption) *VulnEqualUpsertBulk {
@@ -727,6 +755,9 @@ func (u *VulnEqualUpsertBulk) UpdateNewValues() *VulnEqualUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(vulnequal.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(vulnequal.FieldTenant)
+			}
 		}
 	}))
 	return u
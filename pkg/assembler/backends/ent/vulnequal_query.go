@@ -336,12 +336,12 @@ func (veq *VulnEqualQuery) WithVulnerabilityB(opts ...func(*VulnerabilityIDQuery
 // Example:
 //
 //	var v []struct {
-//		VulnID uuid.UUID `json:"vuln_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.VulnEqual.Query().
-//		GroupBy(vulnequal.FieldVulnID).
+//		GroupBy(vulnequal.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (veq *VulnEqualQuery) GroupBy(field string, fields ...string) *VulnEqualGroupBy {
@@ -359,11 +359,11 @@ func (veq *VulnEqualQuery) GroupBy(field string, fields ...string) *VulnEqualGro
 // Example:
 //
 //	var v []struct {
-//		VulnID uuid.UUID `json:"vuln_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.VulnEqual.Query().
-//		Select(vulnequal.FieldVulnID).
+//		Select(vulnequal.FieldTenant).
 //		Scan(ctx, &v)
 func (veq *VulnEqualQuery) Select(fields ...string) *VulnEqualSelect {
 	veq.ctx.Fields = append(veq.ctx.Fields, fields...)
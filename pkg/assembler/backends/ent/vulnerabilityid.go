@@ -17,6 +17,8 @@ type VulnerabilityID struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// ID of the vulnerability, one of OSV, GHSA, CVE, or custom
 	VulnerabilityID string `json:"vulnerability_id,omitempty"`
 	// Type of vulnerability, one of OSV, GHSA, CVE, or custom
@@ -102,7 +104,7 @@ func (*VulnerabilityID) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case vulnerabilityid.FieldVulnerabilityID, vulnerabilityid.FieldType:
+		case vulnerabilityid.FieldTenant, vulnerabilityid.FieldVulnerabilityID, vulnerabilityid.FieldType:
 			values[i] = new(sql.NullString)
 		case vulnerabilityid.FieldID:
 			values[i] = new(uuid.UUID)
@@ -127,6 +129,12 @@ func (vi *VulnerabilityID) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				vi.ID = *value
 			}
+		case vulnerabilityid.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				vi.Tenant = value.String
+			}
 		case vulnerabilityid.FieldVulnerabilityID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field vulnerability_id", values[i])
@@ -200,6 +208,9 @@ func (vi *VulnerabilityID) String() string {
 	var builder strings.Builder
 	builder.WriteString("VulnerabilityID(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", vi.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(vi.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("vulnerability_id=")
 	builder.WriteString(vi.VulnerabilityID)
 	builder.WriteString(", ")
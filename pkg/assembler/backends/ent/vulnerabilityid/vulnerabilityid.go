@@ -13,6 +13,8 @@ const (
 	Label = "vulnerability_id"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldVulnerabilityID holds the string denoting the vulnerability_id field in the database.
 	FieldVulnerabilityID = "vulnerability_id"
 	// FieldType holds the string denoting the type field in the database.
@@ -69,6 +71,7 @@ const (
 // Columns holds all SQL columns for vulnerabilityid fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldVulnerabilityID,
 	FieldType,
 }
@@ -84,6 +87,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	TypeValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
@@ -98,6 +103,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByVulnerabilityID orders the results by the vulnerability_id field.
 func ByVulnerabilityID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVulnerabilityID, opts...).ToFunc()
@@ -54,6 +54,11 @@ func IDLTE(id uuid.UUID) predicate.VulnerabilityID {
 	return predicate.VulnerabilityID(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldEQ(FieldTenant, v))
+}
+
 // VulnerabilityID applies equality check predicate on the "vulnerability_id" field. It's identical to VulnerabilityIDEQ.
 func VulnerabilityID(v string) predicate.VulnerabilityID {
 	return predicate.VulnerabilityID(sql.FieldEQ(FieldVulnerabilityID, v))
@@ -64,6 +69,71 @@ func Type(v string) predicate.VulnerabilityID {
 	return predicate.VulnerabilityID(sql.FieldEQ(FieldType, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // VulnerabilityIDEQ applies the EQ predicate on the "vulnerability_id" field.
 func VulnerabilityIDEQ(v string) predicate.VulnerabilityID {
 	return predicate.VulnerabilityID(sql.FieldEQ(FieldVulnerabilityID, v))
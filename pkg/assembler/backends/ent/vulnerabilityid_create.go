@@ -27,6 +27,20 @@ type VulnerabilityIDCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (vic *VulnerabilityIDCreate) SetTenant(s string) *VulnerabilityIDCreate {
+	vic.mutation.SetTenant(s)
+	return vic
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (vic *VulnerabilityIDCreate) SetNillableTenant(s *string) *VulnerabilityIDCreate {
+	if s != nil {
+		vic.SetTenant(*s)
+	}
+	return vic
+}
+
 // SetVulnerabilityID sets the "vulnerability_id" field.
 func (vic *VulnerabilityIDCreate) SetVulnerabilityID(s string) *VulnerabilityIDCreate {
 	vic.mutation.SetVulnerabilityID(s)
@@ -163,6 +177,10 @@ func (vic *VulnerabilityIDCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (vic *VulnerabilityIDCreate) defaults() {
+	if _, ok := vic.mutation.Tenant(); !ok {
+		v := vulnerabilityid.DefaultTenant
+		vic.mutation.SetTenant(v)
+	}
 	if _, ok := vic.mutation.ID(); !ok {
 		v := vulnerabilityid.DefaultID()
 		vic.mutation.SetID(v)
@@ -171,6 +189,9 @@ func (vic *VulnerabilityIDCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (vic *VulnerabilityIDCreate) check() error {
+	if _, ok := vic.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "VulnerabilityID.tenant"`)}
+	}
 	if _, ok := vic.mutation.VulnerabilityID(); !ok {
 		return &ValidationError{Name: "vulnerability_id", err: errors.New(`ent: missing required field "VulnerabilityID.vulnerability_id"`)}
 	}
@@ -218,6 +239,10 @@ func (vic *VulnerabilityIDCreate) createSpec() (*VulnerabilityID, *sqlgraph.Crea
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := vic.mutation.Tenant(); ok {
+		_spec.SetField(vulnerabilityid.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := vic.mutation.VulnerabilityID(); ok {
 		_spec.SetField(vulnerabilityid.FieldVulnerabilityID, field.TypeString, value)
 		_node.VulnerabilityID = value
@@ -313,7 +338,7 @@ func (vic *VulnerabilityIDCreate) createSpec() (*VulnerabilityID, *sqlgraph.Crea
 // of the `INSERT` statement. For example:
 //
 //	client.VulnerabilityID.Create().
-//		SetVulnerabilityID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -322,7 +347,7 @@ func (vic *VulnerabilityIDCreate) createSpec() (*VulnerabilityID, *sqlgraph.Crea
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.VulnerabilityIDUpsert) {
-//			SetVulnerabilityID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (vic *VulnerabilityIDCreate) OnConflict(opts ...sql.ConflictOption) *VulnerabilityIDUpsertOne {
@@ -399,6 +424,9 @@ func (u *VulnerabilityIDUpsertOne) UpdateNewValues() *VulnerabilityIDUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(vulnerabilityid.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(vulnerabilityid.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -594,7 +622,7 @@ func (vicb *VulnerabilityIDCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.VulnerabilityIDUpsert) {
-//			SetVulnerabilityID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (vicb *VulnerabilityIDCreateBulk) OnConflict(opts ...sql.ConflictOption) *VulnerabilityIDUpsertBulk {
@@ -641,6 +669,9 @@ func (u *VulnerabilityIDUpsertBulk) UpdateNewValues() *VulnerabilityIDUpsertBulk
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(vulnerabilityid.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(vulnerabilityid.FieldTenant)
+			}
 		}
 	}))
 	return u
@@ -450,12 +450,12 @@ func (viq *VulnerabilityIDQuery) WithVex(opts ...func(*CertifyVexQuery)) *Vulner
 // Example:
 //
 //	var v []struct {
-//		VulnerabilityID string `json:"vulnerability_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.VulnerabilityID.Query().
-//		GroupBy(vulnerabilityid.FieldVulnerabilityID).
+//		GroupBy(vulnerabilityid.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (viq *VulnerabilityIDQuery) GroupBy(field string, fields ...string) *VulnerabilityIDGroupBy {
@@ -473,11 +473,11 @@ func (viq *VulnerabilityIDQuery) GroupBy(field string, fields ...string) *Vulner
 // Example:
 //
 //	var v []struct {
-//		VulnerabilityID string `json:"vulnerability_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.VulnerabilityID.Query().
-//		Select(vulnerabilityid.FieldVulnerabilityID).
+//		Select(vulnerabilityid.FieldTenant).
 //		Scan(ctx, &v)
 func (viq *VulnerabilityIDQuery) Select(fields ...string) *VulnerabilityIDSelect {
 	viq.ctx.Fields = append(viq.ctx.Fields, fields...)
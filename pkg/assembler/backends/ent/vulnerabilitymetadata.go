@@ -19,6 +19,8 @@ type VulnerabilityMetadata struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The tenant that ingested the node, empty for the global tenant
+	Tenant string `json:"tenant,omitempty"`
 	// VulnerabilityIDID holds the value of the "vulnerability_id_id" field.
 	VulnerabilityIDID uuid.UUID `json:"vulnerability_id_id,omitempty"`
 	// ScoreType holds the value of the "score_type" field.
@@ -68,7 +70,7 @@ func (*VulnerabilityMetadata) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case vulnerabilitymetadata.FieldScoreValue:
 			values[i] = new(sql.NullFloat64)
-		case vulnerabilitymetadata.FieldScoreType, vulnerabilitymetadata.FieldOrigin, vulnerabilitymetadata.FieldCollector, vulnerabilitymetadata.FieldDocumentRef:
+		case vulnerabilitymetadata.FieldTenant, vulnerabilitymetadata.FieldScoreType, vulnerabilitymetadata.FieldOrigin, vulnerabilitymetadata.FieldCollector, vulnerabilitymetadata.FieldDocumentRef:
 			values[i] = new(sql.NullString)
 		case vulnerabilitymetadata.FieldTimestamp:
 			values[i] = new(sql.NullTime)
@@ -95,6 +97,12 @@ func (vm *VulnerabilityMetadata) assignValues(columns []string, values []any) er
 			} else if value != nil {
 				vm.ID = *value
 			}
+		case vulnerabilitymetadata.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				vm.Tenant = value.String
+			}
 		case vulnerabilitymetadata.FieldVulnerabilityIDID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field vulnerability_id_id", values[i])
@@ -178,6 +186,9 @@ func (vm *VulnerabilityMetadata) String() string {
 	var builder strings.Builder
 	builder.WriteString("VulnerabilityMetadata(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", vm.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(vm.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("vulnerability_id_id=")
 	builder.WriteString(fmt.Sprintf("%v", vm.VulnerabilityIDID))
 	builder.WriteString(", ")
@@ -17,6 +17,8 @@ const (
 	Label = "vulnerability_metadata"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldVulnerabilityIDID holds the string denoting the vulnerability_id_id field in the database.
 	FieldVulnerabilityIDID = "vulnerability_id_id"
 	// FieldScoreType holds the string denoting the score_type field in the database.
@@ -47,6 +49,7 @@ const (
 // Columns holds all SQL columns for vulnerabilitymetadata fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldVulnerabilityIDID,
 	FieldScoreType,
 	FieldScoreValue,
@@ -67,6 +70,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -108,6 +113,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByVulnerabilityIDID orders the results by the vulnerability_id_id field.
 func ByVulnerabilityIDID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVulnerabilityIDID, opts...).ToFunc()
@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldTenant, v))
+}
+
 // VulnerabilityIDID applies equality check predicate on the "vulnerability_id_id" field. It's identical to VulnerabilityIDIDEQ.
 func VulnerabilityIDID(v uuid.UUID) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldVulnerabilityIDID, v))
@@ -86,6 +91,71 @@ func DocumentRef(v string) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldDocumentRef, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // VulnerabilityIDIDEQ applies the EQ predicate on the "vulnerability_id_id" field.
 func VulnerabilityIDIDEQ(v uuid.UUID) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldVulnerabilityIDID, v))
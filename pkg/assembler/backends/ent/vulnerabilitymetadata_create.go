@@ -25,6 +25,20 @@ type VulnerabilityMetadataCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (vmc *VulnerabilityMetadataCreate) SetTenant(s string) *VulnerabilityMetadataCreate {
+	vmc.mutation.SetTenant(s)
+	return vmc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (vmc *VulnerabilityMetadataCreate) SetNillableTenant(s *string) *VulnerabilityMetadataCreate {
+	if s != nil {
+		vmc.SetTenant(*s)
+	}
+	return vmc
+}
+
 // SetVulnerabilityIDID sets the "vulnerability_id_id" field.
 func (vmc *VulnerabilityMetadataCreate) SetVulnerabilityIDID(u uuid.UUID) *VulnerabilityMetadataCreate {
 	vmc.mutation.SetVulnerabilityIDID(u)
@@ -121,6 +135,10 @@ func (vmc *VulnerabilityMetadataCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (vmc *VulnerabilityMetadataCreate) defaults() {
+	if _, ok := vmc.mutation.Tenant(); !ok {
+		v := vulnerabilitymetadata.DefaultTenant
+		vmc.mutation.SetTenant(v)
+	}
 	if _, ok := vmc.mutation.ID(); !ok {
 		v := vulnerabilitymetadata.DefaultID()
 		vmc.mutation.SetID(v)
@@ -129,6 +147,9 @@ func (vmc *VulnerabilityMetadataCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (vmc *VulnerabilityMetadataCreate) check() error {
+	if _, ok := vmc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "VulnerabilityMetadata.tenant"`)}
+	}
 	if _, ok := vmc.mutation.VulnerabilityIDID(); !ok {
 		return &ValidationError{Name: "vulnerability_id_id", err: errors.New(`ent: missing required field "VulnerabilityMetadata.vulnerability_id_id"`)}
 	}
@@ -194,6 +215,10 @@ func (vmc *VulnerabilityMetadataCreate) createSpec() (*VulnerabilityMetadata, *s
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := vmc.mutation.Tenant(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := vmc.mutation.ScoreType(); ok {
 		_spec.SetField(vulnerabilitymetadata.FieldScoreType, field.TypeEnum, value)
 		_node.ScoreType = value
@@ -242,7 +267,7 @@ func (vmc *VulnerabilityMetadataCreate) createSpec() (*VulnerabilityMetadata, *s
 // of the `INSERT` statement. For example:
 //
 //	client.VulnerabilityMetadata.Create().
-//		SetVulnerabilityIDID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -251,7 +276,7 @@ func (vmc *VulnerabilityMetadataCreate) createSpec() (*VulnerabilityMetadata, *s
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.VulnerabilityMetadataUpsert) {
-//			SetVulnerabilityIDID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (vmc *VulnerabilityMetadataCreate) OnConflict(opts ...sql.ConflictOption) *VulnerabilityMetadataUpsertOne {
@@ -394,6 +419,9 @@ func (u *VulnerabilityMetadataUpsertOne) UpdateNewValues() *VulnerabilityMetadat
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(vulnerabilitymetadata.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(vulnerabilitymetadata.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -666,7 +694,7 @@ func (vmcb *VulnerabilityMetadataCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.VulnerabilityMetadataUpsert) {
-//			SetVulnerabilityIDID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (vmcb *VulnerabilityMetadataCreateBulk) OnConflict(opts ...sql.ConflictOption) *VulnerabilityMetadataUpsertBulk {
@@ -713,6 +741,9 @@ func (u *VulnerabilityMetadataUpsertBulk) UpdateNewValues() *VulnerabilityMetada
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(vulnerabilitymetadata.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(vulnerabilitymetadata.FieldTenant)
+			}
 		}
 	}))
 	return u
@@ -301,12 +301,12 @@ func (vmq *VulnerabilityMetadataQuery) WithVulnerabilityID(opts ...func(*Vulnera
 // Example:
 //
 //	var v []struct {
-//		VulnerabilityIDID uuid.UUID `json:"vulnerability_id_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.VulnerabilityMetadata.Query().
-//		GroupBy(vulnerabilitymetadata.FieldVulnerabilityIDID).
+//		GroupBy(vulnerabilitymetadata.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (vmq *VulnerabilityMetadataQuery) GroupBy(field string, fields ...string) *VulnerabilityMetadataGroupBy {
@@ -324,11 +324,11 @@ func (vmq *VulnerabilityMetadataQuery) GroupBy(field string, fields ...string) *
 // Example:
 //
 //	var v []struct {
-//		VulnerabilityIDID uuid.UUID `json:"vulnerability_id_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.VulnerabilityMetadata.Query().
-//		Select(vulnerabilitymetadata.FieldVulnerabilityIDID).
+//		Select(vulnerabilitymetadata.FieldTenant).
 //		Scan(ctx, &v)
 func (vmq *VulnerabilityMetadataQuery) Select(fields ...string) *VulnerabilityMetadataSelect {
 	vmq.ctx.Fields = append(vmq.ctx.Fields, fields...)
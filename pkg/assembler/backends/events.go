@@ -0,0 +1,232 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"context"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// EvidenceType is the type of the evidence reported by an Event.
+type EvidenceType string
+
+// The evidence types published by the backends returned by WithEvents.
+const (
+	EvidenceCertifyVuln         EvidenceType = "CertifyVuln"
+	EvidenceCertifyVEXStatement EvidenceType = "CertifyVEXStatement"
+	EvidenceCertifyBad          EvidenceType = "CertifyBad"
+	EvidenceHasSBOM             EvidenceType = "HasSBOM"
+)
+
+// eventBuffer is the number of events a subscriber can fall behind by before
+// it is dropped.
+const eventBuffer = 256
+
+// Event reports evidence created in a backend. Ingesting evidence that
+// already exists does not report it again.
+type Event struct {
+	Type EvidenceType
+	// Tenant is the tenant of the caller that ingested the evidence.
+	Tenant string
+	IDs    []string
+}
+
+// Events is an in-process publisher of the evidence ingested into a backend.
+type Events struct {
+	mu   sync.Mutex
+	subs map[chan Event]subscriber
+}
+
+type subscriber struct {
+	typ    EvidenceType
+	tenant string
+}
+
+// NewEvents returns an Events without subscribers.
+func NewEvents() *Events {
+	return &Events{subs: map[chan Event]subscriber{}}
+}
+
+// Subscribe returns the events of the given type ingested by the tenant of
+// ctx and by the global tenant, the data visible to the subscriber, until ctx
+// is done. The channel is closed when ctx is done, or when the subscriber
+// falls too far behind: a dropped subscriber finds the channel closed while
+// ctx is not done.
+func (e *Events) Subscribe(ctx context.Context, typ EvidenceType) <-chan Event {
+	ch := make(chan Event, eventBuffer)
+	e.mu.Lock()
+	e.subs[ch] = subscriber{typ: typ, tenant: TenantFromContext(ctx)}
+	e.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		e.unsubscribe(ch)
+	}()
+	return ch
+}
+
+func (e *Events) unsubscribe(ch chan Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.subs[ch]; ok {
+		delete(e.subs, ch)
+		close(ch)
+	}
+}
+
+// Publish sends the event to the subscribers. It never blocks: a subscriber
+// whose buffer is full is dropped instead.
+func (e *Events) Publish(ctx context.Context, event Event) {
+	if len(event.IDs) == 0 {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for ch, s := range e.subs {
+		if s.typ != event.Type || (event.Tenant != GlobalTenant && event.Tenant != s.tenant) {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			logging.FromContext(ctx).Warnf("dropping a subscriber to %s events that fell behind", s.typ)
+			delete(e.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// CreatedReporter is implemented by the backends that call ReportCreated
+// with the CertifyVuln, CertifyVEXStatement, CertifyBad and HasSBOM evidence
+// that their ingestion creates.
+type CreatedReporter interface {
+	ReportsCreated() bool
+}
+
+type createdKey struct{}
+
+type created struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+// ReportCreated reports the IDs of the evidence created by the ingestion
+// that ctx belongs to, once it is stored. The evidence that the ingestion
+// finds already stored must not be reported.
+func ReportCreated(ctx context.Context, ids ...string) {
+	c, ok := ctx.Value(createdKey{}).(*created)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range ids {
+		if id != "" {
+			c.ids = append(c.ids, id)
+		}
+	}
+}
+
+// WithEvents returns a backend that publishes the CertifyVuln,
+// CertifyVEXStatement, CertifyBad and HasSBOM evidence created through it to
+// events. A backend that does not implement CreatedReporter is returned as
+// is, without events, as it cannot tell new evidence from evidence ingested
+// again.
+func WithEvents(backend Backend, events *Events) Backend {
+	if r, ok := backend.(CreatedReporter); !ok || !r.ReportsCreated() {
+		return backend
+	}
+	return &eventBackend{Backend: backend, events: events}
+}
+
+// EventsOf returns the events published by a backend returned by WithEvents,
+// or nil for other backends.
+func EventsOf(backend Backend) *Events {
+	if b, ok := backend.(*eventBackend); ok {
+		return b.events
+	}
+	return nil
+}
+
+type eventBackend struct {
+	Backend
+	events *Events
+}
+
+// SupportsTenants implements MultiTenant for the wrapped backend.
+func (b *eventBackend) SupportsTenants() bool {
+	mt, ok := b.Backend.(MultiTenant)
+	return ok && mt.SupportsTenants()
+}
+
+// publishCreated runs ingest and publishes the evidence that it reports as
+// created, even if it fails afterwards, as that evidence is stored.
+func publishCreated[T any](ctx context.Context, events *Events, typ EvidenceType, ingest func(ctx context.Context) (T, error)) (T, error) {
+	c := &created{}
+	result, err := ingest(context.WithValue(ctx, createdKey{}, c))
+	events.Publish(ctx, Event{Type: typ, Tenant: TenantFromContext(ctx), IDs: c.ids})
+	return result, err
+}
+
+func (b *eventBackend) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, certifyVuln model.ScanMetadataInput) (string, error) {
+	return publishCreated(ctx, b.events, EvidenceCertifyVuln, func(ctx context.Context) (string, error) {
+		return b.Backend.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+	})
+}
+
+func (b *eventBackend) IngestCertifyVulns(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) ([]string, error) {
+	return publishCreated(ctx, b.events, EvidenceCertifyVuln, func(ctx context.Context) ([]string, error) {
+		return b.Backend.IngestCertifyVulns(ctx, pkgs, vulnerabilities, certifyVulns)
+	})
+}
+
+func (b *eventBackend) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.IDorVulnerabilityInput, vexStatement model.VexStatementInputSpec) (string, error) {
+	return publishCreated(ctx, b.events, EvidenceCertifyVEXStatement, func(ctx context.Context) (string, error) {
+		return b.Backend.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+	})
+}
+
+func (b *eventBackend) IngestVEXStatements(ctx context.Context, subjects model.PackageOrArtifactInputs, vulnerabilities []*model.IDorVulnerabilityInput, vexStatements []*model.VexStatementInputSpec) ([]string, error) {
+	return publishCreated(ctx, b.events, EvidenceCertifyVEXStatement, func(ctx context.Context) ([]string, error) {
+		return b.Backend.IngestVEXStatements(ctx, subjects, vulnerabilities, vexStatements)
+	})
+}
+
+func (b *eventBackend) IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) (string, error) {
+	return publishCreated(ctx, b.events, EvidenceCertifyBad, func(ctx context.Context) (string, error) {
+		return b.Backend.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+	})
+}
+
+func (b *eventBackend) IngestCertifyBads(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, certifyBads []*model.CertifyBadInputSpec) ([]string, error) {
+	return publishCreated(ctx, b.events, EvidenceCertifyBad, func(ctx context.Context) ([]string, error) {
+		return b.Backend.IngestCertifyBads(ctx, subjects, pkgMatchType, certifyBads)
+	})
+}
+
+func (b *eventBackend) IngestHasSbom(ctx context.Context, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) (string, error) {
+	return publishCreated(ctx, b.events, EvidenceHasSBOM, func(ctx context.Context) (string, error) {
+		return b.Backend.IngestHasSbom(ctx, subject, hasSbom, includes)
+	})
+}
+
+func (b *eventBackend) IngestHasSBOMs(ctx context.Context, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) ([]string, error) {
+	return publishCreated(ctx, b.events, EvidenceHasSBOM, func(ctx context.Context) ([]string, error) {
+		return b.Backend.IngestHasSBOMs(ctx, subjects, hasSBOMs, includes)
+	})
+}
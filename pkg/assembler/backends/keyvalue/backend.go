@@ -164,6 +164,8 @@ const (
 	vulnMDCol   = "vulnMetadatas"
 	cVEXCol     = "certifyVEXs"
 	cVulnCol    = "certifyVulns"
+	docCol      = "documents"
+	docRefCol   = "documentRefs"
 )
 
 func typeColMap(col string) node {
@@ -226,6 +228,8 @@ func typeColMap(col string) node {
 		return &vexLink{}
 	case cVulnCol:
 		return &certifyVulnerabilityLink{}
+	case docCol:
+		return &documentStruct{}
 	}
 	return &artStruct{}
 }
@@ -242,6 +246,11 @@ type demoClient struct {
 	kv kv.Store
 }
 
+// ReportsCreated implements backends.CreatedReporter.
+func (c *demoClient) ReportsCreated() bool {
+	return true
+}
+
 func getBackend(ctx context.Context, opts backends.BackendArgs) (backends.Backend, error) {
 
 	store, ok := opts.(kv.Store)
@@ -312,7 +321,15 @@ func (c *demoClient) addToIndex(ctx context.Context, coll string, n node) error
 		return err
 	}
 	val := strings.Join([]string{coll, n.Key()}, ":")
-	return c.kv.Set(ctx, indexCol, n.ID(), val)
+	if err := c.kv.Set(ctx, indexCol, n.ID(), val); err != nil {
+		return err
+	}
+	// Evidence nodes are also indexed by the document they came from, so
+	// that the document can list everything it produced.
+	if e, ok := n.(evidenceNode); ok && e.documentRef() != "" {
+		return c.addDocumentRef(ctx, e.documentRef(), coll, n.ID())
+	}
+	return nil
 }
 
 func validateType[E node](n E, c string) error {
@@ -25,6 +25,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -44,7 +45,8 @@ type badLink struct {
 	KnownSince    time.Time
 }
 
-func (n *badLink) ID() string { return n.ThisID }
+func (n *badLink) ID() string          { return n.ThisID }
+func (n *badLink) documentRef() string { return n.DocumentRef }
 
 func (n *badLink) Key() string {
 	return hashKey(strings.Join([]string{
@@ -186,6 +188,7 @@ func (c *demoClient) ingestCertifyBad(ctx context.Context, subject model.Package
 	if err := setkv(ctx, cbCol, in, c); err != nil {
 		return "", err
 	}
+	backends.ReportCreated(ctx, in.ThisID)
 
 	return in.ThisID, nil
 }
@@ -381,10 +384,14 @@ func (c *demoClient) CertifyBad(ctx context.Context, filter *model.CertifyBadSpe
 			// Not found
 			return nil, nil
 		}
-		foundCertifyBad, err := c.buildCertifyBad(ctx, link, filter, true)
+		// the rest of the filter still applies, as on the other backends
+		foundCertifyBad, err := c.CBIfMatch(ctx, filter, link)
 		if err != nil {
 			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 		}
+		if foundCertifyBad == nil {
+			return nil, nil
+		}
 		return []*model.CertifyBad{foundCertifyBad}, nil
 	}
 
@@ -451,6 +458,10 @@ func (c *demoClient) CertifyBad(ctx context.Context, filter *model.CertifyBadSpe
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
 
+				if cb == nil {
+					continue
+				}
+
 				out = append(out, cb)
 			}
 		}
@@ -465,7 +476,8 @@ func (c *demoClient) CBIfMatch(ctx context.Context, filter *model.CertifyBadSpec
 			noMatch(filter.Collector, link.Collector) ||
 			noMatch(filter.Origin, link.Origin) ||
 			noMatch(filter.DocumentRef, link.DocumentRef) ||
-			filter.KnownSince != nil && filter.KnownSince.After(link.KnownSince) {
+			filter.KnownSince != nil && filter.KnownSince.After(link.KnownSince) ||
+			filter.AsOf != nil && filter.AsOf.Before(link.KnownSince) {
 			return nil, nil
 		}
 	}
@@ -42,7 +42,8 @@ type goodLink struct {
 	KnownSince    time.Time
 }
 
-func (n *goodLink) ID() string { return n.ThisID }
+func (n *goodLink) ID() string          { return n.ThisID }
+func (n *goodLink) documentRef() string { return n.DocumentRef }
 
 func (n *goodLink) Key() string {
 	return hashKey(strings.Join([]string{
@@ -450,6 +451,10 @@ func (c *demoClient) CertifyGood(ctx context.Context, filter *model.CertifyGoodS
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
 
+				if cg == nil {
+					continue
+				}
+
 				out = append(out, cg)
 			}
 		}
@@ -464,7 +469,8 @@ func (c *demoClient) CGIfMatch(ctx context.Context, filter *model.CertifyGoodSpe
 			noMatch(filter.Collector, link.Collector) ||
 			noMatch(filter.Origin, link.Origin) ||
 			noMatch(filter.DocumentRef, link.DocumentRef) ||
-			filter.KnownSince != nil && filter.KnownSince.After(link.KnownSince) {
+			filter.KnownSince != nil && filter.KnownSince.After(link.KnownSince) ||
+			filter.AsOf != nil && filter.AsOf.Before(link.KnownSince) {
 			return nil, nil
 		}
 	}
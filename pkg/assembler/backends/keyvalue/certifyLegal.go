@@ -49,7 +49,8 @@ type certifyLegalStruct struct {
 	DocumentRef        string
 }
 
-func (n *certifyLegalStruct) ID() string { return n.ThisID }
+func (n *certifyLegalStruct) ID() string          { return n.ThisID }
+func (n *certifyLegalStruct) documentRef() string { return n.DocumentRef }
 func (n *certifyLegalStruct) Key() string {
 	return hashKey(strings.Join([]string{
 		n.Pkg,
@@ -572,6 +573,7 @@ func (c *demoClient) legalIfMatch(ctx context.Context, filter *model.CertifyLega
 		noMatch(filter.Collector, link.Collector) ||
 		noMatch(filter.DocumentRef, link.DocumentRef) ||
 		(filter.TimeScanned != nil && !link.TimeScanned.Equal(*filter.TimeScanned)) ||
+		(filter.AsOf != nil && filter.AsOf.Before(link.TimeScanned)) ||
 		!c.matchLicenses(ctx, filter.DeclaredLicenses, link.DeclaredLicenses) ||
 		!c.matchLicenses(ctx, filter.DiscoveredLicenses, link.DiscoveredLicenses) {
 		return nil, nil
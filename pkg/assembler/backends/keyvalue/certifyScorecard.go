@@ -45,7 +45,8 @@ type scorecardLink struct {
 	DocumentRef      string
 }
 
-func (n *scorecardLink) ID() string { return n.ThisID }
+func (n *scorecardLink) ID() string          { return n.ThisID }
+func (n *scorecardLink) documentRef() string { return n.DocumentRef }
 func (n *scorecardLink) Key() string {
 	return hashKey(strings.Join([]string{
 		n.SourceID,
@@ -388,6 +389,9 @@ func (c *demoClient) SCIfMatch(ctx context.Context, filter *model.CertifyScoreca
 	if filter != nil && filter.TimeScanned != nil && !filter.TimeScanned.Equal(link.TimeScanned) {
 		return nil, nil
 	}
+	if filter != nil && filter.AsOf != nil && filter.AsOf.Before(link.TimeScanned) {
+		return nil, nil
+	}
 	if filter != nil && noMatchFloat(filter.AggregateScore, link.AggregateScore) {
 		return nil, nil
 	}
@@ -424,6 +428,9 @@ func (c *demoClient) addSCIfMatch(ctx context.Context, out []*model.CertifyScore
 	if filter != nil && filter.TimeScanned != nil && !filter.TimeScanned.Equal(link.TimeScanned) {
 		return out, nil
 	}
+	if filter != nil && filter.AsOf != nil && filter.AsOf.Before(link.TimeScanned) {
+		return out, nil
+	}
 	if filter != nil && noMatchFloat(filter.AggregateScore, link.AggregateScore) {
 		return out, nil
 	}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -46,7 +47,8 @@ type vexLink struct {
 	DocumentRef     string
 }
 
-func (n *vexLink) ID() string { return n.ThisID }
+func (n *vexLink) ID() string          { return n.ThisID }
+func (n *vexLink) documentRef() string { return n.DocumentRef }
 
 func (n *vexLink) Key() string {
 	return hashKey(strings.Join([]string{
@@ -188,6 +190,7 @@ func (c *demoClient) ingestVEXStatement(ctx context.Context, subject model.Packa
 	if err := setkv(ctx, cVEXCol, in, c); err != nil {
 		return "", err
 	}
+	backends.ReportCreated(ctx, in.ThisID)
 
 	return in.ThisID, nil
 }
@@ -391,11 +394,14 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 			// Not found
 			return nil, nil
 		}
-		// If found by id, ignore rest of fields in spec and return as a match
-		foundCertifyVex, err := c.buildCertifyVEXStatement(ctx, link, filter, true)
+		// the rest of the filter still applies, as on the other backends
+		foundCertifyVex, err := c.vexIfMatch(ctx, filter, link)
 		if err != nil {
 			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 		}
+		if foundCertifyVex == nil {
+			return nil, nil
+		}
 		return []*model.CertifyVEXStatement{foundCertifyVex}, nil
 	}
 
@@ -488,6 +494,9 @@ func (c *demoClient) vexIfMatch(ctx context.Context, filter *model.CertifyVEXSta
 	if filter != nil && filter.KnownSince != nil && !filter.KnownSince.Equal(link.KnownSince) {
 		return nil, nil
 	}
+	if filter != nil && filter.AsOf != nil && filter.AsOf.Before(link.KnownSince) {
+		return nil, nil
+	}
 	if filter != nil && filter.VexJustification != nil && *filter.VexJustification != link.Justification {
 		return nil, nil
 	}
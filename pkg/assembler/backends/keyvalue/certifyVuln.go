@@ -26,6 +26,7 @@ import (
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
 )
@@ -45,7 +46,8 @@ type certifyVulnerabilityLink struct {
 	DocumentRef     string
 }
 
-func (n *certifyVulnerabilityLink) ID() string { return n.ThisID }
+func (n *certifyVulnerabilityLink) ID() string          { return n.ThisID }
+func (n *certifyVulnerabilityLink) documentRef() string { return n.DocumentRef }
 func (n *certifyVulnerabilityLink) Key() string {
 	return hashKey(strings.Join([]string{
 		n.PackageID,
@@ -153,6 +155,7 @@ func (c *demoClient) ingestVulnerability(ctx context.Context, packageArg model.I
 	if err := setkv(ctx, cVulnCol, in, c); err != nil {
 		return "", err
 	}
+	backends.ReportCreated(ctx, in.ThisID)
 
 	return in.ThisID, nil
 }
@@ -363,11 +366,14 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 			// Not found
 			return nil, nil
 		}
-		// If found by id, ignore rest of fields in spec and return as a match
-		foundCertifyVuln, err := c.buildCertifyVulnerability(ctx, link, filter, true)
+		// the rest of the filter still applies, as on the other backends
+		foundCertifyVuln, err := c.certifyVulnIfMatch(ctx, filter, link)
 		if err != nil {
 			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 		}
+		if foundCertifyVuln == nil {
+			return nil, nil
+		}
 		return []*model.CertifyVuln{foundCertifyVuln}, nil
 	}
 
@@ -469,6 +475,9 @@ func (c *demoClient) certifyVulnIfMatch(ctx context.Context, filter *model.Certi
 	if filter != nil && filter.TimeScanned != nil && !filter.TimeScanned.Equal(link.TimeScanned) {
 		return nil, nil
 	}
+	if filter != nil && filter.AsOf != nil && filter.AsOf.Before(link.TimeScanned) {
+		return nil, nil
+	}
 	if filter != nil && noMatch(filter.DbURI, link.DBURI) {
 		return nil, nil
 	}
@@ -0,0 +1,407 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+// Internal data: Documents
+type documentStruct struct {
+	ThisID        string
+	BlobKey       string
+	SHA256        string
+	DocumentType  string
+	Format        string
+	Collector     string
+	Source        string
+	ParserVersion string
+	IngestedAt    time.Time
+}
+
+func (n *documentStruct) ID() string { return n.ThisID }
+func (n *documentStruct) Key() string {
+	return documentKey(n.BlobKey)
+}
+
+// Neighbors of a document are not stored on the document itself, as
+// evidence can be ingested before the document. They are looked up in the
+// documentRefs collection by documentNeighbors instead.
+func (n *documentStruct) Neighbors(allowedEdges edgeMap) []string {
+	return nil
+}
+
+func (n *documentStruct) BuildModelNode(ctx context.Context, c *demoClient) (model.Node, error) {
+	return c.convDocument(n), nil
+}
+
+func documentKey(blobKey string) string {
+	return hashKey(blobKey)
+}
+
+// evidenceNode is implemented by all the evidence nodes, which record the
+// document they were ingested from.
+type evidenceNode interface {
+	node
+	documentRef() string
+}
+
+// documentRefs holds the IDs of the evidence ingested from a document, keyed
+// by the collection of the evidence.
+type documentRefs struct {
+	Evidence map[string][]string
+}
+
+// documentEdges maps each evidence collection to the edges between the
+// evidence and its document.
+var documentEdges = map[string]struct{ toDocument, fromDocument model.Edge }{
+	cbCol:      {model.EdgeCertifyBadDocument, model.EdgeDocumentCertifyBad},
+	cgCol:      {model.EdgeCertifyGoodDocument, model.EdgeDocumentCertifyGood},
+	clCol:      {model.EdgeCertifyLegalDocument, model.EdgeDocumentCertifyLegal},
+	cscCol:     {model.EdgeCertifyScorecardDocument, model.EdgeDocumentCertifyScorecard},
+	cVEXCol:    {model.EdgeCertifyVexStatementDocument, model.EdgeDocumentCertifyVexStatement},
+	cVulnCol:   {model.EdgeCertifyVulnDocument, model.EdgeDocumentCertifyVuln},
+	hashEqCol:  {model.EdgeHashEqualDocument, model.EdgeDocumentHashEqual},
+	hasMDCol:   {model.EdgeHasMetadataDocument, model.EdgeDocumentHasMetadata},
+	hasSBOMCol: {model.EdgeHasSbomDocument, model.EdgeDocumentHasSbom},
+	slsaCol:    {model.EdgeHasSlsaDocument, model.EdgeDocumentHasSlsa},
+	hsaCol:     {model.EdgeHasSourceAtDocument, model.EdgeDocumentHasSourceAt},
+	isDepCol:   {model.EdgeIsDependencyDocument, model.EdgeDocumentIsDependency},
+	occCol:     {model.EdgeIsOccurrenceDocument, model.EdgeDocumentIsOccurrence},
+	pkgEqCol:   {model.EdgePkgEqualDocument, model.EdgeDocumentPkgEqual},
+	pocCol:     {model.EdgePointOfContactDocument, model.EdgeDocumentPointOfContact},
+	vulnEqCol:  {model.EdgeVulnEqualDocument, model.EdgeDocumentVulnEqual},
+	vulnMDCol:  {model.EdgeVulnMetadataDocument, model.EdgeDocumentVulnMetadata},
+}
+
+func (c *demoClient) addDocumentRef(ctx context.Context, blobKey, coll, id string) error {
+	var refs *documentRefs
+	if err := c.kv.Get(ctx, docRefCol, documentKey(blobKey), &refs); err != nil {
+		if !errors.Is(err, kv.NotFoundError) {
+			return err
+		}
+		refs = &documentRefs{Evidence: map[string][]string{}}
+	}
+	refs.Evidence[coll] = append(refs.Evidence[coll], id)
+	return c.kv.Set(ctx, docRefCol, documentKey(blobKey), refs)
+}
+
+// documentNeighbors returns the document of an evidence node, or the
+// evidence ingested from a document.
+func (c *demoClient) documentNeighbors(ctx context.Context, coll string, n node, allowedEdges edgeMap) ([]string, error) {
+	switch n := n.(type) {
+	case *documentStruct:
+		var refs *documentRefs
+		if err := c.kv.Get(ctx, docRefCol, n.Key(), &refs); err != nil {
+			if errors.Is(err, kv.NotFoundError) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		colls := make([]string, 0, len(refs.Evidence))
+		for evidenceCol := range refs.Evidence {
+			colls = append(colls, evidenceCol)
+		}
+		sort.Strings(colls)
+		var out []string
+		for _, evidenceCol := range colls {
+			if allowedEdges[documentEdges[evidenceCol].fromDocument] {
+				out = append(out, refs.Evidence[evidenceCol]...)
+			}
+		}
+		return out, nil
+	case evidenceNode:
+		if n.documentRef() == "" || !allowedEdges[documentEdges[coll].toDocument] {
+			return nil, nil
+		}
+		doc, err := byKeykv[*documentStruct](ctx, docCol, documentKey(n.documentRef()), c)
+		if err != nil {
+			if errors.Is(err, kv.NotFoundError) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []string{doc.ThisID}, nil
+	}
+	return nil, nil
+}
+
+// Ingest Documents
+
+func (c *demoClient) IngestDocuments(ctx context.Context, documents []*model.DocumentInputSpec) ([]string, error) {
+	var modelDocuments []string
+	for _, doc := range documents {
+		modelDoc, err := c.IngestDocument(ctx, *doc)
+		if err != nil {
+			return nil, gqlerror.Errorf("ingestDocument failed with err: %v", err)
+		}
+		modelDocuments = append(modelDocuments, modelDoc)
+	}
+	return modelDocuments, nil
+}
+
+func (c *demoClient) IngestDocument(ctx context.Context, document model.DocumentInputSpec) (string, error) {
+	return c.ingestDocument(ctx, document, true)
+}
+
+func (c *demoClient) ingestDocument(ctx context.Context, document model.DocumentInputSpec, readOnly bool) (string, error) {
+	in := &documentStruct{
+		BlobKey:       document.BlobKey,
+		SHA256:        document.Sha256,
+		DocumentType:  document.DocumentType,
+		Format:        document.Format,
+		Collector:     document.Collector,
+		Source:        document.Source,
+		ParserVersion: document.ParserVersion,
+		IngestedAt:    document.IngestedAt.UTC(),
+	}
+
+	lock(&c.m, readOnly)
+	defer unlock(&c.m, readOnly)
+
+	out, err := byKeykv[*documentStruct](ctx, docCol, in.Key(), c)
+	if err == nil {
+		return out.ThisID, nil
+	}
+	if !errors.Is(err, kv.NotFoundError) {
+		return "", err
+	}
+	if readOnly {
+		c.m.RUnlock()
+		d, err := c.ingestDocument(ctx, document, false)
+		c.m.RLock() // relock so that defer unlock does not panic
+		return d, err
+	}
+	in.ThisID = c.getNextID()
+
+	if err := c.addToIndex(ctx, docCol, in); err != nil {
+		return "", err
+	}
+	if err := setkv(ctx, docCol, in, c); err != nil {
+		return "", err
+	}
+
+	return in.ThisID, nil
+}
+
+// Query Documents
+
+func (c *demoClient) documentExact(ctx context.Context, documentSpec *model.DocumentSpec) (*documentStruct, error) {
+	if documentSpec == nil {
+		return nil, nil
+	}
+
+	// If ID is provided, try to look up
+	if documentSpec.ID != nil {
+		d, err := byIDkv[*documentStruct](ctx, *documentSpec.ID, c)
+		if err == nil {
+			// If found by id, ignore rest of fields in spec and return as a match
+			return d, nil
+		}
+		if !errors.Is(err, kv.NotFoundError) && !errors.Is(err, errTypeNotMatch) {
+			return nil, err
+		}
+		// Not found
+		return nil, nil
+	}
+
+	if documentSpec.BlobKey != nil {
+		d, err := byKeykv[*documentStruct](ctx, docCol, documentKey(*documentSpec.BlobKey), c)
+		if err == nil {
+			if documentNoMatch(documentSpec, d) {
+				return nil, nil
+			}
+			return d, nil
+		}
+		if !errors.Is(err, kv.NotFoundError) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func documentNoMatch(documentSpec *model.DocumentSpec, d *documentStruct) bool {
+	return noMatch(documentSpec.BlobKey, d.BlobKey) ||
+		noMatch(documentSpec.Sha256, d.SHA256) ||
+		noMatch(documentSpec.DocumentType, d.DocumentType) ||
+		noMatch(documentSpec.Format, d.Format) ||
+		noMatch(documentSpec.Collector, d.Collector) ||
+		noMatch(documentSpec.Source, d.Source) ||
+		noMatch(documentSpec.ParserVersion, d.ParserVersion)
+}
+
+func (c *demoClient) DocumentList(ctx context.Context, documentSpec model.DocumentSpec, after *string, first *int) (*model.DocumentConnection, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	d, err := c.documentExact(ctx, &documentSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("Documents :: invalid spec %s", err)
+	}
+	if d != nil {
+		document := c.convDocument(d)
+		return &model.DocumentConnection{
+			TotalCount: 1,
+			PageInfo: &model.PageInfo{
+				HasNextPage: false,
+				StartCursor: ptrfrom.String(document.ID),
+				EndCursor:   ptrfrom.String(document.ID),
+			},
+			Edges: []*model.DocumentEdge{
+				{
+					Cursor: document.ID,
+					Node:   document,
+				},
+			},
+		}, nil
+	}
+	if documentSpec.ID != nil || documentSpec.BlobKey != nil {
+		return nil, nil
+	}
+
+	edges := make([]*model.DocumentEdge, 0)
+	hasNextPage := false
+	numNodes := 0
+	totalCount := 0
+
+	var done bool
+	scn := c.kv.Keys(docCol)
+	currentPage := false
+
+	// If no cursor present start from the top
+	if after == nil {
+		currentPage = true
+	}
+	for !done {
+		var dKeys []string
+		dKeys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Strings(dKeys)
+		totalCount = len(dKeys)
+
+		for i, dk := range dKeys {
+			d, err := byKeykv[*documentStruct](ctx, docCol, dk, c)
+			if err != nil {
+				return nil, err
+			}
+			if documentNoMatch(&documentSpec, d) {
+				continue
+			}
+
+			document := c.convDocument(d)
+
+			if after != nil && !currentPage {
+				if document.ID == *after {
+					totalCount = len(dKeys) - (i + 1)
+					currentPage = true
+				}
+				continue
+			}
+
+			if first != nil {
+				if numNodes < *first {
+					edges = append(edges, &model.DocumentEdge{
+						Cursor: document.ID,
+						Node:   document,
+					})
+					numNodes++
+				} else if numNodes == *first {
+					hasNextPage = true
+				}
+			} else {
+				edges = append(edges, &model.DocumentEdge{
+					Cursor: document.ID,
+					Node:   document,
+				})
+			}
+		}
+	}
+
+	if len(edges) != 0 {
+		return &model.DocumentConnection{
+			TotalCount: totalCount,
+			PageInfo: &model.PageInfo{
+				HasNextPage: hasNextPage,
+				StartCursor: ptrfrom.String(edges[0].Node.ID),
+				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Node.ID),
+			},
+			Edges: edges,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (c *demoClient) Documents(ctx context.Context, documentSpec *model.DocumentSpec) ([]*model.Document, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	d, err := c.documentExact(ctx, documentSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("Documents :: invalid spec %s", err)
+	}
+	if d != nil {
+		return []*model.Document{c.convDocument(d)}, nil
+	}
+	if documentSpec.ID != nil || documentSpec.BlobKey != nil {
+		return nil, nil
+	}
+
+	var rv []*model.Document
+	var done bool
+	scn := c.kv.Keys(docCol)
+	for !done {
+		var dKeys []string
+		dKeys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, dk := range dKeys {
+			d, err := byKeykv[*documentStruct](ctx, docCol, dk, c)
+			if err != nil {
+				return nil, err
+			}
+			if documentNoMatch(documentSpec, d) {
+				continue
+			}
+			rv = append(rv, c.convDocument(d))
+		}
+	}
+	return rv, nil
+}
+
+func (c *demoClient) convDocument(d *documentStruct) *model.Document {
+	return &model.Document{
+		ID:            d.ThisID,
+		BlobKey:       d.BlobKey,
+		Sha256:        d.SHA256,
+		DocumentType:  d.DocumentType,
+		Format:        d.Format,
+		Collector:     d.Collector,
+		Source:        d.Source,
+		ParserVersion: d.ParserVersion,
+		IngestedAt:    d.IngestedAt,
+	}
+}
@@ -43,7 +43,8 @@ type hasMetadataLink struct {
 	DocumentRef   string
 }
 
-func (n *hasMetadataLink) ID() string { return n.ThisID }
+func (n *hasMetadataLink) ID() string          { return n.ThisID }
+func (n *hasMetadataLink) documentRef() string { return n.DocumentRef }
 func (n *hasMetadataLink) Key() string {
 	return hashKey(strings.Join([]string{
 		n.PackageID,
@@ -494,6 +495,10 @@ func (c *demoClient) hasMetadataIfMatch(ctx context.Context, filter *model.HasMe
 	if filter != nil && filter.Since != nil && filter.Since.After(link.Timestamp) {
 		return nil, nil
 	}
+	// no match if the link was not yet known at asOf
+	if filter != nil && filter.AsOf != nil && filter.AsOf.Before(link.Timestamp) {
+		return nil, nil
+	}
 
 	found, err := c.buildHasMetadata(ctx, link, filter, false)
 	if err != nil {
@@ -27,6 +27,7 @@ import (
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
@@ -49,7 +50,8 @@ type hasSBOMStruct struct {
 	IncludedOccurrences  []string
 }
 
-func (n *hasSBOMStruct) ID() string { return n.ThisID }
+func (n *hasSBOMStruct) ID() string          { return n.ThisID }
+func (n *hasSBOMStruct) documentRef() string { return n.DocumentRef }
 func (n *hasSBOMStruct) Key() string {
 	return hashKey(strings.Join([]string{
 		n.Pkg,
@@ -247,6 +249,7 @@ func (c *demoClient) ingestHasSbom(ctx context.Context, subject model.PackageOrA
 	if err := setkv(ctx, hasSBOMCol, in, c); err != nil {
 		return "", err
 	}
+	backends.ReportCreated(ctx, in.ThisID)
 
 	return in.ThisID, nil
 }
@@ -508,11 +511,14 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 			// Not found
 			return nil, nil
 		}
-		// If found by id, ignore rest of fields in spec and return as a match
-		sb, err := c.convHasSBOM(ctx, link)
+		// the rest of the filter still applies, as on the other backends
+		sb, err := c.hasSBOMIfMatch(ctx, filter, link)
 		if err != nil {
 			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 		}
+		if sb == nil {
+			return nil, nil
+		}
 		return []*model.HasSbom{sb}, nil
 	}
 
@@ -600,7 +606,8 @@ func (c *demoClient) hasSBOMIfMatch(ctx context.Context, filter *model.HasSBOMSp
 			noMatch(filter.Origin, link.Origin) ||
 			noMatch(filter.Collector, link.Collector) ||
 			noMatch(filter.DocumentRef, link.DocumentRef) ||
-			(filter.KnownSince != nil && filter.KnownSince.After(link.KnownSince)) {
+			(filter.KnownSince != nil && filter.KnownSince.After(link.KnownSince)) ||
+			(filter.AsOf != nil && filter.AsOf.Before(link.KnownSince)) {
 			return nil, nil
 		}
 		// collect packages and artifacts from included software
@@ -47,7 +47,8 @@ type (
 	}
 )
 
-func (n *hasSLSAStruct) ID() string { return n.ThisID }
+func (n *hasSLSAStruct) ID() string          { return n.ThisID }
+func (n *hasSLSAStruct) documentRef() string { return n.DocumentRef }
 func (n *hasSLSAStruct) Key() string {
 	var st string
 	if n.Start != nil {
@@ -552,6 +553,7 @@ func (c *demoClient) addSLSAIfMatch(ctx context.Context, filter *model.HasSLSASp
 		noMatch(filter.DocumentRef, link.DocumentRef) ||
 		(filter.StartedOn != nil && (link.Start == nil || !filter.StartedOn.Equal(*link.Start))) ||
 		(filter.FinishedOn != nil && (link.Finish == nil || !filter.FinishedOn.Equal(*link.Finish))) ||
+		(filter.AsOf != nil && (link.Finish == nil || filter.AsOf.Before(*link.Finish))) ||
 		(filter.BuiltBy != nil && filter.BuiltBy.ID != nil && *filter.BuiltBy.ID != bb.ThisID) ||
 		(filter.BuiltBy != nil && filter.BuiltBy.URI != nil && *filter.BuiltBy.URI != bb.URI) ||
 		!matchSLSAPreds(link.Predicates, filter.Predicate) ||
@@ -41,7 +41,8 @@ type srcMapLink struct {
 	DocumentRef   string
 }
 
-func (n *srcMapLink) ID() string { return n.ThisID }
+func (n *srcMapLink) ID() string          { return n.ThisID }
+func (n *srcMapLink) documentRef() string { return n.DocumentRef }
 func (n *srcMapLink) Key() string {
 	return hashKey(strings.Join([]string{
 		n.SourceID,
@@ -459,6 +460,9 @@ func (c *demoClient) srcIfMatch(ctx context.Context, filter *model.HasSourceAtSp
 	if filter != nil && filter.KnownSince != nil && !filter.KnownSince.Equal(link.KnownSince) {
 		return nil, nil
 	}
+	if filter != nil && filter.AsOf != nil && filter.AsOf.Before(link.KnownSince) {
+		return nil, nil
+	}
 	foundHasSourceAt, err := c.buildHasSourceAt(ctx, link, filter, false)
 	if err != nil {
 		return nil, err
@@ -40,7 +40,8 @@ type hashEqualStruct struct {
 	DocumentRef   string
 }
 
-func (n *hashEqualStruct) ID() string { return n.ThisID }
+func (n *hashEqualStruct) ID() string          { return n.ThisID }
+func (n *hashEqualStruct) documentRef() string { return n.DocumentRef }
 func (n *hashEqualStruct) Key() string {
 	return hashKey(strings.Join([]string{
 		fmt.Sprint(n.Artifacts),
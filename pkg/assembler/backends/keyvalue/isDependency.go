@@ -42,7 +42,8 @@ type isDependencyLink struct {
 	DocumentRef    string
 }
 
-func (n *isDependencyLink) ID() string { return n.ThisID }
+func (n *isDependencyLink) ID() string          { return n.ThisID }
+func (n *isDependencyLink) documentRef() string { return n.DocumentRef }
 func (n *isDependencyLink) Key() string {
 	return hashKey(strings.Join([]string{
 		n.PackageID,
@@ -42,7 +42,8 @@ type isOccurrenceStruct struct {
 	DocumentRef   string
 }
 
-func (n *isOccurrenceStruct) ID() string { return n.ThisID }
+func (n *isOccurrenceStruct) ID() string          { return n.ThisID }
+func (n *isOccurrenceStruct) documentRef() string { return n.DocumentRef }
 
 func (n *isOccurrenceStruct) Neighbors(allowedEdges edgeMap) []string {
 	out := make([]string, 0, 3)
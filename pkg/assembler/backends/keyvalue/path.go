@@ -36,6 +36,13 @@ func processUsingOnly(usingOnly []model.Edge) edgeMap {
 	for _, edge := range allowedEdges {
 		m[edge] = true
 	}
+	if len(usingOnly) == 0 {
+		// the documents of the evidence are only neighbors when asked for, so
+		// that the default neighbors of the evidence stay the same
+		for _, e := range documentEdges {
+			delete(m, e.toDocument)
+		}
+	}
 	return m
 }
 
@@ -61,23 +68,38 @@ func (c *demoClient) Neighbors(ctx context.Context, source string, usingOnly []m
 	return c.Nodes(ctx, neighbors)
 }
 
-func (c *demoClient) neighborsFromId(ctx context.Context, id string, allowedEdges edgeMap) ([]string, error) {
+// nodeByID looks up a node of any type through the index, and returns the
+// collection it is stored in along with the node.
+func (c *demoClient) nodeByID(ctx context.Context, id string) (string, node, error) {
 	var k string
 	if err := c.kv.Get(ctx, indexCol, id, &k); err != nil {
-		return nil, fmt.Errorf("%w : id not found in index %q", err, id)
+		return "", nil, fmt.Errorf("%w : id not found in index %q", err, id)
 	}
 
 	sub := strings.SplitN(k, ":", 2)
 	if len(sub) != 2 {
-		return nil, fmt.Errorf("Bad value was stored in index map: %v", k)
+		return "", nil, fmt.Errorf("Bad value was stored in index map: %v", k)
 	}
 
 	node := typeColMap(sub[0])
 	if err := c.kv.Get(ctx, sub[0], sub[1], &node); err != nil {
+		return "", nil, err
+	}
+	return sub[0], node, nil
+}
+
+func (c *demoClient) neighborsFromId(ctx context.Context, id string, allowedEdges edgeMap) ([]string, error) {
+	coll, node, err := c.nodeByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	docNeighbors, err := c.documentNeighbors(ctx, coll, node, allowedEdges)
+	if err != nil {
 		return nil, err
 	}
 
-	return node.Neighbors(allowedEdges), nil
+	return append(node.Neighbors(allowedEdges), docNeighbors...), nil
 }
 
 func (c *demoClient) bfs(ctx context.Context, from, to string, maxLength int, allowedEdges edgeMap) ([]model.Node, error) {
@@ -155,18 +177,8 @@ func (c *demoClient) Node(ctx context.Context, id string) (model.Node, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
 
-	var k string
-	if err := c.kv.Get(ctx, indexCol, id, &k); err != nil {
-		return nil, fmt.Errorf("%w : id not found in index %q", err, id)
-	}
-
-	sub := strings.SplitN(k, ":", 2)
-	if len(sub) != 2 {
-		return nil, fmt.Errorf("Bad value was stored in index map: %v", k)
-	}
-
-	node := typeColMap(sub[0])
-	if err := c.kv.Get(ctx, sub[0], sub[1], &node); err != nil {
+	_, node, err := c.nodeByID(ctx, id)
+	if err != nil {
 		return nil, err
 	}
 
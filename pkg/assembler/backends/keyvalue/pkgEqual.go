@@ -38,7 +38,8 @@ type pkgEqualStruct struct {
 	DocumentRef   string
 }
 
-func (n *pkgEqualStruct) ID() string { return n.ThisID }
+func (n *pkgEqualStruct) ID() string          { return n.ThisID }
+func (n *pkgEqualStruct) documentRef() string { return n.DocumentRef }
 func (n *pkgEqualStruct) Key() string {
 	return hashKey(strings.Join([]string{
 		fmt.Sprint(n.Pkgs),
@@ -44,7 +44,8 @@ type pointOfContactLink struct {
 	DocumentRef   string
 }
 
-func (n *pointOfContactLink) ID() string { return n.ThisID }
+func (n *pointOfContactLink) ID() string          { return n.ThisID }
+func (n *pointOfContactLink) documentRef() string { return n.DocumentRef }
 func (n *pointOfContactLink) Key() string {
 	return hashKey(strings.Join([]string{
 		n.PackageID,
@@ -491,6 +492,10 @@ func (c *demoClient) pointOfContactIfMatch(ctx context.Context, filter *model.Po
 	if filter != nil && filter.Since != nil && filter.Since.After(link.Since) {
 		return nil, nil
 	}
+	// no match if the link was not yet known at asOf
+	if filter != nil && filter.AsOf != nil && filter.AsOf.Before(link.Since) {
+		return nil, nil
+	}
 
 	found, err := c.buildPointOfContact(ctx, link, filter, false)
 	if err != nil {
@@ -0,0 +1,336 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+// softwareCols are the collections of the software tree nodes, which are
+// garbage collected when a document is retracted and no evidence is left
+// referring to them.
+var softwareCols = map[string]bool{
+	pkgTypeCol:  true,
+	pkgNSCol:    true,
+	pkgNameCol:  true,
+	pkgVerCol:   true,
+	srcTypeCol:  true,
+	srcNSCol:    true,
+	srcNameCol:  true,
+	artCol:      true,
+	builderCol:  true,
+	vulnTypeCol: true,
+	vulnIDCol:   true,
+	licenseCol:  true,
+}
+
+// retractedNode is a node that is removed when a document is retracted.
+type retractedNode struct {
+	coll string
+	n    node
+}
+
+func (c *demoClient) RetractDocument(ctx context.Context, documentRef string, dryRun bool) (*model.RetractDocumentResult, error) {
+	funcName := "RetractDocument"
+	readOnly := dryRun
+	lock(&c.m, readOnly)
+	defer unlock(&c.m, readOnly)
+
+	doc, err := byKeykv[*documentStruct](ctx, docCol, documentKey(documentRef), c)
+	if err != nil {
+		if !errors.Is(err, kv.NotFoundError) {
+			return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+		}
+		doc = nil
+	}
+
+	evidence, orphans, err := c.retractPlan(ctx, documentRef)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+
+	// The result is built before anything is removed, as building the model
+	// nodes needs the nodes they link to.
+	result := &model.RetractDocumentResult{
+		DocumentRef: documentRef,
+		DryRun:      dryRun,
+		Evidence:    []model.Node{},
+		Orphans:     []model.Node{},
+	}
+	if doc != nil {
+		result.Document = c.convDocument(doc)
+	}
+	for _, r := range evidence {
+		out, err := r.n.BuildModelNode(ctx, c)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v :: could not build node: %s", funcName, err)
+		}
+		result.Evidence = append(result.Evidence, out)
+	}
+	for _, r := range orphans {
+		out, err := r.n.BuildModelNode(ctx, c)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v :: could not build node: %s", funcName, err)
+		}
+		result.Orphans = append(result.Orphans, out)
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	if err := c.retract(ctx, append(evidence, orphans...)); err != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+	if doc != nil {
+		if err := c.kv.Remove(ctx, docCol, doc.Key()); err != nil {
+			return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+		}
+		if err := c.kv.Remove(ctx, indexCol, doc.ThisID); err != nil {
+			return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+		}
+	}
+	if err := c.kv.Remove(ctx, docRefCol, documentKey(documentRef)); err != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+	return result, nil
+}
+
+// retractPlan finds the evidence ingested from a document, and the software
+// tree nodes that would be left without any other node linking to them once
+// that evidence is removed. It does not modify the store.
+func (c *demoClient) retractPlan(ctx context.Context, documentRef string) ([]retractedNode, []retractedNode, error) {
+	var refs *documentRefs
+	if err := c.kv.Get(ctx, docRefCol, documentKey(documentRef), &refs); err != nil {
+		if !errors.Is(err, kv.NotFoundError) {
+			return nil, nil, err
+		}
+		refs = &documentRefs{}
+	}
+
+	removed := map[string]bool{}
+	var evidence []retractedNode
+	colls := make([]string, 0, len(refs.Evidence))
+	for coll := range refs.Evidence {
+		colls = append(colls, coll)
+	}
+	sort.Strings(colls)
+	for _, coll := range colls {
+		for _, id := range refs.Evidence[coll] {
+			if removed[id] {
+				continue
+			}
+			_, n, err := c.nodeByID(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			removed[id] = true
+			evidence = append(evidence, retractedNode{coll: coll, n: n})
+		}
+	}
+
+	// The software included in an SBOM does not link back to the SBOM, so
+	// anything a remaining SBOM includes is kept.
+	included, err := c.includedInSBOMs(ctx, removed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allEdges := processUsingOnly(nil)
+	var queue []string
+	for _, r := range evidence {
+		queue = append(queue, r.n.Neighbors(allEdges)...)
+	}
+	var orphans []retractedNode
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if removed[id] || included[id] {
+			continue
+		}
+		coll, n, err := c.nodeByID(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !softwareCols[coll] || !allIn(linkedIDs(n), removed) {
+			continue
+		}
+		removed[id] = true
+		orphans = append(orphans, retractedNode{coll: coll, n: n})
+		// the parent of an orphan may now be an orphan too
+		queue = append(queue, n.Neighbors(allEdges)...)
+	}
+	return evidence, orphans, nil
+}
+
+// includedInSBOMs returns the IDs of the nodes included by the SBOMs that
+// are not being removed.
+func (c *demoClient) includedInSBOMs(ctx context.Context, removed map[string]bool) (map[string]bool, error) {
+	included := map[string]bool{}
+	var done bool
+	scn := c.kv.Keys(hasSBOMCol)
+	for !done {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			sbom, err := byKeykv[*hasSBOMStruct](ctx, hasSBOMCol, k, c)
+			if err != nil {
+				return nil, err
+			}
+			if removed[sbom.ThisID] {
+				continue
+			}
+			for _, id := range sbom.IncludedSoftware {
+				included[id] = true
+			}
+			for _, id := range sbom.IncludedDependencies {
+				included[id] = true
+			}
+			for _, id := range sbom.IncludedOccurrences {
+				included[id] = true
+			}
+		}
+	}
+	return included, nil
+}
+
+// retract removes the nodes from the store, along with the links to them
+// from the nodes that are kept.
+func (c *demoClient) retract(ctx context.Context, nodes []retractedNode) error {
+	removed := map[string]bool{}
+	for _, r := range nodes {
+		removed[r.n.ID()] = true
+	}
+
+	allEdges := processUsingOnly(nil)
+	kept := map[string]bool{}
+	for _, r := range nodes {
+		for _, id := range r.n.Neighbors(allEdges) {
+			if !removed[id] {
+				kept[id] = true
+			}
+		}
+	}
+	// Remaining SBOMs may include evidence from the retracted document
+	// without it linking back to them.
+	var done bool
+	scn := c.kv.Keys(hasSBOMCol)
+	for !done {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			sbom, err := byKeykv[*hasSBOMStruct](ctx, hasSBOMCol, k, c)
+			if err != nil {
+				return err
+			}
+			if !removed[sbom.ThisID] && anyIn(linkedIDs(sbom), removed) {
+				kept[sbom.ThisID] = true
+			}
+		}
+	}
+
+	keptIDs := make([]string, 0, len(kept))
+	for id := range kept {
+		keptIDs = append(keptIDs, id)
+	}
+	sort.Strings(keptIDs)
+	for _, id := range keptIDs {
+		coll, n, err := c.nodeByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		unlinkIDs(n, removed)
+		if err := setkv(ctx, coll, n, c); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range nodes {
+		if err := c.kv.Remove(ctx, r.coll, r.n.Key()); err != nil {
+			return err
+		}
+		if err := c.kv.Remove(ctx, indexCol, r.n.ID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var stringSliceType = reflect.TypeOf([]string(nil))
+
+// linkedIDs returns the IDs held in the []string fields of a node, which
+// are the links to its children and back links to the evidence about it.
+func linkedIDs(n node) []string {
+	var out []string
+	v := reflect.ValueOf(n).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Type() == stringSliceType {
+			out = append(out, v.Field(i).Interface().([]string)...)
+		}
+	}
+	return out
+}
+
+// unlinkIDs removes the given IDs from the []string fields of a node.
+func unlinkIDs(n node, ids map[string]bool) {
+	v := reflect.ValueOf(n).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Type() != stringSliceType {
+			continue
+		}
+		var kept []string
+		for _, id := range f.Interface().([]string) {
+			if !ids[id] {
+				kept = append(kept, id)
+			}
+		}
+		f.Set(reflect.ValueOf(kept))
+	}
+}
+
+func allIn(ids []string, set map[string]bool) bool {
+	for _, id := range ids {
+		if !set[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func anyIn(ids []string, set map[string]bool) bool {
+	for _, id := range ids {
+		if set[id] {
+			return true
+		}
+	}
+	return false
+}
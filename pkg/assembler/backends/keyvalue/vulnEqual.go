@@ -39,7 +39,8 @@ type vulnerabilityEqualLink struct {
 	DocumentRef     string
 }
 
-func (n *vulnerabilityEqualLink) ID() string { return n.ThisID }
+func (n *vulnerabilityEqualLink) ID() string          { return n.ThisID }
+func (n *vulnerabilityEqualLink) documentRef() string { return n.DocumentRef }
 func (n *vulnerabilityEqualLink) Key() string {
 	return hashKey(strings.Join([]string{
 		fmt.Sprint(n.Vulnerabilities),
@@ -41,7 +41,8 @@ type vulnerabilityMetadataLink struct {
 	DocumentRef     string
 }
 
-func (n *vulnerabilityMetadataLink) ID() string { return n.ThisID }
+func (n *vulnerabilityMetadataLink) ID() string          { return n.ThisID }
+func (n *vulnerabilityMetadataLink) documentRef() string { return n.DocumentRef }
 func (n *vulnerabilityMetadataLink) Key() string {
 	return hashKey(strings.Join([]string{
 		n.VulnerabilityID,
@@ -417,6 +418,9 @@ func (c *demoClient) vulnMetadataIfMatch(ctx context.Context, filter *model.Vuln
 	if filter != nil && noMatch(filter.DocumentRef, link.DocumentRef) {
 		return nil, nil
 	}
+	if filter != nil && filter.AsOf != nil && filter.AsOf.Before(link.Timestamp) {
+		return nil, nil
+	}
 
 	foundVulnMetadata, err := c.buildVulnerabilityMetadata(ctx, link, filter, false)
 	if err != nil {
@@ -0,0 +1,42 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrResultLimit is returned by the backends that stop reading the results
+// of a query over the limit set by WithResultLimit.
+var ErrResultLimit = errors.New("the query returns more items than its result limit")
+
+type resultLimitKey struct{}
+
+// WithResultLimit returns a copy of ctx that limits the queries run with it to
+// n items. The backends that support it read at most n+1 items, and return
+// ErrResultLimit rather than the items when there are more than n, so that a
+// query over the limit fails before its results are built. The other
+// backends ignore it.
+func WithResultLimit(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, resultLimitKey{}, n)
+}
+
+// ResultLimit returns the limit set by WithResultLimit, if any.
+func ResultLimit(ctx context.Context) (int, bool) {
+	n, ok := ctx.Value(resultLimitKey{}).(int)
+	return n, ok
+}
@@ -172,6 +172,25 @@ func (c *neo4jClient) IngestLicenses(ctx context.Context, licenses []*model.IDor
 	panic(fmt.Errorf("not implemented: IngestLicenses"))
 }
 
+func (c *neo4jClient) Documents(ctx context.Context, documentSpec *model.DocumentSpec) ([]*model.Document, error) {
+	panic(fmt.Errorf("not implemented: Documents"))
+}
+
+func (c *neo4jClient) DocumentList(ctx context.Context, documentSpec model.DocumentSpec, after *string, first *int) (*model.DocumentConnection, error) {
+	panic(fmt.Errorf("not implemented: DocumentList"))
+}
+
+func (c *neo4jClient) IngestDocument(ctx context.Context, document model.DocumentInputSpec) (string, error) {
+	panic(fmt.Errorf("not implemented: IngestDocument"))
+}
+func (c *neo4jClient) IngestDocuments(ctx context.Context, documents []*model.DocumentInputSpec) ([]string, error) {
+	panic(fmt.Errorf("not implemented: IngestDocuments"))
+}
+
+func (c *neo4jClient) RetractDocument(ctx context.Context, documentRef string, dryRun bool) (*model.RetractDocumentResult, error) {
+	panic(fmt.Errorf("not implemented: RetractDocument"))
+}
+
 func (c *neo4jClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
 	panic(fmt.Errorf("not implemented: CertifyLegalList"))
 }
@@ -0,0 +1,63 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"context"
+	"fmt"
+)
+
+// GlobalTenant is the tenant of the data shared by all the tenants of a
+// deployment, such as OSV vulnerabilities and scorecards. It is the tenant of
+// the callers that are not assigned one, so a deployment without tenants
+// keeps all its data in the global tenant.
+const GlobalTenant = ""
+
+type tenantKey struct{}
+
+// WithTenant returns a copy of ctx that carries the tenant of the caller.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant carried by ctx, or GlobalTenant if
+// there is none.
+func TenantFromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+	return tenant
+}
+
+// MultiTenant is implemented by the backends that keep the graphs of
+// several tenants apart. Such a backend tags the evidence and nodes it
+// ingests with the tenant of the caller, but for the nouns that it shares
+// between all the tenants in the global tenant, and scopes the queries to the
+// tenant of the caller and the global tenant.
+type MultiTenant interface {
+	SupportsTenants() bool
+}
+
+// CheckTenant returns an error if the tenant carried by ctx is not the
+// global tenant and the backend cannot keep it apart from the other tenants.
+func CheckTenant(ctx context.Context, backend Backend) error {
+	tenant := TenantFromContext(ctx)
+	if tenant == GlobalTenant {
+		return nil
+	}
+	if mt, ok := backend.(MultiTenant); ok && mt.SupportsTenants() {
+		return nil
+	}
+	return fmt.Errorf("tenant %q is not supported by this backend, only the global tenant is", tenant)
+}
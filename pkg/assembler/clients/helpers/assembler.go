@@ -108,6 +108,13 @@ func GetAssembler(ctx context.Context, gqlclient graphql.Client) func([]assemble
 				}
 			}
 
+			logger.Infof("assembling Document: %v", len(p.Documents))
+			for _, d := range p.Documents {
+				if _, err := model.IngestDocument(ctx, gqlclient, d); err != nil {
+					return fmt.Errorf("failed document ingest with error: %w", err)
+				}
+			}
+
 			logger.Infof("assembling CertifyScorecard: %v", len(p.CertifyScorecard))
 			for _, v := range p.CertifyScorecard {
 				if err := ingestCertifyScorecard(ctx, gqlclient, v, collectedIDorSrcInputs); err != nil {
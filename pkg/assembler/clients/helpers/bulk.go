@@ -18,6 +18,7 @@ package helpers
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"go.uber.org/zap"
 
@@ -31,6 +32,12 @@ import (
 type AssemblerIngestedIDs struct {
 	HasSBOMIDs []string
 	HasSLSAIDs []string
+	// IDs of the nodes a HasSBOM of the ingested predicates includes, so that
+	// a document ingested in several calls can include them all in its HasSBOM.
+	PackageIDs      []string
+	ArtifactIDs     []string
+	IsDependencyIDs []string
+	IsOccurrenceIDs []string
 }
 
 func GetBulkAssembler(ctx context.Context, logger *zap.SugaredLogger, gqlclient graphql.Client) func([]assembler.AssemblerInput) (*AssemblerIngestedIDs, error) {
@@ -119,6 +126,13 @@ func GetBulkAssembler(ctx context.Context, logger *zap.SugaredLogger, gqlclient
 				return nil, fmt.Errorf("ingestLicenses failed with error: %w", err)
 			}
 
+			// Ingest Documents
+			logger.Infof("assembling Documents: %v", len(p.Documents))
+			if err := ingestDocuments(ctx, gqlclient, p.Documents); err != nil {
+				logger.Errorf("ingestDocuments failed with error: %v", err)
+				rvErr = err
+			}
+
 			logger.Infof("assembling CertifyScorecard: %v", len(p.CertifyScorecard))
 			if err := ingestCertifyScorecards(ctx, gqlclient, p.CertifyScorecard, collectedIDorSrcInputs); err != nil {
 				logger.Errorf("ingestCertifyScorecards failed with error: %v", err)
@@ -207,6 +221,10 @@ func GetBulkAssembler(ctx context.Context, logger *zap.SugaredLogger, gqlclient
 				logger.Errorf("ingestHasSBOMs failed with error: %v", err)
 				rvErr = err
 			}
+			ingestedIDs.PackageIDs = append(ingestedIDs.PackageIDs, packageIDs...)
+			ingestedIDs.ArtifactIDs = append(ingestedIDs.ArtifactIDs, artifactIDs...)
+			ingestedIDs.IsDependencyIDs = append(ingestedIDs.IsDependencyIDs, isDependenciesIDs...)
+			ingestedIDs.IsOccurrenceIDs = append(ingestedIDs.IsOccurrenceIDs, isOccurrencesIDs...)
 
 			logger.Infof("assembling VEX : %v", len(p.Vex))
 			if err := ingestVEXs(ctx, gqlclient, p.Vex, collectedIDorPkgInputs, collectedIDorArtInputs, collectedIDorVulnInputs); err != nil {
@@ -236,6 +254,17 @@ func GetBulkAssembler(ctx context.Context, logger *zap.SugaredLogger, gqlclient
 	}
 }
 
+// ingestDocuments records the provenance of the documents the predicates were parsed from
+func ingestDocuments(ctx context.Context, client graphql.Client, documents []model.DocumentInputSpec) error {
+	if len(documents) == 0 {
+		return nil
+	}
+	if _, err := model.IngestDocuments(ctx, client, documents); err != nil {
+		return fmt.Errorf("IngestDocuments failed with error: %w", err)
+	}
+	return nil
+}
+
 // ingestPackages takes in the map of IDorPkgInput which contains the pkgInputSpec and outputs a map that contains the pkgIDs to be used for verb ingestion
 func ingestPackages(ctx context.Context, client graphql.Client, packageInputMap map[string]*model.IDorPkgInput) (map[string]*model.IDorPkgInput, error) {
 	var keys []string
@@ -741,7 +770,7 @@ func ingestHasSBOMs(ctx context.Context, client graphql.Client, hs []assembler.H
 				return fmt.Errorf("failed to find ingested Package ID for hasSBOM: %s", helpers.GetKey[*model.PkgInputSpec, helpers.PkgIds](ingest.Pkg, helpers.PkgClientKey).VersionId)
 			}
 			pkgSBOMs = append(pkgSBOMs, *ingest.HasSBOM)
-			pkgIncludes = append(pkgIncludes, includes)
+			pkgIncludes = append(pkgIncludes, MergeHasSBOMIncludes(includes, ingest.Includes))
 		} else {
 			if artID, found := artInputMap[helpers.GetKey[*model.ArtifactInputSpec, string](ingest.Artifact, helpers.ArtifactClientKey)]; found {
 				artIDs = append(artIDs, *artID)
@@ -749,7 +778,7 @@ func ingestHasSBOMs(ctx context.Context, client graphql.Client, hs []assembler.H
 				return fmt.Errorf("failed to find ingested artifact ID for hasSBOM: %s", helpers.GetKey[*model.ArtifactInputSpec, string](ingest.Artifact, helpers.ArtifactClientKey))
 			}
 			artSBOMs = append(artSBOMs, *ingest.HasSBOM)
-			artIncludes = append(artIncludes, includes)
+			artIncludes = append(artIncludes, MergeHasSBOMIncludes(includes, ingest.Includes))
 		}
 	}
 	if len(artIDs) > 0 {
@@ -769,6 +798,21 @@ func ingestHasSBOMs(ctx context.Context, client graphql.Client, hs []assembler.H
 	return nil
 }
 
+// MergeHasSBOMIncludes returns the includes of a batch of predicates along with
+// the includes set on a HasSBOM ingest, which are the nodes of the same
+// document ingested in earlier batches.
+func MergeHasSBOMIncludes(batch model.HasSBOMIncludesInputSpec, earlier *model.HasSBOMIncludesInputSpec) model.HasSBOMIncludesInputSpec {
+	if earlier == nil {
+		return batch
+	}
+	return model.HasSBOMIncludesInputSpec{
+		Packages:     slices.Concat(earlier.Packages, batch.Packages),
+		Artifacts:    slices.Concat(earlier.Artifacts, batch.Artifacts),
+		Dependencies: slices.Concat(earlier.Dependencies, batch.Dependencies),
+		Occurrences:  slices.Concat(earlier.Occurrences, batch.Occurrences),
+	}
+}
+
 func ingestPointOfContacts(ctx context.Context, client graphql.Client, poc []assembler.PointOfContactIngest, packageInputMap map[string]*model.IDorPkgInput,
 	artInputMap map[string]*model.IDorArtifactInput, sourceInputMap map[string]*model.IDorSourceInput) error {
 
@@ -621,7 +621,7 @@ func (ec *executionContext) unmarshalInputCertifyScorecardSpec(ctx context.Conte
 		asMap["checks"] = []any{}
 	}
 
-	fieldsInOrder := [...]string{"id", "source", "timeScanned", "aggregateScore", "checks", "scorecardVersion", "scorecardCommit", "origin", "collector", "documentRef"}
+	fieldsInOrder := [...]string{"id", "source", "timeScanned", "asOf", "aggregateScore", "checks", "scorecardVersion", "scorecardCommit", "origin", "collector", "documentRef"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -649,6 +649,13 @@ func (ec *executionContext) unmarshalInputCertifyScorecardSpec(ctx context.Conte
 				return it, err
 			}
 			it.TimeScanned = data
+		case "asOf":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AsOf = data
 		case "aggregateScore":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("aggregateScore"))
 			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
@@ -1363,6 +1370,13 @@ func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) marshalOCertifyScorecard2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyScorecard(ctx context.Context, sel ast.SelectionSet, v *model.CertifyScorecard) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CertifyScorecard(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOCertifyScorecardConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyScorecardConnection(ctx context.Context, sel ast.SelectionSet, v *model.CertifyScorecardConnection) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
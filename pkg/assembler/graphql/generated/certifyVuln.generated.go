@@ -591,7 +591,7 @@ func (ec *executionContext) unmarshalInputCertifyVulnSpec(ctx context.Context, o
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "package", "vulnerability", "timeScanned", "dbUri", "dbVersion", "scannerUri", "scannerVersion", "origin", "collector", "documentRef"}
+	fieldsInOrder := [...]string{"id", "package", "vulnerability", "timeScanned", "asOf", "dbUri", "dbVersion", "scannerUri", "scannerVersion", "origin", "collector", "documentRef"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -626,6 +626,13 @@ func (ec *executionContext) unmarshalInputCertifyVulnSpec(ctx context.Context, o
 				return it, err
 			}
 			it.TimeScanned = data
+		case "asOf":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AsOf = data
 		case "dbUri":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dbUri"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
@@ -990,6 +997,10 @@ func (ec *executionContext) _ScanMetadata(ctx context.Context, sel ast.Selection
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNCertifyVuln2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVuln(ctx context.Context, sel ast.SelectionSet, v model.CertifyVuln) graphql.Marshaler {
+	return ec._CertifyVuln(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNCertifyVuln2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVulnᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CertifyVuln) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -549,7 +549,7 @@ func (ec *executionContext) unmarshalInputPointOfContactSpec(ctx context.Context
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "subject", "email", "info", "since", "justification", "origin", "collector", "documentRef"}
+	fieldsInOrder := [...]string{"id", "subject", "email", "info", "since", "asOf", "justification", "origin", "collector", "documentRef"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -591,6 +591,13 @@ func (ec *executionContext) unmarshalInputPointOfContactSpec(ctx context.Context
 				return it, err
 			}
 			it.Since = data
+		case "asOf":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AsOf = data
 		case "justification":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("justification"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
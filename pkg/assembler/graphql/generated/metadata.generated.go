@@ -549,7 +549,7 @@ func (ec *executionContext) unmarshalInputHasMetadataSpec(ctx context.Context, o
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "subject", "since", "key", "value", "justification", "origin", "collector", "documentRef"}
+	fieldsInOrder := [...]string{"id", "subject", "since", "asOf", "key", "value", "justification", "origin", "collector", "documentRef"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -577,6 +577,13 @@ func (ec *executionContext) unmarshalInputHasMetadataSpec(ctx context.Context, o
 				return it, err
 			}
 			it.Since = data
+		case "asOf":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AsOf = data
 		case "key":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
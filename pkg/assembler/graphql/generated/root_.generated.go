@@ -36,6 +36,7 @@ type ResolverRoot interface {
 	Mutation() MutationResolver
 	Package() PackageResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -193,6 +194,29 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	Document struct {
+		BlobKey       func(childComplexity int) int
+		Collector     func(childComplexity int) int
+		DocumentType  func(childComplexity int) int
+		Format        func(childComplexity int) int
+		ID            func(childComplexity int) int
+		IngestedAt    func(childComplexity int) int
+		ParserVersion func(childComplexity int) int
+		Sha256        func(childComplexity int) int
+		Source        func(childComplexity int) int
+	}
+
+	DocumentConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	DocumentEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	FindSoftwareConnection struct {
 		Edges      func(childComplexity int) int
 		PageInfo   func(childComplexity int) int
@@ -387,6 +411,8 @@ type ComplexityRoot struct {
 		IngestCertifyVulns              func(childComplexity int, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) int
 		IngestDependencies              func(childComplexity int, pkgs []*model.IDorPkgInput, depPkgs []*model.IDorPkgInput, dependencies []*model.IsDependencyInputSpec) int
 		IngestDependency                func(childComplexity int, pkg model.IDorPkgInput, depPkg model.IDorPkgInput, dependency model.IsDependencyInputSpec) int
+		IngestDocument                  func(childComplexity int, document model.DocumentInputSpec) int
+		IngestDocuments                 func(childComplexity int, documents []*model.DocumentInputSpec) int
 		IngestHasMetadata               func(childComplexity int, subject model.PackageSourceOrArtifactInput, pkgMatchType model.MatchFlags, hasMetadata model.HasMetadataInputSpec) int
 		IngestHasSBOMs                  func(childComplexity int, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) int
 		IngestHasSbom                   func(childComplexity int, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) int
@@ -417,6 +443,7 @@ type ComplexityRoot struct {
 		IngestVulnerabilities           func(childComplexity int, vulns []*model.IDorVulnerabilityInput) int
 		IngestVulnerability             func(childComplexity int, vuln model.IDorVulnerabilityInput) int
 		IngestVulnerabilityMetadata     func(childComplexity int, vulnerability model.IDorVulnerabilityInput, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) int
+		RetractDocument                 func(childComplexity int, documentRef string, dryRun bool) int
 	}
 
 	NeighborConnection struct {
@@ -547,7 +574,11 @@ type ComplexityRoot struct {
 		CertifyVEXStatementList        func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) int
 		CertifyVuln                    func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec) int
 		CertifyVulnList                func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) int
+		DocumentContent                func(childComplexity int, documentID string) int
+		DocumentList                   func(childComplexity int, documentSpec model.DocumentSpec, after *string, first *int) int
+		Documents                      func(childComplexity int, documentSpec model.DocumentSpec) int
 		FindPackagesThatNeedScanning   func(childComplexity int, queryType model.QueryType, lastScan *int) int
+		FindReachableVulnerabilities   func(childComplexity int, root model.PackageOrArtifactSpec, maxDepth *int, dependencyType *model.DependencyType) int
 		FindSoftware                   func(childComplexity int, searchText string) int
 		FindSoftwareList               func(childComplexity int, searchText string, after *string, first *int) int
 		HasMetadata                    func(childComplexity int, hasMetadataSpec model.HasMetadataSpec) int
@@ -578,6 +609,7 @@ type ComplexityRoot struct {
 		PointOfContact                 func(childComplexity int, pointOfContactSpec model.PointOfContactSpec) int
 		PointOfContactList             func(childComplexity int, pointOfContactSpec model.PointOfContactSpec, after *string, first *int) int
 		QueryPackagesListForScan       func(childComplexity int, pkgIDs []string, after *string, first *int) int
+		SbomDiff                       func(childComplexity int, before string, after string) int
 		Scorecards                     func(childComplexity int, scorecardSpec model.CertifyScorecardSpec) int
 		ScorecardsList                 func(childComplexity int, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) int
 		Sources                        func(childComplexity int, sourceSpec model.SourceSpec) int
@@ -590,6 +622,22 @@ type ComplexityRoot struct {
 		VulnerabilityMetadataList      func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, after *string, first *int) int
 	}
 
+	ReachableVulnerability struct {
+		CertifyVuln   func(childComplexity int) int
+		Depth         func(childComplexity int) int
+		NotAffected   func(childComplexity int) int
+		Path          func(childComplexity int) int
+		VexStatements func(childComplexity int) int
+	}
+
+	RetractDocumentResult struct {
+		Document    func(childComplexity int) int
+		DocumentRef func(childComplexity int) int
+		DryRun      func(childComplexity int) int
+		Evidence    func(childComplexity int) int
+		Orphans     func(childComplexity int) int
+	}
+
 	SLSA struct {
 		BuildType     func(childComplexity int) int
 		BuiltBy       func(childComplexity int) int
@@ -608,6 +656,52 @@ type ComplexityRoot struct {
 		Value func(childComplexity int) int
 	}
 
+	SbomArtifactDiff struct {
+		Artifact func(childComplexity int) int
+		Change   func(childComplexity int) int
+	}
+
+	SbomDependencyDiff struct {
+		Change       func(childComplexity int) int
+		IsDependency func(childComplexity int) int
+	}
+
+	SbomDiff struct {
+		After           func(childComplexity int) int
+		Artifacts       func(childComplexity int) int
+		Before          func(childComplexity int) int
+		Dependencies    func(childComplexity int) int
+		Licenses        func(childComplexity int) int
+		Packages        func(childComplexity int) int
+		Scorecards      func(childComplexity int) int
+		Vulnerabilities func(childComplexity int) int
+	}
+
+	SbomLicenseDiff struct {
+		After        func(childComplexity int) int
+		AfterLegals  func(childComplexity int) int
+		Before       func(childComplexity int) int
+		BeforeLegals func(childComplexity int) int
+	}
+
+	SbomPackageDiff struct {
+		After  func(childComplexity int) int
+		Before func(childComplexity int) int
+		Change func(childComplexity int) int
+	}
+
+	SbomScorecardDiff struct {
+		After   func(childComplexity int) int
+		Before  func(childComplexity int) int
+		Change  func(childComplexity int) int
+		Package func(childComplexity int) int
+	}
+
+	SbomVulnerabilityDiff struct {
+		CertifyVuln func(childComplexity int) int
+		Change      func(childComplexity int) int
+	}
+
 	ScanMetadata struct {
 		Collector      func(childComplexity int) int
 		DbURI          func(childComplexity int) int
@@ -676,6 +770,13 @@ type ComplexityRoot struct {
 		Namespace func(childComplexity int) int
 	}
 
+	Subscription struct {
+		CertifyBadIngested          func(childComplexity int, certifyBadSpec model.CertifyBadSpec) int
+		CertifyVEXStatementIngested func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec) int
+		CertifyVulnIngested         func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec) int
+		HasSBOMIngested             func(childComplexity int, hasSBOMSpec model.HasSBOMSpec) int
+	}
+
 	VEXConnection struct {
 		Edges      func(childComplexity int) int
 		PageInfo   func(childComplexity int) int
@@ -1364,6 +1465,104 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CertifyVulnEdge.Node(childComplexity), true
 
+	case "Document.blobKey":
+		if e.complexity.Document.BlobKey == nil {
+			break
+		}
+
+		return e.complexity.Document.BlobKey(childComplexity), true
+
+	case "Document.collector":
+		if e.complexity.Document.Collector == nil {
+			break
+		}
+
+		return e.complexity.Document.Collector(childComplexity), true
+
+	case "Document.documentType":
+		if e.complexity.Document.DocumentType == nil {
+			break
+		}
+
+		return e.complexity.Document.DocumentType(childComplexity), true
+
+	case "Document.format":
+		if e.complexity.Document.Format == nil {
+			break
+		}
+
+		return e.complexity.Document.Format(childComplexity), true
+
+	case "Document.id":
+		if e.complexity.Document.ID == nil {
+			break
+		}
+
+		return e.complexity.Document.ID(childComplexity), true
+
+	case "Document.ingestedAt":
+		if e.complexity.Document.IngestedAt == nil {
+			break
+		}
+
+		return e.complexity.Document.IngestedAt(childComplexity), true
+
+	case "Document.parserVersion":
+		if e.complexity.Document.ParserVersion == nil {
+			break
+		}
+
+		return e.complexity.Document.ParserVersion(childComplexity), true
+
+	case "Document.sha256":
+		if e.complexity.Document.Sha256 == nil {
+			break
+		}
+
+		return e.complexity.Document.Sha256(childComplexity), true
+
+	case "Document.source":
+		if e.complexity.Document.Source == nil {
+			break
+		}
+
+		return e.complexity.Document.Source(childComplexity), true
+
+	case "DocumentConnection.edges":
+		if e.complexity.DocumentConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.DocumentConnection.Edges(childComplexity), true
+
+	case "DocumentConnection.pageInfo":
+		if e.complexity.DocumentConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.DocumentConnection.PageInfo(childComplexity), true
+
+	case "DocumentConnection.totalCount":
+		if e.complexity.DocumentConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.DocumentConnection.TotalCount(childComplexity), true
+
+	case "DocumentEdge.cursor":
+		if e.complexity.DocumentEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.DocumentEdge.Cursor(childComplexity), true
+
+	case "DocumentEdge.node":
+		if e.complexity.DocumentEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.DocumentEdge.Node(childComplexity), true
+
 	case "FindSoftwareConnection.edges":
 		if e.complexity.FindSoftwareConnection.Edges == nil {
 			break
@@ -2275,6 +2474,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.IngestDependency(childComplexity, args["pkg"].(model.IDorPkgInput), args["depPkg"].(model.IDorPkgInput), args["dependency"].(model.IsDependencyInputSpec)), true
 
+	case "Mutation.ingestDocument":
+		if e.complexity.Mutation.IngestDocument == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestDocument_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestDocument(childComplexity, args["document"].(model.DocumentInputSpec)), true
+
+	case "Mutation.ingestDocuments":
+		if e.complexity.Mutation.IngestDocuments == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestDocuments_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestDocuments(childComplexity, args["documents"].([]*model.DocumentInputSpec)), true
+
 	case "Mutation.ingestHasMetadata":
 		if e.complexity.Mutation.IngestHasMetadata == nil {
 			break
@@ -2635,6 +2858,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.IngestVulnerabilityMetadata(childComplexity, args["vulnerability"].(model.IDorVulnerabilityInput), args["vulnerabilityMetadata"].(model.VulnerabilityMetadataInputSpec)), true
 
+	case "Mutation.retractDocument":
+		if e.complexity.Mutation.RetractDocument == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_retractDocument_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RetractDocument(childComplexity, args["documentRef"].(string), args["dryRun"].(bool)), true
+
 	case "NeighborConnection.edges":
 		if e.complexity.NeighborConnection.Edges == nil {
 			break
@@ -3257,6 +3492,42 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.CertifyVulnList(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec), args["after"].(*string), args["first"].(*int)), true
 
+	case "Query.documentContent":
+		if e.complexity.Query.DocumentContent == nil {
+			break
+		}
+
+		args, err := ec.field_Query_documentContent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DocumentContent(childComplexity, args["documentID"].(string)), true
+
+	case "Query.documentList":
+		if e.complexity.Query.DocumentList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_documentList_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DocumentList(childComplexity, args["documentSpec"].(model.DocumentSpec), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.documents":
+		if e.complexity.Query.Documents == nil {
+			break
+		}
+
+		args, err := ec.field_Query_documents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Documents(childComplexity, args["documentSpec"].(model.DocumentSpec)), true
+
 	case "Query.findPackagesThatNeedScanning":
 		if e.complexity.Query.FindPackagesThatNeedScanning == nil {
 			break
@@ -3269,6 +3540,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.FindPackagesThatNeedScanning(childComplexity, args["queryType"].(model.QueryType), args["lastScan"].(*int)), true
 
+	case "Query.findReachableVulnerabilities":
+		if e.complexity.Query.FindReachableVulnerabilities == nil {
+			break
+		}
+
+		args, err := ec.field_Query_findReachableVulnerabilities_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FindReachableVulnerabilities(childComplexity, args["root"].(model.PackageOrArtifactSpec), args["maxDepth"].(*int), args["dependencyType"].(*model.DependencyType)), true
+
 	case "Query.findSoftware":
 		if e.complexity.Query.FindSoftware == nil {
 			break
@@ -3629,6 +3912,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.QueryPackagesListForScan(childComplexity, args["pkgIDs"].([]string), args["after"].(*string), args["first"].(*int)), true
 
+	case "Query.sbomDiff":
+		if e.complexity.Query.SbomDiff == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sbomDiff_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SbomDiff(childComplexity, args["before"].(string), args["after"].(string)), true
+
 	case "Query.scorecards":
 		if e.complexity.Query.Scorecards == nil {
 			break
@@ -3749,6 +4044,76 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.VulnerabilityMetadataList(childComplexity, args["vulnerabilityMetadataSpec"].(model.VulnerabilityMetadataSpec), args["after"].(*string), args["first"].(*int)), true
 
+	case "ReachableVulnerability.certifyVuln":
+		if e.complexity.ReachableVulnerability.CertifyVuln == nil {
+			break
+		}
+
+		return e.complexity.ReachableVulnerability.CertifyVuln(childComplexity), true
+
+	case "ReachableVulnerability.depth":
+		if e.complexity.ReachableVulnerability.Depth == nil {
+			break
+		}
+
+		return e.complexity.ReachableVulnerability.Depth(childComplexity), true
+
+	case "ReachableVulnerability.notAffected":
+		if e.complexity.ReachableVulnerability.NotAffected == nil {
+			break
+		}
+
+		return e.complexity.ReachableVulnerability.NotAffected(childComplexity), true
+
+	case "ReachableVulnerability.path":
+		if e.complexity.ReachableVulnerability.Path == nil {
+			break
+		}
+
+		return e.complexity.ReachableVulnerability.Path(childComplexity), true
+
+	case "ReachableVulnerability.vexStatements":
+		if e.complexity.ReachableVulnerability.VexStatements == nil {
+			break
+		}
+
+		return e.complexity.ReachableVulnerability.VexStatements(childComplexity), true
+
+	case "RetractDocumentResult.document":
+		if e.complexity.RetractDocumentResult.Document == nil {
+			break
+		}
+
+		return e.complexity.RetractDocumentResult.Document(childComplexity), true
+
+	case "RetractDocumentResult.documentRef":
+		if e.complexity.RetractDocumentResult.DocumentRef == nil {
+			break
+		}
+
+		return e.complexity.RetractDocumentResult.DocumentRef(childComplexity), true
+
+	case "RetractDocumentResult.dryRun":
+		if e.complexity.RetractDocumentResult.DryRun == nil {
+			break
+		}
+
+		return e.complexity.RetractDocumentResult.DryRun(childComplexity), true
+
+	case "RetractDocumentResult.evidence":
+		if e.complexity.RetractDocumentResult.Evidence == nil {
+			break
+		}
+
+		return e.complexity.RetractDocumentResult.Evidence(childComplexity), true
+
+	case "RetractDocumentResult.orphans":
+		if e.complexity.RetractDocumentResult.Orphans == nil {
+			break
+		}
+
+		return e.complexity.RetractDocumentResult.Orphans(childComplexity), true
+
 	case "SLSA.buildType":
 		if e.complexity.SLSA.BuildType == nil {
 			break
@@ -3798,40 +4163,215 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SLSA.Origin(childComplexity), true
 
-	case "SLSA.slsaPredicate":
-		if e.complexity.SLSA.SlsaPredicate == nil {
+	case "SLSA.slsaPredicate":
+		if e.complexity.SLSA.SlsaPredicate == nil {
+			break
+		}
+
+		return e.complexity.SLSA.SlsaPredicate(childComplexity), true
+
+	case "SLSA.slsaVersion":
+		if e.complexity.SLSA.SlsaVersion == nil {
+			break
+		}
+
+		return e.complexity.SLSA.SlsaVersion(childComplexity), true
+
+	case "SLSA.startedOn":
+		if e.complexity.SLSA.StartedOn == nil {
+			break
+		}
+
+		return e.complexity.SLSA.StartedOn(childComplexity), true
+
+	case "SLSAPredicate.key":
+		if e.complexity.SLSAPredicate.Key == nil {
+			break
+		}
+
+		return e.complexity.SLSAPredicate.Key(childComplexity), true
+
+	case "SLSAPredicate.value":
+		if e.complexity.SLSAPredicate.Value == nil {
+			break
+		}
+
+		return e.complexity.SLSAPredicate.Value(childComplexity), true
+
+	case "SbomArtifactDiff.artifact":
+		if e.complexity.SbomArtifactDiff.Artifact == nil {
+			break
+		}
+
+		return e.complexity.SbomArtifactDiff.Artifact(childComplexity), true
+
+	case "SbomArtifactDiff.change":
+		if e.complexity.SbomArtifactDiff.Change == nil {
+			break
+		}
+
+		return e.complexity.SbomArtifactDiff.Change(childComplexity), true
+
+	case "SbomDependencyDiff.change":
+		if e.complexity.SbomDependencyDiff.Change == nil {
+			break
+		}
+
+		return e.complexity.SbomDependencyDiff.Change(childComplexity), true
+
+	case "SbomDependencyDiff.isDependency":
+		if e.complexity.SbomDependencyDiff.IsDependency == nil {
+			break
+		}
+
+		return e.complexity.SbomDependencyDiff.IsDependency(childComplexity), true
+
+	case "SbomDiff.after":
+		if e.complexity.SbomDiff.After == nil {
+			break
+		}
+
+		return e.complexity.SbomDiff.After(childComplexity), true
+
+	case "SbomDiff.artifacts":
+		if e.complexity.SbomDiff.Artifacts == nil {
+			break
+		}
+
+		return e.complexity.SbomDiff.Artifacts(childComplexity), true
+
+	case "SbomDiff.before":
+		if e.complexity.SbomDiff.Before == nil {
+			break
+		}
+
+		return e.complexity.SbomDiff.Before(childComplexity), true
+
+	case "SbomDiff.dependencies":
+		if e.complexity.SbomDiff.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.SbomDiff.Dependencies(childComplexity), true
+
+	case "SbomDiff.licenses":
+		if e.complexity.SbomDiff.Licenses == nil {
+			break
+		}
+
+		return e.complexity.SbomDiff.Licenses(childComplexity), true
+
+	case "SbomDiff.packages":
+		if e.complexity.SbomDiff.Packages == nil {
+			break
+		}
+
+		return e.complexity.SbomDiff.Packages(childComplexity), true
+
+	case "SbomDiff.scorecards":
+		if e.complexity.SbomDiff.Scorecards == nil {
+			break
+		}
+
+		return e.complexity.SbomDiff.Scorecards(childComplexity), true
+
+	case "SbomDiff.vulnerabilities":
+		if e.complexity.SbomDiff.Vulnerabilities == nil {
+			break
+		}
+
+		return e.complexity.SbomDiff.Vulnerabilities(childComplexity), true
+
+	case "SbomLicenseDiff.after":
+		if e.complexity.SbomLicenseDiff.After == nil {
+			break
+		}
+
+		return e.complexity.SbomLicenseDiff.After(childComplexity), true
+
+	case "SbomLicenseDiff.afterLegals":
+		if e.complexity.SbomLicenseDiff.AfterLegals == nil {
+			break
+		}
+
+		return e.complexity.SbomLicenseDiff.AfterLegals(childComplexity), true
+
+	case "SbomLicenseDiff.before":
+		if e.complexity.SbomLicenseDiff.Before == nil {
+			break
+		}
+
+		return e.complexity.SbomLicenseDiff.Before(childComplexity), true
+
+	case "SbomLicenseDiff.beforeLegals":
+		if e.complexity.SbomLicenseDiff.BeforeLegals == nil {
+			break
+		}
+
+		return e.complexity.SbomLicenseDiff.BeforeLegals(childComplexity), true
+
+	case "SbomPackageDiff.after":
+		if e.complexity.SbomPackageDiff.After == nil {
+			break
+		}
+
+		return e.complexity.SbomPackageDiff.After(childComplexity), true
+
+	case "SbomPackageDiff.before":
+		if e.complexity.SbomPackageDiff.Before == nil {
+			break
+		}
+
+		return e.complexity.SbomPackageDiff.Before(childComplexity), true
+
+	case "SbomPackageDiff.change":
+		if e.complexity.SbomPackageDiff.Change == nil {
+			break
+		}
+
+		return e.complexity.SbomPackageDiff.Change(childComplexity), true
+
+	case "SbomScorecardDiff.after":
+		if e.complexity.SbomScorecardDiff.After == nil {
+			break
+		}
+
+		return e.complexity.SbomScorecardDiff.After(childComplexity), true
+
+	case "SbomScorecardDiff.before":
+		if e.complexity.SbomScorecardDiff.Before == nil {
 			break
 		}
 
-		return e.complexity.SLSA.SlsaPredicate(childComplexity), true
+		return e.complexity.SbomScorecardDiff.Before(childComplexity), true
 
-	case "SLSA.slsaVersion":
-		if e.complexity.SLSA.SlsaVersion == nil {
+	case "SbomScorecardDiff.change":
+		if e.complexity.SbomScorecardDiff.Change == nil {
 			break
 		}
 
-		return e.complexity.SLSA.SlsaVersion(childComplexity), true
+		return e.complexity.SbomScorecardDiff.Change(childComplexity), true
 
-	case "SLSA.startedOn":
-		if e.complexity.SLSA.StartedOn == nil {
+	case "SbomScorecardDiff.package":
+		if e.complexity.SbomScorecardDiff.Package == nil {
 			break
 		}
 
-		return e.complexity.SLSA.StartedOn(childComplexity), true
+		return e.complexity.SbomScorecardDiff.Package(childComplexity), true
 
-	case "SLSAPredicate.key":
-		if e.complexity.SLSAPredicate.Key == nil {
+	case "SbomVulnerabilityDiff.certifyVuln":
+		if e.complexity.SbomVulnerabilityDiff.CertifyVuln == nil {
 			break
 		}
 
-		return e.complexity.SLSAPredicate.Key(childComplexity), true
+		return e.complexity.SbomVulnerabilityDiff.CertifyVuln(childComplexity), true
 
-	case "SLSAPredicate.value":
-		if e.complexity.SLSAPredicate.Value == nil {
+	case "SbomVulnerabilityDiff.change":
+		if e.complexity.SbomVulnerabilityDiff.Change == nil {
 			break
 		}
 
-		return e.complexity.SLSAPredicate.Value(childComplexity), true
+		return e.complexity.SbomVulnerabilityDiff.Change(childComplexity), true
 
 	case "ScanMetadata.collector":
 		if e.complexity.ScanMetadata.Collector == nil {
@@ -4099,6 +4639,54 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SourceNamespace.Namespace(childComplexity), true
 
+	case "Subscription.CertifyBadIngested":
+		if e.complexity.Subscription.CertifyBadIngested == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_CertifyBadIngested_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CertifyBadIngested(childComplexity, args["certifyBadSpec"].(model.CertifyBadSpec)), true
+
+	case "Subscription.CertifyVEXStatementIngested":
+		if e.complexity.Subscription.CertifyVEXStatementIngested == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_CertifyVEXStatementIngested_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CertifyVEXStatementIngested(childComplexity, args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec)), true
+
+	case "Subscription.CertifyVulnIngested":
+		if e.complexity.Subscription.CertifyVulnIngested == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_CertifyVulnIngested_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CertifyVulnIngested(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec)), true
+
+	case "Subscription.HasSBOMIngested":
+		if e.complexity.Subscription.HasSBOMIngested == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_HasSBOMIngested_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.HasSBOMIngested(childComplexity, args["hasSBOMSpec"].(model.HasSBOMSpec)), true
+
 	case "VEXConnection.edges":
 		if e.complexity.VEXConnection.Edges == nil {
 			break
@@ -4407,6 +4995,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputCertifyScorecardSpec,
 		ec.unmarshalInputCertifyVEXStatementSpec,
 		ec.unmarshalInputCertifyVulnSpec,
+		ec.unmarshalInputDocumentInputSpec,
+		ec.unmarshalInputDocumentSpec,
 		ec.unmarshalInputHasMetadataInputSpec,
 		ec.unmarshalInputHasMetadataSpec,
 		ec.unmarshalInputHasSBOMIncludesInputSpec,
@@ -4508,6 +5098,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -4861,12 +5468,16 @@ and optionally a tag and a commit.
 
 If KnownSince is specified, the returned value will be after or equal to the specified time.
 Any nodes time that is before KnownSince is excluded.
+
+If asOf is specified, only attestations with a knownSince at or before asOf are
+returned. This allows querying the graph as it was known at that time.
 """
 input CertifyBadSpec {
   id: ID
   subject: PackageSourceOrArtifactSpec
   justification: String
   knownSince: Time
+  asOf: Time
   origin: String
   collector: String
   documentRef: String
@@ -4932,6 +5543,11 @@ extend type Query {
   CertifyBadList(certifyBadSpec: CertifyBadSpec!, after: ID, first: Int): CertifyBadConnection
 }
 
+extend type Subscription {
+  "Returns the CertifyBad attestations matching a filter as they are ingested."
+  CertifyBadIngested(certifyBadSpec: CertifyBadSpec!): CertifyBad!
+}
+
 extend type Mutation {
   "Adds a certification that a package, source or artifact is considered bad. The returned ID can be empty string."
   ingestCertifyBad(
@@ -5008,12 +5624,16 @@ and optionally a tag and a commit.
 
 If KnownSince is specified, the returned value will be after or equal to the specified time.
 Any nodes time that is before KnownSince is excluded.
+
+If asOf is specified, only attestations with a knownSince at or before asOf are
+returned. This allows querying the graph as it was known at that time.
 """
 input CertifyGoodSpec {
   id: ID
   subject: PackageSourceOrArtifactSpec
   justification: String
   knownSince: Time
+  asOf: Time
   origin: String
   collector: String
   documentRef: String
@@ -5146,6 +5766,9 @@ return in a query.
 
 Specifying just the package allows to query for all certifications associated
 with the package.
+
+If asOf is specified, only attestations with a timeScanned at or before asOf are
+returned. This allows querying the graph as it was known at that time.
 """
 input CertifyLegalSpec {
   id: ID
@@ -5157,6 +5780,7 @@ input CertifyLegalSpec {
   attribution: String
   justification: String
   timeScanned: Time
+  asOf: Time
   origin: String
   collector: String
   documentRef: String
@@ -5307,11 +5931,17 @@ type ScorecardCheck {
   score: Int!
 }
 
-"CertifyScorecardSpec allows filtering the list of Scorecards to return."
+"""
+CertifyScorecardSpec allows filtering the list of Scorecards to return.
+
+If asOf is specified, only attestations with a timeScanned at or before asOf are
+returned. This allows querying the graph as it was known at that time.
+"""
 input CertifyScorecardSpec {
   id: ID
   source: SourceSpec
   timeScanned: Time
+  asOf: Time
   aggregateScore: Float
   checks: [ScorecardCheckSpec!] = []
   scorecardVersion: String
@@ -5495,6 +6125,9 @@ return in a query.
 Only one subject type (package or artifact) and one vulnerability may be specified.
 
 Note that setting noVuln vulnerability type is invalid for VEX statements!
+
+If asOf is specified, only attestations with a knownSince at or before asOf are
+returned. This allows querying the graph as it was known at that time.
 """
 input CertifyVEXStatementSpec {
   id: ID
@@ -5505,6 +6138,7 @@ input CertifyVEXStatementSpec {
   statement: String
   statusNotes: String
   knownSince: Time
+  asOf: Time
   origin: String
   collector: String
   documentRef: String
@@ -5558,6 +6192,16 @@ extend type Query {
   CertifyVEXStatementList(certifyVEXStatementSpec: CertifyVEXStatementSpec!, after: ID, first: Int): VEXConnection
 }
 
+extend type Subscription {
+  """
+  Returns the VEX certifications matching the input filter as they are
+  ingested.
+  """
+  CertifyVEXStatementIngested(
+    certifyVEXStatementSpec: CertifyVEXStatementSpec!
+  ): CertifyVEXStatement!
+}
+
 extend type Mutation {
   "Adds a VEX certification for a package. The returned ID can be empty string."
   ingestVEXStatement(
@@ -5642,12 +6286,16 @@ with the package.
 
 Only one vulnerability (or NoVuln vulnerability type) may be
 specified.
+
+If asOf is specified, only attestations with a timeScanned at or before asOf are
+returned. This allows querying the graph as it was known at that time.
 """
 input CertifyVulnSpec {
   id: ID
   package: PkgSpec
   vulnerability: VulnerabilitySpec
   timeScanned: Time
+  asOf: Time
   dbUri: String
   dbVersion: String
   scannerUri: String
@@ -5708,6 +6356,14 @@ extend type Query {
   BatchQueryPkgIDCertifyVuln(pkgIDs: [ID!]!): [CertifyVuln!]!
 }
 
+extend type Subscription {
+  """
+  Returns the vulnerability certifications matching the input filter as they
+  are ingested.
+  """
+  CertifyVulnIngested(certifyVulnSpec: CertifyVulnSpec!): CertifyVuln!
+}
+
 extend type Mutation {
   "Adds a certification that a package has been scanned for vulnerabilities. The returned ID can be empty string."
   ingestCertifyVuln(
@@ -5795,6 +6451,9 @@ If a source is specified in the subject filter, then it must specify a name,
 and optionally a tag and a commit.
 
 since filters attestations with a value of since later or equal to the provided filter.
+
+If asOf is specified, only attestations with a since time at or before asOf are
+returned. This allows querying the graph as it was known at that time.
 """
 input PointOfContactSpec {
   id: ID
@@ -5802,6 +6461,7 @@ input PointOfContactSpec {
   email: String
   info: String
   since: Time
+  asOf: Time
   justification: String
   origin: String
   collector: String
@@ -5920,6 +6580,158 @@ enum FilterOperation {
   CONTAINS
   STARTSWITH
 }`, BuiltIn: false},
+	{Name: "../schema/document.graphql", Input: `#
+# Copyright 2026 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the documents evidence is ingested from
+
+"""
+Document records the provenance of an ingested document.
+
+Every evidence node carries a documentRef, which is the key of the original
+document in the blob store. The Document with the same blobKey records what
+that document was and when it was ingested, and links to all the evidence
+it produced via the DOCUMENT_* edges.
+
+blobKey is the blob store key, matching documentRef on the evidence.
+sha256 is the hex encoded sha256 digest of the document bytes.
+documentType is the processor document type (e.g. SPDX, CycloneDX, ITE6).
+format is the document format (e.g. JSON, XML).
+collector is the name of the collector that retrieved the document.
+source is the location the document was collected from.
+parserVersion is the version of GUAC that parsed the document.
+ingestedAt is the timestamp when the document was first ingested.
+"""
+type Document {
+  id: ID!
+  blobKey: String!
+  sha256: String!
+  documentType: String!
+  format: String!
+  collector: String!
+  source: String!
+  parserVersion: String!
+  ingestedAt: Time!
+}
+
+"""
+DocumentSpec allows filtering the list of documents to return in a query.
+"""
+input DocumentSpec {
+  id: ID
+  blobKey: String
+  sha256: String
+  documentType: String
+  format: String
+  collector: String
+  source: String
+  parserVersion: String
+}
+
+"""
+DocumentInputSpec is the same as Document but for mutation input.
+
+A document is identified by its blobKey. Ingesting the same blobKey again
+returns the existing document and leaves its fields unchanged.
+"""
+input DocumentInputSpec {
+  blobKey: String!
+  sha256: String!
+  documentType: String!
+  format: String!
+  collector: String!
+  source: String!
+  parserVersion: String!
+  ingestedAt: Time!
+}
+
+"""
+DocumentConnection returns the paginated results for Document.
+
+totalCount is the total number of results returned.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the DocumentEdge which contains the current cursor
+and the Document node itself
+"""
+type DocumentConnection {
+    totalCount: Int!
+    pageInfo: PageInfo!
+    edges: [DocumentEdge!]!
+}
+
+"""
+DocumentEdge contains the cursor for the resulting node and
+the Document node itself.
+"""
+type DocumentEdge {
+  cursor: ID!
+  node: Document!
+}
+
+"""
+RetractDocumentResult lists the nodes removed by retractDocument.
+
+documentRef is the blob key of the retracted document.
+dryRun is true if nothing was removed and the lists only show what would be.
+document is the Document node for documentRef, if it was ingested.
+evidence contains every evidence node whose documentRef matches.
+orphans contains the software tree nodes (packages, sources, artifacts,
+builders, vulnerabilities and licenses) that were only referenced by that
+evidence and are garbage collected with it.
+"""
+type RetractDocumentResult {
+  documentRef: String!
+  dryRun: Boolean!
+  document: Document
+  evidence: [Node!]!
+  orphans: [Node!]!
+}
+
+extend type Query {
+  "Returns all documents matching a filter."
+  documents(documentSpec: DocumentSpec!): [Document!]!
+  "Returns a paginated results via DocumentConnection"
+  documentList(documentSpec: DocumentSpec!, after: ID, first: Int): DocumentConnection
+  """
+  Returns the original bytes of a document from the blob store, base64
+  encoded. The content is verified against the recorded sha256.
+  """
+  documentContent(documentID: ID!): String!
+}
+
+extend type Mutation {
+  "Ingests a new document and returns its ID."
+  ingestDocument(document: DocumentInputSpec!): ID!
+  "Bulk ingests new documents and returns a list of them. The returned array of IDs must be in the same order as the inputs."
+  ingestDocuments(documents: [DocumentInputSpec!]!): [ID!]!
+  """
+  Retracts a document that should not have been ingested. Removes all the
+  evidence whose documentRef is the given blob key, the Document node itself
+  and any software tree nodes that no other evidence refers to.
+
+  If dryRun is true nothing is removed and the result lists what would be.
+  """
+  retractDocument(documentRef: String!, dryRun: Boolean! = false): RetractDocumentResult!
+}
+`, BuiltIn: false},
 	{Name: "../schema/hasSBOM.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
 #
@@ -5974,6 +6786,9 @@ Only the package or artifact can be added, not both.
 
 If KnownSince is specified, the returned value will be after or equal to the specified time.
 Any nodes time that is before KnownSince is excluded.
+
+If asOf is specified, only attestations with a knownSince at or before asOf are
+returned. This allows querying the graph as it was known at that time.
 """
 input HasSBOMSpec {
   id: ID
@@ -5983,6 +6798,7 @@ input HasSBOMSpec {
   digest: String
   downloadLocation: String
   knownSince: Time
+  asOf: Time
   origin: String
   collector: String
   documentRef: String
@@ -6044,6 +6860,11 @@ extend type Query {
   HasSBOMList(hasSBOMSpec: HasSBOMSpec!, after: ID, first: Int): HasSBOMConnection
 }
 
+extend type Subscription {
+  "Returns the SBOM certifications matching a filter as they are ingested."
+  HasSBOMIngested(hasSBOMSpec: HasSBOMSpec!): HasSBOM!
+}
+
 extend type Mutation {
   "Certifies that a package or artifact has an SBOM. The returned ID can be empty string."
   ingestHasSBOM(
@@ -6152,7 +6973,13 @@ type SLSAPredicate {
   value: String!
 }
 
-"HasSLSASpec allows filtering the list of HasSLSA to return."
+"""
+HasSLSASpec allows filtering the list of HasSLSA to return.
+
+If asOf is specified, only attestations with a finishedOn at or before asOf are
+returned, so attestations without a finishedOn are excluded. This allows
+querying the graph as it was known at that time.
+"""
 input HasSLSASpec {
   id: ID
   subject: ArtifactSpec
@@ -6163,6 +6990,7 @@ input HasSLSASpec {
   slsaVersion: String
   startedOn: Time
   finishedOn: Time
+  asOf: Time
   origin: String
   collector: String
   documentRef: String
@@ -6281,12 +7109,18 @@ type HasSourceAt {
   documentRef: String!
 }
 
-"HasSourceAtSpec allows filtering the list of HasSourceAt to return."
+"""
+HasSourceAtSpec allows filtering the list of HasSourceAt to return.
+
+If asOf is specified, only attestations with a knownSince at or before asOf are
+returned. This allows querying the graph as it was known at that time.
+"""
 input HasSourceAtSpec {
   id: ID
   package: PkgSpec
   source: SourceSpec
   knownSince: Time
+  asOf: Time
   justification: String
   origin: String
   collector: String
@@ -6925,11 +7759,15 @@ If a source is specified in the subject filter, then it must specify a name,
 and optionally a tag and a commit.
 
 since specified indicates filtering timestamps after the specified time
+
+If asOf is specified, only attestations with a timestamp at or before asOf are
+returned. This allows querying the graph as it was known at that time.
 """
 input HasMetadataSpec {
   id: ID
   subject: PackageSourceOrArtifactSpec
   since: Time
+  asOf: Time
   key: String
   value: String
   justification: String
@@ -7333,6 +8171,7 @@ union Node =
   | VulnerabilityMetadata
   | License
   | CertifyLegal
+  | Document
 
 """
 Edge allows filtering path/neighbors output to only contain a subset of all
@@ -7346,6 +8185,11 @@ which means nodes can be traversed from either direction.
 The only exception to the above rule is for links out of HasSLSA. The names are
 HAS_SLSA_SUBJECT, HAS_SLSA_BUILT_BY, and HAS_SLSA_MATERIALS. This is because
 ARTIFACT_HAS_SLSA is only from subject Artifact to HasSLSA.
+
+Evidence nodes link to the Document they were ingested from through their
+documentRef, so every evidence node has a DOCUMENT_* edge in both directions.
+The edges from the evidence to its Document (such as CERTIFY_BAD_DOCUMENT) are
+only followed when they are listed in usingOnly.
 """
 enum Edge {
   ARTIFACT_CERTIFY_BAD
@@ -7358,6 +8202,23 @@ enum Edge {
   ARTIFACT_IS_OCCURRENCE
   ARTIFACT_POINT_OF_CONTACT
   BUILDER_HAS_SLSA
+  DOCUMENT_CERTIFY_BAD
+  DOCUMENT_CERTIFY_GOOD
+  DOCUMENT_CERTIFY_LEGAL
+  DOCUMENT_CERTIFY_SCORECARD
+  DOCUMENT_CERTIFY_VEX_STATEMENT
+  DOCUMENT_CERTIFY_VULN
+  DOCUMENT_HASH_EQUAL
+  DOCUMENT_HAS_METADATA
+  DOCUMENT_HAS_SBOM
+  DOCUMENT_HAS_SLSA
+  DOCUMENT_HAS_SOURCE_AT
+  DOCUMENT_IS_DEPENDENCY
+  DOCUMENT_IS_OCCURRENCE
+  DOCUMENT_PKG_EQUAL
+  DOCUMENT_POINT_OF_CONTACT
+  DOCUMENT_VULN_EQUAL
+  DOCUMENT_VULN_METADATA
   LICENSE_CERTIFY_LEGAL
   PACKAGE_CERTIFY_BAD
   PACKAGE_CERTIFY_GOOD
@@ -7397,43 +8258,60 @@ enum Edge {
   VULNERABILITY_VULN_METADATA
 
   CERTIFY_BAD_ARTIFACT
+  CERTIFY_BAD_DOCUMENT
   CERTIFY_BAD_PACKAGE
   CERTIFY_BAD_SOURCE
   CERTIFY_GOOD_ARTIFACT
+  CERTIFY_GOOD_DOCUMENT
   CERTIFY_GOOD_PACKAGE
   CERTIFY_GOOD_SOURCE
+  CERTIFY_LEGAL_DOCUMENT
   CERTIFY_LEGAL_LICENSE
   CERTIFY_LEGAL_PACKAGE
   CERTIFY_LEGAL_SOURCE
+  CERTIFY_SCORECARD_DOCUMENT
   CERTIFY_SCORECARD_SOURCE
   CERTIFY_VEX_STATEMENT_ARTIFACT
+  CERTIFY_VEX_STATEMENT_DOCUMENT
   CERTIFY_VEX_STATEMENT_PACKAGE
   CERTIFY_VEX_STATEMENT_VULNERABILITY
+  CERTIFY_VULN_DOCUMENT
   CERTIFY_VULN_PACKAGE
   CERTIFY_VULN_VULNERABILITY
   HASH_EQUAL_ARTIFACT
+  HASH_EQUAL_DOCUMENT
   HAS_METADATA_ARTIFACT
+  HAS_METADATA_DOCUMENT
   HAS_METADATA_PACKAGE
   HAS_METADATA_SOURCE
   HAS_SBOM_ARTIFACT
+  HAS_SBOM_DOCUMENT
   HAS_SBOM_PACKAGE
   HAS_SBOM_INCLUDED_SOFTWARE
   HAS_SBOM_INCLUDED_DEPENDENCIES
   HAS_SBOM_INCLUDED_OCCURRENCES
   HAS_SLSA_BUILT_BY
+  HAS_SLSA_DOCUMENT
   HAS_SLSA_MATERIALS
   HAS_SLSA_SUBJECT
+  HAS_SOURCE_AT_DOCUMENT
   HAS_SOURCE_AT_PACKAGE
   HAS_SOURCE_AT_SOURCE
+  IS_DEPENDENCY_DOCUMENT
   IS_DEPENDENCY_PACKAGE
   IS_OCCURRENCE_ARTIFACT
+  IS_OCCURRENCE_DOCUMENT
   IS_OCCURRENCE_PACKAGE
   IS_OCCURRENCE_SOURCE
+  PKG_EQUAL_DOCUMENT
   PKG_EQUAL_PACKAGE
   POINT_OF_CONTACT_ARTIFACT
+  POINT_OF_CONTACT_DOCUMENT
   POINT_OF_CONTACT_PACKAGE
   POINT_OF_CONTACT_SOURCE
+  VULN_EQUAL_DOCUMENT
   VULN_EQUAL_VULNERABILITY
+  VULN_METADATA_DOCUMENT
   VULN_METADATA_VULNERABILITY
 }
 
@@ -7616,6 +8494,216 @@ extend type Mutation {
     pkgEquals: [PkgEqualInputSpec!]!
   ): [ID!]!
 }
+`, BuiltIn: false},
+	{Name: "../schema/reachability.graphql", Input: `#
+# Copyright 2026 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for reachability-aware vulnerability queries
+
+"""
+ReachableVulnerability is a vulnerability attested by a CertifyVuln on a
+package that can be reached from the root of a findReachableVulnerabilities
+query.
+
+path is the chain from the root to the vulnerable package version with the
+fewest IsDependency nodes; IsOccurrence and HasSBOM hops do not add to its
+length. It starts with the root, ends with the vulnerable package version and alternates
+between software nodes (Package or Artifact) and the evidence nodes
+(IsDependency, IsOccurrence or HasSBOM) that connect them.
+
+depth is the number of IsDependency nodes in path. A depth of 0 means that the
+root itself is vulnerable, a depth of 1 means the vulnerability is in a direct
+dependency of the root.
+
+notAffected is true if any CertifyVEXStatement with a NOT_AFFECTED status for
+any of the IDs of the vulnerability has a subject along path. These statements
+are returned in vexStatements.
+"""
+type ReachableVulnerability {
+  certifyVuln: CertifyVuln!
+  path: [Node!]!
+  depth: Int!
+  notAffected: Boolean!
+  vexStatements: [CertifyVEXStatement!]!
+}
+
+extend type Query {
+  """
+  findReachableVulnerabilities walks the IsDependency, IsOccurrence and HasSBOM
+  edges starting from the package or artifact matched by root and returns every
+  vulnerability found on a reachable package version, together with the
+  shortest chain that reaches it.
+
+  maxDepth limits the number of IsDependency edges that are followed. If it is
+  not set or set to 0, the whole dependency graph is walked.
+
+  dependencyType restricts the IsDependency edges that are followed to those of
+  the given type, for example DIRECT to skip the indirect dependencies that
+  SBOMs list next to the direct ones. If it is not set, all edges are followed.
+
+  Results are sorted by depth and then by vulnerability ID.
+
+  Warning: This is an EXPERIMENTAL feature. This is subject to change.
+  """
+  findReachableVulnerabilities(
+    root: PackageOrArtifactSpec!
+    maxDepth: Int
+    dependencyType: DependencyType
+  ): [ReachableVulnerability!]!
+}
+`, BuiltIn: false},
+	{Name: "../schema/sbomDiff.graphql", Input: `#
+# Copyright 2026 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema to compare the SBOMs of two versions of a product
+
+"""
+SbomDiffChange is the kind of change of an entry of an SbomDiff between the
+before and the after SBOM.
+
+For vulnerabilities, ADDED means that the vulnerability is new in the after
+SBOM and REMOVED that it was resolved.
+"""
+enum SbomDiffChange {
+  ADDED
+  REMOVED
+  "The version of the package is higher in the after SBOM."
+  UPGRADED
+  "The version of the package is lower in the after SBOM."
+  DOWNGRADED
+  "The version of the package changed, but the versions cannot be ordered."
+  CHANGED
+}
+
+"""
+SbomPackageDiff is a package included in one of the SBOMs with a version that
+is not included in the other.
+
+Packages are matched by type, namespace and name. before and after are trimmed
+to a single package version. before is not set for ADDED packages and after is
+not set for REMOVED packages.
+"""
+type SbomPackageDiff {
+  change: SbomDiffChange!
+  before: Package
+  after: Package
+}
+
+"""
+SbomArtifactDiff is an artifact included in only one of the SBOMs. change is
+either ADDED or REMOVED.
+"""
+type SbomArtifactDiff {
+  change: SbomDiffChange!
+  artifact: Artifact!
+}
+
+"""
+SbomDependencyDiff is a dependency between two packages that is only included
+in one of the SBOMs. Dependencies are matched by the type, namespace and name
+of both packages, so a dependency whose packages were upgraded is not reported
+here. change is either ADDED or REMOVED.
+"""
+type SbomDependencyDiff {
+  change: SbomDiffChange!
+  isDependency: IsDependency!
+}
+
+"""
+SbomVulnerabilityDiff is a vulnerability of an included package that only
+affects one of the SBOMs. Vulnerabilities are matched by ID and by the type,
+namespace and name of the affected package. change is ADDED for new
+vulnerabilities and REMOVED for resolved ones.
+"""
+type SbomVulnerabilityDiff {
+  change: SbomDiffChange!
+  certifyVuln: CertifyVuln!
+}
+
+"""
+SbomLicenseDiff is a package whose version changed between the SBOMs together
+with the licenses of both versions, when the declared or discovered licenses of
+the versions differ.
+"""
+type SbomLicenseDiff {
+  before: Package!
+  after: Package!
+  beforeLegals: [CertifyLegal!]!
+  afterLegals: [CertifyLegal!]!
+}
+
+"""
+SbomScorecardDiff is a changed package whose source has a different aggregate
+Scorecard score in the before and after SBOM. The source of a package version
+is found with HasSourceAt and the latest scorecard of that source is used.
+
+before and after are not set for the SBOM that does not include the package, or
+when the source of the package has no scorecard.
+"""
+type SbomScorecardDiff {
+  change: SbomDiffChange!
+  package: Package!
+  before: CertifyScorecard
+  after: CertifyScorecard
+}
+
+"""
+SbomDiff is the difference between two SBOMs, usually of two versions of the
+same product. All lists are sorted by package, artifact or vulnerability.
+"""
+type SbomDiff {
+  before: HasSBOM!
+  after: HasSBOM!
+  packages: [SbomPackageDiff!]!
+  artifacts: [SbomArtifactDiff!]!
+  dependencies: [SbomDependencyDiff!]!
+  vulnerabilities: [SbomVulnerabilityDiff!]!
+  licenses: [SbomLicenseDiff!]!
+  scorecards: [SbomScorecardDiff!]!
+}
+
+extend type Query {
+  """
+  sbomDiff compares the included software and dependencies of two SBOMs and
+  joins the changes with the vulnerabilities, licenses and scorecards of the
+  packages.
+
+  before and after are the IDs of HasSBOM nodes, or of artifacts or package
+  versions which are the subject of an SBOM. If a subject has several SBOMs, the
+  most recent one is used.
+
+  Warning: This is an EXPERIMENTAL feature. This is subject to change.
+  """
+  sbomDiff(before: ID!, after: ID!): SbomDiff!
+}
 `, BuiltIn: false},
 	{Name: "../schema/search.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -8080,6 +9168,9 @@ Comparator field is an enum that be set to filter the score and return a
 range that matches. If the comparator is not specified, it will default to equal operation.
 
 Timestamp specified indicates filtering timestamps after the specified time
+
+If asOf is specified, only attestations with a timestamp at or before asOf are
+returned. This allows querying the graph as it was known at that time.
 """
 input VulnerabilityMetadataSpec {
   id: ID
@@ -8088,6 +9179,7 @@ input VulnerabilityMetadataSpec {
   scoreValue: Float
   comparator: Comparator
   timestamp: Time
+  asOf: Time
   origin: String
   collector: String
   documentRef: String
@@ -211,11 +211,15 @@ type CertifyBadInputSpec struct {
 //
 // If KnownSince is specified, the returned value will be after or equal to the specified time.
 // Any nodes time that is before KnownSince is excluded.
+//
+// If asOf is specified, only attestations with a knownSince at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type CertifyBadSpec struct {
 	ID            *string                      `json:"id,omitempty"`
 	Subject       *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
 	Justification *string                      `json:"justification,omitempty"`
 	KnownSince    *time.Time                   `json:"knownSince,omitempty"`
+	AsOf          *time.Time                   `json:"asOf,omitempty"`
 	Origin        *string                      `json:"origin,omitempty"`
 	Collector     *string                      `json:"collector,omitempty"`
 	DocumentRef   *string                      `json:"documentRef,omitempty"`
@@ -294,11 +298,15 @@ type CertifyGoodInputSpec struct {
 //
 // If KnownSince is specified, the returned value will be after or equal to the specified time.
 // Any nodes time that is before KnownSince is excluded.
+//
+// If asOf is specified, only attestations with a knownSince at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type CertifyGoodSpec struct {
 	ID            *string                      `json:"id,omitempty"`
 	Subject       *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
 	Justification *string                      `json:"justification,omitempty"`
 	KnownSince    *time.Time                   `json:"knownSince,omitempty"`
+	AsOf          *time.Time                   `json:"asOf,omitempty"`
 	Origin        *string                      `json:"origin,omitempty"`
 	Collector     *string                      `json:"collector,omitempty"`
 	DocumentRef   *string                      `json:"documentRef,omitempty"`
@@ -387,6 +395,9 @@ type CertifyLegalInputSpec struct {
 //
 // Specifying just the package allows to query for all certifications associated
 // with the package.
+//
+// If asOf is specified, only attestations with a timeScanned at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type CertifyLegalSpec struct {
 	ID                 *string              `json:"id,omitempty"`
 	Subject            *PackageOrSourceSpec `json:"subject,omitempty"`
@@ -397,6 +408,7 @@ type CertifyLegalSpec struct {
 	Attribution        *string              `json:"attribution,omitempty"`
 	Justification      *string              `json:"justification,omitempty"`
 	TimeScanned        *time.Time           `json:"timeScanned,omitempty"`
+	AsOf               *time.Time           `json:"asOf,omitempty"`
 	Origin             *string              `json:"origin,omitempty"`
 	Collector          *string              `json:"collector,omitempty"`
 	DocumentRef        *string              `json:"documentRef,omitempty"`
@@ -438,10 +450,14 @@ type CertifyScorecardEdge struct {
 }
 
 // CertifyScorecardSpec allows filtering the list of Scorecards to return.
+//
+// If asOf is specified, only attestations with a timeScanned at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type CertifyScorecardSpec struct {
 	ID               *string               `json:"id,omitempty"`
 	Source           *SourceSpec           `json:"source,omitempty"`
 	TimeScanned      *time.Time            `json:"timeScanned,omitempty"`
+	AsOf             *time.Time            `json:"asOf,omitempty"`
 	AggregateScore   *float64              `json:"aggregateScore,omitempty"`
 	Checks           []*ScorecardCheckSpec `json:"checks,omitempty"`
 	ScorecardVersion *string               `json:"scorecardVersion,omitempty"`
@@ -485,6 +501,9 @@ func (CertifyVEXStatement) IsNode() {}
 // Only one subject type (package or artifact) and one vulnerability may be specified.
 //
 // Note that setting noVuln vulnerability type is invalid for VEX statements!
+//
+// If asOf is specified, only attestations with a knownSince at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type CertifyVEXStatementSpec struct {
 	ID               *string                `json:"id,omitempty"`
 	Subject          *PackageOrArtifactSpec `json:"subject,omitempty"`
@@ -494,6 +513,7 @@ type CertifyVEXStatementSpec struct {
 	Statement        *string                `json:"statement,omitempty"`
 	StatusNotes      *string                `json:"statusNotes,omitempty"`
 	KnownSince       *time.Time             `json:"knownSince,omitempty"`
+	AsOf             *time.Time             `json:"asOf,omitempty"`
 	Origin           *string                `json:"origin,omitempty"`
 	Collector        *string                `json:"collector,omitempty"`
 	DocumentRef      *string                `json:"documentRef,omitempty"`
@@ -547,11 +567,15 @@ type CertifyVulnEdge struct {
 //
 // Only one vulnerability (or NoVuln vulnerability type) may be
 // specified.
+//
+// If asOf is specified, only attestations with a timeScanned at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type CertifyVulnSpec struct {
 	ID             *string            `json:"id,omitempty"`
 	Package        *PkgSpec           `json:"package,omitempty"`
 	Vulnerability  *VulnerabilitySpec `json:"vulnerability,omitempty"`
 	TimeScanned    *time.Time         `json:"timeScanned,omitempty"`
+	AsOf           *time.Time         `json:"asOf,omitempty"`
 	DbURI          *string            `json:"dbUri,omitempty"`
 	DbVersion      *string            `json:"dbVersion,omitempty"`
 	ScannerURI     *string            `json:"scannerUri,omitempty"`
@@ -561,6 +585,85 @@ type CertifyVulnSpec struct {
 	DocumentRef    *string            `json:"documentRef,omitempty"`
 }
 
+// Document records the provenance of an ingested document.
+//
+// Every evidence node carries a documentRef, which is the key of the original
+// document in the blob store. The Document with the same blobKey records what
+// that document was and when it was ingested, and links to all the evidence
+// it produced via the DOCUMENT_* edges.
+//
+// blobKey is the blob store key, matching documentRef on the evidence.
+// sha256 is the hex encoded sha256 digest of the document bytes.
+// documentType is the processor document type (e.g. SPDX, CycloneDX, ITE6).
+// format is the document format (e.g. JSON, XML).
+// collector is the name of the collector that retrieved the document.
+// source is the location the document was collected from.
+// parserVersion is the version of GUAC that parsed the document.
+// ingestedAt is the timestamp when the document was first ingested.
+type Document struct {
+	ID            string    `json:"id"`
+	BlobKey       string    `json:"blobKey"`
+	Sha256        string    `json:"sha256"`
+	DocumentType  string    `json:"documentType"`
+	Format        string    `json:"format"`
+	Collector     string    `json:"collector"`
+	Source        string    `json:"source"`
+	ParserVersion string    `json:"parserVersion"`
+	IngestedAt    time.Time `json:"ingestedAt"`
+}
+
+func (Document) IsNode() {}
+
+// DocumentConnection returns the paginated results for Document.
+//
+// totalCount is the total number of results returned.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the DocumentEdge which contains the current cursor
+// and the Document node itself
+type DocumentConnection struct {
+	TotalCount int             `json:"totalCount"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	Edges      []*DocumentEdge `json:"edges"`
+}
+
+// DocumentEdge contains the cursor for the resulting node and
+// the Document node itself.
+type DocumentEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *Document `json:"node"`
+}
+
+// DocumentInputSpec is the same as Document but for mutation input.
+//
+// A document is identified by its blobKey. Ingesting the same blobKey again
+// returns the existing document and leaves its fields unchanged.
+type DocumentInputSpec struct {
+	BlobKey       string    `json:"blobKey"`
+	Sha256        string    `json:"sha256"`
+	DocumentType  string    `json:"documentType"`
+	Format        string    `json:"format"`
+	Collector     string    `json:"collector"`
+	Source        string    `json:"source"`
+	ParserVersion string    `json:"parserVersion"`
+	IngestedAt    time.Time `json:"ingestedAt"`
+}
+
+// DocumentSpec allows filtering the list of documents to return in a query.
+type DocumentSpec struct {
+	ID            *string `json:"id,omitempty"`
+	BlobKey       *string `json:"blobKey,omitempty"`
+	Sha256        *string `json:"sha256,omitempty"`
+	DocumentType  *string `json:"documentType,omitempty"`
+	Format        *string `json:"format,omitempty"`
+	Collector     *string `json:"collector,omitempty"`
+	Source        *string `json:"source,omitempty"`
+	ParserVersion *string `json:"parserVersion,omitempty"`
+}
+
 // FindSoftwareConnection returns the paginated results for FindSoftware.
 //
 // totalCount is the total number of results returned.
@@ -658,10 +761,14 @@ type HasMetadataInputSpec struct {
 // and optionally a tag and a commit.
 //
 // since specified indicates filtering timestamps after the specified time
+//
+// If asOf is specified, only attestations with a timestamp at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type HasMetadataSpec struct {
 	ID            *string                      `json:"id,omitempty"`
 	Subject       *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
 	Since         *time.Time                   `json:"since,omitempty"`
+	AsOf          *time.Time                   `json:"asOf,omitempty"`
 	Key           *string                      `json:"key,omitempty"`
 	Value         *string                      `json:"value,omitempty"`
 	Justification *string                      `json:"justification,omitempty"`
@@ -748,6 +855,9 @@ type HasSBOMInputSpec struct {
 //
 // If KnownSince is specified, the returned value will be after or equal to the specified time.
 // Any nodes time that is before KnownSince is excluded.
+//
+// If asOf is specified, only attestations with a knownSince at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type HasSBOMSpec struct {
 	ID                   *string                  `json:"id,omitempty"`
 	Subject              *PackageOrArtifactSpec   `json:"subject,omitempty"`
@@ -756,6 +866,7 @@ type HasSBOMSpec struct {
 	Digest               *string                  `json:"digest,omitempty"`
 	DownloadLocation     *string                  `json:"downloadLocation,omitempty"`
 	KnownSince           *time.Time               `json:"knownSince,omitempty"`
+	AsOf                 *time.Time               `json:"asOf,omitempty"`
 	Origin               *string                  `json:"origin,omitempty"`
 	Collector            *string                  `json:"collector,omitempty"`
 	DocumentRef          *string                  `json:"documentRef,omitempty"`
@@ -799,6 +910,10 @@ type HasSLSAEdge struct {
 }
 
 // HasSLSASpec allows filtering the list of HasSLSA to return.
+//
+// If asOf is specified, only attestations with a finishedOn at or before asOf are
+// returned, so attestations without a finishedOn are excluded. This allows
+// querying the graph as it was known at that time.
 type HasSLSASpec struct {
 	ID          *string              `json:"id,omitempty"`
 	Subject     *ArtifactSpec        `json:"subject,omitempty"`
@@ -809,6 +924,7 @@ type HasSLSASpec struct {
 	SlsaVersion *string              `json:"slsaVersion,omitempty"`
 	StartedOn   *time.Time           `json:"startedOn,omitempty"`
 	FinishedOn  *time.Time           `json:"finishedOn,omitempty"`
+	AsOf        *time.Time           `json:"asOf,omitempty"`
 	Origin      *string              `json:"origin,omitempty"`
 	Collector   *string              `json:"collector,omitempty"`
 	DocumentRef *string              `json:"documentRef,omitempty"`
@@ -868,11 +984,15 @@ type HasSourceAtInputSpec struct {
 }
 
 // HasSourceAtSpec allows filtering the list of HasSourceAt to return.
+//
+// If asOf is specified, only attestations with a knownSince at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type HasSourceAtSpec struct {
 	ID            *string     `json:"id,omitempty"`
 	Package       *PkgSpec    `json:"package,omitempty"`
 	Source        *SourceSpec `json:"source,omitempty"`
 	KnownSince    *time.Time  `json:"knownSince,omitempty"`
+	AsOf          *time.Time  `json:"asOf,omitempty"`
 	Justification *string     `json:"justification,omitempty"`
 	Origin        *string     `json:"origin,omitempty"`
 	Collector     *string     `json:"collector,omitempty"`
@@ -1673,12 +1793,16 @@ type PointOfContactInputSpec struct {
 // and optionally a tag and a commit.
 //
 // since filters attestations with a value of since later or equal to the provided filter.
+//
+// If asOf is specified, only attestations with a since time at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type PointOfContactSpec struct {
 	ID            *string                      `json:"id,omitempty"`
 	Subject       *PackageSourceOrArtifactSpec `json:"subject,omitempty"`
 	Email         *string                      `json:"email,omitempty"`
 	Info          *string                      `json:"info,omitempty"`
 	Since         *time.Time                   `json:"since,omitempty"`
+	AsOf          *time.Time                   `json:"asOf,omitempty"`
 	Justification *string                      `json:"justification,omitempty"`
 	Origin        *string                      `json:"origin,omitempty"`
 	Collector     *string                      `json:"collector,omitempty"`
@@ -1688,6 +1812,47 @@ type PointOfContactSpec struct {
 type Query struct {
 }
 
+// ReachableVulnerability is a vulnerability attested by a CertifyVuln on a
+// package that can be reached from the root of a findReachableVulnerabilities
+// query.
+//
+// path is the shortest chain from the root to the vulnerable package version. It
+// starts with the root, ends with the vulnerable package version and alternates
+// between software nodes (Package or Artifact) and the evidence nodes
+// (IsDependency, IsOccurrence or HasSBOM) that connect them.
+//
+// depth is the number of IsDependency nodes in path. A depth of 0 means that the
+// root itself is vulnerable, a depth of 1 means the vulnerability is in a direct
+// dependency of the root.
+//
+// notAffected is true if any CertifyVEXStatement with a NOT_AFFECTED status for
+// the vulnerability has a subject along path. These statements are returned in
+// vexStatements.
+type ReachableVulnerability struct {
+	CertifyVuln   *CertifyVuln           `json:"certifyVuln"`
+	Path          []Node                 `json:"path"`
+	Depth         int                    `json:"depth"`
+	NotAffected   bool                   `json:"notAffected"`
+	VexStatements []*CertifyVEXStatement `json:"vexStatements"`
+}
+
+// RetractDocumentResult lists the nodes removed by retractDocument.
+//
+// documentRef is the blob key of the retracted document.
+// dryRun is true if nothing was removed and the lists only show what would be.
+// document is the Document node for documentRef, if it was ingested.
+// evidence contains every evidence node whose documentRef matches.
+// orphans contains the software tree nodes (packages, sources, artifacts,
+// builders, vulnerabilities and licenses) that were only referenced by that
+// evidence and are garbage collected with it.
+type RetractDocumentResult struct {
+	DocumentRef string    `json:"documentRef"`
+	DryRun      bool      `json:"dryRun"`
+	Document    *Document `json:"document,omitempty"`
+	Evidence    []Node    `json:"evidence"`
+	Orphans     []Node    `json:"orphans"`
+}
+
 // SLSA contains all of the fields present in a SLSA attestation.
 //
 // The materials and builders are objects of the HasSLSA predicate, everything
@@ -1775,6 +1940,79 @@ type SLSAPredicateSpec struct {
 	Value string `json:"value"`
 }
 
+// SbomArtifactDiff is an artifact included in only one of the SBOMs. change is
+// either ADDED or REMOVED.
+type SbomArtifactDiff struct {
+	Change   SbomDiffChange `json:"change"`
+	Artifact *Artifact      `json:"artifact"`
+}
+
+// SbomDependencyDiff is a dependency between two packages that is only included
+// in one of the SBOMs. Dependencies are matched by the type, namespace and name
+// of both packages, so a dependency whose packages were upgraded is not reported
+// here. change is either ADDED or REMOVED.
+type SbomDependencyDiff struct {
+	Change       SbomDiffChange `json:"change"`
+	IsDependency *IsDependency  `json:"isDependency"`
+}
+
+// SbomDiff is the difference between two SBOMs, usually of two versions of the
+// same product. All lists are sorted by package, artifact or vulnerability.
+type SbomDiff struct {
+	Before          *HasSbom                 `json:"before"`
+	After           *HasSbom                 `json:"after"`
+	Packages        []*SbomPackageDiff       `json:"packages"`
+	Artifacts       []*SbomArtifactDiff      `json:"artifacts"`
+	Dependencies    []*SbomDependencyDiff    `json:"dependencies"`
+	Vulnerabilities []*SbomVulnerabilityDiff `json:"vulnerabilities"`
+	Licenses        []*SbomLicenseDiff       `json:"licenses"`
+	Scorecards      []*SbomScorecardDiff     `json:"scorecards"`
+}
+
+// SbomLicenseDiff is a package whose version changed between the SBOMs together
+// with the licenses of both versions, when the declared or discovered licenses of
+// the versions differ.
+type SbomLicenseDiff struct {
+	Before       *Package        `json:"before"`
+	After        *Package        `json:"after"`
+	BeforeLegals []*CertifyLegal `json:"beforeLegals"`
+	AfterLegals  []*CertifyLegal `json:"afterLegals"`
+}
+
+// SbomPackageDiff is a package included in one of the SBOMs with a version that
+// is not included in the other.
+//
+// Packages are matched by type, namespace and name. before and after are trimmed
+// to a single package version. before is not set for ADDED packages and after is
+// not set for REMOVED packages.
+type SbomPackageDiff struct {
+	Change SbomDiffChange `json:"change"`
+	Before *Package       `json:"before,omitempty"`
+	After  *Package       `json:"after,omitempty"`
+}
+
+// SbomScorecardDiff is a changed package whose source has a different aggregate
+// Scorecard score in the before and after SBOM. The source of a package version
+// is found with HasSourceAt and the latest scorecard of that source is used.
+//
+// before and after are not set for the SBOM that does not include the package, or
+// when the source of the package has no scorecard.
+type SbomScorecardDiff struct {
+	Change  SbomDiffChange    `json:"change"`
+	Package *Package          `json:"package"`
+	Before  *CertifyScorecard `json:"before,omitempty"`
+	After   *CertifyScorecard `json:"after,omitempty"`
+}
+
+// SbomVulnerabilityDiff is a vulnerability of an included package that only
+// affects one of the SBOMs. Vulnerabilities are matched by ID and by the type,
+// namespace and name of the affected package. change is ADDED for new
+// vulnerabilities and REMOVED for resolved ones.
+type SbomVulnerabilityDiff struct {
+	Change      SbomDiffChange `json:"change"`
+	CertifyVuln *CertifyVuln   `json:"certifyVuln"`
+}
+
 // ScanMetadata is the metadata attached to vulnerability certification.
 //
 // It contains metadata about the scanner process that created the certification.
@@ -1996,6 +2234,9 @@ type SourceSpec struct {
 	Commit    *string `json:"commit,omitempty"`
 }
 
+type Subscription struct {
+}
+
 // VEXConnection returns the paginated results for CertifyVEXStatement.
 //
 // totalCount is the total number of results returned.
@@ -2253,7 +2494,10 @@ type VulnerabilityMetadataInputSpec struct {
 // Comparator field is an enum that be set to filter the score and return a
 // range that matches. If the comparator is not specified, it will default to equal operation.
 //
-// Timestamp specified indicates filtering timestamps after the specified time
+// # Timestamp specified indicates filtering timestamps after the specified time
+//
+// If asOf is specified, only attestations with a timestamp at or before asOf are
+// returned. This allows querying the graph as it was known at that time.
 type VulnerabilityMetadataSpec struct {
 	ID            *string                 `json:"id,omitempty"`
 	Vulnerability *VulnerabilitySpec      `json:"vulnerability,omitempty"`
@@ -2261,6 +2505,7 @@ type VulnerabilityMetadataSpec struct {
 	ScoreValue    *float64                `json:"scoreValue,omitempty"`
 	Comparator    *Comparator             `json:"comparator,omitempty"`
 	Timestamp     *time.Time              `json:"timestamp,omitempty"`
+	AsOf          *time.Time              `json:"asOf,omitempty"`
 	Origin        *string                 `json:"origin,omitempty"`
 	Collector     *string                 `json:"collector,omitempty"`
 	DocumentRef   *string                 `json:"documentRef,omitempty"`
@@ -2418,6 +2663,11 @@ func (e DependencyType) MarshalJSON() ([]byte, error) {
 // The only exception to the above rule is for links out of HasSLSA. The names are
 // HAS_SLSA_SUBJECT, HAS_SLSA_BUILT_BY, and HAS_SLSA_MATERIALS. This is because
 // ARTIFACT_HAS_SLSA is only from subject Artifact to HasSLSA.
+//
+// Evidence nodes link to the Document they were ingested from through their
+// documentRef, so every evidence node has a DOCUMENT_* edge in both directions.
+// The edges from the evidence to its Document (such as CERTIFY_BAD_DOCUMENT) are
+// only followed when they are listed in usingOnly.
 type Edge string
 
 const (
@@ -2431,6 +2681,23 @@ const (
 	EdgeArtifactIsOccurrence             Edge = "ARTIFACT_IS_OCCURRENCE"
 	EdgeArtifactPointOfContact           Edge = "ARTIFACT_POINT_OF_CONTACT"
 	EdgeBuilderHasSlsa                   Edge = "BUILDER_HAS_SLSA"
+	EdgeDocumentCertifyBad               Edge = "DOCUMENT_CERTIFY_BAD"
+	EdgeDocumentCertifyGood              Edge = "DOCUMENT_CERTIFY_GOOD"
+	EdgeDocumentCertifyLegal             Edge = "DOCUMENT_CERTIFY_LEGAL"
+	EdgeDocumentCertifyScorecard         Edge = "DOCUMENT_CERTIFY_SCORECARD"
+	EdgeDocumentCertifyVexStatement      Edge = "DOCUMENT_CERTIFY_VEX_STATEMENT"
+	EdgeDocumentCertifyVuln              Edge = "DOCUMENT_CERTIFY_VULN"
+	EdgeDocumentHashEqual                Edge = "DOCUMENT_HASH_EQUAL"
+	EdgeDocumentHasMetadata              Edge = "DOCUMENT_HAS_METADATA"
+	EdgeDocumentHasSbom                  Edge = "DOCUMENT_HAS_SBOM"
+	EdgeDocumentHasSlsa                  Edge = "DOCUMENT_HAS_SLSA"
+	EdgeDocumentHasSourceAt              Edge = "DOCUMENT_HAS_SOURCE_AT"
+	EdgeDocumentIsDependency             Edge = "DOCUMENT_IS_DEPENDENCY"
+	EdgeDocumentIsOccurrence             Edge = "DOCUMENT_IS_OCCURRENCE"
+	EdgeDocumentPkgEqual                 Edge = "DOCUMENT_PKG_EQUAL"
+	EdgeDocumentPointOfContact           Edge = "DOCUMENT_POINT_OF_CONTACT"
+	EdgeDocumentVulnEqual                Edge = "DOCUMENT_VULN_EQUAL"
+	EdgeDocumentVulnMetadata             Edge = "DOCUMENT_VULN_METADATA"
 	EdgeLicenseCertifyLegal              Edge = "LICENSE_CERTIFY_LEGAL"
 	EdgePackageCertifyBad                Edge = "PACKAGE_CERTIFY_BAD"
 	EdgePackageCertifyGood               Edge = "PACKAGE_CERTIFY_GOOD"
@@ -2469,43 +2736,60 @@ const (
 	EdgeVulnerabilityVulnEqual           Edge = "VULNERABILITY_VULN_EQUAL"
 	EdgeVulnerabilityVulnMetadata        Edge = "VULNERABILITY_VULN_METADATA"
 	EdgeCertifyBadArtifact               Edge = "CERTIFY_BAD_ARTIFACT"
+	EdgeCertifyBadDocument               Edge = "CERTIFY_BAD_DOCUMENT"
 	EdgeCertifyBadPackage                Edge = "CERTIFY_BAD_PACKAGE"
 	EdgeCertifyBadSource                 Edge = "CERTIFY_BAD_SOURCE"
 	EdgeCertifyGoodArtifact              Edge = "CERTIFY_GOOD_ARTIFACT"
+	EdgeCertifyGoodDocument              Edge = "CERTIFY_GOOD_DOCUMENT"
 	EdgeCertifyGoodPackage               Edge = "CERTIFY_GOOD_PACKAGE"
 	EdgeCertifyGoodSource                Edge = "CERTIFY_GOOD_SOURCE"
+	EdgeCertifyLegalDocument             Edge = "CERTIFY_LEGAL_DOCUMENT"
 	EdgeCertifyLegalLicense              Edge = "CERTIFY_LEGAL_LICENSE"
 	EdgeCertifyLegalPackage              Edge = "CERTIFY_LEGAL_PACKAGE"
 	EdgeCertifyLegalSource               Edge = "CERTIFY_LEGAL_SOURCE"
+	EdgeCertifyScorecardDocument         Edge = "CERTIFY_SCORECARD_DOCUMENT"
 	EdgeCertifyScorecardSource           Edge = "CERTIFY_SCORECARD_SOURCE"
 	EdgeCertifyVexStatementArtifact      Edge = "CERTIFY_VEX_STATEMENT_ARTIFACT"
+	EdgeCertifyVexStatementDocument      Edge = "CERTIFY_VEX_STATEMENT_DOCUMENT"
 	EdgeCertifyVexStatementPackage       Edge = "CERTIFY_VEX_STATEMENT_PACKAGE"
 	EdgeCertifyVexStatementVulnerability Edge = "CERTIFY_VEX_STATEMENT_VULNERABILITY"
+	EdgeCertifyVulnDocument              Edge = "CERTIFY_VULN_DOCUMENT"
 	EdgeCertifyVulnPackage               Edge = "CERTIFY_VULN_PACKAGE"
 	EdgeCertifyVulnVulnerability         Edge = "CERTIFY_VULN_VULNERABILITY"
 	EdgeHashEqualArtifact                Edge = "HASH_EQUAL_ARTIFACT"
+	EdgeHashEqualDocument                Edge = "HASH_EQUAL_DOCUMENT"
 	EdgeHasMetadataArtifact              Edge = "HAS_METADATA_ARTIFACT"
+	EdgeHasMetadataDocument              Edge = "HAS_METADATA_DOCUMENT"
 	EdgeHasMetadataPackage               Edge = "HAS_METADATA_PACKAGE"
 	EdgeHasMetadataSource                Edge = "HAS_METADATA_SOURCE"
 	EdgeHasSbomArtifact                  Edge = "HAS_SBOM_ARTIFACT"
+	EdgeHasSbomDocument                  Edge = "HAS_SBOM_DOCUMENT"
 	EdgeHasSbomPackage                   Edge = "HAS_SBOM_PACKAGE"
 	EdgeHasSbomIncludedSoftware          Edge = "HAS_SBOM_INCLUDED_SOFTWARE"
 	EdgeHasSbomIncludedDependencies      Edge = "HAS_SBOM_INCLUDED_DEPENDENCIES"
 	EdgeHasSbomIncludedOccurrences       Edge = "HAS_SBOM_INCLUDED_OCCURRENCES"
 	EdgeHasSlsaBuiltBy                   Edge = "HAS_SLSA_BUILT_BY"
+	EdgeHasSlsaDocument                  Edge = "HAS_SLSA_DOCUMENT"
 	EdgeHasSlsaMaterials                 Edge = "HAS_SLSA_MATERIALS"
 	EdgeHasSlsaSubject                   Edge = "HAS_SLSA_SUBJECT"
+	EdgeHasSourceAtDocument              Edge = "HAS_SOURCE_AT_DOCUMENT"
 	EdgeHasSourceAtPackage               Edge = "HAS_SOURCE_AT_PACKAGE"
 	EdgeHasSourceAtSource                Edge = "HAS_SOURCE_AT_SOURCE"
+	EdgeIsDependencyDocument             Edge = "IS_DEPENDENCY_DOCUMENT"
 	EdgeIsDependencyPackage              Edge = "IS_DEPENDENCY_PACKAGE"
 	EdgeIsOccurrenceArtifact             Edge = "IS_OCCURRENCE_ARTIFACT"
+	EdgeIsOccurrenceDocument             Edge = "IS_OCCURRENCE_DOCUMENT"
 	EdgeIsOccurrencePackage              Edge = "IS_OCCURRENCE_PACKAGE"
 	EdgeIsOccurrenceSource               Edge = "IS_OCCURRENCE_SOURCE"
+	EdgePkgEqualDocument                 Edge = "PKG_EQUAL_DOCUMENT"
 	EdgePkgEqualPackage                  Edge = "PKG_EQUAL_PACKAGE"
 	EdgePointOfContactArtifact           Edge = "POINT_OF_CONTACT_ARTIFACT"
+	EdgePointOfContactDocument           Edge = "POINT_OF_CONTACT_DOCUMENT"
 	EdgePointOfContactPackage            Edge = "POINT_OF_CONTACT_PACKAGE"
 	EdgePointOfContactSource             Edge = "POINT_OF_CONTACT_SOURCE"
+	EdgeVulnEqualDocument                Edge = "VULN_EQUAL_DOCUMENT"
 	EdgeVulnEqualVulnerability           Edge = "VULN_EQUAL_VULNERABILITY"
+	EdgeVulnMetadataDocument             Edge = "VULN_METADATA_DOCUMENT"
 	EdgeVulnMetadataVulnerability        Edge = "VULN_METADATA_VULNERABILITY"
 )
 
@@ -2520,6 +2804,23 @@ var AllEdge = []Edge{
 	EdgeArtifactIsOccurrence,
 	EdgeArtifactPointOfContact,
 	EdgeBuilderHasSlsa,
+	EdgeDocumentCertifyBad,
+	EdgeDocumentCertifyGood,
+	EdgeDocumentCertifyLegal,
+	EdgeDocumentCertifyScorecard,
+	EdgeDocumentCertifyVexStatement,
+	EdgeDocumentCertifyVuln,
+	EdgeDocumentHashEqual,
+	EdgeDocumentHasMetadata,
+	EdgeDocumentHasSbom,
+	EdgeDocumentHasSlsa,
+	EdgeDocumentHasSourceAt,
+	EdgeDocumentIsDependency,
+	EdgeDocumentIsOccurrence,
+	EdgeDocumentPkgEqual,
+	EdgeDocumentPointOfContact,
+	EdgeDocumentVulnEqual,
+	EdgeDocumentVulnMetadata,
 	EdgeLicenseCertifyLegal,
 	EdgePackageCertifyBad,
 	EdgePackageCertifyGood,
@@ -2558,49 +2859,66 @@ var AllEdge = []Edge{
 	EdgeVulnerabilityVulnEqual,
 	EdgeVulnerabilityVulnMetadata,
 	EdgeCertifyBadArtifact,
+	EdgeCertifyBadDocument,
 	EdgeCertifyBadPackage,
 	EdgeCertifyBadSource,
 	EdgeCertifyGoodArtifact,
+	EdgeCertifyGoodDocument,
 	EdgeCertifyGoodPackage,
 	EdgeCertifyGoodSource,
+	EdgeCertifyLegalDocument,
 	EdgeCertifyLegalLicense,
 	EdgeCertifyLegalPackage,
 	EdgeCertifyLegalSource,
+	EdgeCertifyScorecardDocument,
 	EdgeCertifyScorecardSource,
 	EdgeCertifyVexStatementArtifact,
+	EdgeCertifyVexStatementDocument,
 	EdgeCertifyVexStatementPackage,
 	EdgeCertifyVexStatementVulnerability,
+	EdgeCertifyVulnDocument,
 	EdgeCertifyVulnPackage,
 	EdgeCertifyVulnVulnerability,
 	EdgeHashEqualArtifact,
+	EdgeHashEqualDocument,
 	EdgeHasMetadataArtifact,
+	EdgeHasMetadataDocument,
 	EdgeHasMetadataPackage,
 	EdgeHasMetadataSource,
 	EdgeHasSbomArtifact,
+	EdgeHasSbomDocument,
 	EdgeHasSbomPackage,
 	EdgeHasSbomIncludedSoftware,
 	EdgeHasSbomIncludedDependencies,
 	EdgeHasSbomIncludedOccurrences,
 	EdgeHasSlsaBuiltBy,
+	EdgeHasSlsaDocument,
 	EdgeHasSlsaMaterials,
 	EdgeHasSlsaSubject,
+	EdgeHasSourceAtDocument,
 	EdgeHasSourceAtPackage,
 	EdgeHasSourceAtSource,
+	EdgeIsDependencyDocument,
 	EdgeIsDependencyPackage,
 	EdgeIsOccurrenceArtifact,
+	EdgeIsOccurrenceDocument,
 	EdgeIsOccurrencePackage,
 	EdgeIsOccurrenceSource,
+	EdgePkgEqualDocument,
 	EdgePkgEqualPackage,
 	EdgePointOfContactArtifact,
+	EdgePointOfContactDocument,
 	EdgePointOfContactPackage,
 	EdgePointOfContactSource,
+	EdgeVulnEqualDocument,
 	EdgeVulnEqualVulnerability,
+	EdgeVulnMetadataDocument,
 	EdgeVulnMetadataVulnerability,
 }
 
 func (e Edge) IsValid() bool {
 	switch e {
-	case EdgeArtifactCertifyBad, EdgeArtifactCertifyGood, EdgeArtifactCertifyVexStatement, EdgeArtifactHashEqual, EdgeArtifactHasMetadata, EdgeArtifactHasSbom, EdgeArtifactHasSlsa, EdgeArtifactIsOccurrence, EdgeArtifactPointOfContact, EdgeBuilderHasSlsa, EdgeLicenseCertifyLegal, EdgePackageCertifyBad, EdgePackageCertifyGood, EdgePackageCertifyLegal, EdgePackageCertifyVexStatement, EdgePackageCertifyVuln, EdgePackageHasMetadata, EdgePackageHasSbom, EdgePackageHasSourceAt, EdgePackageIsDependency, EdgePackageIsOccurrence, EdgePackageNamePackageNamespace, EdgePackageNamePackageVersion, EdgePackageNamespacePackageName, EdgePackageNamespacePackageType, EdgePackagePkgEqual, EdgePackagePointOfContact, EdgePackageTypePackageNamespace, EdgePackageVersionPackageName, EdgeSourceCertifyBad, EdgeSourceCertifyGood, EdgeSourceCertifyLegal, EdgeSourceCertifyScorecard, EdgeSourceHasMetadata, EdgeSourceHasSourceAt, EdgeSourceIsOccurrence, EdgeSourceNameSourceNamespace, EdgeSourceNamespaceSourceName, EdgeSourceNamespaceSourceType, EdgeSourcePointOfContact, EdgeSourceTypeSourceNamespace, EdgeVulnerabilityCertifyVexStatement, EdgeVulnerabilityCertifyVuln, EdgeVulnerabilityIDVulnerabilityType, EdgeVulnerabilityTypeVulnerabilityID, EdgeVulnerabilityVulnEqual, EdgeVulnerabilityVulnMetadata, EdgeCertifyBadArtifact, EdgeCertifyBadPackage, EdgeCertifyBadSource, EdgeCertifyGoodArtifact, EdgeCertifyGoodPackage, EdgeCertifyGoodSource, EdgeCertifyLegalLicense, EdgeCertifyLegalPackage, EdgeCertifyLegalSource, EdgeCertifyScorecardSource, EdgeCertifyVexStatementArtifact, EdgeCertifyVexStatementPackage, EdgeCertifyVexStatementVulnerability, EdgeCertifyVulnPackage, EdgeCertifyVulnVulnerability, EdgeHashEqualArtifact, EdgeHasMetadataArtifact, EdgeHasMetadataPackage, EdgeHasMetadataSource, EdgeHasSbomArtifact, EdgeHasSbomPackage, EdgeHasSbomIncludedSoftware, EdgeHasSbomIncludedDependencies, EdgeHasSbomIncludedOccurrences, EdgeHasSlsaBuiltBy, EdgeHasSlsaMaterials, EdgeHasSlsaSubject, EdgeHasSourceAtPackage, EdgeHasSourceAtSource, EdgeIsDependencyPackage, EdgeIsOccurrenceArtifact, EdgeIsOccurrencePackage, EdgeIsOccurrenceSource, EdgePkgEqualPackage, EdgePointOfContactArtifact, EdgePointOfContactPackage, EdgePointOfContactSource, EdgeVulnEqualVulnerability, EdgeVulnMetadataVulnerability:
+	case EdgeArtifactCertifyBad, EdgeArtifactCertifyGood, EdgeArtifactCertifyVexStatement, EdgeArtifactHashEqual, EdgeArtifactHasMetadata, EdgeArtifactHasSbom, EdgeArtifactHasSlsa, EdgeArtifactIsOccurrence, EdgeArtifactPointOfContact, EdgeBuilderHasSlsa, EdgeDocumentCertifyBad, EdgeDocumentCertifyGood, EdgeDocumentCertifyLegal, EdgeDocumentCertifyScorecard, EdgeDocumentCertifyVexStatement, EdgeDocumentCertifyVuln, EdgeDocumentHashEqual, EdgeDocumentHasMetadata, EdgeDocumentHasSbom, EdgeDocumentHasSlsa, EdgeDocumentHasSourceAt, EdgeDocumentIsDependency, EdgeDocumentIsOccurrence, EdgeDocumentPkgEqual, EdgeDocumentPointOfContact, EdgeDocumentVulnEqual, EdgeDocumentVulnMetadata, EdgeLicenseCertifyLegal, EdgePackageCertifyBad, EdgePackageCertifyGood, EdgePackageCertifyLegal, EdgePackageCertifyVexStatement, EdgePackageCertifyVuln, EdgePackageHasMetadata, EdgePackageHasSbom, EdgePackageHasSourceAt, EdgePackageIsDependency, EdgePackageIsOccurrence, EdgePackageNamePackageNamespace, EdgePackageNamePackageVersion, EdgePackageNamespacePackageName, EdgePackageNamespacePackageType, EdgePackagePkgEqual, EdgePackagePointOfContact, EdgePackageTypePackageNamespace, EdgePackageVersionPackageName, EdgeSourceCertifyBad, EdgeSourceCertifyGood, EdgeSourceCertifyLegal, EdgeSourceCertifyScorecard, EdgeSourceHasMetadata, EdgeSourceHasSourceAt, EdgeSourceIsOccurrence, EdgeSourceNameSourceNamespace, EdgeSourceNamespaceSourceName, EdgeSourceNamespaceSourceType, EdgeSourcePointOfContact, EdgeSourceTypeSourceNamespace, EdgeVulnerabilityCertifyVexStatement, EdgeVulnerabilityCertifyVuln, EdgeVulnerabilityIDVulnerabilityType, EdgeVulnerabilityTypeVulnerabilityID, EdgeVulnerabilityVulnEqual, EdgeVulnerabilityVulnMetadata, EdgeCertifyBadArtifact, EdgeCertifyBadDocument, EdgeCertifyBadPackage, EdgeCertifyBadSource, EdgeCertifyGoodArtifact, EdgeCertifyGoodDocument, EdgeCertifyGoodPackage, EdgeCertifyGoodSource, EdgeCertifyLegalDocument, EdgeCertifyLegalLicense, EdgeCertifyLegalPackage, EdgeCertifyLegalSource, EdgeCertifyScorecardDocument, EdgeCertifyScorecardSource, EdgeCertifyVexStatementArtifact, EdgeCertifyVexStatementDocument, EdgeCertifyVexStatementPackage, EdgeCertifyVexStatementVulnerability, EdgeCertifyVulnDocument, EdgeCertifyVulnPackage, EdgeCertifyVulnVulnerability, EdgeHashEqualArtifact, EdgeHashEqualDocument, EdgeHasMetadataArtifact, EdgeHasMetadataDocument, EdgeHasMetadataPackage, EdgeHasMetadataSource, EdgeHasSbomArtifact, EdgeHasSbomDocument, EdgeHasSbomPackage, EdgeHasSbomIncludedSoftware, EdgeHasSbomIncludedDependencies, EdgeHasSbomIncludedOccurrences, EdgeHasSlsaBuiltBy, EdgeHasSlsaDocument, EdgeHasSlsaMaterials, EdgeHasSlsaSubject, EdgeHasSourceAtDocument, EdgeHasSourceAtPackage, EdgeHasSourceAtSource, EdgeIsDependencyDocument, EdgeIsDependencyPackage, EdgeIsOccurrenceArtifact, EdgeIsOccurrenceDocument, EdgeIsOccurrencePackage, EdgeIsOccurrenceSource, EdgePkgEqualDocument, EdgePkgEqualPackage, EdgePointOfContactArtifact, EdgePointOfContactDocument, EdgePointOfContactPackage, EdgePointOfContactSource, EdgeVulnEqualDocument, EdgeVulnEqualVulnerability, EdgeVulnMetadataDocument, EdgeVulnMetadataVulnerability:
 		return true
 	}
 	return false
@@ -2816,6 +3134,75 @@ func (e QueryType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// SbomDiffChange is the kind of change of an entry of an SbomDiff between the
+// before and the after SBOM.
+//
+// For vulnerabilities, ADDED means that the vulnerability is new in the after
+// SBOM and REMOVED that it was resolved.
+type SbomDiffChange string
+
+const (
+	SbomDiffChangeAdded   SbomDiffChange = "ADDED"
+	SbomDiffChangeRemoved SbomDiffChange = "REMOVED"
+	// The version of the package is higher in the after SBOM.
+	SbomDiffChangeUpgraded SbomDiffChange = "UPGRADED"
+	// The version of the package is lower in the after SBOM.
+	SbomDiffChangeDowngraded SbomDiffChange = "DOWNGRADED"
+	// The version of the package changed, but the versions cannot be ordered.
+	SbomDiffChangeChanged SbomDiffChange = "CHANGED"
+)
+
+var AllSbomDiffChange = []SbomDiffChange{
+	SbomDiffChangeAdded,
+	SbomDiffChangeRemoved,
+	SbomDiffChangeUpgraded,
+	SbomDiffChangeDowngraded,
+	SbomDiffChangeChanged,
+}
+
+func (e SbomDiffChange) IsValid() bool {
+	switch e {
+	case SbomDiffChangeAdded, SbomDiffChangeRemoved, SbomDiffChangeUpgraded, SbomDiffChangeDowngraded, SbomDiffChangeChanged:
+		return true
+	}
+	return false
+}
+
+func (e SbomDiffChange) String() string {
+	return string(e)
+}
+
+func (e *SbomDiffChange) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SbomDiffChange(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SbomDiffChange", str)
+	}
+	return nil
+}
+
+func (e SbomDiffChange) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SbomDiffChange) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SbomDiffChange) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Records the justification included in the VEX statement.
 type VexJustification string
 
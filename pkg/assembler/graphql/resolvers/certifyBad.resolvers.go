@@ -7,6 +7,8 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -74,3 +76,21 @@ func (r *queryResolver) CertifyBadList(ctx context.Context, certifyBadSpec model
 	}
 	return r.Backend.CertifyBadList(ctx, certifyBadSpec, after, first)
 }
+
+// CertifyBadIngested is the resolver for the CertifyBadIngested field.
+func (r *subscriptionResolver) CertifyBadIngested(ctx context.Context, certifyBadSpec model.CertifyBadSpec) (<-chan *model.CertifyBad, error) {
+	if err := validatePackageSourceOrArtifactQueryFilter(certifyBadSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("CertifyBadIngested :: %s", err)
+	}
+	return subscribe(ctx, r.Backend, backends.EvidenceCertifyBad, certifyBadSpec.ID,
+		func(ctx context.Context, id string) ([]*model.CertifyBad, error) {
+			spec := certifyBadSpec
+			spec.ID = &id
+			return r.Backend.CertifyBad(ctx, &spec)
+		})
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -184,3 +185,16 @@ func (r *queryResolver) CertifyVEXStatementList(ctx context.Context, certifyVEXS
 		return r.Backend.CertifyVEXStatementList(ctx, certifyVEXStatementSpec, after, first)
 	}
 }
+
+// CertifyVEXStatementIngested is the resolver for the CertifyVEXStatementIngested field.
+func (r *subscriptionResolver) CertifyVEXStatementIngested(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec) (<-chan *model.CertifyVEXStatement, error) {
+	if err := validatePackageOrArtifactQueryFilter(certifyVEXStatementSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("CertifyVEXStatementIngested :: %s", err)
+	}
+	return subscribe(ctx, r.Backend, backends.EvidenceCertifyVEXStatement, certifyVEXStatementSpec.ID,
+		func(ctx context.Context, id string) ([]*model.CertifyVEXStatement, error) {
+			spec := certifyVEXStatementSpec
+			spec.ID = &id
+			return r.Backend.CertifyVEXStatement(ctx, &spec)
+		})
+}
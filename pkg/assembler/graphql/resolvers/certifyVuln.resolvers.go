@@ -8,6 +8,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -168,3 +169,16 @@ func (r *queryResolver) CertifyVulnList(ctx context.Context, certifyVulnSpec mod
 func (r *queryResolver) BatchQueryPkgIDCertifyVuln(ctx context.Context, pkgIDs []string) ([]*model.CertifyVuln, error) {
 	return r.Backend.BatchQueryPkgIDCertifyVuln(ctx, pkgIDs)
 }
+
+// CertifyVulnIngested is the resolver for the CertifyVulnIngested field.
+func (r *subscriptionResolver) CertifyVulnIngested(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) (<-chan *model.CertifyVuln, error) {
+	if v := certifyVulnSpec.Vulnerability; v != nil && v.NoVuln != nil && !*v.NoVuln && v.Type != nil && strings.ToLower(*v.Type) == "novuln" {
+		return nil, gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
+	}
+	return subscribe(ctx, r.Backend, backends.EvidenceCertifyVuln, certifyVulnSpec.ID,
+		func(ctx context.Context, id string) ([]*model.CertifyVuln, error) {
+			spec := certifyVulnSpec
+			spec.ID = &id
+			return r.Backend.CertifyVuln(ctx, &spec)
+		})
+}
@@ -0,0 +1,76 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.79
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// IngestDocument is the resolver for the ingestDocument field.
+func (r *mutationResolver) IngestDocument(ctx context.Context, document model.DocumentInputSpec) (string, error) {
+	if err := validateDocumentInput(&document); err != nil {
+		return "", err
+	}
+	return r.Backend.IngestDocument(ctx, document)
+}
+
+// IngestDocuments is the resolver for the ingestDocuments field.
+func (r *mutationResolver) IngestDocuments(ctx context.Context, documents []*model.DocumentInputSpec) ([]string, error) {
+	for _, d := range documents {
+		if err := validateDocumentInput(d); err != nil {
+			return nil, err
+		}
+	}
+	return r.Backend.IngestDocuments(ctx, documents)
+}
+
+// RetractDocument is the resolver for the retractDocument field.
+func (r *mutationResolver) RetractDocument(ctx context.Context, documentRef string, dryRun bool) (*model.RetractDocumentResult, error) {
+	if documentRef == "" {
+		return nil, gqlerror.Errorf("retractDocument :: documentRef must not be empty")
+	}
+	return r.Backend.RetractDocument(ctx, documentRef, dryRun)
+}
+
+// Documents is the resolver for the documents field.
+func (r *queryResolver) Documents(ctx context.Context, documentSpec model.DocumentSpec) ([]*model.Document, error) {
+	return r.Backend.Documents(ctx, &documentSpec)
+}
+
+// DocumentList is the resolver for the documentList field.
+func (r *queryResolver) DocumentList(ctx context.Context, documentSpec model.DocumentSpec, after *string, first *int) (*model.DocumentConnection, error) {
+	return r.Backend.DocumentList(ctx, documentSpec, after, first)
+}
+
+// DocumentContent is the resolver for the documentContent field.
+func (r *queryResolver) DocumentContent(ctx context.Context, documentID string) (string, error) {
+	if r.BlobStore == nil {
+		return "", gqlerror.Errorf("documentContent :: no blob store is configured for this server")
+	}
+	docs, err := r.Backend.Documents(ctx, &model.DocumentSpec{ID: &documentID})
+	if err != nil {
+		return "", gqlerror.Errorf("documentContent :: %v", err)
+	}
+	if len(docs) != 1 {
+		return "", gqlerror.Errorf("documentContent :: document %s not found", documentID)
+	}
+	doc := docs[0]
+	content, err := r.BlobStore.Read(ctx, doc.BlobKey)
+	if err != nil {
+		return "", gqlerror.Errorf("documentContent :: failed to read %s from the blob store: %v", doc.BlobKey, err)
+	}
+	sum := sha256.Sum256(content)
+	if !strings.EqualFold(hex.EncodeToString(sum[:]), doc.Sha256) {
+		return "", gqlerror.Errorf("documentContent :: content of %s does not match sha256 %s", doc.BlobKey, doc.Sha256)
+	}
+	return base64.StdEncoding.EncodeToString(content), nil
+}
@@ -0,0 +1,210 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/events"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIngestDocument(t *testing.T) {
+	valid := model.DocumentInputSpec{
+		BlobKey:       "sha256_abc",
+		Sha256:        "abc",
+		DocumentType:  "SPDX",
+		Format:        "JSON",
+		Collector:     "FileCollector",
+		Source:        "file:///sbom.json",
+		ParserVersion: "v1.0.0",
+		IngestedAt:    time.Unix(1e9, 0),
+	}
+	noKey := valid
+	noKey.BlobKey = ""
+	noDigest := valid
+	noDigest.Sha256 = ""
+	tests := []struct {
+		Name         string
+		Call         model.DocumentInputSpec
+		ExpIngestErr bool
+	}{
+		{
+			Name: "Happy path",
+			Call: valid,
+		},
+		{
+			Name:         "Missing blob key",
+			Call:         noKey,
+			ExpIngestErr: true,
+		},
+		{
+			Name:         "Missing sha256",
+			Call:         noDigest,
+			ExpIngestErr: true,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpIngestErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				IngestDocument(ctx, test.Call).
+				Return("", nil).
+				Times(times)
+			_, err := r.Mutation().IngestDocument(ctx, test.Call)
+			if (err != nil) != test.ExpIngestErr {
+				t.Errorf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+			}
+		})
+	}
+}
+
+func TestDocumentContent(t *testing.T) {
+	ctx := context.Background()
+	content := []byte(`{"spdxVersion":"SPDX-2.3"}`)
+	key := events.GetKey(content)
+
+	store, err := blob.NewBlobStore(ctx, "mem://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Write(ctx, key, content); err != nil {
+		t.Fatal(err)
+	}
+	tampered := []byte(`{"spdxVersion":"SPDX-2.2"}`)
+	if err := store.Write(ctx, "sha256_tampered", tampered); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name      string
+		BlobStore *blob.BlobStore
+		Doc       *model.Document
+		Exp       []byte
+		ExpErr    string
+	}{
+		{
+			Name:      "Happy path",
+			BlobStore: store,
+			Doc:       &model.Document{ID: "1", BlobKey: key, Sha256: strings.TrimPrefix(key, "sha256_")},
+			Exp:       content,
+		},
+		{
+			Name:      "Digest mismatch",
+			BlobStore: store,
+			Doc:       &model.Document{ID: "1", BlobKey: "sha256_tampered", Sha256: strings.TrimPrefix(key, "sha256_")},
+			ExpErr:    "does not match sha256",
+		},
+		{
+			Name:      "Missing blob",
+			BlobStore: store,
+			Doc:       &model.Document{ID: "1", BlobKey: "sha256_missing", Sha256: "missing"},
+			ExpErr:    "failed to read",
+		},
+		{
+			Name:   "No blob store",
+			ExpErr: "no blob store",
+		},
+	}
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b, BlobStore: test.BlobStore}
+			if test.Doc != nil {
+				b.
+					EXPECT().
+					Documents(ctx, &model.DocumentSpec{ID: &test.Doc.ID}).
+					Return([]*model.Document{test.Doc}, nil).
+					Times(1)
+			}
+			id := "1"
+			got, err := r.Query().DocumentContent(ctx, id)
+			if test.ExpErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpErr) {
+					t.Fatalf("DocumentContent() error = %v, want %q", err, test.ExpErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DocumentContent() error = %v", err)
+			}
+			if got != base64.StdEncoding.EncodeToString(test.Exp) {
+				t.Errorf("DocumentContent() = %q, want %q", got, base64.StdEncoding.EncodeToString(test.Exp))
+			}
+		})
+	}
+}
+
+func TestRetractDocument(t *testing.T) {
+	tests := []struct {
+		Name          string
+		DocumentRef   string
+		DryRun        bool
+		ExpRetractErr bool
+	}{
+		{
+			Name:        "Happy path",
+			DocumentRef: "sha256_abc",
+		},
+		{
+			Name:        "Dry run",
+			DocumentRef: "sha256_abc",
+			DryRun:      true,
+		},
+		{
+			Name:          "Missing document ref",
+			ExpRetractErr: true,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpRetractErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				RetractDocument(ctx, test.DocumentRef, test.DryRun).
+				Return(&model.RetractDocumentResult{DocumentRef: test.DocumentRef, DryRun: test.DryRun}, nil).
+				Times(times)
+			_, err := r.Mutation().RetractDocument(ctx, test.DocumentRef, test.DryRun)
+			if (err != nil) != test.ExpRetractErr {
+				t.Errorf("did not get expected retract error, want: %v, got: %v", test.ExpRetractErr, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -72,3 +73,16 @@ func (r *queryResolver) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSB
 	}
 	return r.Backend.HasSBOMList(ctx, hasSBOMSpec, after, first)
 }
+
+// HasSBOMIngested is the resolver for the HasSBOMIngested field.
+func (r *subscriptionResolver) HasSBOMIngested(ctx context.Context, hasSBOMSpec model.HasSBOMSpec) (<-chan *model.HasSbom, error) {
+	if err := validatePackageOrArtifactQueryFilter(hasSBOMSpec.Subject); err != nil {
+		return nil, gqlerror.Errorf("HasSBOMIngested :: %s", err)
+	}
+	return subscribe(ctx, r.Backend, backends.EvidenceHasSBOM, hasSBOMSpec.ID,
+		func(ctx context.Context, id string) ([]*model.HasSbom, error) {
+			spec := hasSBOMSpec
+			spec.ID = &id
+			return r.Backend.HasSBOM(ctx, &spec)
+		})
+}
@@ -0,0 +1,374 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// reachNode is a package version or an artifact visited while walking the
+// graph from the root of a findReachableVulnerabilities query.
+type reachNode struct {
+	// id is the ID of the package version or of the artifact
+	id   string
+	node model.Node
+	// parent is nil for the roots
+	parent *reachNode
+	// edge is the evidence node connecting parent to this node
+	edge model.Node
+	// depth is the number of IsDependency edges between the root and this node
+	depth int
+}
+
+func (n *reachNode) isPackage() bool {
+	_, ok := n.node.(*model.Package)
+	return ok
+}
+
+// path returns the chain of nodes from the root to n, alternating between
+// software and evidence nodes.
+func (n *reachNode) path() []model.Node {
+	var reversed []model.Node
+	for cur := n; cur != nil; cur = cur.parent {
+		reversed = append(reversed, cur.node)
+		if cur.edge != nil {
+			reversed = append(reversed, cur.edge)
+		}
+	}
+	path := make([]model.Node, 0, len(reversed))
+	for i := len(reversed) - 1; i >= 0; i-- {
+		path = append(path, reversed[i])
+	}
+	return path
+}
+
+// findReachableVulnerabilities searches from the nodes matched by root over
+// IsDependency, IsOccurrence and HasSBOM and returns the vulnerabilities of all
+// reached package versions. Only IsDependency edges count towards the depth,
+// so the search is a 0-1 BFS: nodes reached over an IsOccurrence or HasSBOM
+// edge go to the front of the queue and nodes reached over an IsDependency
+// edge to the back. The first time a node is taken from the queue is
+// therefore via the chain with the fewest IsDependency edges. A maxDepth of 0
+// means that the number of IsDependency edges followed is not limited. If
+// depType is set, only IsDependency edges of that type are followed.
+func findReachableVulnerabilities(ctx context.Context, b backends.Backend, root model.PackageOrArtifactSpec, maxDepth int, depType *model.DependencyType) ([]*model.ReachableVulnerability, error) {
+	queue, err := reachRoots(ctx, b, root)
+	if err != nil {
+		return nil, err
+	}
+
+	// best holds the node with the lowest depth found so far for every ID.
+	// Queue entries that have been superseded are skipped.
+	best := map[string]*reachNode{}
+	done := map[string]bool{}
+	var order []*reachNode
+	for _, n := range queue {
+		best[n.id] = n
+	}
+	for len(queue) > 0 {
+		now := queue[0]
+		queue = queue[1:]
+		if done[now.id] || best[now.id] != now {
+			continue
+		}
+		done[now.id] = true
+		order = append(order, now)
+
+		next, err := reachNeighbors(ctx, b, now, maxDepth, depType)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range next {
+			if prev, seen := best[n.id]; seen && prev.depth <= n.depth {
+				continue
+			}
+			best[n.id] = n
+			if n.depth == now.depth {
+				queue = append([]*reachNode{n}, queue...)
+			} else {
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	var results []*model.ReachableVulnerability
+	vexCache := map[string][]*model.CertifyVEXStatement{}
+	for _, n := range order {
+		if !n.isPackage() {
+			continue
+		}
+		certifyVulns, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{
+			Package:       &model.PkgSpec{ID: ptrfrom.String(n.id)},
+			Vulnerability: &model.VulnerabilitySpec{NoVuln: ptrfrom.Bool(false)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query vulnerabilities of package %s: %w", n.id, err)
+		}
+		for _, cv := range certifyVulns {
+			path := n.path()
+			vexStatements, err := notAffectedStatements(ctx, b, cv.Vulnerability, vexCache)
+			if err != nil {
+				return nil, err
+			}
+			result := &model.ReachableVulnerability{
+				CertifyVuln:   cv,
+				Path:          path,
+				Depth:         n.depth,
+				VexStatements: []*model.CertifyVEXStatement{},
+			}
+			onPath := map[string]bool{}
+			for cur := n; cur != nil; cur = cur.parent {
+				onPath[cur.id] = true
+			}
+			for _, vex := range vexStatements {
+				if vexSubjectOnPath(vex.Subject, onPath) {
+					result.NotAffected = true
+					result.VexStatements = append(result.VexStatements, vex)
+				}
+			}
+			results = append(results, result)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Depth != results[j].Depth {
+			return results[i].Depth < results[j].Depth
+		}
+		return vulnerabilityKey(results[i].CertifyVuln.Vulnerability) < vulnerabilityKey(results[j].CertifyVuln.Vulnerability)
+	})
+	return results, nil
+}
+
+// reachRoots returns the package versions or artifacts matched by root.
+func reachRoots(ctx context.Context, b backends.Backend, root model.PackageOrArtifactSpec) ([]*reachNode, error) {
+	var roots []*reachNode
+	switch {
+	case root.Package != nil:
+		pkgs, err := b.Packages(ctx, root.Package)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query root package: %w", err)
+		}
+		for _, p := range pkgs {
+			roots = append(roots, packageVersions(p)...)
+		}
+	case root.Artifact != nil:
+		arts, err := b.Artifacts(ctx, root.Artifact)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query root artifact: %w", err)
+		}
+		for _, a := range arts {
+			roots = append(roots, &reachNode{id: a.ID, node: a})
+		}
+	}
+	return roots, nil
+}
+
+// reachNeighbors returns the package versions and artifacts that are directly
+// connected to n.
+func reachNeighbors(ctx context.Context, b backends.Backend, n *reachNode, maxDepth int, depType *model.DependencyType) ([]*reachNode, error) {
+	var next []*reachNode
+	child := func(node *reachNode, edge model.Node, depth int) {
+		if node.id == n.id {
+			return
+		}
+		node.parent = n
+		node.edge = edge
+		node.depth = depth
+		next = append(next, node)
+	}
+
+	var subject model.PackageOrArtifactSpec
+	if n.isPackage() {
+		pkgFilter := &model.PkgSpec{ID: ptrfrom.String(n.id)}
+		subject.Package = pkgFilter
+
+		if maxDepth == 0 || n.depth < maxDepth {
+			deps, err := b.IsDependency(ctx, &model.IsDependencySpec{Package: pkgFilter, DependencyType: depType})
+			if err != nil {
+				return nil, fmt.Errorf("failed to query dependencies of package %s: %w", n.id, err)
+			}
+			for _, dep := range deps {
+				for _, v := range packageVersions(dep.DependencyPackage) {
+					child(v, dep, n.depth+1)
+				}
+			}
+		}
+
+		occurrences, err := b.IsOccurrence(ctx, &model.IsOccurrenceSpec{Subject: &model.PackageOrSourceSpec{Package: pkgFilter}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query occurrences of package %s: %w", n.id, err)
+		}
+		for _, occ := range occurrences {
+			child(&reachNode{id: occ.Artifact.ID, node: occ.Artifact}, occ, n.depth)
+		}
+	} else {
+		artFilter := &model.ArtifactSpec{ID: ptrfrom.String(n.id)}
+		subject.Artifact = artFilter
+
+		occurrences, err := b.IsOccurrence(ctx, &model.IsOccurrenceSpec{Artifact: artFilter})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query occurrences of artifact %s: %w", n.id, err)
+		}
+		for _, occ := range occurrences {
+			if p, ok := occ.Subject.(*model.Package); ok {
+				for _, v := range packageVersions(p) {
+					child(v, occ, n.depth)
+				}
+			}
+		}
+	}
+
+	sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{Subject: &subject})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query SBOMs of %s: %w", n.id, err)
+	}
+	for _, sbom := range sboms {
+		for _, top := range sbomTopLevel(sbom) {
+			child(top, sbom, n.depth)
+		}
+	}
+	return next, nil
+}
+
+// sbomTopLevel returns the software described by an SBOM: the subjects of the
+// included dependencies that are not themselves a dependency of another
+// included package. If the SBOM has no dependencies, all included software is
+// returned.
+func sbomTopLevel(sbom *model.HasSbom) []*reachNode {
+	var top []*reachNode
+	if len(sbom.IncludedDependencies) == 0 {
+		for _, s := range sbom.IncludedSoftware {
+			switch v := s.(type) {
+			case *model.Package:
+				top = append(top, packageVersions(v)...)
+			case *model.Artifact:
+				top = append(top, &reachNode{id: v.ID, node: v})
+			}
+		}
+		return top
+	}
+
+	isDependency := map[string]bool{}
+	for _, dep := range sbom.IncludedDependencies {
+		for _, v := range packageVersions(dep.DependencyPackage) {
+			isDependency[v.id] = true
+		}
+	}
+	seen := map[string]bool{}
+	for _, dep := range sbom.IncludedDependencies {
+		for _, v := range packageVersions(dep.Package) {
+			if !isDependency[v.id] && !seen[v.id] {
+				seen[v.id] = true
+				top = append(top, v)
+			}
+		}
+	}
+	return top
+}
+
+// packageVersions splits a package tree into one trimmed tree per package
+// version.
+func packageVersions(p *model.Package) []*reachNode {
+	if p == nil {
+		return nil
+	}
+	var nodes []*reachNode
+	for _, ns := range p.Namespaces {
+		for _, name := range ns.Names {
+			for _, version := range name.Versions {
+				nodes = append(nodes, &reachNode{
+					id: version.ID,
+					node: &model.Package{
+						ID:   p.ID,
+						Type: p.Type,
+						Namespaces: []*model.PackageNamespace{{
+							ID:        ns.ID,
+							Namespace: ns.Namespace,
+							Names: []*model.PackageName{{
+								ID:       name.ID,
+								Name:     name.Name,
+								Versions: []*model.PackageVersion{version},
+							}},
+						}},
+					},
+				})
+			}
+		}
+	}
+	return nodes
+}
+
+// notAffectedStatements returns all the VEX statements which mark any of the
+// IDs of vuln as NOT_AFFECTED, for any subject.
+func notAffectedStatements(ctx context.Context, b backends.Backend, vuln *model.Vulnerability, cache map[string][]*model.CertifyVEXStatement) ([]*model.CertifyVEXStatement, error) {
+	if vuln == nil {
+		return nil, nil
+	}
+	var statements []*model.CertifyVEXStatement
+	seen := map[string]bool{}
+	for _, id := range vuln.VulnerabilityIDs {
+		key := vuln.Type + "/" + id.VulnerabilityID
+		byID, ok := cache[key]
+		if !ok {
+			var err error
+			byID, err = b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{
+				Vulnerability: &model.VulnerabilitySpec{
+					Type:            ptrfrom.String(vuln.Type),
+					VulnerabilityID: ptrfrom.String(id.VulnerabilityID),
+				},
+				Status: ptrfrom.Any(model.VexStatusNotAffected),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to query VEX statements for %s: %w", key, err)
+			}
+			cache[key] = byID
+		}
+		for _, statement := range byID {
+			if !seen[statement.ID] {
+				seen[statement.ID] = true
+				statements = append(statements, statement)
+			}
+		}
+	}
+	return statements, nil
+}
+
+func vexSubjectOnPath(subject model.PackageOrArtifact, onPath map[string]bool) bool {
+	switch s := subject.(type) {
+	case *model.Package:
+		for _, v := range packageVersions(s) {
+			if onPath[v.id] {
+				return true
+			}
+		}
+	case *model.Artifact:
+		return onPath[s.ID]
+	}
+	return false
+}
+
+func vulnerabilityKey(vuln *model.Vulnerability) string {
+	if vuln == nil || len(vuln.VulnerabilityIDs) == 0 {
+		return ""
+	}
+	return vuln.Type + "/" + vuln.VulnerabilityIDs[0].VulnerabilityID
+}
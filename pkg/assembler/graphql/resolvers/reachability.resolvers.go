@@ -0,0 +1,29 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.79
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// FindReachableVulnerabilities is the resolver for the findReachableVulnerabilities field.
+func (r *queryResolver) FindReachableVulnerabilities(ctx context.Context, root model.PackageOrArtifactSpec, maxDepth *int, dependencyType *model.DependencyType) ([]*model.ReachableVulnerability, error) {
+	funcName := "FindReachableVulnerabilities"
+	if err := validatePackageOrArtifactQueryFilter(&root); err != nil {
+		return nil, gqlerror.Errorf("%v ::  %s", funcName, err)
+	}
+	depth := 0
+	if maxDepth != nil {
+		if *maxDepth < 0 {
+			return nil, gqlerror.Errorf("%v :: maxDepth argument must not be negative, got %d", funcName, *maxDepth)
+		}
+		depth = *maxDepth
+	}
+
+	return findReachableVulnerabilities(ctx, r.Backend, root, depth, dependencyType)
+}
@@ -0,0 +1,292 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestFindReachableVulnerabilities(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("failed to get backend: %v", err)
+	}
+
+	app := &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "app", Version: ptrfrom.String("v1.0.0")}
+	lib := &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "lib", Version: ptrfrom.String("v1.0.0")}
+	leaf := &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "leaf", Version: ptrfrom.String("v2.0.0")}
+	art := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"}
+	libVuln := &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "cve-2026-0001"}
+	leafVuln := &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "cve-2026-0002"}
+
+	for _, p := range []*model.PkgInputSpec{app, lib, leaf} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p}); err != nil {
+			t.Fatalf("failed to ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: art}); err != nil {
+		t.Fatalf("failed to ingest artifact: %v", err)
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{libVuln, leafVuln} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("failed to ingest vulnerability: %v", err)
+		}
+	}
+	for _, dep := range [][2]*model.PkgInputSpec{{app, lib}, {lib, leaf}} {
+		if _, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: dep[0]}, model.IDorPkgInput{PackageInput: dep[1]},
+			model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Origin: "test"}); err != nil {
+			t.Fatalf("failed to ingest dependency: %v", err)
+		}
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: app}},
+		model.IDorArtifactInput{ArtifactInput: art}, model.IsOccurrenceInputSpec{Origin: "test"}); err != nil {
+		t.Fatalf("failed to ingest occurrence: %v", err)
+	}
+	for _, cv := range []struct {
+		pkg  *model.PkgInputSpec
+		vuln *model.VulnerabilityInputSpec
+	}{{lib, libVuln}, {leaf, leafVuln}} {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: cv.pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: cv.vuln},
+			model.ScanMetadataInput{DbURI: "osv.dev", Origin: "test"}); err != nil {
+			t.Fatalf("failed to ingest certifyVuln: %v", err)
+		}
+	}
+	// lib does not call the vulnerable code of leaf
+	if _, err := b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: lib}},
+		model.IDorVulnerabilityInput{VulnerabilityInput: leafVuln},
+		model.VexStatementInputSpec{Status: model.VexStatusNotAffected, VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath, Origin: "test"}); err != nil {
+		t.Fatalf("failed to ingest VEX statement: %v", err)
+	}
+
+	type result struct {
+		vulnID      string
+		depth       int
+		pathLen     int
+		notAffected bool
+	}
+	tests := []struct {
+		Name     string
+		Root     model.PackageOrArtifactSpec
+		MaxDepth *int
+		Want     []result
+		WantErr  bool
+	}{
+		{
+			Name: "From package",
+			Root: model.PackageOrArtifactSpec{Package: &model.PkgSpec{Name: ptrfrom.String("app")}},
+			Want: []result{
+				{vulnID: "cve-2026-0001", depth: 1, pathLen: 3},
+				{vulnID: "cve-2026-0002", depth: 2, pathLen: 5, notAffected: true},
+			},
+		},
+		{
+			Name:     "From package with maxDepth",
+			Root:     model.PackageOrArtifactSpec{Package: &model.PkgSpec{Name: ptrfrom.String("app")}},
+			MaxDepth: ptrfrom.Int(1),
+			Want: []result{
+				{vulnID: "cve-2026-0001", depth: 1, pathLen: 3},
+			},
+		},
+		{
+			Name: "From artifact",
+			Root: model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{Digest: ptrfrom.String(art.Digest)}},
+			Want: []result{
+				{vulnID: "cve-2026-0001", depth: 1, pathLen: 5},
+				{vulnID: "cve-2026-0002", depth: 2, pathLen: 7, notAffected: true},
+			},
+		},
+		{
+			Name: "From vulnerable package",
+			Root: model.PackageOrArtifactSpec{Package: &model.PkgSpec{Name: ptrfrom.String("leaf")}},
+			Want: []result{
+				{vulnID: "cve-2026-0002", depth: 0, pathLen: 1},
+			},
+		},
+		{
+			Name:     "Negative maxDepth",
+			Root:     model.PackageOrArtifactSpec{Package: &model.PkgSpec{Name: ptrfrom.String("app")}},
+			MaxDepth: ptrfrom.Int(-1),
+			WantErr:  true,
+		},
+		{
+			Name: "Both package and artifact",
+			Root: model.PackageOrArtifactSpec{
+				Package:  &model.PkgSpec{Name: ptrfrom.String("app")},
+				Artifact: &model.ArtifactSpec{Digest: ptrfrom.String(art.Digest)},
+			},
+			WantErr: true,
+		},
+	}
+	r := resolvers.Resolver{Backend: b}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := r.Query().FindReachableVulnerabilities(ctx, test.Root, test.MaxDepth, nil)
+			if (err != nil) != test.WantErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.WantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if len(got) != len(test.Want) {
+				t.Fatalf("unexpected number of results, want: %d, got: %d", len(test.Want), len(got))
+			}
+			for i, want := range test.Want {
+				if id := got[i].CertifyVuln.Vulnerability.VulnerabilityIDs[0].VulnerabilityID; id != want.vulnID {
+					t.Errorf("result %d: unexpected vulnerability, want: %s, got: %s", i, want.vulnID, id)
+				}
+				if got[i].Depth != want.depth {
+					t.Errorf("result %d: unexpected depth, want: %d, got: %d", i, want.depth, got[i].Depth)
+				}
+				if len(got[i].Path) != want.pathLen {
+					t.Errorf("result %d: unexpected path length, want: %d, got: %d", i, want.pathLen, len(got[i].Path))
+				}
+				if got[i].NotAffected != want.notAffected {
+					t.Errorf("result %d: unexpected notAffected, want: %v, got: %v", i, want.notAffected, got[i].NotAffected)
+				}
+				if want.notAffected && len(got[i].VexStatements) == 0 {
+					t.Errorf("result %d: expected VEX statements", i)
+				}
+			}
+		})
+	}
+}
+
+func TestFindReachableVulnerabilitiesChains(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("failed to get backend: %v", err)
+	}
+
+	svc := &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "svc", Version: ptrfrom.String("v1.0.0")}
+	mid := &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "mid", Version: ptrfrom.String("v1.0.0")}
+	deep := &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "deep", Version: ptrfrom.String("v1.0.0")}
+	bundled := &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: "bundled", Version: ptrfrom.String("v1.0.0")}
+	image := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "1f7a8f47318e4676dacb0142afa0b83029cd7befd9a1f7a8f47318e4676dacb0"}
+	deepVuln := &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "cve-2026-0010"}
+	bundledVuln := &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "cve-2026-0011"}
+
+	var bundledID string
+	for _, p := range []*model.PkgInputSpec{svc, mid, deep, bundled} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("failed to ingest package: %v", err)
+		}
+		if p == bundled {
+			bundledID = ids.PackageVersionID
+		}
+	}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: image}); err != nil {
+		t.Fatalf("failed to ingest artifact: %v", err)
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{deepVuln, bundledVuln} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("failed to ingest vulnerability: %v", err)
+		}
+	}
+	// svc -> mid -> deep are direct dependencies, and an SBOM of svc also
+	// lists deep as an indirect dependency of svc. svc -> mid -> bundled is
+	// two IsDependency edges, but bundled is also in the SBOM of the image
+	// svc occurs in, which does not add to the depth.
+	for _, dep := range []struct {
+		pkg, depPkg *model.PkgInputSpec
+		depType     model.DependencyType
+	}{
+		{svc, mid, model.DependencyTypeDirect},
+		{mid, deep, model.DependencyTypeDirect},
+		{svc, deep, model.DependencyTypeIndirect},
+		{mid, bundled, model.DependencyTypeDirect},
+	} {
+		if _, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: dep.pkg}, model.IDorPkgInput{PackageInput: dep.depPkg},
+			model.IsDependencyInputSpec{DependencyType: dep.depType, Origin: "test"}); err != nil {
+			t.Fatalf("failed to ingest dependency: %v", err)
+		}
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: svc}},
+		model.IDorArtifactInput{ArtifactInput: image}, model.IsOccurrenceInputSpec{Origin: "test"}); err != nil {
+		t.Fatalf("failed to ingest occurrence: %v", err)
+	}
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: image}},
+		model.HasSBOMInputSpec{URI: "test", KnownSince: time.Unix(1e9, 0), Origin: "test"},
+		model.HasSBOMIncludesInputSpec{Packages: []string{bundledID}}); err != nil {
+		t.Fatalf("failed to ingest SBOM: %v", err)
+	}
+	for _, cv := range []struct {
+		pkg  *model.PkgInputSpec
+		vuln *model.VulnerabilityInputSpec
+	}{{deep, deepVuln}, {bundled, bundledVuln}} {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: cv.pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: cv.vuln},
+			model.ScanMetadataInput{DbURI: "osv.dev", Origin: "test"}); err != nil {
+			t.Fatalf("failed to ingest certifyVuln: %v", err)
+		}
+	}
+
+	root := model.PackageOrArtifactSpec{Package: &model.PkgSpec{Name: ptrfrom.String("svc")}}
+	tests := []struct {
+		Name     string
+		DepType  *model.DependencyType
+		MaxDepth *int
+		Want     map[string]int
+	}{
+		{
+			Name: "All dependencies",
+			Want: map[string]int{"cve-2026-0010": 1, "cve-2026-0011": 0},
+		},
+		{
+			Name:    "Direct dependencies",
+			DepType: ptrfrom.Any(model.DependencyTypeDirect),
+			Want:    map[string]int{"cve-2026-0010": 2, "cve-2026-0011": 0},
+		},
+		{
+			Name:     "Direct dependencies with maxDepth",
+			DepType:  ptrfrom.Any(model.DependencyTypeDirect),
+			MaxDepth: ptrfrom.Int(1),
+			Want:     map[string]int{"cve-2026-0011": 0},
+		},
+		{
+			Name:    "Indirect dependencies",
+			DepType: ptrfrom.Any(model.DependencyTypeIndirect),
+			Want:    map[string]int{"cve-2026-0010": 1, "cve-2026-0011": 0},
+		},
+	}
+	r := resolvers.Resolver{Backend: b}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := r.Query().FindReachableVulnerabilities(ctx, root, test.MaxDepth, test.DepType)
+			if err != nil {
+				t.Fatalf("unexpected query error: %v", err)
+			}
+			depths := map[string]int{}
+			for _, res := range got {
+				depths[res.CertifyVuln.Vulnerability.VulnerabilityIDs[0].VulnerabilityID] = res.Depth
+			}
+			if diff := cmp.Diff(test.Want, depths); diff != "" {
+				t.Errorf("unexpected depths (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
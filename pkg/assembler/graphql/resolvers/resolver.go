@@ -21,8 +21,12 @@ package resolvers
 
 import (
 	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/blob"
 )
 
 type Resolver struct {
 	Backend backends.Backend
+	// BlobStore holds the original documents, used to return the content
+	// of a Document. It is optional.
+	BlobStore *blob.BlobStore
 }
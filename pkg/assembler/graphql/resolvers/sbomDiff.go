@@ -0,0 +1,495 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// sbomPackages maps the type, namespace and name of the packages included in
+// an SBOM to their package versions, by ID.
+type sbomPackages map[string]map[string]*reachNode
+
+// versionPair is a package whose version changed between two SBOMs.
+type versionPair struct {
+	change        model.SbomDiffChange
+	before, after *reachNode
+}
+
+// sbomDiff compares the SBOMs identified by before and after. The backend is
+// queried for the vulnerabilities, licenses and scorecards of the packages
+// that differ.
+func sbomDiff(ctx context.Context, b backends.Backend, before, after string) (*model.SbomDiff, error) {
+	beforeSBOM, err := resolveSBOM(ctx, b, before)
+	if err != nil {
+		return nil, err
+	}
+	afterSBOM, err := resolveSBOM(ctx, b, after)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &model.SbomDiff{
+		Before:          beforeSBOM,
+		After:           afterSBOM,
+		Packages:        []*model.SbomPackageDiff{},
+		Artifacts:       []*model.SbomArtifactDiff{},
+		Dependencies:    []*model.SbomDependencyDiff{},
+		Vulnerabilities: []*model.SbomVulnerabilityDiff{},
+		Licenses:        []*model.SbomLicenseDiff{},
+		Scorecards:      []*model.SbomScorecardDiff{},
+	}
+	beforePkgs := includedPackages(beforeSBOM)
+	afterPkgs := includedPackages(afterSBOM)
+
+	pairs := diffPackages(beforePkgs, afterPkgs)
+	for _, p := range pairs {
+		entry := &model.SbomPackageDiff{Change: p.change}
+		if p.before != nil {
+			entry.Before = p.before.node.(*model.Package)
+		}
+		if p.after != nil {
+			entry.After = p.after.node.(*model.Package)
+		}
+		diff.Packages = append(diff.Packages, entry)
+	}
+	diff.Artifacts = diffArtifacts(beforeSBOM, afterSBOM)
+	diff.Dependencies = diffDependencies(beforeSBOM, afterSBOM)
+
+	if diff.Vulnerabilities, err = diffVulnerabilities(ctx, b, beforePkgs, afterPkgs); err != nil {
+		return nil, err
+	}
+	if diff.Licenses, err = diffLicenses(ctx, b, pairs); err != nil {
+		return nil, err
+	}
+	if diff.Scorecards, err = diffScorecards(ctx, b, pairs); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// resolveSBOM returns the SBOM with the given ID, or the most recent SBOM of
+// the artifact or package version with the given ID.
+func resolveSBOM(ctx context.Context, b backends.Backend, id string) (*model.HasSbom, error) {
+	node, err := b.Node(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query node %s: %w", id, err)
+	}
+	var subject model.PackageOrArtifactSpec
+	switch n := node.(type) {
+	case *model.HasSbom:
+		return n, nil
+	case *model.Artifact:
+		subject.Artifact = &model.ArtifactSpec{ID: ptrfrom.String(id)}
+	case *model.Package:
+		subject.Package = &model.PkgSpec{ID: ptrfrom.String(id)}
+	default:
+		return nil, fmt.Errorf("node %s is not an SBOM, an artifact or a package", id)
+	}
+	sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{Subject: &subject})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query SBOMs of %s: %w", id, err)
+	}
+	if len(sboms) == 0 {
+		return nil, fmt.Errorf("no SBOM found for %s", id)
+	}
+	latest := sboms[0]
+	for _, s := range sboms[1:] {
+		if s.KnownSince.After(latest.KnownSince) {
+			latest = s
+		}
+	}
+	return latest, nil
+}
+
+// includedPackages returns the package versions included in sbom, either as
+// software or as part of a dependency.
+func includedPackages(sbom *model.HasSbom) sbomPackages {
+	pkgs := sbomPackages{}
+	add := func(p *model.Package) {
+		for _, v := range packageVersions(p) {
+			key := packageNameKey(v.node.(*model.Package))
+			if pkgs[key] == nil {
+				pkgs[key] = map[string]*reachNode{}
+			}
+			pkgs[key][v.id] = v
+		}
+	}
+	for _, s := range sbom.IncludedSoftware {
+		if p, ok := s.(*model.Package); ok {
+			add(p)
+		}
+	}
+	for _, dep := range sbom.IncludedDependencies {
+		add(dep.Package)
+		add(dep.DependencyPackage)
+	}
+	return pkgs
+}
+
+// diffPackages returns the package versions that are only in one of the
+// SBOMs. A package with a single version that is only in before and a single
+// version that is only in after is reported as a version change.
+func diffPackages(before, after sbomPackages) []versionPair {
+	var pairs []versionPair
+	for _, key := range unionKeys(before, after) {
+		onlyBefore := versionsNotIn(before[key], after[key])
+		onlyAfter := versionsNotIn(after[key], before[key])
+		if len(onlyBefore) == 1 && len(onlyAfter) == 1 {
+			pairs = append(pairs, versionPair{
+				change: compareVersions(packageVersion(onlyBefore[0]), packageVersion(onlyAfter[0])),
+				before: onlyBefore[0],
+				after:  onlyAfter[0],
+			})
+			continue
+		}
+		for _, v := range onlyBefore {
+			pairs = append(pairs, versionPair{change: model.SbomDiffChangeRemoved, before: v})
+		}
+		for _, v := range onlyAfter {
+			pairs = append(pairs, versionPair{change: model.SbomDiffChangeAdded, after: v})
+		}
+	}
+	return pairs
+}
+
+func diffArtifacts(before, after *model.HasSbom) []*model.SbomArtifactDiff {
+	beforeArts := includedArtifacts(before)
+	afterArts := includedArtifacts(after)
+	diffs := []*model.SbomArtifactDiff{}
+	for _, key := range unionKeys(beforeArts, afterArts) {
+		a, inBefore := beforeArts[key]
+		if _, inAfter := afterArts[key]; inBefore && inAfter {
+			continue
+		}
+		change := model.SbomDiffChangeRemoved
+		if !inBefore {
+			a = afterArts[key]
+			change = model.SbomDiffChangeAdded
+		}
+		diffs = append(diffs, &model.SbomArtifactDiff{Change: change, Artifact: a})
+	}
+	return diffs
+}
+
+func includedArtifacts(sbom *model.HasSbom) map[string]*model.Artifact {
+	arts := map[string]*model.Artifact{}
+	for _, s := range sbom.IncludedSoftware {
+		if a, ok := s.(*model.Artifact); ok {
+			arts[a.Algorithm+":"+a.Digest] = a
+		}
+	}
+	return arts
+}
+
+// diffDependencies returns the dependencies that are only in one of the
+// SBOMs, matching them by the names of their packages.
+func diffDependencies(before, after *model.HasSbom) []*model.SbomDependencyDiff {
+	beforeDeps := includedDependencies(before)
+	afterDeps := includedDependencies(after)
+	diffs := []*model.SbomDependencyDiff{}
+	for _, key := range unionKeys(beforeDeps, afterDeps) {
+		dep, inBefore := beforeDeps[key]
+		if _, inAfter := afterDeps[key]; inBefore && inAfter {
+			continue
+		}
+		change := model.SbomDiffChangeRemoved
+		if !inBefore {
+			dep = afterDeps[key]
+			change = model.SbomDiffChangeAdded
+		}
+		diffs = append(diffs, &model.SbomDependencyDiff{Change: change, IsDependency: dep})
+	}
+	return diffs
+}
+
+func includedDependencies(sbom *model.HasSbom) map[string]*model.IsDependency {
+	deps := map[string]*model.IsDependency{}
+	for _, dep := range sbom.IncludedDependencies {
+		key := packageNameKey(dep.Package) + " -> " + packageNameKey(dep.DependencyPackage)
+		if _, ok := deps[key]; !ok {
+			deps[key] = dep
+		}
+	}
+	return deps
+}
+
+// diffVulnerabilities returns the vulnerabilities that only affect the
+// packages of one of the SBOMs.
+func diffVulnerabilities(ctx context.Context, b backends.Backend, before, after sbomPackages) ([]*model.SbomVulnerabilityDiff, error) {
+	cache := map[string][]*model.CertifyVuln{}
+	beforeVulns, err := packageVulnerabilities(ctx, b, before, cache)
+	if err != nil {
+		return nil, err
+	}
+	afterVulns, err := packageVulnerabilities(ctx, b, after, cache)
+	if err != nil {
+		return nil, err
+	}
+	diffs := []*model.SbomVulnerabilityDiff{}
+	for _, key := range unionKeys(beforeVulns, afterVulns) {
+		cv, inBefore := beforeVulns[key]
+		if _, inAfter := afterVulns[key]; inBefore && inAfter {
+			continue
+		}
+		change := model.SbomDiffChangeRemoved
+		if !inBefore {
+			cv = afterVulns[key]
+			change = model.SbomDiffChangeAdded
+		}
+		diffs = append(diffs, &model.SbomVulnerabilityDiff{Change: change, CertifyVuln: cv})
+	}
+	return diffs, nil
+}
+
+// packageVulnerabilities returns the CertifyVuln of the packages, keyed by
+// vulnerability and package name. cache holds the CertifyVuln of each package
+// version, as most versions are included in both SBOMs.
+func packageVulnerabilities(ctx context.Context, b backends.Backend, pkgs sbomPackages, cache map[string][]*model.CertifyVuln) (map[string]*model.CertifyVuln, error) {
+	vulns := map[string]*model.CertifyVuln{}
+	for key, versions := range pkgs {
+		for id := range versions {
+			certifyVulns, ok := cache[id]
+			if !ok {
+				var err error
+				certifyVulns, err = b.CertifyVuln(ctx, &model.CertifyVulnSpec{
+					Package:       &model.PkgSpec{ID: ptrfrom.String(id)},
+					Vulnerability: &model.VulnerabilitySpec{NoVuln: ptrfrom.Bool(false)},
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to query vulnerabilities of package %s: %w", id, err)
+				}
+				cache[id] = certifyVulns
+			}
+			for _, cv := range certifyVulns {
+				vulnKey := vulnerabilityKey(cv.Vulnerability) + " " + key
+				if _, ok := vulns[vulnKey]; !ok {
+					vulns[vulnKey] = cv
+				}
+			}
+		}
+	}
+	return vulns, nil
+}
+
+// diffLicenses returns the packages whose version changed together with
+// their licenses, if the licenses of the two versions differ.
+func diffLicenses(ctx context.Context, b backends.Backend, pairs []versionPair) ([]*model.SbomLicenseDiff, error) {
+	diffs := []*model.SbomLicenseDiff{}
+	for _, p := range pairs {
+		if p.before == nil || p.after == nil {
+			continue
+		}
+		beforeLegals, err := packageLegals(ctx, b, p.before.id)
+		if err != nil {
+			return nil, err
+		}
+		afterLegals, err := packageLegals(ctx, b, p.after.id)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Equal(licenseSet(beforeLegals), licenseSet(afterLegals)) {
+			continue
+		}
+		diffs = append(diffs, &model.SbomLicenseDiff{
+			Before:       p.before.node.(*model.Package),
+			After:        p.after.node.(*model.Package),
+			BeforeLegals: beforeLegals,
+			AfterLegals:  afterLegals,
+		})
+	}
+	return diffs, nil
+}
+
+func packageLegals(ctx context.Context, b backends.Backend, id string) ([]*model.CertifyLegal, error) {
+	legals, err := b.CertifyLegal(ctx, &model.CertifyLegalSpec{
+		Subject: &model.PackageOrSourceSpec{Package: &model.PkgSpec{ID: ptrfrom.String(id)}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query licenses of package %s: %w", id, err)
+	}
+	if legals == nil {
+		legals = []*model.CertifyLegal{}
+	}
+	return legals, nil
+}
+
+// licenseSet returns the sorted, distinct declared and discovered licenses.
+func licenseSet(legals []*model.CertifyLegal) []string {
+	var set []string
+	for _, l := range legals {
+		set = append(set, "declared:"+l.DeclaredLicense, "discovered:"+l.DiscoveredLicense)
+	}
+	sort.Strings(set)
+	return slices.Compact(set)
+}
+
+// diffScorecards returns the changed packages whose source has a different
+// scorecard in the two SBOMs.
+func diffScorecards(ctx context.Context, b backends.Backend, pairs []versionPair) ([]*model.SbomScorecardDiff, error) {
+	cache := map[string]*model.CertifyScorecard{}
+	diffs := []*model.SbomScorecardDiff{}
+	for _, p := range pairs {
+		entry := &model.SbomScorecardDiff{Change: p.change}
+		var err error
+		if p.before != nil {
+			entry.Package = p.before.node.(*model.Package)
+			if entry.Before, err = latestScorecard(ctx, b, p.before.id, cache); err != nil {
+				return nil, err
+			}
+		}
+		if p.after != nil {
+			entry.Package = p.after.node.(*model.Package)
+			if entry.After, err = latestScorecard(ctx, b, p.after.id, cache); err != nil {
+				return nil, err
+			}
+		}
+		if entry.Before == nil && entry.After == nil {
+			continue
+		}
+		if entry.Before != nil && entry.After != nil &&
+			entry.Before.Scorecard.AggregateScore == entry.After.Scorecard.AggregateScore {
+			continue
+		}
+		diffs = append(diffs, entry)
+	}
+	return diffs, nil
+}
+
+// latestScorecard returns the most recent scorecard of the sources of the
+// package version, or nil if there is none. cache holds the scorecards by
+// source name ID.
+func latestScorecard(ctx context.Context, b backends.Backend, pkgID string, cache map[string]*model.CertifyScorecard) (*model.CertifyScorecard, error) {
+	hasSourceAts, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{Package: &model.PkgSpec{ID: ptrfrom.String(pkgID)}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the source of package %s: %w", pkgID, err)
+	}
+	var latest *model.CertifyScorecard
+	for _, hsa := range hasSourceAts {
+		for _, ns := range hsa.Source.Namespaces {
+			for _, name := range ns.Names {
+				scorecard, ok := cache[name.ID]
+				if !ok {
+					scorecards, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{Source: &model.SourceSpec{ID: ptrfrom.String(name.ID)}})
+					if err != nil {
+						return nil, fmt.Errorf("failed to query scorecards of source %s: %w", name.ID, err)
+					}
+					for _, s := range scorecards {
+						if scorecard == nil || s.Scorecard.TimeScanned.After(scorecard.Scorecard.TimeScanned) {
+							scorecard = s
+						}
+					}
+					cache[name.ID] = scorecard
+				}
+				if scorecard != nil && (latest == nil || scorecard.Scorecard.TimeScanned.After(latest.Scorecard.TimeScanned)) {
+					latest = scorecard
+				}
+			}
+		}
+	}
+	return latest, nil
+}
+
+// compareVersions orders two package versions. Semantic versions are compared
+// as such, other versions, such as the 2.13.4.Final of maven, by their leading
+// numeric components.
+func compareVersions(before, after string) model.SbomDiffChange {
+	var cmp int
+	bv, berr := semver.NewVersion(before)
+	av, aerr := semver.NewVersion(after)
+	if berr == nil && aerr == nil {
+		cmp = bv.Compare(av)
+	} else {
+		cmp = slices.Compare(numericVersion(before), numericVersion(after))
+	}
+	switch cmp {
+	case -1:
+		return model.SbomDiffChangeUpgraded
+	case 1:
+		return model.SbomDiffChangeDowngraded
+	default:
+		return model.SbomDiffChangeChanged
+	}
+}
+
+// numericVersion returns the leading dot separated numbers of a version.
+func numericVersion(v string) []int {
+	var nums []int
+	for _, part := range strings.Split(strings.TrimPrefix(v, "v"), ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		nums = append(nums, n)
+	}
+	return nums
+}
+
+// versionsNotIn returns the versions of a that are not in b, sorted by
+// version.
+func versionsNotIn(a, b map[string]*reachNode) []*reachNode {
+	var out []*reachNode
+	for id, v := range a {
+		if _, ok := b[id]; !ok {
+			out = append(out, v)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if vi, vj := packageVersion(out[i]), packageVersion(out[j]); vi != vj {
+			return vi < vj
+		}
+		return out[i].id < out[j].id
+	})
+	return out
+}
+
+// packageVersion returns the version of a node returned by packageVersions.
+func packageVersion(n *reachNode) string {
+	return n.node.(*model.Package).Namespaces[0].Names[0].Versions[0].Version
+}
+
+// packageNameKey identifies a package regardless of its version.
+func packageNameKey(p *model.Package) string {
+	if p == nil || len(p.Namespaces) == 0 || len(p.Namespaces[0].Names) == 0 {
+		return ""
+	}
+	return p.Type + "/" + p.Namespaces[0].Namespace + "/" + p.Namespaces[0].Names[0].Name
+}
+
+// unionKeys returns the sorted keys of both maps.
+func unionKeys[V any](a, b map[string]V) []string {
+	var keys []string
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
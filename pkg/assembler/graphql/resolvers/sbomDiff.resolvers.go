@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.79
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// SbomDiff is the resolver for the sbomDiff field.
+func (r *queryResolver) SbomDiff(ctx context.Context, before string, after string) (*model.SbomDiff, error) {
+	funcName := "SbomDiff"
+	if before == "" || after == "" {
+		return nil, gqlerror.Errorf("%v :: before and after must be set", funcName)
+	}
+
+	diff, err := sbomDiff(ctx, r.Backend, before, after)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+	return diff, nil
+}
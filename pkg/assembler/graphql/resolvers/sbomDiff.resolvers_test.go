@@ -0,0 +1,272 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+func TestSbomDiff(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("failed to get backend: %v", err)
+	}
+
+	pkg := func(name, version string) *model.PkgInputSpec {
+		return &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("example.com"), Name: name, Version: ptrfrom.String(version)}
+	}
+	appBefore := pkg("app", "v1.1.0")
+	appAfter := pkg("app", "v1.2.0")
+	// four components are not a semantic version and are compared by number
+	libBefore := pkg("lib", "v1.0.0.1")
+	libAfter := pkg("lib", "v1.0.1.0")
+	old := pkg("old", "v1.0.0")
+	added := pkg("added", "v2.0.0")
+	artBefore := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "1111111111111111111111111111111111111111111111111111111111111111"}
+	artAfter := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "2222222222222222222222222222222222222222222222222222222222222222"}
+	binary := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "3333333333333333333333333333333333333333333333333333333333333333"}
+	libVuln := &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "cve-2026-0001"}
+	addedVuln := &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "cve-2026-0002"}
+	libSrc := &model.SourceInputSpec{Type: "git", Namespace: "github.com/example", Name: "lib"}
+	addedSrc := &model.SourceInputSpec{Type: "git", Namespace: "github.com/example", Name: "added"}
+
+	pkgIDs := map[*model.PkgInputSpec]string{}
+	for _, p := range []*model.PkgInputSpec{appBefore, appAfter, libBefore, libAfter, old, added} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("failed to ingest package: %v", err)
+		}
+		pkgIDs[p] = ids.PackageVersionID
+	}
+	artIDs := map[*model.ArtifactInputSpec]string{}
+	for _, a := range []*model.ArtifactInputSpec{artBefore, artAfter, binary} {
+		id, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: a})
+		if err != nil {
+			t.Fatalf("failed to ingest artifact: %v", err)
+		}
+		artIDs[a] = id
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{libVuln, addedVuln} {
+		if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+			t.Fatalf("failed to ingest vulnerability: %v", err)
+		}
+	}
+	for _, s := range []*model.SourceInputSpec{libSrc, addedSrc} {
+		if _, err := b.IngestSource(ctx, model.IDorSourceInput{SourceInput: s}); err != nil {
+			t.Fatalf("failed to ingest source: %v", err)
+		}
+	}
+	ingestDeps := func(deps ...[2]*model.PkgInputSpec) []string {
+		var ids []string
+		for _, dep := range deps {
+			id, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: dep[0]}, model.IDorPkgInput{PackageInput: dep[1]},
+				model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Origin: "test"})
+			if err != nil {
+				t.Fatalf("failed to ingest dependency: %v", err)
+			}
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	beforeDeps := ingestDeps([2]*model.PkgInputSpec{appBefore, libBefore}, [2]*model.PkgInputSpec{appBefore, old})
+	afterDeps := ingestDeps([2]*model.PkgInputSpec{appAfter, libAfter}, [2]*model.PkgInputSpec{appAfter, added})
+
+	beforeID, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: artBefore}},
+		model.HasSBOMInputSpec{URI: "app-1.1.spdx.json", KnownSince: time.Unix(1e9, 0), Origin: "test"},
+		model.HasSBOMIncludesInputSpec{
+			Packages:     []string{pkgIDs[appBefore], pkgIDs[libBefore], pkgIDs[old]},
+			Dependencies: beforeDeps,
+		})
+	if err != nil {
+		t.Fatalf("failed to ingest SBOM: %v", err)
+	}
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: artAfter}},
+		model.HasSBOMInputSpec{URI: "app-1.2.spdx.json", KnownSince: time.Unix(2e9, 0), Origin: "test"},
+		model.HasSBOMIncludesInputSpec{
+			Packages:     []string{pkgIDs[appAfter], pkgIDs[libAfter], pkgIDs[added]},
+			Artifacts:    []string{artIDs[binary]},
+			Dependencies: afterDeps,
+		}); err != nil {
+		t.Fatalf("failed to ingest SBOM: %v", err)
+	}
+
+	for _, cv := range []struct {
+		pkg  *model.PkgInputSpec
+		vuln *model.VulnerabilityInputSpec
+	}{{libBefore, libVuln}, {added, addedVuln}} {
+		if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: cv.pkg}, model.IDorVulnerabilityInput{VulnerabilityInput: cv.vuln},
+			model.ScanMetadataInput{DbURI: "osv.dev", Origin: "test"}); err != nil {
+			t.Fatalf("failed to ingest certifyVuln: %v", err)
+		}
+	}
+	for _, cl := range []struct {
+		pkg     *model.PkgInputSpec
+		license string
+	}{{libBefore, "MIT"}, {libAfter, "Apache-2.0"}} {
+		if _, err := b.IngestLicense(ctx, &model.IDorLicenseInput{LicenseInput: &model.LicenseInputSpec{Name: cl.license, ListVersion: ptrfrom.String("3.21")}}); err != nil {
+			t.Fatalf("failed to ingest license: %v", err)
+		}
+		if _, err := b.IngestCertifyLegal(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: cl.pkg}},
+			[]*model.IDorLicenseInput{{LicenseInput: &model.LicenseInputSpec{Name: cl.license, ListVersion: ptrfrom.String("3.21")}}}, nil,
+			&model.CertifyLegalInputSpec{DeclaredLicense: cl.license, Origin: "test"}); err != nil {
+			t.Fatalf("failed to ingest certifyLegal: %v", err)
+		}
+	}
+	for _, hsa := range []struct {
+		pkg *model.PkgInputSpec
+		src *model.SourceInputSpec
+	}{{libBefore, libSrc}, {libAfter, libSrc}, {added, addedSrc}} {
+		if _, err := b.IngestHasSourceAt(ctx, model.IDorPkgInput{PackageInput: hsa.pkg}, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+			model.IDorSourceInput{SourceInput: hsa.src}, model.HasSourceAtInputSpec{Origin: "test"}); err != nil {
+			t.Fatalf("failed to ingest hasSourceAt: %v", err)
+		}
+	}
+	for _, sc := range []struct {
+		src   *model.SourceInputSpec
+		score float64
+	}{{libSrc, 6}, {addedSrc, 7.5}} {
+		if _, err := b.IngestScorecard(ctx, model.IDorSourceInput{SourceInput: sc.src},
+			model.ScorecardInputSpec{AggregateScore: sc.score, TimeScanned: time.Unix(1e9, 0), Origin: "test"}); err != nil {
+			t.Fatalf("failed to ingest scorecard: %v", err)
+		}
+	}
+
+	// summary flattens a diff to the changed names and versions
+	type summary struct {
+		Packages        []string
+		Artifacts       []string
+		Dependencies    []string
+		Vulnerabilities []string
+		Licenses        []string
+		Scorecards      []string
+	}
+	version := func(p *model.Package) string {
+		if p == nil {
+			return "-"
+		}
+		return p.Namespaces[0].Names[0].Name + "@" + p.Namespaces[0].Names[0].Versions[0].Version
+	}
+	summarize := func(d *model.SbomDiff) summary {
+		var s summary
+		for _, p := range d.Packages {
+			s.Packages = append(s.Packages, string(p.Change)+" "+version(p.Before)+" "+version(p.After))
+		}
+		for _, a := range d.Artifacts {
+			s.Artifacts = append(s.Artifacts, string(a.Change)+" "+a.Artifact.Digest)
+		}
+		for _, dep := range d.Dependencies {
+			s.Dependencies = append(s.Dependencies, string(dep.Change)+" "+version(dep.IsDependency.Package)+" "+version(dep.IsDependency.DependencyPackage))
+		}
+		for _, v := range d.Vulnerabilities {
+			s.Vulnerabilities = append(s.Vulnerabilities, string(v.Change)+" "+v.CertifyVuln.Vulnerability.VulnerabilityIDs[0].VulnerabilityID)
+		}
+		for _, l := range d.Licenses {
+			s.Licenses = append(s.Licenses, l.BeforeLegals[0].DeclaredLicense+" "+l.AfterLegals[0].DeclaredLicense)
+		}
+		for _, sc := range d.Scorecards {
+			entry := string(sc.Change) + " " + version(sc.Package)
+			if sc.After != nil {
+				entry += " " + sc.After.Source.Namespaces[0].Names[0].Name
+			}
+			s.Scorecards = append(s.Scorecards, entry)
+		}
+		return s
+	}
+	want := summary{
+		Packages: []string{
+			"ADDED - added@v2.0.0",
+			"UPGRADED app@v1.1.0 app@v1.2.0",
+			"UPGRADED lib@v1.0.0.1 lib@v1.0.1.0",
+			"REMOVED old@v1.0.0 -",
+		},
+		Artifacts: []string{"ADDED " + binary.Digest},
+		Dependencies: []string{
+			"ADDED app@v1.2.0 added@v2.0.0",
+			"REMOVED app@v1.1.0 old@v1.0.0",
+		},
+		Vulnerabilities: []string{
+			"REMOVED cve-2026-0001",
+			"ADDED cve-2026-0002",
+		},
+		Licenses:   []string{"MIT Apache-2.0"},
+		Scorecards: []string{"ADDED added@v2.0.0 added"},
+	}
+
+	tests := []struct {
+		Name    string
+		Before  string
+		After   string
+		Want    *summary
+		WantErr bool
+	}{
+		{
+			Name:   "By SBOM ID",
+			Before: beforeID,
+			After:  artIDs[artAfter],
+			Want:   &want,
+		},
+		{
+			Name:   "Same SBOM",
+			Before: artIDs[artBefore],
+			After:  beforeID,
+			Want:   &summary{},
+		},
+		{
+			Name:    "Subject without SBOM",
+			Before:  artIDs[binary],
+			After:   beforeID,
+			WantErr: true,
+		},
+		{
+			Name:    "Not an SBOM",
+			Before:  beforeID,
+			After:   beforeDeps[0],
+			WantErr: true,
+		},
+		{
+			Name:    "Missing ID",
+			Before:  "",
+			After:   beforeID,
+			WantErr: true,
+		},
+	}
+	r := resolvers.Resolver{Backend: b}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := r.Query().SbomDiff(ctx, test.Before, test.After)
+			if (err != nil) != test.WantErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.WantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(*test.Want, summarize(got)); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
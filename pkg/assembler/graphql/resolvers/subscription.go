@@ -0,0 +1,116 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// SubscriptionErrors is a gqlgen extension that sends the error that ended a
+// subscription, such as the subscriber falling too far behind the ingested
+// evidence, as the last response of the subscription. Without it the
+// subscription completes as if the server had no more evidence to send.
+type SubscriptionErrors struct{}
+
+var (
+	_ graphql.HandlerExtension     = SubscriptionErrors{}
+	_ graphql.OperationInterceptor = SubscriptionErrors{}
+)
+
+type subscriptionErrorKey struct{}
+
+func (SubscriptionErrors) ExtensionName() string {
+	return "SubscriptionErrors"
+}
+
+func (SubscriptionErrors) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (SubscriptionErrors) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	op := graphql.GetOperationContext(ctx).Operation
+	if op == nil || op.Operation != ast.Subscription {
+		return next(ctx)
+	}
+	var ended atomic.Pointer[gqlerror.Error]
+	responses := next(context.WithValue(ctx, subscriptionErrorKey{}, &ended))
+	return func(ctx context.Context) *graphql.Response {
+		if response := responses(ctx); response != nil {
+			return response
+		}
+		if err := ended.Swap(nil); err != nil {
+			return &graphql.Response{Errors: gqlerror.List{err}}
+		}
+		return nil
+	}
+}
+
+// endSubscription sets the error sent by SubscriptionErrors once the
+// subscription of ctx ends.
+func endSubscription(ctx context.Context, err *gqlerror.Error) {
+	if ended, ok := ctx.Value(subscriptionErrorKey{}).(*atomic.Pointer[gqlerror.Error]); ok {
+		ended.Store(err)
+	}
+}
+
+// subscribe returns the evidence of the given type that matches the filter
+// of the subscriber as it is ingested, until ctx is done. Each ingested node
+// is queried with the filter and the ID of the node, so that the backend
+// matches it as it would match the results of the same query, and with the
+// context of the subscriber, so that it only sees the evidence of its own
+// tenant. filterID is the ID in the filter, if any. A subscriber that falls
+// too far behind is dropped, and gets an error through SubscriptionErrors.
+func subscribe[T any](ctx context.Context, backend backends.Backend, typ backends.EvidenceType, filterID *string, query func(ctx context.Context, id string) ([]T, error)) (<-chan T, error) {
+	events := backends.EventsOf(backend)
+	if events == nil {
+		return nil, gqlerror.Errorf("%sIngested :: subscriptions are not enabled on this server", typ)
+	}
+	in := events.Subscribe(ctx, typ)
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for event := range in {
+			for _, id := range event.IDs {
+				if filterID != nil && *filterID != id {
+					continue
+				}
+				found, err := query(ctx, id)
+				if err != nil {
+					logging.FromContext(ctx).Warnf("failed to look up ingested %s %s: %v", typ, id, err)
+					continue
+				}
+				for _, evidence := range found {
+					select {
+					case out <- evidence:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+		if ctx.Err() == nil {
+			endSubscription(ctx, gqlerror.Errorf("%sIngested :: the subscriber fell behind the ingested evidence and was dropped", typ))
+		}
+	}()
+	return out, nil
+}
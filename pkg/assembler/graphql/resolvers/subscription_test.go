@@ -0,0 +1,289 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"go.uber.org/mock/gomock"
+)
+
+// reporting is a mock backend that reports the evidence it creates, which
+// backends.WithEvents requires to publish it.
+type reporting struct {
+	*mocks.MockBackend
+}
+
+func (reporting) ReportsCreated() bool {
+	return true
+}
+
+// endID is published after the ID of the evidence under test. Its lookup
+// happens after the evidence was delivered or skipped.
+const endID = "end"
+
+// received publishes the ID of the evidence returned by the lookup, and
+// returns what the subscription delivered.
+func received[T any](t *testing.T, events *backends.Events, typ backends.EvidenceType, out <-chan T, done <-chan struct{}) []T {
+	t.Helper()
+	events.Publish(context.Background(), backends.Event{Type: typ, IDs: []string{"1", endID}})
+	var got []T
+	for {
+		select {
+		case e := <-out:
+			got = append(got, e)
+		case <-done:
+			return got
+		case <-time.After(10 * time.Second):
+			t.Fatalf("the subscription did not look up the ingested %s", typ)
+		}
+	}
+}
+
+// lookup returns the evidence for the ID under test, as the backend would if
+// it matched the filter, and records the filter it was queried with. It
+// closes done on the lookup of endID.
+func lookup[S any, T any](evidence T, specID func(*S) *string, queried **S, done chan struct{}) func(context.Context, *S) ([]T, error) {
+	return func(_ context.Context, spec *S) ([]T, error) {
+		if *specID(spec) == endID {
+			close(done)
+			return nil, nil
+		}
+		*queried = spec
+		return []T{evidence}, nil
+	}
+}
+
+func TestCertifyVulnIngested(t *testing.T) {
+	cv := &model.CertifyVuln{ID: "1", Package: testdata.P4out}
+	tests := []struct {
+		Name      string
+		Spec      model.CertifyVulnSpec
+		ExpQuery  *model.CertifyVulnSpec
+		ExpSubErr bool
+	}{
+		{
+			Name:     "Empty filter",
+			ExpQuery: &model.CertifyVulnSpec{ID: ptrfrom.String("1")},
+		},
+		{
+			Name: "Package and scan",
+			Spec: model.CertifyVulnSpec{
+				Package:     &model.PkgSpec{Type: ptrfrom.String("conan")},
+				TimeScanned: ptrfrom.Time(ZeroTime),
+			},
+			ExpQuery: &model.CertifyVulnSpec{
+				ID:          ptrfrom.String("1"),
+				Package:     &model.PkgSpec{Type: ptrfrom.String("conan")},
+				TimeScanned: ptrfrom.Time(ZeroTime),
+			},
+		},
+		{
+			// only the lookup of endID closing done is made
+			Name: "Other ID",
+			Spec: model.CertifyVulnSpec{ID: ptrfrom.String(endID)},
+		},
+		{
+			Name: "Contradicting novuln filter",
+			Spec: model.CertifyVulnSpec{Vulnerability: &model.VulnerabilitySpec{
+				Type:   ptrfrom.String("NOVULN"),
+				NoVuln: ptrfrom.Bool(false),
+			}},
+			ExpSubErr: true,
+		},
+	}
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			b := mocks.NewMockBackend(ctrl)
+			events := backends.NewEvents()
+			r := resolvers.Resolver{Backend: backends.WithEvents(reporting{b}, events)}
+			done := make(chan struct{})
+			var queried *model.CertifyVulnSpec
+			b.
+				EXPECT().
+				CertifyVuln(gomock.Any(), gomock.Any()).
+				DoAndReturn(lookup(cv, func(s *model.CertifyVulnSpec) *string { return s.ID }, &queried, done)).
+				AnyTimes()
+			out, err := r.Subscription().CertifyVulnIngested(ctx, test.Spec)
+			if (err != nil) != test.ExpSubErr {
+				t.Fatalf("did not get expected subscription error, want: %v, got: %v", test.ExpSubErr, err)
+			}
+			if err != nil {
+				return
+			}
+			got := received(t, events, backends.EvidenceCertifyVuln, out, done)
+			if diff := cmp.Diff(test.ExpQuery, queried); diff != "" {
+				t.Errorf("Unexpected backend query. (-want +got):\n%s", diff)
+			}
+			if (len(got) == 1) != (test.ExpQuery != nil) {
+				t.Errorf("got %d certifyVulns, want the ones returned by the backend", len(got))
+			}
+		})
+	}
+}
+
+func TestCertifyVEXStatementIngested(t *testing.T) {
+	vex := &model.CertifyVEXStatement{ID: "1", Subject: testdata.A1out}
+	tests := []struct {
+		Name      string
+		Spec      model.CertifyVEXStatementSpec
+		ExpQuery  *model.CertifyVEXStatementSpec
+		ExpSubErr bool
+	}{
+		{
+			Name: "Status",
+			Spec: model.CertifyVEXStatementSpec{Status: ptrfrom.Any(model.VexStatusAffected)},
+			ExpQuery: &model.CertifyVEXStatementSpec{
+				ID:     ptrfrom.String("1"),
+				Status: ptrfrom.Any(model.VexStatusAffected),
+			},
+		},
+		{
+			Name: "Two subjects",
+			Spec: model.CertifyVEXStatementSpec{Subject: &model.PackageOrArtifactSpec{
+				Package:  &model.PkgSpec{},
+				Artifact: &model.ArtifactSpec{},
+			}},
+			ExpSubErr: true,
+		},
+	}
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			b := mocks.NewMockBackend(ctrl)
+			events := backends.NewEvents()
+			r := resolvers.Resolver{Backend: backends.WithEvents(reporting{b}, events)}
+			done := make(chan struct{})
+			var queried *model.CertifyVEXStatementSpec
+			b.
+				EXPECT().
+				CertifyVEXStatement(gomock.Any(), gomock.Any()).
+				DoAndReturn(lookup(vex, func(s *model.CertifyVEXStatementSpec) *string { return s.ID }, &queried, done)).
+				AnyTimes()
+			out, err := r.Subscription().CertifyVEXStatementIngested(ctx, test.Spec)
+			if (err != nil) != test.ExpSubErr {
+				t.Fatalf("did not get expected subscription error, want: %v, got: %v", test.ExpSubErr, err)
+			}
+			if err != nil {
+				return
+			}
+			got := received(t, events, backends.EvidenceCertifyVEXStatement, out, done)
+			if diff := cmp.Diff(test.ExpQuery, queried); diff != "" {
+				t.Errorf("Unexpected backend query. (-want +got):\n%s", diff)
+			}
+			if len(got) != 1 {
+				t.Errorf("got %d VEX statements, want the one returned by the backend", len(got))
+			}
+		})
+	}
+}
+
+func TestCertifyBadIngested(t *testing.T) {
+	cb := &model.CertifyBad{ID: "1", Subject: testdata.P4outName}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	events := backends.NewEvents()
+	r := resolvers.Resolver{Backend: backends.WithEvents(reporting{b}, events)}
+	done := make(chan struct{})
+	var queried *model.CertifyBadSpec
+	b.
+		EXPECT().
+		CertifyBad(gomock.Any(), gomock.Any()).
+		DoAndReturn(lookup(cb, func(s *model.CertifyBadSpec) *string { return s.ID }, &queried, done)).
+		AnyTimes()
+	spec := model.CertifyBadSpec{
+		Subject:    &model.PackageSourceOrArtifactSpec{Package: &model.PkgSpec{Name: ptrfrom.String("openssl")}},
+		KnownSince: ptrfrom.Time(ZeroTime),
+	}
+	out, err := r.Subscription().CertifyBadIngested(ctx, spec)
+	if err != nil {
+		t.Fatalf("CertifyBadIngested() error = %v", err)
+	}
+	got := received(t, events, backends.EvidenceCertifyBad, out, done)
+	want := spec
+	want.ID = ptrfrom.String("1")
+	if diff := cmp.Diff(&want, queried); diff != "" {
+		t.Errorf("Unexpected backend query. (-want +got):\n%s", diff)
+	}
+	if len(got) != 1 {
+		t.Errorf("got %d certifyBads, want the one returned by the backend", len(got))
+	}
+}
+
+func TestHasSBOMIngested(t *testing.T) {
+	sbom := &model.HasSbom{ID: "1", Subject: testdata.P1out}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	events := backends.NewEvents()
+	r := resolvers.Resolver{Backend: backends.WithEvents(reporting{b}, events)}
+	done := make(chan struct{})
+	var queried *model.HasSBOMSpec
+	b.
+		EXPECT().
+		HasSBOM(gomock.Any(), gomock.Any()).
+		DoAndReturn(lookup(sbom, func(s *model.HasSBOMSpec) *string { return s.ID }, &queried, done)).
+		AnyTimes()
+	spec := model.HasSBOMSpec{
+		IncludedSoftware:     []*model.PackageOrArtifactSpec{{Package: &model.PkgSpec{Name: ptrfrom.String("openssl")}}},
+		IncludedDependencies: []*model.IsDependencySpec{{}},
+	}
+	out, err := r.Subscription().HasSBOMIngested(ctx, spec)
+	if err != nil {
+		t.Fatalf("HasSBOMIngested() error = %v", err)
+	}
+	got := received(t, events, backends.EvidenceHasSBOM, out, done)
+	want := spec
+	want.ID = ptrfrom.String("1")
+	if diff := cmp.Diff(&want, queried); diff != "" {
+		t.Errorf("Unexpected backend query. (-want +got):\n%s", diff)
+	}
+	if len(got) != 1 {
+		t.Errorf("got %d hasSBOMs, want the one returned by the backend", len(got))
+	}
+}
+
+func TestSubscriptionNotEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	for name, b := range map[string]backends.Backend{
+		"Without events":              mocks.NewMockBackend(ctrl),
+		"Does not report the created": backends.WithEvents(mocks.NewMockBackend(ctrl), backends.NewEvents()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := resolvers.Resolver{Backend: b}
+			if _, err := r.Subscription().CertifyVulnIngested(context.Background(), model.CertifyVulnSpec{}); err == nil {
+				t.Errorf("expected an error subscribing to a backend that does not publish events")
+			}
+		})
+	}
+}
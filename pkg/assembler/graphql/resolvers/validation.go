@@ -151,6 +151,16 @@ func validateLicenseInput(license *model.LicenseInputSpec) error {
 	return nil
 }
 
+func validateDocumentInput(document *model.DocumentInputSpec) error {
+	if document.BlobKey == "" {
+		return gqlerror.Errorf("document blobKey must not be empty")
+	}
+	if document.Sha256 == "" {
+		return gqlerror.Errorf("document sha256 must not be empty")
+	}
+	return nil
+}
+
 // ValidateVexInput
 /*
 For [status] “not_affected”, a VEX statement SHOULD provide a [justification].
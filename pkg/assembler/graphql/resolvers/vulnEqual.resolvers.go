@@ -26,8 +26,8 @@ func (r *mutationResolver) IngestVulnEqual(ctx context.Context, vulnerability mo
 		}
 
 		vulnLowerCase = model.IDorVulnerabilityInput{
-			VulnerabilityTypeID: otherVulnerability.VulnerabilityTypeID,
-			VulnerabilityNodeID: otherVulnerability.VulnerabilityNodeID,
+			VulnerabilityTypeID: vulnerability.VulnerabilityTypeID,
+			VulnerabilityNodeID: vulnerability.VulnerabilityNodeID,
 			VulnerabilityInput:  &model.VulnerabilityInputSpec{Type: strings.ToLower(vulnerability.VulnerabilityInput.Type), VulnerabilityID: strings.ToLower(vulnerability.VulnerabilityInput.VulnerabilityID)},
 		}
 	} else {
@@ -49,7 +49,7 @@ func (r *mutationResolver) IngestVulnEqual(ctx context.Context, vulnerability mo
 			VulnerabilityInput:  &model.VulnerabilityInputSpec{Type: strings.ToLower(otherVulnerability.VulnerabilityInput.Type), VulnerabilityID: strings.ToLower(otherVulnerability.VulnerabilityInput.VulnerabilityID)},
 		}
 	} else {
-		otherVulnLowerCase = vulnerability
+		otherVulnLowerCase = otherVulnerability
 	}
 
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
@@ -212,6 +212,56 @@ func TestIngestVulnEqual(t *testing.T) {
 	}
 }
 
+func TestIngestVulnEqualIDs(t *testing.T) {
+	tests := []struct {
+		Name     string
+		V1       model.IDorVulnerabilityInput
+		V2       model.IDorVulnerabilityInput
+		ExpV1    model.IDorVulnerabilityInput
+		ExpOther model.IDorVulnerabilityInput
+	}{
+		{
+			Name: "IDs with input and IDs only",
+			V1:   model.IDorVulnerabilityInput{VulnerabilityTypeID: ptrfrom.String("t1"), VulnerabilityNodeID: ptrfrom.String("n1"), VulnerabilityInput: testdata.O1},
+			V2:   model.IDorVulnerabilityInput{VulnerabilityTypeID: ptrfrom.String("t2"), VulnerabilityNodeID: ptrfrom.String("n2")},
+			ExpV1: model.IDorVulnerabilityInput{
+				VulnerabilityTypeID: ptrfrom.String("t1"),
+				VulnerabilityNodeID: ptrfrom.String("n1"),
+				VulnerabilityInput:  &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "cve-2014-8140"},
+			},
+			ExpOther: model.IDorVulnerabilityInput{VulnerabilityTypeID: ptrfrom.String("t2"), VulnerabilityNodeID: ptrfrom.String("n2")},
+		},
+		{
+			Name:  "IDs only and IDs with input",
+			V1:    model.IDorVulnerabilityInput{VulnerabilityTypeID: ptrfrom.String("t1"), VulnerabilityNodeID: ptrfrom.String("n1")},
+			V2:    model.IDorVulnerabilityInput{VulnerabilityTypeID: ptrfrom.String("t2"), VulnerabilityNodeID: ptrfrom.String("n2"), VulnerabilityInput: testdata.O2},
+			ExpV1: model.IDorVulnerabilityInput{VulnerabilityTypeID: ptrfrom.String("t1"), VulnerabilityNodeID: ptrfrom.String("n1")},
+			ExpOther: model.IDorVulnerabilityInput{
+				VulnerabilityTypeID: ptrfrom.String("t2"),
+				VulnerabilityNodeID: ptrfrom.String("n2"),
+				VulnerabilityInput:  &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "cve-2022-26499"},
+			},
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			ve := model.VulnEqualInputSpec{Justification: "test justification"}
+			b.
+				EXPECT().
+				IngestVulnEqual(ctx, test.ExpV1, test.ExpOther, ve).
+				Return("", nil).
+				Times(1)
+			if _, err := r.Mutation().IngestVulnEqual(ctx, test.V1, test.V2, ve); err != nil {
+				t.Fatalf("did not expect an ingest error, got: %v", err)
+			}
+		})
+	}
+}
+
 func TestIngestVulnEquals(t *testing.T) {
 	type call struct {
 		V1 []*model.IDorVulnerabilityInput
@@ -0,0 +1,159 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client is a client for the GUAC gRPC API.
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	pb "github.com/guacsec/guac/pkg/assembler/grpc/guacapi"
+	"github.com/guacsec/guac/pkg/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// maxMessageSize matches the limit configured by the server.
+const maxMessageSize = 64 * 1024 * 1024
+
+type Client interface {
+	// IngestPredicates streams all predicates to the server, one message per
+	// element of preds.
+	IngestPredicates(ctx context.Context, preds []assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error)
+	// Service returns the raw gRPC client, used for the List RPCs.
+	Service() pb.GuacServiceClient
+	Close()
+}
+
+type client struct {
+	client pb.GuacServiceClient
+	conn   *grpc.ClientConn
+}
+
+type GrpcClientOptions struct {
+	Addr          string
+	Tls           bool
+	TlsSkipVerify bool
+
+	// TokenFile is a file with a bearer token sent with every call.
+	TokenFile string
+	// ClientCertFile and ClientKeyFile are the TLS client certificate and key
+	// presented to the server.
+	ClientCertFile string
+	ClientKeyFile  string
+	// CAFile is a PEM file with the CAs trusted to sign the server
+	// certificate, instead of the system ones.
+	CAFile string
+}
+
+func NewClient(opts GrpcClientOptions) (Client, error) {
+	var creds credentials.TransportCredentials
+	if !opts.Tls {
+		creds = insecure.NewCredentials()
+	} else {
+		config, err := auth.ClientTLSConfig(opts.ClientCertFile, opts.ClientKeyFile, opts.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		if config.RootCAs == nil {
+			sysPool, err := x509.SystemCertPool()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get system cert: %w", err)
+			}
+			config.RootCAs = sysPool
+		}
+		config.InsecureSkipVerify = opts.TlsSkipVerify
+		creds = credentials.NewTLS(config)
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallSendMsgSize(maxMessageSize),
+			grpc.MaxCallRecvMsgSize(maxMessageSize),
+		),
+	}
+	if opts.TokenFile != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(auth.TokenFileCredentials{
+			Path:          opts.TokenFile,
+			AllowInsecure: !opts.Tls,
+		}))
+	}
+	conn, err := grpc.NewClient(opts.Addr, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientFromConn(conn), nil
+}
+
+// NewClientFromConn wraps an existing connection, closing it on Close.
+func NewClientFromConn(conn *grpc.ClientConn) Client {
+	return &client{
+		client: pb.NewGuacServiceClient(conn),
+		conn:   conn,
+	}
+}
+
+func (c *client) Close() {
+	c.conn.Close()
+}
+
+func (c *client) Service() pb.GuacServiceClient {
+	return c.client
+}
+
+func (c *client) IngestPredicates(ctx context.Context, preds []assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error) {
+	stream, err := c.client.IngestPredicates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ingest stream: %w", err)
+	}
+	for _, p := range preds {
+		if err := stream.Send(&pb.IngestPredicatesRequest{Predicates: FromIngestPredicates(p)}); err != nil {
+			// The real error is only returned by CloseAndRecv.
+			if _, recvErr := stream.CloseAndRecv(); recvErr != nil {
+				return nil, fmt.Errorf("failed to ingest predicates: %w", recvErr)
+			}
+			return nil, fmt.Errorf("failed to send predicates: %w", err)
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest predicates: %w", err)
+	}
+	return &helpers.AssemblerIngestedIDs{
+		HasSBOMIDs:      res.HasSbomIds,
+		HasSLSAIDs:      res.HasSlsaIds,
+		PackageIDs:      res.PackageIds,
+		ArtifactIDs:     res.ArtifactIds,
+		IsDependencyIDs: res.IsDependencyIds,
+		IsOccurrenceIDs: res.IsOccurrenceIds,
+	}, nil
+}
+
+// GetBulkAssembler returns an assembler with the same signature as
+// helpers.GetBulkAssembler which ingests all predicates over gRPC instead of
+// GraphQL.
+func GetBulkAssembler(ctx context.Context, logger *zap.SugaredLogger, c Client) func([]assembler.AssemblerInput) (*helpers.AssemblerIngestedIDs, error) {
+	return func(preds []assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error) {
+		logger.Infof("assembling %d predicate batches over gRPC", len(preds))
+		return c.IngestPredicates(ctx, preds)
+	}
+}
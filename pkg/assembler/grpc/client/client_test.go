@@ -0,0 +1,644 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	pb "github.com/guacsec/guac/pkg/assembler/grpc/guacapi"
+	grpc_server "github.com/guacsec/guac/pkg/assembler/grpc/server"
+	gql_server "github.com/guacsec/guac/pkg/assembler/server"
+	"github.com/guacsec/guac/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var testPredicates = []assembler.IngestPredicates{
+	testdata.SpdxIngestionPredicates,
+	testdata.CdxIngestionPredicates,
+	testdata.CdxQuarkusIngestionPredicates,
+	testdata.CdxQuarkusLegalPredicates,
+	testdata.CycloneDXUnAffectedPredicates,
+	testdata.SlsaPreds,
+	testdata.XraySBOMVulnsPredicates,
+	testdata.CycloneDXResolvedWithPedigreePredicates,
+}
+
+func newBackend(t testing.TB) backends.Backend {
+	t.Helper()
+	backend, err := backends.Get("keyvalue", context.Background(), stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("failed to get backend: %v", err)
+	}
+	return backend
+}
+
+// newGRPCClient starts an in-memory gRPC server backed by backend.
+func newGRPCClient(t testing.TB, backend backends.Backend) Client {
+	t.Helper()
+	ctx := logging.WithLogger(context.Background())
+
+	srv, err := grpc_server.NewServer(backend, 0, "", "")
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+	lis := bufconn.Listen(1024 * 1024)
+	gs := grpc.NewServer(grpc_server.ServerOptions(logging.FromContext(ctx).Desugar())...)
+	srv.Register(gs)
+	go func() {
+		_ = gs.Serve(lis)
+	}()
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxMessageSize)))
+	if err != nil {
+		t.Fatalf("failed to dial server: %v", err)
+	}
+	c := NewClientFromConn(conn)
+	t.Cleanup(c.Close)
+	return c
+}
+
+// newGraphQLClient starts a GraphQL server backed by backend.
+func newGraphQLClient(t testing.TB, backend backends.Backend) graphql.Client {
+	t.Helper()
+	srv := httptest.NewServer(gql_server.GetGraphqlServer(context.Background(), backend, gql_server.Options{Limits: gql_server.DefaultLimits()}))
+	t.Cleanup(srv.Close)
+	return graphql.NewClient(srv.URL, http.DefaultClient)
+}
+
+func receiveAll[T any](recv func() (T, error)) ([]T, error) {
+	var out []T
+	for {
+		v, err := recv()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+}
+
+func Test_IngestPredicates(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	logger := logging.FromContext(ctx)
+
+	grpcBackend := newBackend(t)
+	grpcAssembler := GetBulkAssembler(ctx, logger, newGRPCClient(t, grpcBackend))
+	gqlBackend := newBackend(t)
+	gqlAssembler := helpers.GetBulkAssembler(ctx, logger, newGraphQLClient(t, gqlBackend))
+
+	grpcIDs, err := grpcAssembler(testPredicates)
+	if err != nil {
+		t.Fatalf("gRPC ingestion failed: %v", err)
+	}
+	gqlIDs, err := gqlAssembler(testPredicates)
+	if err != nil {
+		t.Fatalf("GraphQL ingestion failed: %v", err)
+	}
+	if len(grpcIDs.HasSBOMIDs) != len(gqlIDs.HasSBOMIDs) {
+		t.Errorf("got %d HasSBOM IDs over gRPC, %d over GraphQL", len(grpcIDs.HasSBOMIDs), len(gqlIDs.HasSBOMIDs))
+	}
+	if len(grpcIDs.HasSLSAIDs) != len(gqlIDs.HasSLSAIDs) {
+		t.Errorf("got %d HasSLSA IDs over gRPC, %d over GraphQL", len(grpcIDs.HasSLSAIDs), len(gqlIDs.HasSLSAIDs))
+	}
+
+	// Both paths must produce the same graph.
+	counts := func(b backends.Backend) map[string]int {
+		pkgs, err := b.Packages(ctx, &model.PkgSpec{})
+		if err != nil {
+			t.Fatalf("Packages failed: %v", err)
+		}
+		versions := 0
+		for _, p := range pkgs {
+			for _, ns := range p.Namespaces {
+				for _, n := range ns.Names {
+					versions += len(n.Versions)
+				}
+			}
+		}
+		deps, err := b.IsDependency(ctx, &model.IsDependencySpec{})
+		if err != nil {
+			t.Fatalf("IsDependency failed: %v", err)
+		}
+		occs, err := b.IsOccurrence(ctx, &model.IsOccurrenceSpec{})
+		if err != nil {
+			t.Fatalf("IsOccurrence failed: %v", err)
+		}
+		sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{})
+		if err != nil {
+			t.Fatalf("HasSBOM failed: %v", err)
+		}
+		slsas, err := b.HasSlsa(ctx, &model.HasSLSASpec{})
+		if err != nil {
+			t.Fatalf("HasSlsa failed: %v", err)
+		}
+		vexs, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{})
+		if err != nil {
+			t.Fatalf("CertifyVEXStatement failed: %v", err)
+		}
+		legals, err := b.CertifyLegal(ctx, &model.CertifyLegalSpec{})
+		if err != nil {
+			t.Fatalf("CertifyLegal failed: %v", err)
+		}
+		return map[string]int{
+			"packageVersions":     versions,
+			"isDependency":        len(deps),
+			"isOccurrence":        len(occs),
+			"hasSBOM":             len(sboms),
+			"hasSLSA":             len(slsas),
+			"certifyVEXStatement": len(vexs),
+			"certifyLegal":        len(legals),
+		}
+	}
+	got, want := counts(grpcBackend), counts(gqlBackend)
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s: got %d nodes ingested over gRPC, want %d as over GraphQL", k, got[k], v)
+		}
+	}
+	if want["isDependency"] == 0 || want["hasSBOM"] == 0 || want["hasSLSA"] == 0 {
+		t.Errorf("test predicates did not ingest any evidence: %v", want)
+	}
+}
+
+func Test_IngestPredicatesInvalid(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	c := newGRPCClient(t, newBackend(t))
+
+	preds := []assembler.IngestPredicates{{
+		IsDependency: []assembler.IsDependencyIngest{{
+			Pkg:          &generated.PkgInputSpec{Type: "golang", Name: "a"},
+			DepPkg:       &generated.PkgInputSpec{Type: "golang", Name: "b"},
+			IsDependency: &generated.IsDependencyInputSpec{DependencyType: "NOT_A_TYPE"},
+		}},
+	}}
+	if _, err := c.IngestPredicates(ctx, preds); err == nil {
+		t.Errorf("expected an error for an invalid dependency type")
+	}
+}
+
+func Test_ListStreams(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	backend := newBackend(t)
+	c := newGRPCClient(t, backend)
+
+	if _, err := c.IngestPredicates(ctx, testPredicates); err != nil {
+		t.Fatalf("ingestion failed: %v", err)
+	}
+
+	wantDeps, err := backend.IsDependency(ctx, &model.IsDependencySpec{})
+	if err != nil {
+		t.Fatalf("IsDependency failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		pageSize int32
+	}{
+		{name: "default page size", pageSize: 0},
+		{name: "small pages", pageSize: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := c.Service().ListIsDependencies(ctx, &pb.ListIsDependenciesRequest{PageSize: tt.pageSize})
+			if err != nil {
+				t.Fatalf("ListIsDependencies failed: %v", err)
+			}
+			deps, err := receiveAll(stream.Recv)
+			if err != nil {
+				t.Fatalf("receiving IsDependency failed: %v", err)
+			}
+			if len(deps) != len(wantDeps) {
+				t.Errorf("got %d IsDependency nodes, want %d", len(deps), len(wantDeps))
+			}
+			seen := map[string]bool{}
+			for _, d := range deps {
+				if seen[d.Id] {
+					t.Errorf("IsDependency %s returned more than once", d.Id)
+				}
+				seen[d.Id] = true
+			}
+		})
+	}
+
+	t.Run("filtered packages", func(t *testing.T) {
+		pkgType := "maven"
+		stream, err := c.Service().ListPackages(ctx, &pb.ListPackagesRequest{Filter: &pb.PkgSpec{Type: &pkgType}})
+		if err != nil {
+			t.Fatalf("ListPackages failed: %v", err)
+		}
+		pkgs, err := receiveAll(stream.Recv)
+		if err != nil {
+			t.Fatalf("receiving packages failed: %v", err)
+		}
+		if len(pkgs) == 0 {
+			t.Fatalf("expected maven packages")
+		}
+		for _, p := range pkgs {
+			if p.Type != pkgType {
+				t.Errorf("got package of type %q, want %q", p.Type, pkgType)
+			}
+		}
+	})
+
+	t.Run("has sbom includes", func(t *testing.T) {
+		stream, err := c.Service().ListHasSBOMs(ctx, &pb.ListHasSBOMsRequest{})
+		if err != nil {
+			t.Fatalf("ListHasSBOMs failed: %v", err)
+		}
+		sboms, err := receiveAll(stream.Recv)
+		if err != nil {
+			t.Fatalf("receiving HasSBOM failed: %v", err)
+		}
+		if len(sboms) == 0 {
+			t.Fatalf("expected HasSBOM nodes")
+		}
+		for _, s := range sboms {
+			if s.Subject == nil {
+				t.Errorf("HasSBOM %s has no subject", s.Id)
+			}
+		}
+	})
+}
+
+var (
+	evidencePkg = &generated.PkgInputSpec{Type: "npm", Name: "evidence", Version: ptrfrom.String("1.0.0")}
+	evidenceSrc = &generated.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "guac"}
+	evidenceArt = &generated.ArtifactInputSpec{Algorithm: "sha256", Digest: "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"}
+	evidenceVln = &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2023-1234"}
+	evidenceAt  = time.Unix(1e9, 0).UTC()
+	specificVer = generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion}
+)
+
+// evidencePredicates covers the evidence types that testPredicates does not
+// ingest, so that every List RPC has nodes to return.
+var evidencePredicates = assembler.IngestPredicates{
+	CertifyBad: []assembler.CertifyBadIngest{{
+		Pkg:          evidencePkg,
+		PkgMatchFlag: specificVer,
+		CertifyBad:   &generated.CertifyBadInputSpec{Justification: "bad", KnownSince: evidenceAt},
+	}},
+	CertifyGood: []assembler.CertifyGoodIngest{{
+		Artifact:    evidenceArt,
+		CertifyGood: &generated.CertifyGoodInputSpec{Justification: "good", KnownSince: evidenceAt},
+	}},
+	HasSourceAt: []assembler.HasSourceAtIngest{{
+		Pkg:          evidencePkg,
+		PkgMatchFlag: specificVer,
+		Src:          evidenceSrc,
+		HasSourceAt:  &generated.HasSourceAtInputSpec{KnownSince: evidenceAt},
+	}},
+	CertifyScorecard: []assembler.CertifyScorecardIngest{{
+		Source: evidenceSrc,
+		Scorecard: &generated.ScorecardInputSpec{
+			Checks:         []generated.ScorecardCheckInputSpec{{Check: "Code-Review", Score: 7}},
+			AggregateScore: 7,
+			TimeScanned:    evidenceAt,
+		},
+	}},
+	PkgEqual: []assembler.PkgEqualIngest{{
+		Pkg:      evidencePkg,
+		EqualPkg: &generated.PkgInputSpec{Type: "npm", Name: "evidence-fork", Version: ptrfrom.String("1.0.0")},
+		PkgEqual: &generated.PkgEqualInputSpec{Justification: "fork"},
+	}},
+	VulnEqual: []assembler.VulnEqualIngest{{
+		Vulnerability:      evidenceVln,
+		EqualVulnerability: &generated.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-h45f-rjvw-2rv2"},
+		VulnEqual:          &generated.VulnEqualInputSpec{Justification: "alias"},
+	}},
+	HashEqual: []assembler.HashEqualIngest{{
+		Artifact:      evidenceArt,
+		EqualArtifact: &generated.ArtifactInputSpec{Algorithm: "sha1", Digest: "7a8f47318e4676dacb0142afa0b83029cd7befd9"},
+		HashEqual:     &generated.HashEqualInputSpec{Justification: "same file"},
+	}},
+	HasMetadata: []assembler.HasMetadataIngest{{
+		Pkg:          evidencePkg,
+		PkgMatchFlag: specificVer,
+		HasMetadata:  &generated.HasMetadataInputSpec{Key: "reviewed", Value: "true", Timestamp: evidenceAt},
+	}},
+	PointOfContact: []assembler.PointOfContactIngest{{
+		Src:            evidenceSrc,
+		PointOfContact: &generated.PointOfContactInputSpec{Email: "security@example.com", Since: evidenceAt},
+	}},
+	VulnMetadata: []assembler.VulnMetadataIngest{{
+		Vulnerability: evidenceVln,
+		VulnMetadata:  &generated.VulnerabilityMetadataInputSpec{ScoreType: generated.VulnerabilityScoreTypeCvssv3, ScoreValue: 7.5, Timestamp: evidenceAt},
+	}},
+}
+
+func Test_ListEvidenceStreams(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	backend := newBackend(t)
+	c := newGRPCClient(t, backend)
+
+	preds := append([]assembler.IngestPredicates{evidencePredicates}, testPredicates...)
+	if _, err := c.IngestPredicates(ctx, preds); err != nil {
+		t.Fatalf("ingestion failed: %v", err)
+	}
+
+	const pageSize = 2
+	tests := []struct {
+		name string
+		list func() (int, error)
+		want func() (int, error)
+	}{
+		{
+			name: "CertifyBad",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListCertifyBads(ctx, &pb.ListCertifyBadsRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.CertifyBad(ctx, &model.CertifyBadSpec{})) },
+		},
+		{
+			name: "CertifyGood",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListCertifyGoods(ctx, &pb.ListCertifyGoodsRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.CertifyGood(ctx, &model.CertifyGoodSpec{})) },
+		},
+		{
+			name: "HasSLSA",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListHasSLSAs(ctx, &pb.ListHasSLSAsRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.HasSlsa(ctx, &model.HasSLSASpec{})) },
+		},
+		{
+			name: "HasSourceAt",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListHasSourceAts(ctx, &pb.ListHasSourceAtsRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.HasSourceAt(ctx, &model.HasSourceAtSpec{})) },
+		},
+		{
+			name: "CertifyScorecard",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListCertifyScorecards(ctx, &pb.ListCertifyScorecardsRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.Scorecards(ctx, &model.CertifyScorecardSpec{})) },
+		},
+		{
+			name: "CertifyLegal",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListCertifyLegals(ctx, &pb.ListCertifyLegalsRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.CertifyLegal(ctx, &model.CertifyLegalSpec{})) },
+		},
+		{
+			name: "PkgEqual",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListPkgEquals(ctx, &pb.ListPkgEqualsRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.PkgEqual(ctx, &model.PkgEqualSpec{})) },
+		},
+		{
+			name: "VulnEqual",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListVulnEquals(ctx, &pb.ListVulnEqualsRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.VulnEqual(ctx, &model.VulnEqualSpec{})) },
+		},
+		{
+			name: "HashEqual",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListHashEquals(ctx, &pb.ListHashEqualsRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.HashEqual(ctx, &model.HashEqualSpec{})) },
+		},
+		{
+			name: "HasMetadata",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListHasMetadata(ctx, &pb.ListHasMetadataRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.HasMetadata(ctx, &model.HasMetadataSpec{})) },
+		},
+		{
+			name: "PointOfContact",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListPointOfContacts(ctx, &pb.ListPointOfContactsRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) { return sliceLen(backend.PointOfContact(ctx, &model.PointOfContactSpec{})) },
+		},
+		{
+			name: "VulnerabilityMetadata",
+			list: func() (int, error) {
+				return streamLen(c.Service().ListVulnerabilityMetadata(ctx, &pb.ListVulnerabilityMetadataRequest{PageSize: pageSize}))
+			},
+			want: func() (int, error) {
+				return sliceLen(backend.VulnerabilityMetadata(ctx, &model.VulnerabilityMetadataSpec{}))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := tt.want()
+			if err != nil {
+				t.Fatalf("backend query failed: %v", err)
+			}
+			if want == 0 {
+				t.Fatalf("test data has no %s nodes", tt.name)
+			}
+			got, err := tt.list()
+			if err != nil {
+				t.Fatalf("List failed: %v", err)
+			}
+			if got != want {
+				t.Errorf("got %d nodes, want %d", got, want)
+			}
+		})
+	}
+
+	t.Run("filtered subject", func(t *testing.T) {
+		srcType := "git"
+		stream, err := c.Service().ListPointOfContacts(ctx, &pb.ListPointOfContactsRequest{
+			Filter: &pb.PointOfContactSpec{Subject: &pb.PackageSourceOrArtifactSpec{Source: &pb.SourceSpec{Type: &srcType}}},
+		})
+		if err != nil {
+			t.Fatalf("ListPointOfContacts failed: %v", err)
+		}
+		contacts, err := receiveAll(stream.Recv)
+		if err != nil {
+			t.Fatalf("receiving PointOfContact failed: %v", err)
+		}
+		if len(contacts) != 1 {
+			t.Fatalf("got %d PointOfContact nodes, want 1", len(contacts))
+		}
+		src := contacts[0].GetSubject().GetSource()
+		if src == nil || src.Type != srcType {
+			t.Errorf("got subject %v, want a %s source", contacts[0].GetSubject(), srcType)
+		}
+	})
+
+	t.Run("invalid score type", func(t *testing.T) {
+		scoreType := "CVSS_UNKNOWN"
+		stream, err := c.Service().ListVulnerabilityMetadata(ctx, &pb.ListVulnerabilityMetadataRequest{
+			Filter: &pb.VulnerabilityMetadataSpec{ScoreType: &scoreType},
+		})
+		if err == nil {
+			_, err = receiveAll(stream.Recv)
+		}
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("got error %v, want InvalidArgument", err)
+		}
+	})
+}
+
+func streamLen[T any](stream interface{ Recv() (T, error) }, err error) (int, error) {
+	if err != nil {
+		return 0, err
+	}
+	nodes, err := receiveAll(stream.Recv)
+	return len(nodes), err
+}
+
+func sliceLen[T any](nodes []T, err error) (int, error) {
+	return len(nodes), err
+}
+
+func benchmarkPredicates(n int) []assembler.IngestPredicates {
+	var preds []assembler.IngestPredicates
+	for i := 0; i < n; i++ {
+		p := assembler.IngestPredicates{}
+		for j := 0; j < 50; j++ {
+			version := fmt.Sprintf("1.%d.%d", i, j)
+			depVersion := fmt.Sprintf("2.%d.%d", i, j)
+			p.IsDependency = append(p.IsDependency, assembler.IsDependencyIngest{
+				Pkg:    &generated.PkgInputSpec{Type: "golang", Name: "bench", Version: &version},
+				DepPkg: &generated.PkgInputSpec{Type: "golang", Name: fmt.Sprintf("dep%d", j), Version: &depVersion},
+				IsDependency: &generated.IsDependencyInputSpec{
+					DependencyType: generated.DependencyTypeDirect,
+					Justification:  "benchmark",
+					Origin:         "benchmark",
+					Collector:      "benchmark",
+				},
+			})
+			p.IsOccurrence = append(p.IsOccurrence, assembler.IsOccurrenceIngest{
+				Pkg:      &generated.PkgInputSpec{Type: "golang", Name: fmt.Sprintf("dep%d", j), Version: &depVersion},
+				Artifact: &generated.ArtifactInputSpec{Algorithm: "sha256", Digest: fmt.Sprintf("%064d", i*1000+j)},
+				IsOccurrence: &generated.IsOccurrenceInputSpec{
+					Justification: "benchmark",
+					Origin:        "benchmark",
+					Collector:     "benchmark",
+				},
+			})
+		}
+		preds = append(preds, p)
+	}
+	return preds
+}
+
+// Benchmark_Ingest compares ingesting the same predicates through the gRPC
+// and the GraphQL bulk assemblers, both backed by the keyvalue backend.
+func Benchmark_Ingest(b *testing.B) {
+	ctx := logging.WithLogger(context.Background())
+	logger := logging.FromContext(ctx)
+	preds := benchmarkPredicates(10)
+
+	b.Run("grpc", func(b *testing.B) {
+		assemble := GetBulkAssembler(ctx, logger, newGRPCClient(b, newBackend(b)))
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := assemble(preds); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("graphql", func(b *testing.B) {
+		assemble := helpers.GetBulkAssembler(ctx, logger, newGraphQLClient(b, newBackend(b)))
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := assemble(preds); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// Benchmark_List compares streaming all IsDependency nodes over gRPC with
+// paginating through them over GraphQL.
+func Benchmark_List(b *testing.B) {
+	ctx := logging.WithLogger(context.Background())
+	logger := logging.FromContext(ctx)
+	preds := benchmarkPredicates(10)
+
+	b.Run("grpc", func(b *testing.B) {
+		c := newGRPCClient(b, newBackend(b))
+		if _, err := GetBulkAssembler(ctx, logger, c)(preds); err != nil {
+			b.Fatal(err)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			stream, err := c.Service().ListIsDependencies(ctx, &pb.ListIsDependenciesRequest{PageSize: 100})
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := receiveAll(stream.Recv); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("graphql", func(b *testing.B) {
+		c := newGraphQLClient(b, newBackend(b))
+		if _, err := helpers.GetBulkAssembler(ctx, logger, c)(preds); err != nil {
+			b.Fatal(err)
+		}
+		first := 100
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var after *string
+			for {
+				res, err := generated.DependencyList(ctx, c, generated.IsDependencySpec{}, after, &first)
+				if err != nil {
+					b.Fatal(err)
+				}
+				page := res.GetIsDependencyList()
+				if page == nil || !page.PageInfo.HasNextPage {
+					break
+				}
+				after = page.PageInfo.EndCursor
+			}
+		}
+	})
+}
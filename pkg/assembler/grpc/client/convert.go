@@ -0,0 +1,517 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	pb "github.com/guacsec/guac/pkg/assembler/grpc/guacapi"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// This file converts the assembler inputs, which use the GraphQL client
+// types, into protobuf messages.
+
+func fromTime(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func fromTimePtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func fromPkgInputSpec(p *generated.PkgInputSpec) *pb.PkgInputSpec {
+	if p == nil {
+		return nil
+	}
+	out := &pb.PkgInputSpec{
+		Type:      p.Type,
+		Namespace: p.Namespace,
+		Name:      p.Name,
+		Version:   p.Version,
+		Subpath:   p.Subpath,
+	}
+	for _, q := range p.Qualifiers {
+		out.Qualifiers = append(out.Qualifiers, &pb.PackageQualifierInputSpec{Key: q.Key, Value: q.Value})
+	}
+	return out
+}
+
+func fromSourceInputSpec(s *generated.SourceInputSpec) *pb.SourceInputSpec {
+	if s == nil {
+		return nil
+	}
+	return &pb.SourceInputSpec{
+		Type:      s.Type,
+		Namespace: s.Namespace,
+		Name:      s.Name,
+		Tag:       s.Tag,
+		Commit:    s.Commit,
+	}
+}
+
+func fromArtifactInputSpec(a *generated.ArtifactInputSpec) *pb.ArtifactInputSpec {
+	if a == nil {
+		return nil
+	}
+	return &pb.ArtifactInputSpec{Algorithm: a.Algorithm, Digest: a.Digest}
+}
+
+func fromBuilderInputSpec(b *generated.BuilderInputSpec) *pb.BuilderInputSpec {
+	if b == nil {
+		return nil
+	}
+	return &pb.BuilderInputSpec{Uri: b.Uri}
+}
+
+func fromVulnerabilityInputSpec(v *generated.VulnerabilityInputSpec) *pb.VulnerabilityInputSpec {
+	if v == nil {
+		return nil
+	}
+	return &pb.VulnerabilityInputSpec{Type: v.Type, VulnerabilityId: v.VulnerabilityID}
+}
+
+func fromLicenseInputSpecs(ls []generated.LicenseInputSpec) []*pb.LicenseInputSpec {
+	var out []*pb.LicenseInputSpec
+	for _, l := range ls {
+		out = append(out, &pb.LicenseInputSpec{Name: l.Name, Inline: l.Inline, ListVersion: l.ListVersion})
+	}
+	return out
+}
+
+func fromCertifyBadInputSpec(c *generated.CertifyBadInputSpec) *pb.CertifyBadInputSpec {
+	if c == nil {
+		return nil
+	}
+	return &pb.CertifyBadInputSpec{
+		Justification: c.Justification,
+		KnownSince:    fromTime(c.KnownSince),
+		Origin:        c.Origin,
+		Collector:     c.Collector,
+		DocumentRef:   c.DocumentRef,
+	}
+}
+
+func fromCertifyGoodInputSpec(c *generated.CertifyGoodInputSpec) *pb.CertifyGoodInputSpec {
+	if c == nil {
+		return nil
+	}
+	return &pb.CertifyGoodInputSpec{
+		Justification: c.Justification,
+		KnownSince:    fromTime(c.KnownSince),
+		Origin:        c.Origin,
+		Collector:     c.Collector,
+		DocumentRef:   c.DocumentRef,
+	}
+}
+
+func fromCertifyLegalInputSpec(c *generated.CertifyLegalInputSpec) *pb.CertifyLegalInputSpec {
+	if c == nil {
+		return nil
+	}
+	return &pb.CertifyLegalInputSpec{
+		DeclaredLicense:   c.DeclaredLicense,
+		DiscoveredLicense: c.DiscoveredLicense,
+		Attribution:       c.Attribution,
+		Justification:     c.Justification,
+		TimeScanned:       fromTime(c.TimeScanned),
+		Origin:            c.Origin,
+		Collector:         c.Collector,
+		DocumentRef:       c.DocumentRef,
+	}
+}
+
+func fromScorecardInputSpec(s *generated.ScorecardInputSpec) *pb.ScorecardInputSpec {
+	if s == nil {
+		return nil
+	}
+	out := &pb.ScorecardInputSpec{
+		AggregateScore:   s.AggregateScore,
+		TimeScanned:      fromTime(s.TimeScanned),
+		ScorecardVersion: s.ScorecardVersion,
+		ScorecardCommit:  s.ScorecardCommit,
+		Origin:           s.Origin,
+		Collector:        s.Collector,
+		DocumentRef:      s.DocumentRef,
+	}
+	for _, c := range s.Checks {
+		out.Checks = append(out.Checks, &pb.ScorecardCheckInputSpec{Check: c.Check, Score: int32(c.Score)})
+	}
+	return out
+}
+
+func fromScanMetadataInput(s *generated.ScanMetadataInput) *pb.ScanMetadataInput {
+	if s == nil {
+		return nil
+	}
+	return &pb.ScanMetadataInput{
+		TimeScanned:    fromTime(s.TimeScanned),
+		DbUri:          s.DbUri,
+		DbVersion:      s.DbVersion,
+		ScannerUri:     s.ScannerUri,
+		ScannerVersion: s.ScannerVersion,
+		Origin:         s.Origin,
+		Collector:      s.Collector,
+		DocumentRef:    s.DocumentRef,
+	}
+}
+
+func fromHasMetadataInputSpec(h *generated.HasMetadataInputSpec) *pb.HasMetadataInputSpec {
+	if h == nil {
+		return nil
+	}
+	return &pb.HasMetadataInputSpec{
+		Key:           h.Key,
+		Value:         h.Value,
+		Timestamp:     fromTime(h.Timestamp),
+		Justification: h.Justification,
+		Origin:        h.Origin,
+		Collector:     h.Collector,
+		DocumentRef:   h.DocumentRef,
+	}
+}
+
+func fromHasSBOMInputSpec(h *generated.HasSBOMInputSpec) *pb.HasSBOMInputSpec {
+	if h == nil {
+		return nil
+	}
+	return &pb.HasSBOMInputSpec{
+		Uri:              h.Uri,
+		Algorithm:        h.Algorithm,
+		Digest:           h.Digest,
+		DownloadLocation: h.DownloadLocation,
+		KnownSince:       fromTime(h.KnownSince),
+		Origin:           h.Origin,
+		Collector:        h.Collector,
+		DocumentRef:      h.DocumentRef,
+	}
+}
+
+func fromHasSBOMIncludesInputSpec(h *generated.HasSBOMIncludesInputSpec) *pb.HasSBOMIncludesInputSpec {
+	if h == nil {
+		return nil
+	}
+	return &pb.HasSBOMIncludesInputSpec{
+		Packages:     h.Packages,
+		Artifacts:    h.Artifacts,
+		Dependencies: h.Dependencies,
+		Occurrences:  h.Occurrences,
+	}
+}
+
+func fromSLSAInputSpec(s *generated.SLSAInputSpec) *pb.SLSAInputSpec {
+	if s == nil {
+		return nil
+	}
+	out := &pb.SLSAInputSpec{
+		BuildType:   s.BuildType,
+		SlsaVersion: s.SlsaVersion,
+		StartedOn:   fromTimePtr(s.StartedOn),
+		FinishedOn:  fromTimePtr(s.FinishedOn),
+		Origin:      s.Origin,
+		Collector:   s.Collector,
+		DocumentRef: s.DocumentRef,
+	}
+	for _, p := range s.SlsaPredicate {
+		out.SlsaPredicate = append(out.SlsaPredicate, &pb.SLSAPredicateInputSpec{Key: p.Key, Value: p.Value})
+	}
+	return out
+}
+
+func fromHasSourceAtInputSpec(h *generated.HasSourceAtInputSpec) *pb.HasSourceAtInputSpec {
+	if h == nil {
+		return nil
+	}
+	return &pb.HasSourceAtInputSpec{
+		KnownSince:    fromTime(h.KnownSince),
+		Justification: h.Justification,
+		Origin:        h.Origin,
+		Collector:     h.Collector,
+		DocumentRef:   h.DocumentRef,
+	}
+}
+
+func fromHashEqualInputSpec(h *generated.HashEqualInputSpec) *pb.HashEqualInputSpec {
+	if h == nil {
+		return nil
+	}
+	return &pb.HashEqualInputSpec{
+		Justification: h.Justification,
+		Origin:        h.Origin,
+		Collector:     h.Collector,
+		DocumentRef:   h.DocumentRef,
+	}
+}
+
+func fromDocumentInputSpec(d generated.DocumentInputSpec) *pb.DocumentInputSpec {
+	return &pb.DocumentInputSpec{
+		BlobKey:       d.BlobKey,
+		Sha256:        d.Sha256,
+		DocumentType:  d.DocumentType,
+		Format:        d.Format,
+		Collector:     d.Collector,
+		Source:        d.Source,
+		ParserVersion: d.ParserVersion,
+		IngestedAt:    fromTime(d.IngestedAt),
+	}
+}
+
+func fromIsDependencyInputSpec(d *generated.IsDependencyInputSpec) *pb.IsDependencyInputSpec {
+	if d == nil {
+		return nil
+	}
+	return &pb.IsDependencyInputSpec{
+		DependencyType: string(d.DependencyType),
+		Justification:  d.Justification,
+		Origin:         d.Origin,
+		Collector:      d.Collector,
+		DocumentRef:    d.DocumentRef,
+	}
+}
+
+func fromIsOccurrenceInputSpec(o *generated.IsOccurrenceInputSpec) *pb.IsOccurrenceInputSpec {
+	if o == nil {
+		return nil
+	}
+	return &pb.IsOccurrenceInputSpec{
+		Justification: o.Justification,
+		Origin:        o.Origin,
+		Collector:     o.Collector,
+		DocumentRef:   o.DocumentRef,
+	}
+}
+
+func fromPkgEqualInputSpec(p *generated.PkgEqualInputSpec) *pb.PkgEqualInputSpec {
+	if p == nil {
+		return nil
+	}
+	return &pb.PkgEqualInputSpec{
+		Justification: p.Justification,
+		Origin:        p.Origin,
+		Collector:     p.Collector,
+		DocumentRef:   p.DocumentRef,
+	}
+}
+
+func fromPointOfContactInputSpec(p *generated.PointOfContactInputSpec) *pb.PointOfContactInputSpec {
+	if p == nil {
+		return nil
+	}
+	return &pb.PointOfContactInputSpec{
+		Email:         p.Email,
+		Info:          p.Info,
+		Since:         fromTime(p.Since),
+		Justification: p.Justification,
+		Origin:        p.Origin,
+		Collector:     p.Collector,
+		DocumentRef:   p.DocumentRef,
+	}
+}
+
+func fromVexStatementInputSpec(v *generated.VexStatementInputSpec) *pb.VexStatementInputSpec {
+	if v == nil {
+		return nil
+	}
+	return &pb.VexStatementInputSpec{
+		Status:           string(v.Status),
+		VexJustification: string(v.VexJustification),
+		Statement:        v.Statement,
+		StatusNotes:      v.StatusNotes,
+		KnownSince:       fromTime(v.KnownSince),
+		Origin:           v.Origin,
+		Collector:        v.Collector,
+		DocumentRef:      v.DocumentRef,
+	}
+}
+
+func fromVulnEqualInputSpec(v *generated.VulnEqualInputSpec) *pb.VulnEqualInputSpec {
+	if v == nil {
+		return nil
+	}
+	return &pb.VulnEqualInputSpec{
+		Justification: v.Justification,
+		Origin:        v.Origin,
+		Collector:     v.Collector,
+		DocumentRef:   v.DocumentRef,
+	}
+}
+
+func fromVulnerabilityMetadataInputSpec(v *generated.VulnerabilityMetadataInputSpec) *pb.VulnerabilityMetadataInputSpec {
+	if v == nil {
+		return nil
+	}
+	return &pb.VulnerabilityMetadataInputSpec{
+		ScoreType:   string(v.ScoreType),
+		ScoreValue:  v.ScoreValue,
+		Timestamp:   fromTime(v.Timestamp),
+		Origin:      v.Origin,
+		Collector:   v.Collector,
+		DocumentRef: v.DocumentRef,
+	}
+}
+
+// FromIngestPredicates converts the predicates produced by the parsers into
+// the protobuf message accepted by the IngestPredicates RPC.
+func FromIngestPredicates(p assembler.IngestPredicates) *pb.IngestPredicates {
+	out := &pb.IngestPredicates{}
+	for _, v := range p.CertifyScorecard {
+		out.CertifyScorecard = append(out.CertifyScorecard, &pb.CertifyScorecardIngest{
+			Source:    fromSourceInputSpec(v.Source),
+			Scorecard: fromScorecardInputSpec(v.Scorecard),
+		})
+	}
+	for _, v := range p.IsDependency {
+		out.IsDependency = append(out.IsDependency, &pb.IsDependencyIngest{
+			Pkg:          fromPkgInputSpec(v.Pkg),
+			DepPkg:       fromPkgInputSpec(v.DepPkg),
+			IsDependency: fromIsDependencyInputSpec(v.IsDependency),
+		})
+	}
+	for _, v := range p.IsOccurrence {
+		out.IsOccurrence = append(out.IsOccurrence, &pb.IsOccurrenceIngest{
+			Pkg:          fromPkgInputSpec(v.Pkg),
+			Src:          fromSourceInputSpec(v.Src),
+			Artifact:     fromArtifactInputSpec(v.Artifact),
+			IsOccurrence: fromIsOccurrenceInputSpec(v.IsOccurrence),
+		})
+	}
+	for _, v := range p.HasSlsa {
+		slsa := &pb.HasSlsaIngest{
+			Artifact: fromArtifactInputSpec(v.Artifact),
+			HasSlsa:  fromSLSAInputSpec(v.HasSlsa),
+			Builder:  fromBuilderInputSpec(v.Builder),
+		}
+		for i := range v.Materials {
+			slsa.Materials = append(slsa.Materials, fromArtifactInputSpec(&v.Materials[i]))
+		}
+		out.HasSlsa = append(out.HasSlsa, slsa)
+	}
+	for _, v := range p.CertifyVuln {
+		out.CertifyVuln = append(out.CertifyVuln, &pb.CertifyVulnIngest{
+			Pkg:           fromPkgInputSpec(v.Pkg),
+			Vulnerability: fromVulnerabilityInputSpec(v.Vulnerability),
+			VulnData:      fromScanMetadataInput(v.VulnData),
+		})
+	}
+	for _, v := range p.VulnEqual {
+		out.VulnEqual = append(out.VulnEqual, &pb.VulnEqualIngest{
+			Vulnerability:      fromVulnerabilityInputSpec(v.Vulnerability),
+			EqualVulnerability: fromVulnerabilityInputSpec(v.EqualVulnerability),
+			VulnEqual:          fromVulnEqualInputSpec(v.VulnEqual),
+		})
+	}
+	for _, v := range p.HasSourceAt {
+		out.HasSourceAt = append(out.HasSourceAt, &pb.HasSourceAtIngest{
+			Pkg:          fromPkgInputSpec(v.Pkg),
+			PkgMatchFlag: string(v.PkgMatchFlag.Pkg),
+			Src:          fromSourceInputSpec(v.Src),
+			HasSourceAt:  fromHasSourceAtInputSpec(v.HasSourceAt),
+		})
+	}
+	for _, v := range p.CertifyBad {
+		out.CertifyBad = append(out.CertifyBad, &pb.CertifyBadIngest{
+			Pkg:          fromPkgInputSpec(v.Pkg),
+			PkgMatchFlag: string(v.PkgMatchFlag.Pkg),
+			Src:          fromSourceInputSpec(v.Src),
+			Artifact:     fromArtifactInputSpec(v.Artifact),
+			CertifyBad:   fromCertifyBadInputSpec(v.CertifyBad),
+		})
+	}
+	for _, v := range p.CertifyGood {
+		out.CertifyGood = append(out.CertifyGood, &pb.CertifyGoodIngest{
+			Pkg:          fromPkgInputSpec(v.Pkg),
+			PkgMatchFlag: string(v.PkgMatchFlag.Pkg),
+			Src:          fromSourceInputSpec(v.Src),
+			Artifact:     fromArtifactInputSpec(v.Artifact),
+			CertifyGood:  fromCertifyGoodInputSpec(v.CertifyGood),
+		})
+	}
+	for _, v := range p.HasSBOM {
+		out.HasSbom = append(out.HasSbom, &pb.HasSBOMIngest{
+			Pkg:      fromPkgInputSpec(v.Pkg),
+			Artifact: fromArtifactInputSpec(v.Artifact),
+			HasSbom:  fromHasSBOMInputSpec(v.HasSBOM),
+			Includes: fromHasSBOMIncludesInputSpec(v.Includes),
+		})
+	}
+	for _, v := range p.HashEqual {
+		out.HashEqual = append(out.HashEqual, &pb.HashEqualIngest{
+			Artifact:      fromArtifactInputSpec(v.Artifact),
+			EqualArtifact: fromArtifactInputSpec(v.EqualArtifact),
+			HashEqual:     fromHashEqualInputSpec(v.HashEqual),
+		})
+	}
+	for _, v := range p.PkgEqual {
+		out.PkgEqual = append(out.PkgEqual, &pb.PkgEqualIngest{
+			Pkg:      fromPkgInputSpec(v.Pkg),
+			EqualPkg: fromPkgInputSpec(v.EqualPkg),
+			PkgEqual: fromPkgEqualInputSpec(v.PkgEqual),
+		})
+	}
+	for _, v := range p.Vex {
+		out.Vex = append(out.Vex, &pb.VexIngest{
+			Pkg:           fromPkgInputSpec(v.Pkg),
+			Artifact:      fromArtifactInputSpec(v.Artifact),
+			Vulnerability: fromVulnerabilityInputSpec(v.Vulnerability),
+			VexData:       fromVexStatementInputSpec(v.VexData),
+		})
+	}
+	for _, v := range p.PointOfContact {
+		out.PointOfContact = append(out.PointOfContact, &pb.PointOfContactIngest{
+			Pkg:            fromPkgInputSpec(v.Pkg),
+			PkgMatchFlag:   string(v.PkgMatchFlag.Pkg),
+			Src:            fromSourceInputSpec(v.Src),
+			Artifact:       fromArtifactInputSpec(v.Artifact),
+			PointOfContact: fromPointOfContactInputSpec(v.PointOfContact),
+		})
+	}
+	for _, v := range p.VulnMetadata {
+		out.VulnMetadata = append(out.VulnMetadata, &pb.VulnMetadataIngest{
+			Vulnerability: fromVulnerabilityInputSpec(v.Vulnerability),
+			VulnMetadata:  fromVulnerabilityMetadataInputSpec(v.VulnMetadata),
+		})
+	}
+	for _, v := range p.HasMetadata {
+		out.HasMetadata = append(out.HasMetadata, &pb.HasMetadataIngest{
+			Pkg:          fromPkgInputSpec(v.Pkg),
+			PkgMatchFlag: string(v.PkgMatchFlag.Pkg),
+			Src:          fromSourceInputSpec(v.Src),
+			Artifact:     fromArtifactInputSpec(v.Artifact),
+			HasMetadata:  fromHasMetadataInputSpec(v.HasMetadata),
+		})
+	}
+	for _, v := range p.CertifyLegal {
+		out.CertifyLegal = append(out.CertifyLegal, &pb.CertifyLegalIngest{
+			Pkg:          fromPkgInputSpec(v.Pkg),
+			Src:          fromSourceInputSpec(v.Src),
+			Declared:     fromLicenseInputSpecs(v.Declared),
+			Discovered:   fromLicenseInputSpecs(v.Discovered),
+			CertifyLegal: fromCertifyLegalInputSpec(v.CertifyLegal),
+		})
+	}
+	for _, v := range p.Documents {
+		out.Documents = append(out.Documents, fromDocumentInputSpec(v))
+	}
+	return out
+}
@@ -0,0 +1,15195 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: pkg/assembler/grpc/guacapi/guacapi.proto
+
+package guacapi
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PackageQualifierInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *PackageQualifierInputSpec) Reset() {
+	*x = PackageQualifierInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageQualifierInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageQualifierInputSpec) ProtoMessage() {}
+
+func (x *PackageQualifierInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageQualifierInputSpec.ProtoReflect.Descriptor instead.
+func (*PackageQualifierInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PackageQualifierInputSpec) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PackageQualifierInputSpec) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type PkgInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       string                       `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Namespace  *string                      `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Name       string                       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Version    *string                      `protobuf:"bytes,4,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	Qualifiers []*PackageQualifierInputSpec `protobuf:"bytes,5,rep,name=qualifiers,proto3" json:"qualifiers,omitempty"`
+	Subpath    *string                      `protobuf:"bytes,6,opt,name=subpath,proto3,oneof" json:"subpath,omitempty"`
+}
+
+func (x *PkgInputSpec) Reset() {
+	*x = PkgInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PkgInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PkgInputSpec) ProtoMessage() {}
+
+func (x *PkgInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PkgInputSpec.ProtoReflect.Descriptor instead.
+func (*PkgInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PkgInputSpec) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PkgInputSpec) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *PkgInputSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PkgInputSpec) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return ""
+}
+
+func (x *PkgInputSpec) GetQualifiers() []*PackageQualifierInputSpec {
+	if x != nil {
+		return x.Qualifiers
+	}
+	return nil
+}
+
+func (x *PkgInputSpec) GetSubpath() string {
+	if x != nil && x.Subpath != nil {
+		return *x.Subpath
+	}
+	return ""
+}
+
+type SourceInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Namespace string  `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Tag       *string `protobuf:"bytes,4,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	Commit    *string `protobuf:"bytes,5,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+}
+
+func (x *SourceInputSpec) Reset() {
+	*x = SourceInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceInputSpec) ProtoMessage() {}
+
+func (x *SourceInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceInputSpec.ProtoReflect.Descriptor instead.
+func (*SourceInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SourceInputSpec) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SourceInputSpec) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SourceInputSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SourceInputSpec) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+func (x *SourceInputSpec) GetCommit() string {
+	if x != nil && x.Commit != nil {
+		return *x.Commit
+	}
+	return ""
+}
+
+type ArtifactInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Digest    string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *ArtifactInputSpec) Reset() {
+	*x = ArtifactInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactInputSpec) ProtoMessage() {}
+
+func (x *ArtifactInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactInputSpec.ProtoReflect.Descriptor instead.
+func (*ArtifactInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ArtifactInputSpec) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *ArtifactInputSpec) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type BuilderInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *BuilderInputSpec) Reset() {
+	*x = BuilderInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuilderInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuilderInputSpec) ProtoMessage() {}
+
+func (x *BuilderInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuilderInputSpec.ProtoReflect.Descriptor instead.
+func (*BuilderInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BuilderInputSpec) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type VulnerabilityInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type            string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	VulnerabilityId string `protobuf:"bytes,2,opt,name=vulnerability_id,json=vulnerabilityId,proto3" json:"vulnerability_id,omitempty"`
+}
+
+func (x *VulnerabilityInputSpec) Reset() {
+	*x = VulnerabilityInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilityInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilityInputSpec) ProtoMessage() {}
+
+func (x *VulnerabilityInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilityInputSpec.ProtoReflect.Descriptor instead.
+func (*VulnerabilityInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VulnerabilityInputSpec) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VulnerabilityInputSpec) GetVulnerabilityId() string {
+	if x != nil {
+		return x.VulnerabilityId
+	}
+	return ""
+}
+
+type LicenseInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Inline      *string `protobuf:"bytes,2,opt,name=inline,proto3,oneof" json:"inline,omitempty"`
+	ListVersion *string `protobuf:"bytes,3,opt,name=list_version,json=listVersion,proto3,oneof" json:"list_version,omitempty"`
+}
+
+func (x *LicenseInputSpec) Reset() {
+	*x = LicenseInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LicenseInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LicenseInputSpec) ProtoMessage() {}
+
+func (x *LicenseInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LicenseInputSpec.ProtoReflect.Descriptor instead.
+func (*LicenseInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LicenseInputSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LicenseInputSpec) GetInline() string {
+	if x != nil && x.Inline != nil {
+		return *x.Inline
+	}
+	return ""
+}
+
+func (x *LicenseInputSpec) GetListVersion() string {
+	if x != nil && x.ListVersion != nil {
+		return *x.ListVersion
+	}
+	return ""
+}
+
+type CertifyBadInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Justification string                 `protobuf:"bytes,1,opt,name=justification,proto3" json:"justification,omitempty"`
+	KnownSince    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=known_since,json=knownSince,proto3" json:"known_since,omitempty"`
+	Origin        string                 `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                 `protobuf:"bytes,4,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                 `protobuf:"bytes,5,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyBadInputSpec) Reset() {
+	*x = CertifyBadInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyBadInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyBadInputSpec) ProtoMessage() {}
+
+func (x *CertifyBadInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyBadInputSpec.ProtoReflect.Descriptor instead.
+func (*CertifyBadInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CertifyBadInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CertifyBadInputSpec) GetKnownSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KnownSince
+	}
+	return nil
+}
+
+func (x *CertifyBadInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyBadInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyBadInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type CertifyGoodInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Justification string                 `protobuf:"bytes,1,opt,name=justification,proto3" json:"justification,omitempty"`
+	KnownSince    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=known_since,json=knownSince,proto3" json:"known_since,omitempty"`
+	Origin        string                 `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                 `protobuf:"bytes,4,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                 `protobuf:"bytes,5,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyGoodInputSpec) Reset() {
+	*x = CertifyGoodInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyGoodInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyGoodInputSpec) ProtoMessage() {}
+
+func (x *CertifyGoodInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyGoodInputSpec.ProtoReflect.Descriptor instead.
+func (*CertifyGoodInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CertifyGoodInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CertifyGoodInputSpec) GetKnownSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KnownSince
+	}
+	return nil
+}
+
+func (x *CertifyGoodInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyGoodInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyGoodInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type CertifyLegalInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeclaredLicense   string                 `protobuf:"bytes,1,opt,name=declared_license,json=declaredLicense,proto3" json:"declared_license,omitempty"`
+	DiscoveredLicense string                 `protobuf:"bytes,2,opt,name=discovered_license,json=discoveredLicense,proto3" json:"discovered_license,omitempty"`
+	Attribution       string                 `protobuf:"bytes,3,opt,name=attribution,proto3" json:"attribution,omitempty"`
+	Justification     string                 `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	TimeScanned       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_scanned,json=timeScanned,proto3" json:"time_scanned,omitempty"`
+	Origin            string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector         string                 `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef       string                 `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyLegalInputSpec) Reset() {
+	*x = CertifyLegalInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyLegalInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyLegalInputSpec) ProtoMessage() {}
+
+func (x *CertifyLegalInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyLegalInputSpec.ProtoReflect.Descriptor instead.
+func (*CertifyLegalInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CertifyLegalInputSpec) GetDeclaredLicense() string {
+	if x != nil {
+		return x.DeclaredLicense
+	}
+	return ""
+}
+
+func (x *CertifyLegalInputSpec) GetDiscoveredLicense() string {
+	if x != nil {
+		return x.DiscoveredLicense
+	}
+	return ""
+}
+
+func (x *CertifyLegalInputSpec) GetAttribution() string {
+	if x != nil {
+		return x.Attribution
+	}
+	return ""
+}
+
+func (x *CertifyLegalInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CertifyLegalInputSpec) GetTimeScanned() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeScanned
+	}
+	return nil
+}
+
+func (x *CertifyLegalInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyLegalInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyLegalInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type ScorecardCheckInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Check string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	Score int32  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ScorecardCheckInputSpec) Reset() {
+	*x = ScorecardCheckInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScorecardCheckInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScorecardCheckInputSpec) ProtoMessage() {}
+
+func (x *ScorecardCheckInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScorecardCheckInputSpec.ProtoReflect.Descriptor instead.
+func (*ScorecardCheckInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ScorecardCheckInputSpec) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *ScorecardCheckInputSpec) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ScorecardInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checks           []*ScorecardCheckInputSpec `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	AggregateScore   float64                    `protobuf:"fixed64,2,opt,name=aggregate_score,json=aggregateScore,proto3" json:"aggregate_score,omitempty"`
+	TimeScanned      *timestamppb.Timestamp     `protobuf:"bytes,3,opt,name=time_scanned,json=timeScanned,proto3" json:"time_scanned,omitempty"`
+	ScorecardVersion string                     `protobuf:"bytes,4,opt,name=scorecard_version,json=scorecardVersion,proto3" json:"scorecard_version,omitempty"`
+	ScorecardCommit  string                     `protobuf:"bytes,5,opt,name=scorecard_commit,json=scorecardCommit,proto3" json:"scorecard_commit,omitempty"`
+	Origin           string                     `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector        string                     `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef      string                     `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *ScorecardInputSpec) Reset() {
+	*x = ScorecardInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScorecardInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScorecardInputSpec) ProtoMessage() {}
+
+func (x *ScorecardInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScorecardInputSpec.ProtoReflect.Descriptor instead.
+func (*ScorecardInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScorecardInputSpec) GetChecks() []*ScorecardCheckInputSpec {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *ScorecardInputSpec) GetAggregateScore() float64 {
+	if x != nil {
+		return x.AggregateScore
+	}
+	return 0
+}
+
+func (x *ScorecardInputSpec) GetTimeScanned() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeScanned
+	}
+	return nil
+}
+
+func (x *ScorecardInputSpec) GetScorecardVersion() string {
+	if x != nil {
+		return x.ScorecardVersion
+	}
+	return ""
+}
+
+func (x *ScorecardInputSpec) GetScorecardCommit() string {
+	if x != nil {
+		return x.ScorecardCommit
+	}
+	return ""
+}
+
+func (x *ScorecardInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *ScorecardInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *ScorecardInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type ScanMetadataInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeScanned    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_scanned,json=timeScanned,proto3" json:"time_scanned,omitempty"`
+	DbUri          string                 `protobuf:"bytes,2,opt,name=db_uri,json=dbUri,proto3" json:"db_uri,omitempty"`
+	DbVersion      string                 `protobuf:"bytes,3,opt,name=db_version,json=dbVersion,proto3" json:"db_version,omitempty"`
+	ScannerUri     string                 `protobuf:"bytes,4,opt,name=scanner_uri,json=scannerUri,proto3" json:"scanner_uri,omitempty"`
+	ScannerVersion string                 `protobuf:"bytes,5,opt,name=scanner_version,json=scannerVersion,proto3" json:"scanner_version,omitempty"`
+	Origin         string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector      string                 `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef    string                 `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *ScanMetadataInput) Reset() {
+	*x = ScanMetadataInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanMetadataInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanMetadataInput) ProtoMessage() {}
+
+func (x *ScanMetadataInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanMetadataInput.ProtoReflect.Descriptor instead.
+func (*ScanMetadataInput) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ScanMetadataInput) GetTimeScanned() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeScanned
+	}
+	return nil
+}
+
+func (x *ScanMetadataInput) GetDbUri() string {
+	if x != nil {
+		return x.DbUri
+	}
+	return ""
+}
+
+func (x *ScanMetadataInput) GetDbVersion() string {
+	if x != nil {
+		return x.DbVersion
+	}
+	return ""
+}
+
+func (x *ScanMetadataInput) GetScannerUri() string {
+	if x != nil {
+		return x.ScannerUri
+	}
+	return ""
+}
+
+func (x *ScanMetadataInput) GetScannerVersion() string {
+	if x != nil {
+		return x.ScannerVersion
+	}
+	return ""
+}
+
+func (x *ScanMetadataInput) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *ScanMetadataInput) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *ScanMetadataInput) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type HasMetadataInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Justification string                 `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string                 `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                 `protobuf:"bytes,6,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                 `protobuf:"bytes,7,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *HasMetadataInputSpec) Reset() {
+	*x = HasMetadataInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasMetadataInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasMetadataInputSpec) ProtoMessage() {}
+
+func (x *HasMetadataInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasMetadataInputSpec.ProtoReflect.Descriptor instead.
+func (*HasMetadataInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HasMetadataInputSpec) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HasMetadataInputSpec) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *HasMetadataInputSpec) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *HasMetadataInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *HasMetadataInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *HasMetadataInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *HasMetadataInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type HasSBOMInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri              string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Algorithm        string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Digest           string                 `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	DownloadLocation string                 `protobuf:"bytes,4,opt,name=download_location,json=downloadLocation,proto3" json:"download_location,omitempty"`
+	KnownSince       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=known_since,json=knownSince,proto3" json:"known_since,omitempty"`
+	Origin           string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector        string                 `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef      string                 `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *HasSBOMInputSpec) Reset() {
+	*x = HasSBOMInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSBOMInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSBOMInputSpec) ProtoMessage() {}
+
+func (x *HasSBOMInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSBOMInputSpec.ProtoReflect.Descriptor instead.
+func (*HasSBOMInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HasSBOMInputSpec) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *HasSBOMInputSpec) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *HasSBOMInputSpec) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *HasSBOMInputSpec) GetDownloadLocation() string {
+	if x != nil {
+		return x.DownloadLocation
+	}
+	return ""
+}
+
+func (x *HasSBOMInputSpec) GetKnownSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KnownSince
+	}
+	return nil
+}
+
+func (x *HasSBOMInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *HasSBOMInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *HasSBOMInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type SLSAPredicateInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SLSAPredicateInputSpec) Reset() {
+	*x = SLSAPredicateInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLSAPredicateInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLSAPredicateInputSpec) ProtoMessage() {}
+
+func (x *SLSAPredicateInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLSAPredicateInputSpec.ProtoReflect.Descriptor instead.
+func (*SLSAPredicateInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SLSAPredicateInputSpec) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SLSAPredicateInputSpec) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SLSAInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildType     string                    `protobuf:"bytes,1,opt,name=build_type,json=buildType,proto3" json:"build_type,omitempty"`
+	SlsaPredicate []*SLSAPredicateInputSpec `protobuf:"bytes,2,rep,name=slsa_predicate,json=slsaPredicate,proto3" json:"slsa_predicate,omitempty"`
+	SlsaVersion   string                    `protobuf:"bytes,3,opt,name=slsa_version,json=slsaVersion,proto3" json:"slsa_version,omitempty"`
+	StartedOn     *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=started_on,json=startedOn,proto3" json:"started_on,omitempty"`
+	FinishedOn    *timestamppb.Timestamp    `protobuf:"bytes,5,opt,name=finished_on,json=finishedOn,proto3" json:"finished_on,omitempty"`
+	Origin        string                    `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                    `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                    `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *SLSAInputSpec) Reset() {
+	*x = SLSAInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLSAInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLSAInputSpec) ProtoMessage() {}
+
+func (x *SLSAInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLSAInputSpec.ProtoReflect.Descriptor instead.
+func (*SLSAInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SLSAInputSpec) GetBuildType() string {
+	if x != nil {
+		return x.BuildType
+	}
+	return ""
+}
+
+func (x *SLSAInputSpec) GetSlsaPredicate() []*SLSAPredicateInputSpec {
+	if x != nil {
+		return x.SlsaPredicate
+	}
+	return nil
+}
+
+func (x *SLSAInputSpec) GetSlsaVersion() string {
+	if x != nil {
+		return x.SlsaVersion
+	}
+	return ""
+}
+
+func (x *SLSAInputSpec) GetStartedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedOn
+	}
+	return nil
+}
+
+func (x *SLSAInputSpec) GetFinishedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedOn
+	}
+	return nil
+}
+
+func (x *SLSAInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *SLSAInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *SLSAInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type HasSourceAtInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KnownSince    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=known_since,json=knownSince,proto3" json:"known_since,omitempty"`
+	Justification string                 `protobuf:"bytes,2,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string                 `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                 `protobuf:"bytes,4,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                 `protobuf:"bytes,5,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *HasSourceAtInputSpec) Reset() {
+	*x = HasSourceAtInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSourceAtInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSourceAtInputSpec) ProtoMessage() {}
+
+func (x *HasSourceAtInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSourceAtInputSpec.ProtoReflect.Descriptor instead.
+func (*HasSourceAtInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HasSourceAtInputSpec) GetKnownSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KnownSince
+	}
+	return nil
+}
+
+func (x *HasSourceAtInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *HasSourceAtInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *HasSourceAtInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *HasSourceAtInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type HashEqualInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Justification string `protobuf:"bytes,1,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string `protobuf:"bytes,4,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *HashEqualInputSpec) Reset() {
+	*x = HashEqualInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashEqualInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashEqualInputSpec) ProtoMessage() {}
+
+func (x *HashEqualInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashEqualInputSpec.ProtoReflect.Descriptor instead.
+func (*HashEqualInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HashEqualInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *HashEqualInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *HashEqualInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *HashEqualInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type IsDependencyInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DependencyType string `protobuf:"bytes,1,opt,name=dependency_type,json=dependencyType,proto3" json:"dependency_type,omitempty"`
+	Justification  string `protobuf:"bytes,2,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin         string `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector      string `protobuf:"bytes,4,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef    string `protobuf:"bytes,5,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *IsDependencyInputSpec) Reset() {
+	*x = IsDependencyInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsDependencyInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsDependencyInputSpec) ProtoMessage() {}
+
+func (x *IsDependencyInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsDependencyInputSpec.ProtoReflect.Descriptor instead.
+func (*IsDependencyInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *IsDependencyInputSpec) GetDependencyType() string {
+	if x != nil {
+		return x.DependencyType
+	}
+	return ""
+}
+
+func (x *IsDependencyInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *IsDependencyInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *IsDependencyInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *IsDependencyInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type IsOccurrenceInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Justification string `protobuf:"bytes,1,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string `protobuf:"bytes,4,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *IsOccurrenceInputSpec) Reset() {
+	*x = IsOccurrenceInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsOccurrenceInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsOccurrenceInputSpec) ProtoMessage() {}
+
+func (x *IsOccurrenceInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsOccurrenceInputSpec.ProtoReflect.Descriptor instead.
+func (*IsOccurrenceInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *IsOccurrenceInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *IsOccurrenceInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *IsOccurrenceInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *IsOccurrenceInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type PkgEqualInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Justification string `protobuf:"bytes,1,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string `protobuf:"bytes,4,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *PkgEqualInputSpec) Reset() {
+	*x = PkgEqualInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PkgEqualInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PkgEqualInputSpec) ProtoMessage() {}
+
+func (x *PkgEqualInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PkgEqualInputSpec.ProtoReflect.Descriptor instead.
+func (*PkgEqualInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PkgEqualInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *PkgEqualInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *PkgEqualInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *PkgEqualInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type PointOfContactInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Info          string                 `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	Justification string                 `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string                 `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                 `protobuf:"bytes,6,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                 `protobuf:"bytes,7,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *PointOfContactInputSpec) Reset() {
+	*x = PointOfContactInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointOfContactInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointOfContactInputSpec) ProtoMessage() {}
+
+func (x *PointOfContactInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointOfContactInputSpec.ProtoReflect.Descriptor instead.
+func (*PointOfContactInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PointOfContactInputSpec) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PointOfContactInputSpec) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+func (x *PointOfContactInputSpec) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *PointOfContactInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *PointOfContactInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *PointOfContactInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *PointOfContactInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type VexStatementInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status           string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	VexJustification string                 `protobuf:"bytes,2,opt,name=vex_justification,json=vexJustification,proto3" json:"vex_justification,omitempty"`
+	Statement        string                 `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+	StatusNotes      string                 `protobuf:"bytes,4,opt,name=status_notes,json=statusNotes,proto3" json:"status_notes,omitempty"`
+	KnownSince       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=known_since,json=knownSince,proto3" json:"known_since,omitempty"`
+	Origin           string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector        string                 `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef      string                 `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *VexStatementInputSpec) Reset() {
+	*x = VexStatementInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VexStatementInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VexStatementInputSpec) ProtoMessage() {}
+
+func (x *VexStatementInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VexStatementInputSpec.ProtoReflect.Descriptor instead.
+func (*VexStatementInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VexStatementInputSpec) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VexStatementInputSpec) GetVexJustification() string {
+	if x != nil {
+		return x.VexJustification
+	}
+	return ""
+}
+
+func (x *VexStatementInputSpec) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *VexStatementInputSpec) GetStatusNotes() string {
+	if x != nil {
+		return x.StatusNotes
+	}
+	return ""
+}
+
+func (x *VexStatementInputSpec) GetKnownSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KnownSince
+	}
+	return nil
+}
+
+func (x *VexStatementInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *VexStatementInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *VexStatementInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type VulnEqualInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Justification string `protobuf:"bytes,1,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string `protobuf:"bytes,4,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *VulnEqualInputSpec) Reset() {
+	*x = VulnEqualInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnEqualInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnEqualInputSpec) ProtoMessage() {}
+
+func (x *VulnEqualInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnEqualInputSpec.ProtoReflect.Descriptor instead.
+func (*VulnEqualInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VulnEqualInputSpec) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *VulnEqualInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *VulnEqualInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *VulnEqualInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type VulnerabilityMetadataInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScoreType   string                 `protobuf:"bytes,1,opt,name=score_type,json=scoreType,proto3" json:"score_type,omitempty"`
+	ScoreValue  float64                `protobuf:"fixed64,2,opt,name=score_value,json=scoreValue,proto3" json:"score_value,omitempty"`
+	Timestamp   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Origin      string                 `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector   string                 `protobuf:"bytes,5,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef string                 `protobuf:"bytes,6,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *VulnerabilityMetadataInputSpec) Reset() {
+	*x = VulnerabilityMetadataInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilityMetadataInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilityMetadataInputSpec) ProtoMessage() {}
+
+func (x *VulnerabilityMetadataInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilityMetadataInputSpec.ProtoReflect.Descriptor instead.
+func (*VulnerabilityMetadataInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VulnerabilityMetadataInputSpec) GetScoreType() string {
+	if x != nil {
+		return x.ScoreType
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadataInputSpec) GetScoreValue() float64 {
+	if x != nil {
+		return x.ScoreValue
+	}
+	return 0
+}
+
+func (x *VulnerabilityMetadataInputSpec) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *VulnerabilityMetadataInputSpec) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadataInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadataInputSpec) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type DocumentInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlobKey       string                 `protobuf:"bytes,1,opt,name=blob_key,json=blobKey,proto3" json:"blob_key,omitempty"`
+	Sha256        string                 `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	DocumentType  string                 `protobuf:"bytes,3,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	Collector     string                 `protobuf:"bytes,5,opt,name=collector,proto3" json:"collector,omitempty"`
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	ParserVersion string                 `protobuf:"bytes,7,opt,name=parser_version,json=parserVersion,proto3" json:"parser_version,omitempty"`
+	IngestedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ingested_at,json=ingestedAt,proto3" json:"ingested_at,omitempty"`
+}
+
+func (x *DocumentInputSpec) Reset() {
+	*x = DocumentInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentInputSpec) ProtoMessage() {}
+
+func (x *DocumentInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentInputSpec.ProtoReflect.Descriptor instead.
+func (*DocumentInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DocumentInputSpec) GetBlobKey() string {
+	if x != nil {
+		return x.BlobKey
+	}
+	return ""
+}
+
+func (x *DocumentInputSpec) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *DocumentInputSpec) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *DocumentInputSpec) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DocumentInputSpec) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *DocumentInputSpec) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DocumentInputSpec) GetParserVersion() string {
+	if x != nil {
+		return x.ParserVersion
+	}
+	return ""
+}
+
+func (x *DocumentInputSpec) GetIngestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IngestedAt
+	}
+	return nil
+}
+
+type CertifyScorecardIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source    *SourceInputSpec    `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Scorecard *ScorecardInputSpec `protobuf:"bytes,2,opt,name=scorecard,proto3" json:"scorecard,omitempty"`
+}
+
+func (x *CertifyScorecardIngest) Reset() {
+	*x = CertifyScorecardIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyScorecardIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyScorecardIngest) ProtoMessage() {}
+
+func (x *CertifyScorecardIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyScorecardIngest.ProtoReflect.Descriptor instead.
+func (*CertifyScorecardIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CertifyScorecardIngest) GetSource() *SourceInputSpec {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *CertifyScorecardIngest) GetScorecard() *ScorecardInputSpec {
+	if x != nil {
+		return x.Scorecard
+	}
+	return nil
+}
+
+type IsDependencyIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg          *PkgInputSpec          `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	DepPkg       *PkgInputSpec          `protobuf:"bytes,2,opt,name=dep_pkg,json=depPkg,proto3" json:"dep_pkg,omitempty"`
+	IsDependency *IsDependencyInputSpec `protobuf:"bytes,3,opt,name=is_dependency,json=isDependency,proto3" json:"is_dependency,omitempty"`
+}
+
+func (x *IsDependencyIngest) Reset() {
+	*x = IsDependencyIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsDependencyIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsDependencyIngest) ProtoMessage() {}
+
+func (x *IsDependencyIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsDependencyIngest.ProtoReflect.Descriptor instead.
+func (*IsDependencyIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *IsDependencyIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *IsDependencyIngest) GetDepPkg() *PkgInputSpec {
+	if x != nil {
+		return x.DepPkg
+	}
+	return nil
+}
+
+func (x *IsDependencyIngest) GetIsDependency() *IsDependencyInputSpec {
+	if x != nil {
+		return x.IsDependency
+	}
+	return nil
+}
+
+type IsOccurrenceIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg          *PkgInputSpec          `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Src          *SourceInputSpec       `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	Artifact     *ArtifactInputSpec     `protobuf:"bytes,3,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	IsOccurrence *IsOccurrenceInputSpec `protobuf:"bytes,4,opt,name=is_occurrence,json=isOccurrence,proto3" json:"is_occurrence,omitempty"`
+}
+
+func (x *IsOccurrenceIngest) Reset() {
+	*x = IsOccurrenceIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsOccurrenceIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsOccurrenceIngest) ProtoMessage() {}
+
+func (x *IsOccurrenceIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsOccurrenceIngest.ProtoReflect.Descriptor instead.
+func (*IsOccurrenceIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *IsOccurrenceIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *IsOccurrenceIngest) GetSrc() *SourceInputSpec {
+	if x != nil {
+		return x.Src
+	}
+	return nil
+}
+
+func (x *IsOccurrenceIngest) GetArtifact() *ArtifactInputSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *IsOccurrenceIngest) GetIsOccurrence() *IsOccurrenceInputSpec {
+	if x != nil {
+		return x.IsOccurrence
+	}
+	return nil
+}
+
+type HasSlsaIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artifact  *ArtifactInputSpec   `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	HasSlsa   *SLSAInputSpec       `protobuf:"bytes,2,opt,name=has_slsa,json=hasSlsa,proto3" json:"has_slsa,omitempty"`
+	Materials []*ArtifactInputSpec `protobuf:"bytes,3,rep,name=materials,proto3" json:"materials,omitempty"`
+	Builder   *BuilderInputSpec    `protobuf:"bytes,4,opt,name=builder,proto3" json:"builder,omitempty"`
+}
+
+func (x *HasSlsaIngest) Reset() {
+	*x = HasSlsaIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSlsaIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSlsaIngest) ProtoMessage() {}
+
+func (x *HasSlsaIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSlsaIngest.ProtoReflect.Descriptor instead.
+func (*HasSlsaIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HasSlsaIngest) GetArtifact() *ArtifactInputSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *HasSlsaIngest) GetHasSlsa() *SLSAInputSpec {
+	if x != nil {
+		return x.HasSlsa
+	}
+	return nil
+}
+
+func (x *HasSlsaIngest) GetMaterials() []*ArtifactInputSpec {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *HasSlsaIngest) GetBuilder() *BuilderInputSpec {
+	if x != nil {
+		return x.Builder
+	}
+	return nil
+}
+
+type CertifyVulnIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg           *PkgInputSpec           `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Vulnerability *VulnerabilityInputSpec `protobuf:"bytes,2,opt,name=vulnerability,proto3" json:"vulnerability,omitempty"`
+	VulnData      *ScanMetadataInput      `protobuf:"bytes,3,opt,name=vuln_data,json=vulnData,proto3" json:"vuln_data,omitempty"`
+}
+
+func (x *CertifyVulnIngest) Reset() {
+	*x = CertifyVulnIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyVulnIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyVulnIngest) ProtoMessage() {}
+
+func (x *CertifyVulnIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyVulnIngest.ProtoReflect.Descriptor instead.
+func (*CertifyVulnIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CertifyVulnIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *CertifyVulnIngest) GetVulnerability() *VulnerabilityInputSpec {
+	if x != nil {
+		return x.Vulnerability
+	}
+	return nil
+}
+
+func (x *CertifyVulnIngest) GetVulnData() *ScanMetadataInput {
+	if x != nil {
+		return x.VulnData
+	}
+	return nil
+}
+
+type VulnEqualIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vulnerability      *VulnerabilityInputSpec `protobuf:"bytes,1,opt,name=vulnerability,proto3" json:"vulnerability,omitempty"`
+	EqualVulnerability *VulnerabilityInputSpec `protobuf:"bytes,2,opt,name=equal_vulnerability,json=equalVulnerability,proto3" json:"equal_vulnerability,omitempty"`
+	VulnEqual          *VulnEqualInputSpec     `protobuf:"bytes,3,opt,name=vuln_equal,json=vulnEqual,proto3" json:"vuln_equal,omitempty"`
+}
+
+func (x *VulnEqualIngest) Reset() {
+	*x = VulnEqualIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnEqualIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnEqualIngest) ProtoMessage() {}
+
+func (x *VulnEqualIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnEqualIngest.ProtoReflect.Descriptor instead.
+func (*VulnEqualIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VulnEqualIngest) GetVulnerability() *VulnerabilityInputSpec {
+	if x != nil {
+		return x.Vulnerability
+	}
+	return nil
+}
+
+func (x *VulnEqualIngest) GetEqualVulnerability() *VulnerabilityInputSpec {
+	if x != nil {
+		return x.EqualVulnerability
+	}
+	return nil
+}
+
+func (x *VulnEqualIngest) GetVulnEqual() *VulnEqualInputSpec {
+	if x != nil {
+		return x.VulnEqual
+	}
+	return nil
+}
+
+type HasSourceAtIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg          *PkgInputSpec         `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	PkgMatchFlag string                `protobuf:"bytes,2,opt,name=pkg_match_flag,json=pkgMatchFlag,proto3" json:"pkg_match_flag,omitempty"`
+	Src          *SourceInputSpec      `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	HasSourceAt  *HasSourceAtInputSpec `protobuf:"bytes,4,opt,name=has_source_at,json=hasSourceAt,proto3" json:"has_source_at,omitempty"`
+}
+
+func (x *HasSourceAtIngest) Reset() {
+	*x = HasSourceAtIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSourceAtIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSourceAtIngest) ProtoMessage() {}
+
+func (x *HasSourceAtIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSourceAtIngest.ProtoReflect.Descriptor instead.
+func (*HasSourceAtIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *HasSourceAtIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *HasSourceAtIngest) GetPkgMatchFlag() string {
+	if x != nil {
+		return x.PkgMatchFlag
+	}
+	return ""
+}
+
+func (x *HasSourceAtIngest) GetSrc() *SourceInputSpec {
+	if x != nil {
+		return x.Src
+	}
+	return nil
+}
+
+func (x *HasSourceAtIngest) GetHasSourceAt() *HasSourceAtInputSpec {
+	if x != nil {
+		return x.HasSourceAt
+	}
+	return nil
+}
+
+type CertifyBadIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg          *PkgInputSpec        `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	PkgMatchFlag string               `protobuf:"bytes,2,opt,name=pkg_match_flag,json=pkgMatchFlag,proto3" json:"pkg_match_flag,omitempty"`
+	Src          *SourceInputSpec     `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Artifact     *ArtifactInputSpec   `protobuf:"bytes,4,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	CertifyBad   *CertifyBadInputSpec `protobuf:"bytes,5,opt,name=certify_bad,json=certifyBad,proto3" json:"certify_bad,omitempty"`
+}
+
+func (x *CertifyBadIngest) Reset() {
+	*x = CertifyBadIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyBadIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyBadIngest) ProtoMessage() {}
+
+func (x *CertifyBadIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyBadIngest.ProtoReflect.Descriptor instead.
+func (*CertifyBadIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CertifyBadIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *CertifyBadIngest) GetPkgMatchFlag() string {
+	if x != nil {
+		return x.PkgMatchFlag
+	}
+	return ""
+}
+
+func (x *CertifyBadIngest) GetSrc() *SourceInputSpec {
+	if x != nil {
+		return x.Src
+	}
+	return nil
+}
+
+func (x *CertifyBadIngest) GetArtifact() *ArtifactInputSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *CertifyBadIngest) GetCertifyBad() *CertifyBadInputSpec {
+	if x != nil {
+		return x.CertifyBad
+	}
+	return nil
+}
+
+type CertifyGoodIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg          *PkgInputSpec         `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	PkgMatchFlag string                `protobuf:"bytes,2,opt,name=pkg_match_flag,json=pkgMatchFlag,proto3" json:"pkg_match_flag,omitempty"`
+	Src          *SourceInputSpec      `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Artifact     *ArtifactInputSpec    `protobuf:"bytes,4,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	CertifyGood  *CertifyGoodInputSpec `protobuf:"bytes,5,opt,name=certify_good,json=certifyGood,proto3" json:"certify_good,omitempty"`
+}
+
+func (x *CertifyGoodIngest) Reset() {
+	*x = CertifyGoodIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyGoodIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyGoodIngest) ProtoMessage() {}
+
+func (x *CertifyGoodIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyGoodIngest.ProtoReflect.Descriptor instead.
+func (*CertifyGoodIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CertifyGoodIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *CertifyGoodIngest) GetPkgMatchFlag() string {
+	if x != nil {
+		return x.PkgMatchFlag
+	}
+	return ""
+}
+
+func (x *CertifyGoodIngest) GetSrc() *SourceInputSpec {
+	if x != nil {
+		return x.Src
+	}
+	return nil
+}
+
+func (x *CertifyGoodIngest) GetArtifact() *ArtifactInputSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *CertifyGoodIngest) GetCertifyGood() *CertifyGoodInputSpec {
+	if x != nil {
+		return x.CertifyGood
+	}
+	return nil
+}
+
+type HasSBOMIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg      *PkgInputSpec      `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Artifact *ArtifactInputSpec `protobuf:"bytes,2,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	HasSbom  *HasSBOMInputSpec  `protobuf:"bytes,3,opt,name=has_sbom,json=hasSbom,proto3" json:"has_sbom,omitempty"`
+	// nodes ingested in earlier batches that the SBOM also includes, in
+	// addition to the ones of the batch of the HasSBOM
+	Includes *HasSBOMIncludesInputSpec `protobuf:"bytes,4,opt,name=includes,proto3" json:"includes,omitempty"`
+}
+
+func (x *HasSBOMIngest) Reset() {
+	*x = HasSBOMIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSBOMIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSBOMIngest) ProtoMessage() {}
+
+func (x *HasSBOMIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSBOMIngest.ProtoReflect.Descriptor instead.
+func (*HasSBOMIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *HasSBOMIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *HasSBOMIngest) GetArtifact() *ArtifactInputSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *HasSBOMIngest) GetHasSbom() *HasSBOMInputSpec {
+	if x != nil {
+		return x.HasSbom
+	}
+	return nil
+}
+
+func (x *HasSBOMIngest) GetIncludes() *HasSBOMIncludesInputSpec {
+	if x != nil {
+		return x.Includes
+	}
+	return nil
+}
+
+type HasSBOMIncludesInputSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packages     []string `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	Artifacts    []string `protobuf:"bytes,2,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	Dependencies []string `protobuf:"bytes,3,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Occurrences  []string `protobuf:"bytes,4,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+}
+
+func (x *HasSBOMIncludesInputSpec) Reset() {
+	*x = HasSBOMIncludesInputSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSBOMIncludesInputSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSBOMIncludesInputSpec) ProtoMessage() {}
+
+func (x *HasSBOMIncludesInputSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSBOMIncludesInputSpec.ProtoReflect.Descriptor instead.
+func (*HasSBOMIncludesInputSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *HasSBOMIncludesInputSpec) GetPackages() []string {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *HasSBOMIncludesInputSpec) GetArtifacts() []string {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *HasSBOMIncludesInputSpec) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *HasSBOMIncludesInputSpec) GetOccurrences() []string {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+type HashEqualIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artifact      *ArtifactInputSpec  `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	EqualArtifact *ArtifactInputSpec  `protobuf:"bytes,2,opt,name=equal_artifact,json=equalArtifact,proto3" json:"equal_artifact,omitempty"`
+	HashEqual     *HashEqualInputSpec `protobuf:"bytes,3,opt,name=hash_equal,json=hashEqual,proto3" json:"hash_equal,omitempty"`
+}
+
+func (x *HashEqualIngest) Reset() {
+	*x = HashEqualIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashEqualIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashEqualIngest) ProtoMessage() {}
+
+func (x *HashEqualIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashEqualIngest.ProtoReflect.Descriptor instead.
+func (*HashEqualIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HashEqualIngest) GetArtifact() *ArtifactInputSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *HashEqualIngest) GetEqualArtifact() *ArtifactInputSpec {
+	if x != nil {
+		return x.EqualArtifact
+	}
+	return nil
+}
+
+func (x *HashEqualIngest) GetHashEqual() *HashEqualInputSpec {
+	if x != nil {
+		return x.HashEqual
+	}
+	return nil
+}
+
+type PkgEqualIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg      *PkgInputSpec      `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	EqualPkg *PkgInputSpec      `protobuf:"bytes,2,opt,name=equal_pkg,json=equalPkg,proto3" json:"equal_pkg,omitempty"`
+	PkgEqual *PkgEqualInputSpec `protobuf:"bytes,3,opt,name=pkg_equal,json=pkgEqual,proto3" json:"pkg_equal,omitempty"`
+}
+
+func (x *PkgEqualIngest) Reset() {
+	*x = PkgEqualIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PkgEqualIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PkgEqualIngest) ProtoMessage() {}
+
+func (x *PkgEqualIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PkgEqualIngest.ProtoReflect.Descriptor instead.
+func (*PkgEqualIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PkgEqualIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *PkgEqualIngest) GetEqualPkg() *PkgInputSpec {
+	if x != nil {
+		return x.EqualPkg
+	}
+	return nil
+}
+
+func (x *PkgEqualIngest) GetPkgEqual() *PkgEqualInputSpec {
+	if x != nil {
+		return x.PkgEqual
+	}
+	return nil
+}
+
+type VexIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg           *PkgInputSpec           `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Artifact      *ArtifactInputSpec      `protobuf:"bytes,2,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Vulnerability *VulnerabilityInputSpec `protobuf:"bytes,3,opt,name=vulnerability,proto3" json:"vulnerability,omitempty"`
+	VexData       *VexStatementInputSpec  `protobuf:"bytes,4,opt,name=vex_data,json=vexData,proto3" json:"vex_data,omitempty"`
+}
+
+func (x *VexIngest) Reset() {
+	*x = VexIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VexIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VexIngest) ProtoMessage() {}
+
+func (x *VexIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VexIngest.ProtoReflect.Descriptor instead.
+func (*VexIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VexIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *VexIngest) GetArtifact() *ArtifactInputSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *VexIngest) GetVulnerability() *VulnerabilityInputSpec {
+	if x != nil {
+		return x.Vulnerability
+	}
+	return nil
+}
+
+func (x *VexIngest) GetVexData() *VexStatementInputSpec {
+	if x != nil {
+		return x.VexData
+	}
+	return nil
+}
+
+type PointOfContactIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg            *PkgInputSpec            `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	PkgMatchFlag   string                   `protobuf:"bytes,2,opt,name=pkg_match_flag,json=pkgMatchFlag,proto3" json:"pkg_match_flag,omitempty"`
+	Src            *SourceInputSpec         `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Artifact       *ArtifactInputSpec       `protobuf:"bytes,4,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	PointOfContact *PointOfContactInputSpec `protobuf:"bytes,5,opt,name=point_of_contact,json=pointOfContact,proto3" json:"point_of_contact,omitempty"`
+}
+
+func (x *PointOfContactIngest) Reset() {
+	*x = PointOfContactIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointOfContactIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointOfContactIngest) ProtoMessage() {}
+
+func (x *PointOfContactIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointOfContactIngest.ProtoReflect.Descriptor instead.
+func (*PointOfContactIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PointOfContactIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *PointOfContactIngest) GetPkgMatchFlag() string {
+	if x != nil {
+		return x.PkgMatchFlag
+	}
+	return ""
+}
+
+func (x *PointOfContactIngest) GetSrc() *SourceInputSpec {
+	if x != nil {
+		return x.Src
+	}
+	return nil
+}
+
+func (x *PointOfContactIngest) GetArtifact() *ArtifactInputSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *PointOfContactIngest) GetPointOfContact() *PointOfContactInputSpec {
+	if x != nil {
+		return x.PointOfContact
+	}
+	return nil
+}
+
+type VulnMetadataIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vulnerability *VulnerabilityInputSpec         `protobuf:"bytes,1,opt,name=vulnerability,proto3" json:"vulnerability,omitempty"`
+	VulnMetadata  *VulnerabilityMetadataInputSpec `protobuf:"bytes,2,opt,name=vuln_metadata,json=vulnMetadata,proto3" json:"vuln_metadata,omitempty"`
+}
+
+func (x *VulnMetadataIngest) Reset() {
+	*x = VulnMetadataIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnMetadataIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnMetadataIngest) ProtoMessage() {}
+
+func (x *VulnMetadataIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnMetadataIngest.ProtoReflect.Descriptor instead.
+func (*VulnMetadataIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *VulnMetadataIngest) GetVulnerability() *VulnerabilityInputSpec {
+	if x != nil {
+		return x.Vulnerability
+	}
+	return nil
+}
+
+func (x *VulnMetadataIngest) GetVulnMetadata() *VulnerabilityMetadataInputSpec {
+	if x != nil {
+		return x.VulnMetadata
+	}
+	return nil
+}
+
+type HasMetadataIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg          *PkgInputSpec         `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	PkgMatchFlag string                `protobuf:"bytes,2,opt,name=pkg_match_flag,json=pkgMatchFlag,proto3" json:"pkg_match_flag,omitempty"`
+	Src          *SourceInputSpec      `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Artifact     *ArtifactInputSpec    `protobuf:"bytes,4,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	HasMetadata  *HasMetadataInputSpec `protobuf:"bytes,5,opt,name=has_metadata,json=hasMetadata,proto3" json:"has_metadata,omitempty"`
+}
+
+func (x *HasMetadataIngest) Reset() {
+	*x = HasMetadataIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasMetadataIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasMetadataIngest) ProtoMessage() {}
+
+func (x *HasMetadataIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasMetadataIngest.ProtoReflect.Descriptor instead.
+func (*HasMetadataIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *HasMetadataIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *HasMetadataIngest) GetPkgMatchFlag() string {
+	if x != nil {
+		return x.PkgMatchFlag
+	}
+	return ""
+}
+
+func (x *HasMetadataIngest) GetSrc() *SourceInputSpec {
+	if x != nil {
+		return x.Src
+	}
+	return nil
+}
+
+func (x *HasMetadataIngest) GetArtifact() *ArtifactInputSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *HasMetadataIngest) GetHasMetadata() *HasMetadataInputSpec {
+	if x != nil {
+		return x.HasMetadata
+	}
+	return nil
+}
+
+type CertifyLegalIngest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg          *PkgInputSpec          `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Src          *SourceInputSpec       `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	Declared     []*LicenseInputSpec    `protobuf:"bytes,3,rep,name=declared,proto3" json:"declared,omitempty"`
+	Discovered   []*LicenseInputSpec    `protobuf:"bytes,4,rep,name=discovered,proto3" json:"discovered,omitempty"`
+	CertifyLegal *CertifyLegalInputSpec `protobuf:"bytes,5,opt,name=certify_legal,json=certifyLegal,proto3" json:"certify_legal,omitempty"`
+}
+
+func (x *CertifyLegalIngest) Reset() {
+	*x = CertifyLegalIngest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyLegalIngest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyLegalIngest) ProtoMessage() {}
+
+func (x *CertifyLegalIngest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyLegalIngest.ProtoReflect.Descriptor instead.
+func (*CertifyLegalIngest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CertifyLegalIngest) GetPkg() *PkgInputSpec {
+	if x != nil {
+		return x.Pkg
+	}
+	return nil
+}
+
+func (x *CertifyLegalIngest) GetSrc() *SourceInputSpec {
+	if x != nil {
+		return x.Src
+	}
+	return nil
+}
+
+func (x *CertifyLegalIngest) GetDeclared() []*LicenseInputSpec {
+	if x != nil {
+		return x.Declared
+	}
+	return nil
+}
+
+func (x *CertifyLegalIngest) GetDiscovered() []*LicenseInputSpec {
+	if x != nil {
+		return x.Discovered
+	}
+	return nil
+}
+
+func (x *CertifyLegalIngest) GetCertifyLegal() *CertifyLegalInputSpec {
+	if x != nil {
+		return x.CertifyLegal
+	}
+	return nil
+}
+
+type IngestPredicates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CertifyScorecard []*CertifyScorecardIngest `protobuf:"bytes,1,rep,name=certify_scorecard,json=certifyScorecard,proto3" json:"certify_scorecard,omitempty"`
+	IsDependency     []*IsDependencyIngest     `protobuf:"bytes,2,rep,name=is_dependency,json=isDependency,proto3" json:"is_dependency,omitempty"`
+	IsOccurrence     []*IsOccurrenceIngest     `protobuf:"bytes,3,rep,name=is_occurrence,json=isOccurrence,proto3" json:"is_occurrence,omitempty"`
+	HasSlsa          []*HasSlsaIngest          `protobuf:"bytes,4,rep,name=has_slsa,json=hasSlsa,proto3" json:"has_slsa,omitempty"`
+	CertifyVuln      []*CertifyVulnIngest      `protobuf:"bytes,5,rep,name=certify_vuln,json=certifyVuln,proto3" json:"certify_vuln,omitempty"`
+	VulnEqual        []*VulnEqualIngest        `protobuf:"bytes,6,rep,name=vuln_equal,json=vulnEqual,proto3" json:"vuln_equal,omitempty"`
+	HasSourceAt      []*HasSourceAtIngest      `protobuf:"bytes,7,rep,name=has_source_at,json=hasSourceAt,proto3" json:"has_source_at,omitempty"`
+	CertifyBad       []*CertifyBadIngest       `protobuf:"bytes,8,rep,name=certify_bad,json=certifyBad,proto3" json:"certify_bad,omitempty"`
+	CertifyGood      []*CertifyGoodIngest      `protobuf:"bytes,9,rep,name=certify_good,json=certifyGood,proto3" json:"certify_good,omitempty"`
+	HasSbom          []*HasSBOMIngest          `protobuf:"bytes,10,rep,name=has_sbom,json=hasSbom,proto3" json:"has_sbom,omitempty"`
+	HashEqual        []*HashEqualIngest        `protobuf:"bytes,11,rep,name=hash_equal,json=hashEqual,proto3" json:"hash_equal,omitempty"`
+	PkgEqual         []*PkgEqualIngest         `protobuf:"bytes,12,rep,name=pkg_equal,json=pkgEqual,proto3" json:"pkg_equal,omitempty"`
+	Vex              []*VexIngest              `protobuf:"bytes,13,rep,name=vex,proto3" json:"vex,omitempty"`
+	PointOfContact   []*PointOfContactIngest   `protobuf:"bytes,14,rep,name=point_of_contact,json=pointOfContact,proto3" json:"point_of_contact,omitempty"`
+	VulnMetadata     []*VulnMetadataIngest     `protobuf:"bytes,15,rep,name=vuln_metadata,json=vulnMetadata,proto3" json:"vuln_metadata,omitempty"`
+	HasMetadata      []*HasMetadataIngest      `protobuf:"bytes,16,rep,name=has_metadata,json=hasMetadata,proto3" json:"has_metadata,omitempty"`
+	CertifyLegal     []*CertifyLegalIngest     `protobuf:"bytes,17,rep,name=certify_legal,json=certifyLegal,proto3" json:"certify_legal,omitempty"`
+	Documents        []*DocumentInputSpec      `protobuf:"bytes,18,rep,name=documents,proto3" json:"documents,omitempty"`
+}
+
+func (x *IngestPredicates) Reset() {
+	*x = IngestPredicates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IngestPredicates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestPredicates) ProtoMessage() {}
+
+func (x *IngestPredicates) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestPredicates.ProtoReflect.Descriptor instead.
+func (*IngestPredicates) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *IngestPredicates) GetCertifyScorecard() []*CertifyScorecardIngest {
+	if x != nil {
+		return x.CertifyScorecard
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetIsDependency() []*IsDependencyIngest {
+	if x != nil {
+		return x.IsDependency
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetIsOccurrence() []*IsOccurrenceIngest {
+	if x != nil {
+		return x.IsOccurrence
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetHasSlsa() []*HasSlsaIngest {
+	if x != nil {
+		return x.HasSlsa
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetCertifyVuln() []*CertifyVulnIngest {
+	if x != nil {
+		return x.CertifyVuln
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetVulnEqual() []*VulnEqualIngest {
+	if x != nil {
+		return x.VulnEqual
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetHasSourceAt() []*HasSourceAtIngest {
+	if x != nil {
+		return x.HasSourceAt
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetCertifyBad() []*CertifyBadIngest {
+	if x != nil {
+		return x.CertifyBad
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetCertifyGood() []*CertifyGoodIngest {
+	if x != nil {
+		return x.CertifyGood
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetHasSbom() []*HasSBOMIngest {
+	if x != nil {
+		return x.HasSbom
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetHashEqual() []*HashEqualIngest {
+	if x != nil {
+		return x.HashEqual
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetPkgEqual() []*PkgEqualIngest {
+	if x != nil {
+		return x.PkgEqual
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetVex() []*VexIngest {
+	if x != nil {
+		return x.Vex
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetPointOfContact() []*PointOfContactIngest {
+	if x != nil {
+		return x.PointOfContact
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetVulnMetadata() []*VulnMetadataIngest {
+	if x != nil {
+		return x.VulnMetadata
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetHasMetadata() []*HasMetadataIngest {
+	if x != nil {
+		return x.HasMetadata
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetCertifyLegal() []*CertifyLegalIngest {
+	if x != nil {
+		return x.CertifyLegal
+	}
+	return nil
+}
+
+func (x *IngestPredicates) GetDocuments() []*DocumentInputSpec {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+// rpc IngestPredicates
+type IngestPredicatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Predicates *IngestPredicates `protobuf:"bytes,1,opt,name=predicates,proto3" json:"predicates,omitempty"`
+}
+
+func (x *IngestPredicatesRequest) Reset() {
+	*x = IngestPredicatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IngestPredicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestPredicatesRequest) ProtoMessage() {}
+
+func (x *IngestPredicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestPredicatesRequest.ProtoReflect.Descriptor instead.
+func (*IngestPredicatesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *IngestPredicatesRequest) GetPredicates() *IngestPredicates {
+	if x != nil {
+		return x.Predicates
+	}
+	return nil
+}
+
+type IngestPredicatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of predicate batches that were ingested
+	Batches    int64    `protobuf:"varint,1,opt,name=batches,proto3" json:"batches,omitempty"`
+	HasSbomIds []string `protobuf:"bytes,2,rep,name=has_sbom_ids,json=hasSbomIds,proto3" json:"has_sbom_ids,omitempty"`
+	HasSlsaIds []string `protobuf:"bytes,3,rep,name=has_slsa_ids,json=hasSlsaIds,proto3" json:"has_slsa_ids,omitempty"`
+	// IDs of the nodes that a HasSBOM of the batches would include, to
+	// include them in a HasSBOM ingested later
+	PackageIds      []string `protobuf:"bytes,4,rep,name=package_ids,json=packageIds,proto3" json:"package_ids,omitempty"`
+	ArtifactIds     []string `protobuf:"bytes,5,rep,name=artifact_ids,json=artifactIds,proto3" json:"artifact_ids,omitempty"`
+	IsDependencyIds []string `protobuf:"bytes,6,rep,name=is_dependency_ids,json=isDependencyIds,proto3" json:"is_dependency_ids,omitempty"`
+	IsOccurrenceIds []string `protobuf:"bytes,7,rep,name=is_occurrence_ids,json=isOccurrenceIds,proto3" json:"is_occurrence_ids,omitempty"`
+}
+
+func (x *IngestPredicatesResponse) Reset() {
+	*x = IngestPredicatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IngestPredicatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestPredicatesResponse) ProtoMessage() {}
+
+func (x *IngestPredicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestPredicatesResponse.ProtoReflect.Descriptor instead.
+func (*IngestPredicatesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *IngestPredicatesResponse) GetBatches() int64 {
+	if x != nil {
+		return x.Batches
+	}
+	return 0
+}
+
+func (x *IngestPredicatesResponse) GetHasSbomIds() []string {
+	if x != nil {
+		return x.HasSbomIds
+	}
+	return nil
+}
+
+func (x *IngestPredicatesResponse) GetHasSlsaIds() []string {
+	if x != nil {
+		return x.HasSlsaIds
+	}
+	return nil
+}
+
+func (x *IngestPredicatesResponse) GetPackageIds() []string {
+	if x != nil {
+		return x.PackageIds
+	}
+	return nil
+}
+
+func (x *IngestPredicatesResponse) GetArtifactIds() []string {
+	if x != nil {
+		return x.ArtifactIds
+	}
+	return nil
+}
+
+func (x *IngestPredicatesResponse) GetIsDependencyIds() []string {
+	if x != nil {
+		return x.IsDependencyIds
+	}
+	return nil
+}
+
+func (x *IngestPredicatesResponse) GetIsOccurrenceIds() []string {
+	if x != nil {
+		return x.IsOccurrenceIds
+	}
+	return nil
+}
+
+type PackageQualifierSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value *string `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+}
+
+func (x *PackageQualifierSpec) Reset() {
+	*x = PackageQualifierSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageQualifierSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageQualifierSpec) ProtoMessage() {}
+
+func (x *PackageQualifierSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageQualifierSpec.ProtoReflect.Descriptor instead.
+func (*PackageQualifierSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PackageQualifierSpec) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PackageQualifierSpec) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+type PkgSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                       *string                 `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Type                     *string                 `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Namespace                *string                 `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Name                     *string                 `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Version                  *string                 `protobuf:"bytes,5,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	Qualifiers               []*PackageQualifierSpec `protobuf:"bytes,6,rep,name=qualifiers,proto3" json:"qualifiers,omitempty"`
+	MatchOnlyEmptyQualifiers *bool                   `protobuf:"varint,7,opt,name=match_only_empty_qualifiers,json=matchOnlyEmptyQualifiers,proto3,oneof" json:"match_only_empty_qualifiers,omitempty"`
+	Subpath                  *string                 `protobuf:"bytes,8,opt,name=subpath,proto3,oneof" json:"subpath,omitempty"`
+}
+
+func (x *PkgSpec) Reset() {
+	*x = PkgSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PkgSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PkgSpec) ProtoMessage() {}
+
+func (x *PkgSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PkgSpec.ProtoReflect.Descriptor instead.
+func (*PkgSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PkgSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *PkgSpec) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *PkgSpec) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *PkgSpec) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PkgSpec) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return ""
+}
+
+func (x *PkgSpec) GetQualifiers() []*PackageQualifierSpec {
+	if x != nil {
+		return x.Qualifiers
+	}
+	return nil
+}
+
+func (x *PkgSpec) GetMatchOnlyEmptyQualifiers() bool {
+	if x != nil && x.MatchOnlyEmptyQualifiers != nil {
+		return *x.MatchOnlyEmptyQualifiers
+	}
+	return false
+}
+
+func (x *PkgSpec) GetSubpath() string {
+	if x != nil && x.Subpath != nil {
+		return *x.Subpath
+	}
+	return ""
+}
+
+type SourceSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Type      *string `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Name      *string `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Tag       *string `protobuf:"bytes,5,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	Commit    *string `protobuf:"bytes,6,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+}
+
+func (x *SourceSpec) Reset() {
+	*x = SourceSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceSpec) ProtoMessage() {}
+
+func (x *SourceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceSpec.ProtoReflect.Descriptor instead.
+func (*SourceSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SourceSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *SourceSpec) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *SourceSpec) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *SourceSpec) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SourceSpec) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+func (x *SourceSpec) GetCommit() string {
+	if x != nil && x.Commit != nil {
+		return *x.Commit
+	}
+	return ""
+}
+
+type ArtifactSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Algorithm *string `protobuf:"bytes,2,opt,name=algorithm,proto3,oneof" json:"algorithm,omitempty"`
+	Digest    *string `protobuf:"bytes,3,opt,name=digest,proto3,oneof" json:"digest,omitempty"`
+}
+
+func (x *ArtifactSpec) Reset() {
+	*x = ArtifactSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactSpec) ProtoMessage() {}
+
+func (x *ArtifactSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactSpec.ProtoReflect.Descriptor instead.
+func (*ArtifactSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ArtifactSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *ArtifactSpec) GetAlgorithm() string {
+	if x != nil && x.Algorithm != nil {
+		return *x.Algorithm
+	}
+	return ""
+}
+
+func (x *ArtifactSpec) GetDigest() string {
+	if x != nil && x.Digest != nil {
+		return *x.Digest
+	}
+	return ""
+}
+
+type BuilderSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Uri *string `protobuf:"bytes,2,opt,name=uri,proto3,oneof" json:"uri,omitempty"`
+}
+
+func (x *BuilderSpec) Reset() {
+	*x = BuilderSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuilderSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuilderSpec) ProtoMessage() {}
+
+func (x *BuilderSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuilderSpec.ProtoReflect.Descriptor instead.
+func (*BuilderSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BuilderSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *BuilderSpec) GetUri() string {
+	if x != nil && x.Uri != nil {
+		return *x.Uri
+	}
+	return ""
+}
+
+type LicenseSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name        *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Inline      *string `protobuf:"bytes,3,opt,name=inline,proto3,oneof" json:"inline,omitempty"`
+	ListVersion *string `protobuf:"bytes,4,opt,name=list_version,json=listVersion,proto3,oneof" json:"list_version,omitempty"`
+}
+
+func (x *LicenseSpec) Reset() {
+	*x = LicenseSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LicenseSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LicenseSpec) ProtoMessage() {}
+
+func (x *LicenseSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LicenseSpec.ProtoReflect.Descriptor instead.
+func (*LicenseSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LicenseSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *LicenseSpec) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *LicenseSpec) GetInline() string {
+	if x != nil && x.Inline != nil {
+		return *x.Inline
+	}
+	return ""
+}
+
+func (x *LicenseSpec) GetListVersion() string {
+	if x != nil && x.ListVersion != nil {
+		return *x.ListVersion
+	}
+	return ""
+}
+
+type VulnerabilitySpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Type            *string `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	VulnerabilityId *string `protobuf:"bytes,3,opt,name=vulnerability_id,json=vulnerabilityId,proto3,oneof" json:"vulnerability_id,omitempty"`
+	NoVuln          *bool   `protobuf:"varint,4,opt,name=no_vuln,json=noVuln,proto3,oneof" json:"no_vuln,omitempty"`
+}
+
+func (x *VulnerabilitySpec) Reset() {
+	*x = VulnerabilitySpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilitySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilitySpec) ProtoMessage() {}
+
+func (x *VulnerabilitySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilitySpec.ProtoReflect.Descriptor instead.
+func (*VulnerabilitySpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *VulnerabilitySpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *VulnerabilitySpec) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *VulnerabilitySpec) GetVulnerabilityId() string {
+	if x != nil && x.VulnerabilityId != nil {
+		return *x.VulnerabilityId
+	}
+	return ""
+}
+
+func (x *VulnerabilitySpec) GetNoVuln() bool {
+	if x != nil && x.NoVuln != nil {
+		return *x.NoVuln
+	}
+	return false
+}
+
+type PackageOrSourceSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Package *PkgSpec    `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	Source  *SourceSpec `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *PackageOrSourceSpec) Reset() {
+	*x = PackageOrSourceSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageOrSourceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageOrSourceSpec) ProtoMessage() {}
+
+func (x *PackageOrSourceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageOrSourceSpec.ProtoReflect.Descriptor instead.
+func (*PackageOrSourceSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PackageOrSourceSpec) GetPackage() *PkgSpec {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *PackageOrSourceSpec) GetSource() *SourceSpec {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type PackageOrArtifactSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Package  *PkgSpec      `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	Artifact *ArtifactSpec `protobuf:"bytes,2,opt,name=artifact,proto3" json:"artifact,omitempty"`
+}
+
+func (x *PackageOrArtifactSpec) Reset() {
+	*x = PackageOrArtifactSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageOrArtifactSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageOrArtifactSpec) ProtoMessage() {}
+
+func (x *PackageOrArtifactSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageOrArtifactSpec.ProtoReflect.Descriptor instead.
+func (*PackageOrArtifactSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PackageOrArtifactSpec) GetPackage() *PkgSpec {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *PackageOrArtifactSpec) GetArtifact() *ArtifactSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+type IsDependencySpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                *string  `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Package           *PkgSpec `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	DependencyPackage *PkgSpec `protobuf:"bytes,3,opt,name=dependency_package,json=dependencyPackage,proto3" json:"dependency_package,omitempty"`
+	DependencyType    *string  `protobuf:"bytes,4,opt,name=dependency_type,json=dependencyType,proto3,oneof" json:"dependency_type,omitempty"`
+	Justification     *string  `protobuf:"bytes,5,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin            *string  `protobuf:"bytes,6,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector         *string  `protobuf:"bytes,7,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef       *string  `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *IsDependencySpec) Reset() {
+	*x = IsDependencySpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsDependencySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsDependencySpec) ProtoMessage() {}
+
+func (x *IsDependencySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsDependencySpec.ProtoReflect.Descriptor instead.
+func (*IsDependencySpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *IsDependencySpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *IsDependencySpec) GetPackage() *PkgSpec {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *IsDependencySpec) GetDependencyPackage() *PkgSpec {
+	if x != nil {
+		return x.DependencyPackage
+	}
+	return nil
+}
+
+func (x *IsDependencySpec) GetDependencyType() string {
+	if x != nil && x.DependencyType != nil {
+		return *x.DependencyType
+	}
+	return ""
+}
+
+func (x *IsDependencySpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *IsDependencySpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *IsDependencySpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *IsDependencySpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type IsOccurrenceSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            *string              `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Subject       *PackageOrSourceSpec `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Artifact      *ArtifactSpec        `protobuf:"bytes,3,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Justification *string              `protobuf:"bytes,4,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin        *string              `protobuf:"bytes,5,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector     *string              `protobuf:"bytes,6,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef   *string              `protobuf:"bytes,7,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *IsOccurrenceSpec) Reset() {
+	*x = IsOccurrenceSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsOccurrenceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsOccurrenceSpec) ProtoMessage() {}
+
+func (x *IsOccurrenceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsOccurrenceSpec.ProtoReflect.Descriptor instead.
+func (*IsOccurrenceSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *IsOccurrenceSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *IsOccurrenceSpec) GetSubject() *PackageOrSourceSpec {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *IsOccurrenceSpec) GetArtifact() *ArtifactSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *IsOccurrenceSpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *IsOccurrenceSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *IsOccurrenceSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *IsOccurrenceSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type HasSBOMSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Subject          *PackageOrArtifactSpec `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Uri              *string                `protobuf:"bytes,3,opt,name=uri,proto3,oneof" json:"uri,omitempty"`
+	Algorithm        *string                `protobuf:"bytes,4,opt,name=algorithm,proto3,oneof" json:"algorithm,omitempty"`
+	Digest           *string                `protobuf:"bytes,5,opt,name=digest,proto3,oneof" json:"digest,omitempty"`
+	DownloadLocation *string                `protobuf:"bytes,6,opt,name=download_location,json=downloadLocation,proto3,oneof" json:"download_location,omitempty"`
+	Origin           *string                `protobuf:"bytes,7,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector        *string                `protobuf:"bytes,8,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef      *string                `protobuf:"bytes,9,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *HasSBOMSpec) Reset() {
+	*x = HasSBOMSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSBOMSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSBOMSpec) ProtoMessage() {}
+
+func (x *HasSBOMSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSBOMSpec.ProtoReflect.Descriptor instead.
+func (*HasSBOMSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *HasSBOMSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *HasSBOMSpec) GetSubject() *PackageOrArtifactSpec {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *HasSBOMSpec) GetUri() string {
+	if x != nil && x.Uri != nil {
+		return *x.Uri
+	}
+	return ""
+}
+
+func (x *HasSBOMSpec) GetAlgorithm() string {
+	if x != nil && x.Algorithm != nil {
+		return *x.Algorithm
+	}
+	return ""
+}
+
+func (x *HasSBOMSpec) GetDigest() string {
+	if x != nil && x.Digest != nil {
+		return *x.Digest
+	}
+	return ""
+}
+
+func (x *HasSBOMSpec) GetDownloadLocation() string {
+	if x != nil && x.DownloadLocation != nil {
+		return *x.DownloadLocation
+	}
+	return ""
+}
+
+func (x *HasSBOMSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *HasSBOMSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *HasSBOMSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type CertifyVulnSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             *string            `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Package        *PkgSpec           `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	Vulnerability  *VulnerabilitySpec `protobuf:"bytes,3,opt,name=vulnerability,proto3" json:"vulnerability,omitempty"`
+	DbUri          *string            `protobuf:"bytes,4,opt,name=db_uri,json=dbUri,proto3,oneof" json:"db_uri,omitempty"`
+	DbVersion      *string            `protobuf:"bytes,5,opt,name=db_version,json=dbVersion,proto3,oneof" json:"db_version,omitempty"`
+	ScannerUri     *string            `protobuf:"bytes,6,opt,name=scanner_uri,json=scannerUri,proto3,oneof" json:"scanner_uri,omitempty"`
+	ScannerVersion *string            `protobuf:"bytes,7,opt,name=scanner_version,json=scannerVersion,proto3,oneof" json:"scanner_version,omitempty"`
+	Origin         *string            `protobuf:"bytes,8,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector      *string            `protobuf:"bytes,9,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef    *string            `protobuf:"bytes,10,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyVulnSpec) Reset() {
+	*x = CertifyVulnSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyVulnSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyVulnSpec) ProtoMessage() {}
+
+func (x *CertifyVulnSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyVulnSpec.ProtoReflect.Descriptor instead.
+func (*CertifyVulnSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CertifyVulnSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *CertifyVulnSpec) GetPackage() *PkgSpec {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *CertifyVulnSpec) GetVulnerability() *VulnerabilitySpec {
+	if x != nil {
+		return x.Vulnerability
+	}
+	return nil
+}
+
+func (x *CertifyVulnSpec) GetDbUri() string {
+	if x != nil && x.DbUri != nil {
+		return *x.DbUri
+	}
+	return ""
+}
+
+func (x *CertifyVulnSpec) GetDbVersion() string {
+	if x != nil && x.DbVersion != nil {
+		return *x.DbVersion
+	}
+	return ""
+}
+
+func (x *CertifyVulnSpec) GetScannerUri() string {
+	if x != nil && x.ScannerUri != nil {
+		return *x.ScannerUri
+	}
+	return ""
+}
+
+func (x *CertifyVulnSpec) GetScannerVersion() string {
+	if x != nil && x.ScannerVersion != nil {
+		return *x.ScannerVersion
+	}
+	return ""
+}
+
+func (x *CertifyVulnSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyVulnSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyVulnSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type CertifyVEXStatementSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Subject          *PackageOrArtifactSpec `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Vulnerability    *VulnerabilitySpec     `protobuf:"bytes,3,opt,name=vulnerability,proto3" json:"vulnerability,omitempty"`
+	Status           *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	VexJustification *string                `protobuf:"bytes,5,opt,name=vex_justification,json=vexJustification,proto3,oneof" json:"vex_justification,omitempty"`
+	Statement        *string                `protobuf:"bytes,6,opt,name=statement,proto3,oneof" json:"statement,omitempty"`
+	StatusNotes      *string                `protobuf:"bytes,7,opt,name=status_notes,json=statusNotes,proto3,oneof" json:"status_notes,omitempty"`
+	Origin           *string                `protobuf:"bytes,8,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector        *string                `protobuf:"bytes,9,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef      *string                `protobuf:"bytes,10,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyVEXStatementSpec) Reset() {
+	*x = CertifyVEXStatementSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyVEXStatementSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyVEXStatementSpec) ProtoMessage() {}
+
+func (x *CertifyVEXStatementSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyVEXStatementSpec.ProtoReflect.Descriptor instead.
+func (*CertifyVEXStatementSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CertifyVEXStatementSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatementSpec) GetSubject() *PackageOrArtifactSpec {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *CertifyVEXStatementSpec) GetVulnerability() *VulnerabilitySpec {
+	if x != nil {
+		return x.Vulnerability
+	}
+	return nil
+}
+
+func (x *CertifyVEXStatementSpec) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatementSpec) GetVexJustification() string {
+	if x != nil && x.VexJustification != nil {
+		return *x.VexJustification
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatementSpec) GetStatement() string {
+	if x != nil && x.Statement != nil {
+		return *x.Statement
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatementSpec) GetStatusNotes() string {
+	if x != nil && x.StatusNotes != nil {
+		return *x.StatusNotes
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatementSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatementSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatementSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type PackageSourceOrArtifactSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Package  *PkgSpec      `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	Source   *SourceSpec   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Artifact *ArtifactSpec `protobuf:"bytes,3,opt,name=artifact,proto3" json:"artifact,omitempty"`
+}
+
+func (x *PackageSourceOrArtifactSpec) Reset() {
+	*x = PackageSourceOrArtifactSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageSourceOrArtifactSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageSourceOrArtifactSpec) ProtoMessage() {}
+
+func (x *PackageSourceOrArtifactSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageSourceOrArtifactSpec.ProtoReflect.Descriptor instead.
+func (*PackageSourceOrArtifactSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PackageSourceOrArtifactSpec) GetPackage() *PkgSpec {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *PackageSourceOrArtifactSpec) GetSource() *SourceSpec {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *PackageSourceOrArtifactSpec) GetArtifact() *ArtifactSpec {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+type CertifyBadSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            *string                      `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Subject       *PackageSourceOrArtifactSpec `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Justification *string                      `protobuf:"bytes,3,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin        *string                      `protobuf:"bytes,4,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector     *string                      `protobuf:"bytes,5,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef   *string                      `protobuf:"bytes,6,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyBadSpec) Reset() {
+	*x = CertifyBadSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyBadSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyBadSpec) ProtoMessage() {}
+
+func (x *CertifyBadSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyBadSpec.ProtoReflect.Descriptor instead.
+func (*CertifyBadSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CertifyBadSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *CertifyBadSpec) GetSubject() *PackageSourceOrArtifactSpec {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *CertifyBadSpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *CertifyBadSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyBadSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyBadSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type CertifyGoodSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            *string                      `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Subject       *PackageSourceOrArtifactSpec `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Justification *string                      `protobuf:"bytes,3,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin        *string                      `protobuf:"bytes,4,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector     *string                      `protobuf:"bytes,5,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef   *string                      `protobuf:"bytes,6,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyGoodSpec) Reset() {
+	*x = CertifyGoodSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyGoodSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyGoodSpec) ProtoMessage() {}
+
+func (x *CertifyGoodSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyGoodSpec.ProtoReflect.Descriptor instead.
+func (*CertifyGoodSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CertifyGoodSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *CertifyGoodSpec) GetSubject() *PackageSourceOrArtifactSpec {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *CertifyGoodSpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *CertifyGoodSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyGoodSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyGoodSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type SLSAPredicateSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SLSAPredicateSpec) Reset() {
+	*x = SLSAPredicateSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLSAPredicateSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLSAPredicateSpec) ProtoMessage() {}
+
+func (x *SLSAPredicateSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLSAPredicateSpec.ProtoReflect.Descriptor instead.
+func (*SLSAPredicateSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SLSAPredicateSpec) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SLSAPredicateSpec) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type HasSLSASpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          *string              `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Subject     *ArtifactSpec        `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	BuiltFrom   []*ArtifactSpec      `protobuf:"bytes,3,rep,name=built_from,json=builtFrom,proto3" json:"built_from,omitempty"`
+	BuiltBy     *BuilderSpec         `protobuf:"bytes,4,opt,name=built_by,json=builtBy,proto3" json:"built_by,omitempty"`
+	BuildType   *string              `protobuf:"bytes,5,opt,name=build_type,json=buildType,proto3,oneof" json:"build_type,omitempty"`
+	Predicate   []*SLSAPredicateSpec `protobuf:"bytes,6,rep,name=predicate,proto3" json:"predicate,omitempty"`
+	SlsaVersion *string              `protobuf:"bytes,7,opt,name=slsa_version,json=slsaVersion,proto3,oneof" json:"slsa_version,omitempty"`
+	Origin      *string              `protobuf:"bytes,8,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector   *string              `protobuf:"bytes,9,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef *string              `protobuf:"bytes,10,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *HasSLSASpec) Reset() {
+	*x = HasSLSASpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSLSASpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSLSASpec) ProtoMessage() {}
+
+func (x *HasSLSASpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSLSASpec.ProtoReflect.Descriptor instead.
+func (*HasSLSASpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *HasSLSASpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *HasSLSASpec) GetSubject() *ArtifactSpec {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *HasSLSASpec) GetBuiltFrom() []*ArtifactSpec {
+	if x != nil {
+		return x.BuiltFrom
+	}
+	return nil
+}
+
+func (x *HasSLSASpec) GetBuiltBy() *BuilderSpec {
+	if x != nil {
+		return x.BuiltBy
+	}
+	return nil
+}
+
+func (x *HasSLSASpec) GetBuildType() string {
+	if x != nil && x.BuildType != nil {
+		return *x.BuildType
+	}
+	return ""
+}
+
+func (x *HasSLSASpec) GetPredicate() []*SLSAPredicateSpec {
+	if x != nil {
+		return x.Predicate
+	}
+	return nil
+}
+
+func (x *HasSLSASpec) GetSlsaVersion() string {
+	if x != nil && x.SlsaVersion != nil {
+		return *x.SlsaVersion
+	}
+	return ""
+}
+
+func (x *HasSLSASpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *HasSLSASpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *HasSLSASpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type HasSourceAtSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            *string     `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Package       *PkgSpec    `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	Source        *SourceSpec `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Justification *string     `protobuf:"bytes,4,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin        *string     `protobuf:"bytes,5,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector     *string     `protobuf:"bytes,6,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef   *string     `protobuf:"bytes,7,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *HasSourceAtSpec) Reset() {
+	*x = HasSourceAtSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSourceAtSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSourceAtSpec) ProtoMessage() {}
+
+func (x *HasSourceAtSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSourceAtSpec.ProtoReflect.Descriptor instead.
+func (*HasSourceAtSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *HasSourceAtSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *HasSourceAtSpec) GetPackage() *PkgSpec {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *HasSourceAtSpec) GetSource() *SourceSpec {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *HasSourceAtSpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *HasSourceAtSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *HasSourceAtSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *HasSourceAtSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type ScorecardCheckSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Check string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	Score int32  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ScorecardCheckSpec) Reset() {
+	*x = ScorecardCheckSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScorecardCheckSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScorecardCheckSpec) ProtoMessage() {}
+
+func (x *ScorecardCheckSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScorecardCheckSpec.ProtoReflect.Descriptor instead.
+func (*ScorecardCheckSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ScorecardCheckSpec) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *ScorecardCheckSpec) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type CertifyScorecardSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               *string               `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Source           *SourceSpec           `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	AggregateScore   *float64              `protobuf:"fixed64,3,opt,name=aggregate_score,json=aggregateScore,proto3,oneof" json:"aggregate_score,omitempty"`
+	Checks           []*ScorecardCheckSpec `protobuf:"bytes,4,rep,name=checks,proto3" json:"checks,omitempty"`
+	ScorecardVersion *string               `protobuf:"bytes,5,opt,name=scorecard_version,json=scorecardVersion,proto3,oneof" json:"scorecard_version,omitempty"`
+	ScorecardCommit  *string               `protobuf:"bytes,6,opt,name=scorecard_commit,json=scorecardCommit,proto3,oneof" json:"scorecard_commit,omitempty"`
+	Origin           *string               `protobuf:"bytes,7,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector        *string               `protobuf:"bytes,8,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef      *string               `protobuf:"bytes,9,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyScorecardSpec) Reset() {
+	*x = CertifyScorecardSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyScorecardSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyScorecardSpec) ProtoMessage() {}
+
+func (x *CertifyScorecardSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyScorecardSpec.ProtoReflect.Descriptor instead.
+func (*CertifyScorecardSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CertifyScorecardSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *CertifyScorecardSpec) GetSource() *SourceSpec {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *CertifyScorecardSpec) GetAggregateScore() float64 {
+	if x != nil && x.AggregateScore != nil {
+		return *x.AggregateScore
+	}
+	return 0
+}
+
+func (x *CertifyScorecardSpec) GetChecks() []*ScorecardCheckSpec {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *CertifyScorecardSpec) GetScorecardVersion() string {
+	if x != nil && x.ScorecardVersion != nil {
+		return *x.ScorecardVersion
+	}
+	return ""
+}
+
+func (x *CertifyScorecardSpec) GetScorecardCommit() string {
+	if x != nil && x.ScorecardCommit != nil {
+		return *x.ScorecardCommit
+	}
+	return ""
+}
+
+func (x *CertifyScorecardSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyScorecardSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyScorecardSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type CertifyLegalSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 *string              `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Subject            *PackageOrSourceSpec `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	DeclaredLicense    *string              `protobuf:"bytes,3,opt,name=declared_license,json=declaredLicense,proto3,oneof" json:"declared_license,omitempty"`
+	DeclaredLicenses   []*LicenseSpec       `protobuf:"bytes,4,rep,name=declared_licenses,json=declaredLicenses,proto3" json:"declared_licenses,omitempty"`
+	DiscoveredLicense  *string              `protobuf:"bytes,5,opt,name=discovered_license,json=discoveredLicense,proto3,oneof" json:"discovered_license,omitempty"`
+	DiscoveredLicenses []*LicenseSpec       `protobuf:"bytes,6,rep,name=discovered_licenses,json=discoveredLicenses,proto3" json:"discovered_licenses,omitempty"`
+	Attribution        *string              `protobuf:"bytes,7,opt,name=attribution,proto3,oneof" json:"attribution,omitempty"`
+	Justification      *string              `protobuf:"bytes,8,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin             *string              `protobuf:"bytes,9,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector          *string              `protobuf:"bytes,10,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef        *string              `protobuf:"bytes,11,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyLegalSpec) Reset() {
+	*x = CertifyLegalSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyLegalSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyLegalSpec) ProtoMessage() {}
+
+func (x *CertifyLegalSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyLegalSpec.ProtoReflect.Descriptor instead.
+func (*CertifyLegalSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CertifyLegalSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *CertifyLegalSpec) GetSubject() *PackageOrSourceSpec {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *CertifyLegalSpec) GetDeclaredLicense() string {
+	if x != nil && x.DeclaredLicense != nil {
+		return *x.DeclaredLicense
+	}
+	return ""
+}
+
+func (x *CertifyLegalSpec) GetDeclaredLicenses() []*LicenseSpec {
+	if x != nil {
+		return x.DeclaredLicenses
+	}
+	return nil
+}
+
+func (x *CertifyLegalSpec) GetDiscoveredLicense() string {
+	if x != nil && x.DiscoveredLicense != nil {
+		return *x.DiscoveredLicense
+	}
+	return ""
+}
+
+func (x *CertifyLegalSpec) GetDiscoveredLicenses() []*LicenseSpec {
+	if x != nil {
+		return x.DiscoveredLicenses
+	}
+	return nil
+}
+
+func (x *CertifyLegalSpec) GetAttribution() string {
+	if x != nil && x.Attribution != nil {
+		return *x.Attribution
+	}
+	return ""
+}
+
+func (x *CertifyLegalSpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *CertifyLegalSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyLegalSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyLegalSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type PkgEqualSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            *string    `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Packages      []*PkgSpec `protobuf:"bytes,2,rep,name=packages,proto3" json:"packages,omitempty"`
+	Justification *string    `protobuf:"bytes,3,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin        *string    `protobuf:"bytes,4,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector     *string    `protobuf:"bytes,5,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef   *string    `protobuf:"bytes,6,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *PkgEqualSpec) Reset() {
+	*x = PkgEqualSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PkgEqualSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PkgEqualSpec) ProtoMessage() {}
+
+func (x *PkgEqualSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PkgEqualSpec.ProtoReflect.Descriptor instead.
+func (*PkgEqualSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PkgEqualSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *PkgEqualSpec) GetPackages() []*PkgSpec {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *PkgEqualSpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *PkgEqualSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *PkgEqualSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *PkgEqualSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type VulnEqualSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              *string              `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Vulnerabilities []*VulnerabilitySpec `protobuf:"bytes,2,rep,name=vulnerabilities,proto3" json:"vulnerabilities,omitempty"`
+	Justification   *string              `protobuf:"bytes,3,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin          *string              `protobuf:"bytes,4,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector       *string              `protobuf:"bytes,5,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef     *string              `protobuf:"bytes,6,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *VulnEqualSpec) Reset() {
+	*x = VulnEqualSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnEqualSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnEqualSpec) ProtoMessage() {}
+
+func (x *VulnEqualSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnEqualSpec.ProtoReflect.Descriptor instead.
+func (*VulnEqualSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *VulnEqualSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *VulnEqualSpec) GetVulnerabilities() []*VulnerabilitySpec {
+	if x != nil {
+		return x.Vulnerabilities
+	}
+	return nil
+}
+
+func (x *VulnEqualSpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *VulnEqualSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *VulnEqualSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *VulnEqualSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type HashEqualSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            *string         `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Artifacts     []*ArtifactSpec `protobuf:"bytes,2,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	Justification *string         `protobuf:"bytes,3,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin        *string         `protobuf:"bytes,4,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector     *string         `protobuf:"bytes,5,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef   *string         `protobuf:"bytes,6,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *HashEqualSpec) Reset() {
+	*x = HashEqualSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashEqualSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashEqualSpec) ProtoMessage() {}
+
+func (x *HashEqualSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashEqualSpec.ProtoReflect.Descriptor instead.
+func (*HashEqualSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *HashEqualSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *HashEqualSpec) GetArtifacts() []*ArtifactSpec {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *HashEqualSpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *HashEqualSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *HashEqualSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *HashEqualSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type HasMetadataSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            *string                      `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Subject       *PackageSourceOrArtifactSpec `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Key           *string                      `protobuf:"bytes,3,opt,name=key,proto3,oneof" json:"key,omitempty"`
+	Value         *string                      `protobuf:"bytes,4,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Justification *string                      `protobuf:"bytes,5,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin        *string                      `protobuf:"bytes,6,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector     *string                      `protobuf:"bytes,7,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef   *string                      `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *HasMetadataSpec) Reset() {
+	*x = HasMetadataSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasMetadataSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasMetadataSpec) ProtoMessage() {}
+
+func (x *HasMetadataSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasMetadataSpec.ProtoReflect.Descriptor instead.
+func (*HasMetadataSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *HasMetadataSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *HasMetadataSpec) GetSubject() *PackageSourceOrArtifactSpec {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *HasMetadataSpec) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *HasMetadataSpec) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *HasMetadataSpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *HasMetadataSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *HasMetadataSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *HasMetadataSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type PointOfContactSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            *string                      `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Subject       *PackageSourceOrArtifactSpec `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email         *string                      `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Info          *string                      `protobuf:"bytes,4,opt,name=info,proto3,oneof" json:"info,omitempty"`
+	Justification *string                      `protobuf:"bytes,5,opt,name=justification,proto3,oneof" json:"justification,omitempty"`
+	Origin        *string                      `protobuf:"bytes,6,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector     *string                      `protobuf:"bytes,7,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef   *string                      `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *PointOfContactSpec) Reset() {
+	*x = PointOfContactSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointOfContactSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointOfContactSpec) ProtoMessage() {}
+
+func (x *PointOfContactSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointOfContactSpec.ProtoReflect.Descriptor instead.
+func (*PointOfContactSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PointOfContactSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *PointOfContactSpec) GetSubject() *PackageSourceOrArtifactSpec {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *PointOfContactSpec) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *PointOfContactSpec) GetInfo() string {
+	if x != nil && x.Info != nil {
+		return *x.Info
+	}
+	return ""
+}
+
+func (x *PointOfContactSpec) GetJustification() string {
+	if x != nil && x.Justification != nil {
+		return *x.Justification
+	}
+	return ""
+}
+
+func (x *PointOfContactSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *PointOfContactSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *PointOfContactSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+// comparator is one of the GraphQL Comparator values (GREATER, EQUAL, ...)
+// and applies to score_value.
+type VulnerabilityMetadataSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            *string            `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Vulnerability *VulnerabilitySpec `protobuf:"bytes,2,opt,name=vulnerability,proto3" json:"vulnerability,omitempty"`
+	ScoreType     *string            `protobuf:"bytes,3,opt,name=score_type,json=scoreType,proto3,oneof" json:"score_type,omitempty"`
+	ScoreValue    *float64           `protobuf:"fixed64,4,opt,name=score_value,json=scoreValue,proto3,oneof" json:"score_value,omitempty"`
+	Comparator    *string            `protobuf:"bytes,5,opt,name=comparator,proto3,oneof" json:"comparator,omitempty"`
+	Origin        *string            `protobuf:"bytes,6,opt,name=origin,proto3,oneof" json:"origin,omitempty"`
+	Collector     *string            `protobuf:"bytes,7,opt,name=collector,proto3,oneof" json:"collector,omitempty"`
+	DocumentRef   *string            `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3,oneof" json:"document_ref,omitempty"`
+}
+
+func (x *VulnerabilityMetadataSpec) Reset() {
+	*x = VulnerabilityMetadataSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilityMetadataSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilityMetadataSpec) ProtoMessage() {}
+
+func (x *VulnerabilityMetadataSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilityMetadataSpec.ProtoReflect.Descriptor instead.
+func (*VulnerabilityMetadataSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *VulnerabilityMetadataSpec) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadataSpec) GetVulnerability() *VulnerabilitySpec {
+	if x != nil {
+		return x.Vulnerability
+	}
+	return nil
+}
+
+func (x *VulnerabilityMetadataSpec) GetScoreType() string {
+	if x != nil && x.ScoreType != nil {
+		return *x.ScoreType
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadataSpec) GetScoreValue() float64 {
+	if x != nil && x.ScoreValue != nil {
+		return *x.ScoreValue
+	}
+	return 0
+}
+
+func (x *VulnerabilityMetadataSpec) GetComparator() string {
+	if x != nil && x.Comparator != nil {
+		return *x.Comparator
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadataSpec) GetOrigin() string {
+	if x != nil && x.Origin != nil {
+		return *x.Origin
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadataSpec) GetCollector() string {
+	if x != nil && x.Collector != nil {
+		return *x.Collector
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadataSpec) GetDocumentRef() string {
+	if x != nil && x.DocumentRef != nil {
+		return *x.DocumentRef
+	}
+	return ""
+}
+
+type PackageQualifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *PackageQualifier) Reset() {
+	*x = PackageQualifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageQualifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageQualifier) ProtoMessage() {}
+
+func (x *PackageQualifier) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageQualifier.ProtoReflect.Descriptor instead.
+func (*PackageQualifier) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PackageQualifier) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PackageQualifier) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type PackageVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Purl       string              `protobuf:"bytes,2,opt,name=purl,proto3" json:"purl,omitempty"`
+	Version    string              `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Qualifiers []*PackageQualifier `protobuf:"bytes,4,rep,name=qualifiers,proto3" json:"qualifiers,omitempty"`
+	Subpath    string              `protobuf:"bytes,5,opt,name=subpath,proto3" json:"subpath,omitempty"`
+}
+
+func (x *PackageVersion) Reset() {
+	*x = PackageVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageVersion) ProtoMessage() {}
+
+func (x *PackageVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageVersion.ProtoReflect.Descriptor instead.
+func (*PackageVersion) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PackageVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PackageVersion) GetPurl() string {
+	if x != nil {
+		return x.Purl
+	}
+	return ""
+}
+
+func (x *PackageVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PackageVersion) GetQualifiers() []*PackageQualifier {
+	if x != nil {
+		return x.Qualifiers
+	}
+	return nil
+}
+
+func (x *PackageVersion) GetSubpath() string {
+	if x != nil {
+		return x.Subpath
+	}
+	return ""
+}
+
+type PackageName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Versions []*PackageVersion `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *PackageName) Reset() {
+	*x = PackageName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageName) ProtoMessage() {}
+
+func (x *PackageName) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageName.ProtoReflect.Descriptor instead.
+func (*PackageName) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PackageName) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PackageName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackageName) GetVersions() []*PackageVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type PackageNamespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace string         `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Names     []*PackageName `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *PackageNamespace) Reset() {
+	*x = PackageNamespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageNamespace) ProtoMessage() {}
+
+func (x *PackageNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageNamespace.ProtoReflect.Descriptor instead.
+func (*PackageNamespace) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PackageNamespace) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PackageNamespace) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PackageNamespace) GetNames() []*PackageName {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type Package struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       string              `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Namespaces []*PackageNamespace `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (x *Package) Reset() {
+	*x = Package{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Package) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Package) ProtoMessage() {}
+
+func (x *Package) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Package.ProtoReflect.Descriptor instead.
+func (*Package) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Package) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Package) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Package) GetNamespaces() []*PackageNamespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type SourceName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tag    *string `protobuf:"bytes,3,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	Commit *string `protobuf:"bytes,4,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+}
+
+func (x *SourceName) Reset() {
+	*x = SourceName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceName) ProtoMessage() {}
+
+func (x *SourceName) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceName.ProtoReflect.Descriptor instead.
+func (*SourceName) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SourceName) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SourceName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SourceName) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+func (x *SourceName) GetCommit() string {
+	if x != nil && x.Commit != nil {
+		return *x.Commit
+	}
+	return ""
+}
+
+type SourceNamespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace string        `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Names     []*SourceName `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *SourceNamespace) Reset() {
+	*x = SourceNamespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceNamespace) ProtoMessage() {}
+
+func (x *SourceNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceNamespace.ProtoReflect.Descriptor instead.
+func (*SourceNamespace) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SourceNamespace) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SourceNamespace) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SourceNamespace) GetNames() []*SourceName {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type Source struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       string             `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Namespaces []*SourceNamespace `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (x *Source) Reset() {
+	*x = Source{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Source) ProtoMessage() {}
+
+func (x *Source) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Source.ProtoReflect.Descriptor instead.
+func (*Source) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Source) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Source) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Source) GetNamespaces() []*SourceNamespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type Artifact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Digest    string `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *Artifact) Reset() {
+	*x = Artifact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Artifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifact) ProtoMessage() {}
+
+func (x *Artifact) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Artifact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Artifact) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Artifact) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type Builder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uri string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *Builder) Reset() {
+	*x = Builder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Builder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Builder) ProtoMessage() {}
+
+func (x *Builder) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Builder.ProtoReflect.Descriptor instead.
+func (*Builder) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *Builder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Builder) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type License struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Inline      *string `protobuf:"bytes,3,opt,name=inline,proto3,oneof" json:"inline,omitempty"`
+	ListVersion *string `protobuf:"bytes,4,opt,name=list_version,json=listVersion,proto3,oneof" json:"list_version,omitempty"`
+}
+
+func (x *License) Reset() {
+	*x = License{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *License) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*License) ProtoMessage() {}
+
+func (x *License) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use License.ProtoReflect.Descriptor instead.
+func (*License) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *License) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *License) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *License) GetInline() string {
+	if x != nil && x.Inline != nil {
+		return *x.Inline
+	}
+	return ""
+}
+
+func (x *License) GetListVersion() string {
+	if x != nil && x.ListVersion != nil {
+		return *x.ListVersion
+	}
+	return ""
+}
+
+type VulnerabilityID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VulnerabilityId string `protobuf:"bytes,2,opt,name=vulnerability_id,json=vulnerabilityId,proto3" json:"vulnerability_id,omitempty"`
+}
+
+func (x *VulnerabilityID) Reset() {
+	*x = VulnerabilityID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilityID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilityID) ProtoMessage() {}
+
+func (x *VulnerabilityID) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilityID.ProtoReflect.Descriptor instead.
+func (*VulnerabilityID) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *VulnerabilityID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VulnerabilityID) GetVulnerabilityId() string {
+	if x != nil {
+		return x.VulnerabilityId
+	}
+	return ""
+}
+
+type Vulnerability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type             string             `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	VulnerabilityIds []*VulnerabilityID `protobuf:"bytes,3,rep,name=vulnerability_ids,json=vulnerabilityIds,proto3" json:"vulnerability_ids,omitempty"`
+}
+
+func (x *Vulnerability) Reset() {
+	*x = Vulnerability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vulnerability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vulnerability) ProtoMessage() {}
+
+func (x *Vulnerability) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vulnerability.ProtoReflect.Descriptor instead.
+func (*Vulnerability) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Vulnerability) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Vulnerability) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Vulnerability) GetVulnerabilityIds() []*VulnerabilityID {
+	if x != nil {
+		return x.VulnerabilityIds
+	}
+	return nil
+}
+
+type PackageOrSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Subject:
+	//	*PackageOrSource_Package
+	//	*PackageOrSource_Source
+	Subject isPackageOrSource_Subject `protobuf_oneof:"subject"`
+}
+
+func (x *PackageOrSource) Reset() {
+	*x = PackageOrSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageOrSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageOrSource) ProtoMessage() {}
+
+func (x *PackageOrSource) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageOrSource.ProtoReflect.Descriptor instead.
+func (*PackageOrSource) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{90}
+}
+
+func (m *PackageOrSource) GetSubject() isPackageOrSource_Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (x *PackageOrSource) GetPackage() *Package {
+	if x, ok := x.GetSubject().(*PackageOrSource_Package); ok {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *PackageOrSource) GetSource() *Source {
+	if x, ok := x.GetSubject().(*PackageOrSource_Source); ok {
+		return x.Source
+	}
+	return nil
+}
+
+type isPackageOrSource_Subject interface {
+	isPackageOrSource_Subject()
+}
+
+type PackageOrSource_Package struct {
+	Package *Package `protobuf:"bytes,1,opt,name=package,proto3,oneof"`
+}
+
+type PackageOrSource_Source struct {
+	Source *Source `protobuf:"bytes,2,opt,name=source,proto3,oneof"`
+}
+
+func (*PackageOrSource_Package) isPackageOrSource_Subject() {}
+
+func (*PackageOrSource_Source) isPackageOrSource_Subject() {}
+
+type PackageOrArtifact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Subject:
+	//	*PackageOrArtifact_Package
+	//	*PackageOrArtifact_Artifact
+	Subject isPackageOrArtifact_Subject `protobuf_oneof:"subject"`
+}
+
+func (x *PackageOrArtifact) Reset() {
+	*x = PackageOrArtifact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageOrArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageOrArtifact) ProtoMessage() {}
+
+func (x *PackageOrArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageOrArtifact.ProtoReflect.Descriptor instead.
+func (*PackageOrArtifact) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{91}
+}
+
+func (m *PackageOrArtifact) GetSubject() isPackageOrArtifact_Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (x *PackageOrArtifact) GetPackage() *Package {
+	if x, ok := x.GetSubject().(*PackageOrArtifact_Package); ok {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *PackageOrArtifact) GetArtifact() *Artifact {
+	if x, ok := x.GetSubject().(*PackageOrArtifact_Artifact); ok {
+		return x.Artifact
+	}
+	return nil
+}
+
+type isPackageOrArtifact_Subject interface {
+	isPackageOrArtifact_Subject()
+}
+
+type PackageOrArtifact_Package struct {
+	Package *Package `protobuf:"bytes,1,opt,name=package,proto3,oneof"`
+}
+
+type PackageOrArtifact_Artifact struct {
+	Artifact *Artifact `protobuf:"bytes,2,opt,name=artifact,proto3,oneof"`
+}
+
+func (*PackageOrArtifact_Package) isPackageOrArtifact_Subject() {}
+
+func (*PackageOrArtifact_Artifact) isPackageOrArtifact_Subject() {}
+
+type IsDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Package           *Package `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	DependencyPackage *Package `protobuf:"bytes,3,opt,name=dependency_package,json=dependencyPackage,proto3" json:"dependency_package,omitempty"`
+	DependencyType    string   `protobuf:"bytes,4,opt,name=dependency_type,json=dependencyType,proto3" json:"dependency_type,omitempty"`
+	Justification     string   `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin            string   `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector         string   `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef       string   `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *IsDependency) Reset() {
+	*x = IsDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsDependency) ProtoMessage() {}
+
+func (x *IsDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsDependency.ProtoReflect.Descriptor instead.
+func (*IsDependency) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *IsDependency) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IsDependency) GetPackage() *Package {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *IsDependency) GetDependencyPackage() *Package {
+	if x != nil {
+		return x.DependencyPackage
+	}
+	return nil
+}
+
+func (x *IsDependency) GetDependencyType() string {
+	if x != nil {
+		return x.DependencyType
+	}
+	return ""
+}
+
+func (x *IsDependency) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *IsDependency) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *IsDependency) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *IsDependency) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type IsOccurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject       *PackageOrSource `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Artifact      *Artifact        `protobuf:"bytes,3,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Justification string           `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string           `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string           `protobuf:"bytes,6,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string           `protobuf:"bytes,7,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *IsOccurrence) Reset() {
+	*x = IsOccurrence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsOccurrence) ProtoMessage() {}
+
+func (x *IsOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsOccurrence.ProtoReflect.Descriptor instead.
+func (*IsOccurrence) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *IsOccurrence) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IsOccurrence) GetSubject() *PackageOrSource {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *IsOccurrence) GetArtifact() *Artifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *IsOccurrence) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *IsOccurrence) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *IsOccurrence) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *IsOccurrence) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type HasSBOM struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject          *PackageOrArtifact     `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Uri              string                 `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	Algorithm        string                 `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Digest           string                 `protobuf:"bytes,5,opt,name=digest,proto3" json:"digest,omitempty"`
+	DownloadLocation string                 `protobuf:"bytes,6,opt,name=download_location,json=downloadLocation,proto3" json:"download_location,omitempty"`
+	KnownSince       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=known_since,json=knownSince,proto3" json:"known_since,omitempty"`
+	Origin           string                 `protobuf:"bytes,8,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector        string                 `protobuf:"bytes,9,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef      string                 `protobuf:"bytes,10,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+	// IDs of the included software, dependencies and occurrences. The nodes
+	// themselves can be retrieved with the other List RPCs.
+	IncludedSoftwareIds   []string `protobuf:"bytes,11,rep,name=included_software_ids,json=includedSoftwareIds,proto3" json:"included_software_ids,omitempty"`
+	IncludedDependencyIds []string `protobuf:"bytes,12,rep,name=included_dependency_ids,json=includedDependencyIds,proto3" json:"included_dependency_ids,omitempty"`
+	IncludedOccurrenceIds []string `protobuf:"bytes,13,rep,name=included_occurrence_ids,json=includedOccurrenceIds,proto3" json:"included_occurrence_ids,omitempty"`
+}
+
+func (x *HasSBOM) Reset() {
+	*x = HasSBOM{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSBOM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSBOM) ProtoMessage() {}
+
+func (x *HasSBOM) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSBOM.ProtoReflect.Descriptor instead.
+func (*HasSBOM) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *HasSBOM) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HasSBOM) GetSubject() *PackageOrArtifact {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *HasSBOM) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *HasSBOM) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *HasSBOM) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *HasSBOM) GetDownloadLocation() string {
+	if x != nil {
+		return x.DownloadLocation
+	}
+	return ""
+}
+
+func (x *HasSBOM) GetKnownSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KnownSince
+	}
+	return nil
+}
+
+func (x *HasSBOM) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *HasSBOM) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *HasSBOM) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+func (x *HasSBOM) GetIncludedSoftwareIds() []string {
+	if x != nil {
+		return x.IncludedSoftwareIds
+	}
+	return nil
+}
+
+func (x *HasSBOM) GetIncludedDependencyIds() []string {
+	if x != nil {
+		return x.IncludedDependencyIds
+	}
+	return nil
+}
+
+func (x *HasSBOM) GetIncludedOccurrenceIds() []string {
+	if x != nil {
+		return x.IncludedOccurrenceIds
+	}
+	return nil
+}
+
+type ScanMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeScanned    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_scanned,json=timeScanned,proto3" json:"time_scanned,omitempty"`
+	DbUri          string                 `protobuf:"bytes,2,opt,name=db_uri,json=dbUri,proto3" json:"db_uri,omitempty"`
+	DbVersion      string                 `protobuf:"bytes,3,opt,name=db_version,json=dbVersion,proto3" json:"db_version,omitempty"`
+	ScannerUri     string                 `protobuf:"bytes,4,opt,name=scanner_uri,json=scannerUri,proto3" json:"scanner_uri,omitempty"`
+	ScannerVersion string                 `protobuf:"bytes,5,opt,name=scanner_version,json=scannerVersion,proto3" json:"scanner_version,omitempty"`
+	Origin         string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector      string                 `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef    string                 `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *ScanMetadata) Reset() {
+	*x = ScanMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanMetadata) ProtoMessage() {}
+
+func (x *ScanMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanMetadata.ProtoReflect.Descriptor instead.
+func (*ScanMetadata) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ScanMetadata) GetTimeScanned() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeScanned
+	}
+	return nil
+}
+
+func (x *ScanMetadata) GetDbUri() string {
+	if x != nil {
+		return x.DbUri
+	}
+	return ""
+}
+
+func (x *ScanMetadata) GetDbVersion() string {
+	if x != nil {
+		return x.DbVersion
+	}
+	return ""
+}
+
+func (x *ScanMetadata) GetScannerUri() string {
+	if x != nil {
+		return x.ScannerUri
+	}
+	return ""
+}
+
+func (x *ScanMetadata) GetScannerVersion() string {
+	if x != nil {
+		return x.ScannerVersion
+	}
+	return ""
+}
+
+func (x *ScanMetadata) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *ScanMetadata) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *ScanMetadata) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type CertifyVuln struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Package       *Package       `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	Vulnerability *Vulnerability `protobuf:"bytes,3,opt,name=vulnerability,proto3" json:"vulnerability,omitempty"`
+	Metadata      *ScanMetadata  `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *CertifyVuln) Reset() {
+	*x = CertifyVuln{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyVuln) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyVuln) ProtoMessage() {}
+
+func (x *CertifyVuln) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyVuln.ProtoReflect.Descriptor instead.
+func (*CertifyVuln) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CertifyVuln) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CertifyVuln) GetPackage() *Package {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *CertifyVuln) GetVulnerability() *Vulnerability {
+	if x != nil {
+		return x.Vulnerability
+	}
+	return nil
+}
+
+func (x *CertifyVuln) GetMetadata() *ScanMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type CertifyVEXStatement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject          *PackageOrArtifact     `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Vulnerability    *Vulnerability         `protobuf:"bytes,3,opt,name=vulnerability,proto3" json:"vulnerability,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	VexJustification string                 `protobuf:"bytes,5,opt,name=vex_justification,json=vexJustification,proto3" json:"vex_justification,omitempty"`
+	Statement        string                 `protobuf:"bytes,6,opt,name=statement,proto3" json:"statement,omitempty"`
+	StatusNotes      string                 `protobuf:"bytes,7,opt,name=status_notes,json=statusNotes,proto3" json:"status_notes,omitempty"`
+	KnownSince       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=known_since,json=knownSince,proto3" json:"known_since,omitempty"`
+	Origin           string                 `protobuf:"bytes,9,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector        string                 `protobuf:"bytes,10,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef      string                 `protobuf:"bytes,11,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyVEXStatement) Reset() {
+	*x = CertifyVEXStatement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyVEXStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyVEXStatement) ProtoMessage() {}
+
+func (x *CertifyVEXStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyVEXStatement.ProtoReflect.Descriptor instead.
+func (*CertifyVEXStatement) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CertifyVEXStatement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatement) GetSubject() *PackageOrArtifact {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *CertifyVEXStatement) GetVulnerability() *Vulnerability {
+	if x != nil {
+		return x.Vulnerability
+	}
+	return nil
+}
+
+func (x *CertifyVEXStatement) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatement) GetVexJustification() string {
+	if x != nil {
+		return x.VexJustification
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatement) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatement) GetStatusNotes() string {
+	if x != nil {
+		return x.StatusNotes
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatement) GetKnownSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KnownSince
+	}
+	return nil
+}
+
+func (x *CertifyVEXStatement) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatement) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyVEXStatement) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type PackageSourceOrArtifact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Subject:
+	//	*PackageSourceOrArtifact_Package
+	//	*PackageSourceOrArtifact_Source
+	//	*PackageSourceOrArtifact_Artifact
+	Subject isPackageSourceOrArtifact_Subject `protobuf_oneof:"subject"`
+}
+
+func (x *PackageSourceOrArtifact) Reset() {
+	*x = PackageSourceOrArtifact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageSourceOrArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageSourceOrArtifact) ProtoMessage() {}
+
+func (x *PackageSourceOrArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageSourceOrArtifact.ProtoReflect.Descriptor instead.
+func (*PackageSourceOrArtifact) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{98}
+}
+
+func (m *PackageSourceOrArtifact) GetSubject() isPackageSourceOrArtifact_Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (x *PackageSourceOrArtifact) GetPackage() *Package {
+	if x, ok := x.GetSubject().(*PackageSourceOrArtifact_Package); ok {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *PackageSourceOrArtifact) GetSource() *Source {
+	if x, ok := x.GetSubject().(*PackageSourceOrArtifact_Source); ok {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *PackageSourceOrArtifact) GetArtifact() *Artifact {
+	if x, ok := x.GetSubject().(*PackageSourceOrArtifact_Artifact); ok {
+		return x.Artifact
+	}
+	return nil
+}
+
+type isPackageSourceOrArtifact_Subject interface {
+	isPackageSourceOrArtifact_Subject()
+}
+
+type PackageSourceOrArtifact_Package struct {
+	Package *Package `protobuf:"bytes,1,opt,name=package,proto3,oneof"`
+}
+
+type PackageSourceOrArtifact_Source struct {
+	Source *Source `protobuf:"bytes,2,opt,name=source,proto3,oneof"`
+}
+
+type PackageSourceOrArtifact_Artifact struct {
+	Artifact *Artifact `protobuf:"bytes,3,opt,name=artifact,proto3,oneof"`
+}
+
+func (*PackageSourceOrArtifact_Package) isPackageSourceOrArtifact_Subject() {}
+
+func (*PackageSourceOrArtifact_Source) isPackageSourceOrArtifact_Subject() {}
+
+func (*PackageSourceOrArtifact_Artifact) isPackageSourceOrArtifact_Subject() {}
+
+type CertifyBad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject       *PackageSourceOrArtifact `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Justification string                   `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	KnownSince    *timestamppb.Timestamp   `protobuf:"bytes,4,opt,name=known_since,json=knownSince,proto3" json:"known_since,omitempty"`
+	Origin        string                   `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                   `protobuf:"bytes,6,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                   `protobuf:"bytes,7,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyBad) Reset() {
+	*x = CertifyBad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyBad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyBad) ProtoMessage() {}
+
+func (x *CertifyBad) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyBad.ProtoReflect.Descriptor instead.
+func (*CertifyBad) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CertifyBad) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CertifyBad) GetSubject() *PackageSourceOrArtifact {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *CertifyBad) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CertifyBad) GetKnownSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KnownSince
+	}
+	return nil
+}
+
+func (x *CertifyBad) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyBad) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyBad) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type CertifyGood struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject       *PackageSourceOrArtifact `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Justification string                   `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	KnownSince    *timestamppb.Timestamp   `protobuf:"bytes,4,opt,name=known_since,json=knownSince,proto3" json:"known_since,omitempty"`
+	Origin        string                   `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                   `protobuf:"bytes,6,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                   `protobuf:"bytes,7,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyGood) Reset() {
+	*x = CertifyGood{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyGood) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyGood) ProtoMessage() {}
+
+func (x *CertifyGood) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyGood.ProtoReflect.Descriptor instead.
+func (*CertifyGood) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CertifyGood) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CertifyGood) GetSubject() *PackageSourceOrArtifact {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *CertifyGood) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CertifyGood) GetKnownSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KnownSince
+	}
+	return nil
+}
+
+func (x *CertifyGood) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyGood) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyGood) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type SLSAPredicate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SLSAPredicate) Reset() {
+	*x = SLSAPredicate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLSAPredicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLSAPredicate) ProtoMessage() {}
+
+func (x *SLSAPredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLSAPredicate.ProtoReflect.Descriptor instead.
+func (*SLSAPredicate) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SLSAPredicate) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SLSAPredicate) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SLSA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuiltFrom     []*Artifact            `protobuf:"bytes,1,rep,name=built_from,json=builtFrom,proto3" json:"built_from,omitempty"`
+	BuiltBy       *Builder               `protobuf:"bytes,2,opt,name=built_by,json=builtBy,proto3" json:"built_by,omitempty"`
+	BuildType     string                 `protobuf:"bytes,3,opt,name=build_type,json=buildType,proto3" json:"build_type,omitempty"`
+	SlsaPredicate []*SLSAPredicate       `protobuf:"bytes,4,rep,name=slsa_predicate,json=slsaPredicate,proto3" json:"slsa_predicate,omitempty"`
+	SlsaVersion   string                 `protobuf:"bytes,5,opt,name=slsa_version,json=slsaVersion,proto3" json:"slsa_version,omitempty"`
+	StartedOn     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_on,json=startedOn,proto3" json:"started_on,omitempty"`
+	FinishedOn    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished_on,json=finishedOn,proto3" json:"finished_on,omitempty"`
+	Origin        string                 `protobuf:"bytes,8,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                 `protobuf:"bytes,9,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                 `protobuf:"bytes,10,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *SLSA) Reset() {
+	*x = SLSA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SLSA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLSA) ProtoMessage() {}
+
+func (x *SLSA) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLSA.ProtoReflect.Descriptor instead.
+func (*SLSA) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SLSA) GetBuiltFrom() []*Artifact {
+	if x != nil {
+		return x.BuiltFrom
+	}
+	return nil
+}
+
+func (x *SLSA) GetBuiltBy() *Builder {
+	if x != nil {
+		return x.BuiltBy
+	}
+	return nil
+}
+
+func (x *SLSA) GetBuildType() string {
+	if x != nil {
+		return x.BuildType
+	}
+	return ""
+}
+
+func (x *SLSA) GetSlsaPredicate() []*SLSAPredicate {
+	if x != nil {
+		return x.SlsaPredicate
+	}
+	return nil
+}
+
+func (x *SLSA) GetSlsaVersion() string {
+	if x != nil {
+		return x.SlsaVersion
+	}
+	return ""
+}
+
+func (x *SLSA) GetStartedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedOn
+	}
+	return nil
+}
+
+func (x *SLSA) GetFinishedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedOn
+	}
+	return nil
+}
+
+func (x *SLSA) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *SLSA) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *SLSA) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type HasSLSA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject *Artifact `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Slsa    *SLSA     `protobuf:"bytes,3,opt,name=slsa,proto3" json:"slsa,omitempty"`
+}
+
+func (x *HasSLSA) Reset() {
+	*x = HasSLSA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSLSA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSLSA) ProtoMessage() {}
+
+func (x *HasSLSA) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSLSA.ProtoReflect.Descriptor instead.
+func (*HasSLSA) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *HasSLSA) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HasSLSA) GetSubject() *Artifact {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *HasSLSA) GetSlsa() *SLSA {
+	if x != nil {
+		return x.Slsa
+	}
+	return nil
+}
+
+type HasSourceAt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Package       *Package               `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	Source        *Source                `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	KnownSince    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=known_since,json=knownSince,proto3" json:"known_since,omitempty"`
+	Justification string                 `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                 `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                 `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *HasSourceAt) Reset() {
+	*x = HasSourceAt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasSourceAt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasSourceAt) ProtoMessage() {}
+
+func (x *HasSourceAt) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasSourceAt.ProtoReflect.Descriptor instead.
+func (*HasSourceAt) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *HasSourceAt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HasSourceAt) GetPackage() *Package {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *HasSourceAt) GetSource() *Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *HasSourceAt) GetKnownSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KnownSince
+	}
+	return nil
+}
+
+func (x *HasSourceAt) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *HasSourceAt) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *HasSourceAt) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *HasSourceAt) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type ScorecardCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Check string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	Score int32  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ScorecardCheck) Reset() {
+	*x = ScorecardCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScorecardCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScorecardCheck) ProtoMessage() {}
+
+func (x *ScorecardCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScorecardCheck.ProtoReflect.Descriptor instead.
+func (*ScorecardCheck) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ScorecardCheck) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *ScorecardCheck) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type Scorecard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checks           []*ScorecardCheck      `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	AggregateScore   float64                `protobuf:"fixed64,2,opt,name=aggregate_score,json=aggregateScore,proto3" json:"aggregate_score,omitempty"`
+	TimeScanned      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time_scanned,json=timeScanned,proto3" json:"time_scanned,omitempty"`
+	ScorecardVersion string                 `protobuf:"bytes,4,opt,name=scorecard_version,json=scorecardVersion,proto3" json:"scorecard_version,omitempty"`
+	ScorecardCommit  string                 `protobuf:"bytes,5,opt,name=scorecard_commit,json=scorecardCommit,proto3" json:"scorecard_commit,omitempty"`
+	Origin           string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector        string                 `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef      string                 `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *Scorecard) Reset() {
+	*x = Scorecard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scorecard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scorecard) ProtoMessage() {}
+
+func (x *Scorecard) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scorecard.ProtoReflect.Descriptor instead.
+func (*Scorecard) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *Scorecard) GetChecks() []*ScorecardCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *Scorecard) GetAggregateScore() float64 {
+	if x != nil {
+		return x.AggregateScore
+	}
+	return 0
+}
+
+func (x *Scorecard) GetTimeScanned() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeScanned
+	}
+	return nil
+}
+
+func (x *Scorecard) GetScorecardVersion() string {
+	if x != nil {
+		return x.ScorecardVersion
+	}
+	return ""
+}
+
+func (x *Scorecard) GetScorecardCommit() string {
+	if x != nil {
+		return x.ScorecardCommit
+	}
+	return ""
+}
+
+func (x *Scorecard) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *Scorecard) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *Scorecard) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type CertifyScorecard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source    *Source    `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Scorecard *Scorecard `protobuf:"bytes,3,opt,name=scorecard,proto3" json:"scorecard,omitempty"`
+}
+
+func (x *CertifyScorecard) Reset() {
+	*x = CertifyScorecard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyScorecard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyScorecard) ProtoMessage() {}
+
+func (x *CertifyScorecard) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyScorecard.ProtoReflect.Descriptor instead.
+func (*CertifyScorecard) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CertifyScorecard) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CertifyScorecard) GetSource() *Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *CertifyScorecard) GetScorecard() *Scorecard {
+	if x != nil {
+		return x.Scorecard
+	}
+	return nil
+}
+
+type CertifyLegal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject            *PackageOrSource       `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	DeclaredLicense    string                 `protobuf:"bytes,3,opt,name=declared_license,json=declaredLicense,proto3" json:"declared_license,omitempty"`
+	DeclaredLicenses   []*License             `protobuf:"bytes,4,rep,name=declared_licenses,json=declaredLicenses,proto3" json:"declared_licenses,omitempty"`
+	DiscoveredLicense  string                 `protobuf:"bytes,5,opt,name=discovered_license,json=discoveredLicense,proto3" json:"discovered_license,omitempty"`
+	DiscoveredLicenses []*License             `protobuf:"bytes,6,rep,name=discovered_licenses,json=discoveredLicenses,proto3" json:"discovered_licenses,omitempty"`
+	Attribution        string                 `protobuf:"bytes,7,opt,name=attribution,proto3" json:"attribution,omitempty"`
+	Justification      string                 `protobuf:"bytes,8,opt,name=justification,proto3" json:"justification,omitempty"`
+	TimeScanned        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=time_scanned,json=timeScanned,proto3" json:"time_scanned,omitempty"`
+	Origin             string                 `protobuf:"bytes,10,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector          string                 `protobuf:"bytes,11,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef        string                 `protobuf:"bytes,12,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *CertifyLegal) Reset() {
+	*x = CertifyLegal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertifyLegal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertifyLegal) ProtoMessage() {}
+
+func (x *CertifyLegal) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertifyLegal.ProtoReflect.Descriptor instead.
+func (*CertifyLegal) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *CertifyLegal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CertifyLegal) GetSubject() *PackageOrSource {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *CertifyLegal) GetDeclaredLicense() string {
+	if x != nil {
+		return x.DeclaredLicense
+	}
+	return ""
+}
+
+func (x *CertifyLegal) GetDeclaredLicenses() []*License {
+	if x != nil {
+		return x.DeclaredLicenses
+	}
+	return nil
+}
+
+func (x *CertifyLegal) GetDiscoveredLicense() string {
+	if x != nil {
+		return x.DiscoveredLicense
+	}
+	return ""
+}
+
+func (x *CertifyLegal) GetDiscoveredLicenses() []*License {
+	if x != nil {
+		return x.DiscoveredLicenses
+	}
+	return nil
+}
+
+func (x *CertifyLegal) GetAttribution() string {
+	if x != nil {
+		return x.Attribution
+	}
+	return ""
+}
+
+func (x *CertifyLegal) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CertifyLegal) GetTimeScanned() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeScanned
+	}
+	return nil
+}
+
+func (x *CertifyLegal) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *CertifyLegal) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *CertifyLegal) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type PkgEqual struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Packages      []*Package `protobuf:"bytes,2,rep,name=packages,proto3" json:"packages,omitempty"`
+	Justification string     `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string     `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string     `protobuf:"bytes,5,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string     `protobuf:"bytes,6,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *PkgEqual) Reset() {
+	*x = PkgEqual{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PkgEqual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PkgEqual) ProtoMessage() {}
+
+func (x *PkgEqual) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PkgEqual.ProtoReflect.Descriptor instead.
+func (*PkgEqual) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *PkgEqual) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PkgEqual) GetPackages() []*Package {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *PkgEqual) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *PkgEqual) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *PkgEqual) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *PkgEqual) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type VulnEqual struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Vulnerabilities []*Vulnerability `protobuf:"bytes,2,rep,name=vulnerabilities,proto3" json:"vulnerabilities,omitempty"`
+	Justification   string           `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin          string           `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector       string           `protobuf:"bytes,5,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef     string           `protobuf:"bytes,6,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *VulnEqual) Reset() {
+	*x = VulnEqual{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnEqual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnEqual) ProtoMessage() {}
+
+func (x *VulnEqual) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnEqual.ProtoReflect.Descriptor instead.
+func (*VulnEqual) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *VulnEqual) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VulnEqual) GetVulnerabilities() []*Vulnerability {
+	if x != nil {
+		return x.Vulnerabilities
+	}
+	return nil
+}
+
+func (x *VulnEqual) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *VulnEqual) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *VulnEqual) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *VulnEqual) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type HashEqual struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Artifacts     []*Artifact `protobuf:"bytes,2,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	Justification string      `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string      `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string      `protobuf:"bytes,5,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string      `protobuf:"bytes,6,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *HashEqual) Reset() {
+	*x = HashEqual{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashEqual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashEqual) ProtoMessage() {}
+
+func (x *HashEqual) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashEqual.ProtoReflect.Descriptor instead.
+func (*HashEqual) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *HashEqual) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HashEqual) GetArtifacts() []*Artifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *HashEqual) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *HashEqual) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *HashEqual) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *HashEqual) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type HasMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject       *PackageSourceOrArtifact `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Key           string                   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp     *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Justification string                   `protobuf:"bytes,6,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string                   `protobuf:"bytes,7,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                   `protobuf:"bytes,8,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                   `protobuf:"bytes,9,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *HasMetadata) Reset() {
+	*x = HasMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasMetadata) ProtoMessage() {}
+
+func (x *HasMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasMetadata.ProtoReflect.Descriptor instead.
+func (*HasMetadata) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *HasMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HasMetadata) GetSubject() *PackageSourceOrArtifact {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *HasMetadata) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HasMetadata) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *HasMetadata) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *HasMetadata) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *HasMetadata) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *HasMetadata) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *HasMetadata) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type PointOfContact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject       *PackageSourceOrArtifact `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email         string                   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Info          string                   `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	Since         *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Justification string                   `protobuf:"bytes,6,opt,name=justification,proto3" json:"justification,omitempty"`
+	Origin        string                   `protobuf:"bytes,7,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                   `protobuf:"bytes,8,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                   `protobuf:"bytes,9,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *PointOfContact) Reset() {
+	*x = PointOfContact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointOfContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointOfContact) ProtoMessage() {}
+
+func (x *PointOfContact) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointOfContact.ProtoReflect.Descriptor instead.
+func (*PointOfContact) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *PointOfContact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PointOfContact) GetSubject() *PackageSourceOrArtifact {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *PointOfContact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PointOfContact) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+func (x *PointOfContact) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *PointOfContact) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *PointOfContact) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *PointOfContact) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *PointOfContact) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type VulnerabilityMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Vulnerability *Vulnerability         `protobuf:"bytes,2,opt,name=vulnerability,proto3" json:"vulnerability,omitempty"`
+	ScoreType     string                 `protobuf:"bytes,3,opt,name=score_type,json=scoreType,proto3" json:"score_type,omitempty"`
+	ScoreValue    float64                `protobuf:"fixed64,4,opt,name=score_value,json=scoreValue,proto3" json:"score_value,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Origin        string                 `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Collector     string                 `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	DocumentRef   string                 `protobuf:"bytes,8,opt,name=document_ref,json=documentRef,proto3" json:"document_ref,omitempty"`
+}
+
+func (x *VulnerabilityMetadata) Reset() {
+	*x = VulnerabilityMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilityMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilityMetadata) ProtoMessage() {}
+
+func (x *VulnerabilityMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilityMetadata.ProtoReflect.Descriptor instead.
+func (*VulnerabilityMetadata) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *VulnerabilityMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadata) GetVulnerability() *Vulnerability {
+	if x != nil {
+		return x.Vulnerability
+	}
+	return nil
+}
+
+func (x *VulnerabilityMetadata) GetScoreType() string {
+	if x != nil {
+		return x.ScoreType
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadata) GetScoreValue() float64 {
+	if x != nil {
+		return x.ScoreValue
+	}
+	return 0
+}
+
+func (x *VulnerabilityMetadata) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *VulnerabilityMetadata) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadata) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *VulnerabilityMetadata) GetDocumentRef() string {
+	if x != nil {
+		return x.DocumentRef
+	}
+	return ""
+}
+
+type ListPackagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *PkgSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListPackagesRequest) Reset() {
+	*x = ListPackagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackagesRequest) ProtoMessage() {}
+
+func (x *ListPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListPackagesRequest) GetFilter() *PkgSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPackagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListSourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *SourceSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32       `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListSourcesRequest) Reset() {
+	*x = ListSourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSourcesRequest) ProtoMessage() {}
+
+func (x *ListSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListSourcesRequest) GetFilter() *SourceSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListSourcesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListArtifactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *ArtifactSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32         `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListArtifactsRequest) Reset() {
+	*x = ListArtifactsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArtifactsRequest) ProtoMessage() {}
+
+func (x *ListArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*ListArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListArtifactsRequest) GetFilter() *ArtifactSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListArtifactsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListBuildersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *BuilderSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32        `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListBuildersRequest) Reset() {
+	*x = ListBuildersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBuildersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuildersRequest) ProtoMessage() {}
+
+func (x *ListBuildersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuildersRequest.ProtoReflect.Descriptor instead.
+func (*ListBuildersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListBuildersRequest) GetFilter() *BuilderSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListBuildersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLicensesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *LicenseSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32        `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListLicensesRequest) Reset() {
+	*x = ListLicensesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLicensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLicensesRequest) ProtoMessage() {}
+
+func (x *ListLicensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLicensesRequest.ProtoReflect.Descriptor instead.
+func (*ListLicensesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListLicensesRequest) GetFilter() *LicenseSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListLicensesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListVulnerabilitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *VulnerabilitySpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32              `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListVulnerabilitiesRequest) Reset() {
+	*x = ListVulnerabilitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVulnerabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVulnerabilitiesRequest) ProtoMessage() {}
+
+func (x *ListVulnerabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVulnerabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListVulnerabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListVulnerabilitiesRequest) GetFilter() *VulnerabilitySpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListVulnerabilitiesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListIsDependenciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *IsDependencySpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32             `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListIsDependenciesRequest) Reset() {
+	*x = ListIsDependenciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIsDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIsDependenciesRequest) ProtoMessage() {}
+
+func (x *ListIsDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIsDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListIsDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListIsDependenciesRequest) GetFilter() *IsDependencySpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListIsDependenciesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListIsOccurrencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *IsOccurrenceSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32             `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListIsOccurrencesRequest) Reset() {
+	*x = ListIsOccurrencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIsOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIsOccurrencesRequest) ProtoMessage() {}
+
+func (x *ListIsOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIsOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*ListIsOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListIsOccurrencesRequest) GetFilter() *IsOccurrenceSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListIsOccurrencesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListHasSBOMsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *HasSBOMSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32        `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListHasSBOMsRequest) Reset() {
+	*x = ListHasSBOMsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHasSBOMsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHasSBOMsRequest) ProtoMessage() {}
+
+func (x *ListHasSBOMsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHasSBOMsRequest.ProtoReflect.Descriptor instead.
+func (*ListHasSBOMsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListHasSBOMsRequest) GetFilter() *HasSBOMSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListHasSBOMsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCertifyVulnsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *CertifyVulnSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListCertifyVulnsRequest) Reset() {
+	*x = ListCertifyVulnsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCertifyVulnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertifyVulnsRequest) ProtoMessage() {}
+
+func (x *ListCertifyVulnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertifyVulnsRequest.ProtoReflect.Descriptor instead.
+func (*ListCertifyVulnsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ListCertifyVulnsRequest) GetFilter() *CertifyVulnSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListCertifyVulnsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCertifyVEXStatementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *CertifyVEXStatementSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32                    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListCertifyVEXStatementsRequest) Reset() {
+	*x = ListCertifyVEXStatementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCertifyVEXStatementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertifyVEXStatementsRequest) ProtoMessage() {}
+
+func (x *ListCertifyVEXStatementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertifyVEXStatementsRequest.ProtoReflect.Descriptor instead.
+func (*ListCertifyVEXStatementsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListCertifyVEXStatementsRequest) GetFilter() *CertifyVEXStatementSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListCertifyVEXStatementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCertifyBadsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *CertifyBadSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32           `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListCertifyBadsRequest) Reset() {
+	*x = ListCertifyBadsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCertifyBadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertifyBadsRequest) ProtoMessage() {}
+
+func (x *ListCertifyBadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertifyBadsRequest.ProtoReflect.Descriptor instead.
+func (*ListCertifyBadsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListCertifyBadsRequest) GetFilter() *CertifyBadSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListCertifyBadsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCertifyGoodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *CertifyGoodSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListCertifyGoodsRequest) Reset() {
+	*x = ListCertifyGoodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCertifyGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertifyGoodsRequest) ProtoMessage() {}
+
+func (x *ListCertifyGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertifyGoodsRequest.ProtoReflect.Descriptor instead.
+func (*ListCertifyGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListCertifyGoodsRequest) GetFilter() *CertifyGoodSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListCertifyGoodsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListHasSLSAsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *HasSLSASpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32        `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListHasSLSAsRequest) Reset() {
+	*x = ListHasSLSAsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHasSLSAsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHasSLSAsRequest) ProtoMessage() {}
+
+func (x *ListHasSLSAsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHasSLSAsRequest.ProtoReflect.Descriptor instead.
+func (*ListHasSLSAsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListHasSLSAsRequest) GetFilter() *HasSLSASpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListHasSLSAsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListHasSourceAtsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *HasSourceAtSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListHasSourceAtsRequest) Reset() {
+	*x = ListHasSourceAtsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHasSourceAtsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHasSourceAtsRequest) ProtoMessage() {}
+
+func (x *ListHasSourceAtsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHasSourceAtsRequest.ProtoReflect.Descriptor instead.
+func (*ListHasSourceAtsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListHasSourceAtsRequest) GetFilter() *HasSourceAtSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListHasSourceAtsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCertifyScorecardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *CertifyScorecardSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListCertifyScorecardsRequest) Reset() {
+	*x = ListCertifyScorecardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCertifyScorecardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertifyScorecardsRequest) ProtoMessage() {}
+
+func (x *ListCertifyScorecardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertifyScorecardsRequest.ProtoReflect.Descriptor instead.
+func (*ListCertifyScorecardsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListCertifyScorecardsRequest) GetFilter() *CertifyScorecardSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListCertifyScorecardsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCertifyLegalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *CertifyLegalSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32             `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListCertifyLegalsRequest) Reset() {
+	*x = ListCertifyLegalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCertifyLegalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertifyLegalsRequest) ProtoMessage() {}
+
+func (x *ListCertifyLegalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertifyLegalsRequest.ProtoReflect.Descriptor instead.
+func (*ListCertifyLegalsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListCertifyLegalsRequest) GetFilter() *CertifyLegalSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListCertifyLegalsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPkgEqualsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *PkgEqualSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32         `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListPkgEqualsRequest) Reset() {
+	*x = ListPkgEqualsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPkgEqualsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPkgEqualsRequest) ProtoMessage() {}
+
+func (x *ListPkgEqualsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPkgEqualsRequest.ProtoReflect.Descriptor instead.
+func (*ListPkgEqualsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListPkgEqualsRequest) GetFilter() *PkgEqualSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPkgEqualsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListVulnEqualsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *VulnEqualSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32          `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListVulnEqualsRequest) Reset() {
+	*x = ListVulnEqualsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVulnEqualsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVulnEqualsRequest) ProtoMessage() {}
+
+func (x *ListVulnEqualsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVulnEqualsRequest.ProtoReflect.Descriptor instead.
+func (*ListVulnEqualsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListVulnEqualsRequest) GetFilter() *VulnEqualSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListVulnEqualsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListHashEqualsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *HashEqualSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32          `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListHashEqualsRequest) Reset() {
+	*x = ListHashEqualsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHashEqualsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHashEqualsRequest) ProtoMessage() {}
+
+func (x *ListHashEqualsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHashEqualsRequest.ProtoReflect.Descriptor instead.
+func (*ListHashEqualsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListHashEqualsRequest) GetFilter() *HashEqualSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListHashEqualsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListHasMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *HasMetadataSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListHasMetadataRequest) Reset() {
+	*x = ListHasMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHasMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHasMetadataRequest) ProtoMessage() {}
+
+func (x *ListHasMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHasMetadataRequest.ProtoReflect.Descriptor instead.
+func (*ListHasMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListHasMetadataRequest) GetFilter() *HasMetadataSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListHasMetadataRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPointOfContactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *PointOfContactSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32               `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListPointOfContactsRequest) Reset() {
+	*x = ListPointOfContactsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPointOfContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPointOfContactsRequest) ProtoMessage() {}
+
+func (x *ListPointOfContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPointOfContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListPointOfContactsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListPointOfContactsRequest) GetFilter() *PointOfContactSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPointOfContactsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListVulnerabilityMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *VulnerabilityMetadataSpec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize int32                      `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListVulnerabilityMetadataRequest) Reset() {
+	*x = ListVulnerabilityMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVulnerabilityMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVulnerabilityMetadataRequest) ProtoMessage() {}
+
+func (x *ListVulnerabilityMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVulnerabilityMetadataRequest.ProtoReflect.Descriptor instead.
+func (*ListVulnerabilityMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ListVulnerabilityMetadataRequest) GetFilter() *VulnerabilityMetadataSpec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListVulnerabilityMetadataRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+var File_pkg_assembler_grpc_guacapi_guacapi_proto protoreflect.FileDescriptor
+
+var file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x75, 0x61, 0x63, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x75, 0x61,
+	0x63, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43, 0x0a, 0x19, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x94, 0x02, 0x0a,
+	0x0c, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x0a, 0x71, 0x75, 0x61, 0x6c, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x0a, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x1d,
+	0x0a, 0x07, 0x73, 0x75, 0x62, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x07, 0x73, 0x75, 0x62, 0x70, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x75, 0x62, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x9e, 0x01, 0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x61, 0x67, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x22, 0x49, 0x0a, 0x11, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22,
+	0x24, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x57, 0x0a, 0x16, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x87,
+	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x6c, 0x69,
+	0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd1, 0x01, 0x0a, 0x13, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x79, 0x42, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x69,
+	0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xd2, 0x01, 0x0a,
+	0x14, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x66, 0x22, 0xd1, 0x02, 0x0a, 0x15, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x67,
+	0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x29, 0x0a, 0x10, 0x64,
+	0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x4c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0x45, 0x0a, 0x17, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x72, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xfa, 0x02, 0x0a,
+	0x12, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x4b, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xab, 0x02, 0x0a, 0x11, 0x53, 0x63,
+	0x61, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x3d, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x15,
+	0x0a, 0x06, 0x64, 0x62, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x62, 0x55, 0x72, 0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x62, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x62, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f,
+	0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x55, 0x72, 0x69, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xf7, 0x01, 0x0a, 0x14, 0x48, 0x61, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x66, 0x22, 0x9d, 0x02, 0x0a, 0x10, 0x48, 0x61, 0x73, 0x53, 0x42, 0x4f, 0x4d, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x66, 0x22, 0x40, 0x0a, 0x16, 0x53, 0x4c, 0x53, 0x41, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xfd, 0x02, 0x0a, 0x0d, 0x53, 0x4c, 0x53, 0x41, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x73, 0x6c, 0x73, 0x61, 0x5f, 0x70, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x4c, 0x53, 0x41, 0x50, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x0d, 0x73, 0x6c, 0x73, 0x61, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x6c, 0x73, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6c, 0x73, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x3b, 0x0a,
+	0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x66, 0x22, 0xd2, 0x01, 0x0a, 0x14, 0x48, 0x61, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x41, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x3b, 0x0a, 0x0b,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0x93, 0x01, 0x0a, 0x12, 0x48, 0x61, 0x73,
+	0x68, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xbf,
+	0x01, 0x0a, 0x15, 0x49, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66,
+	0x22, 0x96, 0x01, 0x0a, 0x15, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0x92, 0x01, 0x0a, 0x11, 0x50, 0x6b,
+	0x67, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xf4,
+	0x01, 0x0a, 0x17, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xb3, 0x02, 0x0a, 0x15, 0x56, 0x65, 0x78, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x78, 0x5f, 0x6a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x76, 0x65, 0x78, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x69, 0x6e,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0x93, 0x01, 0x0a, 0x12,
+	0x56, 0x75, 0x6c, 0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x66, 0x22, 0xf3, 0x01, 0x0a, 0x1e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0x9d, 0x02, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x6c, 0x6f, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x73, 0x65, 0x72, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x69, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x69, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x16, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72,
+	0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x72, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x12, 0x49, 0x73, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x03,
+	0x70, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x41, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x5f,
+	0x70, 0x6b, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x06, 0x64, 0x65, 0x70, 0x50, 0x6b, 0x67, 0x12, 0x56, 0x0a, 0x0d, 0x69,
+	0x73, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x49, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0xb2, 0x02, 0x0a, 0x12, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x6b,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x3d, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x12, 0x56, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x69, 0x73, 0x4f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xb5, 0x02, 0x0a, 0x0d, 0x48, 0x61, 0x73,
+	0x53, 0x6c, 0x73, 0x61, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x61, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x6c, 0x73,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x4c, 0x53, 0x41, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x07, 0x68, 0x61, 0x73, 0x53, 0x6c, 0x73, 0x61, 0x12, 0x4b, 0x0a, 0x09, 0x6d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x6d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
+	0x22, 0xf5, 0x01, 0x0a, 0x11, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x75, 0x6c, 0x6e,
+	0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x70,
+	0x6b, 0x67, 0x12, 0x58, 0x0a, 0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0d, 0x76,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x09,
+	0x76, 0x75, 0x6c, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x61,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08,
+	0x76, 0x75, 0x6c, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x9f, 0x02, 0x0a, 0x0f, 0x56, 0x75, 0x6c,
+	0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x0d,
+	0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x63, 0x0a, 0x13, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x5f,
+	0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x12, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x56, 0x75,
+	0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x0a, 0x76,
+	0x75, 0x6c, 0x6e, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c,
+	0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x09, 0x76, 0x75, 0x6c, 0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x22, 0x8a, 0x02, 0x0a, 0x11, 0x48,
+	0x61, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x24, 0x0a, 0x0e,
+	0x70, 0x6b, 0x67, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6b, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x3d, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x73, 0x72,
+	0x63, 0x12, 0x54, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
+	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x22, 0xd0, 0x02, 0x0a, 0x10, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x79, 0x42, 0x61, 0x64, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x03,
+	0x70, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6b, 0x67, 0x5f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x6b, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x3d,
+	0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x49, 0x0a,
+	0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x50, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x79, 0x5f, 0x62, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x79, 0x42, 0x61, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x42, 0x61, 0x64, 0x22, 0xd4, 0x02, 0x0a, 0x11, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x24, 0x0a, 0x0e,
+	0x70, 0x6b, 0x67, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6b, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x3d, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x73, 0x72,
+	0x63, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x53, 0x0a, 0x0c,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x47, 0x6f, 0x6f,
+	0x64, 0x22, 0xb1, 0x02, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x53, 0x42, 0x4f, 0x4d, 0x49, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b,
+	0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12,
+	0x49, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x47, 0x0a, 0x08, 0x68, 0x61,
+	0x73, 0x5f, 0x73, 0x62, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x42, 0x4f,
+	0x4d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x68, 0x61, 0x73, 0x53,
+	0x62, 0x6f, 0x6d, 0x12, 0x50, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x42, 0x4f, 0x4d, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x18, 0x48, 0x61, 0x73, 0x53, 0x42, 0x4f,
+	0x4d, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x0f, 0x48, 0x61, 0x73, 0x68, 0x45, 0x71, 0x75, 0x61, 0x6c,
+	0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x12, 0x54, 0x0a, 0x0e, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0d, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x5f,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x45, 0x71, 0x75,
+	0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x68, 0x61, 0x73,
+	0x68, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x22, 0xdf, 0x01, 0x0a, 0x0e, 0x50, 0x6b, 0x67, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x6b, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x45, 0x0a, 0x09, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x5f, 0x70,
+	0x6b, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x08, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x50, 0x6b, 0x67, 0x12, 0x4a, 0x0a, 0x09,
+	0x70, 0x6b, 0x67, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67,
+	0x45, 0x71, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08,
+	0x70, 0x6b, 0x67, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x22, 0xba, 0x02, 0x0a, 0x09, 0x56, 0x65, 0x78,
+	0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x70,
+	0x6b, 0x67, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x58, 0x0a,
+	0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x08, 0x76, 0x65, 0x78, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x76, 0x65,
+	0x78, 0x44, 0x61, 0x74, 0x61, 0x22, 0xe1, 0x02, 0x0a, 0x14, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4f,
+	0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6b,
+	0x67, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x6b, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6c, 0x61, 0x67,
+	0x12, 0x3d, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12,
+	0x49, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0e, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x12, 0x56, 0x75,
+	0x6c, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74,
+	0x12, 0x58, 0x0a, 0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x5f, 0x0a, 0x0d, 0x76, 0x75,
+	0x6c, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x76,
+	0x75, 0x6c, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd4, 0x02, 0x0a, 0x11,
+	0x48, 0x61, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x24, 0x0a,
+	0x0e, 0x70, 0x6b, 0x67, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6b, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x3d, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x73,
+	0x72, 0x63, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x53, 0x0a,
+	0x0c, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x48, 0x61, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xff, 0x02, 0x0a, 0x12, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x65,
+	0x67, 0x61, 0x6c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x03, 0x70, 0x6b, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x3d, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x03, 0x73, 0x72, 0x63, 0x12, 0x48, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x12, 0x4c,
+	0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x56, 0x0a, 0x0d,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x4c,
+	0x65, 0x67, 0x61, 0x6c, 0x22, 0xae, 0x0b, 0x0a, 0x10, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x50,
+	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x11, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x72, 0x64, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x10, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x12, 0x53, 0x0a, 0x0d, 0x69, 0x73,
+	0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49,
+	0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x49, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x53, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x69, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x6c, 0x73, 0x61,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x6c, 0x73, 0x61, 0x49, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x68, 0x61, 0x73, 0x53, 0x6c, 0x73, 0x61, 0x12, 0x50, 0x0a, 0x0c, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x75, 0x6c, 0x6e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52,
+	0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x75, 0x6c, 0x6e, 0x12, 0x4a, 0x0a, 0x0a,
+	0x76, 0x75, 0x6c, 0x6e, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75,
+	0x6c, 0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x09, 0x76,
+	0x75, 0x6c, 0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x12, 0x51, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0b,
+	0x68, 0x61, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x12, 0x4d, 0x0a, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x62, 0x61, 0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x79, 0x42, 0x61, 0x64, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x42, 0x61, 0x64, 0x12, 0x50, 0x0a, 0x0c, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x79, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52,
+	0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x47, 0x6f, 0x6f, 0x64, 0x12, 0x44, 0x0a, 0x08,
+	0x68, 0x61, 0x73, 0x5f, 0x73, 0x62, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x53,
+	0x42, 0x4f, 0x4d, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x07, 0x68, 0x61, 0x73, 0x53, 0x62,
+	0x6f, 0x6d, 0x12, 0x4a, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x52, 0x09, 0x68, 0x61, 0x73, 0x68, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x12, 0x47,
+	0x0a, 0x09, 0x70, 0x6b, 0x67, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x6b, 0x67, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x08, 0x70,
+	0x6b, 0x67, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x03, 0x76, 0x65, 0x78, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x56, 0x65, 0x78, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x03, 0x76, 0x65, 0x78,
+	0x12, 0x5a, 0x0a, 0x10, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x66, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x53, 0x0a, 0x0d,
+	0x76, 0x75, 0x6c, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x52, 0x0c, 0x76, 0x75, 0x6c, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x50, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x53, 0x0a, 0x0d, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x6c,
+	0x65, 0x67, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x4c,
+	0x65, 0x67, 0x61, 0x6c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x12, 0x4b, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x17, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x50,
+	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4c, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x94,
+	0x02, 0x0a, 0x18, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x62, 0x6f,
+	0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x61, 0x73,
+	0x53, 0x62, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x73,
+	0x6c, 0x73, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x68,
+	0x61, 0x73, 0x53, 0x6c, 0x73, 0x61, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x73, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x4d, 0x0a, 0x14, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x19, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xa6, 0x03, 0x0a, 0x07, 0x50, 0x6b, 0x67, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x0a, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x0a, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x1b, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x05, 0x52, 0x18, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x06, 0x52, 0x07, 0x73, 0x75, 0x62, 0x70, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x75, 0x62, 0x70, 0x61, 0x74, 0x68, 0x22, 0xe4, 0x01,
+	0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x03, 0x74, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x61, 0x67, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x0b, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x15,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x75, 0x72, 0x69, 0x22, 0xac, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x26, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xc0, 0x01, 0x0a, 0x11, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x76, 0x75, 0x6c, 0x6e, 0x65,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x0f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6e, 0x6f, 0x5f, 0x76, 0x75,
+	0x6c, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x56, 0x75,
+	0x6c, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e,
+	0x6f, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x4f, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x3d,
+	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x9c, 0x01,
+	0x0a, 0x15, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0xd2, 0x03, 0x0a,
+	0x10, 0x49, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x6b, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x0f, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x66, 0x22, 0x8e, 0x03, 0x0a, 0x10, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x4f, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x0d,
+	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52,
+	0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x22, 0xc8, 0x03, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x53, 0x42, 0x4f, 0x4d, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x03, 0x75, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
+	0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52,
+	0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a,
+	0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x75, 0x72, 0x69, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xa5, 0x04,
+	0x0a, 0x0f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x75, 0x6c, 0x6e, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x06, 0x64, 0x62,
+	0x5f, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x64, 0x62,
+	0x55, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x62, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x64, 0x62,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x63,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x0a, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x72, 0x69, 0x88, 0x01, 0x01,
+	0x12, 0x2c, 0x0a, 0x0f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0e, 0x73, 0x63, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06,
+	0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26,
+	0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x64, 0x62, 0x5f, 0x75, 0x72, 0x69, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x62, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x63, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x69, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x73, 0x63, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xc3, 0x04, 0x0a, 0x17, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x79, 0x56, 0x45, 0x58, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x53, 0x0a, 0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x76, 0x65, 0x78, 0x5f, 0x6a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x10, 0x76, 0x65, 0x78, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x05, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x06, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x76, 0x65, 0x78, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xe2, 0x01, 0x0a, 0x1b,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x72, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x3d, 0x0a, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x22, 0xce, 0x02, 0x0a, 0x0e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x42, 0x61, 0x64, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x0d, 0x6a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0b,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x66, 0x22, 0xcf, 0x02, 0x0a, 0x0f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x47, 0x6f, 0x6f,
+	0x64, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x0a,
+	0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04,
+	0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01,
+	0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a, 0x75, 0x73, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x66, 0x22, 0x3b, 0x0a, 0x11, 0x53, 0x4c, 0x53, 0x41, 0x50, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0xc5, 0x04, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x53, 0x4c, 0x53, 0x41, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x47, 0x0a, 0x0a, 0x62, 0x75, 0x69,
+	0x6c, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x46, 0x72,
+	0x6f, 0x6d, 0x12, 0x42, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x62,
+	0x75, 0x69, 0x6c, 0x74, 0x42, 0x79, 0x12, 0x22, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x09, 0x70, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x4c, 0x53, 0x41, 0x50,
+	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x70, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x73, 0x6c, 0x73, 0x61, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x0b, 0x73, 0x6c, 0x73, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x04, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x73, 0x6c, 0x73, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xfb, 0x02, 0x0a, 0x0f, 0x48, 0x61, 0x73,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x6b, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x12, 0x3e, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x29, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x04, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66,
+	0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x22, 0x40, 0x0a, 0x12, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x72, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x9b, 0x04, 0x0a, 0x14, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x01, 0x52, 0x0e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x11,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x10, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2e,
+	0x0a, 0x10, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05,
+	0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26,
+	0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xbf, 0x05, 0x0a, 0x10, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x49, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2e, 0x0a, 0x10, 0x64,
+	0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65,
+	0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x11, 0x64,
+	0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x10, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x73, 0x12, 0x32, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x12, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52,
+	0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x05, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x06, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xba, 0x02, 0x0a, 0x0c, 0x50, 0x6b, 0x67,
+	0x45, 0x71, 0x75, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3f,
+	0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b,
+	0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88,
+	0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xd3, 0x02, 0x0a, 0x0d, 0x56, 0x75, 0x6c, 0x6e, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x0f,
+	0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x0f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d,
+	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xc2, 0x02, 0x0a, 0x0d,
+	0x48, 0x61, 0x73, 0x68, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x0d, 0x6a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0b, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66,
+	0x22, 0x93, 0x03, 0x0a, 0x0f, 0x48, 0x61, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x15, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29,
+	0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x06, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01,
+	0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x22, 0x99, 0x03, 0x0a, 0x12, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x13, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x51, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x72,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x17, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x6a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0b, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x22, 0xbb, 0x03, 0x0a, 0x19, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24,
+	0x0a, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x06, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x88, 0x01,
+	0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66,
+	0x22, 0x3a, 0x0a, 0x10, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb6, 0x01, 0x0a,
+	0x0e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a,
+	0x0a, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x0a, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x70, 0x61, 0x74, 0x68, 0x22, 0x79, 0x0a, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x7f, 0x0a, 0x10, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x7b, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x4c, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x77,
+	0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x15, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x03, 0x74, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x61, 0x67, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x7d, 0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x22, 0x50, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x22, 0x8e, 0x01, 0x0a, 0x07, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a,
+	0x0c, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x4c, 0x0a, 0x0f, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22,
+	0x8d, 0x01, 0x0a, 0x0d, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75,
+	0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x10, 0x76,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x64, 0x73, 0x22,
+	0x9b, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xa3, 0x01,
+	0x0a, 0x11, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x48, 0x00, 0x52, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x0c, 0x49, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22,
+	0xa6, 0x02, 0x0a, 0x0c, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x45, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52,
+	0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0x91, 0x04, 0x0a, 0x07, 0x48, 0x61, 0x73,
+	0x53, 0x42, 0x4f, 0x4d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x17,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0xa6, 0x02, 0x0a,
+	0x0c, 0x53, 0x63, 0x61, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x64, 0x62, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x62,
+	0x55, 0x72, 0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x62, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x62, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x72,
+	0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x55, 0x72, 0x69, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x63,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xf3, 0x01, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x79, 0x56, 0x75, 0x6c, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x4f, 0x0a, 0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdb, 0x03, 0x0a, 0x13,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x45, 0x58, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x4f, 0x0a, 0x0d,
+	0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0d,
+	0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x78, 0x5f, 0x6a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x76, 0x65, 0x78, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xe7, 0x01, 0x0a, 0x17, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x48, 0x00, 0x52, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0xa7, 0x02, 0x0a, 0x0a, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x42,
+	0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x4d, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x72,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xa8, 0x02,
+	0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x47, 0x6f, 0x6f, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0x37, 0x0a, 0x0d, 0x53, 0x4c, 0x53, 0x41,
+	0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xf0, 0x03, 0x0a, 0x04, 0x53, 0x4c, 0x53, 0x41, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x75,
+	0x69, 0x6c, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x3e, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x42, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x50,
+	0x0a, 0x0e, 0x73, 0x6c, 0x73, 0x61, 0x5f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x4c, 0x53, 0x41, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x0d, 0x73, 0x6c, 0x73, 0x61, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6c, 0x73, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6c, 0x73, 0x61, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x3b,
+	0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x66, 0x22, 0x8f, 0x01, 0x0a, 0x07, 0x48, 0x61, 0x73, 0x53, 0x4c, 0x53, 0x41,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x3e, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x34, 0x0a, 0x04, 0x73, 0x6c, 0x73, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x4c, 0x53, 0x41,
+	0x52, 0x04, 0x73, 0x6c, 0x73, 0x61, 0x22, 0xd4, 0x02, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0x3c, 0x0a,
+	0x0e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xe8, 0x02, 0x0a, 0x09,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73,
+	0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x63,
+	0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x72, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xa3, 0x01, 0x0a, 0x10, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72,
+	0x64, 0x52, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x22, 0xc7, 0x04, 0x0a,
+	0x0c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x45, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x4f, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64,
+	0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x11, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x52,
+	0x10, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x52, 0x12, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d,
+	0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xda, 0x01, 0x0a, 0x08, 0x50, 0x6b, 0x67, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x66, 0x22, 0xef, 0x01, 0x0a, 0x09, 0x56, 0x75, 0x6c, 0x6e, 0x45, 0x71, 0x75, 0x61,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x53, 0x0a, 0x0f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xde, 0x01, 0x0a, 0x09, 0x48, 0x61, 0x73, 0x68, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x42, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xcd, 0x02, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xca, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x66, 0x22, 0xcb, 0x02, 0x0a, 0x15, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4f, 0x0a,
+	0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x0d, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x66, 0x22, 0x6f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x71, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x75, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x73, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x73, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x7e, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x49, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x7d, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x73, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x61, 0x73, 0x53, 0x42, 0x4f, 0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73,
+	0x53, 0x42, 0x4f, 0x4d, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x7b, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x75, 0x6c, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x75, 0x6c,
+	0x6e, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x1f, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x45, 0x58, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x79, 0x56, 0x45, 0x58, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x79, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x42, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x42, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x42, 0x61, 0x64, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x7b, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x79, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x79, 0x47, 0x6f, 0x6f, 0x64, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x73, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x53, 0x4c, 0x53, 0x41, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x4c, 0x53, 0x41, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x7b, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x43, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61,
+	0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72,
+	0x64, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x7d, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x6c,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x75, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6b, 0x67, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x40, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x6b, 0x67, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x77, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x45, 0x71, 0x75, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x77, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x48, 0x61, 0x73, 0x68, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x7a, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x81,
+	0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x32, 0xee, 0x15, 0x0a, 0x0b, 0x47, 0x75, 0x61, 0x63, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x10, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x50, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x50, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74,
+	0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x12, 0x66, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x30,
+	0x01, 0x12, 0x69, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x73, 0x12, 0x30, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x65, 0x72, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x7a, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x30, 0x01, 0x12, 0x77, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x35,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x49, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x30,
+	0x01, 0x12, 0x75, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x73, 0x4f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x61, 0x73, 0x53, 0x42, 0x4f, 0x4d, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x53, 0x42, 0x4f,
+	0x4d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x42, 0x4f, 0x4d, 0x30, 0x01,
+	0x12, 0x72, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56,
+	0x75, 0x6c, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x75, 0x6c,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x75,
+	0x6c, 0x6e, 0x30, 0x01, 0x12, 0x8a, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x79, 0x56, 0x45, 0x58, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x3b, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x56, 0x45, 0x58, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x79, 0x56, 0x45, 0x58, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x30,
+	0x01, 0x12, 0x6f, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79,
+	0x42, 0x61, 0x64, 0x73, 0x12, 0x32, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x42, 0x61, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x42, 0x61, 0x64,
+	0x30, 0x01, 0x12, 0x72, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x79, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x33, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x47,
+	0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79,
+	0x47, 0x6f, 0x6f, 0x64, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61,
+	0x73, 0x53, 0x4c, 0x53, 0x41, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x53, 0x4c, 0x53, 0x41, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x4c, 0x53, 0x41, 0x30, 0x01, 0x12, 0x72,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
+	0x74, 0x73, 0x12, 0x33, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74,
+	0x30, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x38, 0x2e, 0x67,
+	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x72, 0x64, 0x30, 0x01, 0x12, 0x75, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x73, 0x12, 0x34, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x30, 0x01, 0x12, 0x69, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6b, 0x67, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x12, 0x30,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6b, 0x67, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6b,
+	0x67, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x75, 0x6c, 0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x12, 0x31, 0x2e, 0x67, 0x75, 0x61,
+	0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e,
+	0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x45,
+	0x71, 0x75, 0x61, 0x6c, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x12, 0x31, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x45, 0x71, 0x75,
+	0x61, 0x6c, 0x30, 0x01, 0x12, 0x70, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x61, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x7b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x36, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x30, 0x01, 0x12, 0x8e, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e,
+	0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x3c, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x31, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x30, 0x01, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2f, 0x67, 0x75, 0x61, 0x63, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x72, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x67, 0x75, 0x61, 0x63, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescOnce sync.Once
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescData = file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDesc
+)
+
+func file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescGZIP() []byte {
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescOnce.Do(func() {
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescData)
+	})
+	return file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDescData
+}
+
+var file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes = make([]protoimpl.MessageInfo, 138)
+var file_pkg_assembler_grpc_guacapi_guacapi_proto_goTypes = []interface{}{
+	(*PackageQualifierInputSpec)(nil),        // 0: guacsec.guac.assembler.api.PackageQualifierInputSpec
+	(*PkgInputSpec)(nil),                     // 1: guacsec.guac.assembler.api.PkgInputSpec
+	(*SourceInputSpec)(nil),                  // 2: guacsec.guac.assembler.api.SourceInputSpec
+	(*ArtifactInputSpec)(nil),                // 3: guacsec.guac.assembler.api.ArtifactInputSpec
+	(*BuilderInputSpec)(nil),                 // 4: guacsec.guac.assembler.api.BuilderInputSpec
+	(*VulnerabilityInputSpec)(nil),           // 5: guacsec.guac.assembler.api.VulnerabilityInputSpec
+	(*LicenseInputSpec)(nil),                 // 6: guacsec.guac.assembler.api.LicenseInputSpec
+	(*CertifyBadInputSpec)(nil),              // 7: guacsec.guac.assembler.api.CertifyBadInputSpec
+	(*CertifyGoodInputSpec)(nil),             // 8: guacsec.guac.assembler.api.CertifyGoodInputSpec
+	(*CertifyLegalInputSpec)(nil),            // 9: guacsec.guac.assembler.api.CertifyLegalInputSpec
+	(*ScorecardCheckInputSpec)(nil),          // 10: guacsec.guac.assembler.api.ScorecardCheckInputSpec
+	(*ScorecardInputSpec)(nil),               // 11: guacsec.guac.assembler.api.ScorecardInputSpec
+	(*ScanMetadataInput)(nil),                // 12: guacsec.guac.assembler.api.ScanMetadataInput
+	(*HasMetadataInputSpec)(nil),             // 13: guacsec.guac.assembler.api.HasMetadataInputSpec
+	(*HasSBOMInputSpec)(nil),                 // 14: guacsec.guac.assembler.api.HasSBOMInputSpec
+	(*SLSAPredicateInputSpec)(nil),           // 15: guacsec.guac.assembler.api.SLSAPredicateInputSpec
+	(*SLSAInputSpec)(nil),                    // 16: guacsec.guac.assembler.api.SLSAInputSpec
+	(*HasSourceAtInputSpec)(nil),             // 17: guacsec.guac.assembler.api.HasSourceAtInputSpec
+	(*HashEqualInputSpec)(nil),               // 18: guacsec.guac.assembler.api.HashEqualInputSpec
+	(*IsDependencyInputSpec)(nil),            // 19: guacsec.guac.assembler.api.IsDependencyInputSpec
+	(*IsOccurrenceInputSpec)(nil),            // 20: guacsec.guac.assembler.api.IsOccurrenceInputSpec
+	(*PkgEqualInputSpec)(nil),                // 21: guacsec.guac.assembler.api.PkgEqualInputSpec
+	(*PointOfContactInputSpec)(nil),          // 22: guacsec.guac.assembler.api.PointOfContactInputSpec
+	(*VexStatementInputSpec)(nil),            // 23: guacsec.guac.assembler.api.VexStatementInputSpec
+	(*VulnEqualInputSpec)(nil),               // 24: guacsec.guac.assembler.api.VulnEqualInputSpec
+	(*VulnerabilityMetadataInputSpec)(nil),   // 25: guacsec.guac.assembler.api.VulnerabilityMetadataInputSpec
+	(*DocumentInputSpec)(nil),                // 26: guacsec.guac.assembler.api.DocumentInputSpec
+	(*CertifyScorecardIngest)(nil),           // 27: guacsec.guac.assembler.api.CertifyScorecardIngest
+	(*IsDependencyIngest)(nil),               // 28: guacsec.guac.assembler.api.IsDependencyIngest
+	(*IsOccurrenceIngest)(nil),               // 29: guacsec.guac.assembler.api.IsOccurrenceIngest
+	(*HasSlsaIngest)(nil),                    // 30: guacsec.guac.assembler.api.HasSlsaIngest
+	(*CertifyVulnIngest)(nil),                // 31: guacsec.guac.assembler.api.CertifyVulnIngest
+	(*VulnEqualIngest)(nil),                  // 32: guacsec.guac.assembler.api.VulnEqualIngest
+	(*HasSourceAtIngest)(nil),                // 33: guacsec.guac.assembler.api.HasSourceAtIngest
+	(*CertifyBadIngest)(nil),                 // 34: guacsec.guac.assembler.api.CertifyBadIngest
+	(*CertifyGoodIngest)(nil),                // 35: guacsec.guac.assembler.api.CertifyGoodIngest
+	(*HasSBOMIngest)(nil),                    // 36: guacsec.guac.assembler.api.HasSBOMIngest
+	(*HasSBOMIncludesInputSpec)(nil),         // 37: guacsec.guac.assembler.api.HasSBOMIncludesInputSpec
+	(*HashEqualIngest)(nil),                  // 38: guacsec.guac.assembler.api.HashEqualIngest
+	(*PkgEqualIngest)(nil),                   // 39: guacsec.guac.assembler.api.PkgEqualIngest
+	(*VexIngest)(nil),                        // 40: guacsec.guac.assembler.api.VexIngest
+	(*PointOfContactIngest)(nil),             // 41: guacsec.guac.assembler.api.PointOfContactIngest
+	(*VulnMetadataIngest)(nil),               // 42: guacsec.guac.assembler.api.VulnMetadataIngest
+	(*HasMetadataIngest)(nil),                // 43: guacsec.guac.assembler.api.HasMetadataIngest
+	(*CertifyLegalIngest)(nil),               // 44: guacsec.guac.assembler.api.CertifyLegalIngest
+	(*IngestPredicates)(nil),                 // 45: guacsec.guac.assembler.api.IngestPredicates
+	(*IngestPredicatesRequest)(nil),          // 46: guacsec.guac.assembler.api.IngestPredicatesRequest
+	(*IngestPredicatesResponse)(nil),         // 47: guacsec.guac.assembler.api.IngestPredicatesResponse
+	(*PackageQualifierSpec)(nil),             // 48: guacsec.guac.assembler.api.PackageQualifierSpec
+	(*PkgSpec)(nil),                          // 49: guacsec.guac.assembler.api.PkgSpec
+	(*SourceSpec)(nil),                       // 50: guacsec.guac.assembler.api.SourceSpec
+	(*ArtifactSpec)(nil),                     // 51: guacsec.guac.assembler.api.ArtifactSpec
+	(*BuilderSpec)(nil),                      // 52: guacsec.guac.assembler.api.BuilderSpec
+	(*LicenseSpec)(nil),                      // 53: guacsec.guac.assembler.api.LicenseSpec
+	(*VulnerabilitySpec)(nil),                // 54: guacsec.guac.assembler.api.VulnerabilitySpec
+	(*PackageOrSourceSpec)(nil),              // 55: guacsec.guac.assembler.api.PackageOrSourceSpec
+	(*PackageOrArtifactSpec)(nil),            // 56: guacsec.guac.assembler.api.PackageOrArtifactSpec
+	(*IsDependencySpec)(nil),                 // 57: guacsec.guac.assembler.api.IsDependencySpec
+	(*IsOccurrenceSpec)(nil),                 // 58: guacsec.guac.assembler.api.IsOccurrenceSpec
+	(*HasSBOMSpec)(nil),                      // 59: guacsec.guac.assembler.api.HasSBOMSpec
+	(*CertifyVulnSpec)(nil),                  // 60: guacsec.guac.assembler.api.CertifyVulnSpec
+	(*CertifyVEXStatementSpec)(nil),          // 61: guacsec.guac.assembler.api.CertifyVEXStatementSpec
+	(*PackageSourceOrArtifactSpec)(nil),      // 62: guacsec.guac.assembler.api.PackageSourceOrArtifactSpec
+	(*CertifyBadSpec)(nil),                   // 63: guacsec.guac.assembler.api.CertifyBadSpec
+	(*CertifyGoodSpec)(nil),                  // 64: guacsec.guac.assembler.api.CertifyGoodSpec
+	(*SLSAPredicateSpec)(nil),                // 65: guacsec.guac.assembler.api.SLSAPredicateSpec
+	(*HasSLSASpec)(nil),                      // 66: guacsec.guac.assembler.api.HasSLSASpec
+	(*HasSourceAtSpec)(nil),                  // 67: guacsec.guac.assembler.api.HasSourceAtSpec
+	(*ScorecardCheckSpec)(nil),               // 68: guacsec.guac.assembler.api.ScorecardCheckSpec
+	(*CertifyScorecardSpec)(nil),             // 69: guacsec.guac.assembler.api.CertifyScorecardSpec
+	(*CertifyLegalSpec)(nil),                 // 70: guacsec.guac.assembler.api.CertifyLegalSpec
+	(*PkgEqualSpec)(nil),                     // 71: guacsec.guac.assembler.api.PkgEqualSpec
+	(*VulnEqualSpec)(nil),                    // 72: guacsec.guac.assembler.api.VulnEqualSpec
+	(*HashEqualSpec)(nil),                    // 73: guacsec.guac.assembler.api.HashEqualSpec
+	(*HasMetadataSpec)(nil),                  // 74: guacsec.guac.assembler.api.HasMetadataSpec
+	(*PointOfContactSpec)(nil),               // 75: guacsec.guac.assembler.api.PointOfContactSpec
+	(*VulnerabilityMetadataSpec)(nil),        // 76: guacsec.guac.assembler.api.VulnerabilityMetadataSpec
+	(*PackageQualifier)(nil),                 // 77: guacsec.guac.assembler.api.PackageQualifier
+	(*PackageVersion)(nil),                   // 78: guacsec.guac.assembler.api.PackageVersion
+	(*PackageName)(nil),                      // 79: guacsec.guac.assembler.api.PackageName
+	(*PackageNamespace)(nil),                 // 80: guacsec.guac.assembler.api.PackageNamespace
+	(*Package)(nil),                          // 81: guacsec.guac.assembler.api.Package
+	(*SourceName)(nil),                       // 82: guacsec.guac.assembler.api.SourceName
+	(*SourceNamespace)(nil),                  // 83: guacsec.guac.assembler.api.SourceNamespace
+	(*Source)(nil),                           // 84: guacsec.guac.assembler.api.Source
+	(*Artifact)(nil),                         // 85: guacsec.guac.assembler.api.Artifact
+	(*Builder)(nil),                          // 86: guacsec.guac.assembler.api.Builder
+	(*License)(nil),                          // 87: guacsec.guac.assembler.api.License
+	(*VulnerabilityID)(nil),                  // 88: guacsec.guac.assembler.api.VulnerabilityID
+	(*Vulnerability)(nil),                    // 89: guacsec.guac.assembler.api.Vulnerability
+	(*PackageOrSource)(nil),                  // 90: guacsec.guac.assembler.api.PackageOrSource
+	(*PackageOrArtifact)(nil),                // 91: guacsec.guac.assembler.api.PackageOrArtifact
+	(*IsDependency)(nil),                     // 92: guacsec.guac.assembler.api.IsDependency
+	(*IsOccurrence)(nil),                     // 93: guacsec.guac.assembler.api.IsOccurrence
+	(*HasSBOM)(nil),                          // 94: guacsec.guac.assembler.api.HasSBOM
+	(*ScanMetadata)(nil),                     // 95: guacsec.guac.assembler.api.ScanMetadata
+	(*CertifyVuln)(nil),                      // 96: guacsec.guac.assembler.api.CertifyVuln
+	(*CertifyVEXStatement)(nil),              // 97: guacsec.guac.assembler.api.CertifyVEXStatement
+	(*PackageSourceOrArtifact)(nil),          // 98: guacsec.guac.assembler.api.PackageSourceOrArtifact
+	(*CertifyBad)(nil),                       // 99: guacsec.guac.assembler.api.CertifyBad
+	(*CertifyGood)(nil),                      // 100: guacsec.guac.assembler.api.CertifyGood
+	(*SLSAPredicate)(nil),                    // 101: guacsec.guac.assembler.api.SLSAPredicate
+	(*SLSA)(nil),                             // 102: guacsec.guac.assembler.api.SLSA
+	(*HasSLSA)(nil),                          // 103: guacsec.guac.assembler.api.HasSLSA
+	(*HasSourceAt)(nil),                      // 104: guacsec.guac.assembler.api.HasSourceAt
+	(*ScorecardCheck)(nil),                   // 105: guacsec.guac.assembler.api.ScorecardCheck
+	(*Scorecard)(nil),                        // 106: guacsec.guac.assembler.api.Scorecard
+	(*CertifyScorecard)(nil),                 // 107: guacsec.guac.assembler.api.CertifyScorecard
+	(*CertifyLegal)(nil),                     // 108: guacsec.guac.assembler.api.CertifyLegal
+	(*PkgEqual)(nil),                         // 109: guacsec.guac.assembler.api.PkgEqual
+	(*VulnEqual)(nil),                        // 110: guacsec.guac.assembler.api.VulnEqual
+	(*HashEqual)(nil),                        // 111: guacsec.guac.assembler.api.HashEqual
+	(*HasMetadata)(nil),                      // 112: guacsec.guac.assembler.api.HasMetadata
+	(*PointOfContact)(nil),                   // 113: guacsec.guac.assembler.api.PointOfContact
+	(*VulnerabilityMetadata)(nil),            // 114: guacsec.guac.assembler.api.VulnerabilityMetadata
+	(*ListPackagesRequest)(nil),              // 115: guacsec.guac.assembler.api.ListPackagesRequest
+	(*ListSourcesRequest)(nil),               // 116: guacsec.guac.assembler.api.ListSourcesRequest
+	(*ListArtifactsRequest)(nil),             // 117: guacsec.guac.assembler.api.ListArtifactsRequest
+	(*ListBuildersRequest)(nil),              // 118: guacsec.guac.assembler.api.ListBuildersRequest
+	(*ListLicensesRequest)(nil),              // 119: guacsec.guac.assembler.api.ListLicensesRequest
+	(*ListVulnerabilitiesRequest)(nil),       // 120: guacsec.guac.assembler.api.ListVulnerabilitiesRequest
+	(*ListIsDependenciesRequest)(nil),        // 121: guacsec.guac.assembler.api.ListIsDependenciesRequest
+	(*ListIsOccurrencesRequest)(nil),         // 122: guacsec.guac.assembler.api.ListIsOccurrencesRequest
+	(*ListHasSBOMsRequest)(nil),              // 123: guacsec.guac.assembler.api.ListHasSBOMsRequest
+	(*ListCertifyVulnsRequest)(nil),          // 124: guacsec.guac.assembler.api.ListCertifyVulnsRequest
+	(*ListCertifyVEXStatementsRequest)(nil),  // 125: guacsec.guac.assembler.api.ListCertifyVEXStatementsRequest
+	(*ListCertifyBadsRequest)(nil),           // 126: guacsec.guac.assembler.api.ListCertifyBadsRequest
+	(*ListCertifyGoodsRequest)(nil),          // 127: guacsec.guac.assembler.api.ListCertifyGoodsRequest
+	(*ListHasSLSAsRequest)(nil),              // 128: guacsec.guac.assembler.api.ListHasSLSAsRequest
+	(*ListHasSourceAtsRequest)(nil),          // 129: guacsec.guac.assembler.api.ListHasSourceAtsRequest
+	(*ListCertifyScorecardsRequest)(nil),     // 130: guacsec.guac.assembler.api.ListCertifyScorecardsRequest
+	(*ListCertifyLegalsRequest)(nil),         // 131: guacsec.guac.assembler.api.ListCertifyLegalsRequest
+	(*ListPkgEqualsRequest)(nil),             // 132: guacsec.guac.assembler.api.ListPkgEqualsRequest
+	(*ListVulnEqualsRequest)(nil),            // 133: guacsec.guac.assembler.api.ListVulnEqualsRequest
+	(*ListHashEqualsRequest)(nil),            // 134: guacsec.guac.assembler.api.ListHashEqualsRequest
+	(*ListHasMetadataRequest)(nil),           // 135: guacsec.guac.assembler.api.ListHasMetadataRequest
+	(*ListPointOfContactsRequest)(nil),       // 136: guacsec.guac.assembler.api.ListPointOfContactsRequest
+	(*ListVulnerabilityMetadataRequest)(nil), // 137: guacsec.guac.assembler.api.ListVulnerabilityMetadataRequest
+	(*timestamppb.Timestamp)(nil),            // 138: google.protobuf.Timestamp
+}
+var file_pkg_assembler_grpc_guacapi_guacapi_proto_depIdxs = []int32{
+	0,   // 0: guacsec.guac.assembler.api.PkgInputSpec.qualifiers:type_name -> guacsec.guac.assembler.api.PackageQualifierInputSpec
+	138, // 1: guacsec.guac.assembler.api.CertifyBadInputSpec.known_since:type_name -> google.protobuf.Timestamp
+	138, // 2: guacsec.guac.assembler.api.CertifyGoodInputSpec.known_since:type_name -> google.protobuf.Timestamp
+	138, // 3: guacsec.guac.assembler.api.CertifyLegalInputSpec.time_scanned:type_name -> google.protobuf.Timestamp
+	10,  // 4: guacsec.guac.assembler.api.ScorecardInputSpec.checks:type_name -> guacsec.guac.assembler.api.ScorecardCheckInputSpec
+	138, // 5: guacsec.guac.assembler.api.ScorecardInputSpec.time_scanned:type_name -> google.protobuf.Timestamp
+	138, // 6: guacsec.guac.assembler.api.ScanMetadataInput.time_scanned:type_name -> google.protobuf.Timestamp
+	138, // 7: guacsec.guac.assembler.api.HasMetadataInputSpec.timestamp:type_name -> google.protobuf.Timestamp
+	138, // 8: guacsec.guac.assembler.api.HasSBOMInputSpec.known_since:type_name -> google.protobuf.Timestamp
+	15,  // 9: guacsec.guac.assembler.api.SLSAInputSpec.slsa_predicate:type_name -> guacsec.guac.assembler.api.SLSAPredicateInputSpec
+	138, // 10: guacsec.guac.assembler.api.SLSAInputSpec.started_on:type_name -> google.protobuf.Timestamp
+	138, // 11: guacsec.guac.assembler.api.SLSAInputSpec.finished_on:type_name -> google.protobuf.Timestamp
+	138, // 12: guacsec.guac.assembler.api.HasSourceAtInputSpec.known_since:type_name -> google.protobuf.Timestamp
+	138, // 13: guacsec.guac.assembler.api.PointOfContactInputSpec.since:type_name -> google.protobuf.Timestamp
+	138, // 14: guacsec.guac.assembler.api.VexStatementInputSpec.known_since:type_name -> google.protobuf.Timestamp
+	138, // 15: guacsec.guac.assembler.api.VulnerabilityMetadataInputSpec.timestamp:type_name -> google.protobuf.Timestamp
+	138, // 16: guacsec.guac.assembler.api.DocumentInputSpec.ingested_at:type_name -> google.protobuf.Timestamp
+	2,   // 17: guacsec.guac.assembler.api.CertifyScorecardIngest.source:type_name -> guacsec.guac.assembler.api.SourceInputSpec
+	11,  // 18: guacsec.guac.assembler.api.CertifyScorecardIngest.scorecard:type_name -> guacsec.guac.assembler.api.ScorecardInputSpec
+	1,   // 19: guacsec.guac.assembler.api.IsDependencyIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	1,   // 20: guacsec.guac.assembler.api.IsDependencyIngest.dep_pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	19,  // 21: guacsec.guac.assembler.api.IsDependencyIngest.is_dependency:type_name -> guacsec.guac.assembler.api.IsDependencyInputSpec
+	1,   // 22: guacsec.guac.assembler.api.IsOccurrenceIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	2,   // 23: guacsec.guac.assembler.api.IsOccurrenceIngest.src:type_name -> guacsec.guac.assembler.api.SourceInputSpec
+	3,   // 24: guacsec.guac.assembler.api.IsOccurrenceIngest.artifact:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	20,  // 25: guacsec.guac.assembler.api.IsOccurrenceIngest.is_occurrence:type_name -> guacsec.guac.assembler.api.IsOccurrenceInputSpec
+	3,   // 26: guacsec.guac.assembler.api.HasSlsaIngest.artifact:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	16,  // 27: guacsec.guac.assembler.api.HasSlsaIngest.has_slsa:type_name -> guacsec.guac.assembler.api.SLSAInputSpec
+	3,   // 28: guacsec.guac.assembler.api.HasSlsaIngest.materials:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	4,   // 29: guacsec.guac.assembler.api.HasSlsaIngest.builder:type_name -> guacsec.guac.assembler.api.BuilderInputSpec
+	1,   // 30: guacsec.guac.assembler.api.CertifyVulnIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	5,   // 31: guacsec.guac.assembler.api.CertifyVulnIngest.vulnerability:type_name -> guacsec.guac.assembler.api.VulnerabilityInputSpec
+	12,  // 32: guacsec.guac.assembler.api.CertifyVulnIngest.vuln_data:type_name -> guacsec.guac.assembler.api.ScanMetadataInput
+	5,   // 33: guacsec.guac.assembler.api.VulnEqualIngest.vulnerability:type_name -> guacsec.guac.assembler.api.VulnerabilityInputSpec
+	5,   // 34: guacsec.guac.assembler.api.VulnEqualIngest.equal_vulnerability:type_name -> guacsec.guac.assembler.api.VulnerabilityInputSpec
+	24,  // 35: guacsec.guac.assembler.api.VulnEqualIngest.vuln_equal:type_name -> guacsec.guac.assembler.api.VulnEqualInputSpec
+	1,   // 36: guacsec.guac.assembler.api.HasSourceAtIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	2,   // 37: guacsec.guac.assembler.api.HasSourceAtIngest.src:type_name -> guacsec.guac.assembler.api.SourceInputSpec
+	17,  // 38: guacsec.guac.assembler.api.HasSourceAtIngest.has_source_at:type_name -> guacsec.guac.assembler.api.HasSourceAtInputSpec
+	1,   // 39: guacsec.guac.assembler.api.CertifyBadIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	2,   // 40: guacsec.guac.assembler.api.CertifyBadIngest.src:type_name -> guacsec.guac.assembler.api.SourceInputSpec
+	3,   // 41: guacsec.guac.assembler.api.CertifyBadIngest.artifact:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	7,   // 42: guacsec.guac.assembler.api.CertifyBadIngest.certify_bad:type_name -> guacsec.guac.assembler.api.CertifyBadInputSpec
+	1,   // 43: guacsec.guac.assembler.api.CertifyGoodIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	2,   // 44: guacsec.guac.assembler.api.CertifyGoodIngest.src:type_name -> guacsec.guac.assembler.api.SourceInputSpec
+	3,   // 45: guacsec.guac.assembler.api.CertifyGoodIngest.artifact:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	8,   // 46: guacsec.guac.assembler.api.CertifyGoodIngest.certify_good:type_name -> guacsec.guac.assembler.api.CertifyGoodInputSpec
+	1,   // 47: guacsec.guac.assembler.api.HasSBOMIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	3,   // 48: guacsec.guac.assembler.api.HasSBOMIngest.artifact:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	14,  // 49: guacsec.guac.assembler.api.HasSBOMIngest.has_sbom:type_name -> guacsec.guac.assembler.api.HasSBOMInputSpec
+	37,  // 50: guacsec.guac.assembler.api.HasSBOMIngest.includes:type_name -> guacsec.guac.assembler.api.HasSBOMIncludesInputSpec
+	3,   // 51: guacsec.guac.assembler.api.HashEqualIngest.artifact:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	3,   // 52: guacsec.guac.assembler.api.HashEqualIngest.equal_artifact:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	18,  // 53: guacsec.guac.assembler.api.HashEqualIngest.hash_equal:type_name -> guacsec.guac.assembler.api.HashEqualInputSpec
+	1,   // 54: guacsec.guac.assembler.api.PkgEqualIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	1,   // 55: guacsec.guac.assembler.api.PkgEqualIngest.equal_pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	21,  // 56: guacsec.guac.assembler.api.PkgEqualIngest.pkg_equal:type_name -> guacsec.guac.assembler.api.PkgEqualInputSpec
+	1,   // 57: guacsec.guac.assembler.api.VexIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	3,   // 58: guacsec.guac.assembler.api.VexIngest.artifact:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	5,   // 59: guacsec.guac.assembler.api.VexIngest.vulnerability:type_name -> guacsec.guac.assembler.api.VulnerabilityInputSpec
+	23,  // 60: guacsec.guac.assembler.api.VexIngest.vex_data:type_name -> guacsec.guac.assembler.api.VexStatementInputSpec
+	1,   // 61: guacsec.guac.assembler.api.PointOfContactIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	2,   // 62: guacsec.guac.assembler.api.PointOfContactIngest.src:type_name -> guacsec.guac.assembler.api.SourceInputSpec
+	3,   // 63: guacsec.guac.assembler.api.PointOfContactIngest.artifact:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	22,  // 64: guacsec.guac.assembler.api.PointOfContactIngest.point_of_contact:type_name -> guacsec.guac.assembler.api.PointOfContactInputSpec
+	5,   // 65: guacsec.guac.assembler.api.VulnMetadataIngest.vulnerability:type_name -> guacsec.guac.assembler.api.VulnerabilityInputSpec
+	25,  // 66: guacsec.guac.assembler.api.VulnMetadataIngest.vuln_metadata:type_name -> guacsec.guac.assembler.api.VulnerabilityMetadataInputSpec
+	1,   // 67: guacsec.guac.assembler.api.HasMetadataIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	2,   // 68: guacsec.guac.assembler.api.HasMetadataIngest.src:type_name -> guacsec.guac.assembler.api.SourceInputSpec
+	3,   // 69: guacsec.guac.assembler.api.HasMetadataIngest.artifact:type_name -> guacsec.guac.assembler.api.ArtifactInputSpec
+	13,  // 70: guacsec.guac.assembler.api.HasMetadataIngest.has_metadata:type_name -> guacsec.guac.assembler.api.HasMetadataInputSpec
+	1,   // 71: guacsec.guac.assembler.api.CertifyLegalIngest.pkg:type_name -> guacsec.guac.assembler.api.PkgInputSpec
+	2,   // 72: guacsec.guac.assembler.api.CertifyLegalIngest.src:type_name -> guacsec.guac.assembler.api.SourceInputSpec
+	6,   // 73: guacsec.guac.assembler.api.CertifyLegalIngest.declared:type_name -> guacsec.guac.assembler.api.LicenseInputSpec
+	6,   // 74: guacsec.guac.assembler.api.CertifyLegalIngest.discovered:type_name -> guacsec.guac.assembler.api.LicenseInputSpec
+	9,   // 75: guacsec.guac.assembler.api.CertifyLegalIngest.certify_legal:type_name -> guacsec.guac.assembler.api.CertifyLegalInputSpec
+	27,  // 76: guacsec.guac.assembler.api.IngestPredicates.certify_scorecard:type_name -> guacsec.guac.assembler.api.CertifyScorecardIngest
+	28,  // 77: guacsec.guac.assembler.api.IngestPredicates.is_dependency:type_name -> guacsec.guac.assembler.api.IsDependencyIngest
+	29,  // 78: guacsec.guac.assembler.api.IngestPredicates.is_occurrence:type_name -> guacsec.guac.assembler.api.IsOccurrenceIngest
+	30,  // 79: guacsec.guac.assembler.api.IngestPredicates.has_slsa:type_name -> guacsec.guac.assembler.api.HasSlsaIngest
+	31,  // 80: guacsec.guac.assembler.api.IngestPredicates.certify_vuln:type_name -> guacsec.guac.assembler.api.CertifyVulnIngest
+	32,  // 81: guacsec.guac.assembler.api.IngestPredicates.vuln_equal:type_name -> guacsec.guac.assembler.api.VulnEqualIngest
+	33,  // 82: guacsec.guac.assembler.api.IngestPredicates.has_source_at:type_name -> guacsec.guac.assembler.api.HasSourceAtIngest
+	34,  // 83: guacsec.guac.assembler.api.IngestPredicates.certify_bad:type_name -> guacsec.guac.assembler.api.CertifyBadIngest
+	35,  // 84: guacsec.guac.assembler.api.IngestPredicates.certify_good:type_name -> guacsec.guac.assembler.api.CertifyGoodIngest
+	36,  // 85: guacsec.guac.assembler.api.IngestPredicates.has_sbom:type_name -> guacsec.guac.assembler.api.HasSBOMIngest
+	38,  // 86: guacsec.guac.assembler.api.IngestPredicates.hash_equal:type_name -> guacsec.guac.assembler.api.HashEqualIngest
+	39,  // 87: guacsec.guac.assembler.api.IngestPredicates.pkg_equal:type_name -> guacsec.guac.assembler.api.PkgEqualIngest
+	40,  // 88: guacsec.guac.assembler.api.IngestPredicates.vex:type_name -> guacsec.guac.assembler.api.VexIngest
+	41,  // 89: guacsec.guac.assembler.api.IngestPredicates.point_of_contact:type_name -> guacsec.guac.assembler.api.PointOfContactIngest
+	42,  // 90: guacsec.guac.assembler.api.IngestPredicates.vuln_metadata:type_name -> guacsec.guac.assembler.api.VulnMetadataIngest
+	43,  // 91: guacsec.guac.assembler.api.IngestPredicates.has_metadata:type_name -> guacsec.guac.assembler.api.HasMetadataIngest
+	44,  // 92: guacsec.guac.assembler.api.IngestPredicates.certify_legal:type_name -> guacsec.guac.assembler.api.CertifyLegalIngest
+	26,  // 93: guacsec.guac.assembler.api.IngestPredicates.documents:type_name -> guacsec.guac.assembler.api.DocumentInputSpec
+	45,  // 94: guacsec.guac.assembler.api.IngestPredicatesRequest.predicates:type_name -> guacsec.guac.assembler.api.IngestPredicates
+	48,  // 95: guacsec.guac.assembler.api.PkgSpec.qualifiers:type_name -> guacsec.guac.assembler.api.PackageQualifierSpec
+	49,  // 96: guacsec.guac.assembler.api.PackageOrSourceSpec.package:type_name -> guacsec.guac.assembler.api.PkgSpec
+	50,  // 97: guacsec.guac.assembler.api.PackageOrSourceSpec.source:type_name -> guacsec.guac.assembler.api.SourceSpec
+	49,  // 98: guacsec.guac.assembler.api.PackageOrArtifactSpec.package:type_name -> guacsec.guac.assembler.api.PkgSpec
+	51,  // 99: guacsec.guac.assembler.api.PackageOrArtifactSpec.artifact:type_name -> guacsec.guac.assembler.api.ArtifactSpec
+	49,  // 100: guacsec.guac.assembler.api.IsDependencySpec.package:type_name -> guacsec.guac.assembler.api.PkgSpec
+	49,  // 101: guacsec.guac.assembler.api.IsDependencySpec.dependency_package:type_name -> guacsec.guac.assembler.api.PkgSpec
+	55,  // 102: guacsec.guac.assembler.api.IsOccurrenceSpec.subject:type_name -> guacsec.guac.assembler.api.PackageOrSourceSpec
+	51,  // 103: guacsec.guac.assembler.api.IsOccurrenceSpec.artifact:type_name -> guacsec.guac.assembler.api.ArtifactSpec
+	56,  // 104: guacsec.guac.assembler.api.HasSBOMSpec.subject:type_name -> guacsec.guac.assembler.api.PackageOrArtifactSpec
+	49,  // 105: guacsec.guac.assembler.api.CertifyVulnSpec.package:type_name -> guacsec.guac.assembler.api.PkgSpec
+	54,  // 106: guacsec.guac.assembler.api.CertifyVulnSpec.vulnerability:type_name -> guacsec.guac.assembler.api.VulnerabilitySpec
+	56,  // 107: guacsec.guac.assembler.api.CertifyVEXStatementSpec.subject:type_name -> guacsec.guac.assembler.api.PackageOrArtifactSpec
+	54,  // 108: guacsec.guac.assembler.api.CertifyVEXStatementSpec.vulnerability:type_name -> guacsec.guac.assembler.api.VulnerabilitySpec
+	49,  // 109: guacsec.guac.assembler.api.PackageSourceOrArtifactSpec.package:type_name -> guacsec.guac.assembler.api.PkgSpec
+	50,  // 110: guacsec.guac.assembler.api.PackageSourceOrArtifactSpec.source:type_name -> guacsec.guac.assembler.api.SourceSpec
+	51,  // 111: guacsec.guac.assembler.api.PackageSourceOrArtifactSpec.artifact:type_name -> guacsec.guac.assembler.api.ArtifactSpec
+	62,  // 112: guacsec.guac.assembler.api.CertifyBadSpec.subject:type_name -> guacsec.guac.assembler.api.PackageSourceOrArtifactSpec
+	62,  // 113: guacsec.guac.assembler.api.CertifyGoodSpec.subject:type_name -> guacsec.guac.assembler.api.PackageSourceOrArtifactSpec
+	51,  // 114: guacsec.guac.assembler.api.HasSLSASpec.subject:type_name -> guacsec.guac.assembler.api.ArtifactSpec
+	51,  // 115: guacsec.guac.assembler.api.HasSLSASpec.built_from:type_name -> guacsec.guac.assembler.api.ArtifactSpec
+	52,  // 116: guacsec.guac.assembler.api.HasSLSASpec.built_by:type_name -> guacsec.guac.assembler.api.BuilderSpec
+	65,  // 117: guacsec.guac.assembler.api.HasSLSASpec.predicate:type_name -> guacsec.guac.assembler.api.SLSAPredicateSpec
+	49,  // 118: guacsec.guac.assembler.api.HasSourceAtSpec.package:type_name -> guacsec.guac.assembler.api.PkgSpec
+	50,  // 119: guacsec.guac.assembler.api.HasSourceAtSpec.source:type_name -> guacsec.guac.assembler.api.SourceSpec
+	50,  // 120: guacsec.guac.assembler.api.CertifyScorecardSpec.source:type_name -> guacsec.guac.assembler.api.SourceSpec
+	68,  // 121: guacsec.guac.assembler.api.CertifyScorecardSpec.checks:type_name -> guacsec.guac.assembler.api.ScorecardCheckSpec
+	55,  // 122: guacsec.guac.assembler.api.CertifyLegalSpec.subject:type_name -> guacsec.guac.assembler.api.PackageOrSourceSpec
+	53,  // 123: guacsec.guac.assembler.api.CertifyLegalSpec.declared_licenses:type_name -> guacsec.guac.assembler.api.LicenseSpec
+	53,  // 124: guacsec.guac.assembler.api.CertifyLegalSpec.discovered_licenses:type_name -> guacsec.guac.assembler.api.LicenseSpec
+	49,  // 125: guacsec.guac.assembler.api.PkgEqualSpec.packages:type_name -> guacsec.guac.assembler.api.PkgSpec
+	54,  // 126: guacsec.guac.assembler.api.VulnEqualSpec.vulnerabilities:type_name -> guacsec.guac.assembler.api.VulnerabilitySpec
+	51,  // 127: guacsec.guac.assembler.api.HashEqualSpec.artifacts:type_name -> guacsec.guac.assembler.api.ArtifactSpec
+	62,  // 128: guacsec.guac.assembler.api.HasMetadataSpec.subject:type_name -> guacsec.guac.assembler.api.PackageSourceOrArtifactSpec
+	62,  // 129: guacsec.guac.assembler.api.PointOfContactSpec.subject:type_name -> guacsec.guac.assembler.api.PackageSourceOrArtifactSpec
+	54,  // 130: guacsec.guac.assembler.api.VulnerabilityMetadataSpec.vulnerability:type_name -> guacsec.guac.assembler.api.VulnerabilitySpec
+	77,  // 131: guacsec.guac.assembler.api.PackageVersion.qualifiers:type_name -> guacsec.guac.assembler.api.PackageQualifier
+	78,  // 132: guacsec.guac.assembler.api.PackageName.versions:type_name -> guacsec.guac.assembler.api.PackageVersion
+	79,  // 133: guacsec.guac.assembler.api.PackageNamespace.names:type_name -> guacsec.guac.assembler.api.PackageName
+	80,  // 134: guacsec.guac.assembler.api.Package.namespaces:type_name -> guacsec.guac.assembler.api.PackageNamespace
+	82,  // 135: guacsec.guac.assembler.api.SourceNamespace.names:type_name -> guacsec.guac.assembler.api.SourceName
+	83,  // 136: guacsec.guac.assembler.api.Source.namespaces:type_name -> guacsec.guac.assembler.api.SourceNamespace
+	88,  // 137: guacsec.guac.assembler.api.Vulnerability.vulnerability_ids:type_name -> guacsec.guac.assembler.api.VulnerabilityID
+	81,  // 138: guacsec.guac.assembler.api.PackageOrSource.package:type_name -> guacsec.guac.assembler.api.Package
+	84,  // 139: guacsec.guac.assembler.api.PackageOrSource.source:type_name -> guacsec.guac.assembler.api.Source
+	81,  // 140: guacsec.guac.assembler.api.PackageOrArtifact.package:type_name -> guacsec.guac.assembler.api.Package
+	85,  // 141: guacsec.guac.assembler.api.PackageOrArtifact.artifact:type_name -> guacsec.guac.assembler.api.Artifact
+	81,  // 142: guacsec.guac.assembler.api.IsDependency.package:type_name -> guacsec.guac.assembler.api.Package
+	81,  // 143: guacsec.guac.assembler.api.IsDependency.dependency_package:type_name -> guacsec.guac.assembler.api.Package
+	90,  // 144: guacsec.guac.assembler.api.IsOccurrence.subject:type_name -> guacsec.guac.assembler.api.PackageOrSource
+	85,  // 145: guacsec.guac.assembler.api.IsOccurrence.artifact:type_name -> guacsec.guac.assembler.api.Artifact
+	91,  // 146: guacsec.guac.assembler.api.HasSBOM.subject:type_name -> guacsec.guac.assembler.api.PackageOrArtifact
+	138, // 147: guacsec.guac.assembler.api.HasSBOM.known_since:type_name -> google.protobuf.Timestamp
+	138, // 148: guacsec.guac.assembler.api.ScanMetadata.time_scanned:type_name -> google.protobuf.Timestamp
+	81,  // 149: guacsec.guac.assembler.api.CertifyVuln.package:type_name -> guacsec.guac.assembler.api.Package
+	89,  // 150: guacsec.guac.assembler.api.CertifyVuln.vulnerability:type_name -> guacsec.guac.assembler.api.Vulnerability
+	95,  // 151: guacsec.guac.assembler.api.CertifyVuln.metadata:type_name -> guacsec.guac.assembler.api.ScanMetadata
+	91,  // 152: guacsec.guac.assembler.api.CertifyVEXStatement.subject:type_name -> guacsec.guac.assembler.api.PackageOrArtifact
+	89,  // 153: guacsec.guac.assembler.api.CertifyVEXStatement.vulnerability:type_name -> guacsec.guac.assembler.api.Vulnerability
+	138, // 154: guacsec.guac.assembler.api.CertifyVEXStatement.known_since:type_name -> google.protobuf.Timestamp
+	81,  // 155: guacsec.guac.assembler.api.PackageSourceOrArtifact.package:type_name -> guacsec.guac.assembler.api.Package
+	84,  // 156: guacsec.guac.assembler.api.PackageSourceOrArtifact.source:type_name -> guacsec.guac.assembler.api.Source
+	85,  // 157: guacsec.guac.assembler.api.PackageSourceOrArtifact.artifact:type_name -> guacsec.guac.assembler.api.Artifact
+	98,  // 158: guacsec.guac.assembler.api.CertifyBad.subject:type_name -> guacsec.guac.assembler.api.PackageSourceOrArtifact
+	138, // 159: guacsec.guac.assembler.api.CertifyBad.known_since:type_name -> google.protobuf.Timestamp
+	98,  // 160: guacsec.guac.assembler.api.CertifyGood.subject:type_name -> guacsec.guac.assembler.api.PackageSourceOrArtifact
+	138, // 161: guacsec.guac.assembler.api.CertifyGood.known_since:type_name -> google.protobuf.Timestamp
+	85,  // 162: guacsec.guac.assembler.api.SLSA.built_from:type_name -> guacsec.guac.assembler.api.Artifact
+	86,  // 163: guacsec.guac.assembler.api.SLSA.built_by:type_name -> guacsec.guac.assembler.api.Builder
+	101, // 164: guacsec.guac.assembler.api.SLSA.slsa_predicate:type_name -> guacsec.guac.assembler.api.SLSAPredicate
+	138, // 165: guacsec.guac.assembler.api.SLSA.started_on:type_name -> google.protobuf.Timestamp
+	138, // 166: guacsec.guac.assembler.api.SLSA.finished_on:type_name -> google.protobuf.Timestamp
+	85,  // 167: guacsec.guac.assembler.api.HasSLSA.subject:type_name -> guacsec.guac.assembler.api.Artifact
+	102, // 168: guacsec.guac.assembler.api.HasSLSA.slsa:type_name -> guacsec.guac.assembler.api.SLSA
+	81,  // 169: guacsec.guac.assembler.api.HasSourceAt.package:type_name -> guacsec.guac.assembler.api.Package
+	84,  // 170: guacsec.guac.assembler.api.HasSourceAt.source:type_name -> guacsec.guac.assembler.api.Source
+	138, // 171: guacsec.guac.assembler.api.HasSourceAt.known_since:type_name -> google.protobuf.Timestamp
+	105, // 172: guacsec.guac.assembler.api.Scorecard.checks:type_name -> guacsec.guac.assembler.api.ScorecardCheck
+	138, // 173: guacsec.guac.assembler.api.Scorecard.time_scanned:type_name -> google.protobuf.Timestamp
+	84,  // 174: guacsec.guac.assembler.api.CertifyScorecard.source:type_name -> guacsec.guac.assembler.api.Source
+	106, // 175: guacsec.guac.assembler.api.CertifyScorecard.scorecard:type_name -> guacsec.guac.assembler.api.Scorecard
+	90,  // 176: guacsec.guac.assembler.api.CertifyLegal.subject:type_name -> guacsec.guac.assembler.api.PackageOrSource
+	87,  // 177: guacsec.guac.assembler.api.CertifyLegal.declared_licenses:type_name -> guacsec.guac.assembler.api.License
+	87,  // 178: guacsec.guac.assembler.api.CertifyLegal.discovered_licenses:type_name -> guacsec.guac.assembler.api.License
+	138, // 179: guacsec.guac.assembler.api.CertifyLegal.time_scanned:type_name -> google.protobuf.Timestamp
+	81,  // 180: guacsec.guac.assembler.api.PkgEqual.packages:type_name -> guacsec.guac.assembler.api.Package
+	89,  // 181: guacsec.guac.assembler.api.VulnEqual.vulnerabilities:type_name -> guacsec.guac.assembler.api.Vulnerability
+	85,  // 182: guacsec.guac.assembler.api.HashEqual.artifacts:type_name -> guacsec.guac.assembler.api.Artifact
+	98,  // 183: guacsec.guac.assembler.api.HasMetadata.subject:type_name -> guacsec.guac.assembler.api.PackageSourceOrArtifact
+	138, // 184: guacsec.guac.assembler.api.HasMetadata.timestamp:type_name -> google.protobuf.Timestamp
+	98,  // 185: guacsec.guac.assembler.api.PointOfContact.subject:type_name -> guacsec.guac.assembler.api.PackageSourceOrArtifact
+	138, // 186: guacsec.guac.assembler.api.PointOfContact.since:type_name -> google.protobuf.Timestamp
+	89,  // 187: guacsec.guac.assembler.api.VulnerabilityMetadata.vulnerability:type_name -> guacsec.guac.assembler.api.Vulnerability
+	138, // 188: guacsec.guac.assembler.api.VulnerabilityMetadata.timestamp:type_name -> google.protobuf.Timestamp
+	49,  // 189: guacsec.guac.assembler.api.ListPackagesRequest.filter:type_name -> guacsec.guac.assembler.api.PkgSpec
+	50,  // 190: guacsec.guac.assembler.api.ListSourcesRequest.filter:type_name -> guacsec.guac.assembler.api.SourceSpec
+	51,  // 191: guacsec.guac.assembler.api.ListArtifactsRequest.filter:type_name -> guacsec.guac.assembler.api.ArtifactSpec
+	52,  // 192: guacsec.guac.assembler.api.ListBuildersRequest.filter:type_name -> guacsec.guac.assembler.api.BuilderSpec
+	53,  // 193: guacsec.guac.assembler.api.ListLicensesRequest.filter:type_name -> guacsec.guac.assembler.api.LicenseSpec
+	54,  // 194: guacsec.guac.assembler.api.ListVulnerabilitiesRequest.filter:type_name -> guacsec.guac.assembler.api.VulnerabilitySpec
+	57,  // 195: guacsec.guac.assembler.api.ListIsDependenciesRequest.filter:type_name -> guacsec.guac.assembler.api.IsDependencySpec
+	58,  // 196: guacsec.guac.assembler.api.ListIsOccurrencesRequest.filter:type_name -> guacsec.guac.assembler.api.IsOccurrenceSpec
+	59,  // 197: guacsec.guac.assembler.api.ListHasSBOMsRequest.filter:type_name -> guacsec.guac.assembler.api.HasSBOMSpec
+	60,  // 198: guacsec.guac.assembler.api.ListCertifyVulnsRequest.filter:type_name -> guacsec.guac.assembler.api.CertifyVulnSpec
+	61,  // 199: guacsec.guac.assembler.api.ListCertifyVEXStatementsRequest.filter:type_name -> guacsec.guac.assembler.api.CertifyVEXStatementSpec
+	63,  // 200: guacsec.guac.assembler.api.ListCertifyBadsRequest.filter:type_name -> guacsec.guac.assembler.api.CertifyBadSpec
+	64,  // 201: guacsec.guac.assembler.api.ListCertifyGoodsRequest.filter:type_name -> guacsec.guac.assembler.api.CertifyGoodSpec
+	66,  // 202: guacsec.guac.assembler.api.ListHasSLSAsRequest.filter:type_name -> guacsec.guac.assembler.api.HasSLSASpec
+	67,  // 203: guacsec.guac.assembler.api.ListHasSourceAtsRequest.filter:type_name -> guacsec.guac.assembler.api.HasSourceAtSpec
+	69,  // 204: guacsec.guac.assembler.api.ListCertifyScorecardsRequest.filter:type_name -> guacsec.guac.assembler.api.CertifyScorecardSpec
+	70,  // 205: guacsec.guac.assembler.api.ListCertifyLegalsRequest.filter:type_name -> guacsec.guac.assembler.api.CertifyLegalSpec
+	71,  // 206: guacsec.guac.assembler.api.ListPkgEqualsRequest.filter:type_name -> guacsec.guac.assembler.api.PkgEqualSpec
+	72,  // 207: guacsec.guac.assembler.api.ListVulnEqualsRequest.filter:type_name -> guacsec.guac.assembler.api.VulnEqualSpec
+	73,  // 208: guacsec.guac.assembler.api.ListHashEqualsRequest.filter:type_name -> guacsec.guac.assembler.api.HashEqualSpec
+	74,  // 209: guacsec.guac.assembler.api.ListHasMetadataRequest.filter:type_name -> guacsec.guac.assembler.api.HasMetadataSpec
+	75,  // 210: guacsec.guac.assembler.api.ListPointOfContactsRequest.filter:type_name -> guacsec.guac.assembler.api.PointOfContactSpec
+	76,  // 211: guacsec.guac.assembler.api.ListVulnerabilityMetadataRequest.filter:type_name -> guacsec.guac.assembler.api.VulnerabilityMetadataSpec
+	46,  // 212: guacsec.guac.assembler.api.GuacService.IngestPredicates:input_type -> guacsec.guac.assembler.api.IngestPredicatesRequest
+	115, // 213: guacsec.guac.assembler.api.GuacService.ListPackages:input_type -> guacsec.guac.assembler.api.ListPackagesRequest
+	116, // 214: guacsec.guac.assembler.api.GuacService.ListSources:input_type -> guacsec.guac.assembler.api.ListSourcesRequest
+	117, // 215: guacsec.guac.assembler.api.GuacService.ListArtifacts:input_type -> guacsec.guac.assembler.api.ListArtifactsRequest
+	118, // 216: guacsec.guac.assembler.api.GuacService.ListBuilders:input_type -> guacsec.guac.assembler.api.ListBuildersRequest
+	119, // 217: guacsec.guac.assembler.api.GuacService.ListLicenses:input_type -> guacsec.guac.assembler.api.ListLicensesRequest
+	120, // 218: guacsec.guac.assembler.api.GuacService.ListVulnerabilities:input_type -> guacsec.guac.assembler.api.ListVulnerabilitiesRequest
+	121, // 219: guacsec.guac.assembler.api.GuacService.ListIsDependencies:input_type -> guacsec.guac.assembler.api.ListIsDependenciesRequest
+	122, // 220: guacsec.guac.assembler.api.GuacService.ListIsOccurrences:input_type -> guacsec.guac.assembler.api.ListIsOccurrencesRequest
+	123, // 221: guacsec.guac.assembler.api.GuacService.ListHasSBOMs:input_type -> guacsec.guac.assembler.api.ListHasSBOMsRequest
+	124, // 222: guacsec.guac.assembler.api.GuacService.ListCertifyVulns:input_type -> guacsec.guac.assembler.api.ListCertifyVulnsRequest
+	125, // 223: guacsec.guac.assembler.api.GuacService.ListCertifyVEXStatements:input_type -> guacsec.guac.assembler.api.ListCertifyVEXStatementsRequest
+	126, // 224: guacsec.guac.assembler.api.GuacService.ListCertifyBads:input_type -> guacsec.guac.assembler.api.ListCertifyBadsRequest
+	127, // 225: guacsec.guac.assembler.api.GuacService.ListCertifyGoods:input_type -> guacsec.guac.assembler.api.ListCertifyGoodsRequest
+	128, // 226: guacsec.guac.assembler.api.GuacService.ListHasSLSAs:input_type -> guacsec.guac.assembler.api.ListHasSLSAsRequest
+	129, // 227: guacsec.guac.assembler.api.GuacService.ListHasSourceAts:input_type -> guacsec.guac.assembler.api.ListHasSourceAtsRequest
+	130, // 228: guacsec.guac.assembler.api.GuacService.ListCertifyScorecards:input_type -> guacsec.guac.assembler.api.ListCertifyScorecardsRequest
+	131, // 229: guacsec.guac.assembler.api.GuacService.ListCertifyLegals:input_type -> guacsec.guac.assembler.api.ListCertifyLegalsRequest
+	132, // 230: guacsec.guac.assembler.api.GuacService.ListPkgEquals:input_type -> guacsec.guac.assembler.api.ListPkgEqualsRequest
+	133, // 231: guacsec.guac.assembler.api.GuacService.ListVulnEquals:input_type -> guacsec.guac.assembler.api.ListVulnEqualsRequest
+	134, // 232: guacsec.guac.assembler.api.GuacService.ListHashEquals:input_type -> guacsec.guac.assembler.api.ListHashEqualsRequest
+	135, // 233: guacsec.guac.assembler.api.GuacService.ListHasMetadata:input_type -> guacsec.guac.assembler.api.ListHasMetadataRequest
+	136, // 234: guacsec.guac.assembler.api.GuacService.ListPointOfContacts:input_type -> guacsec.guac.assembler.api.ListPointOfContactsRequest
+	137, // 235: guacsec.guac.assembler.api.GuacService.ListVulnerabilityMetadata:input_type -> guacsec.guac.assembler.api.ListVulnerabilityMetadataRequest
+	47,  // 236: guacsec.guac.assembler.api.GuacService.IngestPredicates:output_type -> guacsec.guac.assembler.api.IngestPredicatesResponse
+	81,  // 237: guacsec.guac.assembler.api.GuacService.ListPackages:output_type -> guacsec.guac.assembler.api.Package
+	84,  // 238: guacsec.guac.assembler.api.GuacService.ListSources:output_type -> guacsec.guac.assembler.api.Source
+	85,  // 239: guacsec.guac.assembler.api.GuacService.ListArtifacts:output_type -> guacsec.guac.assembler.api.Artifact
+	86,  // 240: guacsec.guac.assembler.api.GuacService.ListBuilders:output_type -> guacsec.guac.assembler.api.Builder
+	87,  // 241: guacsec.guac.assembler.api.GuacService.ListLicenses:output_type -> guacsec.guac.assembler.api.License
+	89,  // 242: guacsec.guac.assembler.api.GuacService.ListVulnerabilities:output_type -> guacsec.guac.assembler.api.Vulnerability
+	92,  // 243: guacsec.guac.assembler.api.GuacService.ListIsDependencies:output_type -> guacsec.guac.assembler.api.IsDependency
+	93,  // 244: guacsec.guac.assembler.api.GuacService.ListIsOccurrences:output_type -> guacsec.guac.assembler.api.IsOccurrence
+	94,  // 245: guacsec.guac.assembler.api.GuacService.ListHasSBOMs:output_type -> guacsec.guac.assembler.api.HasSBOM
+	96,  // 246: guacsec.guac.assembler.api.GuacService.ListCertifyVulns:output_type -> guacsec.guac.assembler.api.CertifyVuln
+	97,  // 247: guacsec.guac.assembler.api.GuacService.ListCertifyVEXStatements:output_type -> guacsec.guac.assembler.api.CertifyVEXStatement
+	99,  // 248: guacsec.guac.assembler.api.GuacService.ListCertifyBads:output_type -> guacsec.guac.assembler.api.CertifyBad
+	100, // 249: guacsec.guac.assembler.api.GuacService.ListCertifyGoods:output_type -> guacsec.guac.assembler.api.CertifyGood
+	103, // 250: guacsec.guac.assembler.api.GuacService.ListHasSLSAs:output_type -> guacsec.guac.assembler.api.HasSLSA
+	104, // 251: guacsec.guac.assembler.api.GuacService.ListHasSourceAts:output_type -> guacsec.guac.assembler.api.HasSourceAt
+	107, // 252: guacsec.guac.assembler.api.GuacService.ListCertifyScorecards:output_type -> guacsec.guac.assembler.api.CertifyScorecard
+	108, // 253: guacsec.guac.assembler.api.GuacService.ListCertifyLegals:output_type -> guacsec.guac.assembler.api.CertifyLegal
+	109, // 254: guacsec.guac.assembler.api.GuacService.ListPkgEquals:output_type -> guacsec.guac.assembler.api.PkgEqual
+	110, // 255: guacsec.guac.assembler.api.GuacService.ListVulnEquals:output_type -> guacsec.guac.assembler.api.VulnEqual
+	111, // 256: guacsec.guac.assembler.api.GuacService.ListHashEquals:output_type -> guacsec.guac.assembler.api.HashEqual
+	112, // 257: guacsec.guac.assembler.api.GuacService.ListHasMetadata:output_type -> guacsec.guac.assembler.api.HasMetadata
+	113, // 258: guacsec.guac.assembler.api.GuacService.ListPointOfContacts:output_type -> guacsec.guac.assembler.api.PointOfContact
+	114, // 259: guacsec.guac.assembler.api.GuacService.ListVulnerabilityMetadata:output_type -> guacsec.guac.assembler.api.VulnerabilityMetadata
+	236, // [236:260] is the sub-list for method output_type
+	212, // [212:236] is the sub-list for method input_type
+	212, // [212:212] is the sub-list for extension type_name
+	212, // [212:212] is the sub-list for extension extendee
+	0,   // [0:212] is the sub-list for field type_name
+}
+
+func init() { file_pkg_assembler_grpc_guacapi_guacapi_proto_init() }
+func file_pkg_assembler_grpc_guacapi_guacapi_proto_init() {
+	if File_pkg_assembler_grpc_guacapi_guacapi_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageQualifierInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PkgInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuilderInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilityInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LicenseInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyBadInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyGoodInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyLegalInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScorecardCheckInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScorecardInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanMetadataInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasMetadataInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSBOMInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLSAPredicateInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLSAInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSourceAtInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashEqualInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsDependencyInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsOccurrenceInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PkgEqualInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PointOfContactInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VexStatementInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnEqualInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilityMetadataInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyScorecardIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsDependencyIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsOccurrenceIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSlsaIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyVulnIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnEqualIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSourceAtIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyBadIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyGoodIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSBOMIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSBOMIncludesInputSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashEqualIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PkgEqualIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VexIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PointOfContactIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnMetadataIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasMetadataIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyLegalIngest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IngestPredicates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IngestPredicatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IngestPredicatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageQualifierSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PkgSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuilderSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LicenseSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilitySpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageOrSourceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageOrArtifactSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsDependencySpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsOccurrenceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSBOMSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyVulnSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyVEXStatementSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageSourceOrArtifactSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyBadSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyGoodSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLSAPredicateSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSLSASpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSourceAtSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScorecardCheckSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyScorecardSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyLegalSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PkgEqualSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnEqualSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashEqualSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasMetadataSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PointOfContactSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilityMetadataSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageQualifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageNamespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Package); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceNamespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Source); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Builder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*License); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilityID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vulnerability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageOrSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageOrArtifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsDependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsOccurrence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSBOM); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyVuln); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyVEXStatement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageSourceOrArtifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyBad); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyGood); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLSAPredicate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SLSA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSLSA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasSourceAt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScorecardCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scorecard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyScorecard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertifyLegal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PkgEqual); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnEqual); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashEqual); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PointOfContact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilityMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPackagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArtifactsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBuildersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLicensesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVulnerabilitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIsDependenciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIsOccurrencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHasSBOMsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCertifyVulnsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCertifyVEXStatementsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCertifyBadsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCertifyGoodsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHasSLSAsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHasSourceAtsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCertifyScorecardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCertifyLegalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPkgEqualsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVulnEqualsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHashEqualsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHasMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPointOfContactsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVulnerabilityMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[50].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[51].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[52].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[53].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[54].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[57].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[58].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[59].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[60].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[61].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[64].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[67].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[69].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[71].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[72].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[73].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[74].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[75].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[76].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[82].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[87].OneofWrappers = []interface{}{}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[90].OneofWrappers = []interface{}{
+		(*PackageOrSource_Package)(nil),
+		(*PackageOrSource_Source)(nil),
+	}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[91].OneofWrappers = []interface{}{
+		(*PackageOrArtifact_Package)(nil),
+		(*PackageOrArtifact_Artifact)(nil),
+	}
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes[98].OneofWrappers = []interface{}{
+		(*PackageSourceOrArtifact_Package)(nil),
+		(*PackageSourceOrArtifact_Source)(nil),
+		(*PackageSourceOrArtifact_Artifact)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   138,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_assembler_grpc_guacapi_guacapi_proto_goTypes,
+		DependencyIndexes: file_pkg_assembler_grpc_guacapi_guacapi_proto_depIdxs,
+		MessageInfos:      file_pkg_assembler_grpc_guacapi_guacapi_proto_msgTypes,
+	}.Build()
+	File_pkg_assembler_grpc_guacapi_guacapi_proto = out.File
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_rawDesc = nil
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_goTypes = nil
+	file_pkg_assembler_grpc_guacapi_guacapi_proto_depIdxs = nil
+}
@@ -0,0 +1,1588 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: pkg/assembler/grpc/guacapi/guacapi.proto
+
+package guacapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GuacServiceClient is the client API for GuacService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GuacServiceClient interface {
+	IngestPredicates(ctx context.Context, opts ...grpc.CallOption) (GuacService_IngestPredicatesClient, error)
+	ListPackages(ctx context.Context, in *ListPackagesRequest, opts ...grpc.CallOption) (GuacService_ListPackagesClient, error)
+	ListSources(ctx context.Context, in *ListSourcesRequest, opts ...grpc.CallOption) (GuacService_ListSourcesClient, error)
+	ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (GuacService_ListArtifactsClient, error)
+	ListBuilders(ctx context.Context, in *ListBuildersRequest, opts ...grpc.CallOption) (GuacService_ListBuildersClient, error)
+	ListLicenses(ctx context.Context, in *ListLicensesRequest, opts ...grpc.CallOption) (GuacService_ListLicensesClient, error)
+	ListVulnerabilities(ctx context.Context, in *ListVulnerabilitiesRequest, opts ...grpc.CallOption) (GuacService_ListVulnerabilitiesClient, error)
+	ListIsDependencies(ctx context.Context, in *ListIsDependenciesRequest, opts ...grpc.CallOption) (GuacService_ListIsDependenciesClient, error)
+	ListIsOccurrences(ctx context.Context, in *ListIsOccurrencesRequest, opts ...grpc.CallOption) (GuacService_ListIsOccurrencesClient, error)
+	ListHasSBOMs(ctx context.Context, in *ListHasSBOMsRequest, opts ...grpc.CallOption) (GuacService_ListHasSBOMsClient, error)
+	ListCertifyVulns(ctx context.Context, in *ListCertifyVulnsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyVulnsClient, error)
+	ListCertifyVEXStatements(ctx context.Context, in *ListCertifyVEXStatementsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyVEXStatementsClient, error)
+	ListCertifyBads(ctx context.Context, in *ListCertifyBadsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyBadsClient, error)
+	ListCertifyGoods(ctx context.Context, in *ListCertifyGoodsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyGoodsClient, error)
+	ListHasSLSAs(ctx context.Context, in *ListHasSLSAsRequest, opts ...grpc.CallOption) (GuacService_ListHasSLSAsClient, error)
+	ListHasSourceAts(ctx context.Context, in *ListHasSourceAtsRequest, opts ...grpc.CallOption) (GuacService_ListHasSourceAtsClient, error)
+	ListCertifyScorecards(ctx context.Context, in *ListCertifyScorecardsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyScorecardsClient, error)
+	ListCertifyLegals(ctx context.Context, in *ListCertifyLegalsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyLegalsClient, error)
+	ListPkgEquals(ctx context.Context, in *ListPkgEqualsRequest, opts ...grpc.CallOption) (GuacService_ListPkgEqualsClient, error)
+	ListVulnEquals(ctx context.Context, in *ListVulnEqualsRequest, opts ...grpc.CallOption) (GuacService_ListVulnEqualsClient, error)
+	ListHashEquals(ctx context.Context, in *ListHashEqualsRequest, opts ...grpc.CallOption) (GuacService_ListHashEqualsClient, error)
+	ListHasMetadata(ctx context.Context, in *ListHasMetadataRequest, opts ...grpc.CallOption) (GuacService_ListHasMetadataClient, error)
+	ListPointOfContacts(ctx context.Context, in *ListPointOfContactsRequest, opts ...grpc.CallOption) (GuacService_ListPointOfContactsClient, error)
+	ListVulnerabilityMetadata(ctx context.Context, in *ListVulnerabilityMetadataRequest, opts ...grpc.CallOption) (GuacService_ListVulnerabilityMetadataClient, error)
+}
+
+type guacServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuacServiceClient(cc grpc.ClientConnInterface) GuacServiceClient {
+	return &guacServiceClient{cc}
+}
+
+func (c *guacServiceClient) IngestPredicates(ctx context.Context, opts ...grpc.CallOption) (GuacService_IngestPredicatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[0], "/guacsec.guac.assembler.api.GuacService/IngestPredicates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceIngestPredicatesClient{stream}
+	return x, nil
+}
+
+type GuacService_IngestPredicatesClient interface {
+	Send(*IngestPredicatesRequest) error
+	CloseAndRecv() (*IngestPredicatesResponse, error)
+	grpc.ClientStream
+}
+
+type guacServiceIngestPredicatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceIngestPredicatesClient) Send(m *IngestPredicatesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *guacServiceIngestPredicatesClient) CloseAndRecv() (*IngestPredicatesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(IngestPredicatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListPackages(ctx context.Context, in *ListPackagesRequest, opts ...grpc.CallOption) (GuacService_ListPackagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[1], "/guacsec.guac.assembler.api.GuacService/ListPackages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListPackagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListPackagesClient interface {
+	Recv() (*Package, error)
+	grpc.ClientStream
+}
+
+type guacServiceListPackagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListPackagesClient) Recv() (*Package, error) {
+	m := new(Package)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListSources(ctx context.Context, in *ListSourcesRequest, opts ...grpc.CallOption) (GuacService_ListSourcesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[2], "/guacsec.guac.assembler.api.GuacService/ListSources", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListSourcesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListSourcesClient interface {
+	Recv() (*Source, error)
+	grpc.ClientStream
+}
+
+type guacServiceListSourcesClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListSourcesClient) Recv() (*Source, error) {
+	m := new(Source)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (GuacService_ListArtifactsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[3], "/guacsec.guac.assembler.api.GuacService/ListArtifacts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListArtifactsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListArtifactsClient interface {
+	Recv() (*Artifact, error)
+	grpc.ClientStream
+}
+
+type guacServiceListArtifactsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListArtifactsClient) Recv() (*Artifact, error) {
+	m := new(Artifact)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListBuilders(ctx context.Context, in *ListBuildersRequest, opts ...grpc.CallOption) (GuacService_ListBuildersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[4], "/guacsec.guac.assembler.api.GuacService/ListBuilders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListBuildersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListBuildersClient interface {
+	Recv() (*Builder, error)
+	grpc.ClientStream
+}
+
+type guacServiceListBuildersClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListBuildersClient) Recv() (*Builder, error) {
+	m := new(Builder)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListLicenses(ctx context.Context, in *ListLicensesRequest, opts ...grpc.CallOption) (GuacService_ListLicensesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[5], "/guacsec.guac.assembler.api.GuacService/ListLicenses", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListLicensesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListLicensesClient interface {
+	Recv() (*License, error)
+	grpc.ClientStream
+}
+
+type guacServiceListLicensesClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListLicensesClient) Recv() (*License, error) {
+	m := new(License)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListVulnerabilities(ctx context.Context, in *ListVulnerabilitiesRequest, opts ...grpc.CallOption) (GuacService_ListVulnerabilitiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[6], "/guacsec.guac.assembler.api.GuacService/ListVulnerabilities", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListVulnerabilitiesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListVulnerabilitiesClient interface {
+	Recv() (*Vulnerability, error)
+	grpc.ClientStream
+}
+
+type guacServiceListVulnerabilitiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListVulnerabilitiesClient) Recv() (*Vulnerability, error) {
+	m := new(Vulnerability)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListIsDependencies(ctx context.Context, in *ListIsDependenciesRequest, opts ...grpc.CallOption) (GuacService_ListIsDependenciesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[7], "/guacsec.guac.assembler.api.GuacService/ListIsDependencies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListIsDependenciesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListIsDependenciesClient interface {
+	Recv() (*IsDependency, error)
+	grpc.ClientStream
+}
+
+type guacServiceListIsDependenciesClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListIsDependenciesClient) Recv() (*IsDependency, error) {
+	m := new(IsDependency)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListIsOccurrences(ctx context.Context, in *ListIsOccurrencesRequest, opts ...grpc.CallOption) (GuacService_ListIsOccurrencesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[8], "/guacsec.guac.assembler.api.GuacService/ListIsOccurrences", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListIsOccurrencesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListIsOccurrencesClient interface {
+	Recv() (*IsOccurrence, error)
+	grpc.ClientStream
+}
+
+type guacServiceListIsOccurrencesClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListIsOccurrencesClient) Recv() (*IsOccurrence, error) {
+	m := new(IsOccurrence)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListHasSBOMs(ctx context.Context, in *ListHasSBOMsRequest, opts ...grpc.CallOption) (GuacService_ListHasSBOMsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[9], "/guacsec.guac.assembler.api.GuacService/ListHasSBOMs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListHasSBOMsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListHasSBOMsClient interface {
+	Recv() (*HasSBOM, error)
+	grpc.ClientStream
+}
+
+type guacServiceListHasSBOMsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListHasSBOMsClient) Recv() (*HasSBOM, error) {
+	m := new(HasSBOM)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListCertifyVulns(ctx context.Context, in *ListCertifyVulnsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyVulnsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[10], "/guacsec.guac.assembler.api.GuacService/ListCertifyVulns", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListCertifyVulnsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListCertifyVulnsClient interface {
+	Recv() (*CertifyVuln, error)
+	grpc.ClientStream
+}
+
+type guacServiceListCertifyVulnsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListCertifyVulnsClient) Recv() (*CertifyVuln, error) {
+	m := new(CertifyVuln)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListCertifyVEXStatements(ctx context.Context, in *ListCertifyVEXStatementsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyVEXStatementsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[11], "/guacsec.guac.assembler.api.GuacService/ListCertifyVEXStatements", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListCertifyVEXStatementsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListCertifyVEXStatementsClient interface {
+	Recv() (*CertifyVEXStatement, error)
+	grpc.ClientStream
+}
+
+type guacServiceListCertifyVEXStatementsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListCertifyVEXStatementsClient) Recv() (*CertifyVEXStatement, error) {
+	m := new(CertifyVEXStatement)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListCertifyBads(ctx context.Context, in *ListCertifyBadsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyBadsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[12], "/guacsec.guac.assembler.api.GuacService/ListCertifyBads", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListCertifyBadsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListCertifyBadsClient interface {
+	Recv() (*CertifyBad, error)
+	grpc.ClientStream
+}
+
+type guacServiceListCertifyBadsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListCertifyBadsClient) Recv() (*CertifyBad, error) {
+	m := new(CertifyBad)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListCertifyGoods(ctx context.Context, in *ListCertifyGoodsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyGoodsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[13], "/guacsec.guac.assembler.api.GuacService/ListCertifyGoods", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListCertifyGoodsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListCertifyGoodsClient interface {
+	Recv() (*CertifyGood, error)
+	grpc.ClientStream
+}
+
+type guacServiceListCertifyGoodsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListCertifyGoodsClient) Recv() (*CertifyGood, error) {
+	m := new(CertifyGood)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListHasSLSAs(ctx context.Context, in *ListHasSLSAsRequest, opts ...grpc.CallOption) (GuacService_ListHasSLSAsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[14], "/guacsec.guac.assembler.api.GuacService/ListHasSLSAs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListHasSLSAsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListHasSLSAsClient interface {
+	Recv() (*HasSLSA, error)
+	grpc.ClientStream
+}
+
+type guacServiceListHasSLSAsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListHasSLSAsClient) Recv() (*HasSLSA, error) {
+	m := new(HasSLSA)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListHasSourceAts(ctx context.Context, in *ListHasSourceAtsRequest, opts ...grpc.CallOption) (GuacService_ListHasSourceAtsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[15], "/guacsec.guac.assembler.api.GuacService/ListHasSourceAts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListHasSourceAtsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListHasSourceAtsClient interface {
+	Recv() (*HasSourceAt, error)
+	grpc.ClientStream
+}
+
+type guacServiceListHasSourceAtsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListHasSourceAtsClient) Recv() (*HasSourceAt, error) {
+	m := new(HasSourceAt)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListCertifyScorecards(ctx context.Context, in *ListCertifyScorecardsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyScorecardsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[16], "/guacsec.guac.assembler.api.GuacService/ListCertifyScorecards", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListCertifyScorecardsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListCertifyScorecardsClient interface {
+	Recv() (*CertifyScorecard, error)
+	grpc.ClientStream
+}
+
+type guacServiceListCertifyScorecardsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListCertifyScorecardsClient) Recv() (*CertifyScorecard, error) {
+	m := new(CertifyScorecard)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListCertifyLegals(ctx context.Context, in *ListCertifyLegalsRequest, opts ...grpc.CallOption) (GuacService_ListCertifyLegalsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[17], "/guacsec.guac.assembler.api.GuacService/ListCertifyLegals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListCertifyLegalsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListCertifyLegalsClient interface {
+	Recv() (*CertifyLegal, error)
+	grpc.ClientStream
+}
+
+type guacServiceListCertifyLegalsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListCertifyLegalsClient) Recv() (*CertifyLegal, error) {
+	m := new(CertifyLegal)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListPkgEquals(ctx context.Context, in *ListPkgEqualsRequest, opts ...grpc.CallOption) (GuacService_ListPkgEqualsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[18], "/guacsec.guac.assembler.api.GuacService/ListPkgEquals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListPkgEqualsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListPkgEqualsClient interface {
+	Recv() (*PkgEqual, error)
+	grpc.ClientStream
+}
+
+type guacServiceListPkgEqualsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListPkgEqualsClient) Recv() (*PkgEqual, error) {
+	m := new(PkgEqual)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListVulnEquals(ctx context.Context, in *ListVulnEqualsRequest, opts ...grpc.CallOption) (GuacService_ListVulnEqualsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[19], "/guacsec.guac.assembler.api.GuacService/ListVulnEquals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListVulnEqualsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListVulnEqualsClient interface {
+	Recv() (*VulnEqual, error)
+	grpc.ClientStream
+}
+
+type guacServiceListVulnEqualsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListVulnEqualsClient) Recv() (*VulnEqual, error) {
+	m := new(VulnEqual)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListHashEquals(ctx context.Context, in *ListHashEqualsRequest, opts ...grpc.CallOption) (GuacService_ListHashEqualsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[20], "/guacsec.guac.assembler.api.GuacService/ListHashEquals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListHashEqualsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListHashEqualsClient interface {
+	Recv() (*HashEqual, error)
+	grpc.ClientStream
+}
+
+type guacServiceListHashEqualsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListHashEqualsClient) Recv() (*HashEqual, error) {
+	m := new(HashEqual)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListHasMetadata(ctx context.Context, in *ListHasMetadataRequest, opts ...grpc.CallOption) (GuacService_ListHasMetadataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[21], "/guacsec.guac.assembler.api.GuacService/ListHasMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListHasMetadataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListHasMetadataClient interface {
+	Recv() (*HasMetadata, error)
+	grpc.ClientStream
+}
+
+type guacServiceListHasMetadataClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListHasMetadataClient) Recv() (*HasMetadata, error) {
+	m := new(HasMetadata)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListPointOfContacts(ctx context.Context, in *ListPointOfContactsRequest, opts ...grpc.CallOption) (GuacService_ListPointOfContactsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[22], "/guacsec.guac.assembler.api.GuacService/ListPointOfContacts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListPointOfContactsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListPointOfContactsClient interface {
+	Recv() (*PointOfContact, error)
+	grpc.ClientStream
+}
+
+type guacServiceListPointOfContactsClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListPointOfContactsClient) Recv() (*PointOfContact, error) {
+	m := new(PointOfContact)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *guacServiceClient) ListVulnerabilityMetadata(ctx context.Context, in *ListVulnerabilityMetadataRequest, opts ...grpc.CallOption) (GuacService_ListVulnerabilityMetadataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GuacService_ServiceDesc.Streams[23], "/guacsec.guac.assembler.api.GuacService/ListVulnerabilityMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &guacServiceListVulnerabilityMetadataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GuacService_ListVulnerabilityMetadataClient interface {
+	Recv() (*VulnerabilityMetadata, error)
+	grpc.ClientStream
+}
+
+type guacServiceListVulnerabilityMetadataClient struct {
+	grpc.ClientStream
+}
+
+func (x *guacServiceListVulnerabilityMetadataClient) Recv() (*VulnerabilityMetadata, error) {
+	m := new(VulnerabilityMetadata)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GuacServiceServer is the server API for GuacService service.
+// All implementations must embed UnimplementedGuacServiceServer
+// for forward compatibility
+type GuacServiceServer interface {
+	IngestPredicates(GuacService_IngestPredicatesServer) error
+	ListPackages(*ListPackagesRequest, GuacService_ListPackagesServer) error
+	ListSources(*ListSourcesRequest, GuacService_ListSourcesServer) error
+	ListArtifacts(*ListArtifactsRequest, GuacService_ListArtifactsServer) error
+	ListBuilders(*ListBuildersRequest, GuacService_ListBuildersServer) error
+	ListLicenses(*ListLicensesRequest, GuacService_ListLicensesServer) error
+	ListVulnerabilities(*ListVulnerabilitiesRequest, GuacService_ListVulnerabilitiesServer) error
+	ListIsDependencies(*ListIsDependenciesRequest, GuacService_ListIsDependenciesServer) error
+	ListIsOccurrences(*ListIsOccurrencesRequest, GuacService_ListIsOccurrencesServer) error
+	ListHasSBOMs(*ListHasSBOMsRequest, GuacService_ListHasSBOMsServer) error
+	ListCertifyVulns(*ListCertifyVulnsRequest, GuacService_ListCertifyVulnsServer) error
+	ListCertifyVEXStatements(*ListCertifyVEXStatementsRequest, GuacService_ListCertifyVEXStatementsServer) error
+	ListCertifyBads(*ListCertifyBadsRequest, GuacService_ListCertifyBadsServer) error
+	ListCertifyGoods(*ListCertifyGoodsRequest, GuacService_ListCertifyGoodsServer) error
+	ListHasSLSAs(*ListHasSLSAsRequest, GuacService_ListHasSLSAsServer) error
+	ListHasSourceAts(*ListHasSourceAtsRequest, GuacService_ListHasSourceAtsServer) error
+	ListCertifyScorecards(*ListCertifyScorecardsRequest, GuacService_ListCertifyScorecardsServer) error
+	ListCertifyLegals(*ListCertifyLegalsRequest, GuacService_ListCertifyLegalsServer) error
+	ListPkgEquals(*ListPkgEqualsRequest, GuacService_ListPkgEqualsServer) error
+	ListVulnEquals(*ListVulnEqualsRequest, GuacService_ListVulnEqualsServer) error
+	ListHashEquals(*ListHashEqualsRequest, GuacService_ListHashEqualsServer) error
+	ListHasMetadata(*ListHasMetadataRequest, GuacService_ListHasMetadataServer) error
+	ListPointOfContacts(*ListPointOfContactsRequest, GuacService_ListPointOfContactsServer) error
+	ListVulnerabilityMetadata(*ListVulnerabilityMetadataRequest, GuacService_ListVulnerabilityMetadataServer) error
+	mustEmbedUnimplementedGuacServiceServer()
+}
+
+// UnimplementedGuacServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGuacServiceServer struct {
+}
+
+func (UnimplementedGuacServiceServer) IngestPredicates(GuacService_IngestPredicatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method IngestPredicates not implemented")
+}
+func (UnimplementedGuacServiceServer) ListPackages(*ListPackagesRequest, GuacService_ListPackagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPackages not implemented")
+}
+func (UnimplementedGuacServiceServer) ListSources(*ListSourcesRequest, GuacService_ListSourcesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListSources not implemented")
+}
+func (UnimplementedGuacServiceServer) ListArtifacts(*ListArtifactsRequest, GuacService_ListArtifactsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListArtifacts not implemented")
+}
+func (UnimplementedGuacServiceServer) ListBuilders(*ListBuildersRequest, GuacService_ListBuildersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBuilders not implemented")
+}
+func (UnimplementedGuacServiceServer) ListLicenses(*ListLicensesRequest, GuacService_ListLicensesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListLicenses not implemented")
+}
+func (UnimplementedGuacServiceServer) ListVulnerabilities(*ListVulnerabilitiesRequest, GuacService_ListVulnerabilitiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListVulnerabilities not implemented")
+}
+func (UnimplementedGuacServiceServer) ListIsDependencies(*ListIsDependenciesRequest, GuacService_ListIsDependenciesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListIsDependencies not implemented")
+}
+func (UnimplementedGuacServiceServer) ListIsOccurrences(*ListIsOccurrencesRequest, GuacService_ListIsOccurrencesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListIsOccurrences not implemented")
+}
+func (UnimplementedGuacServiceServer) ListHasSBOMs(*ListHasSBOMsRequest, GuacService_ListHasSBOMsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListHasSBOMs not implemented")
+}
+func (UnimplementedGuacServiceServer) ListCertifyVulns(*ListCertifyVulnsRequest, GuacService_ListCertifyVulnsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCertifyVulns not implemented")
+}
+func (UnimplementedGuacServiceServer) ListCertifyVEXStatements(*ListCertifyVEXStatementsRequest, GuacService_ListCertifyVEXStatementsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCertifyVEXStatements not implemented")
+}
+func (UnimplementedGuacServiceServer) ListCertifyBads(*ListCertifyBadsRequest, GuacService_ListCertifyBadsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCertifyBads not implemented")
+}
+func (UnimplementedGuacServiceServer) ListCertifyGoods(*ListCertifyGoodsRequest, GuacService_ListCertifyGoodsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCertifyGoods not implemented")
+}
+func (UnimplementedGuacServiceServer) ListHasSLSAs(*ListHasSLSAsRequest, GuacService_ListHasSLSAsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListHasSLSAs not implemented")
+}
+func (UnimplementedGuacServiceServer) ListHasSourceAts(*ListHasSourceAtsRequest, GuacService_ListHasSourceAtsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListHasSourceAts not implemented")
+}
+func (UnimplementedGuacServiceServer) ListCertifyScorecards(*ListCertifyScorecardsRequest, GuacService_ListCertifyScorecardsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCertifyScorecards not implemented")
+}
+func (UnimplementedGuacServiceServer) ListCertifyLegals(*ListCertifyLegalsRequest, GuacService_ListCertifyLegalsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCertifyLegals not implemented")
+}
+func (UnimplementedGuacServiceServer) ListPkgEquals(*ListPkgEqualsRequest, GuacService_ListPkgEqualsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPkgEquals not implemented")
+}
+func (UnimplementedGuacServiceServer) ListVulnEquals(*ListVulnEqualsRequest, GuacService_ListVulnEqualsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListVulnEquals not implemented")
+}
+func (UnimplementedGuacServiceServer) ListHashEquals(*ListHashEqualsRequest, GuacService_ListHashEqualsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListHashEquals not implemented")
+}
+func (UnimplementedGuacServiceServer) ListHasMetadata(*ListHasMetadataRequest, GuacService_ListHasMetadataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListHasMetadata not implemented")
+}
+func (UnimplementedGuacServiceServer) ListPointOfContacts(*ListPointOfContactsRequest, GuacService_ListPointOfContactsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPointOfContacts not implemented")
+}
+func (UnimplementedGuacServiceServer) ListVulnerabilityMetadata(*ListVulnerabilityMetadataRequest, GuacService_ListVulnerabilityMetadataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListVulnerabilityMetadata not implemented")
+}
+func (UnimplementedGuacServiceServer) mustEmbedUnimplementedGuacServiceServer() {}
+
+// UnsafeGuacServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuacServiceServer will
+// result in compilation errors.
+type UnsafeGuacServiceServer interface {
+	mustEmbedUnimplementedGuacServiceServer()
+}
+
+func RegisterGuacServiceServer(s grpc.ServiceRegistrar, srv GuacServiceServer) {
+	s.RegisterService(&GuacService_ServiceDesc, srv)
+}
+
+func _GuacService_IngestPredicates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GuacServiceServer).IngestPredicates(&guacServiceIngestPredicatesServer{stream})
+}
+
+type GuacService_IngestPredicatesServer interface {
+	SendAndClose(*IngestPredicatesResponse) error
+	Recv() (*IngestPredicatesRequest, error)
+	grpc.ServerStream
+}
+
+type guacServiceIngestPredicatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceIngestPredicatesServer) SendAndClose(m *IngestPredicatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *guacServiceIngestPredicatesServer) Recv() (*IngestPredicatesRequest, error) {
+	m := new(IngestPredicatesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GuacService_ListPackages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPackagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListPackages(m, &guacServiceListPackagesServer{stream})
+}
+
+type GuacService_ListPackagesServer interface {
+	Send(*Package) error
+	grpc.ServerStream
+}
+
+type guacServiceListPackagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListPackagesServer) Send(m *Package) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListSources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListSourcesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListSources(m, &guacServiceListSourcesServer{stream})
+}
+
+type GuacService_ListSourcesServer interface {
+	Send(*Source) error
+	grpc.ServerStream
+}
+
+type guacServiceListSourcesServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListSourcesServer) Send(m *Source) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListArtifacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListArtifactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListArtifacts(m, &guacServiceListArtifactsServer{stream})
+}
+
+type GuacService_ListArtifactsServer interface {
+	Send(*Artifact) error
+	grpc.ServerStream
+}
+
+type guacServiceListArtifactsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListArtifactsServer) Send(m *Artifact) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListBuilders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBuildersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListBuilders(m, &guacServiceListBuildersServer{stream})
+}
+
+type GuacService_ListBuildersServer interface {
+	Send(*Builder) error
+	grpc.ServerStream
+}
+
+type guacServiceListBuildersServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListBuildersServer) Send(m *Builder) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListLicenses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListLicensesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListLicenses(m, &guacServiceListLicensesServer{stream})
+}
+
+type GuacService_ListLicensesServer interface {
+	Send(*License) error
+	grpc.ServerStream
+}
+
+type guacServiceListLicensesServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListLicensesServer) Send(m *License) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListVulnerabilities_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListVulnerabilitiesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListVulnerabilities(m, &guacServiceListVulnerabilitiesServer{stream})
+}
+
+type GuacService_ListVulnerabilitiesServer interface {
+	Send(*Vulnerability) error
+	grpc.ServerStream
+}
+
+type guacServiceListVulnerabilitiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListVulnerabilitiesServer) Send(m *Vulnerability) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListIsDependencies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListIsDependenciesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListIsDependencies(m, &guacServiceListIsDependenciesServer{stream})
+}
+
+type GuacService_ListIsDependenciesServer interface {
+	Send(*IsDependency) error
+	grpc.ServerStream
+}
+
+type guacServiceListIsDependenciesServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListIsDependenciesServer) Send(m *IsDependency) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListIsOccurrences_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListIsOccurrencesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListIsOccurrences(m, &guacServiceListIsOccurrencesServer{stream})
+}
+
+type GuacService_ListIsOccurrencesServer interface {
+	Send(*IsOccurrence) error
+	grpc.ServerStream
+}
+
+type guacServiceListIsOccurrencesServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListIsOccurrencesServer) Send(m *IsOccurrence) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListHasSBOMs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListHasSBOMsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListHasSBOMs(m, &guacServiceListHasSBOMsServer{stream})
+}
+
+type GuacService_ListHasSBOMsServer interface {
+	Send(*HasSBOM) error
+	grpc.ServerStream
+}
+
+type guacServiceListHasSBOMsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListHasSBOMsServer) Send(m *HasSBOM) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListCertifyVulns_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCertifyVulnsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListCertifyVulns(m, &guacServiceListCertifyVulnsServer{stream})
+}
+
+type GuacService_ListCertifyVulnsServer interface {
+	Send(*CertifyVuln) error
+	grpc.ServerStream
+}
+
+type guacServiceListCertifyVulnsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListCertifyVulnsServer) Send(m *CertifyVuln) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListCertifyVEXStatements_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCertifyVEXStatementsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListCertifyVEXStatements(m, &guacServiceListCertifyVEXStatementsServer{stream})
+}
+
+type GuacService_ListCertifyVEXStatementsServer interface {
+	Send(*CertifyVEXStatement) error
+	grpc.ServerStream
+}
+
+type guacServiceListCertifyVEXStatementsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListCertifyVEXStatementsServer) Send(m *CertifyVEXStatement) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListCertifyBads_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCertifyBadsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListCertifyBads(m, &guacServiceListCertifyBadsServer{stream})
+}
+
+type GuacService_ListCertifyBadsServer interface {
+	Send(*CertifyBad) error
+	grpc.ServerStream
+}
+
+type guacServiceListCertifyBadsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListCertifyBadsServer) Send(m *CertifyBad) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListCertifyGoods_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCertifyGoodsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListCertifyGoods(m, &guacServiceListCertifyGoodsServer{stream})
+}
+
+type GuacService_ListCertifyGoodsServer interface {
+	Send(*CertifyGood) error
+	grpc.ServerStream
+}
+
+type guacServiceListCertifyGoodsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListCertifyGoodsServer) Send(m *CertifyGood) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListHasSLSAs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListHasSLSAsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListHasSLSAs(m, &guacServiceListHasSLSAsServer{stream})
+}
+
+type GuacService_ListHasSLSAsServer interface {
+	Send(*HasSLSA) error
+	grpc.ServerStream
+}
+
+type guacServiceListHasSLSAsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListHasSLSAsServer) Send(m *HasSLSA) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListHasSourceAts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListHasSourceAtsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListHasSourceAts(m, &guacServiceListHasSourceAtsServer{stream})
+}
+
+type GuacService_ListHasSourceAtsServer interface {
+	Send(*HasSourceAt) error
+	grpc.ServerStream
+}
+
+type guacServiceListHasSourceAtsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListHasSourceAtsServer) Send(m *HasSourceAt) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListCertifyScorecards_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCertifyScorecardsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListCertifyScorecards(m, &guacServiceListCertifyScorecardsServer{stream})
+}
+
+type GuacService_ListCertifyScorecardsServer interface {
+	Send(*CertifyScorecard) error
+	grpc.ServerStream
+}
+
+type guacServiceListCertifyScorecardsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListCertifyScorecardsServer) Send(m *CertifyScorecard) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListCertifyLegals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCertifyLegalsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListCertifyLegals(m, &guacServiceListCertifyLegalsServer{stream})
+}
+
+type GuacService_ListCertifyLegalsServer interface {
+	Send(*CertifyLegal) error
+	grpc.ServerStream
+}
+
+type guacServiceListCertifyLegalsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListCertifyLegalsServer) Send(m *CertifyLegal) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListPkgEquals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPkgEqualsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListPkgEquals(m, &guacServiceListPkgEqualsServer{stream})
+}
+
+type GuacService_ListPkgEqualsServer interface {
+	Send(*PkgEqual) error
+	grpc.ServerStream
+}
+
+type guacServiceListPkgEqualsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListPkgEqualsServer) Send(m *PkgEqual) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListVulnEquals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListVulnEqualsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListVulnEquals(m, &guacServiceListVulnEqualsServer{stream})
+}
+
+type GuacService_ListVulnEqualsServer interface {
+	Send(*VulnEqual) error
+	grpc.ServerStream
+}
+
+type guacServiceListVulnEqualsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListVulnEqualsServer) Send(m *VulnEqual) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListHashEquals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListHashEqualsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListHashEquals(m, &guacServiceListHashEqualsServer{stream})
+}
+
+type GuacService_ListHashEqualsServer interface {
+	Send(*HashEqual) error
+	grpc.ServerStream
+}
+
+type guacServiceListHashEqualsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListHashEqualsServer) Send(m *HashEqual) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListHasMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListHasMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListHasMetadata(m, &guacServiceListHasMetadataServer{stream})
+}
+
+type GuacService_ListHasMetadataServer interface {
+	Send(*HasMetadata) error
+	grpc.ServerStream
+}
+
+type guacServiceListHasMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListHasMetadataServer) Send(m *HasMetadata) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListPointOfContacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPointOfContactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListPointOfContacts(m, &guacServiceListPointOfContactsServer{stream})
+}
+
+type GuacService_ListPointOfContactsServer interface {
+	Send(*PointOfContact) error
+	grpc.ServerStream
+}
+
+type guacServiceListPointOfContactsServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListPointOfContactsServer) Send(m *PointOfContact) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GuacService_ListVulnerabilityMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListVulnerabilityMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GuacServiceServer).ListVulnerabilityMetadata(m, &guacServiceListVulnerabilityMetadataServer{stream})
+}
+
+type GuacService_ListVulnerabilityMetadataServer interface {
+	Send(*VulnerabilityMetadata) error
+	grpc.ServerStream
+}
+
+type guacServiceListVulnerabilityMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *guacServiceListVulnerabilityMetadataServer) Send(m *VulnerabilityMetadata) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// GuacService_ServiceDesc is the grpc.ServiceDesc for GuacService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuacService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "guacsec.guac.assembler.api.GuacService",
+	HandlerType: (*GuacServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "IngestPredicates",
+			Handler:       _GuacService_IngestPredicates_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ListPackages",
+			Handler:       _GuacService_ListPackages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListSources",
+			Handler:       _GuacService_ListSources_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListArtifacts",
+			Handler:       _GuacService_ListArtifacts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListBuilders",
+			Handler:       _GuacService_ListBuilders_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListLicenses",
+			Handler:       _GuacService_ListLicenses_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVulnerabilities",
+			Handler:       _GuacService_ListVulnerabilities_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListIsDependencies",
+			Handler:       _GuacService_ListIsDependencies_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListIsOccurrences",
+			Handler:       _GuacService_ListIsOccurrences_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListHasSBOMs",
+			Handler:       _GuacService_ListHasSBOMs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCertifyVulns",
+			Handler:       _GuacService_ListCertifyVulns_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCertifyVEXStatements",
+			Handler:       _GuacService_ListCertifyVEXStatements_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCertifyBads",
+			Handler:       _GuacService_ListCertifyBads_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCertifyGoods",
+			Handler:       _GuacService_ListCertifyGoods_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListHasSLSAs",
+			Handler:       _GuacService_ListHasSLSAs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListHasSourceAts",
+			Handler:       _GuacService_ListHasSourceAts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCertifyScorecards",
+			Handler:       _GuacService_ListCertifyScorecards_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCertifyLegals",
+			Handler:       _GuacService_ListCertifyLegals_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListPkgEquals",
+			Handler:       _GuacService_ListPkgEquals_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVulnEquals",
+			Handler:       _GuacService_ListVulnEquals_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListHashEquals",
+			Handler:       _GuacService_ListHashEquals_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListHasMetadata",
+			Handler:       _GuacService_ListHasMetadata_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListPointOfContacts",
+			Handler:       _GuacService_ListPointOfContacts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVulnerabilityMetadata",
+			Handler:       _GuacService_ListVulnerabilityMetadata_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/assembler/grpc/guacapi/guacapi.proto",
+}
@@ -0,0 +1,1156 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	pb "github.com/guacsec/guac/pkg/assembler/grpc/guacapi"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// This file converts protobuf messages into the GraphQL model types used by
+// the backends.
+
+func toTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+func toTimePtr(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.AsTime()
+	return &v
+}
+
+func toMatchFlags(flag string) (model.MatchFlags, error) {
+	if flag == "" {
+		return model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, nil
+	}
+	m := model.PkgMatchType(flag)
+	if !m.IsValid() {
+		return model.MatchFlags{}, fmt.Errorf("invalid package match type: %q", flag)
+	}
+	return model.MatchFlags{Pkg: m}, nil
+}
+
+func toPkgInputSpec(p *pb.PkgInputSpec) *model.PkgInputSpec {
+	if p == nil {
+		return nil
+	}
+	var qualifiers []*model.PackageQualifierInputSpec
+	for _, q := range p.Qualifiers {
+		qualifiers = append(qualifiers, &model.PackageQualifierInputSpec{Key: q.Key, Value: q.Value})
+	}
+	return &model.PkgInputSpec{
+		Type:       p.Type,
+		Namespace:  p.Namespace,
+		Name:       p.Name,
+		Version:    p.Version,
+		Qualifiers: qualifiers,
+		Subpath:    p.Subpath,
+	}
+}
+
+func toSourceInputSpec(s *pb.SourceInputSpec) *model.SourceInputSpec {
+	if s == nil {
+		return nil
+	}
+	return &model.SourceInputSpec{
+		Type:      s.Type,
+		Namespace: s.Namespace,
+		Name:      s.Name,
+		Tag:       s.Tag,
+		Commit:    s.Commit,
+	}
+}
+
+func toArtifactInputSpec(a *pb.ArtifactInputSpec) *model.ArtifactInputSpec {
+	if a == nil {
+		return nil
+	}
+	return &model.ArtifactInputSpec{Algorithm: a.Algorithm, Digest: a.Digest}
+}
+
+func toBuilderInputSpec(b *pb.BuilderInputSpec) *model.BuilderInputSpec {
+	if b == nil {
+		return nil
+	}
+	return &model.BuilderInputSpec{URI: b.Uri}
+}
+
+func toVulnerabilityInputSpec(v *pb.VulnerabilityInputSpec) *model.VulnerabilityInputSpec {
+	if v == nil {
+		return nil
+	}
+	return &model.VulnerabilityInputSpec{Type: v.Type, VulnerabilityID: v.VulnerabilityId}
+}
+
+func toLicenseInputSpec(l *pb.LicenseInputSpec) *model.LicenseInputSpec {
+	if l == nil {
+		return nil
+	}
+	return &model.LicenseInputSpec{Name: l.Name, Inline: l.Inline, ListVersion: l.ListVersion}
+}
+
+func toCertifyBadInputSpec(c *pb.CertifyBadInputSpec) *model.CertifyBadInputSpec {
+	return &model.CertifyBadInputSpec{
+		Justification: c.GetJustification(),
+		KnownSince:    toTime(c.GetKnownSince()),
+		Origin:        c.GetOrigin(),
+		Collector:     c.GetCollector(),
+		DocumentRef:   c.GetDocumentRef(),
+	}
+}
+
+func toCertifyGoodInputSpec(c *pb.CertifyGoodInputSpec) *model.CertifyGoodInputSpec {
+	return &model.CertifyGoodInputSpec{
+		Justification: c.GetJustification(),
+		KnownSince:    toTime(c.GetKnownSince()),
+		Origin:        c.GetOrigin(),
+		Collector:     c.GetCollector(),
+		DocumentRef:   c.GetDocumentRef(),
+	}
+}
+
+func toCertifyLegalInputSpec(c *pb.CertifyLegalInputSpec) *model.CertifyLegalInputSpec {
+	return &model.CertifyLegalInputSpec{
+		DeclaredLicense:   c.GetDeclaredLicense(),
+		DiscoveredLicense: c.GetDiscoveredLicense(),
+		Attribution:       c.GetAttribution(),
+		Justification:     c.GetJustification(),
+		TimeScanned:       toTime(c.GetTimeScanned()),
+		Origin:            c.GetOrigin(),
+		Collector:         c.GetCollector(),
+		DocumentRef:       c.GetDocumentRef(),
+	}
+}
+
+func toScorecardInputSpec(s *pb.ScorecardInputSpec) *model.ScorecardInputSpec {
+	var checks []*model.ScorecardCheckInputSpec
+	for _, c := range s.GetChecks() {
+		checks = append(checks, &model.ScorecardCheckInputSpec{Check: c.Check, Score: int(c.Score)})
+	}
+	return &model.ScorecardInputSpec{
+		Checks:           checks,
+		AggregateScore:   s.GetAggregateScore(),
+		TimeScanned:      toTime(s.GetTimeScanned()),
+		ScorecardVersion: s.GetScorecardVersion(),
+		ScorecardCommit:  s.GetScorecardCommit(),
+		Origin:           s.GetOrigin(),
+		Collector:        s.GetCollector(),
+		DocumentRef:      s.GetDocumentRef(),
+	}
+}
+
+func toScanMetadataInput(s *pb.ScanMetadataInput) *model.ScanMetadataInput {
+	return &model.ScanMetadataInput{
+		TimeScanned:    toTime(s.GetTimeScanned()),
+		DbURI:          s.GetDbUri(),
+		DbVersion:      s.GetDbVersion(),
+		ScannerURI:     s.GetScannerUri(),
+		ScannerVersion: s.GetScannerVersion(),
+		Origin:         s.GetOrigin(),
+		Collector:      s.GetCollector(),
+		DocumentRef:    s.GetDocumentRef(),
+	}
+}
+
+func toHasMetadataInputSpec(h *pb.HasMetadataInputSpec) *model.HasMetadataInputSpec {
+	return &model.HasMetadataInputSpec{
+		Key:           h.GetKey(),
+		Value:         h.GetValue(),
+		Timestamp:     toTime(h.GetTimestamp()),
+		Justification: h.GetJustification(),
+		Origin:        h.GetOrigin(),
+		Collector:     h.GetCollector(),
+		DocumentRef:   h.GetDocumentRef(),
+	}
+}
+
+func toHasSBOMInputSpec(h *pb.HasSBOMInputSpec) *model.HasSBOMInputSpec {
+	return &model.HasSBOMInputSpec{
+		URI:              h.GetUri(),
+		Algorithm:        h.GetAlgorithm(),
+		Digest:           h.GetDigest(),
+		DownloadLocation: h.GetDownloadLocation(),
+		KnownSince:       toTime(h.GetKnownSince()),
+		Origin:           h.GetOrigin(),
+		Collector:        h.GetCollector(),
+		DocumentRef:      h.GetDocumentRef(),
+	}
+}
+
+func toSLSAInputSpec(s *pb.SLSAInputSpec) *model.SLSAInputSpec {
+	var predicates []*model.SLSAPredicateInputSpec
+	for _, p := range s.GetSlsaPredicate() {
+		predicates = append(predicates, &model.SLSAPredicateInputSpec{Key: p.Key, Value: p.Value})
+	}
+	return &model.SLSAInputSpec{
+		BuildType:     s.GetBuildType(),
+		SlsaPredicate: predicates,
+		SlsaVersion:   s.GetSlsaVersion(),
+		StartedOn:     toTimePtr(s.GetStartedOn()),
+		FinishedOn:    toTimePtr(s.GetFinishedOn()),
+		Origin:        s.GetOrigin(),
+		Collector:     s.GetCollector(),
+		DocumentRef:   s.GetDocumentRef(),
+	}
+}
+
+func toHasSourceAtInputSpec(h *pb.HasSourceAtInputSpec) *model.HasSourceAtInputSpec {
+	return &model.HasSourceAtInputSpec{
+		KnownSince:    toTime(h.GetKnownSince()),
+		Justification: h.GetJustification(),
+		Origin:        h.GetOrigin(),
+		Collector:     h.GetCollector(),
+		DocumentRef:   h.GetDocumentRef(),
+	}
+}
+
+func toHashEqualInputSpec(h *pb.HashEqualInputSpec) *model.HashEqualInputSpec {
+	return &model.HashEqualInputSpec{
+		Justification: h.GetJustification(),
+		Origin:        h.GetOrigin(),
+		Collector:     h.GetCollector(),
+		DocumentRef:   h.GetDocumentRef(),
+	}
+}
+
+func toIsDependencyInputSpec(d *pb.IsDependencyInputSpec) (*model.IsDependencyInputSpec, error) {
+	depType := model.DependencyType(d.GetDependencyType())
+	if depType == "" {
+		depType = model.DependencyTypeUnknown
+	}
+	if !depType.IsValid() {
+		return nil, fmt.Errorf("invalid dependency type: %q", d.GetDependencyType())
+	}
+	return &model.IsDependencyInputSpec{
+		DependencyType: depType,
+		Justification:  d.GetJustification(),
+		Origin:         d.GetOrigin(),
+		Collector:      d.GetCollector(),
+		DocumentRef:    d.GetDocumentRef(),
+	}, nil
+}
+
+func toIsOccurrenceInputSpec(o *pb.IsOccurrenceInputSpec) *model.IsOccurrenceInputSpec {
+	return &model.IsOccurrenceInputSpec{
+		Justification: o.GetJustification(),
+		Origin:        o.GetOrigin(),
+		Collector:     o.GetCollector(),
+		DocumentRef:   o.GetDocumentRef(),
+	}
+}
+
+func toPkgEqualInputSpec(p *pb.PkgEqualInputSpec) *model.PkgEqualInputSpec {
+	return &model.PkgEqualInputSpec{
+		Justification: p.GetJustification(),
+		Origin:        p.GetOrigin(),
+		Collector:     p.GetCollector(),
+		DocumentRef:   p.GetDocumentRef(),
+	}
+}
+
+func toPointOfContactInputSpec(p *pb.PointOfContactInputSpec) *model.PointOfContactInputSpec {
+	return &model.PointOfContactInputSpec{
+		Email:         p.GetEmail(),
+		Info:          p.GetInfo(),
+		Since:         toTime(p.GetSince()),
+		Justification: p.GetJustification(),
+		Origin:        p.GetOrigin(),
+		Collector:     p.GetCollector(),
+		DocumentRef:   p.GetDocumentRef(),
+	}
+}
+
+func toVexStatementInputSpec(v *pb.VexStatementInputSpec) (*model.VexStatementInputSpec, error) {
+	status := model.VexStatus(v.GetStatus())
+	if !status.IsValid() {
+		return nil, fmt.Errorf("invalid VEX status: %q", v.GetStatus())
+	}
+	justification := model.VexJustification(v.GetVexJustification())
+	if justification == "" {
+		justification = model.VexJustificationNotProvided
+	}
+	if !justification.IsValid() {
+		return nil, fmt.Errorf("invalid VEX justification: %q", v.GetVexJustification())
+	}
+	return &model.VexStatementInputSpec{
+		Status:           status,
+		VexJustification: justification,
+		Statement:        v.GetStatement(),
+		StatusNotes:      v.GetStatusNotes(),
+		KnownSince:       toTime(v.GetKnownSince()),
+		Origin:           v.GetOrigin(),
+		Collector:        v.GetCollector(),
+		DocumentRef:      v.GetDocumentRef(),
+	}, nil
+}
+
+func toVulnEqualInputSpec(v *pb.VulnEqualInputSpec) *model.VulnEqualInputSpec {
+	return &model.VulnEqualInputSpec{
+		Justification: v.GetJustification(),
+		Origin:        v.GetOrigin(),
+		Collector:     v.GetCollector(),
+		DocumentRef:   v.GetDocumentRef(),
+	}
+}
+
+func toVulnerabilityMetadataInputSpec(v *pb.VulnerabilityMetadataInputSpec) (*model.VulnerabilityMetadataInputSpec, error) {
+	scoreType := model.VulnerabilityScoreType(v.GetScoreType())
+	if !scoreType.IsValid() {
+		return nil, fmt.Errorf("invalid vulnerability score type: %q", v.GetScoreType())
+	}
+	return &model.VulnerabilityMetadataInputSpec{
+		ScoreType:   scoreType,
+		ScoreValue:  v.GetScoreValue(),
+		Timestamp:   toTime(v.GetTimestamp()),
+		Origin:      v.GetOrigin(),
+		Collector:   v.GetCollector(),
+		DocumentRef: v.GetDocumentRef(),
+	}, nil
+}
+
+func toDocumentInputSpec(d *pb.DocumentInputSpec) *model.DocumentInputSpec {
+	return &model.DocumentInputSpec{
+		BlobKey:       d.GetBlobKey(),
+		Sha256:        d.GetSha256(),
+		DocumentType:  d.GetDocumentType(),
+		Format:        d.GetFormat(),
+		Collector:     d.GetCollector(),
+		Source:        d.GetSource(),
+		ParserVersion: d.GetParserVersion(),
+		IngestedAt:    toTime(d.GetIngestedAt()),
+	}
+}
+
+// Query filters
+
+func toPkgSpec(p *pb.PkgSpec) *model.PkgSpec {
+	if p == nil {
+		return nil
+	}
+	var qualifiers []*model.PackageQualifierSpec
+	for _, q := range p.Qualifiers {
+		qualifiers = append(qualifiers, &model.PackageQualifierSpec{Key: q.Key, Value: q.Value})
+	}
+	return &model.PkgSpec{
+		ID:                       p.Id,
+		Type:                     p.Type,
+		Namespace:                p.Namespace,
+		Name:                     p.Name,
+		Version:                  p.Version,
+		Qualifiers:               qualifiers,
+		MatchOnlyEmptyQualifiers: p.MatchOnlyEmptyQualifiers,
+		Subpath:                  p.Subpath,
+	}
+}
+
+func toSourceSpec(s *pb.SourceSpec) *model.SourceSpec {
+	if s == nil {
+		return nil
+	}
+	return &model.SourceSpec{
+		ID:        s.Id,
+		Type:      s.Type,
+		Namespace: s.Namespace,
+		Name:      s.Name,
+		Tag:       s.Tag,
+		Commit:    s.Commit,
+	}
+}
+
+func toArtifactSpec(a *pb.ArtifactSpec) *model.ArtifactSpec {
+	if a == nil {
+		return nil
+	}
+	return &model.ArtifactSpec{ID: a.Id, Algorithm: a.Algorithm, Digest: a.Digest}
+}
+
+func toBuilderSpec(b *pb.BuilderSpec) *model.BuilderSpec {
+	if b == nil {
+		return nil
+	}
+	return &model.BuilderSpec{ID: b.Id, URI: b.Uri}
+}
+
+func toLicenseSpec(l *pb.LicenseSpec) *model.LicenseSpec {
+	if l == nil {
+		return nil
+	}
+	return &model.LicenseSpec{ID: l.Id, Name: l.Name, Inline: l.Inline, ListVersion: l.ListVersion}
+}
+
+func toVulnerabilitySpec(v *pb.VulnerabilitySpec) *model.VulnerabilitySpec {
+	if v == nil {
+		return nil
+	}
+	return &model.VulnerabilitySpec{ID: v.Id, Type: v.Type, VulnerabilityID: v.VulnerabilityId, NoVuln: v.NoVuln}
+}
+
+func toPackageOrSourceSpec(s *pb.PackageOrSourceSpec) *model.PackageOrSourceSpec {
+	if s == nil {
+		return nil
+	}
+	return &model.PackageOrSourceSpec{Package: toPkgSpec(s.Package), Source: toSourceSpec(s.Source)}
+}
+
+func toPackageOrArtifactSpec(s *pb.PackageOrArtifactSpec) *model.PackageOrArtifactSpec {
+	if s == nil {
+		return nil
+	}
+	return &model.PackageOrArtifactSpec{Package: toPkgSpec(s.Package), Artifact: toArtifactSpec(s.Artifact)}
+}
+
+func toIsDependencySpec(d *pb.IsDependencySpec) (*model.IsDependencySpec, error) {
+	if d == nil {
+		return &model.IsDependencySpec{}, nil
+	}
+	spec := &model.IsDependencySpec{
+		ID:                d.Id,
+		Package:           toPkgSpec(d.Package),
+		DependencyPackage: toPkgSpec(d.DependencyPackage),
+		Justification:     d.Justification,
+		Origin:            d.Origin,
+		Collector:         d.Collector,
+		DocumentRef:       d.DocumentRef,
+	}
+	if d.DependencyType != nil {
+		depType := model.DependencyType(*d.DependencyType)
+		if !depType.IsValid() {
+			return nil, fmt.Errorf("invalid dependency type: %q", *d.DependencyType)
+		}
+		spec.DependencyType = &depType
+	}
+	return spec, nil
+}
+
+func toIsOccurrenceSpec(o *pb.IsOccurrenceSpec) *model.IsOccurrenceSpec {
+	if o == nil {
+		return &model.IsOccurrenceSpec{}
+	}
+	return &model.IsOccurrenceSpec{
+		ID:            o.Id,
+		Subject:       toPackageOrSourceSpec(o.Subject),
+		Artifact:      toArtifactSpec(o.Artifact),
+		Justification: o.Justification,
+		Origin:        o.Origin,
+		Collector:     o.Collector,
+		DocumentRef:   o.DocumentRef,
+	}
+}
+
+func toHasSBOMSpec(h *pb.HasSBOMSpec) *model.HasSBOMSpec {
+	if h == nil {
+		return &model.HasSBOMSpec{}
+	}
+	return &model.HasSBOMSpec{
+		ID:               h.Id,
+		Subject:          toPackageOrArtifactSpec(h.Subject),
+		URI:              h.Uri,
+		Algorithm:        h.Algorithm,
+		Digest:           h.Digest,
+		DownloadLocation: h.DownloadLocation,
+		Origin:           h.Origin,
+		Collector:        h.Collector,
+		DocumentRef:      h.DocumentRef,
+	}
+}
+
+func toCertifyVulnSpec(c *pb.CertifyVulnSpec) *model.CertifyVulnSpec {
+	if c == nil {
+		return &model.CertifyVulnSpec{}
+	}
+	return &model.CertifyVulnSpec{
+		ID:             c.Id,
+		Package:        toPkgSpec(c.Package),
+		Vulnerability:  toVulnerabilitySpec(c.Vulnerability),
+		DbURI:          c.DbUri,
+		DbVersion:      c.DbVersion,
+		ScannerURI:     c.ScannerUri,
+		ScannerVersion: c.ScannerVersion,
+		Origin:         c.Origin,
+		Collector:      c.Collector,
+		DocumentRef:    c.DocumentRef,
+	}
+}
+
+func toCertifyVEXStatementSpec(c *pb.CertifyVEXStatementSpec) (*model.CertifyVEXStatementSpec, error) {
+	if c == nil {
+		return &model.CertifyVEXStatementSpec{}, nil
+	}
+	spec := &model.CertifyVEXStatementSpec{
+		ID:            c.Id,
+		Subject:       toPackageOrArtifactSpec(c.Subject),
+		Vulnerability: toVulnerabilitySpec(c.Vulnerability),
+		Statement:     c.Statement,
+		StatusNotes:   c.StatusNotes,
+		Origin:        c.Origin,
+		Collector:     c.Collector,
+		DocumentRef:   c.DocumentRef,
+	}
+	if c.Status != nil {
+		status := model.VexStatus(*c.Status)
+		if !status.IsValid() {
+			return nil, fmt.Errorf("invalid VEX status: %q", *c.Status)
+		}
+		spec.Status = &status
+	}
+	if c.VexJustification != nil {
+		justification := model.VexJustification(*c.VexJustification)
+		if !justification.IsValid() {
+			return nil, fmt.Errorf("invalid VEX justification: %q", *c.VexJustification)
+		}
+		spec.VexJustification = &justification
+	}
+	return spec, nil
+}
+
+func toPackageSourceOrArtifactSpec(s *pb.PackageSourceOrArtifactSpec) *model.PackageSourceOrArtifactSpec {
+	if s == nil {
+		return nil
+	}
+	return &model.PackageSourceOrArtifactSpec{
+		Package:  toPkgSpec(s.Package),
+		Source:   toSourceSpec(s.Source),
+		Artifact: toArtifactSpec(s.Artifact),
+	}
+}
+
+func toCertifyBadSpec(c *pb.CertifyBadSpec) *model.CertifyBadSpec {
+	if c == nil {
+		return &model.CertifyBadSpec{}
+	}
+	return &model.CertifyBadSpec{
+		ID:            c.Id,
+		Subject:       toPackageSourceOrArtifactSpec(c.Subject),
+		Justification: c.Justification,
+		Origin:        c.Origin,
+		Collector:     c.Collector,
+		DocumentRef:   c.DocumentRef,
+	}
+}
+
+func toCertifyGoodSpec(c *pb.CertifyGoodSpec) *model.CertifyGoodSpec {
+	if c == nil {
+		return &model.CertifyGoodSpec{}
+	}
+	return &model.CertifyGoodSpec{
+		ID:            c.Id,
+		Subject:       toPackageSourceOrArtifactSpec(c.Subject),
+		Justification: c.Justification,
+		Origin:        c.Origin,
+		Collector:     c.Collector,
+		DocumentRef:   c.DocumentRef,
+	}
+}
+
+func toHasSLSASpec(h *pb.HasSLSASpec) *model.HasSLSASpec {
+	if h == nil {
+		return &model.HasSLSASpec{}
+	}
+	spec := &model.HasSLSASpec{
+		ID:          h.Id,
+		Subject:     toArtifactSpec(h.Subject),
+		BuiltBy:     toBuilderSpec(h.BuiltBy),
+		BuildType:   h.BuildType,
+		SlsaVersion: h.SlsaVersion,
+		Origin:      h.Origin,
+		Collector:   h.Collector,
+		DocumentRef: h.DocumentRef,
+	}
+	for _, a := range h.BuiltFrom {
+		spec.BuiltFrom = append(spec.BuiltFrom, toArtifactSpec(a))
+	}
+	for _, p := range h.Predicate {
+		spec.Predicate = append(spec.Predicate, &model.SLSAPredicateSpec{Key: p.Key, Value: p.Value})
+	}
+	return spec
+}
+
+func toHasSourceAtSpec(h *pb.HasSourceAtSpec) *model.HasSourceAtSpec {
+	if h == nil {
+		return &model.HasSourceAtSpec{}
+	}
+	return &model.HasSourceAtSpec{
+		ID:            h.Id,
+		Package:       toPkgSpec(h.Package),
+		Source:        toSourceSpec(h.Source),
+		Justification: h.Justification,
+		Origin:        h.Origin,
+		Collector:     h.Collector,
+		DocumentRef:   h.DocumentRef,
+	}
+}
+
+func toCertifyScorecardSpec(c *pb.CertifyScorecardSpec) *model.CertifyScorecardSpec {
+	if c == nil {
+		return &model.CertifyScorecardSpec{}
+	}
+	spec := &model.CertifyScorecardSpec{
+		ID:               c.Id,
+		Source:           toSourceSpec(c.Source),
+		AggregateScore:   c.AggregateScore,
+		ScorecardVersion: c.ScorecardVersion,
+		ScorecardCommit:  c.ScorecardCommit,
+		Origin:           c.Origin,
+		Collector:        c.Collector,
+		DocumentRef:      c.DocumentRef,
+	}
+	for _, check := range c.Checks {
+		spec.Checks = append(spec.Checks, &model.ScorecardCheckSpec{Check: check.Check, Score: int(check.Score)})
+	}
+	return spec
+}
+
+func toCertifyLegalSpec(c *pb.CertifyLegalSpec) *model.CertifyLegalSpec {
+	if c == nil {
+		return &model.CertifyLegalSpec{}
+	}
+	spec := &model.CertifyLegalSpec{
+		ID:                c.Id,
+		Subject:           toPackageOrSourceSpec(c.Subject),
+		DeclaredLicense:   c.DeclaredLicense,
+		DiscoveredLicense: c.DiscoveredLicense,
+		Attribution:       c.Attribution,
+		Justification:     c.Justification,
+		Origin:            c.Origin,
+		Collector:         c.Collector,
+		DocumentRef:       c.DocumentRef,
+	}
+	for _, l := range c.DeclaredLicenses {
+		spec.DeclaredLicenses = append(spec.DeclaredLicenses, toLicenseSpec(l))
+	}
+	for _, l := range c.DiscoveredLicenses {
+		spec.DiscoveredLicenses = append(spec.DiscoveredLicenses, toLicenseSpec(l))
+	}
+	return spec
+}
+
+func toPkgEqualSpec(p *pb.PkgEqualSpec) *model.PkgEqualSpec {
+	if p == nil {
+		return &model.PkgEqualSpec{}
+	}
+	spec := &model.PkgEqualSpec{
+		ID:            p.Id,
+		Justification: p.Justification,
+		Origin:        p.Origin,
+		Collector:     p.Collector,
+		DocumentRef:   p.DocumentRef,
+	}
+	for _, pkg := range p.Packages {
+		spec.Packages = append(spec.Packages, toPkgSpec(pkg))
+	}
+	return spec
+}
+
+func toVulnEqualSpec(v *pb.VulnEqualSpec) *model.VulnEqualSpec {
+	if v == nil {
+		return &model.VulnEqualSpec{}
+	}
+	spec := &model.VulnEqualSpec{
+		ID:            v.Id,
+		Justification: v.Justification,
+		Origin:        v.Origin,
+		Collector:     v.Collector,
+		DocumentRef:   v.DocumentRef,
+	}
+	for _, vuln := range v.Vulnerabilities {
+		spec.Vulnerabilities = append(spec.Vulnerabilities, toVulnerabilitySpec(vuln))
+	}
+	return spec
+}
+
+func toHashEqualSpec(h *pb.HashEqualSpec) *model.HashEqualSpec {
+	if h == nil {
+		return &model.HashEqualSpec{}
+	}
+	spec := &model.HashEqualSpec{
+		ID:            h.Id,
+		Justification: h.Justification,
+		Origin:        h.Origin,
+		Collector:     h.Collector,
+		DocumentRef:   h.DocumentRef,
+	}
+	for _, a := range h.Artifacts {
+		spec.Artifacts = append(spec.Artifacts, toArtifactSpec(a))
+	}
+	return spec
+}
+
+func toHasMetadataSpec(h *pb.HasMetadataSpec) *model.HasMetadataSpec {
+	if h == nil {
+		return &model.HasMetadataSpec{}
+	}
+	return &model.HasMetadataSpec{
+		ID:            h.Id,
+		Subject:       toPackageSourceOrArtifactSpec(h.Subject),
+		Key:           h.Key,
+		Value:         h.Value,
+		Justification: h.Justification,
+		Origin:        h.Origin,
+		Collector:     h.Collector,
+		DocumentRef:   h.DocumentRef,
+	}
+}
+
+func toPointOfContactSpec(p *pb.PointOfContactSpec) *model.PointOfContactSpec {
+	if p == nil {
+		return &model.PointOfContactSpec{}
+	}
+	return &model.PointOfContactSpec{
+		ID:            p.Id,
+		Subject:       toPackageSourceOrArtifactSpec(p.Subject),
+		Email:         p.Email,
+		Info:          p.Info,
+		Justification: p.Justification,
+		Origin:        p.Origin,
+		Collector:     p.Collector,
+		DocumentRef:   p.DocumentRef,
+	}
+}
+
+func toVulnerabilityMetadataSpec(v *pb.VulnerabilityMetadataSpec) (*model.VulnerabilityMetadataSpec, error) {
+	if v == nil {
+		return &model.VulnerabilityMetadataSpec{}, nil
+	}
+	spec := &model.VulnerabilityMetadataSpec{
+		ID:            v.Id,
+		Vulnerability: toVulnerabilitySpec(v.Vulnerability),
+		ScoreValue:    v.ScoreValue,
+		Origin:        v.Origin,
+		Collector:     v.Collector,
+		DocumentRef:   v.DocumentRef,
+	}
+	if v.ScoreType != nil {
+		scoreType := model.VulnerabilityScoreType(*v.ScoreType)
+		if !scoreType.IsValid() {
+			return nil, fmt.Errorf("invalid score type: %q", *v.ScoreType)
+		}
+		spec.ScoreType = &scoreType
+	}
+	if v.Comparator != nil {
+		comparator := model.Comparator(*v.Comparator)
+		if !comparator.IsValid() {
+			return nil, fmt.Errorf("invalid comparator: %q", *v.Comparator)
+		}
+		spec.Comparator = &comparator
+	}
+	return spec, nil
+}
+
+// Query results
+
+func fromPackage(p *model.Package) *pb.Package {
+	if p == nil {
+		return nil
+	}
+	out := &pb.Package{Id: p.ID, Type: p.Type}
+	for _, ns := range p.Namespaces {
+		pbNs := &pb.PackageNamespace{Id: ns.ID, Namespace: ns.Namespace}
+		for _, n := range ns.Names {
+			pbName := &pb.PackageName{Id: n.ID, Name: n.Name}
+			for _, v := range n.Versions {
+				pbVersion := &pb.PackageVersion{Id: v.ID, Purl: v.Purl, Version: v.Version, Subpath: v.Subpath}
+				for _, q := range v.Qualifiers {
+					pbVersion.Qualifiers = append(pbVersion.Qualifiers, &pb.PackageQualifier{Key: q.Key, Value: q.Value})
+				}
+				pbName.Versions = append(pbName.Versions, pbVersion)
+			}
+			pbNs.Names = append(pbNs.Names, pbName)
+		}
+		out.Namespaces = append(out.Namespaces, pbNs)
+	}
+	return out
+}
+
+func fromSource(s *model.Source) *pb.Source {
+	if s == nil {
+		return nil
+	}
+	out := &pb.Source{Id: s.ID, Type: s.Type}
+	for _, ns := range s.Namespaces {
+		pbNs := &pb.SourceNamespace{Id: ns.ID, Namespace: ns.Namespace}
+		for _, n := range ns.Names {
+			pbNs.Names = append(pbNs.Names, &pb.SourceName{Id: n.ID, Name: n.Name, Tag: n.Tag, Commit: n.Commit})
+		}
+		out.Namespaces = append(out.Namespaces, pbNs)
+	}
+	return out
+}
+
+func fromArtifact(a *model.Artifact) *pb.Artifact {
+	if a == nil {
+		return nil
+	}
+	return &pb.Artifact{Id: a.ID, Algorithm: a.Algorithm, Digest: a.Digest}
+}
+
+func fromBuilder(b *model.Builder) *pb.Builder {
+	return &pb.Builder{Id: b.ID, Uri: b.URI}
+}
+
+func fromLicense(l *model.License) *pb.License {
+	return &pb.License{Id: l.ID, Name: l.Name, Inline: l.Inline, ListVersion: l.ListVersion}
+}
+
+func fromVulnerability(v *model.Vulnerability) *pb.Vulnerability {
+	if v == nil {
+		return nil
+	}
+	out := &pb.Vulnerability{Id: v.ID, Type: v.Type}
+	for _, id := range v.VulnerabilityIDs {
+		out.VulnerabilityIds = append(out.VulnerabilityIds, &pb.VulnerabilityID{Id: id.ID, VulnerabilityId: id.VulnerabilityID})
+	}
+	return out
+}
+
+func fromPackageOrSource(s model.PackageOrSource) *pb.PackageOrSource {
+	switch v := s.(type) {
+	case *model.Package:
+		return &pb.PackageOrSource{Subject: &pb.PackageOrSource_Package{Package: fromPackage(v)}}
+	case *model.Source:
+		return &pb.PackageOrSource{Subject: &pb.PackageOrSource_Source{Source: fromSource(v)}}
+	}
+	return nil
+}
+
+func fromPackageOrArtifact(s model.PackageOrArtifact) *pb.PackageOrArtifact {
+	switch v := s.(type) {
+	case *model.Package:
+		return &pb.PackageOrArtifact{Subject: &pb.PackageOrArtifact_Package{Package: fromPackage(v)}}
+	case *model.Artifact:
+		return &pb.PackageOrArtifact{Subject: &pb.PackageOrArtifact_Artifact{Artifact: fromArtifact(v)}}
+	}
+	return nil
+}
+
+func packageOrArtifactID(s model.PackageOrArtifact) string {
+	switch v := s.(type) {
+	case *model.Package:
+		if len(v.Namespaces) > 0 && len(v.Namespaces[0].Names) > 0 && len(v.Namespaces[0].Names[0].Versions) > 0 {
+			return v.Namespaces[0].Names[0].Versions[0].ID
+		}
+		return v.ID
+	case *model.Artifact:
+		return v.ID
+	}
+	return ""
+}
+
+func fromIsDependency(d *model.IsDependency) *pb.IsDependency {
+	return &pb.IsDependency{
+		Id:                d.ID,
+		Package:           fromPackage(d.Package),
+		DependencyPackage: fromPackage(d.DependencyPackage),
+		DependencyType:    string(d.DependencyType),
+		Justification:     d.Justification,
+		Origin:            d.Origin,
+		Collector:         d.Collector,
+		DocumentRef:       d.DocumentRef,
+	}
+}
+
+func fromIsOccurrence(o *model.IsOccurrence) *pb.IsOccurrence {
+	return &pb.IsOccurrence{
+		Id:            o.ID,
+		Subject:       fromPackageOrSource(o.Subject),
+		Artifact:      fromArtifact(o.Artifact),
+		Justification: o.Justification,
+		Origin:        o.Origin,
+		Collector:     o.Collector,
+		DocumentRef:   o.DocumentRef,
+	}
+}
+
+func fromHasSBOM(h *model.HasSbom) *pb.HasSBOM {
+	out := &pb.HasSBOM{
+		Id:               h.ID,
+		Subject:          fromPackageOrArtifact(h.Subject),
+		Uri:              h.URI,
+		Algorithm:        h.Algorithm,
+		Digest:           h.Digest,
+		DownloadLocation: h.DownloadLocation,
+		KnownSince:       timestamppb.New(h.KnownSince),
+		Origin:           h.Origin,
+		Collector:        h.Collector,
+		DocumentRef:      h.DocumentRef,
+	}
+	for _, s := range h.IncludedSoftware {
+		out.IncludedSoftwareIds = append(out.IncludedSoftwareIds, packageOrArtifactID(s))
+	}
+	for _, d := range h.IncludedDependencies {
+		out.IncludedDependencyIds = append(out.IncludedDependencyIds, d.ID)
+	}
+	for _, o := range h.IncludedOccurrences {
+		out.IncludedOccurrenceIds = append(out.IncludedOccurrenceIds, o.ID)
+	}
+	return out
+}
+
+func fromCertifyVuln(c *model.CertifyVuln) *pb.CertifyVuln {
+	out := &pb.CertifyVuln{
+		Id:            c.ID,
+		Package:       fromPackage(c.Package),
+		Vulnerability: fromVulnerability(c.Vulnerability),
+	}
+	if c.Metadata != nil {
+		out.Metadata = &pb.ScanMetadata{
+			TimeScanned:    timestamppb.New(c.Metadata.TimeScanned),
+			DbUri:          c.Metadata.DbURI,
+			DbVersion:      c.Metadata.DbVersion,
+			ScannerUri:     c.Metadata.ScannerURI,
+			ScannerVersion: c.Metadata.ScannerVersion,
+			Origin:         c.Metadata.Origin,
+			Collector:      c.Metadata.Collector,
+			DocumentRef:    c.Metadata.DocumentRef,
+		}
+	}
+	return out
+}
+
+func fromCertifyVEXStatement(c *model.CertifyVEXStatement) *pb.CertifyVEXStatement {
+	return &pb.CertifyVEXStatement{
+		Id:               c.ID,
+		Subject:          fromPackageOrArtifact(c.Subject),
+		Vulnerability:    fromVulnerability(c.Vulnerability),
+		Status:           string(c.Status),
+		VexJustification: string(c.VexJustification),
+		Statement:        c.Statement,
+		StatusNotes:      c.StatusNotes,
+		KnownSince:       timestamppb.New(c.KnownSince),
+		Origin:           c.Origin,
+		Collector:        c.Collector,
+		DocumentRef:      c.DocumentRef,
+	}
+}
+
+func fromPackageSourceOrArtifact(s model.PackageSourceOrArtifact) *pb.PackageSourceOrArtifact {
+	switch v := s.(type) {
+	case *model.Package:
+		return &pb.PackageSourceOrArtifact{Subject: &pb.PackageSourceOrArtifact_Package{Package: fromPackage(v)}}
+	case *model.Source:
+		return &pb.PackageSourceOrArtifact{Subject: &pb.PackageSourceOrArtifact_Source{Source: fromSource(v)}}
+	case *model.Artifact:
+		return &pb.PackageSourceOrArtifact{Subject: &pb.PackageSourceOrArtifact_Artifact{Artifact: fromArtifact(v)}}
+	}
+	return nil
+}
+
+func fromCertifyBad(c *model.CertifyBad) *pb.CertifyBad {
+	return &pb.CertifyBad{
+		Id:            c.ID,
+		Subject:       fromPackageSourceOrArtifact(c.Subject),
+		Justification: c.Justification,
+		KnownSince:    timestamppb.New(c.KnownSince),
+		Origin:        c.Origin,
+		Collector:     c.Collector,
+		DocumentRef:   c.DocumentRef,
+	}
+}
+
+func fromCertifyGood(c *model.CertifyGood) *pb.CertifyGood {
+	return &pb.CertifyGood{
+		Id:            c.ID,
+		Subject:       fromPackageSourceOrArtifact(c.Subject),
+		Justification: c.Justification,
+		KnownSince:    timestamppb.New(c.KnownSince),
+		Origin:        c.Origin,
+		Collector:     c.Collector,
+		DocumentRef:   c.DocumentRef,
+	}
+}
+
+func fromHasSLSA(h *model.HasSlsa) *pb.HasSLSA {
+	out := &pb.HasSLSA{
+		Id:      h.ID,
+		Subject: fromArtifact(h.Subject),
+	}
+	if h.Slsa != nil {
+		slsa := &pb.SLSA{
+			BuildType:   h.Slsa.BuildType,
+			SlsaVersion: h.Slsa.SlsaVersion,
+			Origin:      h.Slsa.Origin,
+			Collector:   h.Slsa.Collector,
+			DocumentRef: h.Slsa.DocumentRef,
+		}
+		if h.Slsa.BuiltBy != nil {
+			slsa.BuiltBy = fromBuilder(h.Slsa.BuiltBy)
+		}
+		if h.Slsa.StartedOn != nil {
+			slsa.StartedOn = timestamppb.New(*h.Slsa.StartedOn)
+		}
+		if h.Slsa.FinishedOn != nil {
+			slsa.FinishedOn = timestamppb.New(*h.Slsa.FinishedOn)
+		}
+		for _, a := range h.Slsa.BuiltFrom {
+			slsa.BuiltFrom = append(slsa.BuiltFrom, fromArtifact(a))
+		}
+		for _, p := range h.Slsa.SlsaPredicate {
+			slsa.SlsaPredicate = append(slsa.SlsaPredicate, &pb.SLSAPredicate{Key: p.Key, Value: p.Value})
+		}
+		out.Slsa = slsa
+	}
+	return out
+}
+
+func fromHasSourceAt(h *model.HasSourceAt) *pb.HasSourceAt {
+	return &pb.HasSourceAt{
+		Id:            h.ID,
+		Package:       fromPackage(h.Package),
+		Source:        fromSource(h.Source),
+		KnownSince:    timestamppb.New(h.KnownSince),
+		Justification: h.Justification,
+		Origin:        h.Origin,
+		Collector:     h.Collector,
+		DocumentRef:   h.DocumentRef,
+	}
+}
+
+func fromCertifyScorecard(c *model.CertifyScorecard) *pb.CertifyScorecard {
+	out := &pb.CertifyScorecard{
+		Id:     c.ID,
+		Source: fromSource(c.Source),
+	}
+	if c.Scorecard != nil {
+		scorecard := &pb.Scorecard{
+			AggregateScore:   c.Scorecard.AggregateScore,
+			TimeScanned:      timestamppb.New(c.Scorecard.TimeScanned),
+			ScorecardVersion: c.Scorecard.ScorecardVersion,
+			ScorecardCommit:  c.Scorecard.ScorecardCommit,
+			Origin:           c.Scorecard.Origin,
+			Collector:        c.Scorecard.Collector,
+			DocumentRef:      c.Scorecard.DocumentRef,
+		}
+		for _, check := range c.Scorecard.Checks {
+			scorecard.Checks = append(scorecard.Checks, &pb.ScorecardCheck{Check: check.Check, Score: int32(check.Score)})
+		}
+		out.Scorecard = scorecard
+	}
+	return out
+}
+
+func fromCertifyLegal(c *model.CertifyLegal) *pb.CertifyLegal {
+	out := &pb.CertifyLegal{
+		Id:                c.ID,
+		Subject:           fromPackageOrSource(c.Subject),
+		DeclaredLicense:   c.DeclaredLicense,
+		DiscoveredLicense: c.DiscoveredLicense,
+		Attribution:       c.Attribution,
+		Justification:     c.Justification,
+		TimeScanned:       timestamppb.New(c.TimeScanned),
+		Origin:            c.Origin,
+		Collector:         c.Collector,
+		DocumentRef:       c.DocumentRef,
+	}
+	for _, l := range c.DeclaredLicenses {
+		out.DeclaredLicenses = append(out.DeclaredLicenses, fromLicense(l))
+	}
+	for _, l := range c.DiscoveredLicenses {
+		out.DiscoveredLicenses = append(out.DiscoveredLicenses, fromLicense(l))
+	}
+	return out
+}
+
+func fromPkgEqual(p *model.PkgEqual) *pb.PkgEqual {
+	out := &pb.PkgEqual{
+		Id:            p.ID,
+		Justification: p.Justification,
+		Origin:        p.Origin,
+		Collector:     p.Collector,
+		DocumentRef:   p.DocumentRef,
+	}
+	for _, pkg := range p.Packages {
+		out.Packages = append(out.Packages, fromPackage(pkg))
+	}
+	return out
+}
+
+func fromVulnEqual(v *model.VulnEqual) *pb.VulnEqual {
+	out := &pb.VulnEqual{
+		Id:            v.ID,
+		Justification: v.Justification,
+		Origin:        v.Origin,
+		Collector:     v.Collector,
+		DocumentRef:   v.DocumentRef,
+	}
+	for _, vuln := range v.Vulnerabilities {
+		out.Vulnerabilities = append(out.Vulnerabilities, fromVulnerability(vuln))
+	}
+	return out
+}
+
+func fromHashEqual(h *model.HashEqual) *pb.HashEqual {
+	out := &pb.HashEqual{
+		Id:            h.ID,
+		Justification: h.Justification,
+		Origin:        h.Origin,
+		Collector:     h.Collector,
+		DocumentRef:   h.DocumentRef,
+	}
+	for _, a := range h.Artifacts {
+		out.Artifacts = append(out.Artifacts, fromArtifact(a))
+	}
+	return out
+}
+
+func fromHasMetadata(h *model.HasMetadata) *pb.HasMetadata {
+	return &pb.HasMetadata{
+		Id:            h.ID,
+		Subject:       fromPackageSourceOrArtifact(h.Subject),
+		Key:           h.Key,
+		Value:         h.Value,
+		Timestamp:     timestamppb.New(h.Timestamp),
+		Justification: h.Justification,
+		Origin:        h.Origin,
+		Collector:     h.Collector,
+		DocumentRef:   h.DocumentRef,
+	}
+}
+
+func fromPointOfContact(p *model.PointOfContact) *pb.PointOfContact {
+	return &pb.PointOfContact{
+		Id:            p.ID,
+		Subject:       fromPackageSourceOrArtifact(p.Subject),
+		Email:         p.Email,
+		Info:          p.Info,
+		Since:         timestamppb.New(p.Since),
+		Justification: p.Justification,
+		Origin:        p.Origin,
+		Collector:     p.Collector,
+		DocumentRef:   p.DocumentRef,
+	}
+}
+
+func fromVulnerabilityMetadata(v *model.VulnerabilityMetadata) *pb.VulnerabilityMetadata {
+	return &pb.VulnerabilityMetadata{
+		Id:            v.ID,
+		Vulnerability: fromVulnerability(v.Vulnerability),
+		ScoreType:     string(v.ScoreType),
+		ScoreValue:    v.ScoreValue,
+		Timestamp:     timestamppb.New(v.Timestamp),
+		Origin:        v.Origin,
+		Collector:     v.Collector,
+		DocumentRef:   v.DocumentRef,
+	}
+}
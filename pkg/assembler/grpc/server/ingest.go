@@ -0,0 +1,1090 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	pb "github.com/guacsec/guac/pkg/assembler/grpc/guacapi"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *server) IngestPredicates(stream pb.GuacService_IngestPredicatesServer) error {
+	ctx := stream.Context()
+	logger := ctxzap.Extract(ctx).Sugar()
+
+	response := &pb.IngestPredicatesResponse{}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			logger.Infof("IngestPredicates ingested %d batches", response.Batches)
+			return stream.SendAndClose(response)
+		}
+		if err != nil {
+			return err
+		}
+
+		ingested, err := ingestPredicates(ctx, s.mutations, req.GetPredicates())
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to ingest predicates batch %d: %v", response.Batches, err)
+		}
+		response.Batches++
+		response.HasSbomIds = append(response.HasSbomIds, ingested.hasSBOMIDs...)
+		response.HasSlsaIds = append(response.HasSlsaIds, ingested.hasSLSAIDs...)
+		response.PackageIds = append(response.PackageIds, ingested.includes.Packages...)
+		response.ArtifactIds = append(response.ArtifactIds, ingested.includes.Artifacts...)
+		response.IsDependencyIds = append(response.IsDependencyIds, ingested.includes.Dependencies...)
+		response.IsOccurrenceIds = append(response.IsOccurrenceIds, ingested.includes.Occurrences...)
+	}
+}
+
+type ingestedIDs struct {
+	hasSBOMIDs []string
+	hasSLSAIDs []string
+	includes   model.HasSBOMIncludesInputSpec
+}
+
+// softwareTrees holds the IDs of all the software tree nodes referenced by a
+// batch of predicates, keyed the same way as the client bulk assembler.
+type softwareTrees struct {
+	packages  map[string]*model.IDorPkgInput
+	sources   map[string]*model.IDorSourceInput
+	artifacts map[string]*model.IDorArtifactInput
+	builders  map[string]*model.IDorBuilderInput
+	vulns     map[string]*model.IDorVulnerabilityInput
+	licenses  map[string]*model.IDorLicenseInput
+}
+
+func newSoftwareTrees() *softwareTrees {
+	return &softwareTrees{
+		packages:  map[string]*model.IDorPkgInput{},
+		sources:   map[string]*model.IDorSourceInput{},
+		artifacts: map[string]*model.IDorArtifactInput{},
+		builders:  map[string]*model.IDorBuilderInput{},
+		vulns:     map[string]*model.IDorVulnerabilityInput{},
+		licenses:  map[string]*model.IDorLicenseInput{},
+	}
+}
+
+func (t *softwareTrees) addPkg(p *pb.PkgInputSpec) {
+	if p == nil {
+		return
+	}
+	spec := toPkgInputSpec(p)
+	t.packages[helpers.PkgServerKey(spec).VersionId] = &model.IDorPkgInput{PackageInput: spec}
+}
+
+func (t *softwareTrees) addSrc(s *pb.SourceInputSpec) {
+	if s == nil {
+		return
+	}
+	spec := toSourceInputSpec(s)
+	t.sources[helpers.SrcServerKey(spec).NameId] = &model.IDorSourceInput{SourceInput: spec}
+}
+
+func (t *softwareTrees) addArtifact(a *pb.ArtifactInputSpec) {
+	if a == nil {
+		return
+	}
+	spec := toArtifactInputSpec(a)
+	t.artifacts[helpers.ArtifactServerKey(spec)] = &model.IDorArtifactInput{ArtifactInput: spec}
+}
+
+func (t *softwareTrees) addBuilder(b *pb.BuilderInputSpec) {
+	if b == nil {
+		return
+	}
+	t.builders[b.Uri] = &model.IDorBuilderInput{BuilderInput: toBuilderInputSpec(b)}
+}
+
+func (t *softwareTrees) addVuln(v *pb.VulnerabilityInputSpec) {
+	if v == nil {
+		return
+	}
+	spec := toVulnerabilityInputSpec(v)
+	t.vulns[helpers.VulnServerKey(spec).VulnerabilityID] = &model.IDorVulnerabilityInput{VulnerabilityInput: spec}
+}
+
+func (t *softwareTrees) addLicense(l *pb.LicenseInputSpec) {
+	if l == nil {
+		return
+	}
+	spec := toLicenseInputSpec(l)
+	t.licenses[helpers.LicenseServerKey(spec)] = &model.IDorLicenseInput{LicenseInput: spec}
+}
+
+func (t *softwareTrees) pkg(p *pb.PkgInputSpec) (*model.IDorPkgInput, error) {
+	key := helpers.PkgServerKey(toPkgInputSpec(p)).VersionId
+	if id, ok := t.packages[key]; ok {
+		return id, nil
+	}
+	return nil, fmt.Errorf("failed to find ingested Package ID: %s", key)
+}
+
+func (t *softwareTrees) src(s *pb.SourceInputSpec) (*model.IDorSourceInput, error) {
+	key := helpers.SrcServerKey(toSourceInputSpec(s)).NameId
+	if id, ok := t.sources[key]; ok {
+		return id, nil
+	}
+	return nil, fmt.Errorf("failed to find ingested Source ID: %s", key)
+}
+
+func (t *softwareTrees) artifact(a *pb.ArtifactInputSpec) (*model.IDorArtifactInput, error) {
+	key := helpers.ArtifactServerKey(toArtifactInputSpec(a))
+	if id, ok := t.artifacts[key]; ok {
+		return id, nil
+	}
+	return nil, fmt.Errorf("failed to find ingested Artifact ID: %s", key)
+}
+
+func (t *softwareTrees) builder(b *pb.BuilderInputSpec) (*model.IDorBuilderInput, error) {
+	if id, ok := t.builders[b.GetUri()]; ok {
+		return id, nil
+	}
+	return nil, fmt.Errorf("failed to find ingested Builder ID: %s", b.GetUri())
+}
+
+func (t *softwareTrees) vuln(v *pb.VulnerabilityInputSpec) (*model.IDorVulnerabilityInput, error) {
+	key := helpers.VulnServerKey(toVulnerabilityInputSpec(v)).VulnerabilityID
+	if id, ok := t.vulns[key]; ok {
+		return id, nil
+	}
+	return nil, fmt.Errorf("failed to find ingested Vulnerability ID: %s", key)
+}
+
+func (t *softwareTrees) license(l *pb.LicenseInputSpec) (*model.IDorLicenseInput, error) {
+	key := helpers.LicenseServerKey(toLicenseInputSpec(l))
+	if id, ok := t.licenses[key]; ok {
+		return id, nil
+	}
+	return nil, fmt.Errorf("failed to find ingested License ID: %s", key)
+}
+
+// collect walks all the predicates and records every software tree node that
+// needs to exist before the evidence can be ingested.
+func (t *softwareTrees) collect(p *pb.IngestPredicates) {
+	for _, v := range p.CertifyScorecard {
+		t.addSrc(v.Source)
+	}
+	for _, v := range p.IsDependency {
+		t.addPkg(v.Pkg)
+		t.addPkg(v.DepPkg)
+	}
+	for _, v := range p.IsOccurrence {
+		t.addPkg(v.Pkg)
+		t.addSrc(v.Src)
+		t.addArtifact(v.Artifact)
+	}
+	for _, v := range p.HasSlsa {
+		t.addArtifact(v.Artifact)
+		for _, m := range v.Materials {
+			t.addArtifact(m)
+		}
+		t.addBuilder(v.Builder)
+	}
+	for _, v := range p.CertifyVuln {
+		t.addPkg(v.Pkg)
+		t.addVuln(v.Vulnerability)
+	}
+	for _, v := range p.VulnEqual {
+		t.addVuln(v.Vulnerability)
+		t.addVuln(v.EqualVulnerability)
+	}
+	for _, v := range p.HasSourceAt {
+		t.addPkg(v.Pkg)
+		t.addSrc(v.Src)
+	}
+	for _, v := range p.CertifyBad {
+		t.addPkg(v.Pkg)
+		t.addSrc(v.Src)
+		t.addArtifact(v.Artifact)
+	}
+	for _, v := range p.CertifyGood {
+		t.addPkg(v.Pkg)
+		t.addSrc(v.Src)
+		t.addArtifact(v.Artifact)
+	}
+	for _, v := range p.HasSbom {
+		t.addPkg(v.Pkg)
+		t.addArtifact(v.Artifact)
+	}
+	for _, v := range p.HashEqual {
+		t.addArtifact(v.Artifact)
+		t.addArtifact(v.EqualArtifact)
+	}
+	for _, v := range p.PkgEqual {
+		t.addPkg(v.Pkg)
+		t.addPkg(v.EqualPkg)
+	}
+	for _, v := range p.Vex {
+		t.addPkg(v.Pkg)
+		t.addArtifact(v.Artifact)
+		t.addVuln(v.Vulnerability)
+	}
+	for _, v := range p.PointOfContact {
+		t.addPkg(v.Pkg)
+		t.addSrc(v.Src)
+		t.addArtifact(v.Artifact)
+	}
+	for _, v := range p.VulnMetadata {
+		t.addVuln(v.Vulnerability)
+	}
+	for _, v := range p.HasMetadata {
+		t.addPkg(v.Pkg)
+		t.addSrc(v.Src)
+		t.addArtifact(v.Artifact)
+	}
+	for _, v := range p.CertifyLegal {
+		t.addPkg(v.Pkg)
+		t.addSrc(v.Src)
+		for _, l := range v.Declared {
+			t.addLicense(l)
+		}
+		for _, l := range v.Discovered {
+			t.addLicense(l)
+		}
+	}
+}
+
+// ingest creates all software tree nodes in the backend and replaces the
+// inputs with their IDs, so that evidence ingestion does not need to look them
+// up again.
+func (t *softwareTrees) ingest(ctx context.Context, m generated.MutationResolver) error {
+	if len(t.packages) > 0 {
+		keys, inputs := splitMap(t.packages)
+		ids, err := m.IngestPackages(ctx, inputs)
+		if err != nil {
+			return fmt.Errorf("IngestPackages failed with error: %w", err)
+		}
+		for i := range ids {
+			t.packages[keys[i]] = &model.IDorPkgInput{
+				PackageInput:       inputs[i].PackageInput,
+				PackageTypeID:      &ids[i].PackageTypeID,
+				PackageNamespaceID: &ids[i].PackageNamespaceID,
+				PackageNameID:      &ids[i].PackageNameID,
+				PackageVersionID:   &ids[i].PackageVersionID,
+			}
+		}
+	}
+	if len(t.sources) > 0 {
+		keys, inputs := splitMap(t.sources)
+		ids, err := m.IngestSources(ctx, inputs)
+		if err != nil {
+			return fmt.Errorf("IngestSources failed with error: %w", err)
+		}
+		for i := range ids {
+			t.sources[keys[i]] = &model.IDorSourceInput{
+				SourceInput:       inputs[i].SourceInput,
+				SourceTypeID:      &ids[i].SourceTypeID,
+				SourceNamespaceID: &ids[i].SourceNamespaceID,
+				SourceNameID:      &ids[i].SourceNameID,
+			}
+		}
+	}
+	if len(t.artifacts) > 0 {
+		keys, inputs := splitMap(t.artifacts)
+		ids, err := m.IngestArtifacts(ctx, inputs)
+		if err != nil {
+			return fmt.Errorf("IngestArtifacts failed with error: %w", err)
+		}
+		for i := range ids {
+			t.artifacts[keys[i]] = &model.IDorArtifactInput{ArtifactInput: inputs[i].ArtifactInput, ArtifactID: &ids[i]}
+		}
+	}
+	if len(t.builders) > 0 {
+		keys, inputs := splitMap(t.builders)
+		ids, err := m.IngestBuilders(ctx, inputs)
+		if err != nil {
+			return fmt.Errorf("IngestBuilders failed with error: %w", err)
+		}
+		for i := range ids {
+			t.builders[keys[i]] = &model.IDorBuilderInput{BuilderInput: inputs[i].BuilderInput, BuilderID: &ids[i]}
+		}
+	}
+	if len(t.vulns) > 0 {
+		keys, inputs := splitMap(t.vulns)
+		ids, err := m.IngestVulnerabilities(ctx, inputs)
+		if err != nil {
+			return fmt.Errorf("IngestVulnerabilities failed with error: %w", err)
+		}
+		for i := range ids {
+			t.vulns[keys[i]] = &model.IDorVulnerabilityInput{
+				VulnerabilityInput:  inputs[i].VulnerabilityInput,
+				VulnerabilityTypeID: &ids[i].VulnerabilityTypeID,
+				VulnerabilityNodeID: &ids[i].VulnerabilityNodeID,
+			}
+		}
+	}
+	if len(t.licenses) > 0 {
+		keys, inputs := splitMap(t.licenses)
+		ids, err := m.IngestLicenses(ctx, inputs)
+		if err != nil {
+			return fmt.Errorf("IngestLicenses failed with error: %w", err)
+		}
+		for i := range ids {
+			t.licenses[keys[i]] = &model.IDorLicenseInput{LicenseInput: inputs[i].LicenseInput, LicenseID: &ids[i]}
+		}
+	}
+	return nil
+}
+
+func splitMap[T any](m map[string]*T) ([]string, []*T) {
+	keys := make([]string, 0, len(m))
+	values := make([]*T, 0, len(m))
+	for k, v := range m {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	return keys, values
+}
+
+// ingestPredicates ingests a batch of predicates through the GraphQL mutation
+// resolvers, so that inputs are validated the same way as over GraphQL, and
+// follows the same order as the GraphQL bulk assembler.
+func ingestPredicates(ctx context.Context, m generated.MutationResolver, p *pb.IngestPredicates) (*ingestedIDs, error) {
+	if p == nil {
+		return &ingestedIDs{}, nil
+	}
+
+	trees := newSoftwareTrees()
+	trees.collect(p)
+	if err := trees.ingest(ctx, m); err != nil {
+		return nil, err
+	}
+
+	if err := ingestDocuments(ctx, m, p.Documents); err != nil {
+		return nil, err
+	}
+
+	ingested := &ingestedIDs{}
+	if err := ingestCertifyScorecards(ctx, m, p.CertifyScorecard, trees); err != nil {
+		return nil, err
+	}
+	dependencyIDs, err := ingestIsDependencies(ctx, m, p.IsDependency, trees)
+	if err != nil {
+		return nil, err
+	}
+	occurrenceIDs, err := ingestIsOccurrences(ctx, m, p.IsOccurrence, trees)
+	if err != nil {
+		return nil, err
+	}
+	if ingested.hasSLSAIDs, err = ingestHasSLSAs(ctx, m, p.HasSlsa, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestCertifyVulns(ctx, m, p.CertifyVuln, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestVulnMetadatas(ctx, m, p.VulnMetadata, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestVulnEquals(ctx, m, p.VulnEqual, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestHasSourceAts(ctx, m, p.HasSourceAt, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestCertifyBads(ctx, m, p.CertifyBad, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestCertifyGoods(ctx, m, p.CertifyGood, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestPointOfContacts(ctx, m, p.PointOfContact, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestHasMetadatas(ctx, m, p.HasMetadata, trees); err != nil {
+		return nil, err
+	}
+
+	var packageIDs, artifactIDs []string
+	for _, pkg := range trees.packages {
+		packageIDs = append(packageIDs, *pkg.PackageVersionID)
+	}
+	for _, art := range trees.artifacts {
+		artifactIDs = append(artifactIDs, *art.ArtifactID)
+	}
+	ingested.includes = model.HasSBOMIncludesInputSpec{
+		Packages:     packageIDs,
+		Artifacts:    artifactIDs,
+		Dependencies: dependencyIDs,
+		Occurrences:  occurrenceIDs,
+	}
+	if ingested.hasSBOMIDs, err = ingestHasSBOMs(ctx, m, p.HasSbom, &ingested.includes, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestVEXs(ctx, m, p.Vex, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestHashEquals(ctx, m, p.HashEqual, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestPkgEquals(ctx, m, p.PkgEqual, trees); err != nil {
+		return nil, err
+	}
+	if err := ingestCertifyLegals(ctx, m, p.CertifyLegal, trees); err != nil {
+		return nil, err
+	}
+	return ingested, nil
+}
+
+func ingestDocuments(ctx context.Context, m generated.MutationResolver, ds []*pb.DocumentInputSpec) error {
+	if len(ds) == 0 {
+		return nil
+	}
+	var documents []*model.DocumentInputSpec
+	for _, v := range ds {
+		documents = append(documents, toDocumentInputSpec(v))
+	}
+	if _, err := m.IngestDocuments(ctx, documents); err != nil {
+		return fmt.Errorf("IngestDocuments failed with error: %w", err)
+	}
+	return nil
+}
+
+func ingestCertifyScorecards(ctx context.Context, m generated.MutationResolver, cs []*pb.CertifyScorecardIngest, trees *softwareTrees) error {
+	if len(cs) == 0 {
+		return nil
+	}
+	var srcs []*model.IDorSourceInput
+	var scorecards []*model.ScorecardInputSpec
+	for _, v := range cs {
+		src, err := trees.src(v.Source)
+		if err != nil {
+			return fmt.Errorf("certifyScorecard: %w", err)
+		}
+		srcs = append(srcs, src)
+		scorecards = append(scorecards, toScorecardInputSpec(v.Scorecard))
+	}
+	if _, err := m.IngestScorecards(ctx, srcs, scorecards); err != nil {
+		return fmt.Errorf("IngestScorecards failed with error: %w", err)
+	}
+	return nil
+}
+
+func ingestIsDependencies(ctx context.Context, m generated.MutationResolver, deps []*pb.IsDependencyIngest, trees *softwareTrees) ([]string, error) {
+	if len(deps) == 0 {
+		return nil, nil
+	}
+	var pkgs, depPkgs []*model.IDorPkgInput
+	var dependencies []*model.IsDependencyInputSpec
+	for _, v := range deps {
+		pkg, err := trees.pkg(v.Pkg)
+		if err != nil {
+			return nil, fmt.Errorf("isDependency: %w", err)
+		}
+		depPkg, err := trees.pkg(v.DepPkg)
+		if err != nil {
+			return nil, fmt.Errorf("isDependency: %w", err)
+		}
+		dependency, err := toIsDependencyInputSpec(v.IsDependency)
+		if err != nil {
+			return nil, fmt.Errorf("isDependency: %w", err)
+		}
+		pkgs = append(pkgs, pkg)
+		depPkgs = append(depPkgs, depPkg)
+		dependencies = append(dependencies, dependency)
+	}
+	ids, err := m.IngestDependencies(ctx, pkgs, depPkgs, dependencies)
+	if err != nil {
+		return nil, fmt.Errorf("IngestDependencies failed with error: %w", err)
+	}
+	return ids, nil
+}
+
+func ingestIsOccurrences(ctx context.Context, m generated.MutationResolver, occs []*pb.IsOccurrenceIngest, trees *softwareTrees) ([]string, error) {
+	var pkgs []*model.IDorPkgInput
+	var srcs []*model.IDorSourceInput
+	var pkgArts, srcArts []*model.IDorArtifactInput
+	var pkgOccs, srcOccs []*model.IsOccurrenceInputSpec
+	for _, v := range occs {
+		if (v.Pkg == nil) == (v.Src == nil) {
+			return nil, fmt.Errorf("isOccurrence must have exactly one of package or source as subject")
+		}
+		art, err := trees.artifact(v.Artifact)
+		if err != nil {
+			return nil, fmt.Errorf("isOccurrence: %w", err)
+		}
+		if v.Pkg != nil {
+			pkg, err := trees.pkg(v.Pkg)
+			if err != nil {
+				return nil, fmt.Errorf("isOccurrence: %w", err)
+			}
+			pkgs = append(pkgs, pkg)
+			pkgArts = append(pkgArts, art)
+			pkgOccs = append(pkgOccs, toIsOccurrenceInputSpec(v.IsOccurrence))
+		} else {
+			src, err := trees.src(v.Src)
+			if err != nil {
+				return nil, fmt.Errorf("isOccurrence: %w", err)
+			}
+			srcs = append(srcs, src)
+			srcArts = append(srcArts, art)
+			srcOccs = append(srcOccs, toIsOccurrenceInputSpec(v.IsOccurrence))
+		}
+	}
+	var ids []string
+	if len(srcs) > 0 {
+		srcIDs, err := m.IngestOccurrences(ctx, model.PackageOrSourceInputs{Sources: srcs}, srcArts, srcOccs)
+		if err != nil {
+			return nil, fmt.Errorf("IngestOccurrences failed with error: %w", err)
+		}
+		ids = append(ids, srcIDs...)
+	}
+	if len(pkgs) > 0 {
+		pkgIDs, err := m.IngestOccurrences(ctx, model.PackageOrSourceInputs{Packages: pkgs}, pkgArts, pkgOccs)
+		if err != nil {
+			return nil, fmt.Errorf("IngestOccurrences failed with error: %w", err)
+		}
+		ids = append(ids, pkgIDs...)
+	}
+	return ids, nil
+}
+
+func ingestHasSLSAs(ctx context.Context, m generated.MutationResolver, hs []*pb.HasSlsaIngest, trees *softwareTrees) ([]string, error) {
+	if len(hs) == 0 {
+		return nil, nil
+	}
+	var subjects []*model.IDorArtifactInput
+	var builtFromList [][]*model.IDorArtifactInput
+	var builtByList []*model.IDorBuilderInput
+	var slsaList []*model.SLSAInputSpec
+	for _, v := range hs {
+		subject, err := trees.artifact(v.Artifact)
+		if err != nil {
+			return nil, fmt.Errorf("hasSLSA: %w", err)
+		}
+		var materials []*model.IDorArtifactInput
+		for _, m := range v.Materials {
+			material, err := trees.artifact(m)
+			if err != nil {
+				return nil, fmt.Errorf("hasSLSA: %w", err)
+			}
+			materials = append(materials, material)
+		}
+		builder, err := trees.builder(v.Builder)
+		if err != nil {
+			return nil, fmt.Errorf("hasSLSA: %w", err)
+		}
+		subjects = append(subjects, subject)
+		builtFromList = append(builtFromList, materials)
+		builtByList = append(builtByList, builder)
+		slsaList = append(slsaList, toSLSAInputSpec(v.HasSlsa))
+	}
+	ids, err := m.IngestSLSAs(ctx, subjects, builtFromList, builtByList, slsaList)
+	if err != nil {
+		return nil, fmt.Errorf("IngestSLSAs failed with error: %w", err)
+	}
+	return ids, nil
+}
+
+func ingestCertifyVulns(ctx context.Context, m generated.MutationResolver, cv []*pb.CertifyVulnIngest, trees *softwareTrees) error {
+	if len(cv) == 0 {
+		return nil
+	}
+	var pkgs []*model.IDorPkgInput
+	var vulns []*model.IDorVulnerabilityInput
+	var metadata []*model.ScanMetadataInput
+	for _, v := range cv {
+		pkg, err := trees.pkg(v.Pkg)
+		if err != nil {
+			return fmt.Errorf("certifyVuln: %w", err)
+		}
+		vuln, err := trees.vuln(v.Vulnerability)
+		if err != nil {
+			return fmt.Errorf("certifyVuln: %w", err)
+		}
+		pkgs = append(pkgs, pkg)
+		vulns = append(vulns, vuln)
+		metadata = append(metadata, toScanMetadataInput(v.VulnData))
+	}
+	if _, err := m.IngestCertifyVulns(ctx, pkgs, vulns, metadata); err != nil {
+		return fmt.Errorf("IngestCertifyVulns failed with error: %w", err)
+	}
+	return nil
+}
+
+func ingestVulnMetadatas(ctx context.Context, m generated.MutationResolver, vm []*pb.VulnMetadataIngest, trees *softwareTrees) error {
+	if len(vm) == 0 {
+		return nil
+	}
+	var vulns []*model.IDorVulnerabilityInput
+	var metadata []*model.VulnerabilityMetadataInputSpec
+	for _, v := range vm {
+		vuln, err := trees.vuln(v.Vulnerability)
+		if err != nil {
+			return fmt.Errorf("vulnMetadata: %w", err)
+		}
+		m, err := toVulnerabilityMetadataInputSpec(v.VulnMetadata)
+		if err != nil {
+			return fmt.Errorf("vulnMetadata: %w", err)
+		}
+		vulns = append(vulns, vuln)
+		metadata = append(metadata, m)
+	}
+	if _, err := m.IngestBulkVulnerabilityMetadata(ctx, vulns, metadata); err != nil {
+		return fmt.Errorf("IngestBulkVulnerabilityMetadata failed with error: %w", err)
+	}
+	return nil
+}
+
+func ingestVulnEquals(ctx context.Context, m generated.MutationResolver, ve []*pb.VulnEqualIngest, trees *softwareTrees) error {
+	if len(ve) == 0 {
+		return nil
+	}
+	var vulns, equalVulns []*model.IDorVulnerabilityInput
+	var vulnEquals []*model.VulnEqualInputSpec
+	for _, v := range ve {
+		vuln, err := trees.vuln(v.Vulnerability)
+		if err != nil {
+			return fmt.Errorf("vulnEqual: %w", err)
+		}
+		equalVuln, err := trees.vuln(v.EqualVulnerability)
+		if err != nil {
+			return fmt.Errorf("vulnEqual: %w", err)
+		}
+		vulns = append(vulns, vuln)
+		equalVulns = append(equalVulns, equalVuln)
+		vulnEquals = append(vulnEquals, toVulnEqualInputSpec(v.VulnEqual))
+	}
+	if _, err := m.IngestVulnEquals(ctx, vulns, equalVulns, vulnEquals); err != nil {
+		return fmt.Errorf("IngestVulnEquals failed with error: %w", err)
+	}
+	return nil
+}
+
+// pkgsByMatchFlag groups package subjects by their match flag, as the backend
+// bulk mutations accept a single match flag per call.
+type pkgsByMatchFlag[T any] struct {
+	specificVersion []*model.IDorPkgInput
+	specificInputs  []T
+	allVersions     []*model.IDorPkgInput
+	allInputs       []T
+}
+
+func (g *pkgsByMatchFlag[T]) add(flag string, pkg *model.IDorPkgInput, input T) error {
+	matchFlags, err := toMatchFlags(flag)
+	if err != nil {
+		return err
+	}
+	if matchFlags.Pkg == model.PkgMatchTypeAllVersions {
+		g.allVersions = append(g.allVersions, pkg)
+		g.allInputs = append(g.allInputs, input)
+	} else {
+		g.specificVersion = append(g.specificVersion, pkg)
+		g.specificInputs = append(g.specificInputs, input)
+	}
+	return nil
+}
+
+func (g *pkgsByMatchFlag[T]) each(f func(pkgs []*model.IDorPkgInput, matchFlags model.MatchFlags, inputs []T) error) error {
+	if len(g.specificVersion) > 0 {
+		if err := f(g.specificVersion, model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}, g.specificInputs); err != nil {
+			return err
+		}
+	}
+	if len(g.allVersions) > 0 {
+		if err := f(g.allVersions, model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions}, g.allInputs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestHasSourceAts(ctx context.Context, m generated.MutationResolver, hs []*pb.HasSourceAtIngest, trees *softwareTrees) error {
+	type pkgSource struct {
+		src         *model.IDorSourceInput
+		hasSourceAt *model.HasSourceAtInputSpec
+	}
+	var grouped pkgsByMatchFlag[pkgSource]
+	for _, v := range hs {
+		pkg, err := trees.pkg(v.Pkg)
+		if err != nil {
+			return fmt.Errorf("hasSourceAt: %w", err)
+		}
+		src, err := trees.src(v.Src)
+		if err != nil {
+			return fmt.Errorf("hasSourceAt: %w", err)
+		}
+		if err := grouped.add(v.PkgMatchFlag, pkg, pkgSource{src, toHasSourceAtInputSpec(v.HasSourceAt)}); err != nil {
+			return fmt.Errorf("hasSourceAt: %w", err)
+		}
+	}
+	return grouped.each(func(pkgs []*model.IDorPkgInput, matchFlags model.MatchFlags, inputs []pkgSource) error {
+		var srcs []*model.IDorSourceInput
+		var hasSourceAts []*model.HasSourceAtInputSpec
+		for _, in := range inputs {
+			srcs = append(srcs, in.src)
+			hasSourceAts = append(hasSourceAts, in.hasSourceAt)
+		}
+		if _, err := m.IngestHasSourceAts(ctx, pkgs, matchFlags, srcs, hasSourceAts); err != nil {
+			return fmt.Errorf("IngestHasSourceAts failed with error: %w", err)
+		}
+		return nil
+	})
+}
+
+// subjects collects package, source or artifact subjects for evidence that
+// can be attached to any of them (CertifyBad, CertifyGood, HasMetadata and
+// PointOfContact).
+type subjects[T any] struct {
+	pkgs      pkgsByMatchFlag[T]
+	srcs      []*model.IDorSourceInput
+	srcInputs []T
+	arts      []*model.IDorArtifactInput
+	artInputs []T
+}
+
+func (s *subjects[T]) add(trees *softwareTrees, pkg *pb.PkgInputSpec, flag string, src *pb.SourceInputSpec, art *pb.ArtifactInputSpec, input T) error {
+	count := 0
+	for _, set := range []bool{pkg != nil, src != nil, art != nil} {
+		if set {
+			count++
+		}
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of package, source or artifact must be specified as subject")
+	}
+	switch {
+	case pkg != nil:
+		id, err := trees.pkg(pkg)
+		if err != nil {
+			return err
+		}
+		return s.pkgs.add(flag, id, input)
+	case src != nil:
+		id, err := trees.src(src)
+		if err != nil {
+			return err
+		}
+		s.srcs = append(s.srcs, id)
+		s.srcInputs = append(s.srcInputs, input)
+	default:
+		id, err := trees.artifact(art)
+		if err != nil {
+			return err
+		}
+		s.arts = append(s.arts, id)
+		s.artInputs = append(s.artInputs, input)
+	}
+	return nil
+}
+
+func (s *subjects[T]) each(f func(subjects model.PackageSourceOrArtifactInputs, matchFlags model.MatchFlags, inputs []T) error) error {
+	if err := s.pkgs.each(func(pkgs []*model.IDorPkgInput, matchFlags model.MatchFlags, inputs []T) error {
+		return f(model.PackageSourceOrArtifactInputs{Packages: pkgs}, matchFlags, inputs)
+	}); err != nil {
+		return err
+	}
+	if len(s.srcs) > 0 {
+		if err := f(model.PackageSourceOrArtifactInputs{Sources: s.srcs}, model.MatchFlags{}, s.srcInputs); err != nil {
+			return err
+		}
+	}
+	if len(s.arts) > 0 {
+		if err := f(model.PackageSourceOrArtifactInputs{Artifacts: s.arts}, model.MatchFlags{}, s.artInputs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestCertifyBads(ctx context.Context, m generated.MutationResolver, cb []*pb.CertifyBadIngest, trees *softwareTrees) error {
+	var s subjects[*model.CertifyBadInputSpec]
+	for _, v := range cb {
+		if err := s.add(trees, v.Pkg, v.PkgMatchFlag, v.Src, v.Artifact, toCertifyBadInputSpec(v.CertifyBad)); err != nil {
+			return fmt.Errorf("certifyBad: %w", err)
+		}
+	}
+	return s.each(func(subjects model.PackageSourceOrArtifactInputs, matchFlags model.MatchFlags, inputs []*model.CertifyBadInputSpec) error {
+		if _, err := m.IngestCertifyBads(ctx, subjects, matchFlags, inputs); err != nil {
+			return fmt.Errorf("IngestCertifyBads failed with error: %w", err)
+		}
+		return nil
+	})
+}
+
+func ingestCertifyGoods(ctx context.Context, m generated.MutationResolver, cg []*pb.CertifyGoodIngest, trees *softwareTrees) error {
+	var s subjects[*model.CertifyGoodInputSpec]
+	for _, v := range cg {
+		if err := s.add(trees, v.Pkg, v.PkgMatchFlag, v.Src, v.Artifact, toCertifyGoodInputSpec(v.CertifyGood)); err != nil {
+			return fmt.Errorf("certifyGood: %w", err)
+		}
+	}
+	return s.each(func(subjects model.PackageSourceOrArtifactInputs, matchFlags model.MatchFlags, inputs []*model.CertifyGoodInputSpec) error {
+		if _, err := m.IngestCertifyGoods(ctx, subjects, matchFlags, inputs); err != nil {
+			return fmt.Errorf("IngestCertifyGoods failed with error: %w", err)
+		}
+		return nil
+	})
+}
+
+func ingestPointOfContacts(ctx context.Context, m generated.MutationResolver, poc []*pb.PointOfContactIngest, trees *softwareTrees) error {
+	var s subjects[*model.PointOfContactInputSpec]
+	for _, v := range poc {
+		if err := s.add(trees, v.Pkg, v.PkgMatchFlag, v.Src, v.Artifact, toPointOfContactInputSpec(v.PointOfContact)); err != nil {
+			return fmt.Errorf("pointOfContact: %w", err)
+		}
+	}
+	return s.each(func(subjects model.PackageSourceOrArtifactInputs, matchFlags model.MatchFlags, inputs []*model.PointOfContactInputSpec) error {
+		if _, err := m.IngestPointOfContacts(ctx, subjects, matchFlags, inputs); err != nil {
+			return fmt.Errorf("IngestPointOfContacts failed with error: %w", err)
+		}
+		return nil
+	})
+}
+
+func ingestHasMetadatas(ctx context.Context, m generated.MutationResolver, hm []*pb.HasMetadataIngest, trees *softwareTrees) error {
+	var s subjects[*model.HasMetadataInputSpec]
+	for _, v := range hm {
+		if err := s.add(trees, v.Pkg, v.PkgMatchFlag, v.Src, v.Artifact, toHasMetadataInputSpec(v.HasMetadata)); err != nil {
+			return fmt.Errorf("hasMetadata: %w", err)
+		}
+	}
+	return s.each(func(subjects model.PackageSourceOrArtifactInputs, matchFlags model.MatchFlags, inputs []*model.HasMetadataInputSpec) error {
+		if _, err := m.IngestBulkHasMetadata(ctx, subjects, matchFlags, inputs); err != nil {
+			return fmt.Errorf("IngestBulkHasMetadata failed with error: %w", err)
+		}
+		return nil
+	})
+}
+
+func ingestHasSBOMs(ctx context.Context, m generated.MutationResolver, hs []*pb.HasSBOMIngest, includes *model.HasSBOMIncludesInputSpec, trees *softwareTrees) ([]string, error) {
+	var pkgs []*model.IDorPkgInput
+	var arts []*model.IDorArtifactInput
+	var pkgSBOMs, artSBOMs []*model.HasSBOMInputSpec
+	var pkgIncludes, artIncludes []*model.HasSBOMIncludesInputSpec
+	for _, v := range hs {
+		if (v.Pkg == nil) == (v.Artifact == nil) {
+			return nil, fmt.Errorf("hasSBOM must have exactly one of package or artifact as subject")
+		}
+		if v.Pkg != nil {
+			pkg, err := trees.pkg(v.Pkg)
+			if err != nil {
+				return nil, fmt.Errorf("hasSBOM: %w", err)
+			}
+			pkgs = append(pkgs, pkg)
+			pkgSBOMs = append(pkgSBOMs, toHasSBOMInputSpec(v.HasSbom))
+			pkgIncludes = append(pkgIncludes, mergeHasSBOMIncludes(includes, v.Includes))
+		} else {
+			art, err := trees.artifact(v.Artifact)
+			if err != nil {
+				return nil, fmt.Errorf("hasSBOM: %w", err)
+			}
+			arts = append(arts, art)
+			artSBOMs = append(artSBOMs, toHasSBOMInputSpec(v.HasSbom))
+			artIncludes = append(artIncludes, mergeHasSBOMIncludes(includes, v.Includes))
+		}
+	}
+	var ids []string
+	if len(arts) > 0 {
+		artIDs, err := m.IngestHasSBOMs(ctx, model.PackageOrArtifactInputs{Artifacts: arts}, artSBOMs, artIncludes)
+		if err != nil {
+			return nil, fmt.Errorf("IngestHasSBOMs failed with error: %w", err)
+		}
+		ids = append(ids, artIDs...)
+	}
+	if len(pkgs) > 0 {
+		pkgIDs, err := m.IngestHasSBOMs(ctx, model.PackageOrArtifactInputs{Packages: pkgs}, pkgSBOMs, pkgIncludes)
+		if err != nil {
+			return nil, fmt.Errorf("IngestHasSBOMs failed with error: %w", err)
+		}
+		ids = append(ids, pkgIDs...)
+	}
+	return ids, nil
+}
+
+// mergeHasSBOMIncludes adds the includes set on a HasSBOM ingest, which are
+// the nodes of the same document ingested in earlier batches, to the ones of
+// the batch.
+func mergeHasSBOMIncludes(batch *model.HasSBOMIncludesInputSpec, earlier *pb.HasSBOMIncludesInputSpec) *model.HasSBOMIncludesInputSpec {
+	if earlier == nil {
+		return batch
+	}
+	return &model.HasSBOMIncludesInputSpec{
+		Packages:     slices.Concat(earlier.Packages, batch.Packages),
+		Artifacts:    slices.Concat(earlier.Artifacts, batch.Artifacts),
+		Dependencies: slices.Concat(earlier.Dependencies, batch.Dependencies),
+		Occurrences:  slices.Concat(earlier.Occurrences, batch.Occurrences),
+	}
+}
+
+func ingestVEXs(ctx context.Context, m generated.MutationResolver, vi []*pb.VexIngest, trees *softwareTrees) error {
+	var pkgs []*model.IDorPkgInput
+	var arts []*model.IDorArtifactInput
+	var pkgVulns, artVulns []*model.IDorVulnerabilityInput
+	var pkgVEXs, artVEXs []*model.VexStatementInputSpec
+	for _, v := range vi {
+		if (v.Pkg == nil) == (v.Artifact == nil) {
+			return fmt.Errorf("certifyVEXStatement must have exactly one of package or artifact as subject")
+		}
+		vuln, err := trees.vuln(v.Vulnerability)
+		if err != nil {
+			return fmt.Errorf("certifyVEXStatement: %w", err)
+		}
+		statement, err := toVexStatementInputSpec(v.VexData)
+		if err != nil {
+			return fmt.Errorf("certifyVEXStatement: %w", err)
+		}
+		if v.Pkg != nil {
+			pkg, err := trees.pkg(v.Pkg)
+			if err != nil {
+				return fmt.Errorf("certifyVEXStatement: %w", err)
+			}
+			pkgs = append(pkgs, pkg)
+			pkgVulns = append(pkgVulns, vuln)
+			pkgVEXs = append(pkgVEXs, statement)
+		} else {
+			art, err := trees.artifact(v.Artifact)
+			if err != nil {
+				return fmt.Errorf("certifyVEXStatement: %w", err)
+			}
+			arts = append(arts, art)
+			artVulns = append(artVulns, vuln)
+			artVEXs = append(artVEXs, statement)
+		}
+	}
+	if len(arts) > 0 {
+		if _, err := m.IngestVEXStatements(ctx, model.PackageOrArtifactInputs{Artifacts: arts}, artVulns, artVEXs); err != nil {
+			return fmt.Errorf("IngestVEXStatements failed with error: %w", err)
+		}
+	}
+	if len(pkgs) > 0 {
+		if _, err := m.IngestVEXStatements(ctx, model.PackageOrArtifactInputs{Packages: pkgs}, pkgVulns, pkgVEXs); err != nil {
+			return fmt.Errorf("IngestVEXStatements failed with error: %w", err)
+		}
+	}
+	return nil
+}
+
+func ingestHashEquals(ctx context.Context, m generated.MutationResolver, he []*pb.HashEqualIngest, trees *softwareTrees) error {
+	if len(he) == 0 {
+		return nil
+	}
+	var arts, equalArts []*model.IDorArtifactInput
+	var hashEquals []*model.HashEqualInputSpec
+	for _, v := range he {
+		art, err := trees.artifact(v.Artifact)
+		if err != nil {
+			return fmt.Errorf("hashEqual: %w", err)
+		}
+		equalArt, err := trees.artifact(v.EqualArtifact)
+		if err != nil {
+			return fmt.Errorf("hashEqual: %w", err)
+		}
+		arts = append(arts, art)
+		equalArts = append(equalArts, equalArt)
+		hashEquals = append(hashEquals, toHashEqualInputSpec(v.HashEqual))
+	}
+	if _, err := m.IngestHashEquals(ctx, arts, equalArts, hashEquals); err != nil {
+		return fmt.Errorf("IngestHashEquals failed with error: %w", err)
+	}
+	return nil
+}
+
+func ingestPkgEquals(ctx context.Context, m generated.MutationResolver, pe []*pb.PkgEqualIngest, trees *softwareTrees) error {
+	if len(pe) == 0 {
+		return nil
+	}
+	var pkgs, equalPkgs []*model.IDorPkgInput
+	var pkgEquals []*model.PkgEqualInputSpec
+	for _, v := range pe {
+		pkg, err := trees.pkg(v.Pkg)
+		if err != nil {
+			return fmt.Errorf("pkgEqual: %w", err)
+		}
+		equalPkg, err := trees.pkg(v.EqualPkg)
+		if err != nil {
+			return fmt.Errorf("pkgEqual: %w", err)
+		}
+		pkgs = append(pkgs, pkg)
+		equalPkgs = append(equalPkgs, equalPkg)
+		pkgEquals = append(pkgEquals, toPkgEqualInputSpec(v.PkgEqual))
+	}
+	if _, err := m.IngestPkgEquals(ctx, pkgs, equalPkgs, pkgEquals); err != nil {
+		return fmt.Errorf("IngestPkgEquals failed with error: %w", err)
+	}
+	return nil
+}
+
+func ingestCertifyLegals(ctx context.Context, m generated.MutationResolver, cl []*pb.CertifyLegalIngest, trees *softwareTrees) error {
+	var pkgs []*model.IDorPkgInput
+	var srcs []*model.IDorSourceInput
+	var pkgDeclared, pkgDiscovered, srcDeclared, srcDiscovered [][]*model.IDorLicenseInput
+	var pkgLegals, srcLegals []*model.CertifyLegalInputSpec
+	licenses := func(in []*pb.LicenseInputSpec) ([]*model.IDorLicenseInput, error) {
+		out := []*model.IDorLicenseInput{}
+		for _, l := range in {
+			id, err := trees.license(l)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, id)
+		}
+		return out, nil
+	}
+	for _, v := range cl {
+		if (v.Pkg == nil) == (v.Src == nil) {
+			return fmt.Errorf("certifyLegal must have exactly one of package or source as subject")
+		}
+		declared, err := licenses(v.Declared)
+		if err != nil {
+			return fmt.Errorf("certifyLegal: %w", err)
+		}
+		discovered, err := licenses(v.Discovered)
+		if err != nil {
+			return fmt.Errorf("certifyLegal: %w", err)
+		}
+		if v.Pkg != nil {
+			pkg, err := trees.pkg(v.Pkg)
+			if err != nil {
+				return fmt.Errorf("certifyLegal: %w", err)
+			}
+			pkgs = append(pkgs, pkg)
+			pkgDeclared = append(pkgDeclared, declared)
+			pkgDiscovered = append(pkgDiscovered, discovered)
+			pkgLegals = append(pkgLegals, toCertifyLegalInputSpec(v.CertifyLegal))
+		} else {
+			src, err := trees.src(v.Src)
+			if err != nil {
+				return fmt.Errorf("certifyLegal: %w", err)
+			}
+			srcs = append(srcs, src)
+			srcDeclared = append(srcDeclared, declared)
+			srcDiscovered = append(srcDiscovered, discovered)
+			srcLegals = append(srcLegals, toCertifyLegalInputSpec(v.CertifyLegal))
+		}
+	}
+	if len(srcs) > 0 {
+		if _, err := m.IngestCertifyLegals(ctx, model.PackageOrSourceInputs{Sources: srcs}, srcDeclared, srcDiscovered, srcLegals); err != nil {
+			return fmt.Errorf("IngestCertifyLegals failed with error: %w", err)
+		}
+	}
+	if len(pkgs) > 0 {
+		if _, err := m.IngestCertifyLegals(ctx, model.PackageOrSourceInputs{Packages: pkgs}, pkgDeclared, pkgDiscovered, pkgLegals); err != nil {
+			return fmt.Errorf("IngestCertifyLegals failed with error: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,451 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	pb "github.com/guacsec/guac/pkg/assembler/grpc/guacapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultPageSize is the number of nodes retrieved from the backend for every
+// page when the request does not specify a page size.
+const defaultPageSize = 1000
+
+// page is a single page of results retrieved from a backend List method.
+type page[T any] struct {
+	nodes    []T
+	pageInfo *model.PageInfo
+}
+
+// streamPages walks all the pages returned by fetch and sends every node to
+// the client, stopping when the backend reports there are no more pages.
+func streamPages[T any, R any](ctx context.Context, name string, pageSize int32, fetch func(after *string, first *int) (*page[T], error), convert func(T) R, send func(R) error) error {
+	logger := ctxzap.Extract(ctx).Sugar()
+
+	first := int(pageSize)
+	if first <= 0 {
+		first = defaultPageSize
+	}
+
+	var after *string
+	sent := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		p, err := fetch(after, &first)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%s failed: %v", name, err)
+		}
+		if p == nil {
+			break
+		}
+		for _, node := range p.nodes {
+			if err := send(convert(node)); err != nil {
+				return err
+			}
+			sent++
+		}
+		if p.pageInfo == nil || !p.pageInfo.HasNextPage || p.pageInfo.EndCursor == nil {
+			break
+		}
+		after = p.pageInfo.EndCursor
+	}
+	logger.Infof("%s streamed %d nodes", name, sent)
+	return nil
+}
+
+func (s *server) ListPackages(in *pb.ListPackagesRequest, out pb.GuacService_ListPackagesServer) error {
+	ctx := out.Context()
+	filter := toPkgSpec(in.GetFilter())
+	return streamPages(ctx, "ListPackages", in.GetPageSize(), func(after *string, first *int) (*page[*model.Package], error) {
+		c, err := s.backend.PackagesList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.Package]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromPackage, out.Send)
+}
+
+func (s *server) ListSources(in *pb.ListSourcesRequest, out pb.GuacService_ListSourcesServer) error {
+	ctx := out.Context()
+	filter := toSourceSpec(in.GetFilter())
+	return streamPages(ctx, "ListSources", in.GetPageSize(), func(after *string, first *int) (*page[*model.Source], error) {
+		c, err := s.backend.SourcesList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.Source]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromSource, out.Send)
+}
+
+func (s *server) ListArtifacts(in *pb.ListArtifactsRequest, out pb.GuacService_ListArtifactsServer) error {
+	ctx := out.Context()
+	filter := toArtifactSpec(in.GetFilter())
+	return streamPages(ctx, "ListArtifacts", in.GetPageSize(), func(after *string, first *int) (*page[*model.Artifact], error) {
+		c, err := s.backend.ArtifactsList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.Artifact]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromArtifact, out.Send)
+}
+
+func (s *server) ListBuilders(in *pb.ListBuildersRequest, out pb.GuacService_ListBuildersServer) error {
+	ctx := out.Context()
+	filter := toBuilderSpec(in.GetFilter())
+	return streamPages(ctx, "ListBuilders", in.GetPageSize(), func(after *string, first *int) (*page[*model.Builder], error) {
+		c, err := s.backend.BuildersList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.Builder]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromBuilder, out.Send)
+}
+
+func (s *server) ListLicenses(in *pb.ListLicensesRequest, out pb.GuacService_ListLicensesServer) error {
+	ctx := out.Context()
+	filter := toLicenseSpec(in.GetFilter())
+	return streamPages(ctx, "ListLicenses", in.GetPageSize(), func(after *string, first *int) (*page[*model.License], error) {
+		c, err := s.backend.LicenseList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.License]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromLicense, out.Send)
+}
+
+func (s *server) ListVulnerabilities(in *pb.ListVulnerabilitiesRequest, out pb.GuacService_ListVulnerabilitiesServer) error {
+	ctx := out.Context()
+	filter := toVulnerabilitySpec(in.GetFilter())
+	return streamPages(ctx, "ListVulnerabilities", in.GetPageSize(), func(after *string, first *int) (*page[*model.Vulnerability], error) {
+		c, err := s.backend.VulnerabilityList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.Vulnerability]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromVulnerability, out.Send)
+}
+
+func (s *server) ListIsDependencies(in *pb.ListIsDependenciesRequest, out pb.GuacService_ListIsDependenciesServer) error {
+	ctx := out.Context()
+	filter, err := toIsDependencySpec(in.GetFilter())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	return streamPages(ctx, "ListIsDependencies", in.GetPageSize(), func(after *string, first *int) (*page[*model.IsDependency], error) {
+		c, err := s.backend.IsDependencyList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.IsDependency]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromIsDependency, out.Send)
+}
+
+func (s *server) ListIsOccurrences(in *pb.ListIsOccurrencesRequest, out pb.GuacService_ListIsOccurrencesServer) error {
+	ctx := out.Context()
+	filter := toIsOccurrenceSpec(in.GetFilter())
+	return streamPages(ctx, "ListIsOccurrences", in.GetPageSize(), func(after *string, first *int) (*page[*model.IsOccurrence], error) {
+		c, err := s.backend.IsOccurrenceList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.IsOccurrence]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromIsOccurrence, out.Send)
+}
+
+func (s *server) ListHasSBOMs(in *pb.ListHasSBOMsRequest, out pb.GuacService_ListHasSBOMsServer) error {
+	ctx := out.Context()
+	filter := toHasSBOMSpec(in.GetFilter())
+	return streamPages(ctx, "ListHasSBOMs", in.GetPageSize(), func(after *string, first *int) (*page[*model.HasSbom], error) {
+		c, err := s.backend.HasSBOMList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.HasSbom]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromHasSBOM, out.Send)
+}
+
+func (s *server) ListCertifyVulns(in *pb.ListCertifyVulnsRequest, out pb.GuacService_ListCertifyVulnsServer) error {
+	ctx := out.Context()
+	filter := toCertifyVulnSpec(in.GetFilter())
+	return streamPages(ctx, "ListCertifyVulns", in.GetPageSize(), func(after *string, first *int) (*page[*model.CertifyVuln], error) {
+		c, err := s.backend.CertifyVulnList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.CertifyVuln]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromCertifyVuln, out.Send)
+}
+
+func (s *server) ListCertifyVEXStatements(in *pb.ListCertifyVEXStatementsRequest, out pb.GuacService_ListCertifyVEXStatementsServer) error {
+	ctx := out.Context()
+	filter, err := toCertifyVEXStatementSpec(in.GetFilter())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	return streamPages(ctx, "ListCertifyVEXStatements", in.GetPageSize(), func(after *string, first *int) (*page[*model.CertifyVEXStatement], error) {
+		c, err := s.backend.CertifyVEXStatementList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.CertifyVEXStatement]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromCertifyVEXStatement, out.Send)
+}
+
+func (s *server) ListCertifyBads(in *pb.ListCertifyBadsRequest, out pb.GuacService_ListCertifyBadsServer) error {
+	ctx := out.Context()
+	filter := toCertifyBadSpec(in.GetFilter())
+	return streamPages(ctx, "ListCertifyBads", in.GetPageSize(), func(after *string, first *int) (*page[*model.CertifyBad], error) {
+		c, err := s.backend.CertifyBadList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.CertifyBad]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromCertifyBad, out.Send)
+}
+
+func (s *server) ListCertifyGoods(in *pb.ListCertifyGoodsRequest, out pb.GuacService_ListCertifyGoodsServer) error {
+	ctx := out.Context()
+	filter := toCertifyGoodSpec(in.GetFilter())
+	return streamPages(ctx, "ListCertifyGoods", in.GetPageSize(), func(after *string, first *int) (*page[*model.CertifyGood], error) {
+		c, err := s.backend.CertifyGoodList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.CertifyGood]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromCertifyGood, out.Send)
+}
+
+func (s *server) ListHasSLSAs(in *pb.ListHasSLSAsRequest, out pb.GuacService_ListHasSLSAsServer) error {
+	ctx := out.Context()
+	filter := toHasSLSASpec(in.GetFilter())
+	return streamPages(ctx, "ListHasSLSAs", in.GetPageSize(), func(after *string, first *int) (*page[*model.HasSlsa], error) {
+		c, err := s.backend.HasSLSAList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.HasSlsa]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromHasSLSA, out.Send)
+}
+
+func (s *server) ListHasSourceAts(in *pb.ListHasSourceAtsRequest, out pb.GuacService_ListHasSourceAtsServer) error {
+	ctx := out.Context()
+	filter := toHasSourceAtSpec(in.GetFilter())
+	return streamPages(ctx, "ListHasSourceAts", in.GetPageSize(), func(after *string, first *int) (*page[*model.HasSourceAt], error) {
+		c, err := s.backend.HasSourceAtList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.HasSourceAt]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromHasSourceAt, out.Send)
+}
+
+func (s *server) ListCertifyScorecards(in *pb.ListCertifyScorecardsRequest, out pb.GuacService_ListCertifyScorecardsServer) error {
+	ctx := out.Context()
+	filter := toCertifyScorecardSpec(in.GetFilter())
+	return streamPages(ctx, "ListCertifyScorecards", in.GetPageSize(), func(after *string, first *int) (*page[*model.CertifyScorecard], error) {
+		c, err := s.backend.ScorecardsList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.CertifyScorecard]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromCertifyScorecard, out.Send)
+}
+
+func (s *server) ListCertifyLegals(in *pb.ListCertifyLegalsRequest, out pb.GuacService_ListCertifyLegalsServer) error {
+	ctx := out.Context()
+	filter := toCertifyLegalSpec(in.GetFilter())
+	return streamPages(ctx, "ListCertifyLegals", in.GetPageSize(), func(after *string, first *int) (*page[*model.CertifyLegal], error) {
+		c, err := s.backend.CertifyLegalList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.CertifyLegal]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromCertifyLegal, out.Send)
+}
+
+func (s *server) ListPkgEquals(in *pb.ListPkgEqualsRequest, out pb.GuacService_ListPkgEqualsServer) error {
+	ctx := out.Context()
+	filter := toPkgEqualSpec(in.GetFilter())
+	return streamPages(ctx, "ListPkgEquals", in.GetPageSize(), func(after *string, first *int) (*page[*model.PkgEqual], error) {
+		c, err := s.backend.PkgEqualList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.PkgEqual]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromPkgEqual, out.Send)
+}
+
+func (s *server) ListVulnEquals(in *pb.ListVulnEqualsRequest, out pb.GuacService_ListVulnEqualsServer) error {
+	ctx := out.Context()
+	filter := toVulnEqualSpec(in.GetFilter())
+	return streamPages(ctx, "ListVulnEquals", in.GetPageSize(), func(after *string, first *int) (*page[*model.VulnEqual], error) {
+		c, err := s.backend.VulnEqualList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.VulnEqual]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromVulnEqual, out.Send)
+}
+
+func (s *server) ListHashEquals(in *pb.ListHashEqualsRequest, out pb.GuacService_ListHashEqualsServer) error {
+	ctx := out.Context()
+	filter := toHashEqualSpec(in.GetFilter())
+	return streamPages(ctx, "ListHashEquals", in.GetPageSize(), func(after *string, first *int) (*page[*model.HashEqual], error) {
+		c, err := s.backend.HashEqualList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.HashEqual]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromHashEqual, out.Send)
+}
+
+func (s *server) ListHasMetadata(in *pb.ListHasMetadataRequest, out pb.GuacService_ListHasMetadataServer) error {
+	ctx := out.Context()
+	filter := toHasMetadataSpec(in.GetFilter())
+	return streamPages(ctx, "ListHasMetadata", in.GetPageSize(), func(after *string, first *int) (*page[*model.HasMetadata], error) {
+		c, err := s.backend.HasMetadataList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.HasMetadata]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromHasMetadata, out.Send)
+}
+
+func (s *server) ListPointOfContacts(in *pb.ListPointOfContactsRequest, out pb.GuacService_ListPointOfContactsServer) error {
+	ctx := out.Context()
+	filter := toPointOfContactSpec(in.GetFilter())
+	return streamPages(ctx, "ListPointOfContacts", in.GetPageSize(), func(after *string, first *int) (*page[*model.PointOfContact], error) {
+		c, err := s.backend.PointOfContactList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.PointOfContact]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromPointOfContact, out.Send)
+}
+
+func (s *server) ListVulnerabilityMetadata(in *pb.ListVulnerabilityMetadataRequest, out pb.GuacService_ListVulnerabilityMetadataServer) error {
+	ctx := out.Context()
+	filter, err := toVulnerabilityMetadataSpec(in.GetFilter())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	return streamPages(ctx, "ListVulnerabilityMetadata", in.GetPageSize(), func(after *string, first *int) (*page[*model.VulnerabilityMetadata], error) {
+		c, err := s.backend.VulnerabilityMetadataList(ctx, *filter, after, first)
+		if err != nil || c == nil {
+			return nil, err
+		}
+		p := &page[*model.VulnerabilityMetadata]{pageInfo: c.PageInfo}
+		for _, e := range c.Edges {
+			p.nodes = append(p.nodes, e.Node)
+		}
+		return p, nil
+	}, fromVulnerabilityMetadata, out.Send)
+}
@@ -0,0 +1,191 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package server implements the GUAC gRPC API on top of a backends.Backend.
+// It is served next to the GraphQL API and is intended for high-throughput
+// ingestion and bulk retrieval.
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	pb "github.com/guacsec/guac/pkg/assembler/grpc/guacapi"
+	"github.com/guacsec/guac/pkg/auth"
+	"github.com/guacsec/guac/pkg/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// MaxMessageSize is the largest message accepted by the server. Ingestion
+// batches are usually much larger than queries, so this is above the gRPC
+// default.
+const MaxMessageSize = 64 * 1024 * 1024
+
+type server struct {
+	pb.UnimplementedGuacServiceServer
+
+	backend     backends.Backend
+	mutations   generated.MutationResolver
+	port        int
+	tlsCertFile string
+	tlsKeyFile  string
+
+	authenticator auth.Authenticator
+	clientTLS     *tls.Config
+}
+
+func NewServer(backend backends.Backend, port int, tlsCertFile string, tlsKeyFile string) (*server, error) {
+	if backend == nil {
+		return nil, fmt.Errorf("a backend is required to start the gRPC server")
+	}
+	return &server{
+		backend:     backend,
+		mutations:   (&resolvers.Resolver{Backend: backend}).Mutation(),
+		port:        port,
+		tlsCertFile: tlsCertFile,
+		tlsKeyFile:  tlsKeyFile,
+	}, nil
+}
+
+// WithAuth makes the server authenticate every call with a, and authorize it
+// based on the roles of the caller. clientTLS, if set, holds the client
+// certificate settings of the TLS listener, see auth.Config.ServerTLSConfig.
+func (s *server) WithAuth(a auth.Authenticator, clientTLS *tls.Config) *server {
+	s.authenticator = a
+	s.clientTLS = clientTLS
+	return s
+}
+
+// Register registers the GUAC gRPC service on gs.
+func (s *server) Register(gs *grpc.Server) {
+	pb.RegisterGuacServiceServer(gs, s)
+}
+
+// ServerOptions returns the interceptors and limits used by the GUAC gRPC
+// server.
+func ServerOptions(logger *zap.Logger) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(
+			grpc_middleware.ChainUnaryServer(
+				grpc_zap.UnaryServerInterceptor(logger),
+			)),
+		grpc.StreamInterceptor(
+			grpc_middleware.ChainStreamServer(
+				grpc_zap.StreamServerInterceptor(logger),
+			)),
+		grpc.MaxRecvMsgSize(MaxMessageSize),
+		grpc.MaxSendMsgSize(MaxMessageSize),
+	}
+}
+
+// withTenant scopes the call to the tenant of the authenticated caller.
+func (s *server) withTenant(ctx context.Context) (context.Context, error) {
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		ctx = backends.WithTenant(ctx, p.Tenant)
+	}
+	if err := backends.CheckTenant(ctx, s.backend); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return ctx, nil
+}
+
+func (s *server) unaryTenantInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := s.withTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *server) streamTenantInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.withTenant(ss.Context())
+	if err != nil {
+		return err
+	}
+	wrapped := grpc_middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = ctx
+	return handler(srv, wrapped)
+}
+
+func (s *server) Serve(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		return fmt.Errorf("error opening port %d when starting gRPC server: %w", s.port, err)
+	}
+
+	opts := ServerOptions(logger.Desugar())
+	if s.authenticator != nil {
+		// chained after the logging interceptors, so rejected calls are logged
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(s.authenticator), s.unaryTenantInterceptor),
+			grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(s.authenticator), s.streamTenantInterceptor))
+	}
+	if s.tlsCertFile != "" && s.tlsKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(s.tlsCertFile, s.tlsKeyFile)
+		if err != nil {
+			return fmt.Errorf("error loading credentials from certificate: %s and key %s: %w", s.tlsCertFile, s.tlsKeyFile, err)
+		}
+		config := &tls.Config{}
+		if s.clientTLS != nil {
+			config = s.clientTLS.Clone()
+		}
+		config.Certificates = []tls.Certificate{cert}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(config)))
+	}
+
+	gs := grpc.NewServer(opts...)
+	s.Register(gs)
+
+	var wg sync.WaitGroup
+	var retErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		logger.Infof("gRPC server listening at %v", lis.Addr())
+		if err := gs.Serve(lis); err != nil {
+			retErr = fmt.Errorf("guac grpc server error: %w", err)
+		}
+	}()
+	<-ctx.Done()
+	logger.Infof("context cancelled, gracefully shutting down guac grpc server")
+	done := make(chan bool, 1)
+	go func() {
+		gs.GracefulStop()
+		wg.Wait()
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		logger.Warnf("forcibly shutting down guac grpc server")
+		gs.Stop()
+	}
+	wg.Wait()
+	return retErr
+}
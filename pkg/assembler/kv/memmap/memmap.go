@@ -56,6 +56,11 @@ func (s *store) Set(_ context.Context, c, k string, v any) error {
 	return nil
 }
 
+func (s *store) Remove(_ context.Context, c, k string) error {
+	delete(s.m[c], k)
+	return nil
+}
+
 func (s *store) Keys(c string) kv.Scanner {
 	return &scanner{
 		collection: c,
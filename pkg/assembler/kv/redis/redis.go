@@ -67,6 +67,10 @@ func (s *store) Set(ctx context.Context, c, k string, v any) error {
 	return s.c.HSet(ctx, c, k, string(b)).Err()
 }
 
+func (s *store) Remove(ctx context.Context, c, k string) error {
+	return s.c.HDel(ctx, c, k).Err()
+}
+
 func (s *store) Keys(c string) kv.Scanner {
 	return &scanner{
 		collection: c,
@@ -0,0 +1,409 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+// Limits bounds the cost of the GraphQL queries and subscriptions served.
+// Mutations are not limited, as they are used to ingest large batches. A zero
+// limit is not enforced.
+type Limits struct {
+	// MaxComplexity is the maximum complexity of an operation. Each field
+	// costs 1 plus the complexity of its selections, multiplied by the number
+	// of items it may return: the value of its first, maxPathLength or
+	// maxDepth argument or the number of nodes asked for, and otherwise
+	// ListSize for the list fields of the root operation type.
+	MaxComplexity int
+	// ListSize is the maximum number of items returned by the root fields
+	// that return a list without an argument bounding its size. The
+	// paginated fields queried without first return pages of ListSize items,
+	// and the other fields fail when they have more items. Backends that
+	// support backends.WithResultLimit stop reading the items over the limit.
+	ListSize int
+	// MaxDepth is the maximum number of nested fields in an operation.
+	MaxDepth int
+	// QueryTimeout is the maximum duration of a query.
+	QueryTimeout time.Duration
+	// MaxResultSize is the maximum number of list items returned by a query,
+	// summed over all its fields.
+	MaxResultSize int
+}
+
+// DefaultLimits returns limits that fit the queries of the GUAC clients.
+func DefaultLimits() Limits {
+	return Limits{
+		MaxComplexity: 1500000,
+		ListSize:      10000,
+		MaxDepth:      15,
+		QueryTimeout:  time.Minute,
+		MaxResultSize: 1000000,
+	}
+}
+
+const (
+	complexityLimitExceeded = "COMPLEXITY_LIMIT_EXCEEDED"
+	depthLimitExceeded      = "DEPTH_LIMIT_EXCEEDED"
+	listSizeLimitExceeded   = "LIST_SIZE_LIMIT_EXCEEDED"
+	resultSizeLimitExceeded = "RESULT_SIZE_LIMIT_EXCEEDED"
+	queryTimeout            = "QUERY_TIMEOUT"
+)
+
+// limiter is a gqlgen extension enforcing Limits.
+type limiter struct {
+	Limits
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = limiter{}
+
+func (limiter) ExtensionName() string {
+	return "GUACLimits"
+}
+
+func (limiter) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext rejects the operations that are nested too deeply or
+// are too complex, before they are executed.
+func (l limiter) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	op := opCtx.Operation
+	if op == nil || op.Operation == ast.Mutation {
+		return nil
+	}
+	if l.MaxDepth > 0 {
+		if path, depth := l.tooDeep(op.SelectionSet, nil, 1); path != nil {
+			err := gqlerror.Errorf("field %q is nested %d fields deep, over the depth limit of %d", path.String(), depth, l.MaxDepth)
+			errcode.Set(err, depthLimitExceeded)
+			return err
+		}
+	}
+	if l.MaxComplexity > 0 {
+		c := l.complexity(op.SelectionSet, nil, opCtx.Variables, true)
+		if c.cost > l.MaxComplexity {
+			err := gqlerror.Errorf("field %q exceeds the complexity limit of %d: the %s has a complexity of %d", c.culprit.String(), l.MaxComplexity, op.Operation, c.cost)
+			errcode.Set(err, complexityLimitExceeded)
+			return err
+		}
+	}
+	return nil
+}
+
+// tooDeep returns the path of the first field nested deeper than MaxDepth, and
+// its depth.
+func (l limiter) tooDeep(selections ast.SelectionSet, path ast.Path, depth int) (ast.Path, int) {
+	for _, f := range fields(selections) {
+		if strings.HasPrefix(f.Name, "__") {
+			continue
+		}
+		fieldPath := appendPath(path, f.Alias)
+		if depth > l.MaxDepth {
+			return fieldPath, depth
+		}
+		if p, d := l.tooDeep(f.SelectionSet, fieldPath, depth+1); p != nil {
+			return p, d
+		}
+	}
+	return nil, 0
+}
+
+// cost is the complexity of a selection, and the field to blame if it is over
+// the limit, with the complexity of that field.
+type cost struct {
+	cost        int
+	culprit     ast.Path
+	culpritCost int
+}
+
+// add adds the complexity of a field to the one of a selection. The costliest
+// field is blamed for the selection.
+func (c *cost) add(field cost) {
+	if c.culprit == nil || field.cost > c.culpritCost {
+		c.culprit, c.culpritCost = field.culprit, field.culpritCost
+	}
+	c.cost = saturatingAdd(c.cost, field.cost)
+}
+
+// complexity returns the complexity of the selections. Selections on the
+// concrete types of an interface or a union apply to different items, so only
+// the costliest type counts.
+func (l limiter) complexity(selections ast.SelectionSet, path ast.Path, vars map[string]any, root bool) cost {
+	var common cost
+	typed := map[string]*cost{}
+	for _, tf := range typedFields(selections, "") {
+		f := tf.field
+		if strings.HasPrefix(f.Name, "__") {
+			continue
+		}
+		c := l.fieldComplexity(f, appendPath(path, f.Alias), vars, root)
+		if tf.typeCondition == "" {
+			common.add(c)
+			continue
+		}
+		if typed[tf.typeCondition] == nil {
+			typed[tf.typeCondition] = &cost{}
+		}
+		typed[tf.typeCondition].add(c)
+	}
+	var costliest cost
+	for _, c := range typed {
+		if c.cost > costliest.cost {
+			costliest = *c
+		}
+	}
+	total := common
+	if costliest.cost > common.cost {
+		total.culprit, total.culpritCost = costliest.culprit, costliest.culpritCost
+	}
+	total.cost = saturatingAdd(common.cost, costliest.cost)
+	return total
+}
+
+// fieldComplexity returns the complexity of a field. The deepest field that is
+// over the limit on its own is blamed for it.
+func (l limiter) fieldComplexity(f *ast.Field, path ast.Path, vars map[string]any, root bool) cost {
+	children := l.complexity(f.SelectionSet, path, vars, false)
+	c := saturatingMul(l.multiplier(f, vars, root), saturatingAdd(1, children.cost))
+	if l.MaxComplexity > 0 && children.culpritCost > l.MaxComplexity {
+		return cost{cost: c, culprit: children.culprit, culpritCost: children.culpritCost}
+	}
+	return cost{cost: c, culprit: path, culpritCost: c}
+}
+
+// multiplier returns the number of items a field may return.
+func (l limiter) multiplier(f *ast.Field, vars map[string]any, root bool) int {
+	if n := bound(f, vars); n > 0 {
+		return n
+	}
+	if root && isList(f) {
+		return max(l.ListSize, 1)
+	}
+	return 1
+}
+
+// bound returns the number of items that the arguments of a field bound it
+// to, or 0 if they do not.
+func bound(f *ast.Field, vars map[string]any) int {
+	args := f.ArgumentMap(vars)
+	for _, name := range []string{"first", "maxPathLength", "maxDepth"} {
+		if n, ok := toInt(args[name]); ok && n > 0 {
+			return n
+		}
+	}
+	if nodes, ok := args["nodes"].([]any); ok {
+		return max(len(nodes), 1)
+	}
+	return 0
+}
+
+// isList reports whether a field returns a list, or a page of one.
+func isList(f *ast.Field) bool {
+	return f.Definition != nil && (f.Definition.Type.Elem != nil || isPaginated(f))
+}
+
+// isPaginated reports whether a field returns a page of a list.
+func isPaginated(f *ast.Field) bool {
+	return f.Definition != nil && f.Definition.Arguments.ForName("first") != nil
+}
+
+type typedField struct {
+	field         *ast.Field
+	typeCondition string
+}
+
+// typedFields flattens the fragments of the selections, keeping the type
+// condition the fields are selected under.
+func typedFields(selections ast.SelectionSet, typeCondition string) []typedField {
+	var fields []typedField
+	for _, s := range selections {
+		switch s := s.(type) {
+		case *ast.Field:
+			fields = append(fields, typedField{field: s, typeCondition: typeCondition})
+		case *ast.InlineFragment:
+			fields = append(fields, typedFields(s.SelectionSet, condition(typeCondition, s.TypeCondition, s.ObjectDefinition))...)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				fields = append(fields, typedFields(s.Definition.SelectionSet, condition(typeCondition, s.Definition.TypeCondition, s.Definition.Definition))...)
+			}
+		}
+	}
+	return fields
+}
+
+func fields(selections ast.SelectionSet) []*ast.Field {
+	var fields []*ast.Field
+	for _, tf := range typedFields(selections, "") {
+		fields = append(fields, tf.field)
+	}
+	return fields
+}
+
+// condition returns the type condition of the fields of a fragment: the
+// concrete type of the fragment, or the one of the enclosing fragment.
+func condition(outer, inner string, def *ast.Definition) string {
+	if inner == "" || (def != nil && def.IsAbstractType()) {
+		return outer
+	}
+	return inner
+}
+
+func appendPath(path ast.Path, name string) ast.Path {
+	p := make(ast.Path, len(path), len(path)+1)
+	copy(p, path)
+	return append(p, ast.PathName(name))
+}
+
+func toInt(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	case json.Number:
+		i, err := n.Int64()
+		return int(i), err == nil
+	}
+	return 0, false
+}
+
+const maxCost = int(^uint(0) >> 2)
+
+func saturatingAdd(a, b int) int {
+	return min(a+b, maxCost)
+}
+
+func saturatingMul(a, b int) int {
+	if a != 0 && b > maxCost/a {
+		return maxCost
+	}
+	return a * b
+}
+
+type resultCounterKey struct{}
+
+// resultCounter counts the list items returned by a query.
+type resultCounter struct {
+	mu       sync.Mutex
+	size     int
+	exceeded *gqlerror.Error
+	cancel   context.CancelFunc
+}
+
+// InterceptResponse applies the timeout and the result size limit to the
+// queries. Queries over the result size limit are canceled, and only return
+// the error.
+func (l limiter) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) || graphql.GetOperationContext(ctx).Operation.Operation != ast.Query {
+		return next(ctx)
+	}
+	var cancel context.CancelFunc
+	if l.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, l.QueryTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	counter := &resultCounter{cancel: cancel}
+	resp := next(context.WithValue(ctx, resultCounterKey{}, counter))
+
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+	if counter.exceeded != nil {
+		return &graphql.Response{Errors: gqlerror.List{counter.exceeded}}
+	}
+	return resp
+}
+
+// InterceptField applies the list size limit to the root fields of a query,
+// counts the items of the lists returned by its fields, and names the field
+// that timed out when the query does.
+func (l limiter) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	counter, ok := ctx.Value(resultCounterKey{}).(*resultCounter)
+	if !ok {
+		return next(ctx)
+	}
+	fc := graphql.GetFieldContext(ctx)
+	limited := l.ListSize > 0 && len(fc.Path()) == 1 && isList(fc.Field.Field) &&
+		bound(fc.Field.Field, graphql.GetOperationContext(ctx).Variables) == 0
+	if limited && isPaginated(fc.Field.Field) {
+		// the resolvers read the arguments from the field context, so the
+		// list is paged by ListSize
+		first := l.ListSize
+		fc.Args["first"] = &first
+		limited = false
+	}
+	if limited {
+		ctx = backends.WithResultLimit(ctx, l.ListSize)
+	}
+	res, err := next(ctx)
+	// the backends that ignore the result limit return the whole list
+	overLimit := errors.Is(err, backends.ErrResultLimit) ||
+		err == nil && reflect.ValueOf(res).Kind() == reflect.Slice && reflect.ValueOf(res).Len() > l.ListSize
+	if limited && overLimit {
+		sizeErr := gqlerror.ErrorPathf(fc.Path(), "field %q returns more than %d items, over the list size limit: narrow its filter or use the paginated query", fc.Path().String(), l.ListSize)
+		errcode.Set(sizeErr, listSizeLimitExceeded)
+		return nil, sizeErr
+	}
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			timeoutErr := gqlerror.ErrorPathf(fc.Path(), "field %q timed out: the query ran over its timeout of %s", fc.Path().String(), l.QueryTimeout)
+			errcode.Set(timeoutErr, queryTimeout)
+			return res, timeoutErr
+		}
+		return res, err
+	}
+	if l.MaxResultSize <= 0 || res == nil {
+		return res, nil
+	}
+	if v := reflect.ValueOf(res); v.Kind() == reflect.Slice && v.Len() > 0 {
+		counter.mu.Lock()
+		defer counter.mu.Unlock()
+		counter.size += v.Len()
+		if counter.size > l.MaxResultSize {
+			sizeErr := gqlerror.ErrorPathf(fc.Path(), "field %q exceeds the result size limit of %d items", fc.Path().String(), l.MaxResultSize)
+			errcode.Set(sizeErr, resultSizeLimitExceeded)
+			if counter.exceeded == nil {
+				counter.exceeded = sizeErr
+				counter.cancel()
+			}
+			return nil, sizeErr
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,231 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/mock/gomock"
+
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// TestClientOperationsFitDefaultLimits checks that the default limits do not
+// reject the queries of the GUAC clients, including the pages of packages and
+// sources of the default certifier batch size.
+func TestClientOperationsFitDefaultLimits(t *testing.T) {
+	files, err := filepath.Glob("../clients/operations/*.graphql")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("Could not find the client operations: %v", err)
+	}
+	var operations strings.Builder
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Could not read %s: %v", file, err)
+		}
+		operations.Write(content)
+		operations.WriteString("\n")
+	}
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+	doc, errs := gqlparser.LoadQuery(schema, operations.String())
+	if errs != nil {
+		t.Fatalf("Could not load the client operations: %v", errs)
+	}
+
+	l := limiter{Limits: DefaultLimits()}
+	batched := map[string]bool{"QueryPackagesListForScan": true, "SourcesList": true}
+	for _, op := range doc.Operations {
+		if op.Operation == ast.Mutation {
+			continue
+		}
+		vars := map[string]any{}
+		if batched[op.Name] {
+			vars["first"] = 60000
+		}
+		opCtx := &graphql.OperationContext{Operation: op, Variables: vars}
+		if err := l.MutateOperationContext(context.Background(), opCtx); err != nil {
+			t.Errorf("operation %s is over the default limits: %v", op.Name, err)
+		}
+	}
+}
+
+func TestLimits(t *testing.T) {
+	ctx := context.Background()
+	backend, err := backends.Get("keyvalue", ctx, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("Error getting backend: %v", err)
+	}
+	for _, pkg := range []*model.PkgInputSpec{testdata.P1, testdata.P2, testdata.P4, {Type: "golang", Name: "x"}} {
+		if _, err := backend.IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg}); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		limits    Limits
+		query     string
+		wantError string
+	}{{
+		name:   "within limits",
+		limits: DefaultLimits(),
+		query:  `{ packages(pkgSpec: {}) { id namespaces { names { versions { id } } } } }`,
+	}, {
+		name:      "long path",
+		limits:    DefaultLimits(),
+		query:     `{ path(subject: "1", target: "2", maxPathLength: 100000000, usingOnly: []) { __typename ... on Package { id } } }`,
+		wantError: `field "path" exceeds the complexity limit of 1500000: the query has a complexity of 200000000`,
+	}, {
+		name:      "blames the costliest field",
+		limits:    Limits{MaxComplexity: 1000, ListSize: 10},
+		query:     `{ packages(pkgSpec: {}) { id } nodes(nodes: ["1", "2"]) { __typename } packagesList(pkgSpec: {}, first: 1000) { edges { node { id } } } }`,
+		wantError: `field "packagesList" exceeds the complexity limit of 1000: the query has a complexity of 4022`,
+	}, {
+		name:      "too deep",
+		limits:    Limits{MaxDepth: 3},
+		query:     `{ packages(pkgSpec: {}) { namespaces { names { versions { id } } } } }`,
+		wantError: `field "packages.namespaces.names.versions" is nested 4 fields deep, over the depth limit of 3`,
+	}, {
+		name:      "too deep in a fragment",
+		limits:    Limits{MaxDepth: 3},
+		query:     `query { packages(pkgSpec: {}) { ...tree } } fragment tree on Package { namespaces { ... on PackageNamespace { names { versions { id } } } } }`,
+		wantError: `field "packages.namespaces.names.versions" is nested 4 fields deep, over the depth limit of 3`,
+	}, {
+		name:      "too many items in a root list",
+		limits:    Limits{ListSize: 2},
+		query:     `{ packages(pkgSpec: {}) { id } }`,
+		wantError: `field "packages" returns more than 2 items, over the list size limit`,
+	}, {
+		name:      "too many results",
+		limits:    Limits{MaxResultSize: 2},
+		query:     `{ packages(pkgSpec: {}) { id } }`,
+		wantError: `field "packages" exceeds the result size limit of 2 items`,
+	}, {
+		name:      "too many nested results",
+		limits:    Limits{MaxResultSize: 4},
+		query:     `{ packages(pkgSpec: {type: "pypi"}) { namespaces { names { versions { id } } } } }`,
+		wantError: `field "packages[0].namespaces[0].names[0].versions" exceeds the result size limit of 4 items`,
+	}, {
+		name:   "mutations are not limited",
+		limits: Limits{MaxComplexity: 1, MaxDepth: 1, MaxResultSize: 1},
+		query:  `mutation { ingestPackages(pkgs: [{packageInput: {type: "golang", name: "x"}}, {packageInput: {type: "golang", name: "y"}}]) { packageVersionID } }`,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := post(t, GetGraphqlServer(ctx, backend, Options{Limits: test.limits}), test.query)
+			if test.wantError == "" {
+				if len(errs) != 0 {
+					t.Errorf("unexpected errors: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.HasPrefix(errs[0].Message, test.wantError) {
+				t.Errorf("got errors %v, want %q", errs, test.wantError)
+			}
+		})
+	}
+}
+
+func TestQueryTimeout(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	backend := mocks.NewMockBackend(ctrl)
+	backend.
+		EXPECT().
+		Packages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ *model.PkgSpec) ([]*model.Package, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+	srv := GetGraphqlServer(ctx, backend, Options{Limits: Limits{QueryTimeout: 10 * time.Millisecond}})
+
+	errs := post(t, srv, `{ packages(pkgSpec: {}) { id } }`)
+	want := `field "packages" timed out: the query ran over its timeout of 10ms`
+	if len(errs) != 1 || errs[0].Message != want {
+		t.Errorf("got errors %v, want %q", errs, want)
+	}
+}
+
+func TestListSize(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	backend := mocks.NewMockBackend(ctrl)
+	backend.
+		EXPECT().
+		Packages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ *model.PkgSpec) ([]*model.Package, error) {
+			if n, ok := backends.ResultLimit(ctx); !ok || n != 5 {
+				t.Errorf("got result limit %d, %t, want 5", n, ok)
+			}
+			return nil, backends.ErrResultLimit
+		})
+	backend.
+		EXPECT().
+		PackagesList(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ model.PkgSpec, _ *string, first *int) (*model.PackageConnection, error) {
+			if first == nil || *first != 5 {
+				t.Errorf("got first %v, want a page of 5 packages", first)
+			}
+			return &model.PackageConnection{PageInfo: &model.PageInfo{}}, nil
+		})
+	srv := GetGraphqlServer(ctx, backend, Options{Limits: Limits{ListSize: 5}})
+
+	errs := post(t, srv, `{ packages(pkgSpec: {}) { id } }`)
+	want := `field "packages" returns more than 5 items, over the list size limit: narrow its filter or use the paginated query`
+	if len(errs) != 1 || errs[0].Message != want {
+		t.Errorf("got errors %v, want %q", errs, want)
+	}
+	if errs := post(t, srv, `{ packagesList(pkgSpec: {}) { totalCount } }`); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
+
+func post(t *testing.T, srv http.Handler, query string) gqlerror.List {
+	t.Helper()
+	body, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		t.Fatalf("Could not encode query: %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	var resp struct {
+		Errors gqlerror.List
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Could not decode response %s: %v", rec.Body.String(), err)
+	}
+	return resp.Errors
+}
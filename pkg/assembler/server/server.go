@@ -2,17 +2,53 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
-func GetGraphqlServer(ctx context.Context, backend backends.Backend) *handler.Server {
-	topResolver := resolvers.Resolver{Backend: backend}
+// Options configures the GraphQL server returned by GetGraphqlServer.
+type Options struct {
+	// BlobStore, if not nil, is used to return the content of ingested
+	// documents.
+	BlobStore *blob.BlobStore
+	// Limits bound the queries and subscriptions served. The zero Limits
+	// disables them: use DefaultLimits for the defaults.
+	Limits Limits
+	// WebsocketInit, if not nil, is called when a websocket connection is
+	// initialized, e.g. to authenticate it.
+	WebsocketInit transport.WebsocketInitFunc
+}
+
+// GetGraphqlServer returns the GraphQL server for the backend.
+func GetGraphqlServer(ctx context.Context, backend backends.Backend, opts Options) *handler.Server {
+	topResolver := resolvers.Resolver{Backend: backend, BlobStore: opts.BlobStore}
 	config := generated.Config{Resolvers: &topResolver}
 	config.Directives.Filter = resolvers.Filter
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	// same as handler.NewDefaultServer, with the websocket InitFunc
+	srv := handler.New(generated.NewExecutableSchema(config))
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              opts.WebsocketInit,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New[string](100),
+	})
+	srv.Use(limiter{Limits: opts.Limits})
+	srv.Use(resolvers.SubscriptionErrors{})
 	return srv
 }
@@ -2,12 +2,21 @@ package server
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/99designs/gqlgen/client"
 	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"go.uber.org/mock/gomock"
 
+	"github.com/guacsec/guac/internal/testing/mocks"
 	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/internal/testing/testdata"
 	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 func TestGetGraphqlServer(t *testing.T) {
@@ -19,8 +28,149 @@ func TestGetGraphqlServer(t *testing.T) {
 		t.Errorf("Error getting backend: %v", err)
 	}
 
-	srv := GetGraphqlServer(ctx, backend)
+	srv := GetGraphqlServer(ctx, backend, Options{Limits: DefaultLimits()})
 	if srv == nil {
 		t.Errorf("Expected GetGraphqlServer to return a non-nil server")
 	}
 }
+
+func TestSubscription(t *testing.T) {
+	ctx := context.Background()
+
+	store := stablememmap.GetStore()
+	backend, err := backends.Get("keyvalue", ctx, store)
+	if err != nil {
+		t.Fatalf("Error getting backend: %v", err)
+	}
+	backend = backends.WithEvents(backend, backends.NewEvents())
+	for _, pkg := range []*model.PkgInputSpec{testdata.P1, testdata.P4} {
+		if _, err := backend.IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg}); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	if _, err := backend.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.V1}); err != nil {
+		t.Fatalf("Could not ingest vulnerability: %v", err)
+	}
+	c := client.New(GetGraphqlServer(ctx, backend, Options{Limits: DefaultLimits()}))
+
+	sub := c.Websocket(`subscription {
+		CertifyVulnIngested(certifyVulnSpec: {package: {type: "pypi"}}) {
+			package { type namespaces { names { name } } }
+			vulnerability { vulnerabilityIDs { vulnerabilityID } }
+		}
+	}`)
+	defer func() { _ = sub.Close() }()
+
+	// the subscription starts asynchronously, so keep ingesting new scans
+	// until one is received
+	ingest := func(pkg *model.PkgInputSpec, dbVersion string) {
+		if _, err := backend.IngestCertifyVuln(ctx,
+			model.IDorPkgInput{PackageInput: pkg},
+			model.IDorVulnerabilityInput{VulnerabilityInput: testdata.V1},
+			model.ScanMetadataInput{TimeScanned: time.Unix(0, 0), DbVersion: dbVersion, Collector: "test"}); err != nil {
+			t.Errorf("Could not ingest certifyVuln: %v", err)
+		}
+	}
+	stop, stopped := make(chan struct{}), make(chan struct{})
+	defer func() {
+		close(stop)
+		<-stopped
+	}()
+	go func() {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			ingest(testdata.P4, strconv.Itoa(i))
+			ingest(testdata.P1, strconv.Itoa(i))
+			select {
+			case <-stop:
+				return
+			case <-time.After(50 * time.Millisecond):
+			}
+		}
+	}()
+
+	var resp struct {
+		CertifyVulnIngested struct {
+			Package struct {
+				Type       string
+				Namespaces []struct {
+					Names []struct{ Name string }
+				}
+			}
+			Vulnerability struct {
+				VulnerabilityIDs []struct{ VulnerabilityID string }
+			}
+		}
+	}
+	if err := sub.Next(&resp); err != nil {
+		t.Fatalf("subscription failed: %v", err)
+	}
+	got := resp.CertifyVulnIngested
+	if got.Package.Type != "pypi" || got.Package.Namespaces[0].Names[0].Name != "tensorflow" {
+		t.Errorf("got a scan of package %+v, want the scan of tensorflow", got.Package)
+	}
+	if id := got.Vulnerability.VulnerabilityIDs[0].VulnerabilityID; id != "cve-2014-8140" {
+		t.Errorf("got a scan with vulnerability %s, want cve-2014-8140", id)
+	}
+}
+
+// reporting is a mock backend that reports the evidence it creates, which
+// backends.WithEvents requires to publish it.
+type reporting struct {
+	*mocks.MockBackend
+}
+
+func (reporting) ReportsCreated() bool {
+	return true
+}
+
+func TestSubscriptionDropped(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	events := backends.NewEvents()
+	backend := backends.WithEvents(reporting{b}, events)
+
+	// the first lookup of an ingested scan blocks, so that the subscriber
+	// falls behind the scans published meanwhile
+	started, release := make(chan struct{}), make(chan struct{})
+	var once sync.Once
+	b.
+		EXPECT().
+		CertifyVuln(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+			once.Do(func() { close(started) })
+			<-release
+			return nil, nil
+		}).
+		AnyTimes()
+	c := client.New(GetGraphqlServer(ctx, backend, Options{Limits: DefaultLimits()}))
+	sub := c.Websocket(`subscription { CertifyVulnIngested(certifyVulnSpec: {}) { id } }`)
+	defer func() { _ = sub.Close() }()
+
+	publish := func() {
+		events.Publish(ctx, backends.Event{Type: backends.EvidenceCertifyVuln, IDs: []string{"1"}})
+	}
+	// the subscription starts asynchronously
+	timeout := time.After(10 * time.Second)
+	for waiting := true; waiting; {
+		publish()
+		select {
+		case <-started:
+			waiting = false
+		case <-time.After(10 * time.Millisecond):
+		case <-timeout:
+			t.Fatal("the subscription did not look up the ingested scans")
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		publish()
+	}
+	close(release)
+
+	var resp struct{ CertifyVulnIngested struct{ ID string } }
+	err := sub.Next(&resp)
+	if err == nil || !strings.Contains(err.Error(), "fell behind") {
+		t.Errorf("got %v from the dropped subscription, want an error that it fell behind", err)
+	}
+}
@@ -0,0 +1,165 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth authenticates the callers of the GUAC GraphQL, gRPC and REST
+// APIs, and authorizes their operations based on roles.
+//
+// Callers are authenticated with a static API token, a TLS client
+// certificate or an OIDC JWT. Each method maps the caller to a Principal
+// holding a set of roles: read for queries, ingest for the ingest mutations
+// and delete for the mutations that remove data from the graph. A principal
+// can also be assigned a tenant, which scopes its operations to the graph of
+// that tenant on the backends that support it.
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Role is a set of operations that a principal is allowed to perform.
+type Role string
+
+const (
+	// RoleRead allows queries.
+	RoleRead Role = "read"
+	// RoleIngest allows the mutations that add data to the graph.
+	RoleIngest Role = "ingest"
+	// RoleDelete allows the mutations that remove data from the graph.
+	RoleDelete Role = "delete"
+)
+
+// Roles lists all the known roles.
+var Roles = []Role{RoleRead, RoleIngest, RoleDelete}
+
+// ParseRole returns the role with the given name.
+func ParseRole(s string) (Role, error) {
+	r := Role(strings.ToLower(strings.TrimSpace(s)))
+	if !slices.Contains(Roles, r) {
+		return "", fmt.Errorf("unknown role %q, must be one of %v", s, Roles)
+	}
+	return r, nil
+}
+
+var (
+	// ErrNoCredentials is returned by an Authenticator when the request has
+	// no credentials it can check, so that the next one can be tried.
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrInvalidCredentials is returned by an Authenticator when the request
+	// has credentials it can check, but they are not valid.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Principal is an authenticated caller.
+type Principal struct {
+	// Name identifies the caller in logs: the token name, the certificate
+	// common name or the JWT subject.
+	Name string
+	// Method is the authentication method that authenticated the caller.
+	Method string
+	Roles  []Role
+	// Tenant is the tenant whose graph the caller works on. It is empty for
+	// the callers of the global tenant.
+	Tenant string
+}
+
+// HasRole returns whether the principal has the role.
+func (p *Principal) HasRole(r Role) bool {
+	return p != nil && slices.Contains(p.Roles, r)
+}
+
+// ValidateTenant returns an error if name is not a valid tenant name: up to
+// 63 lower case letters, digits, '.', '_' and '-', starting with a letter or
+// a digit. The empty name is the global tenant.
+func ValidateTenant(name string) error {
+	if name != "" && !tenantName.MatchString(name) {
+		return fmt.Errorf("invalid tenant name %q", name)
+	}
+	return nil
+}
+
+var tenantName = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,62}$`)
+
+func (p *Principal) String() string {
+	return fmt.Sprintf("%s:%s", p.Method, p.Name)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx that carries the principal.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal carried by ctx, or nil if there
+// is none.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// Credentials are the parts of a request that callers authenticate with,
+// independent of the transport.
+type Credentials struct {
+	// Authorization is the value of the Authorization header or metadata.
+	Authorization string
+	// VerifiedChains are the client certificate chains verified by the TLS
+	// handshake.
+	VerifiedChains [][]*x509.Certificate
+}
+
+// bearerToken returns the token of a "Bearer" Authorization value.
+func (c Credentials) bearerToken() (string, bool) {
+	scheme, token, ok := strings.Cut(c.Authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// Authenticator maps credentials to a principal.
+type Authenticator interface {
+	// Authenticate returns ErrNoCredentials if creds do not hold a credential
+	// of the kind that the authenticator checks.
+	Authenticate(ctx context.Context, creds Credentials) (*Principal, error)
+}
+
+// chain tries each authenticator in turn, and returns the principal of the
+// first one that accepts the credentials. Several authenticators can check
+// the same credentials, e.g. static tokens and JWTs are both bearer tokens.
+type chain []Authenticator
+
+func (c chain) Authenticate(ctx context.Context, creds Credentials) (*Principal, error) {
+	var firstErr error
+	for _, a := range c {
+		p, err := a.Authenticate(ctx, creds)
+		if err == nil {
+			return p, nil
+		}
+		if firstErr == nil && !errors.Is(err, ErrNoCredentials) {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return nil, ErrNoCredentials
+}
@@ -0,0 +1,384 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const testConfig = `
+tokens:
+  - name: reader
+    token: read-token
+    roles: [read]
+  - name: ingestor
+    # sha256 of "ingest-token"
+    sha256: %s
+    roles: [read, ingest]
+  - name: payments
+    token: payments-token
+    roles: [read, ingest]
+    tenant: payments
+mtls:
+  clientCAFile: %s
+  subjects:
+    - commonName: admin
+      roles: [read, ingest, delete]
+    - commonName: payments-ingest
+      roles: [read, ingest]
+      tenant: payments
+  defaultRoles: [read]
+oidc:
+  issuer: https://issuer.example.com
+  audience: guac
+  jwksFile: %s
+  defaultRoles: [read]
+`
+
+// setup writes a configuration with all the methods to a temporary
+// directory, and returns its authenticator and the key signing the JWTs.
+func setup(t *testing.T) (Authenticator, *rsa.PrivateKey) {
+	t.Helper()
+	dir := t.TempDir()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+		Key: &key.PublicKey, KeyID: "test", Algorithm: string(jose.RS256), Use: "sig",
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwksFile := filepath.Join(dir, "jwks.json")
+	if err := os.WriteFile(jwksFile, jwks, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, ca, ca, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caFile := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	configFile := filepath.Join(dir, "auth.yaml")
+	config := fmt.Sprintf(testConfig, sha256Hex("ingest-token"), caFile, jwksFile)
+	if err := os.WriteFile(configFile, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	a, clientTLS, err := Load(configFile)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if clientTLS == nil || clientTLS.ClientCAs == nil {
+		t.Fatalf("Load() did not return the client CAs of the mtls method")
+	}
+	return a, key
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func signJWT(t *testing.T, key *rsa.PrivateKey, claims jwt.Claims, roles any) string {
+	t.Helper()
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: key, KeyID: "test"}},
+		(&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder := jwt.Signed(signer).Claims(claims)
+	if roles != nil {
+		builder = builder.Claims(map[string]any{"roles": roles})
+	}
+	token, err := builder.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestAuthenticate(t *testing.T) {
+	a, key := setup(t)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	validClaims := jwt.Claims{
+		Issuer:   "https://issuer.example.com",
+		Subject:  "alice",
+		Audience: jwt.Audience{"guac"},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	wrongAudience := validClaims
+	wrongAudience.Audience = jwt.Audience{"other"}
+	expired := validClaims
+	expired.Expiry = jwt.NewNumericDate(now.Add(-time.Hour))
+
+	cert := func(cn string) [][]*x509.Certificate {
+		return [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: cn}}}}
+	}
+
+	tests := []struct {
+		name    string
+		creds   Credentials
+		want    *Principal
+		wantErr error
+	}{{
+		name:    "no credentials",
+		wantErr: ErrNoCredentials,
+	}, {
+		name:  "plain text token",
+		creds: Credentials{Authorization: "Bearer read-token"},
+		want:  &Principal{Name: "reader", Method: MethodToken, Roles: []Role{RoleRead}},
+	}, {
+		name:  "hashed token",
+		creds: Credentials{Authorization: "bearer ingest-token"},
+		want:  &Principal{Name: "ingestor", Method: MethodToken, Roles: []Role{RoleRead, RoleIngest}},
+	}, {
+		name:  "token of a tenant",
+		creds: Credentials{Authorization: "Bearer payments-token"},
+		want:  &Principal{Name: "payments", Method: MethodToken, Roles: []Role{RoleRead, RoleIngest}, Tenant: "payments"},
+	}, {
+		name:    "unknown token",
+		creds:   Credentials{Authorization: "Bearer nope"},
+		wantErr: ErrInvalidCredentials,
+	}, {
+		name:    "basic auth is not supported",
+		creds:   Credentials{Authorization: "Basic cmVhZC10b2tlbg=="},
+		wantErr: ErrNoCredentials,
+	}, {
+		name:  "client certificate with roles",
+		creds: Credentials{VerifiedChains: cert("admin")},
+		want:  &Principal{Name: "admin", Method: MethodMTLS, Roles: []Role{RoleRead, RoleIngest, RoleDelete}},
+	}, {
+		name:  "client certificate of a tenant",
+		creds: Credentials{VerifiedChains: cert("payments-ingest")},
+		want:  &Principal{Name: "payments-ingest", Method: MethodMTLS, Roles: []Role{RoleRead, RoleIngest}, Tenant: "payments"},
+	}, {
+		name:  "client certificate with default roles",
+		creds: Credentials{VerifiedChains: cert("someone")},
+		want:  &Principal{Name: "someone", Method: MethodMTLS, Roles: []Role{RoleRead}},
+	}, {
+		name:  "JWT with roles",
+		creds: Credentials{Authorization: "Bearer " + signJWT(t, key, validClaims, []string{"ingest", "unknown"})},
+		want:  &Principal{Name: "alice", Method: MethodOIDC, Roles: []Role{RoleRead, RoleIngest}},
+	}, {
+		name:  "JWT with a single role",
+		creds: Credentials{Authorization: "Bearer " + signJWT(t, key, validClaims, "delete")},
+		want:  &Principal{Name: "alice", Method: MethodOIDC, Roles: []Role{RoleRead, RoleDelete}},
+	}, {
+		name:    "JWT signed by another key",
+		creds:   Credentials{Authorization: "Bearer " + signJWT(t, otherKey, validClaims, nil)},
+		wantErr: ErrInvalidCredentials,
+	}, {
+		name:    "JWT for another audience",
+		creds:   Credentials{Authorization: "Bearer " + signJWT(t, key, wrongAudience, nil)},
+		wantErr: ErrInvalidCredentials,
+	}, {
+		name:    "expired JWT",
+		creds:   Credentials{Authorization: "Bearer " + signJWT(t, key, expired, nil)},
+		wantErr: ErrInvalidCredentials,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := a.Authenticate(context.Background(), test.creds)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Authenticate() unexpected principal (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewAuthenticatorErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+	}{{
+		name: "no method",
+	}, {
+		name:   "unknown role",
+		config: Config{Tokens: []TokenConfig{{Name: "a", Token: "t", Roles: []Role{"admin"}}}},
+	}, {
+		name:   "token and hash",
+		config: Config{Tokens: []TokenConfig{{Name: "a", Token: "t", SHA256: sha256Hex("t")}}},
+	}, {
+		name:   "invalid hash",
+		config: Config{Tokens: []TokenConfig{{Name: "a", SHA256: "abc"}}},
+	}, {
+		name:   "invalid tenant",
+		config: Config{Tokens: []TokenConfig{{Name: "a", Token: "t", Tenant: "Payments/EU"}}},
+	}, {
+		name:   "oidc without jwks",
+		config: Config{OIDC: &OIDCConfig{Issuer: "i", Audience: "a"}},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewAuthenticator(&test.config); err == nil {
+				t.Errorf("NewAuthenticator() expected an error")
+			}
+		})
+	}
+}
+
+func TestHasTenants(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   bool
+	}{{
+		name:   "global tenant only",
+		config: Config{Tokens: []TokenConfig{{Name: "a"}}, MTLS: &MTLSConfig{Subjects: []SubjectConfig{{CommonName: "b"}}}, OIDC: &OIDCConfig{}},
+	}, {
+		name:   "token tenant",
+		config: Config{Tokens: []TokenConfig{{Name: "a"}, {Name: "b", Tenant: "payments"}}},
+		want:   true,
+	}, {
+		name:   "subject tenant",
+		config: Config{MTLS: &MTLSConfig{Subjects: []SubjectConfig{{CommonName: "b", Tenant: "payments"}}}},
+		want:   true,
+	}, {
+		name:   "default tenant",
+		config: Config{MTLS: &MTLSConfig{DefaultTenant: "payments"}},
+		want:   true,
+	}, {
+		name:   "tenant claim",
+		config: Config{OIDC: &OIDCConfig{TenantClaim: "group"}},
+		want:   true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.config.HasTenants(); got != test.want {
+				t.Errorf("HasTenants() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	a, _ := setup(t)
+	handler := Middleware(a, "/healthz")(RequireRole(RoleIngest, "/healthz")(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if p := PrincipalFromContext(r.Context()); p != nil {
+				_, _ = w.Write([]byte(p.Name))
+			}
+		})))
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		wantCode      int
+		wantBody      string
+	}{{
+		name:     "public path",
+		path:     "/healthz",
+		wantCode: http.StatusOK,
+	}, {
+		name:     "no credentials",
+		path:     "/query",
+		wantCode: http.StatusUnauthorized,
+	}, {
+		name:          "missing role",
+		path:          "/query",
+		authorization: "Bearer read-token",
+		wantCode:      http.StatusForbidden,
+	}, {
+		name:          "allowed",
+		path:          "/query",
+		authorization: "Bearer ingest-token",
+		wantCode:      http.StatusOK,
+		wantBody:      "ingestor",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, test.path, nil)
+			if test.authorization != "" {
+				req.Header.Set("Authorization", test.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != test.wantCode {
+				t.Errorf("status = %d, want %d", rec.Code, test.wantCode)
+			}
+			if test.wantBody != "" && rec.Body.String() != test.wantBody {
+				t.Errorf("body = %q, want %q", rec.Body.String(), test.wantBody)
+			}
+		})
+	}
+}
+
+func TestRequiredRole(t *testing.T) {
+	tests := []struct {
+		operation ast.Operation
+		name      string
+		want      Role
+	}{
+		{ast.Query, "packages", RoleRead},
+		{ast.Subscription, "certifyVulnIngested", RoleRead},
+		{ast.Mutation, "ingestPackage", RoleIngest},
+		{ast.Mutation, "retractDocument", RoleDelete},
+		{ast.Mutation, "delete", RoleDelete},
+	}
+	for _, test := range tests {
+		if got := RequiredRole(test.operation, test.name); got != test.want {
+			t.Errorf("RequiredRole(%s, %s) = %s, want %s", test.operation, test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ReadToken reads a bearer token, a static API token or a JWT, from a file.
+// Clients read it again for every request, so that short lived JWTs can be
+// refreshed by rewriting the file.
+func ReadToken(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file %s: %w", path, err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// ClientTLSConfig returns the TLS configuration of a client that presents
+// the certificate of certFile and keyFile, if set, and trusts the servers
+// signed by the CAs of caFile, if set, instead of the system ones.
+func ClientTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	config := &tls.Config{}
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, fmt.Errorf("both a client certificate and a client key are required")
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate %s and key %s: %w", certFile, keyFile, err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	return config, nil
+}
+
+// TokenFileCredentials are gRPC per-RPC credentials that send the token of
+// a file as a bearer token.
+type TokenFileCredentials struct {
+	Path string
+	// AllowInsecure allows sending the token over connections without TLS.
+	AllowInsecure bool
+}
+
+func (c TokenFileCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	token, err := ReadToken(c.Path)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"authorization": "Bearer " + token}, nil
+}
+
+func (c TokenFileCredentials) RequireTransportSecurity() bool {
+	return !c.AllowInsecure
+}
@@ -0,0 +1,241 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config configures the authentication methods accepted by a server. A
+// request is authenticated by the first method that accepts its credentials.
+//
+// An example configuration:
+//
+//	tokens:
+//	  - name: ci
+//	    sha256: 2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae
+//	    roles: [read, ingest]
+//	  - name: payments-ci
+//	    sha256: fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9
+//	    roles: [read, ingest]
+//	    tenant: payments
+//	mtls:
+//	  clientCAFile: /etc/guac/client-ca.pem
+//	  subjects:
+//	    - commonName: guacingest
+//	      roles: [read, ingest]
+//	oidc:
+//	  issuer: https://issuer.example.com
+//	  audience: guac
+//	  jwksFile: /etc/guac/jwks.json
+//	  defaultRoles: [read]
+//	  tenantClaim: group
+//
+// Callers without a tenant work on the global tenant, whose data is visible
+// to all the tenants.
+type Config struct {
+	Tokens []TokenConfig `yaml:"tokens"`
+	MTLS   *MTLSConfig   `yaml:"mtls"`
+	OIDC   *OIDCConfig   `yaml:"oidc"`
+}
+
+// TokenConfig is a static API token, sent by clients as a bearer token.
+// Exactly one of Token and SHA256 must be set.
+type TokenConfig struct {
+	Name string `yaml:"name"`
+	// Token is the token in clear text.
+	Token string `yaml:"token"`
+	// SHA256 is the hex encoded SHA-256 hash of the token, so that the
+	// configuration does not need to hold the token itself.
+	SHA256 string `yaml:"sha256"`
+	Roles  []Role `yaml:"roles"`
+	Tenant string `yaml:"tenant"`
+}
+
+// MTLSConfig authenticates clients with a TLS client certificate. It
+// requires the server to serve TLS.
+type MTLSConfig struct {
+	// ClientCAFile is a PEM file with the certificate authorities that sign
+	// client certificates.
+	ClientCAFile string `yaml:"clientCAFile"`
+	// Subjects maps the common name of client certificates to roles.
+	Subjects []SubjectConfig `yaml:"subjects"`
+	// DefaultRoles are the roles of clients whose common name is not listed
+	// in Subjects.
+	DefaultRoles []Role `yaml:"defaultRoles"`
+	// DefaultTenant is the tenant of clients whose common name is not listed
+	// in Subjects.
+	DefaultTenant string `yaml:"defaultTenant"`
+}
+
+// SubjectConfig gives roles and a tenant to the client certificates with a
+// common name.
+type SubjectConfig struct {
+	CommonName string `yaml:"commonName"`
+	Roles      []Role `yaml:"roles"`
+	Tenant     string `yaml:"tenant"`
+}
+
+// OIDCConfig authenticates clients with a JWT issued by an OIDC provider,
+// sent as a bearer token. Signatures are checked against a JWKS file rather
+// than keys fetched from the provider, so that the server does not depend on
+// the provider being reachable.
+type OIDCConfig struct {
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	// JWKSFile is a JSON Web Key Set file with the keys that sign tokens.
+	JWKSFile string `yaml:"jwksFile"`
+	// UsernameClaim is the claim that names the caller, "sub" by default.
+	UsernameClaim string `yaml:"usernameClaim"`
+	// RolesClaim is the claim that lists the roles of the caller, "roles" by
+	// default. Values that are not known roles are ignored.
+	RolesClaim string `yaml:"rolesClaim"`
+	// DefaultRoles are given to every caller with a valid token, on top of
+	// those of the roles claim.
+	DefaultRoles []Role `yaml:"defaultRoles"`
+	// TenantClaim is the claim that names the tenant of the caller. If it
+	// is set, tokens without the claim are rejected. If it is not, all the
+	// callers work on the global tenant.
+	TenantClaim string `yaml:"tenantClaim"`
+}
+
+// LoadConfig reads a YAML configuration file.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read auth config %s: %w", path, err)
+	}
+	var c Config
+	if err := yaml.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse auth config %s: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Load reads a configuration file, and returns its authenticator and server
+// TLS configuration, see NewAuthenticator and Config.ServerTLSConfig.
+func Load(path string) (Authenticator, *tls.Config, error) {
+	c, err := LoadConfig(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	a, err := NewAuthenticator(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	clientTLS, err := c.ServerTLSConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	return a, clientTLS, nil
+}
+
+// NewAuthenticator returns an authenticator that accepts the methods of the
+// configuration.
+func NewAuthenticator(c *Config) (Authenticator, error) {
+	var res chain
+	if c.MTLS != nil {
+		a, err := newMTLSAuthenticator(c.MTLS)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+	if len(c.Tokens) > 0 {
+		a, err := newTokenAuthenticator(c.Tokens)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+	if c.OIDC != nil {
+		a, err := newOIDCAuthenticator(c.OIDC)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+	if len(res) == 0 {
+		return nil, errors.New("auth config does not enable any authentication method")
+	}
+	return res, nil
+}
+
+// HasTenants returns whether the configuration assigns a tenant other than
+// the global tenant to some callers.
+func (c *Config) HasTenants() bool {
+	for _, t := range c.Tokens {
+		if t.Tenant != "" {
+			return true
+		}
+	}
+	if c.MTLS != nil {
+		if c.MTLS.DefaultTenant != "" {
+			return true
+		}
+		for _, s := range c.MTLS.Subjects {
+			if s.Tenant != "" {
+				return true
+			}
+		}
+	}
+	return c.OIDC != nil && c.OIDC.TenantClaim != ""
+}
+
+// ServerTLSConfig returns the TLS configuration of a server that accepts
+// client certificates signed by the client CAs of the mTLS method, or nil if
+// the method is not enabled. Client certificates are optional at the TLS
+// level, so that clients can authenticate with a token instead.
+func (c *Config) ServerTLSConfig() (*tls.Config, error) {
+	if c.MTLS == nil {
+		return nil, nil
+	}
+	pool, err := loadCertPool(c.MTLS.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		ClientCAs:  pool,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+	}, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file %s: %w", path, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no PEM certificate found in %s", path)
+	}
+	return pool, nil
+}
+
+func validateRoles(roles []Role) error {
+	for _, r := range roles {
+		if _, err := ParseRole(string(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// RequiredRole returns the role required to call the root field of a
+// GraphQL operation, or the gRPC method of the same name.
+func RequiredRole(operation ast.Operation, name string) Role {
+	if operation != ast.Mutation {
+		return RoleRead
+	}
+	if strings.HasPrefix(name, "delete") || strings.HasPrefix(name, "retract") {
+		return RoleDelete
+	}
+	return RoleIngest
+}
+
+// Authorizer is a gqlgen extension that checks that the principal of the
+// request has the role required by each root field of the operation. Query
+// and mutation fields that are not allowed resolve to null, with an error
+// naming the field. Subscriptions with a field that is not allowed are
+// refused, since gqlgen does not intercept the root fields of subscriptions.
+type Authorizer struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.RootFieldInterceptor
+} = Authorizer{}
+
+func (Authorizer) ExtensionName() string {
+	return "GUACAuthorizer"
+}
+
+func (Authorizer) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Authorizer) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil || oc.Operation.Operation != ast.Subscription {
+		return next(ctx)
+	}
+	for _, field := range graphql.CollectFields(oc, oc.Operation.SelectionSet, []string{"Subscription"}) {
+		if err := authorize(ctx, ast.Subscription, field.Name); err != nil {
+			return graphql.OneShot(graphql.ErrorResponse(ctx, "%s", err))
+		}
+	}
+	return next(ctx)
+}
+
+func (Authorizer) InterceptRootField(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+	fc := graphql.GetRootFieldContext(ctx)
+	if err := authorize(ctx, graphql.GetOperationContext(ctx).Operation.Operation, fc.Field.Name); err != nil {
+		graphql.AddErrorf(ctx, "%s", err)
+		return graphql.Null
+	}
+	return next(ctx)
+}
+
+// authorize returns an error if the principal of ctx does not have the role
+// required to call the root field.
+func authorize(ctx context.Context, operation ast.Operation, field string) error {
+	role := RequiredRole(operation, field)
+	p := PrincipalFromContext(ctx)
+	if !p.HasRole(role) {
+		name := "anonymous"
+		if p != nil {
+			name = p.String()
+		}
+		return fmt.Errorf("%s is not allowed to call %s: the %s role is required", name, field, role)
+	}
+	return nil
+}
+
+// WebsocketInit returns a websocket InitFunc that authenticates the
+// connections whose upgrade request had no credentials, which Middleware lets
+// through, with a and the Authorization of their connectionParams, since
+// browsers cannot set the headers of websocket requests. Connections left
+// without a principal are refused. next, if not nil, is then called with the
+// context of the principal.
+func WebsocketInit(a Authenticator, next transport.WebsocketInitFunc) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		if PrincipalFromContext(ctx) == nil {
+			p, err := a.Authenticate(ctx, Credentials{Authorization: payload.Authorization()})
+			if err != nil {
+				return ctx, nil, fmt.Errorf("unauthorized: %w", err)
+			}
+			ctx = WithPrincipal(ctx, p)
+		}
+		if next != nil {
+			return next(ctx, payload)
+		}
+		return ctx, nil, nil
+	}
+}
@@ -0,0 +1,188 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/server"
+	"github.com/guacsec/guac/pkg/auth"
+)
+
+func TestAuthorizer(t *testing.T) {
+	ctx := context.Background()
+	backend, err := backends.Get("keyvalue", ctx, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("Error getting backend: %v", err)
+	}
+	srv := server.GetGraphqlServer(ctx, backend, server.Options{Limits: server.DefaultLimits()})
+	srv.Use(auth.Authorizer{})
+
+	const query = `{"query": "query { packages(pkgSpec: {}) { id } }"}`
+	const mutation = `{"query": "mutation { ingestPackage(pkg: {packageInput: {type: \"golang\", name: \"x\"}}) { packageVersionID } }"}`
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		body      string
+		wantError string
+	}{{
+		name:      "anonymous query",
+		body:      query,
+		wantError: "anonymous is not allowed to call packages: the read role is required",
+	}, {
+		name:      "reader query",
+		principal: &auth.Principal{Name: "r", Method: auth.MethodToken, Roles: []auth.Role{auth.RoleRead}},
+		body:      query,
+	}, {
+		name:      "reader mutation",
+		principal: &auth.Principal{Name: "r", Method: auth.MethodToken, Roles: []auth.Role{auth.RoleRead}},
+		body:      mutation,
+		wantError: "token:r is not allowed to call ingestPackage: the ingest role is required",
+	}, {
+		name:      "ingestor mutation",
+		principal: &auth.Principal{Name: "i", Method: auth.MethodToken, Roles: []auth.Role{auth.RoleIngest}},
+		body:      mutation,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			if test.principal != nil {
+				req = req.WithContext(auth.WithPrincipal(req.Context(), test.principal))
+			}
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+			body := rec.Body.String()
+			if test.wantError == "" {
+				if strings.Contains(body, `"errors"`) {
+					t.Errorf("unexpected errors in response: %s", body)
+				}
+			} else if !strings.Contains(body, test.wantError) {
+				t.Errorf("response %s does not contain %q", body, test.wantError)
+			}
+		})
+	}
+}
+
+func TestAuthorizerSubscription(t *testing.T) {
+	ctx := context.Background()
+	backend, err := backends.Get("keyvalue", ctx, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("Error getting backend: %v", err)
+	}
+	backend = backends.WithEvents(backend, backends.NewEvents())
+	if _, err := backend.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	a, err := auth.NewAuthenticator(&auth.Config{Tokens: []auth.TokenConfig{
+		{Name: "reader", Token: "read-token", Roles: []auth.Role{auth.RoleRead}},
+		{Name: "collector", Token: "ingest-token", Roles: []auth.Role{auth.RoleIngest}},
+	}})
+	if err != nil {
+		t.Fatalf("NewAuthenticator() error = %v", err)
+	}
+	srv := server.GetGraphqlServer(ctx, backend, server.Options{
+		Limits:        server.DefaultLimits(),
+		WebsocketInit: auth.WebsocketInit(a, nil),
+	})
+	srv.Use(auth.Authorizer{})
+	c := client.New(auth.Middleware(a)(srv))
+
+	// keep ingesting until the test is done, the subscriptions start
+	// asynchronously
+	stop, stopped := make(chan struct{}), make(chan struct{})
+	defer func() {
+		close(stop)
+		<-stopped
+	}()
+	go func() {
+		defer close(stopped)
+		for {
+			if _, err := backend.IngestCertifyBad(ctx,
+				model.PackageSourceOrArtifactInput{Package: &model.IDorPkgInput{PackageInput: testdata.P1}},
+				&model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				model.CertifyBadInputSpec{Justification: "test justification", KnownSince: time.Now()}); err != nil {
+				t.Errorf("Could not ingest certifyBad: %v", err)
+			}
+			select {
+			case <-stop:
+				return
+			case <-time.After(50 * time.Millisecond):
+			}
+		}
+	}()
+
+	const subscription = `subscription { CertifyBadIngested(certifyBadSpec: {}) { justification } }`
+	tests := []struct {
+		name      string
+		header    string
+		payload   map[string]any
+		wantError string
+	}{{
+		name:    "reader in connectionParams",
+		payload: map[string]any{"Authorization": "Bearer read-token"},
+	}, {
+		name:   "reader in header",
+		header: "Bearer read-token",
+	}, {
+		name:      "collector in connectionParams",
+		payload:   map[string]any{"Authorization": "Bearer ingest-token"},
+		wantError: "token:collector is not allowed to call CertifyBadIngested: the read role is required",
+	}, {
+		name:      "invalid connectionParams",
+		payload:   map[string]any{"Authorization": "Bearer other-token"},
+		wantError: "connection_error",
+	}, {
+		name:      "no credentials",
+		wantError: "connection_error",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var options []client.Option
+			if test.header != "" {
+				options = append(options, client.AddHeader("Authorization", test.header))
+			}
+			sub := c.WebsocketWithPayload(subscription, test.payload, options...)
+			defer func() { _ = sub.Close() }()
+			var resp struct {
+				CertifyBadIngested struct{ Justification string }
+			}
+			err := sub.Next(&resp)
+			if test.wantError == "" {
+				if err != nil {
+					t.Fatalf("subscription failed: %v", err)
+				}
+				if resp.CertifyBadIngested.Justification != "test justification" {
+					t.Errorf("got %+v, want the ingested certifyBad", resp.CertifyBadIngested)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), test.wantError) {
+				t.Errorf("subscription error = %v, want %q", err, test.wantError)
+			}
+		})
+	}
+}
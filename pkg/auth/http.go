@@ -0,0 +1,85 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// CredentialsFromRequest returns the credentials of an HTTP request.
+func CredentialsFromRequest(r *http.Request) Credentials {
+	creds := Credentials{Authorization: r.Header.Get("Authorization")}
+	if r.TLS != nil {
+		creds.VerifiedChains = r.TLS.VerifiedChains
+	}
+	return creds
+}
+
+// Middleware authenticates the requests with a, and adds their principal to
+// the request context. Requests that cannot be authenticated are rejected
+// with 401 Unauthorized, except for those to one of the public paths and the
+// websocket upgrades without credentials, whose connections are
+// authenticated by WebsocketInit.
+func Middleware(a Authenticator, publicPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(publicPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			p, err := a.Authenticate(r.Context(), CredentialsFromRequest(r))
+			if errors.Is(err, ErrNoCredentials) && isWebsocketUpgrade(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err != nil {
+				logging.FromContext(r.Context()).Infof("rejected unauthenticated request to %s from %s: %v", r.URL.Path, r.RemoteAddr, err)
+				w.Header().Set("WWW-Authenticate", `Bearer realm="guac"`)
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), p)))
+		})
+	}
+}
+
+// RequireRole rejects with 403 Forbidden the requests whose principal does
+// not have the role, except for those to one of the public paths. It must be
+// used after Middleware.
+func RequireRole(role Role, publicPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(publicPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if p := PrincipalFromContext(r.Context()); !p.HasRole(role) {
+				http.Error(w, "forbidden: the "+string(role)+" role is required", http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isWebsocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
@@ -0,0 +1,72 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+)
+
+// MethodMTLS is the Principal.Method of TLS client certificates.
+const MethodMTLS = "mtls"
+
+// mtlsAuthenticator maps the client certificates verified by the TLS
+// handshake to roles and a tenant. It does not verify certificates itself:
+// the server must be configured with Config.ServerTLSConfig.
+type mtlsAuthenticator struct {
+	subjects      map[string]SubjectConfig
+	defaultRoles  []Role
+	defaultTenant string
+}
+
+func newMTLSAuthenticator(c *MTLSConfig) (*mtlsAuthenticator, error) {
+	if c.ClientCAFile == "" {
+		return nil, fmt.Errorf("mtls requires a clientCAFile")
+	}
+	if err := validateRoles(c.DefaultRoles); err != nil {
+		return nil, fmt.Errorf("mtls default roles: %w", err)
+	}
+	if err := ValidateTenant(c.DefaultTenant); err != nil {
+		return nil, fmt.Errorf("mtls default tenant: %w", err)
+	}
+	res := &mtlsAuthenticator{
+		subjects:      map[string]SubjectConfig{},
+		defaultRoles:  c.DefaultRoles,
+		defaultTenant: c.DefaultTenant,
+	}
+	for _, s := range c.Subjects {
+		if err := validateRoles(s.Roles); err != nil {
+			return nil, fmt.Errorf("mtls subject %s: %w", s.CommonName, err)
+		}
+		if err := ValidateTenant(s.Tenant); err != nil {
+			return nil, fmt.Errorf("mtls subject %s: %w", s.CommonName, err)
+		}
+		res.subjects[s.CommonName] = s
+	}
+	return res, nil
+}
+
+func (a *mtlsAuthenticator) Authenticate(_ context.Context, creds Credentials) (*Principal, error) {
+	if len(creds.VerifiedChains) == 0 || len(creds.VerifiedChains[0]) == 0 {
+		return nil, ErrNoCredentials
+	}
+	name := creds.VerifiedChains[0][0].Subject.CommonName
+	subject, ok := a.subjects[name]
+	if !ok {
+		subject = SubjectConfig{Roles: a.defaultRoles, Tenant: a.defaultTenant}
+	}
+	return &Principal{Name: name, Method: MethodMTLS, Roles: subject.Roles, Tenant: subject.Tenant}, nil
+}
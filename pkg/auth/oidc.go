@@ -0,0 +1,158 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+// MethodOIDC is the Principal.Method of OIDC JWTs.
+const MethodOIDC = "oidc"
+
+// clockSkew is the leeway allowed when checking the expiry of tokens.
+const clockSkew = time.Minute
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+type oidcAuthenticator struct {
+	issuer        string
+	audience      string
+	keys          *jose.JSONWebKeySet
+	usernameClaim string
+	rolesClaim    string
+	tenantClaim   string
+	defaultRoles  []Role
+	now           func() time.Time
+}
+
+func newOIDCAuthenticator(c *OIDCConfig) (*oidcAuthenticator, error) {
+	if c.Issuer == "" || c.Audience == "" || c.JWKSFile == "" {
+		return nil, fmt.Errorf("oidc requires an issuer, an audience and a jwksFile")
+	}
+	if err := validateRoles(c.DefaultRoles); err != nil {
+		return nil, fmt.Errorf("oidc default roles: %w", err)
+	}
+	b, err := os.ReadFile(c.JWKSFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file %s: %w", c.JWKSFile, err)
+	}
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(b, &keys); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS file %s: %w", c.JWKSFile, err)
+	}
+	if len(keys.Keys) == 0 {
+		return nil, fmt.Errorf("JWKS file %s has no keys", c.JWKSFile)
+	}
+	res := &oidcAuthenticator{
+		issuer:        c.Issuer,
+		audience:      c.Audience,
+		keys:          &keys,
+		usernameClaim: c.UsernameClaim,
+		rolesClaim:    c.RolesClaim,
+		tenantClaim:   c.TenantClaim,
+		defaultRoles:  c.DefaultRoles,
+		now:           time.Now,
+	}
+	if res.usernameClaim == "" {
+		res.usernameClaim = "sub"
+	}
+	if res.rolesClaim == "" {
+		res.rolesClaim = "roles"
+	}
+	return res, nil
+}
+
+func (a *oidcAuthenticator) Authenticate(_ context.Context, creds Credentials) (*Principal, error) {
+	raw, ok := creds.bearerToken()
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+	token, err := jwt.ParseSigned(raw, signatureAlgorithms)
+	if err != nil {
+		// not a JWT, possibly a static token
+		return nil, ErrNoCredentials
+	}
+
+	var claims jwt.Claims
+	custom := map[string]any{}
+	if err := token.Claims(a.keys, &claims, &custom); err != nil {
+		return nil, fmt.Errorf("%w: JWT signature: %v", ErrInvalidCredentials, err)
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      a.issuer,
+		AnyAudience: jwt.Audience{a.audience},
+		Time:        a.now(),
+	}, clockSkew); err != nil {
+		return nil, fmt.Errorf("%w: JWT claims: %v", ErrInvalidCredentials, err)
+	}
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w: JWT has no expiry", ErrInvalidCredentials)
+	}
+
+	name, _ := custom[a.usernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("%w: JWT has no %s claim", ErrInvalidCredentials, a.usernameClaim)
+	}
+	var tenant string
+	if a.tenantClaim != "" {
+		tenant, _ = custom[a.tenantClaim].(string)
+		if tenant == "" {
+			return nil, fmt.Errorf("%w: JWT has no %s claim", ErrInvalidCredentials, a.tenantClaim)
+		}
+		if err := ValidateTenant(tenant); err != nil {
+			return nil, fmt.Errorf("%w: JWT %s claim: %v", ErrInvalidCredentials, a.tenantClaim, err)
+		}
+	}
+	return &Principal{Name: name, Method: MethodOIDC, Roles: a.roles(custom[a.rolesClaim]), Tenant: tenant}, nil
+}
+
+// roles returns the default roles and the known roles of the roles claim,
+// which is either a list of roles or a single role.
+func (a *oidcAuthenticator) roles(claim any) []Role {
+	res := slices.Clone(a.defaultRoles)
+	var names []any
+	switch c := claim.(type) {
+	case []any:
+		names = c
+	case string:
+		names = []any{c}
+	}
+	for _, n := range names {
+		s, ok := n.(string)
+		if !ok {
+			continue
+		}
+		r, err := ParseRole(s)
+		if err != nil || slices.Contains(res, r) {
+			continue
+		}
+		res = append(res, r)
+	}
+	return res
+}
@@ -0,0 +1,86 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// MethodToken is the Principal.Method of static API tokens.
+const MethodToken = "token"
+
+type staticToken struct {
+	name   string
+	hash   []byte
+	roles  []Role
+	tenant string
+}
+
+type tokenAuthenticator struct {
+	tokens []staticToken
+}
+
+func newTokenAuthenticator(tokens []TokenConfig) (*tokenAuthenticator, error) {
+	res := &tokenAuthenticator{}
+	for i, t := range tokens {
+		if t.Name == "" {
+			return nil, fmt.Errorf("token %d has no name", i)
+		}
+		if err := validateRoles(t.Roles); err != nil {
+			return nil, fmt.Errorf("token %s: %w", t.Name, err)
+		}
+		if err := ValidateTenant(t.Tenant); err != nil {
+			return nil, fmt.Errorf("token %s: %w", t.Name, err)
+		}
+		var hash []byte
+		switch {
+		case t.Token != "" && t.SHA256 != "":
+			return nil, fmt.Errorf("token %s sets both token and sha256", t.Name)
+		case t.Token != "":
+			sum := sha256.Sum256([]byte(t.Token))
+			hash = sum[:]
+		case t.SHA256 != "":
+			var err error
+			hash, err = hex.DecodeString(strings.TrimSpace(t.SHA256))
+			if err != nil || len(hash) != sha256.Size {
+				return nil, fmt.Errorf("token %s has an invalid sha256 hash", t.Name)
+			}
+		default:
+			return nil, fmt.Errorf("token %s sets neither token nor sha256", t.Name)
+		}
+		res.tokens = append(res.tokens, staticToken{name: t.Name, hash: hash, roles: t.Roles, tenant: t.Tenant})
+	}
+	return res, nil
+}
+
+func (a *tokenAuthenticator) Authenticate(_ context.Context, creds Credentials) (*Principal, error) {
+	token, ok := creds.bearerToken()
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+	sum := sha256.Sum256([]byte(token))
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare(sum[:], t.hash) == 1 {
+			return &Principal{Name: t.name, Method: MethodToken, Roles: t.roles, Tenant: t.tenant}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: unknown API token", ErrInvalidCredentials)
+}
@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 
 	"gocloud.dev/blob"
 	_ "gocloud.dev/blob/azureblob"
@@ -80,3 +81,22 @@ func (b *BlobStore) Read(ctx context.Context, key string) ([]byte, error) {
 	}
 	return buf.Bytes(), nil
 }
+
+// Size returns the size in bytes of the data of the key.
+func (b *BlobStore) Size(ctx context.Context, key string) (int64, error) {
+	attrs, err := b.bucket.Attributes(ctx, key)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get attributes from bucket with error: %w", err)
+	}
+	return attrs.Size, nil
+}
+
+// NewReader returns a reader of the data of the key, for the data too large
+// to be read at once with Read. The reader must be closed.
+func (b *BlobStore) NewReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	r, err := b.bucket.NewReader(ctx, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read to bucket with error: %w", err)
+	}
+	return r, nil
+}
@@ -0,0 +1,86 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/guacsec/guac/pkg/auth"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/viper"
+)
+
+// AuthFlags are the flags of the credentials that clients send to the GQL
+// and gRPC servers, to be added to the BuildFlags of the commands that call
+// HTTPAuthTransport.
+var AuthFlags = []string{
+	"gql-token-file",
+	"gql-client-cert-file",
+	"gql-client-key-file",
+	"gql-ca-file",
+}
+
+type httpTokenTransport struct {
+	tokenFile string
+	http.RoundTripper
+}
+
+// HTTPAuthTransport returns a transport that sends the credentials configured
+// by the AuthFlags: the token of gql-token-file as a bearer token, and the
+// client certificate of gql-client-cert-file and gql-client-key-file. It
+// returns transport unchanged if none is configured.
+func HTTPAuthTransport(ctx context.Context, transport http.RoundTripper) http.RoundTripper {
+	logger := logging.FromContext(ctx)
+	tokenFile := viper.GetString("gql-token-file")
+	certFile := viper.GetString("gql-client-cert-file")
+	keyFile := viper.GetString("gql-client-key-file")
+	caFile := viper.GetString("gql-ca-file")
+
+	if certFile != "" || keyFile != "" || caFile != "" {
+		t, ok := transport.(*http.Transport)
+		if !ok {
+			logger.Fatalf("cannot configure client certificates on a %T transport", transport)
+		}
+		config, err := auth.ClientTLSConfig(certFile, keyFile, caFile)
+		if err != nil {
+			logger.Fatalf("error configuring client TLS: %v", err)
+		}
+		t = t.Clone()
+		t.TLSClientConfig = config
+		transport = t
+	}
+
+	if tokenFile != "" {
+		// fail early rather than on the first request
+		if _, err := auth.ReadToken(tokenFile); err != nil {
+			logger.Fatalf("error reading token file: %v", err)
+		}
+		transport = &httpTokenTransport{tokenFile, transport}
+	}
+	return transport
+}
+
+func (t *httpTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := auth.ReadToken(t.tokenFile)
+	if err != nil {
+		return nil, err
+	}
+	// RoundTrip must not modify the request
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.RoundTripper.RoundTrip(req)
+}
@@ -0,0 +1,53 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPAuthTransport(t *testing.T) {
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Authorization"))
+	}))
+	defer srv.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("first\n"), 0o600))
+	viper.Set("gql-token-file", tokenFile)
+	defer viper.Set("gql-token-file", "")
+
+	c := http.Client{Transport: HTTPAuthTransport(context.Background(), http.DefaultTransport)}
+	_, err := c.Get(srv.URL)
+	require.NoError(t, err)
+
+	// the token is read again for every request
+	require.NoError(t, os.WriteFile(tokenFile, []byte("second"), 0o600))
+	_, err = c.Get(srv.URL)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Bearer first", "Bearer second"}, got)
+}
@@ -0,0 +1,169 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// Node types of the table rows of the query commands that describe problems.
+const (
+	NodeTypeCertifyVuln = "certifyVuln"
+	NodeTypeCertifyBad  = "badLink"
+	NodeTypeVEX         = "vexLink"
+)
+
+const noVulnType = "novuln"
+
+// FindingsFromRows converts the "Node Type", "Node ID #" and "Additional
+// Information" rows of the query commands to findings. The CertifyVuln,
+// CertifyBad and CertifyVEXStatement nodes of the rows are fetched to fill in
+// their subject, vulnerability and failure.
+func FindingsFromRows(ctx context.Context, gqlClient graphql.Client, rows []table.Row) ([]Finding, error) {
+	findings := []Finding{}
+	for _, row := range rows {
+		if len(row) < 2 {
+			continue
+		}
+		f := Finding{NodeType: fmt.Sprint(row[0]), NodeID: fmt.Sprint(row[1])}
+		if len(row) > 2 {
+			f.Info = fmt.Sprint(row[2])
+		}
+		switch f.NodeType {
+		case NodeTypeCertifyVuln, NodeTypeCertifyBad, NodeTypeVEX:
+			if err := describeNode(ctx, gqlClient, &f); err != nil {
+				return nil, err
+			}
+		}
+		findings = append(findings, f)
+	}
+	return findings, nil
+}
+
+func describeNode(ctx context.Context, gqlClient graphql.Client, f *Finding) error {
+	resp, err := model.Node(ctx, gqlClient, f.NodeID)
+	if err != nil {
+		return fmt.Errorf("failed to query node %s: %w", f.NodeID, err)
+	}
+	switch n := resp.Node.(type) {
+	case *model.NodeNodeCertifyVuln:
+		f.Subject = helpers.AllPkgTreeToPurl(&n.Package.AllPkgTree)
+		if n.Vulnerability.Type == noVulnType {
+			return nil
+		}
+		f.Vulnerability = vulnerabilityID(n.Vulnerability.AllVulnerabilityTree)
+		f.Failure = fmt.Sprintf("%s is affected by %s", f.Subject, f.Vulnerability)
+	case *model.NodeNodeCertifyBad:
+		f.Subject = certifyBadSubject(n.Subject)
+		f.Failure = fmt.Sprintf("%s is certified bad: %s", f.Subject, n.Justification)
+	case *model.NodeNodeCertifyVEXStatement:
+		f.Subject = vexSubject(n.Subject)
+		f.Vulnerability = vulnerabilityID(n.Vulnerability.AllVulnerabilityTree)
+		if n.Status == model.VexStatusAffected {
+			f.Failure = fmt.Sprintf("%s is affected by %s according to a VEX statement", f.Subject, f.Vulnerability)
+		}
+	}
+	return nil
+}
+
+// AddSeverities sets the severity of the findings about a vulnerability to
+// the highest CVSS score of the VulnerabilityMetadata of the vulnerability.
+func AddSeverities(ctx context.Context, gqlClient graphql.Client, findings []Finding) error {
+	severities := map[string]*Severity{}
+	for i := range findings {
+		vuln := findings[i].Vulnerability
+		if vuln == "" {
+			continue
+		}
+		severity, ok := severities[vuln]
+		if !ok {
+			var err error
+			severity, err = highestSeverity(ctx, gqlClient, vuln)
+			if err != nil {
+				return err
+			}
+			severities[vuln] = severity
+		}
+		findings[i].Severity = severity
+	}
+	return nil
+}
+
+func highestSeverity(ctx context.Context, gqlClient graphql.Client, vuln string) (*Severity, error) {
+	resp, err := model.VulnerabilityMetadata(ctx, gqlClient, model.VulnerabilityMetadataSpec{
+		Vulnerability: &model.VulnerabilitySpec{VulnerabilityID: &vuln},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query metadata of vulnerability %s: %w", vuln, err)
+	}
+	var highest *Severity
+	for _, m := range resp.VulnerabilityMetadata {
+		// only CVSS scores are severities, EPSS and SSVC are not
+		if !strings.HasPrefix(string(m.ScoreType), "CVSS") {
+			continue
+		}
+		if highest == nil || m.ScoreValue > highest.Score {
+			highest = &Severity{ScoreType: string(m.ScoreType), Score: m.ScoreValue}
+		}
+	}
+	return highest, nil
+}
+
+func vulnerabilityID(v model.AllVulnerabilityTree) string {
+	if len(v.VulnerabilityIDs) == 0 {
+		return v.Type
+	}
+	return v.VulnerabilityIDs[0].VulnerabilityID
+}
+
+func certifyBadSubject(s model.AllCertifyBadSubjectPackageSourceOrArtifact) string {
+	switch v := s.(type) {
+	case *model.AllCertifyBadSubjectPackage:
+		return helpers.AllPkgTreeToPurl(&v.AllPkgTree)
+	case *model.AllCertifyBadSubjectSource:
+		return sourceString(v.AllSourceTree)
+	case *model.AllCertifyBadSubjectArtifact:
+		return v.Algorithm + ":" + v.Digest
+	default:
+		return ""
+	}
+}
+
+func vexSubject(s model.AllCertifyVEXStatementSubjectPackageOrArtifact) string {
+	switch v := s.(type) {
+	case *model.AllCertifyVEXStatementSubjectPackage:
+		return helpers.AllPkgTreeToPurl(&v.AllPkgTree)
+	case *model.AllCertifyVEXStatementSubjectArtifact:
+		return v.Algorithm + ":" + v.Digest
+	default:
+		return ""
+	}
+}
+
+func sourceString(src model.AllSourceTree) string {
+	namespace := src.Namespaces[0].Namespace
+	if !strings.HasPrefix(namespace, "https://") {
+		namespace = "https://" + namespace
+	}
+	return src.Type + "+" + namespace + "/" + src.Namespaces[0].Names[0].Name
+}
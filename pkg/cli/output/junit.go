@@ -0,0 +1,132 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes r as a JUnit XML report, with a test suite for each
+// section and a test case for each finding. Failures, such as CertifyBad
+// nodes and vulnerabilities, are failed test cases.
+func WriteJUnit(w io.Writer, r *Report) error {
+	suites := junitTestSuites{Name: "guac " + r.Command}
+	for _, s := range r.Sections {
+		suite := junitTestSuite{Name: suiteName(r, s)}
+		for _, f := range s.Findings {
+			subject := f.Subject
+			if subject == "" {
+				subject = r.Subject
+			}
+			tc := junitTestCase{
+				Name:      testCaseName(f),
+				ClassName: subject,
+				SystemOut: f.Info,
+			}
+			if f.Failure != "" {
+				tc.Failure = &junitFailure{
+					Message: f.Failure,
+					Type:    f.NodeType,
+					Text:    failureText(f),
+				}
+				suite.Failures++
+			}
+			suite.TestCases = append(suite.TestCases, tc)
+			suite.Tests++
+		}
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func suiteName(r *Report, s Section) string {
+	name := r.Command
+	if r.Subject != "" {
+		name += " " + r.Subject
+	}
+	if s.Title != "" {
+		name += ": " + s.Title
+	}
+	return name
+}
+
+func testCaseName(f Finding) string {
+	parts := []string{f.NodeType}
+	if f.Vulnerability != "" {
+		parts = append(parts, f.Vulnerability)
+	}
+	if f.NodeID != "" {
+		parts = append(parts, "("+f.NodeID+")")
+	}
+	return strings.Join(parts, " ")
+}
+
+func failureText(f Finding) string {
+	var lines []string
+	if f.Subject != "" {
+		lines = append(lines, "subject: "+f.Subject)
+	}
+	if f.Severity != nil {
+		lines = append(lines, fmt.Sprintf("severity: %s %.1f", f.Severity.ScoreType, f.Severity.Score))
+	}
+	if f.Info != "" {
+		lines = append(lines, f.Info)
+	}
+	return strings.Join(lines, "\n")
+}
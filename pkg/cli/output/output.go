@@ -0,0 +1,138 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package output writes the results of the guacone query commands in
+// machine-readable formats: JSON, SARIF for code scanning tools and JUnit for
+// test dashboards. The table format stays with the commands, which render
+// their own columns.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Format is an output format of the query commands.
+type Format string
+
+const (
+	// FormatTable is the human-readable table that the commands render.
+	FormatTable Format = "table"
+	// FormatJSON is the Report as JSON.
+	FormatJSON Format = "json"
+	// FormatSARIF is a SARIF 2.1.0 log with a result for each failure.
+	FormatSARIF Format = "sarif"
+	// FormatJUnit is a JUnit XML report with a test case for each finding.
+	FormatJUnit Format = "junit"
+)
+
+// Formats lists the supported formats.
+var Formats = []Format{FormatTable, FormatJSON, FormatSARIF, FormatJUnit}
+
+// ParseFormat returns the format named s.
+func ParseFormat(s string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported output format %q, valid formats are: %v", s, Formats)
+}
+
+// Severity is the highest score known for a vulnerability.
+type Severity struct {
+	// ScoreType is the type of the VulnerabilityMetadata score, such as CVSSv3.
+	ScoreType string  `json:"scoreType"`
+	Score     float64 `json:"score"`
+}
+
+// Finding is a node found by a query.
+type Finding struct {
+	// NodeType is the kind of node, such as certifyVuln or badLink, or the
+	// rule name for policy results.
+	NodeType string `json:"nodeType"`
+	NodeID   string `json:"nodeId,omitempty"`
+	// Info is the additional information shown in the table.
+	Info string `json:"info,omitempty"`
+	// Subject is the purl, source or digest that the node is about.
+	Subject       string    `json:"subject,omitempty"`
+	Vulnerability string    `json:"vulnerability,omitempty"`
+	Severity      *Severity `json:"severity,omitempty"`
+	// Failure describes why the finding is a problem, such as a
+	// vulnerability or a CertifyBad justification. It is empty for
+	// informational findings.
+	Failure string `json:"failure,omitempty"`
+}
+
+// Section is a group of findings, such as the nodes attached to a package
+// version.
+type Section struct {
+	Title    string    `json:"title,omitempty"`
+	Findings []Finding `json:"findings"`
+}
+
+// Report is the result of a query command.
+type Report struct {
+	// Command is the name of the command, such as "query vuln".
+	Command       string    `json:"command"`
+	Subject       string    `json:"subject,omitempty"`
+	Sections      []Section `json:"sections"`
+	VisualizerURL string    `json:"visualizerUrl,omitempty"`
+	// Message is set when the query found nothing to report.
+	Message string `json:"message,omitempty"`
+}
+
+// Failures returns the number of findings that are failures.
+func (r *Report) Failures() int {
+	n := 0
+	for _, s := range r.Sections {
+		for _, f := range s.Findings {
+			if f.Failure != "" {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// Write writes r to w in format f. The table format is rendered by the
+// commands and is not supported here.
+func Write(w io.Writer, f Format, r *Report) error {
+	switch f {
+	case FormatJSON:
+		return WriteJSON(w, r)
+	case FormatSARIF:
+		return WriteSARIF(w, r)
+	case FormatJUnit:
+		return WriteJUnit(w, r)
+	default:
+		return fmt.Errorf("output format %q cannot be written as a report", f)
+	}
+}
+
+// WriteJSON writes r as indented JSON.
+func WriteJSON(w io.Writer, r *Report) error {
+	if r.Sections == nil {
+		r.Sections = []Section{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("failed to write JSON report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/cli/output"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/owenrumney/go-sarif/v2/sarif"
+)
+
+var testReport = &output.Report{
+	Command: "query known",
+	Subject: "pkg:guac/app@1.0",
+	Sections: []output.Section{{
+		Title: "Package Version Nodes",
+		Findings: []output.Finding{
+			{
+				NodeType:      "certifyVuln",
+				NodeID:        "1",
+				Subject:       "pkg:guac/lib@1.0",
+				Vulnerability: "cve-2024-1",
+				Severity:      &output.Severity{ScoreType: "CVSSv3", Score: 9.8},
+				Failure:       "pkg:guac/lib@1.0 is affected by cve-2024-1",
+			},
+			{
+				NodeType:      "certifyVuln",
+				NodeID:        "2",
+				Subject:       "pkg:guac/lib@1.0",
+				Vulnerability: "cve-2024-2",
+				Failure:       "pkg:guac/lib@1.0 is affected by cve-2024-2",
+			},
+			{
+				NodeType: "badLink",
+				NodeID:   "3",
+				Failure:  "pkg:guac/app@1.0 is certified bad: malware",
+			},
+			{
+				NodeType: "hasSBOM",
+				NodeID:   "4",
+				Info:     "SBOM Download Location: https://example.com/sbom.json",
+			},
+		},
+	}},
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range output.Formats {
+		if got, err := output.ParseFormat(string(f)); err != nil || got != f {
+			t.Errorf("ParseFormat(%q) = %q, %v", f, got, err)
+		}
+	}
+	if _, err := output.ParseFormat("yaml"); err == nil {
+		t.Errorf("ParseFormat(yaml) did not return an error")
+	}
+	if err := output.Write(&bytes.Buffer{}, output.FormatTable, testReport); err == nil {
+		t.Errorf("Write() with the table format did not return an error")
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := output.Write(&buf, output.FormatJSON, testReport); err != nil {
+		t.Fatalf("Write() returned unexpected error: %v", err)
+	}
+	var got output.Report
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("failed to decode JSON report: %v", err)
+	}
+	if diff := cmp.Diff(testReport, &got); diff != "" {
+		t.Errorf("unexpected report (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := output.Write(&buf, output.FormatSARIF, testReport); err != nil {
+		t.Fatalf("Write() returned unexpected error: %v", err)
+	}
+	log, err := sarif.FromBytes(buf.Bytes())
+	if err != nil {
+		t.Fatalf("failed to parse SARIF log: %v", err)
+	}
+	if len(log.Runs) != 1 {
+		t.Fatalf("SARIF log has %d runs, want 1", len(log.Runs))
+	}
+	run := log.Runs[0]
+
+	type result struct {
+		Rule, Level, Location string
+	}
+	var results []result
+	for _, r := range run.Results {
+		if r.Locations[0].PhysicalLocation != nil {
+			t.Errorf("result of %s has a physical location", *r.RuleID)
+		}
+		results = append(results, result{*r.RuleID, *r.Level, *r.Locations[0].LogicalLocations[0].FullyQualifiedName})
+	}
+	wantResults := []result{
+		{"cve-2024-1", "error", "pkg:guac/lib@1.0"},
+		{"cve-2024-2", "warning", "pkg:guac/lib@1.0"},
+		{"badLink", "error", "pkg:guac/app@1.0"},
+	}
+	if diff := cmp.Diff(wantResults, results); diff != "" {
+		t.Errorf("unexpected results (-want +got):\n%s", diff)
+	}
+
+	rule, err := run.GetRuleById("cve-2024-1")
+	if err != nil {
+		t.Fatalf("rule of cve-2024-1 not found: %v", err)
+	}
+	if got := rule.Properties["security-severity"]; got != "9.8" {
+		t.Errorf("security-severity = %v, want 9.8", got)
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := output.Write(&buf, output.FormatJUnit, testReport); err != nil {
+		t.Fatalf("Write() returned unexpected error: %v", err)
+	}
+	var got struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Suites   []struct {
+			Name  string `xml:"name,attr"`
+			Cases []struct {
+				Name    string `xml:"name,attr"`
+				Failure *struct {
+					Type string `xml:"type,attr"`
+				} `xml:"failure"`
+			} `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("failed to decode JUnit report: %v", err)
+	}
+	if got.Tests != 4 || got.Failures != 3 {
+		t.Errorf("tests = %d, failures = %d, want 4 and 3", got.Tests, got.Failures)
+	}
+	if len(got.Suites) != 1 || got.Suites[0].Name != "query known pkg:guac/app@1.0: Package Version Nodes" {
+		t.Fatalf("unexpected test suites: %+v", got.Suites)
+	}
+	var failed []string
+	for _, c := range got.Suites[0].Cases {
+		if c.Failure != nil {
+			failed = append(failed, c.Name+" "+c.Failure.Type)
+		}
+	}
+	wantFailed := []string{"certifyVuln cve-2024-1 (1) certifyVuln", "certifyVuln cve-2024-2 (2) certifyVuln", "badLink (3) badLink"}
+	if diff := cmp.Diff(wantFailed, failed); diff != "" {
+		t.Errorf("unexpected failed test cases (-want +got):\n%s", diff)
+	}
+}
+
+func TestFindingsFromRows(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	ids := Ingest(ctx, t, gqlClient, GuacData{
+		Packages:        []string{"pkg:guac/app@1.0", "pkg:guac/lib@1.0"},
+		Vulnerabilities: []string{"cve/cve-2024-1"},
+		CertifyVulns:    []CertifyVuln{{Package: "pkg:guac/lib@1.0", Vulnerability: "cve/cve-2024-1"}},
+		CertifyBads:     []CertifyBad{{Subject: "pkg:guac/app@1.0"}},
+	})
+	vulnID := ids.VulnerabilityIds["cve/cve-2024-1"]
+	for _, m := range []model.VulnerabilityMetadataInputSpec{
+		{ScoreType: model.VulnerabilityScoreTypeCvssv2, ScoreValue: 5.0},
+		{ScoreType: model.VulnerabilityScoreTypeCvssv3, ScoreValue: 7.5},
+		{ScoreType: model.VulnerabilityScoreTypeEpssv2, ScoreValue: 9.9},
+	} {
+		m.Timestamp = time.Now()
+		m.Origin = "test-origin"
+		m.Collector = "test-collector"
+		if _, err := model.IngestVulnHasMetadata(ctx, gqlClient, model.IDorVulnerabilityInput{VulnerabilityNodeID: &vulnID}, m); err != nil {
+			t.Fatalf("failed to ingest vulnerability metadata: %v", err)
+		}
+	}
+
+	certifyVulns, err := model.CertifyVuln(ctx, gqlClient, model.CertifyVulnSpec{})
+	if err != nil {
+		t.Fatalf("failed to query CertifyVuln: %v", err)
+	}
+	certifyBads, err := model.CertifyBad(ctx, gqlClient, model.CertifyBadSpec{})
+	if err != nil {
+		t.Fatalf("failed to query CertifyBad: %v", err)
+	}
+	certifyVulnID := certifyVulns.CertifyVuln[0].Id
+	certifyBadID := certifyBads.CertifyBad[0].Id
+
+	findings, err := output.FindingsFromRows(ctx, gqlClient, []table.Row{
+		{output.NodeTypeCertifyVuln, certifyVulnID, "vulnerability ID: cve-2024-1"},
+		{output.NodeTypeCertifyBad, certifyBadID, "justification: test-justification"},
+		{"hashEqual", "42", ""},
+	})
+	if err != nil {
+		t.Fatalf("FindingsFromRows() returned unexpected error: %v", err)
+	}
+	if err := output.AddSeverities(ctx, gqlClient, findings); err != nil {
+		t.Fatalf("AddSeverities() returned unexpected error: %v", err)
+	}
+
+	want := []output.Finding{
+		{
+			NodeType:      output.NodeTypeCertifyVuln,
+			NodeID:        certifyVulnID,
+			Info:          "vulnerability ID: cve-2024-1",
+			Subject:       "pkg:guac/lib@1.0",
+			Vulnerability: "cve-2024-1",
+			Severity:      &output.Severity{ScoreType: "CVSSv3", Score: 7.5},
+			Failure:       "pkg:guac/lib@1.0 is affected by cve-2024-1",
+		},
+		{
+			NodeType: output.NodeTypeCertifyBad,
+			NodeID:   certifyBadID,
+			Info:     "justification: test-justification",
+			Subject:  "pkg:guac/app@1.0",
+			Failure:  "pkg:guac/app@1.0 is certified bad: test-justification",
+		},
+		{
+			NodeType: "hashEqual",
+			NodeID:   "42",
+		},
+	}
+	if diff := cmp.Diff(want, findings); diff != "" {
+		t.Errorf("unexpected findings (-want +got):\n%s", diff)
+	}
+}
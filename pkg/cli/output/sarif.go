@@ -0,0 +1,123 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/guacsec/guac/pkg/version"
+	"github.com/owenrumney/go-sarif/v2/sarif"
+)
+
+const informationURI = "https://guac.sh"
+
+// WriteSARIF writes the failures of r as the results of a SARIF 2.1.0 log.
+// Vulnerabilities become rules named after their ID, with the severity as the
+// security-severity property that GitHub code scanning uses to rank alerts.
+// The subject of each failure is reported as a logical location.
+func WriteSARIF(w io.Writer, r *Report) error {
+	log, err := sarif.New(sarif.Version210)
+	if err != nil {
+		return fmt.Errorf("failed to create SARIF log: %w", err)
+	}
+	driver := sarif.NewVersionedDriver("guac", version.Version)
+	driver.InformationURI = ptr(informationURI)
+	run := sarif.NewRun(*sarif.NewTool(driver))
+	run.Results = []*sarif.Result{}
+
+	for _, s := range r.Sections {
+		for _, f := range s.Findings {
+			if f.Failure == "" {
+				continue
+			}
+			ruleID := f.NodeType
+			if f.Vulnerability != "" {
+				ruleID = f.Vulnerability
+			}
+			rule := run.AddRule(ruleID).WithDescription(ruleDescription(f))
+			if f.Vulnerability != "" {
+				props := sarif.Properties{"tags": []string{"security", "vulnerability"}}
+				if f.Severity != nil {
+					props["security-severity"] = fmt.Sprintf("%.1f", f.Severity.Score)
+				}
+				rule.WithProperties(props)
+			}
+
+			subject := f.Subject
+			if subject == "" {
+				subject = r.Subject
+			}
+			result := run.CreateResultForRule(ruleID).
+				WithLevel(sarifLevel(f)).
+				WithMessage(sarif.NewTextMessage(f.Failure))
+			// the subject is a purl, source or digest rather than a file, so
+			// it is a logical location and the result has no physical one
+			if subject != "" {
+				result.AddLocation(sarif.NewLocation().
+					WithLogicalLocations([]*sarif.LogicalLocation{
+						sarif.NewLogicalLocation().
+							WithName(subject).
+							WithFullyQualifiedName(subject).
+							WithKind(f.NodeType),
+					}))
+			}
+			if f.NodeID != "" {
+				result.WithPartialFingerPrints(map[string]interface{}{"guacNodeId": f.NodeID})
+			}
+		}
+	}
+	log.AddRun(run)
+
+	if err := log.PrettyWrite(w); err != nil {
+		return fmt.Errorf("failed to write SARIF log: %w", err)
+	}
+	_, err = fmt.Fprintln(w)
+	return err
+}
+
+// sarifLevel maps the severity of a vulnerability to a SARIF level, using the
+// CVSS qualitative ratings: high and critical scores are errors, medium scores
+// are warnings and low scores are notes. Other failures are errors.
+func sarifLevel(f Finding) string {
+	if f.Vulnerability == "" {
+		return "error"
+	}
+	switch {
+	case f.Severity == nil:
+		return "warning"
+	case f.Severity.Score >= 7.0:
+		return "error"
+	case f.Severity.Score >= 4.0:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func ruleDescription(f Finding) string {
+	if f.Vulnerability != "" {
+		return "Vulnerability " + f.Vulnerability
+	}
+	if f.Info != "" {
+		return f.NodeType + ": " + f.Info
+	}
+	return f.NodeType
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
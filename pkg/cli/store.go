@@ -39,6 +39,10 @@ func init() {
 	set.Bool("csub-tls", false, "enable tls connection to the server")
 	set.Bool("csub-tls-skip-verify", false, "skip verifying server certificate (for self-signed certificates for example)")
 	set.Bool("use-csub", true, "use collectsub server for datasource")
+	set.Bool("csub-lease", false, "lease the collect entries from the collect-sub service, so that several collectors split the entries instead of each collecting all of them")
+	set.String("csub-lease-duration", "5m", "duration of the collect entry leases, after which entries that were not acknowledged can be leased again")
+	set.Int("csub-lease-max-entries", 0, "maximum number of collect entries leased at once (0 leases all available entries)")
+	set.String("csub-requeue-after", "0", "duration after which acknowledged collect entries can be leased again, e.g. 24h (0 never leases them again)")
 
 	// OCI collector options
 	set.Bool("insecure-skip-tls-verify", false, "skip TLS verification when connecting to OCI registries (allows HTTP)")
@@ -46,6 +50,8 @@ func init() {
 	set.Int("csub-listen-port", 2782, "port to listen to on collect-sub service")
 	set.String("csub-tls-cert-file", "", "path to the TLS certificate in PEM format for collect-sub service")
 	set.String("csub-tls-key-file", "", "path to the TLS key in PEM format for collect-sub service")
+	set.String("csub-db-file", "", "path to a bolt database file that persists the collect entries of the collect-sub service, entries are kept in memory if not set")
+	set.String("csub-db-retention", "0", "remove collect entries that were added longer ago than this duration from the csub-db-file, e.g. 720h (0 keeps them forever)")
 
 	set.String("gql-backend", "keyvalue", "backend used for graphql api server: [keyvalue | arango (experimental) | ent (experimental) | neo4j (unmaintained)]")
 	set.Int("gql-listen-port", 8080, "port used for graphql api server")
@@ -53,6 +59,13 @@ func init() {
 	set.String("gql-tls-key-file", "", "path to the TLS key in PEM format for graphql api server")
 	set.Bool("gql-debug", false, "debug flag which enables the graphQL playground")
 	set.Bool("gql-trace", false, "flag which enables tracing of graphQL requests and responses on the console")
+	set.Int("gql-grpc-listen-port", 0, "port used for the gRPC api served next to the graphql api server (0 disables it)")
+	set.String("gql-auth-config", "", "path to the YAML file configuring the authentication (API tokens, mTLS, OIDC) and roles of the graphql and gRPC api callers (empty disables authentication)")
+	set.Int("gql-max-complexity", 1500000, "maximum complexity of a graphql query: each field costs 1 plus the complexity of its selections, multiplied by the number of items it may return (0 disables the limit)")
+	set.Int("gql-list-size", 10000, "maximum number of items returned by the graphql root fields that return a list without a first, maxPathLength or maxDepth argument; the paginated ones return pages of this size when queried without first (0 disables the limit)")
+	set.Int("gql-max-depth", 15, "maximum number of nested fields in a graphql query (0 disables the limit)")
+	set.String("gql-query-timeout", "1m", "maximum duration of a graphql query, e.g. 30s (0 disables the timeout)")
+	set.Int("gql-max-result-size", 1000000, "maximum number of list items returned by a graphql query, summed over all its fields (0 disables the limit)")
 
 	// blob store address
 	set.String("blob-addr", "file:///tmp/blobstore?no_tmp_dir=true", "gocloud connection string for blob store configured via https://gocloud.dev/howto/blob/ (default: filesystem)")
@@ -77,10 +90,23 @@ func init() {
 	set.Bool("add-depsdev-on-ingest", false, "if enabled, the ingestor will query and ingest deps.dev scorecards and source association data. Warning: This will increase ingestion times")
 
 	set.String("gql-addr", "http://localhost:8080/query", "endpoint used to connect to graphQL server")
+	// notifications of ingested predicates
+	set.String("notify-config", "", "path to the subscription file (YAML or JSON) of the notifications to send when matching predicates are ingested")
+	// dead-letter queue of the documents that failed ingestion
+	set.String("dlq-addr", "", "gocloud connection string for the blob store recording the documents that failed ingestion, e.g. file:///tmp/guac-dlq?no_tmp_dir=true. Only the documents that cannot be decoded, processed or parsed are recorded, other failures are retried (empty disables the dead-letter queue)")
+	set.String("dlq-pubsub-addr", "", "optional gocloud connection string for the pubsub topic the failed documents are also published on, e.g. nats://127.0.0.1:4222?subject=DOCUMENTS.deadletter")
+	// ledger of the ingested documents
+	set.String("ledger-addr", "", "gocloud connection string for the blob store recording the ingested documents, so that unchanged documents are not ingested again, e.g. file:///tmp/guac-ledger?no_tmp_dir=true. The ledger is not used when scanning for vulnerabilities, end-of-life or deps.dev data (empty disables the ledger)")
+	set.Bool("force", false, "ingest documents even if the ledger records them as already ingested")
+
+	set.String("grpc-addr", "", "address of the gRPC api used for ingestion instead of graphQL, e.g. localhost:8082 (empty uses graphQL)")
+	set.Bool("grpc-tls", false, "enable tls connection to the gRPC api server")
+	set.Bool("grpc-tls-skip-verify", false, "skip verifying the gRPC api server certificate (for self-signed certificates for example)")
 
 	set.String("rest-api-server-port", "8081", "port to serve the REST API from")
 	set.String("rest-api-tls-cert-file", "", "path to the TLS certificate in PEM format for rest api server")
 	set.String("rest-api-tls-key-file", "", "path to the TLS key in PEM format for rest api server")
+	set.String("rest-api-auth-config", "", "path to the YAML file configuring the authentication (API tokens, mTLS, OIDC) of the rest api callers, who need the read role (empty disables authentication)")
 	set.Bool("db-direct-connection", false, "[experimental] connect directly to the database that backs the gql API for optimized endpoint implementations")
 
 	set.String("verifier-key-path", "", "path to pem file to verify dsse")
@@ -115,6 +141,7 @@ func init() {
 	set.StringP("justification", "j", "", "justification for the metadata")
 
 	set.IntP("search-depth", "d", 0, "depth to search, 0 has no limit")
+	set.String("dependency-type", "", "only follow dependencies of this type: direct, indirect or unknown (empty follows all)")
 
 	set.StringP("vuln-id", "v", "", "vulnerability ID to check")
 	set.Int("num-path", 0, "number of paths to return, 0 means all paths")
@@ -123,6 +150,16 @@ func init() {
 	set.Bool("is-pkg-version-start", false, "for query path are you inputting a packageVersion to start the search from (if false then packageName)")
 	set.Bool("is-pkg-version-stop", false, "for query path are you inputting a packageVersion to stop the search at (if false then packageName)")
 
+	set.Bool("dry-run", false, "list what would be removed without removing anything")
+
+	set.String("policy-file", "", "path to the policy file (YAML or JSON) to evaluate")
+	set.String("output", "table", "format of the query results: table, json, sarif or junit")
+
+	set.String("sbom-format", "spdx", "format of the exported SBOM: spdx (SPDX 2.3) or cyclonedx (CycloneDX 1.5)")
+	set.String("vex-format", "openvex", "format of the exported VEX document: openvex or csaf (CSAF 2.0)")
+	set.String("vex-author", "GUAC", "author of the exported VEX document")
+	set.String("export-file", "", "path of the file to write the exported document to (default: stdout)")
+
 	// Google Cloud platform flags
 	set.String("gcp-credentials-path", "", "Path to the Google Cloud service account credentials json file.\nAlternatively you can set GOOGLE_APPLICATION_CREDENTIALS=<path> in your environment.")
 
@@ -141,8 +178,24 @@ func init() {
 	set.String("github-sbom", "", "name of sbom file to look for in github release.")
 	set.String("github-workflow-file", "", "name of workflow file to look for in github workflow. \nThis will be the name of the actual file, not the workflow name (i.e. ci.yaml).")
 
+	// File collector options
+	set.Bool("file-watch", false, "watch the path for new and modified files and collect them until interrupted, instead of collecting the files once")
+	set.String("file-checkpoint", "", "path to a checkpoint file recording the content hashes of the collected files, so that only new or modified files are collected again, also across restarts")
+
+	// Git collector options
+	set.String("git-include", "", "comma-separated glob patterns of the files to collect from the git repository, e.g. \"**/*.spdx.json,sbom/*\" (collects all files if empty)")
+	set.String("git-exclude", "", "comma-separated glob patterns of the files to skip in the git repository")
+	set.Bool("git-tag-history", false, "also collect the documents as they were at each tagged release of the git repository")
+	set.String("git-clone-dir", "", "directory to clone the git repository into, which is kept to only collect new commits and tags on later runs (a temporary directory if empty)")
+
 	set.String("header-file", "", "a text file containing HTTP headers to send to the GQL server, in RFC 822 format")
 
+	// credentials sent to the GQL and gRPC servers
+	set.String("gql-token-file", "", "path to a file with the bearer token (API token or OIDC JWT) sent to the GQL and gRPC servers, read again for every request")
+	set.String("gql-client-cert-file", "", "path to the TLS client certificate in PEM format presented to the GQL and gRPC servers")
+	set.String("gql-client-key-file", "", "path to the TLS client key in PEM format presented to the GQL and gRPC servers")
+	set.String("gql-ca-file", "", "path to the CA certificates in PEM format trusted to sign the GQL and gRPC server certificates, instead of the system ones")
+
 	set.String("kubescape-namespace", "kubescape", "Kubernetes namespace to get/watch sboms from.")
 	set.Bool("kubescape-filtered", false, "If false: get/watch \"sbomsyfts\", if true: get/watch \"sbomsyftfiltereds\"")
 
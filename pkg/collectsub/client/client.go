@@ -21,6 +21,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"io"
+	"time"
 
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
 	"google.golang.org/grpc"
@@ -31,6 +32,9 @@ import (
 type Client interface {
 	AddCollectEntries(ctx context.Context, entries []*pb.CollectEntry) error
 	GetCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter) ([]*pb.CollectEntry, error)
+	RemoveCollectEntries(ctx context.Context, entries []*pb.CollectEntry) (int, error)
+	LeaseCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, maxEntries int, leaseDuration time.Duration) ([]*pb.CollectEntryLease, error)
+	AckCollectEntries(ctx context.Context, leaseIDs []string, requeueAfter time.Duration) (int, error)
 	Close()
 }
 
@@ -118,3 +122,36 @@ func (c *client) GetCollectEntries(ctx context.Context, filters []*pb.CollectEnt
 		allEntries = append(allEntries, entries.Entries...)
 	}
 }
+
+func (c *client) RemoveCollectEntries(ctx context.Context, entries []*pb.CollectEntry) (int, error) {
+	res, err := c.client.RemoveCollectEntries(ctx, &pb.RemoveCollectEntriesRequest{
+		Entries: entries,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(res.Removed), nil
+}
+
+func (c *client) LeaseCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, maxEntries int, leaseDuration time.Duration) ([]*pb.CollectEntryLease, error) {
+	res, err := c.client.LeaseCollectEntries(ctx, &pb.LeaseCollectEntriesRequest{
+		Filters:       filters,
+		MaxEntries:    int32(maxEntries),
+		LeaseDuration: int64(leaseDuration / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Leases, nil
+}
+
+func (c *client) AckCollectEntries(ctx context.Context, leaseIDs []string, requeueAfter time.Duration) (int, error) {
+	res, err := c.client.AckCollectEntries(ctx, &pb.AckCollectEntriesRequest{
+		LeaseIds:     leaseIDs,
+		RequeueAfter: int64(requeueAfter / time.Second),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(res.Acknowledged), nil
+}
@@ -17,6 +17,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
 	"github.com/guacsec/guac/pkg/collectsub/server/db/simpledb"
@@ -47,3 +48,15 @@ func (c *MockClient) AddCollectEntries(ctx context.Context, entries []*pb.Collec
 func (c *MockClient) GetCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter) ([]*pb.CollectEntry, error) {
 	return c.db.GetCollectEntries(ctx, filters, 0)
 }
+
+func (c *MockClient) RemoveCollectEntries(ctx context.Context, entries []*pb.CollectEntry) (int, error) {
+	return c.db.RemoveCollectEntries(ctx, entries)
+}
+
+func (c *MockClient) LeaseCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, maxEntries int, leaseDuration time.Duration) ([]*pb.CollectEntryLease, error) {
+	return c.db.LeaseCollectEntries(ctx, filters, maxEntries, leaseDuration)
+}
+
+func (c *MockClient) AckCollectEntries(ctx context.Context, leaseIDs []string, requeueAfter time.Duration) (int, error) {
+	return c.db.AckCollectEntries(ctx, leaseIDs, requeueAfter)
+}
@@ -24,6 +24,9 @@ const _ = grpc.SupportPackageIsVersion7
 type CollectSubscriberServiceClient interface {
 	AddCollectEntries(ctx context.Context, in *AddCollectEntriesRequest, opts ...grpc.CallOption) (*AddCollectEntriesResponse, error)
 	GetCollectEntries(ctx context.Context, in *GetCollectEntriesRequest, opts ...grpc.CallOption) (CollectSubscriberService_GetCollectEntriesClient, error)
+	RemoveCollectEntries(ctx context.Context, in *RemoveCollectEntriesRequest, opts ...grpc.CallOption) (*RemoveCollectEntriesResponse, error)
+	LeaseCollectEntries(ctx context.Context, in *LeaseCollectEntriesRequest, opts ...grpc.CallOption) (*LeaseCollectEntriesResponse, error)
+	AckCollectEntries(ctx context.Context, in *AckCollectEntriesRequest, opts ...grpc.CallOption) (*AckCollectEntriesResponse, error)
 }
 
 type collectSubscriberServiceClient struct {
@@ -75,12 +78,42 @@ func (x *collectSubscriberServiceGetCollectEntriesClient) Recv() (*GetCollectEnt
 	return m, nil
 }
 
+func (c *collectSubscriberServiceClient) RemoveCollectEntries(ctx context.Context, in *RemoveCollectEntriesRequest, opts ...grpc.CallOption) (*RemoveCollectEntriesResponse, error) {
+	out := new(RemoveCollectEntriesResponse)
+	err := c.cc.Invoke(ctx, "/guacsec.guac.collect_subscriber.schema.CollectSubscriberService/RemoveCollectEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectSubscriberServiceClient) LeaseCollectEntries(ctx context.Context, in *LeaseCollectEntriesRequest, opts ...grpc.CallOption) (*LeaseCollectEntriesResponse, error) {
+	out := new(LeaseCollectEntriesResponse)
+	err := c.cc.Invoke(ctx, "/guacsec.guac.collect_subscriber.schema.CollectSubscriberService/LeaseCollectEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectSubscriberServiceClient) AckCollectEntries(ctx context.Context, in *AckCollectEntriesRequest, opts ...grpc.CallOption) (*AckCollectEntriesResponse, error) {
+	out := new(AckCollectEntriesResponse)
+	err := c.cc.Invoke(ctx, "/guacsec.guac.collect_subscriber.schema.CollectSubscriberService/AckCollectEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CollectSubscriberServiceServer is the server API for CollectSubscriberService service.
 // All implementations must embed UnimplementedCollectSubscriberServiceServer
 // for forward compatibility
 type CollectSubscriberServiceServer interface {
 	AddCollectEntries(context.Context, *AddCollectEntriesRequest) (*AddCollectEntriesResponse, error)
 	GetCollectEntries(*GetCollectEntriesRequest, CollectSubscriberService_GetCollectEntriesServer) error
+	RemoveCollectEntries(context.Context, *RemoveCollectEntriesRequest) (*RemoveCollectEntriesResponse, error)
+	LeaseCollectEntries(context.Context, *LeaseCollectEntriesRequest) (*LeaseCollectEntriesResponse, error)
+	AckCollectEntries(context.Context, *AckCollectEntriesRequest) (*AckCollectEntriesResponse, error)
 	mustEmbedUnimplementedCollectSubscriberServiceServer()
 }
 
@@ -94,6 +127,15 @@ func (UnimplementedCollectSubscriberServiceServer) AddCollectEntries(context.Con
 func (UnimplementedCollectSubscriberServiceServer) GetCollectEntries(*GetCollectEntriesRequest, CollectSubscriberService_GetCollectEntriesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetCollectEntries not implemented")
 }
+func (UnimplementedCollectSubscriberServiceServer) RemoveCollectEntries(context.Context, *RemoveCollectEntriesRequest) (*RemoveCollectEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollectEntries not implemented")
+}
+func (UnimplementedCollectSubscriberServiceServer) LeaseCollectEntries(context.Context, *LeaseCollectEntriesRequest) (*LeaseCollectEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseCollectEntries not implemented")
+}
+func (UnimplementedCollectSubscriberServiceServer) AckCollectEntries(context.Context, *AckCollectEntriesRequest) (*AckCollectEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckCollectEntries not implemented")
+}
 func (UnimplementedCollectSubscriberServiceServer) mustEmbedUnimplementedCollectSubscriberServiceServer() {
 }
 
@@ -147,6 +189,60 @@ func (x *collectSubscriberServiceGetCollectEntriesServer) Send(m *GetCollectEntr
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CollectSubscriberService_RemoveCollectEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollectEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectSubscriberServiceServer).RemoveCollectEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guacsec.guac.collect_subscriber.schema.CollectSubscriberService/RemoveCollectEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectSubscriberServiceServer).RemoveCollectEntries(ctx, req.(*RemoveCollectEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectSubscriberService_LeaseCollectEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseCollectEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectSubscriberServiceServer).LeaseCollectEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guacsec.guac.collect_subscriber.schema.CollectSubscriberService/LeaseCollectEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectSubscriberServiceServer).LeaseCollectEntries(ctx, req.(*LeaseCollectEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectSubscriberService_AckCollectEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckCollectEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectSubscriberServiceServer).AckCollectEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guacsec.guac.collect_subscriber.schema.CollectSubscriberService/AckCollectEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectSubscriberServiceServer).AckCollectEntries(ctx, req.(*AckCollectEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CollectSubscriberService_ServiceDesc is the grpc.ServiceDesc for CollectSubscriberService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -158,6 +254,18 @@ var CollectSubscriberService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddCollectEntries",
 			Handler:    _CollectSubscriberService_AddCollectEntries_Handler,
 		},
+		{
+			MethodName: "RemoveCollectEntries",
+			Handler:    _CollectSubscriberService_RemoveCollectEntries_Handler,
+		},
+		{
+			MethodName: "LeaseCollectEntries",
+			Handler:    _CollectSubscriberService_LeaseCollectEntries_Handler,
+		},
+		{
+			MethodName: "AckCollectEntries",
+			Handler:    _CollectSubscriberService_AckCollectEntries_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -25,19 +25,27 @@ type CollectEntryInput struct {
 	// Type string based on protobuf enum CollectDataType
 	Type  string `json:"type,omitempty"`
 	Value string `json:"value,omitempty"`
+	// Ttl in seconds after which the entry expires, 0 never expires
+	Ttl int64 `json:"ttl,omitempty"`
+	// Priority of the entry when leasing, higher priorities are leased first
+	Priority int32 `json:"priority,omitempty"`
 }
 
 func (e *CollectEntryInput) Convert() *pb.CollectEntry {
 	return &pb.CollectEntry{
-		Type:  pb.CollectDataType(pb.CollectDataType_value[e.Type]),
-		Value: e.Value,
+		Type:     pb.CollectDataType(pb.CollectDataType_value[e.Type]),
+		Value:    e.Value,
+		Ttl:      e.Ttl,
+		Priority: e.Priority,
 	}
 }
 
 func ConvertCollectEntry(e *pb.CollectEntry) CollectEntryInput {
 	return CollectEntryInput{
-		Type:  pb.CollectDataType_name[int32(e.GetType())],
-		Value: e.GetValue(),
+		Type:     pb.CollectDataType_name[int32(e.GetType())],
+		Value:    e.GetValue(),
+		Ttl:      e.GetTtl(),
+		Priority: e.GetPriority(),
 	}
 }
 
@@ -60,3 +68,18 @@ func ConvertCollectEntryFilter(e *pb.CollectEntryFilter) CollectEntryFilterInput
 		Glob: e.Glob,
 	}
 }
+
+type CollectEntryLeaseInput struct {
+	LeaseID string            `json:"leaseId,omitempty"`
+	Entry   CollectEntryInput `json:"entry"`
+	// ExpireTime is the unix time in seconds at which the lease expires
+	ExpireTime int64 `json:"expireTime,omitempty"`
+}
+
+func ConvertCollectEntryLease(l *pb.CollectEntryLease) CollectEntryLeaseInput {
+	return CollectEntryLeaseInput{
+		LeaseID:    l.GetLeaseId(),
+		Entry:      ConvertCollectEntry(l.GetEntry()),
+		ExpireTime: l.GetExpireTime(),
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/guacsec/guac/pkg/collectsub/client"
@@ -27,6 +28,15 @@ import (
 	"github.com/guacsec/guac/pkg/logging"
 )
 
+// allFilters match all entries of the data types the collectors handle.
+var allFilters = []*pb.CollectEntryFilter{
+	{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*"},
+	{Type: pb.CollectDataType_DATATYPE_GIT, Glob: "*"},
+	{Type: pb.CollectDataType_DATATYPE_PURL, Glob: "*"},
+	{Type: pb.CollectDataType_DATATYPE_GITHUB_RELEASE, Glob: "*"},
+	{Type: pb.CollectDataType_DATATYPE_OCI_REGISTRY, Glob: "*"},
+}
+
 type csubDataSources struct {
 	c            client.Client
 	lastEntries  *datasource.DataSources
@@ -47,13 +57,7 @@ func NewCsubDatasource(c client.Client, pollDuration time.Duration) (datasource.
 // GetDataSources returns a data source containing targets for the
 // collector to collect
 func (d *csubDataSources) GetDataSources(ctx context.Context) (*datasource.DataSources, error) {
-	entries, err := d.c.GetCollectEntries(ctx, []*pb.CollectEntryFilter{
-		{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*"},
-		{Type: pb.CollectDataType_DATATYPE_GIT, Glob: "*"},
-		{Type: pb.CollectDataType_DATATYPE_PURL, Glob: "*"},
-		{Type: pb.CollectDataType_DATATYPE_GITHUB_RELEASE, Glob: "*"},
-		{Type: pb.CollectDataType_DATATYPE_OCI_REGISTRY, Glob: "*"},
-	})
+	entries, err := d.c.GetCollectEntries(ctx, allFilters)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +100,111 @@ func (d *csubDataSources) DataSourcesUpdate(ctx context.Context) (<-chan error,
 	return updateChan, nil
 }
 
+// LeaseOptions configures how a leasing csub datasource leases its entries.
+type LeaseOptions struct {
+	// Duration of the leases, after which entries that were not acknowledged
+	// are leased to another collector.
+	Duration time.Duration
+	// MaxEntries is the maximum number of entries leased at once, 0 leases
+	// all available entries.
+	MaxEntries int
+	// RequeueAfter is when acknowledged entries can be leased again, 0 never
+	// leases them again.
+	RequeueAfter time.Duration
+}
+
+type leasingCsubDataSources struct {
+	c            client.Client
+	pollDuration time.Duration
+	filters      []*pb.CollectEntryFilter
+	opts         LeaseOptions
+
+	mu sync.Mutex
+	// leaseIDs are the leases of the entries returned by the last call to
+	// GetDataSources that were not collected yet, by entry value
+	leaseIDs map[string][]string
+	// collectedIDs are the leases of the collected entries, which are
+	// acknowledged by the next call to GetDataSources
+	collectedIDs []string
+}
+
+// NewLeasingCsubDatasource creates a datasource which leases its data sources
+// from the collect subscriber service, so that several collectors split the
+// entries instead of each collecting all of them. Only the entries matching
+// the filters are leased, so that a collector does not lease the entries of
+// data types it cannot collect. The leases of the data sources reported as
+// collected are acknowledged by the next call to GetDataSources, the others
+// expire and are leased again.
+func NewLeasingCsubDatasource(c client.Client, pollDuration time.Duration, filters []*pb.CollectEntryFilter, opts LeaseOptions) (datasource.CollectSource, error) {
+	if len(filters) == 0 {
+		return nil, fmt.Errorf("leasing csub datasource requires the filters of the collected data types")
+	}
+	return &leasingCsubDataSources{
+		c:            c,
+		pollDuration: pollDuration,
+		filters:      filters,
+		opts:         opts,
+		leaseIDs:     map[string][]string{},
+	}, nil
+}
+
+// GetDataSources acknowledges the collected entries and returns a data source
+// containing newly leased targets for the collector to collect
+func (d *leasingCsubDataSources) GetDataSources(ctx context.Context) (*datasource.DataSources, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.collectedIDs) > 0 {
+		if _, err := d.c.AckCollectEntries(ctx, d.collectedIDs, d.opts.RequeueAfter); err != nil {
+			return nil, fmt.Errorf("failed to acknowledge collect entries: %w", err)
+		}
+		d.collectedIDs = nil
+	}
+	// the leases of the entries that were not collected expire
+	d.leaseIDs = map[string][]string{}
+
+	leases, err := d.c.LeaseCollectEntries(ctx, d.filters, d.opts.MaxEntries, d.opts.Duration)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*pb.CollectEntry, 0, len(leases))
+	for _, l := range leases {
+		entries = append(entries, l.Entry)
+		d.leaseIDs[l.Entry.Value] = append(d.leaseIDs[l.Entry.Value], l.LeaseId)
+	}
+	return entriesToSources(ctx, entries), nil
+}
+
+// Collected marks the leases of the data source to be acknowledged by the
+// next call to GetDataSources.
+func (d *leasingCsubDataSources) Collected(_ context.Context, s datasource.Source) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.collectedIDs = append(d.collectedIDs, d.leaseIDs[s.Value]...)
+	delete(d.leaseIDs, s.Value)
+}
+
+// DataSourcesUpdate will return a channel which gets an element every poll
+// duration, as entries cannot be checked for updates without leasing them.
+func (d *leasingCsubDataSources) DataSourcesUpdate(ctx context.Context) (<-chan error, error) {
+	updateChan := make(chan error)
+	go func() {
+		timer := time.NewTicker(d.pollDuration)
+		defer timer.Stop()
+		for {
+			select {
+			case <-timer.C:
+				updateChan <- nil
+			case <-ctx.Done():
+				updateChan <- fmt.Errorf("csub lease poller ending from context closure")
+				return
+			}
+		}
+	}()
+	return updateChan, nil
+}
+
 func entriesToSources(ctx context.Context, entries []*pb.CollectEntry) *datasource.DataSources {
 	d := &datasource.DataSources{}
 	for _, e := range entries {
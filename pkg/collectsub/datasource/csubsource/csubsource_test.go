@@ -163,3 +163,132 @@ func Test_CsubSourceDataSourcesUpdate(t *testing.T) {
 	}
 
 }
+
+func Test_LeasingCsubSourceGetDataSources(t *testing.T) {
+	ctx := context.TODO()
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	c, err := createSimpleCsubClient(ctx)
+	if err != nil {
+		t.Fatalf("unable to initiliaze simple source client: %v", err)
+	}
+	defer c.Close()
+
+	count := func(ds *datasource.DataSources) int {
+		return len(ds.OciDataSources) + len(ds.GitDataSources) + len(ds.GithubReleaseDataSources) + len(ds.PurlDataSources)
+	}
+	get := func(cds datasource.CollectSource) int {
+		ds, err := cds.GetDataSources(ctx)
+		if err != nil {
+			t.Fatalf("unable to get DataSources: %v", err)
+		}
+		for _, sources := range [][]datasource.Source{ds.OciDataSources, ds.GitDataSources, ds.GithubReleaseDataSources, ds.PurlDataSources} {
+			for _, s := range sources {
+				datasource.ReportCollected(ctx, cds, s)
+			}
+		}
+		return count(ds)
+	}
+
+	opts := LeaseOptions{Duration: time.Minute, MaxEntries: 3}
+	first, err := NewLeasingCsubDatasource(c, time.Second, allFilters, opts)
+	if err != nil {
+		t.Fatalf("unable to create leasing datasource: %v", err)
+	}
+	second, err := NewLeasingCsubDatasource(c, time.Second, allFilters, opts)
+	if err != nil {
+		t.Fatalf("unable to create leasing datasource: %v", err)
+	}
+
+	// the collectors split the entries between them
+	if got := get(first); got != 3 {
+		t.Errorf("expected the first collector to lease 3 entries, got %d", got)
+	}
+	if got := get(second); got != 2 {
+		t.Errorf("expected the second collector to lease the 2 remaining entries, got %d", got)
+	}
+	// the next poll acknowledges the collected entries, which are not leased again
+	if got := get(first); got != 0 {
+		t.Errorf("expected no entries to be left, got %d", got)
+	}
+	if got := get(second); got != 0 {
+		t.Errorf("expected no entries to be left, got %d", got)
+	}
+
+	// entries added afterwards are leased on the next poll
+	err = c.AddCollectEntries(ctx, []*collectsub.CollectEntry{
+		{Type: collectsub.CollectDataType_DATATYPE_GIT, Value: "git+newentry"},
+	})
+	if err != nil {
+		t.Fatalf("got error from trying to add new entries: %v", err)
+	}
+	if got := get(second); got != 1 {
+		t.Errorf("expected the new entry to be leased, got %d", got)
+	}
+}
+
+func Test_LeasingCsubSourceCollectorTypes(t *testing.T) {
+	ctx := context.TODO()
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	c, err := createSimpleCsubClient(ctx)
+	if err != nil {
+		t.Fatalf("unable to initiliaze simple source client: %v", err)
+	}
+	defer c.Close()
+
+	// leases expire with a second granularity
+	opts := LeaseOptions{Duration: time.Second}
+	purls, err := NewLeasingCsubDatasource(c, time.Second, []*collectsub.CollectEntryFilter{
+		{Type: collectsub.CollectDataType_DATATYPE_PURL, Glob: "*"},
+	}, opts)
+	if err != nil {
+		t.Fatalf("unable to create leasing datasource: %v", err)
+	}
+	ocis, err := NewLeasingCsubDatasource(c, time.Second, []*collectsub.CollectEntryFilter{
+		{Type: collectsub.CollectDataType_DATATYPE_OCI, Glob: "*"},
+	}, opts)
+	if err != nil {
+		t.Fatalf("unable to create leasing datasource: %v", err)
+	}
+	get := func(cds datasource.CollectSource) *datasource.DataSources {
+		ds, err := cds.GetDataSources(ctx)
+		if err != nil {
+			t.Fatalf("unable to get DataSources: %v", err)
+		}
+		return ds
+	}
+
+	// the purl collector only leases the purl entries and collects them
+	ds := get(purls)
+	if want := (&datasource.DataSources{PurlDataSources: expectedDataSource.PurlDataSources}); !reflect.DeepEqual(ds, want) {
+		t.Errorf("unexpected purl collector datasource: expect %v, got %v", want, ds)
+	}
+	for _, s := range ds.PurlDataSources {
+		datasource.ReportCollected(ctx, purls, s)
+	}
+	if ds := get(purls); !reflect.DeepEqual(ds, &datasource.DataSources{}) {
+		t.Errorf("expected the collected purl entries not to be leased again, got %v", ds)
+	}
+
+	// the oci entries are left to the oci collector, which fails to collect
+	// the second one
+	ds = get(ocis)
+	if want := (&datasource.DataSources{OciDataSources: expectedDataSource.OciDataSources}); !reflect.DeepEqual(ds, want) {
+		t.Errorf("unexpected oci collector datasource: expect %v, got %v", want, ds)
+	}
+	datasource.ReportCollected(ctx, ocis, ds.OciDataSources[0])
+	if ds := get(ocis); !reflect.DeepEqual(ds, &datasource.DataSources{}) {
+		t.Errorf("expected the leased oci entries not to be leased again before they expire, got %v", ds)
+	}
+
+	// the lease of the entry that was not collected expires, so it is
+	// collected again
+	time.Sleep(2 * time.Second)
+	want := &datasource.DataSources{OciDataSources: expectedDataSource.OciDataSources[1:]}
+	if ds := get(ocis); !reflect.DeepEqual(ds, want) {
+		t.Errorf("expected the uncollected oci entry to be leased again: expect %v, got %v", want, ds)
+	}
+}
@@ -34,6 +34,23 @@ type CollectSource interface {
 	DataSourcesUpdate(ctx context.Context) (<-chan error, error)
 }
 
+// CollectReporter is implemented by CollectSources that need to know which
+// of the data sources they returned were collected, e.g. to acknowledge them
+// to the service they got them from.
+type CollectReporter interface {
+	// Collected records that the documents of the data source were
+	// collected and emitted.
+	Collected(ctx context.Context, s Source)
+}
+
+// ReportCollected tells the CollectSource that the documents of the data
+// source were collected and emitted, if it keeps track of that.
+func ReportCollected(ctx context.Context, cs CollectSource, s Source) {
+	if r, ok := cs.(CollectReporter); ok {
+		r.Collected(ctx, s)
+	}
+}
+
 type DataSources struct {
 	OciDataSources []Source
 	OciRegistryDataSources []Source
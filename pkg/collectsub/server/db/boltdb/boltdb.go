@@ -0,0 +1,561 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boltdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gobwas/glob"
+	"github.com/google/uuid"
+	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
+	db "github.com/guacsec/guac/pkg/collectsub/server/db/types"
+	"github.com/guacsec/guac/pkg/logging"
+	bolt "go.etcd.io/bbolt"
+)
+
+// The database has a bucket per collect data type in the entries and since
+// top level buckets:
+//
+//   - entries maps the value of an entry to its record. Values are sorted, so
+//     globs with a literal prefix only visit matching values.
+//   - since indexes the entries by since time, with keys made of the big
+//     endian since time followed by the value, for since time queries and
+//     for compaction.
+//
+// The leases bucket maps lease IDs to the type key and value of the leased
+// entry, separated by a slash.
+var (
+	entriesBucket = []byte("entries")
+	sinceBucket   = []byte("since")
+	leasesBucket  = []byte("leases")
+)
+
+// compactInterval is how often expired and stale entries are removed.
+const compactInterval = time.Hour
+
+// record is the state of an entry stored in the entries bucket.
+type record struct {
+	SinceTime       int64  `json:"sinceTime"`
+	Ttl             int64  `json:"ttl,omitempty"`
+	Priority        int32  `json:"priority,omitempty"`
+	ExpireTime      int64  `json:"expireTime,omitempty"`
+	LeaseID         string `json:"leaseID,omitempty"`
+	LeaseExpireTime int64  `json:"leaseExpireTime,omitempty"`
+	// NotBefore is when an acknowledged entry can be leased again, done
+	// entries are never leased again.
+	NotBefore int64 `json:"notBefore,omitempty"`
+	Done      bool  `json:"done,omitempty"`
+}
+
+func (r *record) expired(now int64) bool {
+	return r.ExpireTime != 0 && r.ExpireTime <= now
+}
+
+func (r *record) leasable(now int64) bool {
+	return !r.Done && r.NotBefore <= now && (r.LeaseID == "" || r.LeaseExpireTime <= now)
+}
+
+func (r *record) entry(t pb.CollectDataType, value string) *pb.CollectEntry {
+	return &pb.CollectEntry{
+		Type:       t,
+		Value:      value,
+		SinceTime:  r.SinceTime,
+		Ttl:        r.Ttl,
+		Priority:   r.Priority,
+		ExpireTime: r.ExpireTime,
+	}
+}
+
+type boltDb struct {
+	db        *bolt.DB
+	retention time.Duration
+	done      chan struct{}
+	wg        sync.WaitGroup
+}
+
+// NewBoltDb opens the bolt database at path, creating it if it does not exist.
+// Expired entries, and if retention is set the entries that were added longer
+// ago, are removed when the database is opened and then periodically until it
+// is closed.
+func NewBoltDb(ctx context.Context, path string, retention time.Duration) (db.CollectSubscriberDb, error) {
+	bdb, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt database %s: %w", path, err)
+	}
+	err = bdb.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{entriesBucket, sinceBucket, leasesBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = bdb.Close()
+		return nil, fmt.Errorf("failed to create buckets in bolt database %s: %w", path, err)
+	}
+
+	s := &boltDb{
+		db:        bdb,
+		retention: retention,
+		done:      make(chan struct{}),
+	}
+	if _, err := s.compact(time.Now()); err != nil {
+		_ = bdb.Close()
+		return nil, fmt.Errorf("failed to compact bolt database %s: %w", path, err)
+	}
+	s.wg.Add(1)
+	go s.compactLoop(ctx)
+	return s, nil
+}
+
+func (s *boltDb) AddCollectEntries(ctx context.Context, entries []*pb.CollectEntry) error {
+	return s.addCollectEntries(entries, time.Now().Unix())
+}
+
+func (s *boltDb) addCollectEntries(entries []*pb.CollectEntry, sinceTime int64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		for _, e := range entries {
+			if e == nil {
+				continue
+			}
+			values, index, err := typeBuckets(tx, e.Type)
+			if err != nil {
+				return err
+			}
+			r, err := getRecord(values, e.Value)
+			if err != nil {
+				return err
+			}
+			if r != nil && r.expired(sinceTime) {
+				if err := removeEntry(tx, e.Type, e.Value, r); err != nil {
+					return err
+				}
+				r = nil
+			}
+
+			if r == nil {
+				r = &record{SinceTime: sinceTime}
+				if err := index.Put(indexKey(sinceTime, e.Value), []byte{}); err != nil {
+					return err
+				}
+			}
+			r.Ttl = e.Ttl
+			r.Priority = e.Priority
+			r.ExpireTime = 0
+			if e.Ttl > 0 {
+				r.ExpireTime = sinceTime + e.Ttl
+			}
+			if err := putRecord(values, e.Value, r); err != nil {
+				return err
+			}
+			e.SinceTime = r.SinceTime
+			e.ExpireTime = r.ExpireTime
+		}
+		return nil
+	})
+}
+
+func (s *boltDb) GetCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, sinceTime int64) ([]*pb.CollectEntry, error) {
+	var retList []*pb.CollectEntry
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return scan(tx, filters, sinceTime, time.Now().Unix(), func(t pb.CollectDataType, value string, r *record) error {
+			retList = append(retList, r.entry(t, value))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return retList, nil
+}
+
+func (s *boltDb) RemoveCollectEntries(ctx context.Context, entries []*pb.CollectEntry) (int, error) {
+	removed := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for _, e := range entries {
+			if e == nil {
+				continue
+			}
+			values := tx.Bucket(entriesBucket).Bucket(typeKey(e.Type))
+			if values == nil {
+				continue
+			}
+			r, err := getRecord(values, e.Value)
+			if err != nil {
+				return err
+			}
+			if r == nil {
+				continue
+			}
+			if err := removeEntry(tx, e.Type, e.Value, r); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+	return removed, err
+}
+
+func (s *boltDb) LeaseCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, max int, leaseDuration time.Duration) ([]*pb.CollectEntryLease, error) {
+	type candidate struct {
+		t     pb.CollectDataType
+		value string
+		r     *record
+	}
+
+	var leases []*pb.CollectEntryLease
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		now := time.Now().Unix()
+		var available []candidate
+		err := scan(tx, filters, 0, now, func(t pb.CollectDataType, value string, r *record) error {
+			if r.leasable(now) {
+				available = append(available, candidate{t: t, value: value, r: r})
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		sort.SliceStable(available, func(i, j int) bool {
+			if available[i].r.Priority != available[j].r.Priority {
+				return available[i].r.Priority > available[j].r.Priority
+			}
+			return available[i].r.SinceTime < available[j].r.SinceTime
+		})
+		if max > 0 && len(available) > max {
+			available = available[:max]
+		}
+
+		expireTime := now + int64(leaseDuration/time.Second)
+		for _, c := range available {
+			if c.r.LeaseID != "" {
+				if err := tx.Bucket(leasesBucket).Delete([]byte(c.r.LeaseID)); err != nil {
+					return err
+				}
+			}
+			c.r.LeaseID = uuid.NewString()
+			c.r.LeaseExpireTime = expireTime
+			if err := putRecord(tx.Bucket(entriesBucket).Bucket(typeKey(c.t)), c.value, c.r); err != nil {
+				return err
+			}
+			if err := tx.Bucket(leasesBucket).Put([]byte(c.r.LeaseID), leaseValue(c.t, c.value)); err != nil {
+				return err
+			}
+			leases = append(leases, &pb.CollectEntryLease{
+				LeaseId:    c.r.LeaseID,
+				Entry:      c.r.entry(c.t, c.value),
+				ExpireTime: expireTime,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return leases, nil
+}
+
+func (s *boltDb) AckCollectEntries(ctx context.Context, leaseIDs []string, requeueAfter time.Duration) (int, error) {
+	acknowledged := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		now := time.Now().Unix()
+		for _, id := range leaseIDs {
+			v := tx.Bucket(leasesBucket).Get([]byte(id))
+			if v == nil {
+				continue
+			}
+			t, value, err := parseLeaseValue(v)
+			if err != nil {
+				return err
+			}
+			if err := tx.Bucket(leasesBucket).Delete([]byte(id)); err != nil {
+				return err
+			}
+			values := tx.Bucket(entriesBucket).Bucket(typeKey(t))
+			r, err := getRecord(values, value)
+			if err != nil {
+				return err
+			}
+			if r == nil || r.LeaseID != id {
+				continue
+			}
+			r.LeaseID = ""
+			r.LeaseExpireTime = 0
+			if requeueAfter > 0 {
+				r.NotBefore = now + int64(requeueAfter/time.Second)
+			} else {
+				r.Done = true
+			}
+			if err := putRecord(values, value, r); err != nil {
+				return err
+			}
+			acknowledged++
+		}
+		return nil
+	})
+	return acknowledged, err
+}
+
+// Close stops the compaction and closes the database.
+func (s *boltDb) Close() error {
+	close(s.done)
+	s.wg.Wait()
+	return s.db.Close()
+}
+
+func (s *boltDb) compactLoop(ctx context.Context) {
+	defer s.wg.Done()
+	logger := logging.FromContext(ctx)
+	ticker := time.NewTicker(compactInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			n, err := s.compact(time.Now())
+			if err != nil {
+				logger.Errorf("failed to compact collect entries: %v", err)
+				continue
+			}
+			logger.Infof("compaction removed %d expired or stale collect entries", n)
+		}
+	}
+}
+
+// compact removes the entries whose ttl has passed and, if a retention is set,
+// the entries that were added longer ago. It returns how many were removed.
+func (s *boltDb) compact(now time.Time) (int, error) {
+	var before int64
+	if s.retention > 0 {
+		before = now.Add(-s.retention).Unix()
+	}
+	return s.removeStale(before, now.Unix())
+}
+
+// removeStale removes the entries that were added before the given unix time
+// or that expired at now.
+func (s *boltDb) removeStale(before, now int64) (int, error) {
+	var removed int
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(entriesBucket).ForEachBucket(func(name []byte) error {
+			t, err := parseTypeKey(name)
+			if err != nil {
+				return err
+			}
+
+			// Deleting while iterating with a cursor skips keys, so collect
+			// the stale entries first.
+			stale := map[string]*record{}
+			c := tx.Bucket(entriesBucket).Bucket(name).Cursor()
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				r, err := decodeRecord(v)
+				if err != nil {
+					return err
+				}
+				if r.SinceTime < before || r.expired(now) {
+					stale[string(k)] = r
+				}
+			}
+			for value, r := range stale {
+				if err := removeEntry(tx, t, value, r); err != nil {
+					return err
+				}
+			}
+			removed += len(stale)
+			return nil
+		})
+	})
+	return removed, err
+}
+
+// scan calls fn for the entries matching the filters that were added at or
+// after sinceTime and did not expire at now. Entries matching several filters
+// are only visited once.
+func scan(tx *bolt.Tx, filters []*pb.CollectEntryFilter, sinceTime, now int64, fn func(pb.CollectDataType, string, *record) error) error {
+	var filterMatchers []glob.Glob
+	for _, f := range filters {
+		g, err := glob.Compile(f.Glob)
+		if err != nil {
+			return fmt.Errorf("invalid glob %q: %w", f.Glob, err)
+		}
+		filterMatchers = append(filterMatchers, g)
+	}
+
+	seen := map[pb.CollectDataType]map[string]bool{}
+	for i, f := range filters {
+		values := tx.Bucket(entriesBucket).Bucket(typeKey(f.Type))
+		if values == nil {
+			continue
+		}
+		if seen[f.Type] == nil {
+			seen[f.Type] = map[string]bool{}
+		}
+		visit := func(value string, v []byte) error {
+			if v == nil || seen[f.Type][value] || !filterMatchers[i].Match(value) {
+				return nil
+			}
+			r, err := decodeRecord(v)
+			if err != nil {
+				return err
+			}
+			if r.SinceTime < sinceTime || r.expired(now) {
+				return nil
+			}
+			seen[f.Type][value] = true
+			return fn(f.Type, value, r)
+		}
+
+		// Use the sorted values if the glob has a literal prefix and the
+		// since index otherwise, unless all entries are requested.
+		prefix := []byte(globPrefix(f.Glob))
+		if len(prefix) == 0 && sinceTime > 0 {
+			c := tx.Bucket(sinceBucket).Bucket(typeKey(f.Type)).Cursor()
+			for k, _ := c.Seek(encodeTime(sinceTime)); k != nil; k, _ = c.Next() {
+				if err := visit(string(k[8:]), values.Get(k[8:])); err != nil {
+					return err
+				}
+			}
+		} else {
+			c := values.Cursor()
+			for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+				if err := visit(string(k), v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// removeEntry removes an entry with its since index and lease.
+func removeEntry(tx *bolt.Tx, t pb.CollectDataType, value string, r *record) error {
+	if err := tx.Bucket(entriesBucket).Bucket(typeKey(t)).Delete([]byte(value)); err != nil {
+		return err
+	}
+	if err := tx.Bucket(sinceBucket).Bucket(typeKey(t)).Delete(indexKey(r.SinceTime, value)); err != nil {
+		return err
+	}
+	if r.LeaseID != "" {
+		return tx.Bucket(leasesBucket).Delete([]byte(r.LeaseID))
+	}
+	return nil
+}
+
+// typeBuckets returns the entries and since buckets of a data type, creating
+// them if needed.
+func typeBuckets(tx *bolt.Tx, t pb.CollectDataType) (*bolt.Bucket, *bolt.Bucket, error) {
+	values, err := tx.Bucket(entriesBucket).CreateBucketIfNotExists(typeKey(t))
+	if err != nil {
+		return nil, nil, err
+	}
+	index, err := tx.Bucket(sinceBucket).CreateBucketIfNotExists(typeKey(t))
+	if err != nil {
+		return nil, nil, err
+	}
+	return values, index, nil
+}
+
+func getRecord(values *bolt.Bucket, value string) (*record, error) {
+	v := values.Get([]byte(value))
+	if v == nil {
+		return nil, nil
+	}
+	return decodeRecord(v)
+}
+
+func putRecord(values *bolt.Bucket, value string, r *record) error {
+	v, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return values.Put([]byte(value), v)
+}
+
+func decodeRecord(v []byte) (*record, error) {
+	// entries written before leases were added only stored the since time
+	if len(v) == 8 {
+		return &record{SinceTime: decodeTime(v)}, nil
+	}
+	var r record
+	if err := json.Unmarshal(v, &r); err != nil {
+		return nil, fmt.Errorf("failed to decode collect entry: %w", err)
+	}
+	return &r, nil
+}
+
+// typeKey uses the number of the data type, which unlike its name is stable.
+func typeKey(t pb.CollectDataType) []byte {
+	return []byte(strconv.Itoa(int(t)))
+}
+
+func parseTypeKey(k []byte) (pb.CollectDataType, error) {
+	t, err := strconv.Atoi(string(k))
+	if err != nil {
+		return 0, fmt.Errorf("invalid data type bucket %q: %w", k, err)
+	}
+	return pb.CollectDataType(t), nil
+}
+
+func leaseValue(t pb.CollectDataType, value string) []byte {
+	return append(append(typeKey(t), '/'), value...)
+}
+
+func parseLeaseValue(v []byte) (pb.CollectDataType, string, error) {
+	k, value, ok := bytes.Cut(v, []byte("/"))
+	if !ok {
+		return 0, "", fmt.Errorf("invalid lease %q", v)
+	}
+	t, err := parseTypeKey(k)
+	return t, string(value), err
+}
+
+func indexKey(sinceTime int64, value string) []byte {
+	return append(encodeTime(sinceTime), value...)
+}
+
+func encodeTime(t int64) []byte {
+	if t < 0 {
+		t = 0
+	}
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(t))
+	return b
+}
+
+func decodeTime(b []byte) int64 {
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+// globPrefix returns the literal prefix of a glob, which all matching values
+// start with.
+func globPrefix(g string) string {
+	if i := strings.IndexAny(g, `*?[{\`); i >= 0 {
+		return g[:i]
+	}
+	return g
+}
@@ -0,0 +1,154 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boltdb
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
+)
+
+func Test_BoltDb(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "csub.db")
+	oci := pb.CollectDataType_DATATYPE_OCI
+	git := pb.CollectDataType_DATATYPE_GIT
+
+	open := func(retention time.Duration) *boltDb {
+		d, err := NewBoltDb(ctx, path, retention)
+		if err != nil {
+			t.Fatalf("failed to open database: %v", err)
+		}
+		return d.(*boltDb)
+	}
+	get := func(d *boltDb, sinceTime int64, filters ...*pb.CollectEntryFilter) []*pb.CollectEntry {
+		entries, err := d.GetCollectEntries(ctx, filters, sinceTime)
+		if err != nil {
+			t.Fatalf("failed to get entries: %v", err)
+		}
+		return entries
+	}
+	sortEntries := cmpopts.SortSlices(func(a, b *pb.CollectEntry) bool { return a.Value < b.Value })
+	ignoreProto := cmpopts.IgnoreUnexported(pb.CollectEntry{})
+
+	d := open(0)
+	for since, entries := range map[int64][]*pb.CollectEntry{
+		100: {{Type: oci, Value: "oci://a/old"}, {Type: git, Value: "git+https://example.com/old"}},
+		200: {{Type: oci, Value: "oci://a/new"}, {Type: oci, Value: "oci://b/new"}},
+	} {
+		if err := d.addCollectEntries(entries, since); err != nil {
+			t.Fatalf("failed to add entries: %v", err)
+		}
+	}
+	// adding an existing entry keeps its since time
+	if err := d.addCollectEntries([]*pb.CollectEntry{{Type: oci, Value: "oci://a/old"}}, 300); err != nil {
+		t.Fatalf("failed to add entries: %v", err)
+	}
+	if err := d.Close(); err != nil {
+		t.Fatalf("failed to close database: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		sinceTime int64
+		filters   []*pb.CollectEntryFilter
+		want      []*pb.CollectEntry
+	}{{
+		name:    "all entries survive reopening",
+		filters: []*pb.CollectEntryFilter{{Type: oci, Glob: "*"}, {Type: git, Glob: "*"}},
+		want: []*pb.CollectEntry{
+			{Type: git, Value: "git+https://example.com/old", SinceTime: 100},
+			{Type: oci, Value: "oci://a/new", SinceTime: 200},
+			{Type: oci, Value: "oci://a/old", SinceTime: 100},
+			{Type: oci, Value: "oci://b/new", SinceTime: 200},
+		},
+	}, {
+		name:    "glob with prefix",
+		filters: []*pb.CollectEntryFilter{{Type: oci, Glob: "oci://a/*"}},
+		want: []*pb.CollectEntry{
+			{Type: oci, Value: "oci://a/new", SinceTime: 200},
+			{Type: oci, Value: "oci://a/old", SinceTime: 100},
+		},
+	}, {
+		name:      "glob with prefix and since time",
+		sinceTime: 150,
+		filters:   []*pb.CollectEntryFilter{{Type: oci, Glob: "oci://a/*"}},
+		want:      []*pb.CollectEntry{{Type: oci, Value: "oci://a/new", SinceTime: 200}},
+	}, {
+		name:      "glob without prefix and since time",
+		sinceTime: 150,
+		filters:   []*pb.CollectEntryFilter{{Type: oci, Glob: "*/new"}, {Type: git, Glob: "*"}},
+		want: []*pb.CollectEntry{
+			{Type: oci, Value: "oci://a/new", SinceTime: 200},
+			{Type: oci, Value: "oci://b/new", SinceTime: 200},
+		},
+	}, {
+		name:    "literal glob",
+		filters: []*pb.CollectEntryFilter{{Type: oci, Glob: "oci://a/new"}},
+		want:    []*pb.CollectEntry{{Type: oci, Value: "oci://a/new", SinceTime: 200}},
+	}, {
+		name:    "unknown type",
+		filters: []*pb.CollectEntryFilter{{Type: pb.CollectDataType_DATATYPE_PURL, Glob: "*"}},
+	}}
+
+	d = open(0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := get(d, tt.sinceTime, tt.filters...)
+			if diff := cmp.Diff(tt.want, got, sortEntries, ignoreProto, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected entries (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("compaction", func(t *testing.T) {
+		n, err := d.removeStale(150, time.Now().Unix())
+		if err != nil {
+			t.Fatalf("failed to compact: %v", err)
+		}
+		if n != 2 {
+			t.Errorf("expected 2 stale entries to be removed, got %d", n)
+		}
+		want := []*pb.CollectEntry{
+			{Type: oci, Value: "oci://a/new", SinceTime: 200},
+			{Type: oci, Value: "oci://b/new", SinceTime: 200},
+		}
+		got := get(d, 0, &pb.CollectEntryFilter{Type: oci, Glob: "*"}, &pb.CollectEntryFilter{Type: git, Glob: "*"})
+		if diff := cmp.Diff(want, got, sortEntries, ignoreProto); diff != "" {
+			t.Errorf("unexpected entries (-want +got):\n%s", diff)
+		}
+		got = get(d, 150, &pb.CollectEntryFilter{Type: oci, Glob: "*"})
+		if diff := cmp.Diff(want, got, sortEntries, ignoreProto); diff != "" {
+			t.Errorf("unexpected entries in since index (-want +got):\n%s", diff)
+		}
+	})
+	if err := d.Close(); err != nil {
+		t.Fatalf("failed to close database: %v", err)
+	}
+
+	t.Run("retention compacts on open", func(t *testing.T) {
+		d := open(time.Hour)
+		defer d.Close()
+		if got := get(d, 0, &pb.CollectEntryFilter{Type: oci, Glob: "*"}); len(got) != 0 {
+			t.Errorf("expected entries older than the retention to be removed, got %v", got)
+		}
+	})
+}
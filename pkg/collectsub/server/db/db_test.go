@@ -18,16 +18,19 @@ package db
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
+	"github.com/guacsec/guac/pkg/collectsub/server/db/boltdb"
 	"github.com/guacsec/guac/pkg/collectsub/server/db/simpledb"
 	"github.com/guacsec/guac/pkg/collectsub/server/db/types"
 )
 
-func Test_SimpleDb_AddGetCollectEntries(t *testing.T) {
+func Test_AddGetCollectEntries(t *testing.T) {
 	tests := []struct {
 		name  string
 		calls []testCall
@@ -128,16 +131,96 @@ func Test_SimpleDb_AddGetCollectEntries(t *testing.T) {
 				{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "git*"},
 			}, false, []*pb.CollectEntry{}),
 		},
+	}, {
+		name: "overlapping filters",
+		calls: []testCall{
+			addFn([]*pb.CollectEntry{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://abc"},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://abc"},
+				{Type: pb.CollectDataType_DATATYPE_PURL, Value: "pkg:npm/abc@1.0.0"},
+			}, false),
+
+			// entries matching several filters are returned once
+			getFn([]*pb.CollectEntryFilter{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "oci://*"},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*abc"},
+				{Type: pb.CollectDataType_DATATYPE_PURL, Glob: "pkg:npm/*"},
+			}, false, []*pb.CollectEntry{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://abc"},
+				{Type: pb.CollectDataType_DATATYPE_PURL, Value: "pkg:npm/abc@1.0.0"},
+			}),
+		},
 	}}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
+	for dbName, newDb := range testDbs {
+		for _, tt := range tests {
+			t.Run(dbName+"/"+tt.name, func(t *testing.T) {
+				ctx := context.TODO()
+				db, err := newDb(t)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer db.Close()
+				for _, c := range tt.calls {
+					if err := c(ctx, db); err != nil {
+						t.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// testDbs are the CollectSubscriberDb implementations under test.
+var testDbs = map[string]func(t *testing.T) (types.CollectSubscriberDb, error){
+	"simpledb": func(t *testing.T) (types.CollectSubscriberDb, error) {
+		return simpledb.NewSimpleDb()
+	},
+	"boltdb": func(t *testing.T) (types.CollectSubscriberDb, error) {
+		return boltdb.NewBoltDb(context.TODO(), filepath.Join(t.TempDir(), "csub.db"), 0)
+	},
+}
+
+func Test_RemoveCollectEntries(t *testing.T) {
+	for dbName, newDb := range testDbs {
+		t.Run(dbName, func(t *testing.T) {
 			ctx := context.TODO()
-			db, err := simpledb.NewSimpleDb()
+			db, err := newDb(t)
 			if err != nil {
 				t.Fatal(err)
 			}
-			for _, c := range tt.calls {
+			defer db.Close()
+
+			calls := []testCall{
+				addFn([]*pb.CollectEntry{
+					{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://abc"},
+					{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://def"},
+					{Type: pb.CollectDataType_DATATYPE_GIT, Value: "oci://abc"},
+				}, false),
+				// only entries with the same type and value are removed
+				removeFn([]*pb.CollectEntry{
+					{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://abc"},
+					{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://xyz"},
+					{Type: pb.CollectDataType_DATATYPE_PURL, Value: "oci://abc"},
+				}, 1),
+				getFn([]*pb.CollectEntryFilter{
+					{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*"},
+					{Type: pb.CollectDataType_DATATYPE_GIT, Glob: "*"},
+				}, false, []*pb.CollectEntry{
+					{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://def"},
+					{Type: pb.CollectDataType_DATATYPE_GIT, Value: "oci://abc"},
+				}),
+				// removed entries can be added again
+				addFn([]*pb.CollectEntry{
+					{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://abc"},
+				}, false),
+				getFn([]*pb.CollectEntryFilter{
+					{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "oci://abc"},
+				}, false, []*pb.CollectEntry{
+					{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://abc"},
+				}),
+			}
+			for _, c := range calls {
 				if err := c(ctx, db); err != nil {
 					t.Fatal(err)
 				}
@@ -146,6 +229,167 @@ func Test_SimpleDb_AddGetCollectEntries(t *testing.T) {
 	}
 }
 
+func Test_InvalidGlob(t *testing.T) {
+	invalid := []*pb.CollectEntryFilter{{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "oci://[abc"}}
+	for dbName, newDb := range testDbs {
+		t.Run(dbName, func(t *testing.T) {
+			ctx := context.TODO()
+			db, err := newDb(t)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			if _, err := db.GetCollectEntries(ctx, invalid, 0); err == nil {
+				t.Errorf("GetCollectEntries did not return an error for an invalid glob")
+			}
+			if _, err := db.LeaseCollectEntries(ctx, invalid, 0, time.Minute); err == nil {
+				t.Errorf("LeaseCollectEntries did not return an error for an invalid glob")
+			}
+		})
+	}
+}
+
+func Test_CollectEntriesTtl(t *testing.T) {
+	for dbName, newDb := range testDbs {
+		t.Run(dbName, func(t *testing.T) {
+			ctx := context.TODO()
+			db, err := newDb(t)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			short := &pb.CollectEntry{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://short", Ttl: 1}
+			long := &pb.CollectEntry{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://long", Ttl: 3600}
+			if err := db.AddCollectEntries(ctx, []*pb.CollectEntry{short, long}); err != nil {
+				t.Fatal(err)
+			}
+			if short.ExpireTime != short.SinceTime+1 {
+				t.Errorf("expected expire time %d, got %d", short.SinceTime+1, short.ExpireTime)
+			}
+
+			time.Sleep(1100 * time.Millisecond)
+			err = getFn([]*pb.CollectEntryFilter{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*"},
+			}, false, []*pb.CollectEntry{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://long"},
+			})(ctx, db)
+			if err != nil {
+				t.Fatal(err)
+			}
+			leases, err := db.LeaseCollectEntries(ctx, []*pb.CollectEntryFilter{{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*"}}, 0, time.Minute)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(leases) != 1 || leases[0].Entry.Value != "oci://long" {
+				t.Errorf("expected only the unexpired entry to be leased, got %v", leases)
+			}
+		})
+	}
+}
+
+func Test_LeaseCollectEntries(t *testing.T) {
+	ociFilter := []*pb.CollectEntryFilter{{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*"}}
+	for dbName, newDb := range testDbs {
+		t.Run(dbName, func(t *testing.T) {
+			ctx := context.TODO()
+			db, err := newDb(t)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			lease := func(max int, leaseDuration time.Duration) []*pb.CollectEntryLease {
+				t.Helper()
+				leases, err := db.LeaseCollectEntries(ctx, ociFilter, max, leaseDuration)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return leases
+			}
+			values := func(leases []*pb.CollectEntryLease) []string {
+				var v []string
+				for _, l := range leases {
+					v = append(v, l.Entry.Value)
+				}
+				return v
+			}
+			ack := func(leases []*pb.CollectEntryLease, requeueAfter time.Duration, want int) {
+				t.Helper()
+				var ids []string
+				for _, l := range leases {
+					ids = append(ids, l.LeaseId)
+				}
+				n, err := db.AckCollectEntries(ctx, ids, requeueAfter)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if n != want {
+					t.Errorf("expected %d acknowledged leases, got %d", want, n)
+				}
+			}
+
+			if err := db.AddCollectEntries(ctx, []*pb.CollectEntry{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://low"},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://high", Priority: 10},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://mid", Priority: 5},
+				{Type: pb.CollectDataType_DATATYPE_GIT, Value: "git+https://example.com/repo"},
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			// entries are leased by priority and leased entries are not
+			// handed out again
+			first := lease(2, time.Minute)
+			if diff := cmp.Diff([]string{"oci://high", "oci://mid"}, values(first)); diff != "" {
+				t.Errorf("unexpected first lease (-want +got):\n%s", diff)
+			}
+			second := lease(0, time.Minute)
+			if diff := cmp.Diff([]string{"oci://low"}, values(second)); diff != "" {
+				t.Errorf("unexpected second lease (-want +got):\n%s", diff)
+			}
+			if got := lease(0, time.Minute); len(got) != 0 {
+				t.Errorf("expected no entries to lease, got %v", values(got))
+			}
+
+			// acknowledged entries are done, expired leases can be leased again
+			ack(first, 0, 2)
+			ack(first, 0, 0)
+			ack(second, time.Hour, 1)
+			if err := db.AddCollectEntries(ctx, []*pb.CollectEntry{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://expiring"},
+			}); err != nil {
+				t.Fatal(err)
+			}
+			expired := lease(0, 0)
+			if diff := cmp.Diff([]string{"oci://expiring"}, values(expired)); diff != "" {
+				t.Errorf("unexpected lease (-want +got):\n%s", diff)
+			}
+			again := lease(0, time.Minute)
+			if diff := cmp.Diff([]string{"oci://expiring"}, values(again)); diff != "" {
+				t.Errorf("unexpected lease after expiry (-want +got):\n%s", diff)
+			}
+			// the expired lease was taken over
+			ack(expired, 0, 0)
+			ack(again, 0, 1)
+		})
+	}
+}
+
+func removeFn(entries []*pb.CollectEntry, expect int) testCall {
+	return func(ctx context.Context, db types.CollectSubscriberDb) error {
+		n, err := db.RemoveCollectEntries(ctx, entries)
+		if err != nil {
+			return fmt.Errorf("unexpected err: %v", err)
+		}
+		if n != expect {
+			return fmt.Errorf("expected %d entries to be removed, got %d", expect, n)
+		}
+		return nil
+	}
+}
+
 type testCall func(ctx context.Context, db types.CollectSubscriberDb) error
 
 func getFn(filters []*pb.CollectEntryFilter, expectErr bool, expect []*pb.CollectEntry) testCall {
@@ -17,10 +17,13 @@ package simpledb
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/gobwas/glob"
+	"github.com/google/uuid"
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
 	db "github.com/guacsec/guac/pkg/collectsub/server/db/types"
 )
@@ -33,47 +36,91 @@ func NewSimpleDb() (db.CollectSubscriberDb, error) {
 
 type simpleDb struct {
 	collectEntries []*pb.CollectEntry
+	leases         map[*pb.CollectEntry]*lease
 	lock           *sync.RWMutex
 }
 
+// lease is the lease state of an entry that was leased at least once.
+type lease struct {
+	id         string
+	expireTime int64
+	// notBefore is when an acknowledged entry can be leased again, done
+	// entries are never leased again.
+	notBefore int64
+	done      bool
+}
+
 func entryKeyEq(e1, e2 *pb.CollectEntry) bool {
 	return e1.GetValue() == e2.GetValue() &&
 		e1.GetType() == e2.GetType()
 }
 
-func (s *simpleDb) containsEntry(e *pb.CollectEntry) bool {
+func (s *simpleDb) findEntry(e *pb.CollectEntry) *pb.CollectEntry {
 	for _, ee := range s.collectEntries {
 		if entryKeyEq(e, ee) {
-			return true
+			return ee
+		}
+	}
+	return nil
+}
+
+func expired(e *pb.CollectEntry, now int64) bool {
+	return e.ExpireTime != 0 && e.ExpireTime <= now
+}
+
+// removeExpired removes the entries whose ttl has passed, the lock must be
+// held for writing.
+func (s *simpleDb) removeExpired(now int64) {
+	kept := s.collectEntries[:0]
+	for _, e := range s.collectEntries {
+		if expired(e, now) {
+			delete(s.leases, e)
+		} else {
+			kept = append(kept, e)
 		}
 	}
-	return false
+	s.collectEntries = kept
 }
 
 func (s *simpleDb) AddCollectEntries(ctx context.Context, entries []*pb.CollectEntry) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	var sinceTime = time.Now().Unix()
+	s.removeExpired(sinceTime)
 	for _, e := range entries {
-		if e != nil && !s.containsEntry(e) {
-			e.SinceTime = sinceTime
-			s.collectEntries = append(s.collectEntries, e)
+		if e == nil {
+			continue
+		}
+		expireTime := int64(0)
+		if e.Ttl > 0 {
+			expireTime = sinceTime + e.Ttl
+		}
+		if ee := s.findEntry(e); ee != nil {
+			ee.Ttl = e.Ttl
+			ee.Priority = e.Priority
+			ee.ExpireTime = expireTime
+			continue
 		}
+		e.SinceTime = sinceTime
+		e.ExpireTime = expireTime
+		s.collectEntries = append(s.collectEntries, e)
 	}
 	return nil
 }
 
 func (s *simpleDb) GetCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, sinceTime int64) ([]*pb.CollectEntry, error) {
+	filterMatchers, err := compileFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
 	s.lock.RLock()
 	defer s.lock.RUnlock()
-	var filterMatchers []glob.Glob
-	for _, f := range filters {
-		filterMatchers = append(filterMatchers, glob.MustCompile(f.Glob))
-	}
+	now := time.Now().Unix()
 
 	var retList []*pb.CollectEntry
 	for _, e := range s.collectEntries {
-		if e.SinceTime >= sinceTime {
+		if e.SinceTime >= sinceTime && !expired(e, now) {
 			for i, f := range filters {
 				matched := filterMatchers[i].Match(e.Value)
 				if e.Type == f.Type && matched {
@@ -86,3 +133,112 @@ func (s *simpleDb) GetCollectEntries(ctx context.Context, filters []*pb.CollectE
 
 	return retList, nil
 }
+
+func (s *simpleDb) RemoveCollectEntries(ctx context.Context, entries []*pb.CollectEntry) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.removeExpired(time.Now().Unix())
+	removed := 0
+	for _, e := range entries {
+		if e == nil {
+			continue
+		}
+		for i, ee := range s.collectEntries {
+			if entryKeyEq(e, ee) {
+				s.collectEntries = append(s.collectEntries[:i], s.collectEntries[i+1:]...)
+				delete(s.leases, ee)
+				removed++
+				break
+			}
+		}
+	}
+	return removed, nil
+}
+
+func (s *simpleDb) LeaseCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, max int, leaseDuration time.Duration) ([]*pb.CollectEntryLease, error) {
+	filterMatchers, err := compileFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now().Unix()
+	s.removeExpired(now)
+	if s.leases == nil {
+		s.leases = map[*pb.CollectEntry]*lease{}
+	}
+
+	var available []*pb.CollectEntry
+	for _, e := range s.collectEntries {
+		if l := s.leases[e]; l != nil && (l.done || l.expireTime > now || l.notBefore > now) {
+			continue
+		}
+		for i, f := range filters {
+			if e.Type == f.Type && filterMatchers[i].Match(e.Value) {
+				available = append(available, e)
+				break
+			}
+		}
+	}
+	sort.SliceStable(available, func(i, j int) bool {
+		if available[i].Priority != available[j].Priority {
+			return available[i].Priority > available[j].Priority
+		}
+		return available[i].SinceTime < available[j].SinceTime
+	})
+	if max > 0 && len(available) > max {
+		available = available[:max]
+	}
+
+	expireTime := now + int64(leaseDuration/time.Second)
+	var leases []*pb.CollectEntryLease
+	for _, e := range available {
+		l := &lease{id: uuid.NewString(), expireTime: expireTime}
+		s.leases[e] = l
+		leases = append(leases, &pb.CollectEntryLease{LeaseId: l.id, Entry: e, ExpireTime: expireTime})
+	}
+	return leases, nil
+}
+
+func (s *simpleDb) AckCollectEntries(ctx context.Context, leaseIDs []string, requeueAfter time.Duration) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now().Unix()
+	ids := map[string]bool{}
+	for _, id := range leaseIDs {
+		ids[id] = true
+	}
+
+	acknowledged := 0
+	for _, l := range s.leases {
+		if l.id == "" || !ids[l.id] {
+			continue
+		}
+		l.id = ""
+		l.expireTime = 0
+		if requeueAfter > 0 {
+			l.notBefore = now + int64(requeueAfter/time.Second)
+		} else {
+			l.done = true
+		}
+		acknowledged++
+	}
+	return acknowledged, nil
+}
+
+func (s *simpleDb) Close() error {
+	return nil
+}
+
+func compileFilters(filters []*pb.CollectEntryFilter) ([]glob.Glob, error) {
+	var filterMatchers []glob.Glob
+	for _, f := range filters {
+		g, err := glob.Compile(f.Glob)
+		if err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %w", f.Glob, err)
+		}
+		filterMatchers = append(filterMatchers, g)
+	}
+	return filterMatchers, nil
+}
@@ -17,6 +17,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
 )
@@ -24,4 +25,15 @@ import (
 type CollectSubscriberDb interface {
 	AddCollectEntries(context.Context, []*pb.CollectEntry) error
 	GetCollectEntries(context.Context, []*pb.CollectEntryFilter, int64) ([]*pb.CollectEntry, error)
+	// RemoveCollectEntries removes the entries with the type and value of the
+	// given entries and returns how many were removed.
+	RemoveCollectEntries(context.Context, []*pb.CollectEntry) (int, error)
+	// LeaseCollectEntries leases up to max entries matching the filters, or all
+	// of them if max is 0, highest priority and oldest first.
+	LeaseCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, max int, leaseDuration time.Duration) ([]*pb.CollectEntryLease, error)
+	// AckCollectEntries acknowledges the leases that are still held and returns
+	// how many were. The entries can be leased again after requeueAfter, or
+	// never if it is 0.
+	AckCollectEntries(ctx context.Context, leaseIDs []string, requeueAfter time.Duration) (int, error)
+	Close() error
 }
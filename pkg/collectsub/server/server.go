@@ -22,19 +22,21 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gobwas/glob"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/guacsec/guac/pkg/collectsub/collectsub"
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
-	"github.com/guacsec/guac/pkg/collectsub/server/db/simpledb"
 	db "github.com/guacsec/guac/pkg/collectsub/server/db/types"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/misc/slice"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type server struct {
@@ -47,12 +49,9 @@ type server struct {
 	tlsKeyFile  string
 }
 
-func NewServer(port int, tlsCertFile string, tlsKeyFile string) (*server, error) {
-	db, err := simpledb.NewSimpleDb()
-	if err != nil {
-		return nil, err
-	}
-
+// NewServer returns a collect subscriber server that stores the collect entries
+// in db.
+func NewServer(db db.CollectSubscriberDb, port int, tlsCertFile string, tlsKeyFile string) (*server, error) {
 	return &server{
 		Db:          db,
 		port:        port,
@@ -82,6 +81,9 @@ func (s *server) GetCollectEntries(in *pb.GetCollectEntriesRequest, out collects
 	logger := ctxzap.Extract(ctx).Sugar()
 	logger.Debugf("GetCollectEntries called with filters: %v", in.Filters)
 
+	if err := validateFilters(in.Filters); err != nil {
+		return err
+	}
 	entries, err := s.Db.GetCollectEntries(ctx, in.Filters, in.SinceTime)
 	if err != nil {
 		return fmt.Errorf("failed to get collect entries from db: %w", err)
@@ -98,6 +100,76 @@ func (s *server) GetCollectEntries(in *pb.GetCollectEntriesRequest, out collects
 	return nil
 }
 
+func (s *server) RemoveCollectEntries(ctx context.Context, in *pb.RemoveCollectEntriesRequest) (*pb.RemoveCollectEntriesResponse, error) {
+	logger := ctxzap.Extract(ctx).Sugar()
+	logger.Debugf("RemoveCollectEntries called with entries: %v", in.Entries)
+
+	removed, err := s.Db.RemoveCollectEntries(ctx, in.Entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove entries from db: %w", err)
+	}
+	logger.Infof("RemoveCollectEntries removed %d entries", removed)
+
+	return &pb.RemoveCollectEntriesResponse{
+		Removed: int64(removed),
+	}, nil
+}
+
+func (s *server) LeaseCollectEntries(ctx context.Context, in *pb.LeaseCollectEntriesRequest) (*pb.LeaseCollectEntriesResponse, error) {
+	logger := ctxzap.Extract(ctx).Sugar()
+	logger.Debugf("LeaseCollectEntries called with filters: %v", in.Filters)
+
+	if in.MaxEntries < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_entries must not be negative")
+	}
+	// a lease without a duration expires immediately, and the entry would be
+	// leased to every collector
+	if in.LeaseDuration <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "lease_duration must be positive")
+	}
+	if err := validateFilters(in.Filters); err != nil {
+		return nil, err
+	}
+	leases, err := s.Db.LeaseCollectEntries(ctx, in.Filters, int(in.MaxEntries), time.Duration(in.LeaseDuration)*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lease collect entries from db: %w", err)
+	}
+	logger.Infof("LeaseCollectEntries leased %d entries", len(leases))
+
+	return &pb.LeaseCollectEntriesResponse{
+		Leases: leases,
+	}, nil
+}
+
+func (s *server) AckCollectEntries(ctx context.Context, in *pb.AckCollectEntriesRequest) (*pb.AckCollectEntriesResponse, error) {
+	logger := ctxzap.Extract(ctx).Sugar()
+	logger.Debugf("AckCollectEntries called with leases: %v", in.LeaseIds)
+
+	if in.RequeueAfter < 0 {
+		return nil, status.Error(codes.InvalidArgument, "requeue_after must not be negative")
+	}
+	acknowledged, err := s.Db.AckCollectEntries(ctx, in.LeaseIds, time.Duration(in.RequeueAfter)*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acknowledge collect entries in db: %w", err)
+	}
+	logger.Infof("AckCollectEntries acknowledged %d of %d leases", acknowledged, len(in.LeaseIds))
+
+	return &pb.AckCollectEntriesResponse{
+		Acknowledged: int64(acknowledged),
+	}, nil
+}
+
+// validateFilters returns an InvalidArgument error if the glob of one of the
+// filters does not compile.
+func validateFilters(filters []*pb.CollectEntryFilter) error {
+	for _, f := range filters {
+		if _, err := glob.Compile(f.Glob); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid glob %q: %v", f.Glob, err)
+		}
+	}
+	return nil
+}
+
 func contextPropagationUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -21,6 +21,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 
 	cdevents "github.com/cdevents/sdk-go/pkg/api"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -79,6 +80,16 @@ func GetKey(blob []byte) string {
 	return fmt.Sprintf("sha256_%s", generatedHash)
 }
 
+// GetReaderKey returns the key of the blob read from r, the same as GetKey,
+// for the blobs too large to be held in memory.
+func GetReaderKey(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("sha256_%s", hex.EncodeToString(h.Sum(nil))), nil
+}
+
 // GetDocRef returns the Document Reference of a blob; i.e. the blob store key for this blob.
 func GetDocRef(blob []byte) string {
 	return GetKey(blob)
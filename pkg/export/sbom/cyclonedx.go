@@ -0,0 +1,157 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
+)
+
+// cdxSubjectRef is the bom-ref of the metadata component that is generated
+// when a subject matches more than one root component.
+const cdxSubjectRef = "guac-subject"
+
+var cdxHashAlgorithms = map[string]cdx.HashAlgorithm{
+	"md5":         cdx.HashAlgoMD5,
+	"sha1":        cdx.HashAlgoSHA1,
+	"sha256":      cdx.HashAlgoSHA256,
+	"sha384":      cdx.HashAlgoSHA384,
+	"sha512":      cdx.HashAlgoSHA512,
+	"sha3-256":    cdx.HashAlgoSHA3_256,
+	"sha3-384":    cdx.HashAlgoSHA3_384,
+	"sha3-512":    cdx.HashAlgoSHA3_512,
+	"blake2b-256": cdx.HashAlgoBlake2b_256,
+	"blake2b-384": cdx.HashAlgoBlake2b_384,
+	"blake2b-512": cdx.HashAlgoBlake2b_512,
+	"blake3":      cdx.HashAlgoBlake3,
+}
+
+// ToCycloneDX converts g to a CycloneDX BOM. The BOM should be encoded with
+// version 1.5 of the specification.
+func ToCycloneDX(g *Graph, created time.Time) *cdx.BOM {
+	bom := cdx.NewBOM()
+	bom.SerialNumber = "urn:uuid:" + uuid.NewString()
+	bom.Metadata = &cdx.Metadata{
+		Timestamp: created.UTC().Format(time.RFC3339),
+		Tools: &cdx.ToolsChoice{
+			Components: &[]cdx.Component{{Type: cdx.ComponentTypeApplication, Name: "guac"}},
+		},
+	}
+
+	refs := map[string]string{}
+	for _, comp := range g.Components {
+		refs[comp.ID] = cdxRef(comp)
+	}
+
+	var dependencies []cdx.Dependency
+	var rootID string
+	if len(g.Roots) == 1 {
+		rootID = g.Roots[0].ID
+		root := cdxComponent(g.Roots[0], refs[rootID])
+		bom.Metadata.Component = &root
+	} else {
+		bom.Metadata.Component = &cdx.Component{
+			BOMRef: cdxSubjectRef,
+			Type:   cdx.ComponentTypeApplication,
+			Name:   g.Subject.String(),
+		}
+		var rootRefs []string
+		for _, root := range g.Roots {
+			rootRefs = append(rootRefs, refs[root.ID])
+		}
+		dependencies = append(dependencies, cdx.Dependency{Ref: cdxSubjectRef, Dependencies: &rootRefs})
+	}
+
+	components := []cdx.Component{}
+	for _, comp := range g.Components {
+		if comp.ID != rootID {
+			components = append(components, cdxComponent(comp, refs[comp.ID]))
+		}
+		dependsOn := []string{}
+		for _, to := range g.DependsOn[comp.ID] {
+			dependsOn = append(dependsOn, refs[to])
+		}
+		dependencies = append(dependencies, cdx.Dependency{Ref: refs[comp.ID], Dependencies: &dependsOn})
+	}
+	bom.Components = &components
+	bom.Dependencies = &dependencies
+	return bom
+}
+
+// WriteCycloneDX writes g as a CycloneDX 1.5 JSON document.
+func WriteCycloneDX(g *Graph, w io.Writer) error {
+	encoder := cdx.NewBOMEncoder(w, cdx.BOMFileFormatJSON)
+	encoder.SetPretty(true)
+	if err := encoder.EncodeVersion(ToCycloneDX(g, time.Now()), cdx.SpecVersion1_5); err != nil {
+		return fmt.Errorf("failed to write CycloneDX document: %w", err)
+	}
+	return nil
+}
+
+func cdxRef(comp *Component) string {
+	if comp.Purl != "" {
+		return comp.Purl
+	}
+	return comp.Name
+}
+
+func cdxComponent(comp *Component, ref string) cdx.Component {
+	c := cdx.Component{
+		BOMRef:     ref,
+		Type:       cdx.ComponentTypeLibrary,
+		Name:       comp.Name,
+		Group:      comp.Namespace,
+		Version:    comp.Version,
+		PackageURL: comp.Purl,
+	}
+	if comp.IsArtifact {
+		c.Type = cdx.ComponentTypeFile
+	}
+
+	var hashes []cdx.Hash
+	for _, h := range comp.Hashes {
+		if alg, ok := cdxHashAlgorithms[strings.ToLower(h.Algorithm)]; ok {
+			hashes = append(hashes, cdx.Hash{Algorithm: alg, Value: h.Digest})
+		}
+	}
+	if len(hashes) > 0 {
+		c.Hashes = &hashes
+	}
+
+	// CycloneDX allows a single license expression per component, so the
+	// discovered license is only used when no license is declared
+	expression := comp.DeclaredLicense
+	if expression == "" {
+		expression = comp.DiscoveredLicense
+	}
+	if expression != "" {
+		c.Licenses = &cdx.Licenses{{Expression: expression}}
+	}
+
+	if len(comp.SourceURIs) > 0 {
+		var refs []cdx.ExternalReference
+		for _, uri := range comp.SourceURIs {
+			refs = append(refs, cdx.ExternalReference{Type: cdx.ERTypeVCS, URL: uri})
+		}
+		c.ExternalReferences = &refs
+	}
+	return c
+}
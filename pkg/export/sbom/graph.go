@@ -0,0 +1,471 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/subject"
+)
+
+// ErrSubjectNotFound is returned when the subject of an export is not in the
+// graph.
+var ErrSubjectNotFound = subject.ErrSubjectNotFound
+
+// Subject is the package or artifact that an SBOM is exported for.
+type Subject = subject.Subject
+
+// Hash is a checksum of a component, taken from the artifacts that the
+// component is an occurrence of.
+type Hash struct {
+	Algorithm string
+	Digest    string
+}
+
+// License is a license referenced by the license expressions of a component.
+type License struct {
+	Name   string
+	Inline string
+}
+
+// Component is a package version, or an artifact that is not an occurrence
+// of any exported package.
+type Component struct {
+	// ID is the GUAC node ID of the package version or artifact.
+	ID        string
+	Purl      string
+	Type      string
+	Namespace string
+	Name      string
+	Version   string
+	// IsArtifact is set for components that are a bare artifact.
+	IsArtifact bool
+	Hashes     []Hash
+	// DeclaredLicense and DiscoveredLicense are the license expressions
+	// found by CertifyLegal.
+	DeclaredLicense   string
+	DiscoveredLicense string
+	Licenses          []License
+	// SourceURIs are the VCS locations found by HasSourceAt.
+	SourceURIs []string
+}
+
+// Graph is the software reachable from a subject, in the order in which it
+// was found.
+type Graph struct {
+	Subject    Subject
+	Roots      []*Component
+	Components []*Component
+	// DependsOn maps the ID of a component to the IDs of its dependencies.
+	DependsOn map[string][]string
+	// Contains maps the ID of a component to the IDs of the software included
+	// in its SBOMs.
+	Contains map[string][]string
+}
+
+type collector struct {
+	gqlClient  graphql.Client
+	graph      *Graph
+	components map[string]*Component
+	queue      []*Component
+	visited    map[string]bool
+	// artifactOwners maps artifact IDs to the package versions that they are
+	// occurrences of.
+	artifactOwners map[string][]string
+	artifacts      map[string]model.AllArtifactTree
+	edges          map[string]map[string]bool
+	contains       map[string]map[string]bool
+}
+
+// Collect walks the graph from subject like Walk, and enriches the packages
+// found with their CertifyLegal and HasSourceAt facts.
+func Collect(ctx context.Context, gqlClient graphql.Client, subject Subject) (*Graph, error) {
+	c, err := walk(ctx, gqlClient, subject)
+	if err != nil {
+		return nil, err
+	}
+	for _, comp := range c.graph.Components {
+		if comp.IsArtifact {
+			continue
+		}
+		if err := c.enrich(ctx, comp); err != nil {
+			return nil, err
+		}
+	}
+	return c.graph, nil
+}
+
+// Walk walks the graph from subject, following the software and dependencies
+// included by HasSBOM nodes, IsDependency and IsOccurrence.
+func Walk(ctx context.Context, gqlClient graphql.Client, subject Subject) (*Graph, error) {
+	c, err := walk(ctx, gqlClient, subject)
+	if err != nil {
+		return nil, err
+	}
+	return c.graph, nil
+}
+
+func walk(ctx context.Context, gqlClient graphql.Client, subject Subject) (*collector, error) {
+	if err := subject.Validate(); err != nil {
+		return nil, err
+	}
+	c := &collector{
+		gqlClient:      gqlClient,
+		graph:          &Graph{Subject: subject, DependsOn: map[string][]string{}, Contains: map[string][]string{}},
+		components:     map[string]*Component{},
+		visited:        map[string]bool{},
+		artifactOwners: map[string][]string{},
+		artifacts:      map[string]model.AllArtifactTree{},
+		edges:          map[string]map[string]bool{},
+		contains:       map[string]map[string]bool{},
+	}
+	if err := c.addRoots(ctx); err != nil {
+		return nil, err
+	}
+	for len(c.queue) > 0 {
+		comp := c.queue[0]
+		c.queue = c.queue[1:]
+		if err := c.visit(ctx, comp); err != nil {
+			return nil, err
+		}
+	}
+	c.finish()
+	return c, nil
+}
+
+func (c *collector) addRoots(ctx context.Context) error {
+	resolved, err := subject.Resolve(ctx, c.gqlClient, c.graph.Subject)
+	if err != nil {
+		return err
+	}
+	for _, p := range resolved.Packages {
+		for _, comp := range c.addPackageTree(p) {
+			c.addRoot(comp)
+		}
+	}
+	for _, a := range resolved.Artifacts {
+		occs, err := model.Occurrences(ctx, c.gqlClient, model.IsOccurrenceSpec{
+			Artifact: &model.ArtifactSpec{Id: &a.Id},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to query occurrences: %w", err)
+		}
+		var owners []*Component
+		for _, occ := range occs.IsOccurrence {
+			if p, ok := occ.Subject.(*model.AllIsOccurrencesTreeSubjectPackage); ok {
+				owners = append(owners, c.addPackageTree(p.AllPkgTree)...)
+			}
+		}
+		if len(owners) == 0 {
+			// the artifact is not known to be any package, so it is the root
+			comp := c.addArtifact(a)
+			c.addRoot(comp)
+			if err := c.visitHasSBOM(ctx, comp, model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{Id: &a.Id}}); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, owner := range owners {
+			c.addOccurrence(owner.ID, a)
+			c.addRoot(owner)
+		}
+		if err := c.visitHasSBOM(ctx, owners[0], model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{Id: &a.Id}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *collector) addRoot(comp *Component) {
+	for _, root := range c.graph.Roots {
+		if root.ID == comp.ID {
+			return
+		}
+	}
+	c.graph.Roots = append(c.graph.Roots, comp)
+}
+
+// visit adds the dependencies, occurrences and SBOM contents of a package
+// version to the graph.
+func (c *collector) visit(ctx context.Context, comp *Component) error {
+	if c.visited[comp.ID] {
+		return nil
+	}
+	c.visited[comp.ID] = true
+
+	if err := c.visitHasSBOM(ctx, comp, model.PackageOrArtifactSpec{Package: &model.PkgSpec{Id: &comp.ID}}); err != nil {
+		return err
+	}
+
+	deps, err := model.Dependencies(ctx, c.gqlClient, model.IsDependencySpec{
+		Package: &model.PkgSpec{Id: &comp.ID},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query dependencies of %s: %w", comp.Purl, err)
+	}
+	for _, dep := range deps.IsDependency {
+		c.addDependency(dep.AllIsDependencyTree)
+	}
+
+	occs, err := model.Occurrences(ctx, c.gqlClient, model.IsOccurrenceSpec{
+		Subject: &model.PackageOrSourceSpec{Package: &model.PkgSpec{Id: &comp.ID}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query occurrences of %s: %w", comp.Purl, err)
+	}
+	for _, occ := range occs.IsOccurrence {
+		c.addOccurrence(comp.ID, occ.Artifact.AllArtifactTree)
+	}
+	return nil
+}
+
+// visitHasSBOM adds the software, dependencies and occurrences included by
+// the SBOMs of subject. owner is the component that included artifacts are
+// attached to when they are not an occurrence of a package.
+func (c *collector) visitHasSBOM(ctx context.Context, owner *Component, subject model.PackageOrArtifactSpec) error {
+	resp, err := model.HasSBOMs(ctx, c.gqlClient, model.HasSBOMSpec{Subject: &subject})
+	if err != nil {
+		return fmt.Errorf("failed to query SBOMs of %s: %w", owner.Purl, err)
+	}
+	for _, sbom := range resp.HasSBOM {
+		for _, sw := range sbom.IncludedSoftware {
+			switch s := sw.(type) {
+			case *model.AllHasSBOMTreeIncludedSoftwarePackage:
+				for _, comp := range c.addPackageTree(s.AllPkgTree) {
+					addEdge(c.contains, owner.ID, comp.ID)
+				}
+			case *model.AllHasSBOMTreeIncludedSoftwareArtifact:
+				c.artifacts[s.Id] = s.AllArtifactTree
+				addEdge(c.contains, owner.ID, s.Id)
+			}
+		}
+		for _, dep := range sbom.IncludedDependencies {
+			c.addDependency(dep.AllIsDependencyTree)
+		}
+		for _, occ := range sbom.IncludedOccurrences {
+			if p, ok := occ.Subject.(*model.AllIsOccurrencesTreeSubjectPackage); ok {
+				for _, comp := range c.addPackageTree(p.AllPkgTree) {
+					c.addOccurrence(comp.ID, occ.Artifact.AllArtifactTree)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (c *collector) addDependency(dep model.AllIsDependencyTree) {
+	dependents := c.addPackageTree(dep.Package.AllPkgTree)
+	dependencies := c.addPackageTree(dep.DependencyPackage.AllPkgTree)
+	for _, from := range dependents {
+		for _, to := range dependencies {
+			addEdge(c.edges, from.ID, to.ID)
+		}
+	}
+}
+
+func addEdge(edges map[string]map[string]bool, from, to string) {
+	if from == to {
+		return
+	}
+	if edges[from] == nil {
+		edges[from] = map[string]bool{}
+	}
+	edges[from][to] = true
+}
+
+func (c *collector) addOccurrence(pkgID string, a model.AllArtifactTree) {
+	c.artifacts[a.Id] = a
+	for _, owner := range c.artifactOwners[a.Id] {
+		if owner == pkgID {
+			return
+		}
+	}
+	c.artifactOwners[a.Id] = append(c.artifactOwners[a.Id], pkgID)
+	comp := c.components[pkgID]
+	comp.Hashes = append(comp.Hashes, Hash{Algorithm: a.Algorithm, Digest: a.Digest})
+}
+
+// addPackageTree adds all the package versions of tree to the graph and
+// queues the new ones for a visit.
+func (c *collector) addPackageTree(tree model.AllPkgTree) []*Component {
+	var comps []*Component
+	for _, ns := range tree.Namespaces {
+		for _, name := range ns.Names {
+			for _, v := range name.Versions {
+				comp, ok := c.components[v.Id]
+				if !ok {
+					comp = &Component{
+						ID:        v.Id,
+						Purl:      v.Purl,
+						Type:      tree.Type,
+						Namespace: ns.Namespace,
+						Name:      name.Name,
+						Version:   v.Version,
+					}
+					c.components[v.Id] = comp
+					c.graph.Components = append(c.graph.Components, comp)
+					c.queue = append(c.queue, comp)
+				}
+				comps = append(comps, comp)
+			}
+		}
+	}
+	return comps
+}
+
+func (c *collector) addArtifact(a model.AllArtifactTree) *Component {
+	c.artifacts[a.Id] = a
+	if comp, ok := c.components[a.Id]; ok {
+		return comp
+	}
+	comp := &Component{
+		ID:         a.Id,
+		Name:       a.Algorithm + ":" + a.Digest,
+		IsArtifact: true,
+		Hashes:     []Hash{{Algorithm: a.Algorithm, Digest: a.Digest}},
+	}
+	c.components[a.Id] = comp
+	c.graph.Components = append(c.graph.Components, comp)
+	// artifact components have nothing to visit past their SBOM
+	c.visited[a.Id] = true
+	return comp
+}
+
+// finish adds the included artifacts that are not an occurrence of any
+// package as components, and sorts the dependency edges.
+func (c *collector) finish() {
+	ids := make([]string, 0, len(c.artifacts))
+	for id := range c.artifacts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if len(c.artifactOwners[id]) == 0 {
+			c.addArtifact(c.artifacts[id])
+		}
+	}
+	c.sortEdges(c.edges, c.graph.DependsOn)
+	c.sortEdges(c.contains, c.graph.Contains)
+}
+
+func (c *collector) sortEdges(edges map[string]map[string]bool, out map[string][]string) {
+	for from, tos := range edges {
+		for to := range tos {
+			// artifacts that became the hash of a package are not components
+			if _, ok := c.components[to]; ok {
+				out[from] = append(out[from], to)
+			}
+		}
+		sort.Strings(out[from])
+	}
+}
+
+// enrich adds the licenses and source locations of a package version.
+func (c *collector) enrich(ctx context.Context, comp *Component) error {
+	legals, err := model.CertifyLegal(ctx, c.gqlClient, model.CertifyLegalSpec{
+		Subject: &model.PackageOrSourceSpec{Package: &model.PkgSpec{Id: &comp.ID}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query CertifyLegal of %s: %w", comp.Purl, err)
+	}
+	var declared, discovered []string
+	seenLicenses := map[string]bool{}
+	for _, legal := range legals.CertifyLegal {
+		declared = appendUnique(declared, legal.DeclaredLicense)
+		discovered = appendUnique(discovered, legal.DiscoveredLicense)
+		var trees []model.AllLicenseTree
+		for _, l := range legal.DeclaredLicenses {
+			trees = append(trees, l.AllLicenseTree)
+		}
+		for _, l := range legal.DiscoveredLicenses {
+			trees = append(trees, l.AllLicenseTree)
+		}
+		for _, l := range trees {
+			if seenLicenses[l.Name] {
+				continue
+			}
+			seenLicenses[l.Name] = true
+			license := License{Name: l.Name}
+			if l.Inline != nil {
+				license.Inline = *l.Inline
+			}
+			comp.Licenses = append(comp.Licenses, license)
+		}
+	}
+	comp.DeclaredLicense = joinExpressions(declared)
+	comp.DiscoveredLicense = joinExpressions(discovered)
+
+	sources, err := model.HasSourceAt(ctx, c.gqlClient, model.HasSourceAtSpec{
+		Package: &model.PkgSpec{Id: &comp.ID},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query HasSourceAt of %s: %w", comp.Purl, err)
+	}
+	for _, hsa := range sources.HasSourceAt {
+		for _, uri := range sourceURIs(hsa.Source.AllSourceTree) {
+			comp.SourceURIs = appendUnique(comp.SourceURIs, uri)
+		}
+	}
+	return nil
+}
+
+// sourceURIs returns the VCS locations of the source names in tree, in the
+// "<vcs>+https://<namespace>/<name>@<revision>" form used by SPDX.
+func sourceURIs(tree model.AllSourceTree) []string {
+	var uris []string
+	for _, ns := range tree.Namespaces {
+		for _, n := range ns.Names {
+			uri := tree.Type + "+https://" + ns.Namespace + "/" + n.Name
+			if n.Commit != nil && *n.Commit != "" {
+				uri += "@" + *n.Commit
+			} else if n.Tag != nil && *n.Tag != "" {
+				uri += "@" + *n.Tag
+			}
+			uris = append(uris, uri)
+		}
+	}
+	return uris
+}
+
+func appendUnique(list []string, s string) []string {
+	if s == "" {
+		return list
+	}
+	for _, e := range list {
+		if e == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+// joinExpressions combines the license expressions of several CertifyLegal
+// nodes into one expression.
+func joinExpressions(exprs []string) string {
+	if len(exprs) <= 1 {
+		return strings.Join(exprs, "")
+	}
+	parts := make([]string, len(exprs))
+	for i, e := range exprs {
+		parts[i] = "(" + e + ")"
+	}
+	return strings.Join(parts, " AND ")
+}
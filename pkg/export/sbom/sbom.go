@@ -0,0 +1,78 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom regenerates SPDX and CycloneDX documents from the GUAC graph.
+// Starting from a package or an artifact, it follows the software and
+// dependencies included by HasSBOM nodes and the IsDependency and
+// IsOccurrence edges, and emits a document that carries the hashes, licenses
+// and source locations known for each component.
+package sbom
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// Format is an SBOM document format.
+type Format string
+
+const (
+	// FormatSPDX is SPDX 2.3 JSON.
+	FormatSPDX Format = "spdx"
+	// FormatCycloneDX is CycloneDX 1.5 JSON.
+	FormatCycloneDX Format = "cyclonedx"
+)
+
+// Formats lists the supported formats.
+var Formats = []Format{FormatSPDX, FormatCycloneDX}
+
+// ContentType returns the media type of documents in format f.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCycloneDX:
+		return "application/vnd.cyclonedx+json"
+	default:
+		return "application/spdx+json"
+	}
+}
+
+// ParseFormat returns the format named s.
+func ParseFormat(s string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported SBOM format %q, valid formats are: %v", s, Formats)
+}
+
+// Export collects the software of subject from the graph and writes it to w
+// as a document in format f.
+func Export(ctx context.Context, gqlClient graphql.Client, subject Subject, f Format, w io.Writer) error {
+	if _, err := ParseFormat(string(f)); err != nil {
+		return err
+	}
+	g, err := Collect(ctx, gqlClient, subject)
+	if err != nil {
+		return err
+	}
+	if f == FormatCycloneDX {
+		return WriteCycloneDX(g, w)
+	}
+	return WriteSPDX(g, w)
+}
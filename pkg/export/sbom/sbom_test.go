@@ -0,0 +1,202 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/go-cmp/cmp"
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/export/sbom"
+	"github.com/guacsec/guac/pkg/logging"
+	spdxjson "github.com/spdx/tools-golang/json"
+	spdx "github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+var testData = GuacData{
+	Packages:  []string{"pkg:guac/app@1.0", "pkg:guac/lib@1.0", "pkg:guac/leaf@1.0", "pkg:guac/other@1.0"},
+	Artifacts: []string{"app-digest", "bin-digest"},
+	Sources:   []string{"app-src"},
+	HasSboms: []HasSbom{{
+		Subject:                "pkg:guac/app@1.0",
+		IncludedSoftware:       []string{"pkg:guac/app@1.0", "pkg:guac/lib@1.0", "pkg:guac/other@1.0", "bin-digest"},
+		IncludedIsDependencies: []IsDependency{{DependentPkg: "pkg:guac/app@1.0", DependencyPkg: "pkg:guac/lib@1.0"}},
+		IncludedIsOccurrences:  []IsOccurrence{{Subject: "pkg:guac/app@1.0", Artifact: "app-digest"}},
+	}},
+	// only known from the graph, not from the SBOM of app
+	IsDependencies: []IsDependency{{DependentPkg: "pkg:guac/lib@1.0", DependencyPkg: "pkg:guac/leaf@1.0"}},
+	HasSourceAts:   []HasSourceAt{{Package: "pkg:guac/app@1.0", Source: "app-src"}},
+	CertifyLegals:  []CertifyLegal{{Package: "pkg:guac/app@1.0", DeclaredLicenses: []string{"Apache-2.0"}}},
+}
+
+func TestExport_SPDX(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, testData)
+
+	for _, subject := range []sbom.Subject{{Purl: "pkg:guac/app@1.0"}, {Digest: "sha256:app-digest"}} {
+		t.Run(subject.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := sbom.Export(ctx, gqlClient, subject, sbom.FormatSPDX, &buf); err != nil {
+				t.Fatalf("Export() returned unexpected error: %v", err)
+			}
+			doc, err := spdxjson.Read(&buf)
+			if err != nil {
+				t.Fatalf("failed to read exported SPDX document: %v", err)
+			}
+			if doc.SPDXVersion != spdx.Version {
+				t.Errorf("SPDXVersion = %s, want %s", doc.SPDXVersion, spdx.Version)
+			}
+
+			names := map[string]string{}
+			var app *spdx.Package
+			for _, p := range doc.Packages {
+				names[string(p.PackageSPDXIdentifier)] = p.PackageName
+				if p.PackageName == "app" {
+					app = p
+				}
+			}
+			wantNames := []string{"app", "leaf", "lib", "other", "sha256:bin-digest"}
+			if diff := cmp.Diff(wantNames, sortedValues(names)); diff != "" {
+				t.Errorf("unexpected packages (-want +got):\n%s", diff)
+			}
+			if app == nil {
+				t.Fatalf("package app not exported")
+			}
+			if len(app.PackageChecksums) != 1 || app.PackageChecksums[0].Value != "app-digest" {
+				t.Errorf("app checksums = %+v, want app-digest", app.PackageChecksums)
+			}
+			if app.PackageLicenseDeclared != "Apache-2.0" {
+				t.Errorf("app declared license = %s, want Apache-2.0", app.PackageLicenseDeclared)
+			}
+			if app.PackageDownloadLocation != "test-type+https://test-namespace/app-src" {
+				t.Errorf("app download location = %s", app.PackageDownloadLocation)
+			}
+			if len(app.PackageExternalReferences) != 1 || app.PackageExternalReferences[0].Locator != "pkg:guac/app@1.0" {
+				t.Errorf("app external references = %+v, want its purl", app.PackageExternalReferences)
+			}
+
+			var relationships []string
+			for _, r := range doc.Relationships {
+				from := names[string(r.RefA.ElementRefID)]
+				if r.RefA.ElementRefID == "DOCUMENT" {
+					from = "DOCUMENT"
+				}
+				relationships = append(relationships, from+" "+r.Relationship+" "+names[string(r.RefB.ElementRefID)])
+			}
+			sort.Strings(relationships)
+			wantRelationships := []string{
+				"DOCUMENT DESCRIBES app",
+				"app CONTAINS lib",
+				"app CONTAINS other",
+				"app CONTAINS sha256:bin-digest",
+				"app DEPENDS_ON lib",
+				"lib DEPENDS_ON leaf",
+			}
+			if diff := cmp.Diff(wantRelationships, relationships); diff != "" {
+				t.Errorf("unexpected relationships (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExport_CycloneDX(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, testData)
+
+	var buf bytes.Buffer
+	if err := sbom.Export(ctx, gqlClient, sbom.Subject{Purl: "pkg:guac/app@1.0"}, sbom.FormatCycloneDX, &buf); err != nil {
+		t.Fatalf("Export() returned unexpected error: %v", err)
+	}
+	var bom cdx.BOM
+	if err := cdx.NewBOMDecoder(&buf, cdx.BOMFileFormatJSON).Decode(&bom); err != nil {
+		t.Fatalf("failed to decode exported CycloneDX document: %v", err)
+	}
+	if bom.SpecVersion != cdx.SpecVersion1_5 {
+		t.Errorf("SpecVersion = %v, want 1.5", bom.SpecVersion)
+	}
+
+	root := bom.Metadata.Component
+	if root == nil || root.PackageURL != "pkg:guac/app@1.0" {
+		t.Fatalf("metadata component = %+v, want app", root)
+	}
+	if root.Hashes == nil || len(*root.Hashes) != 1 || (*root.Hashes)[0].Algorithm != cdx.HashAlgoSHA256 {
+		t.Errorf("app hashes = %+v, want a SHA-256", root.Hashes)
+	}
+	if root.Licenses == nil || (*root.Licenses)[0].Expression != "Apache-2.0" {
+		t.Errorf("app licenses = %+v, want Apache-2.0", root.Licenses)
+	}
+	if root.ExternalReferences == nil || (*root.ExternalReferences)[0].Type != cdx.ERTypeVCS {
+		t.Errorf("app external references = %+v, want a vcs reference", root.ExternalReferences)
+	}
+
+	var refs []string
+	for _, c := range *bom.Components {
+		refs = append(refs, c.BOMRef)
+	}
+	sort.Strings(refs)
+	wantRefs := []string{"pkg:guac/leaf@1.0", "pkg:guac/lib@1.0", "pkg:guac/other@1.0", "sha256:bin-digest"}
+	if diff := cmp.Diff(wantRefs, refs); diff != "" {
+		t.Errorf("unexpected components (-want +got):\n%s", diff)
+	}
+
+	dependsOn := map[string][]string{}
+	for _, d := range *bom.Dependencies {
+		if d.Dependencies != nil {
+			dependsOn[d.Ref] = *d.Dependencies
+		}
+	}
+	if diff := cmp.Diff([]string{"pkg:guac/lib@1.0"}, dependsOn["pkg:guac/app@1.0"]); diff != "" {
+		t.Errorf("unexpected dependencies of app (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"pkg:guac/leaf@1.0"}, dependsOn["pkg:guac/lib@1.0"]); diff != "" {
+		t.Errorf("unexpected dependencies of lib (-want +got):\n%s", diff)
+	}
+}
+
+func TestExport_Errors(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, testData)
+
+	var buf bytes.Buffer
+	err := sbom.Export(ctx, gqlClient, sbom.Subject{Purl: "pkg:guac/missing@1.0"}, sbom.FormatSPDX, &buf)
+	if !errors.Is(err, sbom.ErrSubjectNotFound) {
+		t.Errorf("Export() error = %v, want %v", err, sbom.ErrSubjectNotFound)
+	}
+	if err := sbom.Export(ctx, gqlClient, sbom.Subject{Purl: "pkg:guac/app@1.0"}, "swid", &buf); err == nil {
+		t.Errorf("Export() with an unknown format did not return an error")
+	}
+	if err := sbom.Export(ctx, gqlClient, sbom.Subject{}, sbom.FormatSPDX, &buf); err == nil {
+		t.Errorf("Export() without a subject did not return an error")
+	}
+}
+
+func sortedValues(m map[string]string) []string {
+	var values []string
+	for _, v := range m {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return values
+}
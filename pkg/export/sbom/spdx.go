@@ -0,0 +1,165 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	spdxjson "github.com/spdx/tools-golang/json"
+	spdx_common "github.com/spdx/tools-golang/spdx/v2/common"
+	spdx "github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+const (
+	spdxNoAssertion = "NOASSERTION"
+	spdxNamespace   = "https://guac.sh/spdxdocs/"
+)
+
+// spdxInvalidIDChars matches the characters that are not allowed in an SPDX
+// identifier.
+var spdxInvalidIDChars = regexp.MustCompile(`[^A-Za-z0-9.-]`)
+
+var spdxChecksumAlgorithms = map[string]spdx_common.ChecksumAlgorithm{
+	"md5":         spdx_common.MD5,
+	"sha1":        spdx_common.SHA1,
+	"sha224":      spdx_common.SHA224,
+	"sha256":      spdx_common.SHA256,
+	"sha384":      spdx_common.SHA384,
+	"sha512":      spdx_common.SHA512,
+	"sha3-256":    spdx_common.SHA3_256,
+	"sha3-384":    spdx_common.SHA3_384,
+	"sha3-512":    spdx_common.SHA3_512,
+	"blake2b-256": spdx_common.BLAKE2b_256,
+	"blake2b-384": spdx_common.BLAKE2b_384,
+	"blake2b-512": spdx_common.BLAKE2b_512,
+	"blake3":      spdx_common.BLAKE3,
+}
+
+// ToSPDX converts g to an SPDX 2.3 document.
+func ToSPDX(g *Graph, created time.Time) *spdx.Document {
+	name := g.Subject.String()
+	doc := &spdx.Document{
+		SPDXVersion:       spdx.Version,
+		DataLicense:       spdx.DataLicense,
+		SPDXIdentifier:    "DOCUMENT",
+		DocumentName:      name,
+		DocumentNamespace: spdxNamespace + spdxInvalidIDChars.ReplaceAllString(name, "-") + "-" + uuid.NewString(),
+		CreationInfo: &spdx.CreationInfo{
+			Creators: []spdx_common.Creator{{CreatorType: "Tool", Creator: "guac"}},
+			Created:  created.UTC().Format(time.RFC3339),
+		},
+	}
+
+	ids := map[string]spdx_common.ElementID{}
+	for _, comp := range g.Components {
+		ids[comp.ID] = spdxElementID(comp)
+	}
+	seenLicenses := map[string]bool{}
+	for _, comp := range g.Components {
+		doc.Packages = append(doc.Packages, spdxPackage(comp, ids[comp.ID]))
+		for _, l := range comp.Licenses {
+			if l.Inline == "" || !strings.HasPrefix(l.Name, "LicenseRef-") || seenLicenses[l.Name] {
+				continue
+			}
+			seenLicenses[l.Name] = true
+			doc.OtherLicenses = append(doc.OtherLicenses, &spdx.OtherLicense{
+				LicenseIdentifier: l.Name,
+				ExtractedText:     l.Inline,
+			})
+		}
+	}
+
+	for _, root := range g.Roots {
+		doc.Relationships = append(doc.Relationships, &spdx.Relationship{
+			RefA:         spdx_common.MakeDocElementID("", "DOCUMENT"),
+			RefB:         spdx_common.MakeDocElementID("", string(ids[root.ID])),
+			Relationship: spdx_common.TypeRelationshipDescribe,
+		})
+	}
+	for _, comp := range g.Components {
+		for _, to := range g.Contains[comp.ID] {
+			doc.Relationships = append(doc.Relationships, spdxRelationship(ids[comp.ID], ids[to], spdx_common.TypeRelationshipContains))
+		}
+		for _, to := range g.DependsOn[comp.ID] {
+			doc.Relationships = append(doc.Relationships, spdxRelationship(ids[comp.ID], ids[to], spdx_common.TypeRelationshipDependsOn))
+		}
+	}
+	return doc
+}
+
+// WriteSPDX writes g as an SPDX 2.3 JSON document.
+func WriteSPDX(g *Graph, w io.Writer) error {
+	if err := spdxjson.Write(ToSPDX(g, time.Now()), w, spdxjson.Indent("  ")); err != nil {
+		return fmt.Errorf("failed to write SPDX document: %w", err)
+	}
+	return nil
+}
+
+func spdxElementID(comp *Component) spdx_common.ElementID {
+	prefix := "Package-"
+	if comp.IsArtifact {
+		prefix = "Artifact-"
+	}
+	return spdx_common.ElementID(prefix + spdxInvalidIDChars.ReplaceAllString(comp.ID, "-"))
+}
+
+func spdxPackage(comp *Component, id spdx_common.ElementID) *spdx.Package {
+	p := &spdx.Package{
+		PackageName:             comp.Name,
+		PackageSPDXIdentifier:   id,
+		PackageVersion:          comp.Version,
+		PackageDownloadLocation: spdxNoAssertion,
+		PackageLicenseConcluded: spdxNoAssertion,
+		PackageLicenseDeclared:  spdxNoAssertion,
+		PackageCopyrightText:    spdxNoAssertion,
+	}
+	if comp.Purl != "" {
+		p.PackageExternalReferences = append(p.PackageExternalReferences, &spdx.PackageExternalReference{
+			Category: spdx_common.CategoryPackageManager,
+			RefType:  spdx_common.TypePackageManagerPURL,
+			Locator:  comp.Purl,
+		})
+	}
+	for _, h := range comp.Hashes {
+		if alg, ok := spdxChecksumAlgorithms[strings.ToLower(h.Algorithm)]; ok {
+			p.PackageChecksums = append(p.PackageChecksums, spdx_common.Checksum{Algorithm: alg, Value: h.Digest})
+		}
+	}
+	if comp.DeclaredLicense != "" {
+		p.PackageLicenseDeclared = comp.DeclaredLicense
+	}
+	if comp.DiscoveredLicense != "" {
+		p.PackageLicenseConcluded = comp.DiscoveredLicense
+	}
+	if len(comp.SourceURIs) > 0 {
+		p.PackageDownloadLocation = comp.SourceURIs[0]
+		p.PackageSourceInfo = "source found at " + strings.Join(comp.SourceURIs, ", ")
+	}
+	return p
+}
+
+func spdxRelationship(from, to spdx_common.ElementID, relationship string) *spdx.Relationship {
+	return &spdx.Relationship{
+		RefA:         spdx_common.MakeDocElementID("", string(from)),
+		RefB:         spdx_common.MakeDocElementID("", string(to)),
+		Relationship: relationship,
+	}
+}
@@ -0,0 +1,350 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vex
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/export/sbom"
+)
+
+// The types below are the subset of CSAF 2.0 that the VEX profile needs. The
+// CSAF types of github.com/openvex/go-vex only support reading documents.
+
+// CSAF is a CSAF 2.0 document.
+type CSAF struct {
+	Document        CSAFDocument        `json:"document"`
+	ProductTree     CSAFProductTree     `json:"product_tree"`
+	Vulnerabilities []CSAFVulnerability `json:"vulnerabilities"`
+}
+
+// CSAFDocument is the document metadata of a CSAF document.
+type CSAFDocument struct {
+	Category    string        `json:"category"`
+	CSAFVersion string        `json:"csaf_version"`
+	Title       string        `json:"title"`
+	Publisher   CSAFPublisher `json:"publisher"`
+	Tracking    CSAFTracking  `json:"tracking"`
+}
+
+// CSAFPublisher is the publisher of a CSAF document.
+type CSAFPublisher struct {
+	Category  string `json:"category"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// CSAFTracking is the tracking metadata of a CSAF document.
+type CSAFTracking struct {
+	ID                 string         `json:"id"`
+	Status             string         `json:"status"`
+	Version            string         `json:"version"`
+	InitialReleaseDate time.Time      `json:"initial_release_date"`
+	CurrentReleaseDate time.Time      `json:"current_release_date"`
+	RevisionHistory    []CSAFRevision `json:"revision_history"`
+}
+
+// CSAFRevision is an entry of the revision history.
+type CSAFRevision struct {
+	Date    time.Time `json:"date"`
+	Number  string    `json:"number"`
+	Summary string    `json:"summary"`
+}
+
+// CSAFProductTree lists the products that the vulnerabilities refer to.
+type CSAFProductTree struct {
+	FullProductNames []CSAFProduct      `json:"full_product_names"`
+	Relationships    []CSAFRelationship `json:"relationships,omitempty"`
+}
+
+// CSAFProduct is a product of the product tree.
+type CSAFProduct struct {
+	Name                 string                  `json:"name"`
+	ProductID            string                  `json:"product_id"`
+	IdentificationHelper *CSAFIdentificationHelp `json:"product_identification_helper,omitempty"`
+}
+
+// CSAFIdentificationHelp identifies a product by purl or hashes.
+type CSAFIdentificationHelp struct {
+	Purl   string           `json:"purl,omitempty"`
+	Hashes []CSAFFileHashes `json:"hashes,omitempty"`
+}
+
+// CSAFFileHashes are the hashes of a file of a product.
+type CSAFFileHashes struct {
+	FileHashes []CSAFHash `json:"file_hashes"`
+	Filename   string     `json:"filename"`
+}
+
+// CSAFHash is a file hash.
+type CSAFHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
+
+// CSAFRelationship combines two products, such as a component of a product.
+type CSAFRelationship struct {
+	Category                  string      `json:"category"`
+	FullProductName           CSAFProduct `json:"full_product_name"`
+	ProductReference          string      `json:"product_reference"`
+	RelatesToProductReference string      `json:"relates_to_product_reference"`
+}
+
+// CSAFVulnerability is the status of a vulnerability in the products.
+type CSAFVulnerability struct {
+	CVE           string              `json:"cve,omitempty"`
+	IDs           []CSAFTrackingID    `json:"ids,omitempty"`
+	Notes         []CSAFNote          `json:"notes"`
+	ProductStatus map[string][]string `json:"product_status"`
+	Flags         []CSAFFlag          `json:"flags,omitempty"`
+	Threats       []CSAFThreat        `json:"threats,omitempty"`
+	Remediations  []CSAFRemediation   `json:"remediations,omitempty"`
+}
+
+// CSAFTrackingID is the ID of a vulnerability that is not a CVE.
+type CSAFTrackingID struct {
+	SystemName string `json:"system_name"`
+	Text       string `json:"text"`
+}
+
+// CSAFNote is a note about a vulnerability.
+type CSAFNote struct {
+	Category string `json:"category"`
+	Text     string `json:"text"`
+}
+
+// CSAFFlag is the justification of a known_not_affected status.
+type CSAFFlag struct {
+	Label      string   `json:"label"`
+	ProductIDs []string `json:"product_ids"`
+}
+
+// CSAFThreat is the impact statement of a known_not_affected status.
+type CSAFThreat struct {
+	Category   string   `json:"category"`
+	Details    string   `json:"details"`
+	ProductIDs []string `json:"product_ids"`
+}
+
+// CSAFRemediation is the action statement of a known_affected status.
+type CSAFRemediation struct {
+	Category   string   `json:"category"`
+	Details    string   `json:"details"`
+	ProductIDs []string `json:"product_ids"`
+}
+
+// csafProductStatuses are the product_status groups of each VEX status.
+var csafProductStatuses = map[model.VexStatus]string{
+	model.VexStatusNotAffected:        "known_not_affected",
+	model.VexStatusAffected:           "known_affected",
+	model.VexStatusFixed:              "fixed",
+	model.VexStatusUnderInvestigation: "under_investigation",
+}
+
+// csafFlagLabels are the flag labels of each justification, which CSAF
+// names like OpenVEX.
+var csafFlagLabels = map[model.VexJustification]string{
+	model.VexJustificationComponentNotPresent:                         "component_not_present",
+	model.VexJustificationVulnerableCodeNotPresent:                    "vulnerable_code_not_present",
+	model.VexJustificationVulnerableCodeNotInExecutePath:              "vulnerable_code_not_in_execute_path",
+	model.VexJustificationVulnerableCodeCannotBeControlledByAdversary: "vulnerable_code_cannot_be_controlled_by_adversary",
+	model.VexJustificationInlineMitigationsAlreadyExist:               "inline_mitigations_already_exist",
+}
+
+var csafHashAlgorithms = map[string]string{
+	"md5":    "md5",
+	"sha1":   "sha1",
+	"sha256": "sha256",
+	"sha384": "sha384",
+	"sha512": "sha512",
+}
+
+// ToCSAF converts doc to a CSAF 2.0 document with the VEX profile.
+func ToCSAF(doc *Document, author string, created time.Time) (*CSAF, error) {
+	created = created.UTC()
+	c := &CSAF{
+		Document: CSAFDocument{
+			Category:    "csaf_vex",
+			CSAFVersion: "2.0",
+			Title:       "VEX for " + doc.Subject.String(),
+			Publisher: CSAFPublisher{
+				Category:  "other",
+				Name:      documentAuthor(author),
+				Namespace: "https://guac.sh",
+			},
+			Tracking: CSAFTracking{
+				ID:                 "guac-vex-" + uuid.NewString(),
+				Status:             "final",
+				Version:            "1",
+				InitialReleaseDate: created,
+				CurrentReleaseDate: created,
+				RevisionHistory:    []CSAFRevision{{Date: created, Number: "1", Summary: "Generated by GUAC"}},
+			},
+		},
+		Vulnerabilities: []CSAFVulnerability{},
+	}
+
+	// product IDs are assigned in the order in which products are first used
+	productIDs := map[string]string{}
+	nextID := func() string {
+		return fmt.Sprintf("CSAFPID-%04d", len(productIDs)+1)
+	}
+	productID := func(comp *sbom.Component) string {
+		if id, ok := productIDs[comp.ID]; ok {
+			return id
+		}
+		id := nextID()
+		productIDs[comp.ID] = id
+		c.ProductTree.FullProductNames = append(c.ProductTree.FullProductNames, csafProduct(comp, id))
+		return id
+	}
+	statementProductID := func(s Statement) string {
+		product := productID(s.Product)
+		if s.Subcomponent == nil {
+			return product
+		}
+		sub := productID(s.Subcomponent)
+		key := s.Subcomponent.ID + "/" + s.Product.ID
+		if id, ok := productIDs[key]; ok {
+			return id
+		}
+		id := nextID()
+		productIDs[key] = id
+		c.ProductTree.Relationships = append(c.ProductTree.Relationships, CSAFRelationship{
+			Category: "default_component_of",
+			FullProductName: CSAFProduct{
+				Name:      s.Subcomponent.Name + " as a component of " + s.Product.Name,
+				ProductID: id,
+			},
+			ProductReference:          sub,
+			RelatesToProductReference: product,
+		})
+		return id
+	}
+
+	vulnIndex := map[string]int{}
+	for _, s := range doc.Statements {
+		group, ok := csafProductStatuses[s.Status]
+		if !ok {
+			return nil, fmt.Errorf("statement %s has an unknown status %q", s.ID, s.Status)
+		}
+		i, ok := vulnIndex[s.Vulnerability]
+		if !ok {
+			i = len(c.Vulnerabilities)
+			vulnIndex[s.Vulnerability] = i
+			c.Vulnerabilities = append(c.Vulnerabilities, newCSAFVulnerability(s.Vulnerability))
+		}
+		vuln := &c.Vulnerabilities[i]
+		id := statementProductID(s)
+		vuln.ProductStatus[group] = appendUnique(vuln.ProductStatus[group], id)
+
+		switch s.Status {
+		case model.VexStatusNotAffected:
+			// the VEX profile requires a flag or an impact statement
+			label, hasLabel := csafFlagLabels[s.Justification]
+			if hasLabel {
+				vuln.Flags = append(vuln.Flags, CSAFFlag{Label: label, ProductIDs: []string{id}})
+			}
+			details := s.Statement
+			if details == "" && !hasLabel {
+				details = noImpactStatement
+			}
+			if details != "" {
+				vuln.Threats = append(vuln.Threats, CSAFThreat{Category: "impact", Details: details, ProductIDs: []string{id}})
+			}
+		case model.VexStatusAffected:
+			details := s.Statement
+			if details == "" {
+				details = noActionStatement
+			}
+			vuln.Remediations = append(vuln.Remediations, CSAFRemediation{Category: "mitigation", Details: details, ProductIDs: []string{id}})
+		}
+	}
+	return c, nil
+}
+
+// WriteCSAF writes doc as a CSAF 2.0 VEX JSON document.
+func WriteCSAF(doc *Document, author string, w io.Writer) error {
+	c, err := ToCSAF(doc, author, time.Now())
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(c); err != nil {
+		return fmt.Errorf("failed to write CSAF document: %w", err)
+	}
+	return nil
+}
+
+func newCSAFVulnerability(name string) CSAFVulnerability {
+	v := CSAFVulnerability{
+		Notes:         []CSAFNote{{Category: "description", Text: "Status of " + name + " as recorded in GUAC."}},
+		ProductStatus: map[string][]string{},
+	}
+	if strings.HasPrefix(strings.ToLower(name), "cve-") {
+		v.CVE = strings.ToUpper(name)
+	} else {
+		v.IDs = []CSAFTrackingID{{SystemName: vulnerabilitySystem(name), Text: name}}
+	}
+	return v
+}
+
+// vulnerabilitySystem guesses the database that issued a vulnerability ID
+// from its prefix.
+func vulnerabilitySystem(name string) string {
+	if prefix, _, found := strings.Cut(name, "-"); found {
+		return strings.ToUpper(prefix)
+	}
+	return "unknown"
+}
+
+func csafProduct(comp *sbom.Component, id string) CSAFProduct {
+	p := CSAFProduct{Name: comp.Name, ProductID: id}
+	if comp.Version != "" {
+		p.Name += " " + comp.Version
+	}
+	helper := &CSAFIdentificationHelp{Purl: comp.Purl}
+	var hashes []CSAFHash
+	for _, h := range comp.Hashes {
+		if alg, ok := csafHashAlgorithms[strings.ToLower(h.Algorithm)]; ok {
+			hashes = append(hashes, CSAFHash{Algorithm: alg, Value: h.Digest})
+		}
+	}
+	if len(hashes) > 0 {
+		helper.Hashes = []CSAFFileHashes{{FileHashes: hashes, Filename: comp.Name}}
+	}
+	if helper.Purl != "" || len(helper.Hashes) > 0 {
+		p.IdentificationHelper = helper
+	}
+	return p
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, e := range list {
+		if e == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
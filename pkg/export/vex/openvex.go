@@ -0,0 +1,170 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vex
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/export/sbom"
+	"github.com/openvex/go-vex/pkg/vex"
+)
+
+const (
+	// noImpactStatement and noActionStatement fill in the statements that
+	// OpenVEX requires when the graph does not record them.
+	noImpactStatement = "No impact statement was recorded for this product."
+	noActionStatement = "No action statement was recorded for this product."
+)
+
+var (
+	openVEXStatuses = map[model.VexStatus]vex.Status{
+		model.VexStatusNotAffected:        vex.StatusNotAffected,
+		model.VexStatusAffected:           vex.StatusAffected,
+		model.VexStatusFixed:              vex.StatusFixed,
+		model.VexStatusUnderInvestigation: vex.StatusUnderInvestigation,
+	}
+	openVEXJustifications = map[model.VexJustification]vex.Justification{
+		model.VexJustificationComponentNotPresent:                         vex.ComponentNotPresent,
+		model.VexJustificationVulnerableCodeNotPresent:                    vex.VulnerableCodeNotPresent,
+		model.VexJustificationVulnerableCodeNotInExecutePath:              vex.VulnerableCodeNotInExecutePath,
+		model.VexJustificationVulnerableCodeCannotBeControlledByAdversary: vex.VulnerableCodeCannotBeControlledByAdversary,
+		model.VexJustificationInlineMitigationsAlreadyExist:               vex.InlineMitigationsAlreadyExist,
+	}
+	openVEXHashAlgorithms = map[string]vex.Algorithm{
+		"md5":         vex.MD5,
+		"sha1":        vex.SHA1,
+		"sha256":      vex.SHA256,
+		"sha384":      vex.SHA384,
+		"sha512":      vex.SHA512,
+		"sha3-224":    vex.SHA3224,
+		"sha3-256":    vex.SHA3256,
+		"sha3-384":    vex.SHA3384,
+		"sha3-512":    vex.SHA3512,
+		"blake2b-256": vex.BLAKE2B256,
+		"blake2b-512": vex.BLAKE2B512,
+		"blake3":      vex.BLAKE3,
+	}
+)
+
+// ToOpenVEX converts doc to an OpenVEX document. The statements are validated
+// against the OpenVEX specification.
+func ToOpenVEX(doc *Document, author string, created time.Time) (*vex.VEX, error) {
+	v := vex.New()
+	v.Author = documentAuthor(author)
+	v.Timestamp = &created
+
+	for _, s := range doc.Statements {
+		status, ok := openVEXStatuses[s.Status]
+		if !ok {
+			return nil, fmt.Errorf("statement %s has an unknown status %q", s.ID, s.Status)
+		}
+		timestamp := s.Timestamp
+		stmt := vex.Statement{
+			Vulnerability: openVEXVulnerability(s.Vulnerability),
+			Timestamp:     &timestamp,
+			Products:      []vex.Product{openVEXProduct(s.Product, s.Subcomponent)},
+			Status:        status,
+			StatusNotes:   s.StatusNotes,
+		}
+		switch status {
+		case vex.StatusNotAffected:
+			stmt.Justification = openVEXJustifications[s.Justification]
+			stmt.ImpactStatement = s.Statement
+			if stmt.Justification == "" && stmt.ImpactStatement == "" {
+				stmt.ImpactStatement = noImpactStatement
+			}
+		case vex.StatusAffected:
+			stmt.ActionStatement = s.Statement
+			if stmt.ActionStatement == "" {
+				stmt.ActionStatement = noActionStatement
+			}
+		}
+		if err := stmt.Validate(); err != nil {
+			return nil, fmt.Errorf("statement %s is not valid OpenVEX: %w", s.ID, err)
+		}
+		v.Statements = append(v.Statements, stmt)
+	}
+
+	if _, err := v.GenerateCanonicalID(); err != nil {
+		return nil, fmt.Errorf("failed to generate the document ID: %w", err)
+	}
+	return &v, nil
+}
+
+// WriteOpenVEX writes doc as an OpenVEX JSON document.
+func WriteOpenVEX(doc *Document, author string, w io.Writer) error {
+	v, err := ToOpenVEX(doc, author, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := v.ToJSON(w); err != nil {
+		return fmt.Errorf("failed to write OpenVEX document: %w", err)
+	}
+	return nil
+}
+
+// openVEXVulnerability names the vulnerability by its canonical ID, e.g.
+// CVE-2023-1234, and keeps the lower case ID that GUAC records as an alias.
+func openVEXVulnerability(id string) vex.Vulnerability {
+	v := vex.Vulnerability{Name: vex.VulnerabilityID(canonicalVulnerabilityID(id))}
+	if string(v.Name) != id {
+		v.Aliases = []vex.VulnerabilityID{vex.VulnerabilityID(id)}
+	}
+	return v
+}
+
+// canonicalVulnerabilityID returns id in the case used by the database that
+// issued it. GUAC stores vulnerability IDs in lower case, while the databases
+// upper case their prefix: cve-2023-1234 is CVE-2023-1234 and
+// ghsa-h45f-rjvw-2rv2 is GHSA-h45f-rjvw-2rv2.
+func canonicalVulnerabilityID(id string) string {
+	prefix, rest, found := strings.Cut(id, "-")
+	if !found {
+		return id
+	}
+	return strings.ToUpper(prefix) + "-" + rest
+}
+
+func openVEXProduct(product, sub *sbom.Component) vex.Product {
+	p := vex.Product{Component: openVEXComponent(product)}
+	if sub != nil {
+		p.Subcomponents = []vex.Subcomponent{{Component: openVEXComponent(sub)}}
+	}
+	return p
+}
+
+func openVEXComponent(comp *sbom.Component) vex.Component {
+	c := vex.Component{ID: comp.Name}
+	if comp.Purl != "" {
+		c.ID = comp.Purl
+		c.Identifiers = map[vex.IdentifierType]string{vex.PURL: comp.Purl}
+	}
+	for _, h := range comp.Hashes {
+		alg, ok := openVEXHashAlgorithms[strings.ToLower(h.Algorithm)]
+		if !ok {
+			continue
+		}
+		if c.Hashes == nil {
+			c.Hashes = map[vex.Algorithm]vex.Hash{}
+		}
+		c.Hashes[alg] = vex.Hash(h.Digest)
+	}
+	return c
+}
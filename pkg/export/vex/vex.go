@@ -0,0 +1,244 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vex generates VEX documents from the CertifyVEXStatement and
+// CertifyVuln nodes of the GUAC graph. The statements of a product package or
+// artifact, and of the software it depends on, are emitted as they are, and
+// every CertifyVuln that no statement covers yet is emitted as
+// under_investigation.
+package vex
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/export/sbom"
+)
+
+// Subject is the product package or artifact that a VEX document is
+// generated for.
+type Subject = sbom.Subject
+
+// ErrSubjectNotFound is returned when the product is not in the graph.
+var ErrSubjectNotFound = sbom.ErrSubjectNotFound
+
+// DefaultAuthor is the author of generated documents when none is given.
+const DefaultAuthor = "GUAC"
+
+// Format is a VEX document format.
+type Format string
+
+const (
+	// FormatOpenVEX is an OpenVEX JSON document.
+	FormatOpenVEX Format = "openvex"
+	// FormatCSAF is a CSAF 2.0 JSON document with the VEX profile.
+	FormatCSAF Format = "csaf"
+)
+
+// Formats lists the supported formats.
+var Formats = []Format{FormatOpenVEX, FormatCSAF}
+
+// ParseFormat returns the format named s.
+func ParseFormat(s string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported VEX format %q, valid formats are: %v", s, Formats)
+}
+
+// Statement is the status of a vulnerability in a product, or in a
+// subcomponent of the product.
+type Statement struct {
+	// ID is the ID of the CertifyVEXStatement node, or of the CertifyVuln
+	// node for generated under_investigation statements.
+	ID            string
+	Vulnerability string
+	Product       *sbom.Component
+	// Subcomponent is the dependency of Product that the statement is about,
+	// or nil if it is about the product itself.
+	Subcomponent  *sbom.Component
+	Status        model.VexStatus
+	Justification model.VexJustification
+	// Statement is the impact statement of not_affected statements, or the
+	// action statement of affected ones.
+	Statement   string
+	StatusNotes string
+	Timestamp   time.Time
+}
+
+// Document is the set of statements about a product.
+type Document struct {
+	Subject    Subject
+	Products   []*sbom.Component
+	Statements []Statement
+}
+
+// Collect gathers the VEX statements about the product and its dependencies,
+// and adds an under_investigation statement for each CertifyVuln of them that
+// no statement covers.
+func Collect(ctx context.Context, gqlClient graphql.Client, subject Subject) (*Document, error) {
+	g, err := sbom.Walk(ctx, gqlClient, subject)
+	if err != nil {
+		return nil, err
+	}
+	doc := &Document{Subject: subject, Products: g.Roots}
+	roots := map[string]bool{}
+	for _, root := range g.Roots {
+		roots[root.ID] = true
+	}
+
+	// covered records the vulnerabilities with a statement, per component
+	covered := map[string]map[string]bool{}
+	cover := func(compID, vuln string) {
+		if covered[compID] == nil {
+			covered[compID] = map[string]bool{}
+		}
+		covered[compID][vuln] = true
+	}
+
+	for _, comp := range g.Components {
+		spec := model.PackageOrArtifactSpec{Package: &model.PkgSpec{Id: &comp.ID}}
+		if comp.IsArtifact {
+			spec = model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{Id: &comp.ID}}
+		}
+		resp, err := model.VEXStatements(ctx, gqlClient, model.CertifyVEXStatementSpec{Subject: &spec})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query VEX statements of %s: %w", comp.Name, err)
+		}
+		for _, s := range resp.CertifyVEXStatement {
+			vuln := vulnerabilityID(s.Vulnerability.AllVulnerabilityTree)
+			cover(comp.ID, vuln)
+			for _, product := range productsOf(comp, g.Roots, roots) {
+				doc.Statements = append(doc.Statements, Statement{
+					ID:            s.Id,
+					Vulnerability: vuln,
+					Product:       product,
+					Subcomponent:  subcomponent(comp, product),
+					Status:        s.Status,
+					Justification: s.VexJustification,
+					Statement:     s.Statement,
+					StatusNotes:   s.StatusNotes,
+					Timestamp:     s.KnownSince,
+				})
+			}
+		}
+	}
+
+	for _, comp := range g.Components {
+		if comp.IsArtifact {
+			continue
+		}
+		noVuln := false
+		resp, err := model.CertifyVuln(ctx, gqlClient, model.CertifyVulnSpec{
+			Package:       &model.PkgSpec{Id: &comp.ID},
+			Vulnerability: &model.VulnerabilitySpec{NoVuln: &noVuln},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query vulnerabilities of %s: %w", comp.Purl, err)
+		}
+		for _, cv := range resp.CertifyVuln {
+			vuln := vulnerabilityID(cv.Vulnerability.AllVulnerabilityTree)
+			for _, product := range productsOf(comp, g.Roots, roots) {
+				// a statement about the product covers all its subcomponents
+				if covered[comp.ID][vuln] || covered[product.ID][vuln] {
+					continue
+				}
+				doc.Statements = append(doc.Statements, Statement{
+					ID:            cv.Id,
+					Vulnerability: vuln,
+					Product:       product,
+					Subcomponent:  subcomponent(comp, product),
+					Status:        model.VexStatusUnderInvestigation,
+					Timestamp:     cv.Metadata.TimeScanned,
+				})
+			}
+			// only one statement per vulnerability, even with several scans
+			cover(comp.ID, vuln)
+		}
+	}
+
+	sort.SliceStable(doc.Statements, func(i, j int) bool {
+		a, b := doc.Statements[i], doc.Statements[j]
+		if a.Vulnerability != b.Vulnerability {
+			return a.Vulnerability < b.Vulnerability
+		}
+		if a.Product.Name != b.Product.Name {
+			return a.Product.Name < b.Product.Name
+		}
+		return subcomponentName(a) < subcomponentName(b)
+	})
+	return doc, nil
+}
+
+// Export generates the VEX document of subject and writes it to w in format
+// f.
+func Export(ctx context.Context, gqlClient graphql.Client, subject Subject, f Format, author string, w io.Writer) error {
+	if _, err := ParseFormat(string(f)); err != nil {
+		return err
+	}
+	doc, err := Collect(ctx, gqlClient, subject)
+	if err != nil {
+		return err
+	}
+	if f == FormatCSAF {
+		return WriteCSAF(doc, author, w)
+	}
+	return WriteOpenVEX(doc, author, w)
+}
+
+// productsOf returns the products that comp is part of: comp itself if it is
+// a product, or else all the products.
+func productsOf(comp *sbom.Component, products []*sbom.Component, isProduct map[string]bool) []*sbom.Component {
+	if isProduct[comp.ID] {
+		return []*sbom.Component{comp}
+	}
+	return products
+}
+
+func subcomponent(comp, product *sbom.Component) *sbom.Component {
+	if comp.ID == product.ID {
+		return nil
+	}
+	return comp
+}
+
+func subcomponentName(s Statement) string {
+	if s.Subcomponent == nil {
+		return ""
+	}
+	return s.Subcomponent.Name + "@" + s.Subcomponent.Version
+}
+
+func vulnerabilityID(v model.AllVulnerabilityTree) string {
+	if len(v.VulnerabilityIDs) == 0 {
+		return v.Type
+	}
+	return v.VulnerabilityIDs[0].VulnerabilityID
+}
+
+// documentAuthor returns author, or DefaultAuthor if it is empty.
+func documentAuthor(author string) string {
+	if author == "" {
+		return DefaultAuthor
+	}
+	return author
+}
@@ -0,0 +1,155 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vex_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/export/vex"
+	"github.com/guacsec/guac/pkg/logging"
+	openvex "github.com/openvex/go-vex/pkg/vex"
+)
+
+var testData = GuacData{
+	Packages:        []string{"pkg:guac/app@1.0", "pkg:guac/lib@1.0"},
+	Vulnerabilities: []string{"osv/osv-2024-1", "cve/cve-2024-2", "cve/cve-2024-3"},
+	IsDependencies:  []IsDependency{{DependentPkg: "pkg:guac/app@1.0", DependencyPkg: "pkg:guac/lib@1.0"}},
+	CertifyVulns: []CertifyVuln{
+		{Package: "pkg:guac/lib@1.0", Vulnerability: "osv/osv-2024-1"},
+		// covered by the statement about app
+		{Package: "pkg:guac/lib@1.0", Vulnerability: "cve/cve-2024-2"},
+	},
+	VexStatements: []VexStatement{
+		{Subject: "pkg:guac/app@1.0", Vulnerability: "cve/cve-2024-2"},
+		{Subject: "pkg:guac/lib@1.0", Vulnerability: "cve/cve-2024-3"},
+	},
+}
+
+func TestExport_OpenVEX(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, testData)
+
+	var buf bytes.Buffer
+	if err := vex.Export(ctx, gqlClient, vex.Subject{Purl: "pkg:guac/app@1.0"}, vex.FormatOpenVEX, "", &buf); err != nil {
+		t.Fatalf("Export() returned unexpected error: %v", err)
+	}
+	doc, err := openvex.Parse(buf.Bytes())
+	if err != nil {
+		t.Fatalf("failed to parse exported OpenVEX document: %v", err)
+	}
+	if doc.Author != vex.DefaultAuthor {
+		t.Errorf("Author = %s, want %s", doc.Author, vex.DefaultAuthor)
+	}
+
+	var statements []string
+	for _, s := range doc.Statements {
+		if len(s.Products) != 1 {
+			t.Fatalf("statement about %s has %d products, want 1", s.Vulnerability.Name, len(s.Products))
+		}
+		product := s.Products[0]
+		line := string(s.Vulnerability.Name) + " " + product.ID
+		for _, alias := range s.Vulnerability.Aliases {
+			line += " alias:" + string(alias)
+		}
+		for _, sub := range product.Subcomponents {
+			line += " " + sub.ID
+		}
+		statements = append(statements, line+" "+string(s.Status))
+	}
+	want := []string{
+		"CVE-2024-2 pkg:guac/app@1.0 alias:cve-2024-2 not_affected",
+		"CVE-2024-3 pkg:guac/app@1.0 alias:cve-2024-3 pkg:guac/lib@1.0 not_affected",
+		"OSV-2024-1 pkg:guac/app@1.0 alias:osv-2024-1 pkg:guac/lib@1.0 under_investigation",
+	}
+	if diff := cmp.Diff(want, statements); diff != "" {
+		t.Errorf("unexpected statements (-want +got):\n%s", diff)
+	}
+}
+
+func TestExport_CSAF(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, testData)
+
+	var buf bytes.Buffer
+	if err := vex.Export(ctx, gqlClient, vex.Subject{Purl: "pkg:guac/app@1.0"}, vex.FormatCSAF, "ACME", &buf); err != nil {
+		t.Fatalf("Export() returned unexpected error: %v", err)
+	}
+	var doc vex.CSAF
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("failed to decode exported CSAF document: %v", err)
+	}
+	if doc.Document.Category != "csaf_vex" || doc.Document.Publisher.Name != "ACME" {
+		t.Errorf("unexpected document metadata: %+v", doc.Document)
+	}
+
+	products := map[string]string{}
+	for _, p := range doc.ProductTree.FullProductNames {
+		products[p.ProductID] = p.Name
+	}
+	for _, r := range doc.ProductTree.Relationships {
+		products[r.FullProductName.ProductID] = r.FullProductName.Name
+	}
+
+	var statuses []string
+	for _, v := range doc.Vulnerabilities {
+		name := v.CVE
+		if name == "" && len(v.IDs) > 0 {
+			name = v.IDs[0].Text
+		}
+		for group, ids := range v.ProductStatus {
+			for _, id := range ids {
+				statuses = append(statuses, name+" "+group+" "+products[id])
+			}
+		}
+		if _, ok := v.ProductStatus["known_not_affected"]; ok && len(v.Flags) == 0 && len(v.Threats) == 0 {
+			t.Errorf("%s is known_not_affected without a flag or an impact statement", name)
+		}
+	}
+	sort.Strings(statuses)
+	want := []string{
+		"CVE-2024-2 known_not_affected app 1.0",
+		"CVE-2024-3 known_not_affected lib as a component of app",
+		"osv-2024-1 under_investigation lib as a component of app",
+	}
+	if diff := cmp.Diff(want, statuses); diff != "" {
+		t.Errorf("unexpected product statuses (-want +got):\n%s", diff)
+	}
+}
+
+func TestExport_Errors(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, testData)
+
+	var buf bytes.Buffer
+	err := vex.Export(ctx, gqlClient, vex.Subject{Purl: "pkg:guac/missing@1.0"}, vex.FormatOpenVEX, "", &buf)
+	if !errors.Is(err, vex.ErrSubjectNotFound) {
+		t.Errorf("Export() error = %v, want %v", err, vex.ErrSubjectNotFound)
+	}
+	if err := vex.Export(ctx, gqlClient, vex.Subject{Purl: "pkg:guac/app@1.0"}, "cyclonedx", "", &buf); err == nil {
+		t.Errorf("Export() with an unknown format did not return an error")
+	}
+}
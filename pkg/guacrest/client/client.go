@@ -4,6 +4,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -98,16 +99,27 @@ type ClientInterface interface {
 	GetArtifactDeps(ctx context.Context, digest string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetArtifactVulns request
-	GetArtifactVulns(ctx context.Context, digest string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetArtifactVulns(ctx context.Context, digest string, params *GetArtifactVulnsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetPackagePurls request
-	GetPackagePurls(ctx context.Context, purl string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetPackagePurls(ctx context.Context, purl string, params *GetPackagePurlsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetPackageDeps request
 	GetPackageDeps(ctx context.Context, purl string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPackageSbom request
+	GetPackageSbom(ctx context.Context, purl string, params *GetPackageSbomParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPackageVex request
+	GetPackageVex(ctx context.Context, purl string, params *GetPackageVexParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetPackageVulns request
 	GetPackageVulns(ctx context.Context, purl string, params *GetPackageVulnsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EvaluatePolicyWithBody request with any body
+	EvaluatePolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EvaluatePolicy(ctx context.Context, body EvaluatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) AnalyzeDependencies(ctx context.Context, params *AnalyzeDependenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -146,8 +158,8 @@ func (c *Client) GetArtifactDeps(ctx context.Context, digest string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetArtifactVulns(ctx context.Context, digest string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetArtifactVulnsRequest(c.Server, digest)
+func (c *Client) GetArtifactVulns(ctx context.Context, digest string, params *GetArtifactVulnsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetArtifactVulnsRequest(c.Server, digest, params)
 	if err != nil {
 		return nil, err
 	}
@@ -158,8 +170,8 @@ func (c *Client) GetArtifactVulns(ctx context.Context, digest string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPackagePurls(ctx context.Context, purl string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPackagePurlsRequest(c.Server, purl)
+func (c *Client) GetPackagePurls(ctx context.Context, purl string, params *GetPackagePurlsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPackagePurlsRequest(c.Server, purl, params)
 	if err != nil {
 		return nil, err
 	}
@@ -182,6 +194,30 @@ func (c *Client) GetPackageDeps(ctx context.Context, purl string, reqEditors ...
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetPackageSbom(ctx context.Context, purl string, params *GetPackageSbomParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPackageSbomRequest(c.Server, purl, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPackageVex(ctx context.Context, purl string, params *GetPackageVexParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPackageVexRequest(c.Server, purl, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetPackageVulns(ctx context.Context, purl string, params *GetPackageVulnsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetPackageVulnsRequest(c.Server, purl, params)
 	if err != nil {
@@ -194,6 +230,30 @@ func (c *Client) GetPackageVulns(ctx context.Context, purl string, params *GetPa
 	return c.Client.Do(req)
 }
 
+func (c *Client) EvaluatePolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEvaluatePolicyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EvaluatePolicy(ctx context.Context, body EvaluatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEvaluatePolicyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewAnalyzeDependenciesRequest generates requests for AnalyzeDependencies
 func NewAnalyzeDependenciesRequest(server string, params *AnalyzeDependenciesParams) (*http.Request, error) {
 	var err error
@@ -317,7 +377,7 @@ func NewGetArtifactDepsRequest(server string, digest string) (*http.Request, err
 }
 
 // NewGetArtifactVulnsRequest generates requests for GetArtifactVulns
-func NewGetArtifactVulnsRequest(server string, digest string) (*http.Request, error) {
+func NewGetArtifactVulnsRequest(server string, digest string, params *GetArtifactVulnsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -342,6 +402,44 @@ func NewGetArtifactVulnsRequest(server string, digest string) (*http.Request, er
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PaginationSpec != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "paginationSpec", runtime.ParamLocationQuery, *params.PaginationSpec); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeDependencies != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeDependencies", runtime.ParamLocationQuery, *params.IncludeDependencies); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -351,7 +449,7 @@ func NewGetArtifactVulnsRequest(server string, digest string) (*http.Request, er
 }
 
 // NewGetPackagePurlsRequest generates requests for GetPackagePurls
-func NewGetPackagePurlsRequest(server string, purl string) (*http.Request, error) {
+func NewGetPackagePurlsRequest(server string, purl string, params *GetPackagePurlsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -376,6 +474,28 @@ func NewGetPackagePurlsRequest(server string, purl string) (*http.Request, error
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PaginationSpec != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "paginationSpec", runtime.ParamLocationQuery, *params.PaginationSpec); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -418,6 +538,118 @@ func NewGetPackageDepsRequest(server string, purl string) (*http.Request, error)
 	return req, nil
 }
 
+// NewGetPackageSbomRequest generates requests for GetPackageSbom
+func NewGetPackageSbomRequest(server string, purl string, params *GetPackageSbomParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "purl", runtime.ParamLocationPath, purl)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v0/package/%s/sbom", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPackageVexRequest generates requests for GetPackageVex
+func NewGetPackageVexRequest(server string, purl string, params *GetPackageVexParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "purl", runtime.ParamLocationPath, purl)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v0/package/%s/vex", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetPackageVulnsRequest generates requests for GetPackageVulns
 func NewGetPackageVulnsRequest(server string, purl string, params *GetPackageVulnsParams) (*http.Request, error) {
 	var err error
@@ -447,6 +679,22 @@ func NewGetPackageVulnsRequest(server string, purl string, params *GetPackageVul
 	if params != nil {
 		queryValues := queryURL.Query()
 
+		if params.PaginationSpec != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "paginationSpec", runtime.ParamLocationQuery, *params.PaginationSpec); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		if params.IncludeDependencies != nil {
 
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeDependencies", runtime.ParamLocationQuery, *params.IncludeDependencies); err != nil {
@@ -474,6 +722,46 @@ func NewGetPackageVulnsRequest(server string, purl string, params *GetPackageVul
 	return req, nil
 }
 
+// NewEvaluatePolicyRequest calls the generic EvaluatePolicy builder with application/json body
+func NewEvaluatePolicyRequest(server string, body EvaluatePolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEvaluatePolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewEvaluatePolicyRequestWithBody generates requests for EvaluatePolicy with any type of body
+func NewEvaluatePolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v0/policy/eval")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -527,16 +815,27 @@ type ClientWithResponsesInterface interface {
 	GetArtifactDepsWithResponse(ctx context.Context, digest string, reqEditors ...RequestEditorFn) (*GetArtifactDepsResponse, error)
 
 	// GetArtifactVulnsWithResponse request
-	GetArtifactVulnsWithResponse(ctx context.Context, digest string, reqEditors ...RequestEditorFn) (*GetArtifactVulnsResponse, error)
+	GetArtifactVulnsWithResponse(ctx context.Context, digest string, params *GetArtifactVulnsParams, reqEditors ...RequestEditorFn) (*GetArtifactVulnsResponse, error)
 
 	// GetPackagePurlsWithResponse request
-	GetPackagePurlsWithResponse(ctx context.Context, purl string, reqEditors ...RequestEditorFn) (*GetPackagePurlsResponse, error)
+	GetPackagePurlsWithResponse(ctx context.Context, purl string, params *GetPackagePurlsParams, reqEditors ...RequestEditorFn) (*GetPackagePurlsResponse, error)
 
 	// GetPackageDepsWithResponse request
 	GetPackageDepsWithResponse(ctx context.Context, purl string, reqEditors ...RequestEditorFn) (*GetPackageDepsResponse, error)
 
+	// GetPackageSbomWithResponse request
+	GetPackageSbomWithResponse(ctx context.Context, purl string, params *GetPackageSbomParams, reqEditors ...RequestEditorFn) (*GetPackageSbomResponse, error)
+
+	// GetPackageVexWithResponse request
+	GetPackageVexWithResponse(ctx context.Context, purl string, params *GetPackageVexParams, reqEditors ...RequestEditorFn) (*GetPackageVexResponse, error)
+
 	// GetPackageVulnsWithResponse request
 	GetPackageVulnsWithResponse(ctx context.Context, purl string, params *GetPackageVulnsParams, reqEditors ...RequestEditorFn) (*GetPackageVulnsResponse, error)
+
+	// EvaluatePolicyWithBodyWithResponse request with any body
+	EvaluatePolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EvaluatePolicyResponse, error)
+
+	EvaluatePolicyWithResponse(ctx context.Context, body EvaluatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*EvaluatePolicyResponse, error)
 }
 
 type AnalyzeDependenciesResponse struct {
@@ -686,6 +985,57 @@ func (r GetPackageDepsResponse) StatusCode() int {
 	return 0
 }
 
+type GetPackageSbomResponse struct {
+	Body                           []byte
+	HTTPResponse                   *http.Response
+	ApplicationspdxJSON200         *map[string]interface{}
+	ApplicationvndCyclonedxJSON200 *map[string]interface{}
+	JSON400                        *BadRequest
+	JSON500                        *InternalServerError
+	JSON502                        *BadGateway
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPackageSbomResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPackageSbomResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPackageVexResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON400      *BadRequest
+	JSON500      *InternalServerError
+	JSON502      *BadGateway
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPackageVexResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPackageVexResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetPackageVulnsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -711,6 +1061,31 @@ func (r GetPackageVulnsResponse) StatusCode() int {
 	return 0
 }
 
+type EvaluatePolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PolicyResult
+	JSON400      *BadRequest
+	JSON500      *InternalServerError
+	JSON502      *BadGateway
+}
+
+// Status returns HTTPResponse.Status
+func (r EvaluatePolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EvaluatePolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // AnalyzeDependenciesWithResponse request returning *AnalyzeDependenciesResponse
 func (c *ClientWithResponses) AnalyzeDependenciesWithResponse(ctx context.Context, params *AnalyzeDependenciesParams, reqEditors ...RequestEditorFn) (*AnalyzeDependenciesResponse, error) {
 	rsp, err := c.AnalyzeDependencies(ctx, params, reqEditors...)
@@ -739,8 +1114,8 @@ func (c *ClientWithResponses) GetArtifactDepsWithResponse(ctx context.Context, d
 }
 
 // GetArtifactVulnsWithResponse request returning *GetArtifactVulnsResponse
-func (c *ClientWithResponses) GetArtifactVulnsWithResponse(ctx context.Context, digest string, reqEditors ...RequestEditorFn) (*GetArtifactVulnsResponse, error) {
-	rsp, err := c.GetArtifactVulns(ctx, digest, reqEditors...)
+func (c *ClientWithResponses) GetArtifactVulnsWithResponse(ctx context.Context, digest string, params *GetArtifactVulnsParams, reqEditors ...RequestEditorFn) (*GetArtifactVulnsResponse, error) {
+	rsp, err := c.GetArtifactVulns(ctx, digest, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -748,8 +1123,8 @@ func (c *ClientWithResponses) GetArtifactVulnsWithResponse(ctx context.Context,
 }
 
 // GetPackagePurlsWithResponse request returning *GetPackagePurlsResponse
-func (c *ClientWithResponses) GetPackagePurlsWithResponse(ctx context.Context, purl string, reqEditors ...RequestEditorFn) (*GetPackagePurlsResponse, error) {
-	rsp, err := c.GetPackagePurls(ctx, purl, reqEditors...)
+func (c *ClientWithResponses) GetPackagePurlsWithResponse(ctx context.Context, purl string, params *GetPackagePurlsParams, reqEditors ...RequestEditorFn) (*GetPackagePurlsResponse, error) {
+	rsp, err := c.GetPackagePurls(ctx, purl, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -765,6 +1140,24 @@ func (c *ClientWithResponses) GetPackageDepsWithResponse(ctx context.Context, pu
 	return ParseGetPackageDepsResponse(rsp)
 }
 
+// GetPackageSbomWithResponse request returning *GetPackageSbomResponse
+func (c *ClientWithResponses) GetPackageSbomWithResponse(ctx context.Context, purl string, params *GetPackageSbomParams, reqEditors ...RequestEditorFn) (*GetPackageSbomResponse, error) {
+	rsp, err := c.GetPackageSbom(ctx, purl, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPackageSbomResponse(rsp)
+}
+
+// GetPackageVexWithResponse request returning *GetPackageVexResponse
+func (c *ClientWithResponses) GetPackageVexWithResponse(ctx context.Context, purl string, params *GetPackageVexParams, reqEditors ...RequestEditorFn) (*GetPackageVexResponse, error) {
+	rsp, err := c.GetPackageVex(ctx, purl, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPackageVexResponse(rsp)
+}
+
 // GetPackageVulnsWithResponse request returning *GetPackageVulnsResponse
 func (c *ClientWithResponses) GetPackageVulnsWithResponse(ctx context.Context, purl string, params *GetPackageVulnsParams, reqEditors ...RequestEditorFn) (*GetPackageVulnsResponse, error) {
 	rsp, err := c.GetPackageVulns(ctx, purl, params, reqEditors...)
@@ -774,6 +1167,23 @@ func (c *ClientWithResponses) GetPackageVulnsWithResponse(ctx context.Context, p
 	return ParseGetPackageVulnsResponse(rsp)
 }
 
+// EvaluatePolicyWithBodyWithResponse request with arbitrary body returning *EvaluatePolicyResponse
+func (c *ClientWithResponses) EvaluatePolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EvaluatePolicyResponse, error) {
+	rsp, err := c.EvaluatePolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEvaluatePolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) EvaluatePolicyWithResponse(ctx context.Context, body EvaluatePolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*EvaluatePolicyResponse, error) {
+	rsp, err := c.EvaluatePolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEvaluatePolicyResponse(rsp)
+}
+
 // ParseAnalyzeDependenciesResponse parses an HTTP response from a AnalyzeDependenciesWithResponse call
 func ParseAnalyzeDependenciesResponse(rsp *http.Response) (*AnalyzeDependenciesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1035,6 +1445,107 @@ func ParseGetPackageDepsResponse(rsp *http.Response) (*GetPackageDepsResponse, e
 	return response, nil
 }
 
+// ParseGetPackageSbomResponse parses an HTTP response from a GetPackageSbomWithResponse call
+func ParseGetPackageSbomResponse(rsp *http.Response) (*GetPackageSbomResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPackageSbomResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.Header.Get("Content-Type") == "application/spdx+json" && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationspdxJSON200 = &dest
+
+	case rsp.Header.Get("Content-Type") == "application/vnd.cyclonedx+json" && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationvndCyclonedxJSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest BadGateway
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPackageVexResponse parses an HTTP response from a GetPackageVexWithResponse call
+func ParseGetPackageVexResponse(rsp *http.Response) (*GetPackageVexResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPackageVexResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest BadGateway
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetPackageVulnsResponse parses an HTTP response from a GetPackageVulnsWithResponse call
 func ParseGetPackageVulnsResponse(rsp *http.Response) (*GetPackageVulnsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1081,3 +1592,50 @@ func ParseGetPackageVulnsResponse(rsp *http.Response) (*GetPackageVulnsResponse,
 
 	return response, nil
 }
+
+// ParseEvaluatePolicyResponse parses an HTTP response from a EvaluatePolicyWithResponse call
+func ParseEvaluatePolicyResponse(rsp *http.Response) (*EvaluatePolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EvaluatePolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest BadGateway
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
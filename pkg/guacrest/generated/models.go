@@ -13,6 +13,18 @@ const (
 	Scorecard AnalyzeDependenciesParamsSort = "scorecard"
 )
 
+// Defines values for GetPackageSbomParamsFormat.
+const (
+	Cyclonedx GetPackageSbomParamsFormat = "cyclonedx"
+	Spdx      GetPackageSbomParamsFormat = "spdx"
+)
+
+// Defines values for GetPackageVexParamsFormat.
+const (
+	Csaf    GetPackageVexParamsFormat = "csaf"
+	Openvex GetPackageVexParamsFormat = "openvex"
+)
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"Message"`
@@ -30,6 +42,31 @@ type PaginationInfo struct {
 	TotalCount *int    `json:"TotalCount,omitempty"`
 }
 
+// PolicyEvaluationRequest defines model for PolicyEvaluationRequest.
+type PolicyEvaluationRequest struct {
+	// Digest The artifact digest, in the format <algorithm:digest> or only the digest.
+	Digest *string `json:"digest,omitempty"`
+
+	// Policy The policy document, in YAML or JSON.
+	Policy string `json:"policy"`
+	Purl   *Purl  `json:"purl,omitempty"`
+}
+
+// PolicyRuleResult defines model for PolicyRuleResult.
+type PolicyRuleResult struct {
+	Pass       bool              `json:"pass"`
+	Rule       string            `json:"rule"`
+	Type       string            `json:"type"`
+	Violations []PolicyViolation `json:"violations"`
+}
+
+// PolicyViolation defines model for PolicyViolation.
+type PolicyViolation struct {
+	// Evidence The IDs of the graph nodes that caused the violation.
+	Evidence []string `json:"evidence"`
+	Message  string   `json:"message"`
+}
+
 // Purl defines model for Purl.
 type Purl = string
 
@@ -55,7 +92,7 @@ type Vulnerability struct {
 type VulnerabilityDetails struct {
 	Type *string `json:"type,omitempty"`
 
-	// VulnerabilityIDs A list of vulnerability identifiers. These can be CVE IDs or other  formats used to identify vulnerabilities.
+	// VulnerabilityIDs A list of vulnerability identifiers. These can be CVE IDs or other  formats used to identify vulnerabilities. The identifiers of the vulnerabilities recorded as equal to it by VulnEqual are included.
 	VulnerabilityIDs []string `json:"vulnerabilityIDs"`
 }
 
@@ -77,6 +114,14 @@ type InternalServerError = Error
 // PackageNameList defines model for PackageNameList.
 type PackageNameList = []PackageName
 
+// PolicyResult defines model for PolicyResult.
+type PolicyResult struct {
+	Pass    bool               `json:"pass"`
+	Policy  string             `json:"policy"`
+	Rules   []PolicyRuleResult `json:"rules"`
+	Subject string             `json:"subject"`
+}
+
 // PurlList defines model for PurlList.
 type PurlList struct {
 	// PaginationInfo Contains the cursor to retrieve more pages. If there are no more,  NextCursor will be nil.
@@ -85,7 +130,11 @@ type PurlList struct {
 }
 
 // VulnerabilityList defines model for VulnerabilityList.
-type VulnerabilityList = []Vulnerability
+type VulnerabilityList struct {
+	// PaginationInfo Contains the cursor to retrieve more pages. If there are no more,  NextCursor will be nil.
+	PaginationInfo    PaginationInfo  `json:"PaginationInfo"`
+	VulnerabilityList []Vulnerability `json:"VulnerabilityList"`
+}
 
 // AnalyzeDependenciesParams defines parameters for AnalyzeDependencies.
 type AnalyzeDependenciesParams struct {
@@ -103,8 +152,53 @@ type AnalyzeDependenciesParams struct {
 // AnalyzeDependenciesParamsSort defines parameters for AnalyzeDependencies.
 type AnalyzeDependenciesParamsSort string
 
+// GetArtifactVulnsParams defines parameters for GetArtifactVulns.
+type GetArtifactVulnsParams struct {
+	// PaginationSpec The pagination configuration for the query.
+	//   * 'PageSize' specifies the number of results returned
+	//   * 'Cursor' is returned by previous calls and specifies what page to return
+	PaginationSpec *PaginationSpec `form:"paginationSpec,omitempty" json:"paginationSpec,omitempty"`
+
+	// IncludeDependencies A flag to include vulnerabilities of the dependencies. If true, the  response will include vulnerabilities for the artifact and its dependencies  instead of the vulnerabilities of just the artifact.
+	IncludeDependencies *bool `form:"includeDependencies,omitempty" json:"includeDependencies,omitempty"`
+}
+
+// GetPackagePurlsParams defines parameters for GetPackagePurls.
+type GetPackagePurlsParams struct {
+	// PaginationSpec The pagination configuration for the query.
+	//   * 'PageSize' specifies the number of results returned
+	//   * 'Cursor' is returned by previous calls and specifies what page to return
+	PaginationSpec *PaginationSpec `form:"paginationSpec,omitempty" json:"paginationSpec,omitempty"`
+}
+
+// GetPackageSbomParams defines parameters for GetPackageSbom.
+type GetPackageSbomParams struct {
+	// Format The format of the SBOM, SPDX 2.3 or CycloneDX 1.5 JSON.
+	Format *GetPackageSbomParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetPackageSbomParamsFormat defines parameters for GetPackageSbom.
+type GetPackageSbomParamsFormat string
+
+// GetPackageVexParams defines parameters for GetPackageVex.
+type GetPackageVexParams struct {
+	// Format The format of the VEX document, OpenVEX or CSAF 2.0 JSON.
+	Format *GetPackageVexParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetPackageVexParamsFormat defines parameters for GetPackageVex.
+type GetPackageVexParamsFormat string
+
 // GetPackageVulnsParams defines parameters for GetPackageVulns.
 type GetPackageVulnsParams struct {
+	// PaginationSpec The pagination configuration for the query.
+	//   * 'PageSize' specifies the number of results returned
+	//   * 'Cursor' is returned by previous calls and specifies what page to return
+	PaginationSpec *PaginationSpec `form:"paginationSpec,omitempty" json:"paginationSpec,omitempty"`
+
 	// IncludeDependencies A flag to include vulnerabilities of the dependencies. If true, the  response will include vulnerabilities for the purl and its dependencies  instead of the vulnerabilities of just the purl.
 	IncludeDependencies *bool `form:"includeDependencies,omitempty" json:"includeDependencies,omitempty"`
 }
+
+// EvaluatePolicyJSONRequestBody defines body for EvaluatePolicy for application/json ContentType.
+type EvaluatePolicyJSONRequestBody = PolicyEvaluationRequest
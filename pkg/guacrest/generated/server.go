@@ -27,16 +27,25 @@ type ServerInterface interface {
 	GetArtifactDeps(w http.ResponseWriter, r *http.Request, digest string)
 	// Get vulnerabilities for an artifact, identified by a digest
 	// (GET /v0/artifact/{digest}/vulns)
-	GetArtifactVulns(w http.ResponseWriter, r *http.Request, digest string)
+	GetArtifactVulns(w http.ResponseWriter, r *http.Request, digest string, params GetArtifactVulnsParams)
 	// Get all purls related to the given purl
 	// (GET /v0/package/{purl})
-	GetPackagePurls(w http.ResponseWriter, r *http.Request, purl string)
+	GetPackagePurls(w http.ResponseWriter, r *http.Request, purl string, params GetPackagePurlsParams)
 	// Get dependencies for a specific Package URL (purl)
 	// (GET /v0/package/{purl}/dependencies)
 	GetPackageDeps(w http.ResponseWriter, r *http.Request, purl string)
+	// Export an SBOM for a specific Package URL (purl)
+	// (GET /v0/package/{purl}/sbom)
+	GetPackageSbom(w http.ResponseWriter, r *http.Request, purl string, params GetPackageSbomParams)
+	// Export a VEX document for a specific Package URL (purl)
+	// (GET /v0/package/{purl}/vex)
+	GetPackageVex(w http.ResponseWriter, r *http.Request, purl string, params GetPackageVexParams)
 	// Get vulnerabilities for a Package URL (purl)
 	// (GET /v0/package/{purl}/vulns)
 	GetPackageVulns(w http.ResponseWriter, r *http.Request, purl string, params GetPackageVulnsParams)
+	// Evaluate a policy against a package or an artifact
+	// (POST /v0/policy/eval)
+	EvaluatePolicy(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -63,13 +72,13 @@ func (_ Unimplemented) GetArtifactDeps(w http.ResponseWriter, r *http.Request, d
 
 // Get vulnerabilities for an artifact, identified by a digest
 // (GET /v0/artifact/{digest}/vulns)
-func (_ Unimplemented) GetArtifactVulns(w http.ResponseWriter, r *http.Request, digest string) {
+func (_ Unimplemented) GetArtifactVulns(w http.ResponseWriter, r *http.Request, digest string, params GetArtifactVulnsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Get all purls related to the given purl
 // (GET /v0/package/{purl})
-func (_ Unimplemented) GetPackagePurls(w http.ResponseWriter, r *http.Request, purl string) {
+func (_ Unimplemented) GetPackagePurls(w http.ResponseWriter, r *http.Request, purl string, params GetPackagePurlsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -79,12 +88,30 @@ func (_ Unimplemented) GetPackageDeps(w http.ResponseWriter, r *http.Request, pu
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Export an SBOM for a specific Package URL (purl)
+// (GET /v0/package/{purl}/sbom)
+func (_ Unimplemented) GetPackageSbom(w http.ResponseWriter, r *http.Request, purl string, params GetPackageSbomParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export a VEX document for a specific Package URL (purl)
+// (GET /v0/package/{purl}/vex)
+func (_ Unimplemented) GetPackageVex(w http.ResponseWriter, r *http.Request, purl string, params GetPackageVexParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get vulnerabilities for a Package URL (purl)
 // (GET /v0/package/{purl}/vulns)
 func (_ Unimplemented) GetPackageVulns(w http.ResponseWriter, r *http.Request, purl string, params GetPackageVulnsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Evaluate a policy against a package or an artifact
+// (POST /v0/policy/eval)
+func (_ Unimplemented) EvaluatePolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -189,8 +216,27 @@ func (siw *ServerInterfaceWrapper) GetArtifactVulns(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactVulnsParams
+
+	// ------------- Optional query parameter "paginationSpec" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "paginationSpec", r.URL.Query(), &params.PaginationSpec)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "paginationSpec", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeDependencies" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeDependencies", r.URL.Query(), &params.IncludeDependencies)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeDependencies", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactVulns(w, r, digest)
+		siw.Handler.GetArtifactVulns(w, r, digest, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -214,8 +260,19 @@ func (siw *ServerInterfaceWrapper) GetPackagePurls(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackagePurlsParams
+
+	// ------------- Optional query parameter "paginationSpec" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "paginationSpec", r.URL.Query(), &params.PaginationSpec)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "paginationSpec", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPackagePurls(w, r, purl)
+		siw.Handler.GetPackagePurls(w, r, purl, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -250,6 +307,78 @@ func (siw *ServerInterfaceWrapper) GetPackageDeps(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
+// GetPackageSbom operation middleware
+func (siw *ServerInterfaceWrapper) GetPackageSbom(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "purl" -------------
+	var purl string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "purl", chi.URLParam(r, "purl"), &purl, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "purl", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackageSbomParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPackageSbom(w, r, purl, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPackageVex operation middleware
+func (siw *ServerInterfaceWrapper) GetPackageVex(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "purl" -------------
+	var purl string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "purl", chi.URLParam(r, "purl"), &purl, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "purl", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackageVexParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPackageVex(w, r, purl, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetPackageVulns operation middleware
 func (siw *ServerInterfaceWrapper) GetPackageVulns(w http.ResponseWriter, r *http.Request) {
 
@@ -267,6 +396,14 @@ func (siw *ServerInterfaceWrapper) GetPackageVulns(w http.ResponseWriter, r *htt
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetPackageVulnsParams
 
+	// ------------- Optional query parameter "paginationSpec" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "paginationSpec", r.URL.Query(), &params.PaginationSpec)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "paginationSpec", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "includeDependencies" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "includeDependencies", r.URL.Query(), &params.IncludeDependencies)
@@ -286,6 +423,20 @@ func (siw *ServerInterfaceWrapper) GetPackageVulns(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// EvaluatePolicy operation middleware
+func (siw *ServerInterfaceWrapper) EvaluatePolicy(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EvaluatePolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -417,9 +568,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v0/package/{purl}/dependencies", wrapper.GetPackageDeps)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v0/package/{purl}/sbom", wrapper.GetPackageSbom)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v0/package/{purl}/vex", wrapper.GetPackageVex)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v0/package/{purl}/vulns", wrapper.GetPackageVulns)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v0/policy/eval", wrapper.EvaluatePolicy)
+	})
 
 	return r
 }
@@ -432,13 +592,24 @@ type InternalServerErrorJSONResponse Error
 
 type PackageNameListJSONResponse []PackageName
 
+type PolicyResultJSONResponse struct {
+	Pass    bool               `json:"pass"`
+	Policy  string             `json:"policy"`
+	Rules   []PolicyRuleResult `json:"rules"`
+	Subject string             `json:"subject"`
+}
+
 type PurlListJSONResponse struct {
 	// PaginationInfo Contains the cursor to retrieve more pages. If there are no more,  NextCursor will be nil.
 	PaginationInfo PaginationInfo `json:"PaginationInfo"`
 	PurlList       []Purl         `json:"PurlList"`
 }
 
-type VulnerabilityListJSONResponse []Vulnerability
+type VulnerabilityListJSONResponse struct {
+	// PaginationInfo Contains the cursor to retrieve more pages. If there are no more,  NextCursor will be nil.
+	PaginationInfo    PaginationInfo  `json:"PaginationInfo"`
+	VulnerabilityList []Vulnerability `json:"VulnerabilityList"`
+}
 
 type AnalyzeDependenciesRequestObject struct {
 	Params AnalyzeDependenciesParams
@@ -550,6 +721,7 @@ func (response GetArtifactDeps502JSONResponse) VisitGetArtifactDepsResponse(w ht
 
 type GetArtifactVulnsRequestObject struct {
 	Digest string `json:"digest"`
+	Params GetArtifactVulnsParams
 }
 
 type GetArtifactVulnsResponseObject interface {
@@ -595,7 +767,8 @@ func (response GetArtifactVulns502JSONResponse) VisitGetArtifactVulnsResponse(w
 }
 
 type GetPackagePurlsRequestObject struct {
-	Purl string `json:"purl"`
+	Purl   string `json:"purl"`
+	Params GetPackagePurlsParams
 }
 
 type GetPackagePurlsResponseObject interface {
@@ -686,6 +859,109 @@ func (response GetPackageDeps502JSONResponse) VisitGetPackageDepsResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetPackageSbomRequestObject struct {
+	Purl   string `json:"purl"`
+	Params GetPackageSbomParams
+}
+
+type GetPackageSbomResponseObject interface {
+	VisitGetPackageSbomResponse(w http.ResponseWriter) error
+}
+
+type GetPackageSbom200ApplicationSpdxPlusJSONResponse map[string]interface{}
+
+func (response GetPackageSbom200ApplicationSpdxPlusJSONResponse) VisitGetPackageSbomResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/spdx+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageSbom200ApplicationVndCyclonedxPlusJSONResponse map[string]interface{}
+
+func (response GetPackageSbom200ApplicationVndCyclonedxPlusJSONResponse) VisitGetPackageSbomResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.cyclonedx+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageSbom400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPackageSbom400JSONResponse) VisitGetPackageSbomResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageSbom500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPackageSbom500JSONResponse) VisitGetPackageSbomResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageSbom502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response GetPackageSbom502JSONResponse) VisitGetPackageSbomResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageVexRequestObject struct {
+	Purl   string `json:"purl"`
+	Params GetPackageVexParams
+}
+
+type GetPackageVexResponseObject interface {
+	VisitGetPackageVexResponse(w http.ResponseWriter) error
+}
+
+type GetPackageVex200JSONResponse map[string]interface{}
+
+func (response GetPackageVex200JSONResponse) VisitGetPackageVexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageVex400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPackageVex400JSONResponse) VisitGetPackageVexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageVex500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPackageVex500JSONResponse) VisitGetPackageVexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPackageVex502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response GetPackageVex502JSONResponse) VisitGetPackageVexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetPackageVulnsRequestObject struct {
 	Purl   string `json:"purl"`
 	Params GetPackageVulnsParams
@@ -733,6 +1009,52 @@ func (response GetPackageVulns502JSONResponse) VisitGetPackageVulnsResponse(w ht
 	return json.NewEncoder(w).Encode(response)
 }
 
+type EvaluatePolicyRequestObject struct {
+	Body *EvaluatePolicyJSONRequestBody
+}
+
+type EvaluatePolicyResponseObject interface {
+	VisitEvaluatePolicyResponse(w http.ResponseWriter) error
+}
+
+type EvaluatePolicy200JSONResponse struct{ PolicyResultJSONResponse }
+
+func (response EvaluatePolicy200JSONResponse) VisitEvaluatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluatePolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response EvaluatePolicy400JSONResponse) VisitEvaluatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluatePolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response EvaluatePolicy500JSONResponse) VisitEvaluatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluatePolicy502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response EvaluatePolicy502JSONResponse) VisitEvaluatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Identify the most important dependencies
@@ -753,9 +1075,18 @@ type StrictServerInterface interface {
 	// Get dependencies for a specific Package URL (purl)
 	// (GET /v0/package/{purl}/dependencies)
 	GetPackageDeps(ctx context.Context, request GetPackageDepsRequestObject) (GetPackageDepsResponseObject, error)
+	// Export an SBOM for a specific Package URL (purl)
+	// (GET /v0/package/{purl}/sbom)
+	GetPackageSbom(ctx context.Context, request GetPackageSbomRequestObject) (GetPackageSbomResponseObject, error)
+	// Export a VEX document for a specific Package URL (purl)
+	// (GET /v0/package/{purl}/vex)
+	GetPackageVex(ctx context.Context, request GetPackageVexRequestObject) (GetPackageVexResponseObject, error)
 	// Get vulnerabilities for a Package URL (purl)
 	// (GET /v0/package/{purl}/vulns)
 	GetPackageVulns(ctx context.Context, request GetPackageVulnsRequestObject) (GetPackageVulnsResponseObject, error)
+	// Evaluate a policy against a package or an artifact
+	// (POST /v0/policy/eval)
+	EvaluatePolicy(ctx context.Context, request EvaluatePolicyRequestObject) (EvaluatePolicyResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -864,10 +1195,11 @@ func (sh *strictHandler) GetArtifactDeps(w http.ResponseWriter, r *http.Request,
 }
 
 // GetArtifactVulns operation middleware
-func (sh *strictHandler) GetArtifactVulns(w http.ResponseWriter, r *http.Request, digest string) {
+func (sh *strictHandler) GetArtifactVulns(w http.ResponseWriter, r *http.Request, digest string, params GetArtifactVulnsParams) {
 	var request GetArtifactVulnsRequestObject
 
 	request.Digest = digest
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.GetArtifactVulns(ctx, request.(GetArtifactVulnsRequestObject))
@@ -890,10 +1222,11 @@ func (sh *strictHandler) GetArtifactVulns(w http.ResponseWriter, r *http.Request
 }
 
 // GetPackagePurls operation middleware
-func (sh *strictHandler) GetPackagePurls(w http.ResponseWriter, r *http.Request, purl string) {
+func (sh *strictHandler) GetPackagePurls(w http.ResponseWriter, r *http.Request, purl string, params GetPackagePurlsParams) {
 	var request GetPackagePurlsRequestObject
 
 	request.Purl = purl
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.GetPackagePurls(ctx, request.(GetPackagePurlsRequestObject))
@@ -941,6 +1274,60 @@ func (sh *strictHandler) GetPackageDeps(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetPackageSbom operation middleware
+func (sh *strictHandler) GetPackageSbom(w http.ResponseWriter, r *http.Request, purl string, params GetPackageSbomParams) {
+	var request GetPackageSbomRequestObject
+
+	request.Purl = purl
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPackageSbom(ctx, request.(GetPackageSbomRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPackageSbom")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPackageSbomResponseObject); ok {
+		if err := validResponse.VisitGetPackageSbomResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPackageVex operation middleware
+func (sh *strictHandler) GetPackageVex(w http.ResponseWriter, r *http.Request, purl string, params GetPackageVexParams) {
+	var request GetPackageVexRequestObject
+
+	request.Purl = purl
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPackageVex(ctx, request.(GetPackageVexRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPackageVex")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPackageVexResponseObject); ok {
+		if err := validResponse.VisitGetPackageVexResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetPackageVulns operation middleware
 func (sh *strictHandler) GetPackageVulns(w http.ResponseWriter, r *http.Request, purl string, params GetPackageVulnsParams) {
 	var request GetPackageVulnsRequestObject
@@ -967,3 +1354,34 @@ func (sh *strictHandler) GetPackageVulns(w http.ResponseWriter, r *http.Request,
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// EvaluatePolicy operation middleware
+func (sh *strictHandler) EvaluatePolicy(w http.ResponseWriter, r *http.Request) {
+	var request EvaluatePolicyRequestObject
+
+	var body EvaluatePolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EvaluatePolicy(ctx, request.(EvaluatePolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EvaluatePolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EvaluatePolicyResponseObject); ok {
+		if err := validResponse.VisitEvaluatePolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -18,36 +18,50 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xZbY8btxH+KwO2gJNiLalO2w8CDNR3l7gHOKlh2f4SG8iInF3R5pJrkquLfNB/L0ju",
-	"SvumOx1QBzDib6clZziceZ554d0ybsrKaNLeseUtq9BiSZ5s/PUSC6nRS6NXFfHwRZDjVlbhE1uy1xuC",
-	"6rAHuNG5LGqbfuXGgt8QfKrJ7mbvNMDf4NFLLGglP9MjcBVxmUtycZOuyzVZMDlYcrXyDiz52moSjeBl",
-	"bZ2xj0AeV2C9g8rSVpraAUelHKAWHcU3G/TBPgJvGql3mmVMBtujWSxjGktiS1b1r5oxxzdUYvSJNRVZ",
-	"Lyn6JBkS/vK7Kkg6b6Uu2D5j7eU6i1J7Ksiy/T5rP5n1B+Ke7cMnS64y2iXNFyieo6cb3IVf3GhP2oc/",
-	"saqU5NG4+QcXPH/bMe+vlnK2ZH+ZHyM5T6tu/qO1xqajxpFzZLdkgTQ3tfZkSQBqoCASQqmJe6mL4LsQ",
-	"IYEeYY38I2kRLnuB4hV9qsn5L2/tBQqw6bAMXM03gA5ya0qQeotKCjAWSulcsLcD4X3GrsPNNKpVvGw6",
-	"4Yvb2x4K6VRoNgaE8I9Y0C9Y0gv5QM9JT6W7z6TOAewIObQWd1OGPgMlnQ+0q5IgBDo4uJF+E6IuLQiq",
-	"SAvSHiJMolNf1lY92P4+jY6p5Vrn5v5r9XYPTDjPM7VVEy4JHPxUS0uCLX8dWtU55v0kf096s7Yqeupt",
-	"rTRZXEsl/e7LhLx3xMOCvu2IhoSJzhku0ZM4IOAADGMBrZc5xru3+TFad2BVP8Q/k3NY0ESqHHi93Tj2",
-	"cY8x4xOuWmxeBmhOpd2MtZL3Y2NgVRTMhmdM2zjEct/hl0Z7lDpVOh7rR1ORrKQtQWlsrKPkZnCdh12W",
-	"AC2BNnEtA/iFfvep8sCNVArWBFqqWSxnfZ8cd07WqNfGozrprv3U7YJzplStOOqfyWOoDOPYcKMUcX/C",
-	"DLF+Y+WJlbdknUxUGK0aKws5veQ4ak32lN5m+S7lXpa0ittEWM+NLdGzJRPo6XFYZNkEkkcO6xNy5Jiy",
-	"47K7QNlz7z5jDREnLd8Ojzw7YVyRRxly1QD97WlD3dnR/vf33b3VPXJBErvvHtdXbsyl6eS1AxkoGto+",
-	"62bwekOOgKMOPLl8+yNcX7mQwEygFkAKrIPakQhEbGR3w3yY6HVIwmO83FVKRleZriAyZgxdK5UxU5HG",
-	"SrIl+2G2mC0CtdFv4tlz1Kh2Trp5W49548uCIpODe1MKEsFLYfdnuuruzXrN/a/TGDlumQ+a/3021f07",
-	"Yz0YK1Lv3qkXrunb89i3ab57BI/hdWf9WGE2stiQ850Z4NBztFocN5Y4WnFaizI3Qcl/K9Kr1U9wkEh/",
-	"nez7wwVYN3Le1tTt/knXZYjn4SJxNmiUd4J6yAjvB239k8XiFCMP++bDxnCfsX+cI9fpwvcZ++c5IlMd",
-	"cZR9ctZx7YgSe4C6LNHuQsfbUiiEojTOgywrYz1qDz3EBrH5hlD5zeeT8P1PXL/cEP/Ipr15dgs1jM5E",
-	"ry6CdDOHNjORdJBsHN4zWQY8mNYRSNfaLuZtizS/FbIg5/fn8fU5+WeN5BVVE1zt23wVdWeNAdxoEaYe",
-	"n0ai1oROSgSp496U+OBdvVj8wFEVxkq/KZfJ1PiVWpqEzHNkSdpxJ0/+PzRo2+2vDf/PqY/z+PqB+hCM",
-	"7BiN+G6B0Lj0NHBCATkLMW/jxj8rZMYT1teIneEk9lD4NPVwfhsmz30HNYNklwPGuEtUcUjNoPpYLHNj",
-	"5muMaa9CF7oiqSNUNEgPN6ZWAnKpBaBKYg4mZ8VCbkk3ettnmo7+f/99tsjiG13345PZYgYrQss3Uhew",
-	"lQifalSplwsmOVlKhTbORu6wL3ioqwafriEaQVpURmqfBqX07Adr4zej7UNL+FMREP3kX/h0nXq/Eeea",
-	"Ov0yzvf3UO7NqxePSXMjSEAjB29evYDvgoO+n2ZNWPqWZu+kyhGDllTEX/NCeUTfCVqcqsXDtla6AYgO",
-	"2O+l+Lbhra3q0kZqrmoRIBpBJ6Ql7iGCTerm13feonbSyy1931M6g5+MBfody0pRBvL4MPes2efAaLhI",
-	"NLqA7sfLhrLNS2mwYFSSno1ZcRFVXd4N+HO6km94/4PaivZfHHzKzSegP+wmzsf8VGka434GzdMV/JYI",
-	"0Bs+f4NcYRGTOfnI1xDmWFzCWaicaXhDMDqw4dn93Lsbwme1SX8IhrPxo0Z0jz864QwfpLfC6MewBC2c",
-	"kktPKepFL+Yk7/quBamdJxTtmROmfKidP2iZnZytJ4DQ+8eaoBxr5dkyR+XoMEmvjVGE+ltDOGoIp/m+",
-	"3/8vAAD//+ShQKrGHQAA",
+	"H4sIAAAAAAAC/+xa+48bt/H/Vwb7/QJO2o10cZr+cECA+h5Jr7ATw3IOKRKjobizEmMuuSa5ulMM/e/F",
+	"kNzVPu+ki530gPwm8TEznMdnhrN8n3BdlFqhcjY5fZ+UzLACHRr/7yVbCcWc0GpRIqeRDC03oqSh5DR5",
+	"vUYomzXAtcrFqjLhX64NuDXCuwrNdvaTAvgLPHnJVrgQv+ITsCVykQu0fpGqiiUa0DkYtJV0Fgy6yijM",
+	"4sbzylhtnoDYz8ByC6XBjdCVBc6ktMBU1iJ8s2aO5ENwOu76SSVpIkh2L1aSJooVmJwmZfeoaWL5Ggvm",
+	"dWJ0icYJ9DoJgtAvty1pp3VGqFWyS5P6cK1JoRyu0CS7XVoP6eUvyF2yoyGDttTKBspnLPuGObxhW/rH",
+	"tXKoHP1kZSkF98LNf7Gk+fct8f7fYJ6cJv8331tyHmbt/NIYbQKroeUsmg0aQMV1pRwazIApQNpCplTI",
+	"nVAr0h1ZKGOOwZLxt6gyOuwZy17huwqt+/jSnrEMTGCWgq34GpiF3OgChNowKTLQBgphLcnbcuFdmlzR",
+	"yRSTC3/YwOGjy1szhcAV4kLyEP6WrfBbVuBzcaTmhMPC3idSi0GydzlmDNuOCfoMpLCOwq4MG4HCwcKN",
+	"cGuyujCQYYkqQ+XAu4lX6kstBd++8pF61Bm6oVQya1uxstRaIlPEoPQMRoPMVBLt4foIklYSo7QDpaSJ",
+	"rUJEDrn5CH1XCYNZcvpjLdV+RxqOUAv1ZjTGh4EXII60jhsmK+bDjEEkT/qtjDzaP7q63UP3lcr1/W7T",
+	"Wd0T4TBNV0aOuFxXgz0+LTaH6K7lrZWR3hOvK6nQsKWQwm3/YJWNynKQ7jo7j1fikPFx2ty09lPeZNZq",
+	"LpjDrAGCBh+0AWacyJknWqdJf8IGXLtqfYHWshXeH171wqHwHeAccrioIeqcEGos+6ZJvfN+F+5J5Tem",
+	"fR7jMvb9p6vwc60cEyoUPNyXEbEwMQI3CIU2vpxCO4OrnFYZBGYQlPZzKcC3eOtCAQI3QkpYIighZ76q",
+	"6epkv3IURV9rx+SkunZjp/PodBkBS6tW8u9yzsQqjg+Rr3YdCItSEMprI9emYA5+qk5OvuBMrrQRbl2c",
+	"hlV+1HueVnLr14eJWZIOT7ZPHUP2YQ4yzasCVWD/72cvnhPtfy2++3acIHnFQzwnSvJmUpmttHREbqRc",
+	"M2rTMDAysRFaepsdmzev6433YpIXKq5psmKL77QS9jwGOsCNyFBxHDfm1YUl8CJ3WBlWrkHpzN8mmAPO",
+	"KouZn2uEIOM2Z59Q3r4oKA5FrXphuhd39LDRiwaMF5ypF+gY1ddDFXAtJXI3EcXZ8nsjJmau0dio1cGs",
+	"NmIlxqcsZ0qhmaIbp+8i7kSBC78so/kQ2slpkjGHn9HkMMjG8KabEweKKVoqu8uRO+qlaA6JZDxO+iwP",
+	"ztkX6JiQduAcNbc+7XQv/5v7zl7THqhgOt7b268u7DB+xnP/FsiBHV2ejZ3B6zVaBM4UpZnz68sQcQY0",
+	"ZSaImG0hRJqu92775YQn1KZcR21vHRjk2mR0C7WA7yomPVVH13xSyKUfYgZBKC6rDLOQ9w6N6Z5pBkoa",
+	"r5mET+WqkjJNdImKlSI5Tb6YncxOPNC5tec9Z4rJrRV2Xt+XeLTSCj26k+FCbZCR/mn1r3jRXpt2mi8/",
+	"jnvffsm815zZpWMgabVxQFo1tdajS9rYV8n9vVrx7RP4DF635vel31qs1mhdq0dTn9HVVCzXBjkz2TQV",
+	"qW+IyHclqsXia2h2hF+TfRk6QNK2nDMVtrszqKqC7NkcxPduIvGWURusedNruzw9OZmK9WbdvH9x36XJ",
+	"3w7Z1+qS7NLky0O2jHUs/N6nB7GrW0i+OK+KgpktdSTq4CRTFNo6EEWpjWPKQcdjadt8jUy69a+T7vtP",
+	"P3++Rv42GdfmwVewvnVGeikZ7Y59wtizEhaCjP1zBsmAk2itDeFYm5N5XYDO34cScndYvH6D7lnceYHl",
+	"SKx2Zb6I1W0QgGuVQcmMCy2rWoQWJB5RBtdhQsizj5Kw4s44+TBhUF/XH5v/f4NdPydVA1ONMdK9NXxf",
+	"mcUrxh2OQwmk7TF98BUWUGWlFsqFy1ouVAZMykHi6yFzKGFd+7okLAmrOa+MQcURdF7fEeHnmA7byeRn",
+	"yCVb0TaLzndwyRtAREmYtLrOolPSdLSl8448IfFOhsi118wHz2ePIarSYaXlLeGO0newrTcZTUHt4MF6",
+	"U4TqDy6NKpjKQDjbNSUIZR2ybKoK0zn8Ulk3Yu+x9Dzie51vJxnmzN9vcyYtpoPr7MNgaNjweox4NGa/",
+	"YyAp4sX8PXUodpNIdJUD84EimPSN0xTKt6vTXOv5kvlUWjJLNbxQ3t2UhwldyWyPWLTNwmhjcCU2qCLd",
+	"+tNMi/4/Pp+dpN4T24NPZyczWCAzfE29741gQNV9vB8QbIlCSGZ8INhmHWmoTYZ9tQQvRBdow6c+WGq3",
+	"HizvS8K/yggCnv6dfbWcgLVY+1Hu+wio9v2r55+h4pouPpETfP/qOXxCKv10HJho6s9kf2dw7b3WoPQe",
+	"63TPXycCaaoiPDy/j6VOIt0OtACc5NTeTTNhkDsIkK3iv0+cYcoKJzb4aS8/fK0N4C0rSklpff/57llc",
+	"Z0ErOAuBdwbtwfMY5PF7KkkwKIyeDePozJM6vztEDqmN//T336m4rR9C8DE1T7i+XeriQJc3uEJFboC+",
+	"Nl2cffeiqUC6zh4KtKZPO4MrB7mWUt/EO53O3Q0z6D2sc4660UPpj1YSk365nI6XOTS4r5dtWMWyLLCU",
+	"giPpNIU1s+u43OrKcGpUhMuqhbdK34R3LMj4Ghqj3B0EC9Lh/0IQjDaEYjGs80ahKSxeXvwAT2dfgDZw",
+	"vuVSK7z4AT6ffdl8FBkr/QKl8WovsWV2m6RNcyb+5YF4dntEX2aik0AU/zrZTmg6eGln00Zls0aGA3aP",
+	"fr33nl5/Qnp0WHF5W2rjOhH7IKTY4O2BQNGCCbi+/KFR3b1oQYutYw5pdas9LNQeTPZEouRjYDCDyw2a",
+	"ba/XnetKxcLacqbCrVvpPUvgehPLUYOktdCarlSG5j9CbSh7rsKnpTsB4RpvHwsetO2T+l4tjRAsLJ59",
+	"DU9nJw9HBF2i2mAbFPYj3LL8t0PCA+O5feZHG87DyHpYVH+wntZ4cM/gQ3Wtjmlb9WW47m31kc+6eAMZ",
+	"cskM2m6tMXqqlERW2gHLc+QuXtcNgsTcga7uKRg+Urvsj8GUP6Df5Y3xm3tdROXPPtfv1ue6E5T8w5A5",
+	"PY4k1qUee04UXyLFAPUPMJt49/vrOiFedGGpsy2wFSOf6JQM3TZb7QtNrA87y826+AwJLm8Zd3ILWmH9",
+	"OpF2h2koyMWWCBbdDFrvP+kTvE339ws6Q9p9s2JjlULe7Q5+81KM4U2tr5f1I9aolzOdfbj35lOPxHbd",
+	"r+8U6rsHXdHbD48fXa6OFmie+jbeyDrPK1X7heVu998BAOC+sB+jMQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
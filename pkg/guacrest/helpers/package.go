@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	gql "github.com/guacsec/guac/pkg/assembler/clients/generated"
 	assembler_helpers "github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/logging"
@@ -69,3 +70,65 @@ func FindPackageWithPurl(ctx context.Context, gqlClient graphql.Client,
 	// The filter should have matched at most one package
 	return response.GetPackages()[0].AllPkgTree.GetNamespaces()[0].GetNames()[0].GetVersions()[0], nil
 }
+
+// Returns the version nodes of all packages that match the input purl. Unlike
+// FindPackageWithPurl, the purl may be partial: a purl without a version or
+// subpath matches all versions or subpaths of the package, and the qualifiers
+// of the purl need only be a subset of the qualifiers of a matching package.
+func FindPackagesMatchingPurl(ctx context.Context, gqlClient graphql.Client,
+	purl string) ([]gql.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion, error) {
+	logger := logging.FromContext(ctx)
+
+	inputSpec, err := assembler_helpers.PurlToPkg(purl)
+	if err != nil {
+		// return the error message, to indicate unparseable purls
+		return nil, err
+	}
+	filter := gql.PkgSpec{
+		Type:      &inputSpec.Type,
+		Namespace: inputSpec.Namespace,
+		Name:      &inputSpec.Name,
+	}
+	if filter.Namespace == nil {
+		filter.Namespace = ptrfrom.String("")
+	}
+	if inputSpec.Version != nil && *inputSpec.Version != "" {
+		filter.Version = inputSpec.Version
+	}
+	if inputSpec.Subpath != nil && *inputSpec.Subpath != "" {
+		filter.Subpath = inputSpec.Subpath
+	}
+
+	response, err := gql.Packages(ctx, gqlClient, filter)
+	if err != nil {
+		logger.Errorf(fmt.Sprintf("Packages query returned error: %v", err))
+		return nil, Err502
+	}
+
+	// the qualifiers are matched here, as backends only match on all of them
+	res := []gql.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion{}
+	for _, version := range GetVersionsOfPackagesResponse(response.GetPackages()) {
+		if hasQualifiers(version, inputSpec.Qualifiers) {
+			res = append(res, version)
+		}
+	}
+	return res, nil
+}
+
+// Returns whether all of the qualifiers are qualifiers of the version node
+func hasQualifiers(version gql.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion,
+	qualifiers []gql.PackageQualifierInputSpec) bool {
+	for _, q := range qualifiers {
+		found := false
+		for _, vq := range version.GetQualifiers() {
+			if vq.GetKey() == q.Key && vq.GetValue() == q.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
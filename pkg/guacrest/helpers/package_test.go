@@ -223,3 +223,65 @@ func Test_FindPackageWithPurl(t *testing.T) {
 		})
 	}
 }
+
+func Test_FindPackagesMatchingPurl(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := test_helpers.SetupTest(t)
+	test_helpers.Ingest(ctx, t, gqlClient, test_helpers.GuacData{
+		Packages: []string{
+			"pkg:guac/bar",
+			"pkg:guac/bar@v1",
+			"pkg:guac/bar@v1?a=b&c=d",
+			"pkg:guac/bar@v2?a=b",
+			"pkg:guac/baz@v1",
+		},
+	})
+
+	tests := []struct {
+		testName string
+		input    string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			testName: "Input without version matches all versions",
+			input:    "pkg:guac/bar",
+			expected: []string{"pkg:guac/bar", "pkg:guac/bar@v1", "pkg:guac/bar@v1?a=b&c=d", "pkg:guac/bar@v2?a=b"},
+		},
+		{
+			testName: "Input with version matches all qualifiers",
+			input:    "pkg:guac/bar@v1",
+			expected: []string{"pkg:guac/bar@v1", "pkg:guac/bar@v1?a=b&c=d"},
+		},
+		{
+			testName: "Input qualifiers are a subset of the package qualifiers",
+			input:    "pkg:guac/bar?a=b",
+			expected: []string{"pkg:guac/bar@v1?a=b&c=d", "pkg:guac/bar@v2?a=b"},
+		},
+		{
+			testName: "No package matches",
+			input:    "pkg:guac/qux",
+		},
+		{
+			testName: "Invalid purl",
+			input:    "not-a-purl",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			res, err := helpers.FindPackagesMatchingPurl(ctx, gqlClient, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("tt.wantErr is %v, but err is %s", tt.wantErr, err)
+			}
+			actual := []string{}
+			for _, version := range res {
+				actual = append(actual, version.Purl)
+			}
+			if !cmp.Equal(tt.expected, actual, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+				t.Errorf("Got %v, wanted %v", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/server"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const testPolicy = `
+name: gate
+rules:
+  - name: no-bad
+    type: noCertifyBad
+    transitive: true
+`
+
+func Test_EvaluatePolicy(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, GuacData{
+		Packages:       []string{"pkg:guac/foo", "pkg:guac/bar", "pkg:guac/qux"},
+		IsDependencies: []IsDependency{{DependentPkg: "pkg:guac/foo", DependencyPkg: "pkg:guac/bar"}},
+		CertifyBads:    []CertifyBad{{Subject: "pkg:guac/bar"}},
+	})
+	restApi := server.NewDefaultServer(gqlClient)
+
+	tests := []struct {
+		name     string
+		body     *gen.PolicyEvaluationRequest
+		wantPass *bool // nil if a 400 is expected
+	}{
+		{
+			name:     "violation in dependency",
+			body:     &gen.PolicyEvaluationRequest{Policy: testPolicy, Purl: ptrfrom.String("pkg:guac/foo")},
+			wantPass: ptrfrom.Bool(false),
+		},
+		{
+			name:     "violation on subject",
+			body:     &gen.PolicyEvaluationRequest{Policy: testPolicy, Purl: ptrfrom.String("pkg:guac/bar")},
+			wantPass: ptrfrom.Bool(false),
+		},
+		{
+			name:     "no violation",
+			body:     &gen.PolicyEvaluationRequest{Policy: testPolicy, Purl: ptrfrom.String("pkg:guac/qux")},
+			wantPass: ptrfrom.Bool(true),
+		},
+		{
+			name: "invalid policy",
+			body: &gen.PolicyEvaluationRequest{Policy: "rules: []", Purl: ptrfrom.String("pkg:guac/foo")},
+		},
+		{
+			name: "no subject",
+			body: &gen.PolicyEvaluationRequest{Policy: testPolicy},
+		},
+		{
+			name: "unknown subject",
+			body: &gen.PolicyEvaluationRequest{Policy: testPolicy, Purl: ptrfrom.String("pkg:guac/baz")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := restApi.EvaluatePolicy(ctx, gen.EvaluatePolicyRequestObject{Body: tt.body})
+			if err != nil {
+				t.Fatalf("EvaluatePolicy returned unexpected error: %v", err)
+			}
+			switch v := res.(type) {
+			case gen.EvaluatePolicy200JSONResponse:
+				if tt.wantPass == nil {
+					t.Fatalf("EvaluatePolicy returned %+v, wanted a bad request", v)
+				}
+				if v.Pass != *tt.wantPass {
+					t.Errorf("EvaluatePolicy returned pass = %v, wanted %v", v.Pass, *tt.wantPass)
+				}
+				if len(v.Rules) != 1 {
+					t.Fatalf("EvaluatePolicy returned %d rule results, wanted 1", len(v.Rules))
+				}
+			case gen.EvaluatePolicy400JSONResponse:
+				if tt.wantPass != nil {
+					t.Errorf("EvaluatePolicy returned unexpected bad request: %s", v.Message)
+				}
+			default:
+				t.Errorf("EvaluatePolicy returned unexpected response %T", res)
+			}
+		})
+	}
+}
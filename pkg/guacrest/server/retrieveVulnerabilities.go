@@ -0,0 +1,329 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	gql "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// vulnerabilityJoin looks up the vulnerabilities of a set of package version
+// nodes. It joins CertifyVuln with VulnEqual, to report all the known
+// identifiers of a vulnerability, and with CertifyVEXStatement, to leave out
+// the vulnerabilities that a package is not affected by.
+type vulnerabilityJoin struct {
+	gqlClient graphql.Client
+
+	// equivalent caches the VulnEqual lookups, keyed by vulnerability ID node id
+	equivalent map[string][]gql.AllVulnerabilityTree
+}
+
+func newVulnerabilityJoin(gqlClient graphql.Client) *vulnerabilityJoin {
+	return &vulnerabilityJoin{
+		gqlClient:  gqlClient,
+		equivalent: map[string][]gql.AllVulnerabilityTree{},
+	}
+}
+
+// GetVulnsForPackage gets the vulnerabilities of the package with the given
+// purl, and of its direct and transitive dependencies if includeDependencies
+// is set.
+func GetVulnsForPackage(
+	ctx context.Context,
+	gqlClient graphql.Client,
+	purl string,
+	includeDependencies bool,
+) ([]gen.Vulnerability, error) {
+	// Find the start node
+	pkg, err := helpers.FindPackageWithPurl(ctx, gqlClient, purl)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []node{&pkg}
+	if includeDependencies {
+		deps, err := getTransitiveDependencies(ctx, gqlClient, &pkg, newByName(gqlClient))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, deps...)
+	}
+
+	return newVulnerabilityJoin(gqlClient).vulnerabilities(ctx, []node{&pkg}, nodes)
+}
+
+// GetVulnsForArtifact gets the vulnerabilities of the packages that the
+// artifact with the given digest is an occurrence of, and of its direct and
+// transitive dependencies if includeDependencies is set.
+func GetVulnsForArtifact(
+	ctx context.Context,
+	gqlClient graphql.Client,
+	digest string,
+	includeDependencies bool,
+) ([]gen.Vulnerability, error) {
+	// Find the start node
+	art, err := helpers.FindArtifactWithDigest(ctx, gqlClient, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	edgeGenerator := newByDigest(gqlClient)
+	// vulnerabilities are attached to packages, so start from the packages
+	// that are the same software as the artifact
+	equivalent, err := edgeGenerator.getEquivalentNodes(ctx, &art)
+	if err != nil {
+		return nil, err
+	}
+	products := append([]node{&art}, equivalent...)
+
+	nodes := products
+	if includeDependencies {
+		deps, err := getTransitiveDependencies(ctx, gqlClient, &art, edgeGenerator)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, deps...)
+	}
+
+	return newVulnerabilityJoin(gqlClient).vulnerabilities(ctx, products, nodes)
+}
+
+// vulnerabilities returns the vulnerabilities of the package version nodes in
+// nodes, sorted by purl and vulnerability ID. A vulnerability is left out if a
+// NOT_AFFECTED VEX statement covers it for its package, or for one of the
+// products, since a statement about a product covers its dependencies.
+func (j *vulnerabilityJoin) vulnerabilities(ctx context.Context, products []node, nodes []node) ([]gen.Vulnerability, error) {
+	pkgNodes := []node{}
+	seen := map[string]bool{}
+	for _, n := range nodes {
+		v, ok := n.(*gql.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion)
+		if !ok || v == nil || seen[v.Id] {
+			continue
+		}
+		seen[v.Id] = true
+		pkgNodes = append(pkgNodes, v)
+	}
+
+	purls, err := mapPkgNodesToPurls(ctx, j.gqlClient, pkgNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	productNotAffected := map[string]bool{}
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		notAffected, err := j.notAffected(ctx, product)
+		if err != nil {
+			return nil, err
+		}
+		for vulnID := range notAffected {
+			productNotAffected[vulnID] = true
+		}
+	}
+
+	res := []gen.Vulnerability{}
+	for _, pkg := range pkgNodes {
+		notAffected, err := j.notAffected(ctx, pkg)
+		if err != nil {
+			return nil, err
+		}
+		certifyVulns, err := j.certifyVulns(ctx, pkg.GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cv := range certifyVulns {
+			vulns, err := j.equivalentVulns(ctx, cv.Vulnerability.AllVulnerabilityTree)
+			if err != nil {
+				return nil, err
+			}
+			covered := false
+			for _, v := range vulns {
+				if notAffected[vulnerabilityNodeID(v)] || productNotAffected[vulnerabilityNodeID(v)] {
+					covered = true
+					break
+				}
+			}
+			if covered {
+				continue
+			}
+			res = append(res, gen.Vulnerability{
+				Package: purls[pkg.GetId()],
+				Vulnerability: gen.VulnerabilityDetails{
+					Type:             &cv.Vulnerability.Type,
+					VulnerabilityIDs: vulnerabilityIDs(vulns),
+				},
+				Metadata: scanMetadata(cv.Metadata),
+			})
+		}
+	}
+
+	sort.SliceStable(res, func(i, k int) bool {
+		if res[i].Package != res[k].Package {
+			return res[i].Package < res[k].Package
+		}
+		return res[i].Vulnerability.VulnerabilityIDs[0] < res[k].Vulnerability.VulnerabilityIDs[0]
+	})
+	return res, nil
+}
+
+// certifyVulns returns the CertifyVuln nodes of the package that record an
+// actual vulnerability, rather than a scan that found none.
+func (j *vulnerabilityJoin) certifyVulns(ctx context.Context, pkgID string) ([]gql.AllCertifyVuln, error) {
+	logger := logging.FromContext(ctx)
+	noVuln := false
+	response, err := gql.CertifyVuln(ctx, j.gqlClient, gql.CertifyVulnSpec{
+		Package:       &gql.PkgSpec{Id: &pkgID},
+		Vulnerability: &gql.VulnerabilitySpec{NoVuln: &noVuln},
+	})
+	if err != nil {
+		logger.Errorf("CertifyVuln query returned err: %v", err)
+		return nil, helpers.Err502
+	}
+	if response == nil {
+		logger.Errorf("CertifyVuln query returned nil")
+		return nil, helpers.Err500
+	}
+	res := []gql.AllCertifyVuln{}
+	for _, cv := range response.GetCertifyVuln() {
+		res = append(res, cv.AllCertifyVuln)
+	}
+	return res, nil
+}
+
+// notAffected returns the ids of the vulnerability ID nodes that a
+// NOT_AFFECTED VEX statement about the package or artifact covers.
+func (j *vulnerabilityJoin) notAffected(ctx context.Context, v node) (map[string]bool, error) {
+	logger := logging.FromContext(ctx)
+	id := v.GetId()
+	var subject gql.PackageOrArtifactSpec
+	switch v.(type) {
+	case *gql.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion:
+		subject.Package = &gql.PkgSpec{Id: &id}
+	case *gql.AllArtifactTree:
+		subject.Artifact = &gql.ArtifactSpec{Id: &id}
+	default:
+		// VEX statements are only made about packages and artifacts
+		return map[string]bool{}, nil
+	}
+
+	status := gql.VexStatusNotAffected
+	response, err := gql.VEXStatements(ctx, j.gqlClient, gql.CertifyVEXStatementSpec{
+		Subject: &subject,
+		Status:  &status,
+	})
+	if err != nil {
+		logger.Errorf("CertifyVEXStatement query returned err: %v", err)
+		return nil, helpers.Err502
+	}
+	if response == nil {
+		logger.Errorf("CertifyVEXStatement query returned nil")
+		return nil, helpers.Err500
+	}
+
+	res := map[string]bool{}
+	for _, statement := range response.GetCertifyVEXStatement() {
+		res[vulnerabilityNodeID(statement.Vulnerability.AllVulnerabilityTree)] = true
+	}
+	return res, nil
+}
+
+// equivalentVulns returns the vulnerability, followed by the vulnerabilities
+// that a VulnEqual node records as equal to it.
+func (j *vulnerabilityJoin) equivalentVulns(ctx context.Context, vuln gql.AllVulnerabilityTree) ([]gql.AllVulnerabilityTree, error) {
+	id := vulnerabilityNodeID(vuln)
+	if res, ok := j.equivalent[id]; ok {
+		return res, nil
+	}
+	logger := logging.FromContext(ctx)
+	response, err := gql.VulnEquals(ctx, j.gqlClient, gql.VulnEqualSpec{
+		Vulnerabilities: []*gql.VulnerabilitySpec{{Id: &id}},
+	})
+	if err != nil {
+		logger.Errorf("VulnEqual query returned err: %v", err)
+		return nil, helpers.Err502
+	}
+	if response == nil {
+		logger.Errorf("VulnEqual query returned nil")
+		return nil, helpers.Err500
+	}
+
+	res := []gql.AllVulnerabilityTree{vuln}
+	seen := map[string]bool{id: true}
+	for _, vulnEqual := range response.GetVulnEqual() {
+		for _, v := range vulnEqual.Vulnerabilities {
+			if seen[vulnerabilityNodeID(v.AllVulnerabilityTree)] {
+				continue
+			}
+			seen[vulnerabilityNodeID(v.AllVulnerabilityTree)] = true
+			res = append(res, v.AllVulnerabilityTree)
+		}
+	}
+	j.equivalent[id] = res
+	return res, nil
+}
+
+// vulnerabilityNodeID returns the id of the node that identifies the
+// vulnerability. The id of the vulnerability tree itself is the one of its
+// type node, which all the vulnerabilities of that type share.
+func vulnerabilityNodeID(v gql.AllVulnerabilityTree) string {
+	if len(v.VulnerabilityIDs) == 0 {
+		return v.Id
+	}
+	return v.VulnerabilityIDs[0].Id
+}
+
+// vulnerabilityIDs returns the identifiers of the vulnerabilities, without
+// duplicates.
+func vulnerabilityIDs(vulns []gql.AllVulnerabilityTree) []string {
+	res := []string{}
+	seen := map[string]bool{}
+	for _, v := range vulns {
+		for _, id := range v.VulnerabilityIDs {
+			if seen[id.VulnerabilityID] {
+				continue
+			}
+			seen[id.VulnerabilityID] = true
+			res = append(res, id.VulnerabilityID)
+		}
+	}
+	if len(res) == 0 {
+		// should not occur, as noVuln nodes are not returned by certifyVulns
+		res = append(res, fmt.Sprintf("%s/unknown", vulns[0].Type))
+	}
+	return res
+}
+
+func scanMetadata(m gql.AllCertifyVulnMetadataScanMetadata) gen.ScanMetadata {
+	return gen.ScanMetadata{
+		DbUri:          &m.DbUri,
+		DbVersion:      &m.DbVersion,
+		ScannerUri:     &m.ScannerUri,
+		ScannerVersion: &m.ScannerVersion,
+		TimeScanned:    &m.TimeScanned,
+		Origin:         &m.Origin,
+		Collector:      &m.Collector,
+	}
+}
@@ -0,0 +1,113 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/server"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_GetPackageSbom(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, GuacData{
+		Packages:       []string{"pkg:guac/foo@1.0", "pkg:guac/bar@1.0"},
+		IsDependencies: []IsDependency{{DependentPkg: "pkg:guac/foo@1.0", DependencyPkg: "pkg:guac/bar@1.0"}},
+	})
+	restApi := server.NewDefaultServer(gqlClient)
+
+	cyclonedx := gen.Cyclonedx
+	spdx := gen.Spdx
+	tests := []struct {
+		name   string
+		purl   string
+		format *gen.GetPackageSbomParamsFormat
+		// the key of the document that holds the packages, or empty if a 400
+		// is expected
+		wantKey string
+	}{
+		{
+			name:    "spdx by default",
+			purl:    "pkg:guac/foo@1.0",
+			wantKey: "packages",
+		},
+		{
+			name:    "spdx",
+			purl:    url.PathEscape("pkg:guac/foo@1.0"),
+			format:  &spdx,
+			wantKey: "packages",
+		},
+		{
+			name:    "cyclonedx",
+			purl:    "pkg:guac/foo@1.0",
+			format:  &cyclonedx,
+			wantKey: "components",
+		},
+		{
+			name: "unknown package",
+			purl: "pkg:guac/baz@1.0",
+		},
+		{
+			name: "invalid purl",
+			purl: "not-a-purl",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := restApi.GetPackageSbom(ctx, gen.GetPackageSbomRequestObject{
+				Purl:   tt.purl,
+				Params: gen.GetPackageSbomParams{Format: tt.format},
+			})
+			if err != nil {
+				t.Fatalf("GetPackageSbom returned unexpected error: %v", err)
+			}
+			var document map[string]interface{}
+			switch v := res.(type) {
+			case gen.GetPackageSbom200ApplicationSpdxPlusJSONResponse:
+				if tt.format != nil && *tt.format != gen.Spdx {
+					t.Errorf("GetPackageSbom returned SPDX, wanted %s", *tt.format)
+				}
+				document = v
+			case gen.GetPackageSbom200ApplicationVndCyclonedxPlusJSONResponse:
+				if tt.format == nil || *tt.format != gen.Cyclonedx {
+					t.Errorf("GetPackageSbom returned CycloneDX, wanted SPDX")
+				}
+				document = v
+			case gen.GetPackageSbom400JSONResponse:
+				if tt.wantKey != "" {
+					t.Fatalf("GetPackageSbom returned unexpected bad request: %s", v.Message)
+				}
+				return
+			default:
+				t.Fatalf("GetPackageSbom returned unexpected response %T", res)
+			}
+			if tt.wantKey == "" {
+				t.Fatalf("GetPackageSbom returned a document, wanted a bad request")
+			}
+			items, ok := document[tt.wantKey].([]interface{})
+			if !ok || len(items) == 0 {
+				t.Errorf("GetPackageSbom returned a document without %s: %v", tt.wantKey, document)
+			}
+		})
+	}
+}
@@ -16,16 +16,26 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
+	assembler_helpers "github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/dependencies"
+	"github.com/guacsec/guac/pkg/export/sbom"
+	"github.com/guacsec/guac/pkg/export/vex"
 	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/helpers"
+	"github.com/guacsec/guac/pkg/guacrest/pagination"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
 )
 
 // DefaultServer implements the API, backed by the GraphQL Server
@@ -102,17 +112,62 @@ func (s *DefaultServer) AnalyzeDependencies(ctx context.Context, request gen.Ana
 }
 
 func (s *DefaultServer) GetPackagePurls(ctx context.Context, request gen.GetPackagePurlsRequestObject) (gen.GetPackagePurlsResponseObject, error) {
-	return gen.GetPackagePurls500JSONResponse{
-		InternalServerErrorJSONResponse: gen.InternalServerErrorJSONResponse{
-			Message: "GetPackagePurls not implemented",
+	unescapedPurl, err := url.PathUnescape(request.Purl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unescape package url: %w", err)
+	}
+
+	versions, err := helpers.FindPackagesMatchingPurl(ctx, s.gqlClient, unescapedPurl)
+	if err != nil {
+		return handleErr(ctx, err, GetPackagePurls).(gen.GetPackagePurlsResponseObject), nil
+	}
+
+	purls := []string{}
+	for _, version := range versions {
+		purls = append(purls, version.Purl)
+	}
+	sort.Strings(purls)
+
+	page, info, err := pagination.Paginate(ctx, purls, request.Params.PaginationSpec)
+	if err != nil {
+		return gen.GetPackagePurls400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	return gen.GetPackagePurls200JSONResponse{
+		PurlListJSONResponse: gen.PurlListJSONResponse{
+			PaginationInfo: info,
+			PurlList:       page,
 		},
 	}, nil
 }
 
 func (s *DefaultServer) GetPackageVulns(ctx context.Context, request gen.GetPackageVulnsRequestObject) (gen.GetPackageVulnsResponseObject, error) {
-	return gen.GetPackageVulns500JSONResponse{
-		InternalServerErrorJSONResponse: gen.InternalServerErrorJSONResponse{
-			Message: "GetPackageVulns not implemented",
+	unescapedPurl, err := url.PathUnescape(request.Purl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unescape package url: %w", err)
+	}
+
+	includeDependencies := request.Params.IncludeDependencies != nil && *request.Params.IncludeDependencies
+	vulns, err := GetVulnsForPackage(ctx, s.gqlClient, unescapedPurl, includeDependencies)
+	if err != nil {
+		return handleErr(ctx, err, GetPackageVulns).(gen.GetPackageVulnsResponseObject), nil
+	}
+
+	page, info, err := pagination.Paginate(ctx, vulns, request.Params.PaginationSpec)
+	if err != nil {
+		return gen.GetPackageVulns400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	return gen.GetPackageVulns200JSONResponse{
+		VulnerabilityListJSONResponse: gen.VulnerabilityListJSONResponse{
+			PaginationInfo:    info,
+			VulnerabilityList: page,
 		},
 	}, nil
 }
@@ -146,10 +201,162 @@ func (s *DefaultServer) GetPackageDeps(ctx context.Context, request gen.GetPacka
 	return result, nil
 }
 
+func (s *DefaultServer) GetPackageSbom(ctx context.Context, request gen.GetPackageSbomRequestObject) (gen.GetPackageSbomResponseObject, error) {
+	unescapedPurl, err := url.PathUnescape(request.Purl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unescape package url: %w", err)
+	}
+
+	format := sbom.FormatSPDX
+	if request.Params.Format != nil {
+		format, err = sbom.ParseFormat(string(*request.Params.Format))
+		if err != nil {
+			return gen.GetPackageSbom400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	if _, err := assembler_helpers.PurlToPkgFilter(unescapedPurl); err != nil {
+		return gen.GetPackageSbom400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	g, err := sbom.Collect(ctx, s.gqlClient, sbom.Subject{Purl: unescapedPurl})
+	if err != nil {
+		if errors.Is(err, sbom.ErrSubjectNotFound) {
+			return gen.GetPackageSbom400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		logging.FromContext(ctx).Errorf("SBOM export failed: %v", err)
+		return gen.GetPackageSbom502JSONResponse{
+			BadGatewayJSONResponse: gen.BadGatewayJSONResponse{
+				Message: helpers.Err502.Error(),
+			},
+		}, nil
+	}
+
+	var buf bytes.Buffer
+	if format == sbom.FormatCycloneDX {
+		err = sbom.WriteCycloneDX(g, &buf)
+	} else {
+		err = sbom.WriteSPDX(g, &buf)
+	}
+	var document map[string]interface{}
+	if err == nil {
+		err = json.Unmarshal(buf.Bytes(), &document)
+	}
+	if err != nil {
+		logging.FromContext(ctx).Errorf("SBOM export failed: %v", err)
+		return gen.GetPackageSbom500JSONResponse{
+			InternalServerErrorJSONResponse: gen.InternalServerErrorJSONResponse{
+				Message: helpers.Err500.Error(),
+			},
+		}, nil
+	}
+
+	if format == sbom.FormatCycloneDX {
+		return gen.GetPackageSbom200ApplicationVndCyclonedxPlusJSONResponse(document), nil
+	}
+	return gen.GetPackageSbom200ApplicationSpdxPlusJSONResponse(document), nil
+}
+
+func (s *DefaultServer) GetPackageVex(ctx context.Context, request gen.GetPackageVexRequestObject) (gen.GetPackageVexResponseObject, error) {
+	unescapedPurl, err := url.PathUnescape(request.Purl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unescape package url: %w", err)
+	}
+
+	format := vex.FormatOpenVEX
+	if request.Params.Format != nil {
+		format, err = vex.ParseFormat(string(*request.Params.Format))
+		if err != nil {
+			return gen.GetPackageVex400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	if _, err := assembler_helpers.PurlToPkgFilter(unescapedPurl); err != nil {
+		return gen.GetPackageVex400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	doc, err := vex.Collect(ctx, s.gqlClient, vex.Subject{Purl: unescapedPurl})
+	if err != nil {
+		if errors.Is(err, vex.ErrSubjectNotFound) {
+			return gen.GetPackageVex400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		logging.FromContext(ctx).Errorf("VEX export failed: %v", err)
+		return gen.GetPackageVex502JSONResponse{
+			BadGatewayJSONResponse: gen.BadGatewayJSONResponse{
+				Message: helpers.Err502.Error(),
+			},
+		}, nil
+	}
+
+	var buf bytes.Buffer
+	if format == vex.FormatCSAF {
+		err = vex.WriteCSAF(doc, vex.DefaultAuthor, &buf)
+	} else {
+		err = vex.WriteOpenVEX(doc, vex.DefaultAuthor, &buf)
+	}
+	var document map[string]interface{}
+	if err == nil {
+		err = json.Unmarshal(buf.Bytes(), &document)
+	}
+	if err != nil {
+		logging.FromContext(ctx).Errorf("VEX export failed: %v", err)
+		return gen.GetPackageVex500JSONResponse{
+			InternalServerErrorJSONResponse: gen.InternalServerErrorJSONResponse{
+				Message: helpers.Err500.Error(),
+			},
+		}, nil
+	}
+	return gen.GetPackageVex200JSONResponse(document), nil
+}
+
 func (s *DefaultServer) GetArtifactVulns(ctx context.Context, request gen.GetArtifactVulnsRequestObject) (gen.GetArtifactVulnsResponseObject, error) {
-	return gen.GetArtifactVulns500JSONResponse{
-		InternalServerErrorJSONResponse: gen.InternalServerErrorJSONResponse{
-			Message: "GetArtifactVulns not implemented",
+	unescapedDigest, err := url.PathUnescape(request.Digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unescape digest: %w", err)
+	}
+
+	includeDependencies := request.Params.IncludeDependencies != nil && *request.Params.IncludeDependencies
+	vulns, err := GetVulnsForArtifact(ctx, s.gqlClient, unescapedDigest, includeDependencies)
+	if err != nil {
+		return handleErr(ctx, err, GetArtifactVulns).(gen.GetArtifactVulnsResponseObject), nil
+	}
+
+	page, info, err := pagination.Paginate(ctx, vulns, request.Params.PaginationSpec)
+	if err != nil {
+		return gen.GetArtifactVulns400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	return gen.GetArtifactVulns200JSONResponse{
+		VulnerabilityListJSONResponse: gen.VulnerabilityListJSONResponse{
+			PaginationInfo:    info,
+			VulnerabilityList: page,
 		},
 	}, nil
 }
@@ -182,3 +389,78 @@ func (s *DefaultServer) GetArtifactDeps(ctx context.Context, request gen.GetArti
 
 	return result, nil
 }
+
+func (s *DefaultServer) EvaluatePolicy(ctx context.Context, request gen.EvaluatePolicyRequestObject) (gen.EvaluatePolicyResponseObject, error) {
+	if request.Body == nil {
+		return gen.EvaluatePolicy400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: "request body is required",
+			},
+		}, nil
+	}
+	p, err := policy.Parse([]byte(request.Body.Policy))
+	if err != nil {
+		return gen.EvaluatePolicy400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	var subject policy.Subject
+	if request.Body.Purl != nil {
+		subject.Purl = *request.Body.Purl
+	}
+	if request.Body.Digest != nil {
+		subject.Digest = *request.Body.Digest
+	}
+	if (subject.Purl == "") == (subject.Digest == "") {
+		return gen.EvaluatePolicy400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: "exactly one of purl or digest must be specified",
+			},
+		}, nil
+	}
+
+	result, err := policy.Evaluate(ctx, s.gqlClient, p, subject)
+	if err != nil {
+		if errors.Is(err, policy.ErrSubjectNotFound) {
+			return gen.EvaluatePolicy400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		logging.FromContext(ctx).Errorf("policy evaluation failed: %v", err)
+		return gen.EvaluatePolicy502JSONResponse{
+			BadGatewayJSONResponse: gen.BadGatewayJSONResponse{
+				Message: helpers.Err502.Error(),
+			},
+		}, nil
+	}
+
+	response := gen.EvaluatePolicy200JSONResponse{
+		PolicyResultJSONResponse: gen.PolicyResultJSONResponse{
+			Policy:  result.Policy,
+			Subject: result.Subject,
+			Pass:    result.Pass,
+			Rules:   []gen.PolicyRuleResult{},
+		},
+	}
+	for _, r := range result.Rules {
+		rule := gen.PolicyRuleResult{
+			Rule:       r.Rule,
+			Type:       string(r.Type),
+			Pass:       r.Pass,
+			Violations: []gen.PolicyViolation{},
+		}
+		for _, v := range r.Violations {
+			rule.Violations = append(rule.Violations, gen.PolicyViolation{
+				Message:  v.Message,
+				Evidence: v.Evidence,
+			})
+		}
+		response.Rules = append(response.Rules, rule)
+	}
+	return response, nil
+}
@@ -0,0 +1,103 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/server"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_GetPackageVex(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, GuacData{
+		Packages:        []string{"pkg:guac/foo@1.0", "pkg:guac/bar@1.0"},
+		Vulnerabilities: []string{"osv/osv-2024-1"},
+		IsDependencies:  []IsDependency{{DependentPkg: "pkg:guac/foo@1.0", DependencyPkg: "pkg:guac/bar@1.0"}},
+		CertifyVulns:    []CertifyVuln{{Package: "pkg:guac/bar@1.0", Vulnerability: "osv/osv-2024-1"}},
+	})
+	restApi := server.NewDefaultServer(gqlClient)
+
+	openvex := gen.Openvex
+	csaf := gen.Csaf
+	tests := []struct {
+		name   string
+		format *gen.GetPackageVexParamsFormat
+		purl   string
+		// the key of the document that holds the statements, or empty if a
+		// 400 is expected
+		wantKey string
+	}{
+		{
+			name:    "openvex by default",
+			purl:    "pkg:guac/foo@1.0",
+			wantKey: "statements",
+		},
+		{
+			name:    "openvex",
+			purl:    "pkg:guac/foo@1.0",
+			format:  &openvex,
+			wantKey: "statements",
+		},
+		{
+			name:    "csaf",
+			purl:    "pkg:guac/foo@1.0",
+			format:  &csaf,
+			wantKey: "vulnerabilities",
+		},
+		{
+			name: "unknown package",
+			purl: "pkg:guac/baz@1.0",
+		},
+		{
+			name: "invalid purl",
+			purl: "not-a-purl",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := restApi.GetPackageVex(ctx, gen.GetPackageVexRequestObject{
+				Purl:   tt.purl,
+				Params: gen.GetPackageVexParams{Format: tt.format},
+			})
+			if err != nil {
+				t.Fatalf("GetPackageVex returned unexpected error: %v", err)
+			}
+			switch v := res.(type) {
+			case gen.GetPackageVex200JSONResponse:
+				if tt.wantKey == "" {
+					t.Fatalf("GetPackageVex returned a document, wanted a bad request")
+				}
+				items, ok := v[tt.wantKey].([]interface{})
+				if !ok || len(items) != 1 {
+					t.Errorf("GetPackageVex returned a document without one of %s: %v", tt.wantKey, v)
+				}
+			case gen.GetPackageVex400JSONResponse:
+				if tt.wantKey != "" {
+					t.Fatalf("GetPackageVex returned unexpected bad request: %s", v.Message)
+				}
+			default:
+				t.Fatalf("GetPackageVex returned unexpected response %T", res)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/pagination"
+	"github.com/guacsec/guac/pkg/guacrest/server"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// vulnsTestData is a package foo with an SBOM that includes bar and baz, and
+// an artifact that is an occurrence of foo, with an SBOM that includes bar.
+// The vulnerability of baz is covered by a NOT_AFFECTED VEX statement, and
+// the one of qux by a statement about a vulnerability equal to it.
+var vulnsTestData = GuacData{
+	Packages:        []string{"pkg:guac/foo@1.0", "pkg:guac/bar@1.0", "pkg:guac/baz@1.0", "pkg:guac/qux@1.0"},
+	Artifacts:       []string{"sha-xyz"},
+	Vulnerabilities: []string{"osv/osv-1", "cve/cve-1", "osv/osv-2", "osv/osv-3"},
+	HasSboms: []HasSbom{
+		{Subject: "pkg:guac/foo@1.0", IncludedSoftware: []string{"pkg:guac/bar@1.0", "pkg:guac/baz@1.0"}},
+		{Subject: "sha-xyz", IncludedSoftware: []string{"pkg:guac/bar@1.0"}},
+	},
+	IsOccurrences: []IsOccurrence{{Subject: "pkg:guac/foo@1.0", Artifact: "sha-xyz"}},
+	CertifyVulns: []CertifyVuln{
+		{Package: "pkg:guac/foo@1.0", Vulnerability: "osv/osv-1"},
+		{Package: "pkg:guac/bar@1.0", Vulnerability: "osv/osv-2"},
+		{Package: "pkg:guac/baz@1.0", Vulnerability: "osv/osv-3"},
+		{Package: "pkg:guac/qux@1.0", Vulnerability: "cve/cve-1"},
+	},
+	VulnEquals: []VulnEqual{{VulnerabilityA: "osv/osv-1", VulnerabilityB: "cve/cve-1"}},
+	VexStatements: []VexStatement{
+		{Subject: "pkg:guac/baz@1.0", Vulnerability: "osv/osv-3"},
+		{Subject: "pkg:guac/qux@1.0", Vulnerability: "osv/osv-1"},
+	},
+}
+
+type vuln struct {
+	Package string
+	IDs     []string
+}
+
+func vulnsOf(lst []gen.Vulnerability) []vuln {
+	res := []vuln{}
+	for _, v := range lst {
+		res = append(res, vuln{Package: v.Package, IDs: v.Vulnerability.VulnerabilityIDs})
+	}
+	return res
+}
+
+func Test_GetPackageVulns(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, vulnsTestData)
+	restApi := server.NewDefaultServer(gqlClient)
+
+	tests := []struct {
+		name                string
+		purl                string
+		includeDependencies bool
+		pageSize            *int
+		expected            []vuln
+		wantNextCursor      bool
+		wantBadRequest      bool
+	}{
+		{
+			name:     "vulnerabilities of the package",
+			purl:     "pkg:guac/foo@1.0",
+			expected: []vuln{{Package: "pkg:guac/foo@1.0", IDs: []string{"osv-1", "cve-1"}}},
+		},
+		{
+			name:                "vulnerabilities of the dependencies, without the not affected ones",
+			purl:                "pkg:guac/foo@1.0",
+			includeDependencies: true,
+			expected: []vuln{
+				{Package: "pkg:guac/bar@1.0", IDs: []string{"osv-2"}},
+				{Package: "pkg:guac/foo@1.0", IDs: []string{"osv-1", "cve-1"}},
+			},
+		},
+		{
+			name:                "paginated",
+			purl:                "pkg:guac/foo@1.0",
+			includeDependencies: true,
+			pageSize:            pagination.PointerOf(1),
+			expected:            []vuln{{Package: "pkg:guac/bar@1.0", IDs: []string{"osv-2"}}},
+			wantNextCursor:      true,
+		},
+		{
+			name: "not affected by an equal vulnerability",
+			purl: "pkg:guac/qux@1.0",
+		},
+		{
+			name:           "unknown package",
+			purl:           "pkg:guac/unknown@1.0",
+			wantBadRequest: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := restApi.GetPackageVulns(ctx, gen.GetPackageVulnsRequestObject{
+				Purl: tt.purl,
+				Params: gen.GetPackageVulnsParams{
+					IncludeDependencies: &tt.includeDependencies,
+					PaginationSpec:      &gen.PaginationSpec{PageSize: tt.pageSize},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetPackageVulns returned unexpected error: %v", err)
+			}
+			switch v := res.(type) {
+			case gen.GetPackageVulns200JSONResponse:
+				if tt.wantBadRequest {
+					t.Fatalf("GetPackageVulns returned vulnerabilities, wanted a bad request")
+				}
+				if diff := cmp.Diff(tt.expected, vulnsOf(v.VulnerabilityList), cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("GetPackageVulns returned unexpected vulnerabilities (-want +got):\n%s", diff)
+				}
+				if (v.PaginationInfo.NextCursor != nil) != tt.wantNextCursor {
+					t.Errorf("GetPackageVulns returned next cursor %v, wanted one: %v", v.PaginationInfo.NextCursor, tt.wantNextCursor)
+				}
+			case gen.GetPackageVulns400JSONResponse:
+				if !tt.wantBadRequest {
+					t.Fatalf("GetPackageVulns returned unexpected bad request: %s", v.Message)
+				}
+			default:
+				t.Fatalf("GetPackageVulns returned unexpected response %T", res)
+			}
+		})
+	}
+}
+
+func Test_GetArtifactVulns(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, vulnsTestData)
+	restApi := server.NewDefaultServer(gqlClient)
+
+	tests := []struct {
+		name                string
+		digest              string
+		includeDependencies bool
+		expected            []vuln
+		wantBadRequest      bool
+	}{
+		{
+			name:     "vulnerabilities of the occurrence",
+			digest:   "sha-xyz",
+			expected: []vuln{{Package: "pkg:guac/foo@1.0", IDs: []string{"osv-1", "cve-1"}}},
+		},
+		{
+			name:                "vulnerabilities of the dependencies",
+			digest:              "sha-xyz",
+			includeDependencies: true,
+			expected: []vuln{
+				{Package: "pkg:guac/bar@1.0", IDs: []string{"osv-2"}},
+				{Package: "pkg:guac/foo@1.0", IDs: []string{"osv-1", "cve-1"}},
+			},
+		},
+		{
+			name:           "unknown artifact",
+			digest:         "sha-unknown",
+			wantBadRequest: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := restApi.GetArtifactVulns(ctx, gen.GetArtifactVulnsRequestObject{
+				Digest: tt.digest,
+				Params: gen.GetArtifactVulnsParams{IncludeDependencies: &tt.includeDependencies},
+			})
+			if err != nil {
+				t.Fatalf("GetArtifactVulns returned unexpected error: %v", err)
+			}
+			switch v := res.(type) {
+			case gen.GetArtifactVulns200JSONResponse:
+				if tt.wantBadRequest {
+					t.Fatalf("GetArtifactVulns returned vulnerabilities, wanted a bad request")
+				}
+				if diff := cmp.Diff(tt.expected, vulnsOf(v.VulnerabilityList), cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("GetArtifactVulns returned unexpected vulnerabilities (-want +got):\n%s", diff)
+				}
+			case gen.GetArtifactVulns400JSONResponse:
+				if !tt.wantBadRequest {
+					t.Fatalf("GetArtifactVulns returned unexpected bad request: %s", v.Message)
+				}
+			default:
+				t.Fatalf("GetArtifactVulns returned unexpected response %T", res)
+			}
+		})
+	}
+}
@@ -46,6 +46,15 @@ type Collector interface {
 	Type() string
 }
 
+// EmitAcknowledger is implemented by the collectors that keep track of the
+// documents they collected, such as in a checkpoint. Collect reports the result
+// of emitting each collected document to them, so that they only record the
+// documents that were emitted. Documents sent by other collectors are reported
+// as well and must be ignored.
+type EmitAcknowledger interface {
+	Emitted(ctx context.Context, d *processor.Document, err error)
+}
+
 type DeregisterCollector interface {
 	DeregisterCollector(collectorType string) error
 }
@@ -105,9 +114,7 @@ func Collect(ctx context.Context, emitter Emitter, handleErr ErrHandler) error {
 			AddChildLogger(logger, d)
 			logger.Debugf("starting up the child logger: %+v", d.SourceInformation.Source)
 
-			if err := emitter(d); err != nil {
-				d.ChildLogger.Errorf("emit error: %v", err)
-			}
+			emit(ctx, emitter, d)
 		case err := <-errChan:
 			if !handleErr(err) {
 				return err
@@ -121,13 +128,25 @@ func Collect(ctx context.Context, emitter Emitter, handleErr ErrHandler) error {
 		d := <-docChan
 		AddChildLogger(logger, d)
 		logger.Debugf("starting up the child logger: %+v", d.SourceInformation.Source)
-		if err := emitter(d); err != nil {
-			d.ChildLogger.Errorf("emit error: %v", err)
-		}
+		emit(ctx, emitter, d)
 	}
 	return nil
 }
 
+// emit emits d and reports the result to the collectors acknowledging their
+// documents.
+func emit(ctx context.Context, emitter Emitter, d *processor.Document) {
+	err := emitter(d)
+	if err != nil {
+		d.ChildLogger.Errorf("emit error: %v", err)
+	}
+	for _, c := range documentCollectors {
+		if a, ok := c.(EmitAcknowledger); ok {
+			a.Emitted(ctx, d, err)
+		}
+	}
+}
+
 // Publish takes the "document" collected by the collectors and stores it into a blob store for
 // retrieval by the processor/ingestor. A CDEvent is created to transmit the key (which is the
 // sha256 of the collected "document"). This also fixes the issues where the "document" was too large
@@ -125,8 +125,10 @@ func (d *depsCollector) populatePurls(ctx context.Context, docChannel chan<- *pr
 	if !d.retrieveDependencies {
 		// Retrieve versions and projects for all purls concurrently
 		d.dc.RetrieveVersionsAndProjects(ctx, purlStrings)
-		for _, purl := range purlStrings {
+		for _, src := range ds.PurlDataSources {
+			purl := src.Value
 			if d.checkedPurls[purl] {
+				datasource.ReportCollected(ctx, d.collectDataSource, src)
 				continue
 			}
 			d.checkedPurls[purl] = true
@@ -137,6 +139,7 @@ func (d *depsCollector) populatePurls(ctx context.Context, docChannel chan<- *pr
 				return err
 			}
 			emitComponents(ctx, components, docChannel)
+			datasource.ReportCollected(ctx, d.collectDataSource, src)
 		}
 		return nil
 	}
@@ -144,8 +147,10 @@ func (d *depsCollector) populatePurls(ctx context.Context, docChannel chan<- *pr
 	if err := d.dc.RetrieveDependencies(ctx, purlStrings); err != nil {
 		return fmt.Errorf("failed to get all dependencies: %w", err)
 	}
-	for _, purl := range purlStrings {
+	for _, src := range ds.PurlDataSources {
+		purl := src.Value
 		if d.checkedPurls[purl] {
+			datasource.ReportCollected(ctx, d.collectDataSource, src)
 			continue
 		}
 		d.checkedPurls[purl] = true
@@ -156,6 +161,7 @@ func (d *depsCollector) populatePurls(ctx context.Context, docChannel chan<- *pr
 		}
 
 		emitComponents(ctx, components, docChannel)
+		datasource.ReportCollected(ctx, d.collectDataSource, src)
 
 		// add artificial latency to throttle the pagination query
 		if d.addedLatency != nil {
@@ -25,6 +25,7 @@ import (
 
 	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
 )
 
 const (
@@ -36,14 +37,48 @@ type fileCollector struct {
 	lastChecked time.Time
 	poll        bool
 	interval    time.Duration
+	// watch the path for new and modified files instead of polling it
+	watch bool
+	// debounce is how long a watched file must be left unchanged before it
+	// is collected, so that files being written are not collected partially
+	debounce time.Duration
+	// checkpointFile persists the checkpoint, which is kept in memory if empty
+	checkpointFile string
+	checkpoint     *checkpoint
 }
 
-func NewFileCollector(ctx context.Context, path string, poll bool, interval time.Duration) *fileCollector {
-	return &fileCollector{
+type Opt func(*fileCollector)
+
+// WithWatch watches the path for new, modified, renamed and removed files with
+// inotify (or the equivalent of the platform) and only collects new or
+// modified files, instead of walking the path once or on every poll.
+func WithWatch(watch bool) Opt {
+	return func(f *fileCollector) {
+		f.watch = watch
+	}
+}
+
+// WithCheckpoint persists the content hashes of the collected files to
+// checkpointFile, so that files are only collected again once their content
+// changes, also across restarts of the collector. Files are only recorded once
+// their documents were emitted by collector.Collect.
+func WithCheckpoint(checkpointFile string) Opt {
+	return func(f *fileCollector) {
+		f.checkpointFile = checkpointFile
+	}
+}
+
+func NewFileCollector(ctx context.Context, path string, poll bool, interval time.Duration, opts ...Opt) *fileCollector {
+	f := &fileCollector{
 		path:     path,
 		poll:     poll,
 		interval: interval,
+		debounce: defaultDebounce,
+	}
+	for _, opt := range opts {
+		opt(f)
 	}
+	return f
 }
 
 // RetrieveArtifacts collects the documents from the collector. It emits each collected
@@ -60,6 +95,44 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 		return fmt.Errorf("unknown error on os.Stat for FileCollector path: %w", err)
 	}
 
+	if f.checkpoint == nil && (f.watch || f.checkpointFile != "") {
+		c, err := loadCheckpoint(f.checkpointFile)
+		if err != nil {
+			return err
+		}
+		f.checkpoint = c
+	}
+
+	if f.watch {
+		return f.watchArtifacts(ctx, docChannel)
+	}
+
+	for {
+		if err := f.walk(ctx, f.path, f.lastChecked, func(path string) error {
+			return f.collectFile(path, docChannel)
+		}); err != nil {
+			return err
+		}
+		if err := f.checkpoint.save(); err != nil {
+			return err
+		}
+		f.lastChecked = time.Now()
+		if !f.poll {
+			break
+		}
+		select {
+		// If the context has been canceled it contains an err which we can throw.
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		case <-time.After(f.interval):
+		}
+	}
+
+	return nil
+}
+
+// walk calls fn for the files under root that were modified after lastChecked.
+func (f *fileCollector) walk(ctx context.Context, root string, lastChecked time.Time, fn func(path string) error) error {
 	readFunc := func(path string, dirEntry fs.DirEntry, err error) error {
 		// If the context has been canceled it contains an err which we can throw.
 		// When it gets thrown a second time will cancel the walk.
@@ -79,50 +152,72 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 		if err != nil {
 			return fmt.Errorf("unknown error on dirEntry.Info while walking path: %w", err)
 		}
-		if !info.ModTime().After(f.lastChecked) {
+		if !info.ModTime().After(lastChecked) {
 			return nil
 		}
+		return fn(path)
+	}
 
-		blob, err := os.ReadFile(path)
-		if err != nil {
-			return fmt.Errorf("error reading file: %s, err: %w", path, err)
-		}
+	if err := filepath.WalkDir(root, readFunc); err != nil {
+		return fmt.Errorf("error walking path: %s, err: %w", root, err)
+	}
+	return nil
+}
 
-		doc := &processor.Document{
-			Blob:   blob,
-			Type:   processor.DocumentUnknown,
-			Format: processor.FormatUnknown,
-			SourceInformation: processor.SourceInformation{
-				Collector:   string(FileCollector),
-				Source:      fmt.Sprintf("file:///%s", path),
-				DocumentRef: events.GetDocRef(blob),
-			},
+// collectFile emits the document of the file at path, unless the checkpoint
+// shows that its content was already collected or is in flight.
+func (f *fileCollector) collectFile(path string, docChannel chan<- *processor.Document) error {
+	if f.checkpointFile != "" {
+		// the checkpoint may be kept in the collected path
+		clean := filepath.Clean(path)
+		if clean == filepath.Clean(f.checkpointFile) || clean == filepath.Clean(f.checkpointFile+".tmp") {
+			return nil
 		}
+	}
 
-		docChannel <- doc
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading file: %s, err: %w", path, err)
+	}
 
+	key := events.GetKey(blob)
+	if f.checkpoint.seen(key, path) {
 		return nil
 	}
 
-	for {
-		if err := filepath.WalkDir(f.path, readFunc); err != nil {
-			return fmt.Errorf("error walking path: %s, err: %w", f.path, err)
-		}
-		f.lastChecked = time.Now()
-		if !f.poll {
-			break
-		}
-		select {
-		// If the context has been canceled it contains an err which we can throw.
-		case <-ctx.Done():
-			return ctx.Err() // nolint:wrapcheck
-		case <-time.After(f.interval):
-		}
+	doc := &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   string(FileCollector),
+			Source:      fmt.Sprintf("file:///%s", path),
+			DocumentRef: events.GetDocRef(blob),
+		},
 	}
 
+	// the document is only recorded in the checkpoint once it was emitted
+	f.checkpoint.sent(doc, key, path)
+	docChannel <- doc
+
 	return nil
 }
 
+// Emitted records the document in the checkpoint if it was emitted without
+// error, so that it is collected again otherwise. The checkpoint is saved once
+// no document is in flight.
+func (f *fileCollector) Emitted(ctx context.Context, d *processor.Document, err error) {
+	if d.SourceInformation.Collector != FileCollector {
+		return
+	}
+	if !f.checkpoint.emitted(d, err) || !f.checkpoint.idle() {
+		return
+	}
+	if err := f.checkpoint.save(); err != nil {
+		logging.FromContext(ctx).Errorf("failed to save the checkpoint: %v", err)
+	}
+}
+
 // Type returns the collector type
 func (f *fileCollector) Type() string {
 	return FileCollector
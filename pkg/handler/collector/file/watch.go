@@ -0,0 +1,394 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const defaultDebounce = 500 * time.Millisecond
+
+// watchArtifacts collects the files that are not in the checkpoint yet and then
+// collects the files that are created or modified under the path until the
+// context is canceled.
+func (f *fileCollector) watchArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("error creating watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// pending files are collected once they were left unchanged for the
+	// debounce duration
+	pending := map[string]time.Time{}
+	addPending := func(path string) error {
+		pending[path] = time.Now()
+		return nil
+	}
+
+	// the directories are watched before they are walked, so that no file
+	// created in between is missed
+	if err := addWatches(watcher, f.path); err != nil {
+		return err
+	}
+	if err := f.walk(ctx, f.path, time.Time{}, addPending); err != nil {
+		return err
+	}
+
+	debounce := f.debounce
+	if debounce <= 0 {
+		debounce = defaultDebounce
+	}
+	ticker := time.NewTicker(debounce / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		// If the context has been canceled it contains an err which we can throw.
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			if !errors.Is(err, fsnotify.ErrEventOverflow) {
+				return fmt.Errorf("error watching path: %s, err: %w", f.path, err)
+			}
+			// events were dropped, so look for changes by walking the path
+			logger.Warnf("watch events of path %s overflowed, walking the path", f.path)
+			if err := f.walk(ctx, f.path, time.Time{}, addPending); err != nil {
+				return err
+			}
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			switch {
+			case event.Has(fsnotify.Create):
+				info, err := os.Stat(event.Name)
+				if err != nil {
+					// removed again before the event was handled
+					continue
+				}
+				if !info.IsDir() {
+					_ = addPending(event.Name)
+					continue
+				}
+				// a new directory has to be watched, and files may have been
+				// created in it before it was
+				if err := addWatches(watcher, event.Name); err != nil {
+					return err
+				}
+				if err := f.walk(ctx, event.Name, time.Time{}, addPending); err != nil {
+					return err
+				}
+			case event.Has(fsnotify.Write):
+				_ = addPending(event.Name)
+			case event.Has(fsnotify.Remove), event.Has(fsnotify.Rename):
+				// the new name of a renamed file gets a create event, and is
+				// not collected again as its content stays in the checkpoint
+				// until it is pruned
+				logger.Debugf("file %s was removed or renamed", event.Name)
+				delete(pending, event.Name)
+				if f.checkpoint.remove(event.Name) {
+					if err := f.checkpoint.save(); err != nil {
+						return err
+					}
+				}
+			}
+
+		case <-ticker.C:
+			for path, changed := range pending {
+				if time.Since(changed) < debounce {
+					continue
+				}
+				delete(pending, path)
+				info, err := os.Stat(path)
+				if err != nil || !info.Mode().IsRegular() {
+					continue
+				}
+				// a file that cannot be read, such as one removed since, is
+				// skipped instead of stopping the watch
+				if err := f.collectFile(path, docChannel); err != nil {
+					logger.Warnf("skipping file %s: %v", path, err)
+				}
+			}
+			// the files removed earlier than the debounce were not renamed,
+			// as the new name of a renamed file was collected since
+			f.checkpoint.prune(time.Now().Add(-2 * debounce))
+			if err := f.checkpoint.save(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// addWatches watches root and the directories under it.
+func addWatches(watcher *fsnotify.Watcher, root string) error {
+	err := filepath.WalkDir(root, func(path string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("path: %s is invalid", path)
+		}
+		if !dirEntry.IsDir() {
+			return nil
+		}
+		if err := watcher.Add(path); err != nil {
+			return fmt.Errorf("error watching path: %s, err: %w", path, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error walking path: %s, err: %w", root, err)
+	}
+	return nil
+}
+
+// checkpoint records the content hashes of the collected files, so that files
+// are only collected again once their content changes. A document is only
+// recorded once it was emitted, until then it is in flight. A nil checkpoint
+// records nothing.
+type checkpoint struct {
+	file string
+	// Processed maps the blob store key of each collected document to the path
+	// of the file it was last seen at, which is empty once the file is removed.
+	Processed map[string]string `json:"processed"`
+
+	mu sync.Mutex
+	// inFlight holds the documents sent on the channel that were not emitted
+	// yet, and inFlightKeys counts them by key.
+	inFlight     map[*processor.Document]*inFlightDocument
+	inFlightKeys map[string]int
+	// removed holds when the files of the Processed keys with an empty path
+	// were removed, so that they are pruned once they were not found again
+	removed map[string]time.Time
+	dirty   bool
+}
+
+type inFlightDocument struct {
+	key  string
+	path string
+}
+
+// loadCheckpoint reads the checkpoint persisted to file, which is not
+// persisted if file is empty. The entries of the files that were removed since
+// are pruned.
+func loadCheckpoint(file string) (*checkpoint, error) {
+	c := &checkpoint{file: file, Processed: map[string]string{}}
+	c.init()
+	if file == "" {
+		return c, nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		return nil, fmt.Errorf("error reading checkpoint: %s, err: %w", file, err)
+	}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("error parsing checkpoint: %s, err: %w", file, err)
+	}
+	if c.Processed == nil {
+		c.Processed = map[string]string{}
+	}
+	for key, path := range c.Processed {
+		if path == "" {
+			delete(c.Processed, key)
+			continue
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			delete(c.Processed, key)
+		}
+	}
+	return c, nil
+}
+
+func (c *checkpoint) init() {
+	c.inFlight = map[*processor.Document]*inFlightDocument{}
+	c.inFlightKeys = map[string]int{}
+	c.removed = map[string]time.Time{}
+}
+
+// seen returns whether the content with the key was already collected or is in
+// flight, and records path as its location as the file may have been renamed
+// or copied.
+func (c *checkpoint) seen(key, path string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.inFlightKeys[key] > 0 {
+		for _, d := range c.inFlight {
+			if d.key == key {
+				d.path = path
+			}
+		}
+		return true
+	}
+	if _, ok := c.Processed[key]; !ok {
+		return false
+	}
+	if c.Processed[key] != path {
+		c.Processed[key] = path
+		delete(c.removed, key)
+		c.dirty = true
+	}
+	return true
+}
+
+// sent records that the document d with the key of the file at path is in
+// flight.
+func (c *checkpoint) sent(d *processor.Document, key, path string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inFlight[d] = &inFlightDocument{key: key, path: path}
+	c.inFlightKeys[key]++
+}
+
+// emitted records the document d as collected if it was emitted without
+// error. Documents that failed to be emitted are forgotten, so that they are
+// collected again. It returns whether d was in flight.
+func (c *checkpoint) emitted(d *processor.Document, err error) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f, ok := c.inFlight[d]
+	if !ok {
+		return false
+	}
+	delete(c.inFlight, d)
+	if c.inFlightKeys[f.key]--; c.inFlightKeys[f.key] == 0 {
+		delete(c.inFlightKeys, f.key)
+	}
+	if err == nil {
+		c.Processed[f.key] = f.path
+		delete(c.removed, f.key)
+		c.dirty = true
+	}
+	return true
+}
+
+// idle returns whether no document is in flight.
+func (c *checkpoint) idle() bool {
+	if c == nil {
+		return true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.inFlight) == 0
+}
+
+// remove records that the file or directory at path was removed, and returns
+// whether the checkpoint changed. Its content stays collected until it is
+// pruned, so that a renamed file is not collected again.
+func (c *checkpoint) remove(path string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	prefix := path + string(filepath.Separator)
+	removed := func(p string) bool {
+		return p != "" && (p == path || strings.HasPrefix(p, prefix))
+	}
+	for _, d := range c.inFlight {
+		if removed(d.path) {
+			d.path = ""
+		}
+	}
+	changed := false
+	now := time.Now()
+	for key, p := range c.Processed {
+		if removed(p) {
+			c.Processed[key] = ""
+			c.removed[key] = now
+			changed = true
+		}
+	}
+	c.dirty = c.dirty || changed
+	return changed
+}
+
+// prune forgets the content of the files that were removed before the given
+// time and were not found again since, and returns whether the checkpoint
+// changed.
+func (c *checkpoint) prune(before time.Time) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	changed := false
+	for key, removed := range c.removed {
+		if removed.Before(before) {
+			delete(c.removed, key)
+			if c.Processed[key] == "" {
+				delete(c.Processed, key)
+				changed = true
+			}
+		}
+	}
+	c.dirty = c.dirty || changed
+	return changed
+}
+
+// save persists the checkpoint if it changed since it was last saved,
+// replacing the previous one atomically.
+func (c *checkpoint) save() error {
+	if c == nil || c.file == "" {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.dirty {
+		return nil
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("error marshalling checkpoint: %w", err)
+	}
+	tmp := c.file + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("error writing checkpoint: %s, err: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, c.file); err != nil {
+		return fmt.Errorf("error writing checkpoint: %s, err: %w", c.file, err)
+	}
+	c.dirty = false
+	return nil
+}
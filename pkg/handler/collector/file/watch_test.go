@@ -0,0 +1,224 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func Test_fileCollector_Watch(t *testing.T) {
+	dir := t.TempDir()
+	checkpointFile := filepath.Join(dir, "checkpoint.json")
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// start watching, and return a function stopping the watch which returns
+	// the sources of the collected documents, which are emitted as they are
+	// collected
+	watch := func() func() map[string]string {
+		f := NewFileCollector(context.Background(), dir, false, 0, WithWatch(true), WithCheckpoint(checkpointFile))
+		f.debounce = 50 * time.Millisecond
+		ctx, cancel := context.WithCancel(context.Background())
+		docChannel := make(chan *processor.Document, 10)
+		errChannel := make(chan error, 1)
+		go func() {
+			errChannel <- f.RetrieveArtifacts(ctx, docChannel)
+		}()
+		docs := map[string]string{}
+		emitted := make(chan struct{})
+		go func() {
+			defer close(emitted)
+			for d := range docChannel {
+				if d.SourceInformation.DocumentRef != events.GetDocRef(d.Blob) {
+					t.Errorf("unexpected document ref %s", d.SourceInformation.DocumentRef)
+				}
+				docs[d.SourceInformation.Source] = string(d.Blob)
+				f.Emitted(ctx, d, nil)
+			}
+		}()
+		return func() map[string]string {
+			// leave the watch time to collect the last changes
+			time.Sleep(300 * time.Millisecond)
+			cancel()
+			if err := <-errChannel; !errors.Is(err, context.Canceled) {
+				t.Errorf("fileCollector.RetrieveArtifacts() = %v, want %v", err, context.Canceled)
+			}
+			close(docChannel)
+			<-emitted
+			return docs
+		}
+	}
+	source := func(name string) string {
+		return "file:///" + filepath.Join(dir, name)
+	}
+	assertDocs := func(got, want map[string]string) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Errorf("collected documents = %v, want %v", got, want)
+			return
+		}
+		for k, v := range want {
+			if got[k] != v {
+				t.Errorf("collected documents = %v, want %v", got, want)
+				return
+			}
+		}
+	}
+
+	write("existing.json", "existing")
+	stop := watch()
+	time.Sleep(100 * time.Millisecond)
+	write("new.json", "new")
+	write("modified.json", "before")
+	time.Sleep(200 * time.Millisecond)
+	write("modified.json", "after")
+	write("sub/nested.json", "nested")
+	time.Sleep(200 * time.Millisecond)
+	// renamed and removed files are not collected again
+	if err := os.Rename(filepath.Join(dir, "new.json"), filepath.Join(dir, "renamed.json")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, "existing.json")); err != nil {
+		t.Fatal(err)
+	}
+	assertDocs(stop(), map[string]string{
+		source("existing.json"):   "existing",
+		source("new.json"):        "new",
+		source("modified.json"):   "after",
+		source("sub/nested.json"): "nested",
+	})
+
+	// the removed file is pruned from the checkpoint, and the renamed one is
+	// recorded at its new path
+	saved, err := loadCheckpoint(checkpointFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path, ok := saved.Processed[events.GetKey([]byte("existing"))]; ok {
+		t.Errorf("checkpoint path of the removed file = %q, want it to be pruned", path)
+	}
+	if path := saved.Processed[events.GetKey([]byte("new"))]; path != filepath.Join(dir, "renamed.json") {
+		t.Errorf("checkpoint path of the renamed file = %q, want %q", path, filepath.Join(dir, "renamed.json"))
+	}
+
+	// the checkpoint keeps a restarted watch from collecting the files again,
+	// except for the content of removed files
+	write("restored.json", "existing")
+	write("while-stopped.json", "while stopped")
+	stop = watch()
+	assertDocs(stop(), map[string]string{
+		source("restored.json"):      "existing",
+		source("while-stopped.json"): "while stopped",
+	})
+}
+
+func Test_fileCollector_CheckpointEmitted(t *testing.T) {
+	dir := t.TempDir()
+	checkpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+	for name, content := range map[string]string{"ok.json": "ok", "failed.json": "failed"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// collect the files once, and emit them without error unless they are
+	// listed in failed
+	collect := func(failed map[string]bool) []string {
+		t.Helper()
+		ctx := context.Background()
+		f := NewFileCollector(ctx, dir, false, 0, WithCheckpoint(checkpointFile))
+		docChannel := make(chan *processor.Document, 10)
+		if err := f.RetrieveArtifacts(ctx, docChannel); err != nil {
+			t.Fatalf("fileCollector.RetrieveArtifacts() = %v", err)
+		}
+		close(docChannel)
+		var collected []string
+		for d := range docChannel {
+			collected = append(collected, string(d.Blob))
+			var err error
+			if failed[string(d.Blob)] {
+				err = errors.New("ingestion failed")
+			}
+			f.Emitted(ctx, d, err)
+		}
+		sort.Strings(collected)
+		return collected
+	}
+
+	if got := collect(map[string]bool{"failed": true}); !slices.Equal(got, []string{"failed", "ok"}) {
+		t.Errorf("collected %v, want both files", got)
+	}
+	// only the document that failed to be emitted is collected again
+	if got := collect(nil); !slices.Equal(got, []string{"failed"}) {
+		t.Errorf("collected %v, want the file that failed to be emitted", got)
+	}
+	if got := collect(nil); len(got) != 0 {
+		t.Errorf("collected %v, want no file", got)
+	}
+}
+
+func Test_fileCollector_WatchSkipsUnreadableFiles(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("root can read files without permissions")
+	}
+	dir := t.TempDir()
+	f := NewFileCollector(context.Background(), dir, false, 0, WithWatch(true))
+	f.debounce = 50 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	docChannel := make(chan *processor.Document, 10)
+	errChannel := make(chan error, 1)
+	go func() {
+		errChannel <- f.RetrieveArtifacts(ctx, docChannel)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	if err := os.WriteFile(filepath.Join(dir, "unreadable.json"), []byte("unreadable"), 0o200); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if err := os.WriteFile(filepath.Join(dir, "readable.json"), []byte("readable"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case d := <-docChannel:
+		if string(d.Blob) != "readable" {
+			t.Errorf("collected %q, want the readable file", d.Blob)
+		}
+	case err := <-errChannel:
+		t.Fatalf("fileCollector.RetrieveArtifacts() = %v, want the watch to go on", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the readable file was not collected")
+	}
+}
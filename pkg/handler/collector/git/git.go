@@ -17,13 +17,20 @@ package git_collector
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-git/go-git/v5"
-	"github.com/guacsec/guac/pkg/handler/collector"
-	"github.com/guacsec/guac/pkg/handler/collector/file"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/gobwas/glob"
+	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 	"go.uber.org/zap"
@@ -35,27 +42,74 @@ const (
 
 // gitDocumentCollector collects documents from a Git repository (GitHub, GitLab, etc.)
 // The collector clones the repository to a local directory or pulls any updates from the repository if it has been cloned previously.
-// It emits each collected document to the collector to be processed.
+// It emits each document of the checked out commit, and optionally of each tagged release, to the collector to be processed.
 // The collector can either run once and grab all the artifacts or keep running and check for new artifacts based on the polling rate.
 type gitDocumentCollector struct {
-	url           string
-	dir           string
-	lastChecked   time.Time
-	poll          bool
-	interval      time.Duration
-	fileCollector collector.Collector
+	url         string
+	dir         string
+	lastChecked time.Time
+	poll        bool
+	interval    time.Duration
+	includes    []glob.Glob
+	excludes    []glob.Glob
+	tagHistory  bool
+	// lastHead is the last commit whose documents were collected
+	lastHead plumbing.Hash
+	// collectedTags are the tags whose documents were collected
+	collectedTags map[string]bool
 }
 
-func NewGitDocumentCollector(ctx context.Context, url string, dir string, poll bool, interval time.Duration) *gitDocumentCollector {
-	fileCollector := file.NewFileCollector(ctx, dir, false, time.Second)
+type Opt func(*gitDocumentCollector) error
 
-	return &gitDocumentCollector{
-		url:           url,
-		dir:           dir,
-		poll:          poll,
-		interval:      interval,
-		fileCollector: fileCollector,
+// WithIncludes only collects the files whose path in the repository matches
+// one of the glob patterns, e.g. "**/*.spdx.json". All files are collected if
+// no include patterns are given.
+func WithIncludes(patterns ...string) Opt {
+	return func(g *gitDocumentCollector) error {
+		globs, err := compileGlobs(patterns)
+		if err != nil {
+			return fmt.Errorf("invalid include pattern: %w", err)
+		}
+		g.includes = append(g.includes, globs...)
+		return nil
+	}
+}
+
+// WithExcludes skips the files whose path in the repository matches one of
+// the glob patterns.
+func WithExcludes(patterns ...string) Opt {
+	return func(g *gitDocumentCollector) error {
+		globs, err := compileGlobs(patterns)
+		if err != nil {
+			return fmt.Errorf("invalid exclude pattern: %w", err)
+		}
+		g.excludes = append(g.excludes, globs...)
+		return nil
+	}
+}
+
+// WithTagHistory also collects the documents as they were at each tagged
+// release of the repository.
+func WithTagHistory(tagHistory bool) Opt {
+	return func(g *gitDocumentCollector) error {
+		g.tagHistory = tagHistory
+		return nil
+	}
+}
+
+func NewGitDocumentCollector(ctx context.Context, url string, dir string, poll bool, interval time.Duration, opts ...Opt) (*gitDocumentCollector, error) {
+	g := &gitDocumentCollector{
+		url:      url,
+		dir:      dir,
+		poll:     poll,
+		interval: interval,
 	}
+	for _, opt := range opts {
+		if err := opt(g); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
 }
 
 // RetrieveArtifacts collects the documents from the collector. It emits each collected
@@ -106,17 +160,42 @@ func (g *gitDocumentCollector) createOrPull(ctx context.Context, logger *zap.Sug
 		if err != nil {
 			return fmt.Errorf("error cloning repo: %w", err)
 		}
-		err = g.fileCollector.RetrieveArtifacts(ctx, docChannel)
+		r, err := git.PlainOpen(g.dir)
 		if err != nil {
+			return fmt.Errorf("error opening repo: %w", err)
+		}
+		if g.collectedTags == nil {
+			g.collectedTags = map[string]bool{}
+		}
+		if err := g.collectRevisions(ctx, r, true, docChannel); err != nil {
 			return fmt.Errorf("error retrieving artifacts: %w", err)
 		}
 	} else {
-		err := pullRepo(logger, g.dir)
+		r, err := git.PlainOpen(g.dir)
+		if err != nil {
+			return fmt.Errorf("error opening repo: %w", err)
+		}
+		if g.collectedTags == nil {
+			// the tags of a previously cloned repository were collected by the
+			// run that cloned it
+			g.collectedTags, err = tagNames(r)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = pullRepo(logger, g.dir)
 		if err != nil && err != git.NoErrAlreadyUpToDate {
 			return fmt.Errorf("error pulling repo: %w", err)
-		} else if err == nil {
-			err = g.fileCollector.RetrieveArtifacts(ctx, docChannel)
-			if err != nil {
+		}
+		pulled := err == nil
+		if g.tagHistory {
+			if err := fetchTags(r); err != nil {
+				return err
+			}
+		}
+		if pulled || g.tagHistory {
+			if err := g.collectRevisions(ctx, r, pulled, docChannel); err != nil {
 				return fmt.Errorf("error retrieving artifacts: %w", err)
 			}
 		}
@@ -124,11 +203,224 @@ func (g *gitDocumentCollector) createOrPull(ctx context.Context, logger *zap.Sug
 	return nil
 }
 
+// revision is a commit of the repository to collect the documents of.
+type revision struct {
+	commit *object.Commit
+	tag    string
+}
+
+// collectRevisions emits the documents of HEAD if collectHead is set and, if
+// tag history is enabled, of the tags that were not collected yet.
+func (g *gitDocumentCollector) collectRevisions(ctx context.Context, r *git.Repository, collectHead bool, docChannel chan<- *processor.Document) error {
+	tags, err := tagCommits(r)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var revisions []revision
+	var head *object.Commit
+	if collectHead {
+		ref, err := r.Head()
+		if err != nil {
+			return fmt.Errorf("error retrieving HEAD: %w", err)
+		}
+		head, err = r.CommitObject(ref.Hash())
+		if err != nil {
+			return fmt.Errorf("error retrieving commit object: %w", err)
+		}
+		rev := revision{commit: head}
+		for _, name := range names {
+			if tags[name].Hash == head.Hash {
+				rev.tag = name
+				g.collectedTags[name] = true
+				break
+			}
+		}
+		revisions = append(revisions, rev)
+	}
+	if g.tagHistory {
+		for _, name := range names {
+			if g.collectedTags[name] {
+				continue
+			}
+			g.collectedTags[name] = true
+			revisions = append(revisions, revision{commit: tags[name], tag: name})
+		}
+	}
+
+	for _, rev := range revisions {
+		// only the files changed since the last collected HEAD are emitted
+		// again, tagged releases are collected in full
+		var previous *object.Tree
+		if rev.commit == head && !g.lastHead.IsZero() {
+			if c, err := r.CommitObject(g.lastHead); err == nil {
+				previous, _ = c.Tree()
+			}
+		}
+		if err := g.collectRevision(ctx, rev, previous, docChannel); err != nil {
+			return err
+		}
+	}
+	if head != nil {
+		g.lastHead = head.Hash
+	}
+	return nil
+}
+
+// collectRevision emits the files of the revision matching the include and
+// exclude patterns, skipping the files that are unchanged from previous.
+func (g *gitDocumentCollector) collectRevision(ctx context.Context, rev revision, previous *object.Tree, docChannel chan<- *processor.Document) error {
+	files, err := rev.commit.Files()
+	if err != nil {
+		return fmt.Errorf("error listing files of commit %s: %w", rev.commit.Hash, err)
+	}
+	return files.ForEach(func(f *object.File) error {
+		// If the context has been canceled it contains an err which we can throw.
+		if ctx.Err() != nil {
+			return ctx.Err() // nolint:wrapcheck
+		}
+		if !g.matches(f.Name) {
+			return nil
+		}
+		if previous != nil {
+			if p, err := previous.File(f.Name); err == nil && p.Hash == f.Hash {
+				return nil
+			}
+		}
+
+		reader, err := f.Reader()
+		if err != nil {
+			return fmt.Errorf("error reading file: %s, err: %w", f.Name, err)
+		}
+		blob, err := io.ReadAll(reader)
+		_ = reader.Close()
+		if err != nil {
+			return fmt.Errorf("error reading file: %s, err: %w", f.Name, err)
+		}
+
+		docChannel <- &processor.Document{
+			Blob:   blob,
+			Type:   processor.DocumentUnknown,
+			Format: processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{
+				Collector:   CollectorGitDocument,
+				Source:      fmt.Sprintf("%s@%s#%s", vcsURI(g.url), rev.commit.Hash, f.Name),
+				DocumentRef: events.GetDocRef(blob),
+				Commit:      rev.commit.Hash.String(),
+				Tag:         rev.tag,
+			},
+		}
+		return nil
+	})
+}
+
+// matches returns whether the file at path in the repository is collected.
+func (g *gitDocumentCollector) matches(path string) bool {
+	for _, e := range g.excludes {
+		if e.Match(path) {
+			return false
+		}
+	}
+	if len(g.includes) == 0 {
+		return true
+	}
+	for _, i := range g.includes {
+		if i.Match(path) {
+			return true
+		}
+	}
+	return false
+}
+
 // Type returns the collector type
 func (g *gitDocumentCollector) Type() string {
 	return CollectorGitDocument
 }
 
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	var globs []glob.Glob
+	for _, p := range patterns {
+		g, err := glob.Compile(p, '/')
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+// vcsURI returns the repository url in the SPDX VCS uri format
+// <vcs_tool>+<transport>://<host_name>[/<path_to_repository>], which is used
+// to find the source of the documents when ingesting them.
+func vcsURI(url string) string {
+	switch {
+	case strings.HasPrefix(url, "git+"):
+		return url
+	case strings.Contains(url, "://"):
+		return "git+" + url
+	case strings.Contains(url, ":") && !filepath.IsAbs(url):
+		// scp-like syntax, e.g. git@github.com:guacsec/guac.git
+		return "git+ssh://" + strings.Replace(url, ":", "/", 1)
+	default:
+		if abs, err := filepath.Abs(url); err == nil {
+			url = abs
+		}
+		return "git+file://" + filepath.ToSlash(url)
+	}
+}
+
+// tagCommits returns the commits of the tags of the repository by tag name.
+func tagCommits(r *git.Repository) (map[string]*object.Commit, error) {
+	iter, err := r.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags: %w", err)
+	}
+	tags := map[string]*object.Commit{}
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		hash := ref.Hash()
+		// annotated tags point to a tag object instead of the commit
+		if t, err := r.TagObject(hash); err == nil {
+			hash = t.Target
+		}
+		c, err := r.CommitObject(hash)
+		if err != nil {
+			// tags of trees and blobs have no documents to collect
+			return nil
+		}
+		tags[ref.Name().Short()] = c
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags: %w", err)
+	}
+	return tags, nil
+}
+
+func tagNames(r *git.Repository) (map[string]bool, error) {
+	tags, err := tagCommits(r)
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for name := range tags {
+		names[name] = true
+	}
+	return names, nil
+}
+
+func fetchTags(r *git.Repository) error {
+	err := r.Fetch(&git.FetchOptions{RemoteName: "origin", Tags: git.AllTags})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("error fetching tags: %w", err)
+	}
+	return nil
+}
+
 func checkIfDirExists(name string) (bool, error) {
 	_, err := os.Stat(name)
 	if err != nil {
@@ -148,6 +440,7 @@ func cloneRepoToDir(logger *zap.SugaredLogger, url string, directory string) err
 	r, err := git.PlainClone(directory, false, &git.CloneOptions{
 		URL:               url,
 		RecurseSubmodules: git.DefaultSubmoduleRecursionDepth,
+		Tags:              git.AllTags,
 	})
 	if err != nil {
 		return fmt.Errorf("error cloning repo: %w", err)
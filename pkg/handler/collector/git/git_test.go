@@ -19,9 +19,16 @@ import (
 	"context"
 	"errors"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
@@ -80,7 +87,10 @@ func Test_gitCol_RetrieveArtifacts(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// in case the file exists from a failed run, delete it
 			os.RemoveAll(tt.fields.dir)
-			g := NewGitDocumentCollector(ctx, tt.fields.url, tt.fields.dir, tt.fields.poll, tt.fields.interval)
+			g, err := NewGitDocumentCollector(ctx, tt.fields.url, tt.fields.dir, tt.fields.poll, tt.fields.interval)
+			if err != nil {
+				t.Fatalf("could not create collector: %v", err)
+			}
 
 			collector.DeregisterDocumentCollector(CollectorGitDocument)
 			if err := collector.RegisterDocumentCollector(g, CollectorGitDocument); err != nil &&
@@ -131,3 +141,105 @@ func Test_gitCol_RetrieveArtifacts(t *testing.T) {
 		})
 	}
 }
+
+func Test_gitCol_PatternsAndTagHistory(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	origin := t.TempDir()
+	r, err := git.PlainInit(origin, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit := func(files map[string]string) plumbing.Hash {
+		for name, content := range files {
+			path := filepath.Join(origin, name)
+			if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := w.Add(name); err != nil {
+				t.Fatal(err)
+			}
+		}
+		sig := &object.Signature{Name: "guac", Email: "guac@example.com", When: time.Now()}
+		hash, err := w.Commit("update", &git.CommitOptions{Author: sig})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+
+	first := commit(map[string]string{"sbom/app.spdx.json": "v1", "README.md": "readme"})
+	if _, err := r.CreateTag("v1.0.0", first, nil); err != nil {
+		t.Fatal(err)
+	}
+	second := commit(map[string]string{"sbom/app.spdx.json": "v2", "sbom/lib.cdx.json": "lib", "docs/example.json": "example"})
+	if _, err := r.CreateTag("v2.0.0", second, &git.CreateTagOptions{
+		Tagger:  &object.Signature{Name: "guac", Email: "guac@example.com", When: time.Now()},
+		Message: "v2.0.0",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	type doc struct {
+		Path, Content, Commit, Tag string
+	}
+	dir := filepath.Join(t.TempDir(), "clone")
+	g, err := NewGitDocumentCollector(ctx, origin, dir, false, time.Millisecond,
+		WithIncludes("**/*.json"), WithExcludes("docs/**"), WithTagHistory(true))
+	if err != nil {
+		t.Fatalf("could not create collector: %v", err)
+	}
+	retrieve := func() []doc {
+		docChannel := make(chan *processor.Document, 10)
+		if err := g.RetrieveArtifacts(ctx, docChannel); err != nil {
+			t.Fatalf("gitCollector.RetrieveArtifacts() = %v", err)
+		}
+		close(docChannel)
+		var docs []doc
+		for d := range docChannel {
+			src := d.SourceInformation
+			prefix := "git+file://" + filepath.ToSlash(origin) + "@" + src.Commit + "#"
+			if !strings.HasPrefix(src.Source, prefix) || src.Collector != CollectorGitDocument {
+				t.Errorf("unexpected source information: %+v", src)
+			}
+			docs = append(docs, doc{strings.TrimPrefix(src.Source, prefix), string(d.Blob), src.Commit, src.Tag})
+		}
+		sort.Slice(docs, func(i, j int) bool {
+			return docs[i].Tag+docs[i].Path < docs[j].Tag+docs[j].Path
+		})
+		return docs
+	}
+
+	want := []doc{
+		{"sbom/app.spdx.json", "v1", first.String(), "v1.0.0"},
+		{"sbom/app.spdx.json", "v2", second.String(), "v2.0.0"},
+		{"sbom/lib.cdx.json", "lib", second.String(), "v2.0.0"},
+	}
+	if diff := cmp.Diff(want, retrieve()); diff != "" {
+		t.Errorf("unexpected documents of the clone (-want +got):\n%s", diff)
+	}
+
+	// only the changed files of new commits and new tags are collected on the
+	// next pull
+	third := commit(map[string]string{"sbom/lib.cdx.json": "lib v2", "README.md": "new readme"})
+	if _, err := r.CreateTag("v0.9.0", first, nil); err != nil {
+		t.Fatal(err)
+	}
+	want = []doc{
+		{"sbom/lib.cdx.json", "lib v2", third.String(), ""},
+		{"sbom/app.spdx.json", "v1", first.String(), "v0.9.0"},
+	}
+	if diff := cmp.Diff(want, retrieve()); diff != "" {
+		t.Errorf("unexpected documents of the pull (-want +got):\n%s", diff)
+	}
+
+	if got := retrieve(); len(got) != 0 {
+		t.Errorf("expected no documents when the repository is up to date, got %v", got)
+	}
+}
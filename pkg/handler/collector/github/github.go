@@ -43,11 +43,19 @@ func defaultAssetSuffixes() []string {
 // TagOrLatest is either a tag or if it's the empty string "" then it should be considered latest
 type TagOrLatest = string
 
+// releaseRef is a release of a repository to collect the assets of.
+type releaseRef struct {
+	repo client.Repo
+	tag  TagOrLatest
+}
+
 type githubCollector struct {
 	poll              bool
 	interval          time.Duration
 	client            githubclient.GithubClient
 	repoToReleaseTags map[client.Repo][]TagOrLatest
+	// releaseSources are the data sources of the releases to collect
+	releaseSources    map[releaseRef][]datasource.Source
 	assetSuffixes     []string
 	collectDataSource datasource.CollectSource
 	isRelease         bool
@@ -225,6 +233,9 @@ func (g *githubCollector) populateRepoToReleaseTags(ctx context.Context) error {
 		return fmt.Errorf("unable to retrieve datasource: %w", err)
 	}
 
+	if g.releaseSources == nil {
+		g.releaseSources = map[releaseRef][]datasource.Source{}
+	}
 	for _, grds := range ds.GithubReleaseDataSources {
 		r, t, err := ParseGithubReleaseDataSource(grds)
 		if err != nil {
@@ -232,6 +243,7 @@ func (g *githubCollector) populateRepoToReleaseTags(ctx context.Context) error {
 			continue
 		}
 		g.repoToReleaseTags[*r] = append(g.repoToReleaseTags[*r], t)
+		g.releaseSources[releaseRef{*r, t}] = append(g.releaseSources[releaseRef{*r, t}], grds)
 	}
 
 	for _, gds := range ds.GitDataSources {
@@ -241,6 +253,7 @@ func (g *githubCollector) populateRepoToReleaseTags(ctx context.Context) error {
 			continue
 		}
 		g.repoToReleaseTags[*r] = append(g.repoToReleaseTags[*r], t)
+		g.releaseSources[releaseRef{*r, t}] = append(g.releaseSources[releaseRef{*r, t}], gds)
 	}
 
 	return nil
@@ -249,6 +262,7 @@ func (g *githubCollector) populateRepoToReleaseTags(ctx context.Context) error {
 func (g *githubCollector) fetchAssets(ctx context.Context, owner string, repo string, tags []TagOrLatest, docChannel chan<- *processor.Document) {
 	logger := logging.FromContext(ctx)
 	var releases []client.Release
+	var releaseTags []TagOrLatest
 	for _, gitTag := range tags {
 		var release *client.Release
 		var err error
@@ -263,23 +277,37 @@ func (g *githubCollector) fetchAssets(ctx context.Context, owner string, repo st
 			continue
 		}
 		releases = append(releases, *release)
+		releaseTags = append(releaseTags, gitTag)
 	}
 
-	for _, release := range releases {
-		g.collectAssetsForRelease(ctx, release, docChannel)
+	for i, release := range releases {
+		if !g.collectAssetsForRelease(ctx, release, docChannel) {
+			continue
+		}
+		// report the data sources of the release as collected once all its
+		// assets were emitted
+		ref := releaseRef{client.Repo{Owner: owner, Repo: repo}, releaseTags[i]}
+		for _, src := range g.releaseSources[ref] {
+			datasource.ReportCollected(ctx, g.collectDataSource, src)
+		}
+		delete(g.releaseSources, ref)
 	}
 }
 
-func (g *githubCollector) collectAssetsForRelease(ctx context.Context, release client.Release, docChannel chan<- *processor.Document) {
+// collectAssetsForRelease emits the assets of the release and returns whether
+// all of them were collected.
+func (g *githubCollector) collectAssetsForRelease(ctx context.Context, release client.Release, docChannel chan<- *processor.Document) bool {
 	logger := logging.FromContext(ctx)
+	collected := true
 	for _, asset := range release.Assets {
 		if ctx.Err() != nil {
-			return
+			return false
 		}
 		if checkSuffixes(asset.URL, g.assetSuffixes) {
 			content, err := g.client.GetReleaseAsset(asset)
 			if err != nil {
 				logger.Warnf("unable to download asset: %v", err)
+				collected = false
 				continue
 			}
 			doc := &processor.Document{
@@ -295,6 +323,7 @@ func (g *githubCollector) collectAssetsForRelease(ctx context.Context, release c
 			docChannel <- doc
 		}
 	}
+	return collected
 }
 
 // fetchWorkflowRunArtifacts fetches the artifacts from the GitHub Action Workflow runs for a given owner and repo.
@@ -96,10 +96,11 @@ func NewOCICollector(ctx context.Context, collectDataSource datasource.CollectSo
 // RetrieveArtifacts get the artifacts from the collector source based on polling or one time
 func (o *ociCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
 	repoRefs := map[string][]ref.Ref{}
+	repoSources := map[string][]datasource.Source{}
 
 	if o.poll {
 		for {
-			if err := o.populateRepoRefs(ctx, repoRefs); err != nil {
+			if err := o.populateRepoRefs(ctx, repoRefs, repoSources); err != nil {
 				return fmt.Errorf("unable to populate reporefs: %w", err)
 			}
 			for repo, imageRefs := range repoRefs {
@@ -111,6 +112,7 @@ func (o *ociCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 				if err := o.getRefsAndFetch(ctx, repo, imageRefs, docChannel); err != nil {
 					return err
 				}
+				o.reportCollected(ctx, repoSources, repo)
 			}
 			select {
 			case <-ctx.Done():
@@ -119,20 +121,21 @@ func (o *ociCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 			}
 		}
 	} else {
-		if err := o.populateRepoRefs(ctx, repoRefs); err != nil {
+		if err := o.populateRepoRefs(ctx, repoRefs, repoSources); err != nil {
 			return fmt.Errorf("unable to populate reporefs: %w", err)
 		}
 		for repo, imageRefs := range repoRefs {
 			if err := o.getRefsAndFetch(ctx, repo, imageRefs, docChannel); err != nil {
 				return err
 			}
+			o.reportCollected(ctx, repoSources, repo)
 		}
 	}
 
 	return nil
 }
 
-func (o *ociCollector) populateRepoRefs(ctx context.Context, repoRefs map[string][]ref.Ref) error {
+func (o *ociCollector) populateRepoRefs(ctx context.Context, repoRefs map[string][]ref.Ref, repoSources map[string][]datasource.Source) error {
 	logger := logging.FromContext(ctx)
 	ds, err := o.collectDataSource.GetDataSources(ctx)
 	if err != nil {
@@ -146,6 +149,7 @@ func (o *ociCollector) populateRepoRefs(ctx context.Context, repoRefs map[string
 			continue
 		}
 		imagePath := fmt.Sprintf("%s/%s", imageRef.Registry, imageRef.Repository)
+		repoSources[imagePath] = append(repoSources[imagePath], d)
 
 		// If an image reference has no identifier (tag or digest), then
 		// it is considered as getting all tags
@@ -163,6 +167,15 @@ func (o *ociCollector) populateRepoRefs(ctx context.Context, repoRefs map[string
 	return nil
 }
 
+// reportCollected reports the data sources of the repository as collected to
+// the collect data source, once all their artifacts were fetched.
+func (o *ociCollector) reportCollected(ctx context.Context, repoSources map[string][]datasource.Source, repo string) {
+	for _, src := range repoSources[repo] {
+		datasource.ReportCollected(ctx, o.collectDataSource, src)
+	}
+	delete(repoSources, repo)
+}
+
 func (o *ociCollector) getRefsAndFetch(ctx context.Context, repo string, imageRefs []ref.Ref, docChannel chan<- *processor.Document) error {
 	if len(imageRefs) > 0 {
 		for _, r := range imageRefs {
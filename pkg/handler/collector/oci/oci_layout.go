@@ -0,0 +1,456 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/opencontainers/go-digest"
+	"github.com/regclient/regclient/types/descriptor"
+	"github.com/regclient/regclient/types/mediatype"
+)
+
+const (
+	OCILayoutCollector = "OCILayoutCollector"
+)
+
+const (
+	ociLayoutFile = "oci-layout"
+	ociIndexFile  = "index.json"
+	// dockerManifestFile is the manifest of the images saved by docker before
+	// it saved them as an OCI layout
+	dockerManifestFile = "manifest.json"
+	// refNameAnnotation is the annotation of the index descriptors holding the
+	// tag of the manifest
+	refNameAnnotation = "org.opencontainers.image.ref.name"
+)
+
+// errLegacyDockerArchive is returned for the tarballs written by `docker save`
+// before docker 25, which only hold the image configs and filesystem layers
+// listed by their manifest.json, and so no referrers or fallback tagged
+// artifacts.
+var errLegacyDockerArchive = errors.New("legacy docker save archive (manifest.json without index.json), " +
+	"which cannot hold sboms or attestations: save the image with docker 25 or later, or export it with `regctl image export`")
+
+// fallbackTagRegexp matches the tags cosign attaches the sboms and attestations
+// of an image with, such as sha256-<digest>.sbom
+var fallbackTagRegexp = regexp.MustCompile(`^([a-z0-9]+)-([a-f0-9]+)\.(` + strings.Join(wellKnownSuffixes, "|") + `)$`)
+
+type ociLayoutCollector struct {
+	paths []string
+}
+
+// NewOCILayoutCollector initializes the collector of the sboms and attestations
+// attached to the images of local OCI image layouts. Each path is either an
+// OCI image layout directory or a tarball of one, as written by `docker save`
+// since docker 25 or by `regctl image export`. Gzip compressed tarballs are
+// read as well. The tarballs saved by older docker releases are not OCI image
+// layouts and are rejected.
+func NewOCILayoutCollector(ctx context.Context, paths []string) *ociLayoutCollector {
+	return &ociLayoutCollector{
+		paths: paths,
+	}
+}
+
+// RetrieveArtifacts collects the referrers and fallback tagged artifacts of each
+// layout. The artifacts are emitted with the digest of the image they are
+// attached to as their subject.
+func (o *ociLayoutCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	for _, p := range o.paths {
+		if err := o.collectLayout(ctx, p, docChannel); err != nil {
+			return fmt.Errorf("failed collecting OCI layout %s: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// Type is the collector type of the collector
+func (o *ociLayoutCollector) Type() string {
+	return OCILayoutCollector
+}
+
+// layoutManifest holds the fields of both image manifests and indexes that are
+// needed to find the attached artifacts.
+type layoutManifest struct {
+	MediaType    string                  `json:"mediaType,omitempty"`
+	ArtifactType string                  `json:"artifactType,omitempty"`
+	Config       descriptor.Descriptor   `json:"config"`
+	Layers       []descriptor.Descriptor `json:"layers"`
+	Manifests    []descriptor.Descriptor `json:"manifests"`
+	Subject      *descriptor.Descriptor  `json:"subject,omitempty"`
+}
+
+func (m *layoutManifest) isIndex() bool {
+	return m.MediaType == mediatype.OCI1ManifestList || m.MediaType == mediatype.Docker2ManifestList || len(m.Manifests) > 0
+}
+
+// artifactType returns the artifact type of the manifest the same way the
+// referrers API of a registry does.
+func (m *layoutManifest) artifactType() string {
+	if m.ArtifactType != "" {
+		return m.ArtifactType
+	}
+	return m.Config.MediaType
+}
+
+// attachedArtifact is a manifest holding sboms or attestations of an image
+type attachedArtifact struct {
+	digest       digest.Digest
+	subject      digest.Digest
+	artifactType string
+	manifest     *layoutManifest
+}
+
+func (o *ociLayoutCollector) collectLayout(ctx context.Context, layoutPath string, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	l, err := openLayout(layoutPath)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	indexData, err := l.ReadFile(ociIndexFile)
+	if err != nil {
+		if _, dockerErr := l.ReadFile(dockerManifestFile); dockerErr == nil {
+			return errLegacyDockerArchive
+		}
+		return fmt.Errorf("no OCI image layout found: %w", err)
+	}
+	if _, err := l.ReadFile(ociLayoutFile); err != nil {
+		return fmt.Errorf("no OCI image layout found: %w", err)
+	}
+	var index layoutManifest
+	if err := json.Unmarshal(indexData, &index); err != nil {
+		return fmt.Errorf("failed parsing %s: %w", ociIndexFile, err)
+	}
+
+	artifacts, err := findAttachedArtifacts(l, index.Manifests)
+	if err != nil {
+		return err
+	}
+	logger.Infof("Found %d sbom and attestation artifacts in %s", len(artifacts), layoutPath)
+
+	for _, a := range artifacts {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := emitLayoutArtifactBlobs(l, layoutPath, a, docChannel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findAttachedArtifacts walks the manifests of the index, and returns the ones
+// referring to an image through their subject, and the ones tagged with the
+// fallback tag of an image. Referrers of an unknown artifact type are skipped.
+func findAttachedArtifacts(l layout, descs []descriptor.Descriptor) ([]attachedArtifact, error) {
+	var artifacts []attachedArtifact
+	visited := map[digest.Digest]bool{}
+
+	var walk func(descs []descriptor.Descriptor) error
+	walk = func(descs []descriptor.Descriptor) error {
+		for _, desc := range descs {
+			if visited[desc.Digest] {
+				continue
+			}
+			visited[desc.Digest] = true
+
+			// only manifests and indexes are read, the image layers can be large
+			if desc.MediaType != "" && !isManifestMediaType(desc.MediaType) {
+				continue
+			}
+			data, err := readBlob(l, desc.Digest)
+			if err != nil {
+				return err
+			}
+			var m layoutManifest
+			if err := json.Unmarshal(data, &m); err != nil {
+				return fmt.Errorf("failed parsing manifest %s: %w", desc.Digest, err)
+			}
+			if m.isIndex() {
+				if err := walk(m.Manifests); err != nil {
+					return err
+				}
+				continue
+			}
+
+			_, knownType := wellKnownOCIArtifactTypes[m.artifactType()]
+			switch {
+			case m.Subject != nil && knownType:
+				artifacts = append(artifacts, attachedArtifact{
+					digest:       desc.Digest,
+					subject:      m.Subject.Digest,
+					artifactType: m.artifactType(),
+					manifest:     &m,
+				})
+			case fallbackTagRegexp.MatchString(tagName(desc)):
+				match := fallbackTagRegexp.FindStringSubmatch(tagName(desc))
+				artifacts = append(artifacts, attachedArtifact{
+					digest:       desc.Digest,
+					subject:      digest.NewDigestFromEncoded(digest.Algorithm(match[1]), match[2]),
+					artifactType: "unknown",
+					manifest:     &m,
+				})
+			}
+		}
+		return nil
+	}
+
+	if err := walk(descs); err != nil {
+		return nil, err
+	}
+	sort.Slice(artifacts, func(i, j int) bool {
+		return artifacts[i].digest < artifacts[j].digest
+	})
+	return artifacts, nil
+}
+
+// emitLayoutArtifactBlobs sends the layers of the artifact to the docChannel.
+// Similar to fetchOCIArtifactBlobs the layers are sent in reverse.
+func emitLayoutArtifactBlobs(l layout, layoutPath string, a attachedArtifact, docChannel chan<- *processor.Document) error {
+	layers := a.manifest.Layers
+	for i := len(layers) - 1; i >= 0; i-- {
+		blob, err := readBlob(l, layers[i].Digest)
+		if err != nil {
+			return fmt.Errorf("failed reading layer %d of %s: %w", i, a.digest, err)
+		}
+
+		var docType = processor.DocumentUnknown
+		var docFormat = processor.FormatUnknown
+		if wellKnownArtifactType, ok := wellKnownOCIArtifactTypes[a.artifactType]; ok {
+			docType = wellKnownArtifactType.documentType
+			docFormat = wellKnownArtifactType.formatType
+		}
+
+		docChannel <- &processor.Document{
+			Blob:   blob,
+			Type:   docType,
+			Format: docFormat,
+			SourceInformation: processor.SourceInformation{
+				Collector:   string(OCILayoutCollector),
+				Source:      fmt.Sprintf("%s@%s", layoutPath, a.digest),
+				DocumentRef: events.GetDocRef(blob),
+				Subject:     a.subject.String(),
+			},
+		}
+	}
+	return nil
+}
+
+func isManifestMediaType(mt string) bool {
+	switch mt {
+	case mediatype.OCI1Manifest, mediatype.OCI1ManifestList, mediatype.Docker2Manifest, mediatype.Docker2ManifestList:
+		return true
+	}
+	return false
+}
+
+// tagName returns the tag of the descriptor of an index. Docker records the
+// whole image reference rather than just the tag.
+func tagName(desc descriptor.Descriptor) string {
+	name := desc.Annotations[refNameAnnotation]
+	if i := strings.LastIndexAny(name, ":/"); i >= 0 && name[i] == ':' {
+		return name[i+1:]
+	}
+	return name
+}
+
+// readBlob reads the blob with the digest from the layout and verifies its
+// content.
+func readBlob(l layout, d digest.Digest) ([]byte, error) {
+	if err := d.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid digest %q: %w", d, err)
+	}
+	data, err := l.ReadFile(path.Join("blobs", d.Algorithm().String(), d.Encoded()))
+	if err != nil {
+		return nil, err
+	}
+	if d.Algorithm().FromBytes(data) != d {
+		return nil, fmt.Errorf("content of blob %s does not match its digest", d)
+	}
+	return data, nil
+}
+
+// layout reads the files of an OCI image layout by their slash separated path
+// relative to the root of the layout.
+type layout interface {
+	ReadFile(name string) ([]byte, error)
+	Close() error
+}
+
+func openLayout(layoutPath string) (layout, error) {
+	info, err := os.Stat(layoutPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read OCI layout: %w", err)
+	}
+	if info.IsDir() {
+		return dirLayout(layoutPath), nil
+	}
+	return openTarLayout(layoutPath)
+}
+
+// dirLayout is an OCI image layout directory
+type dirLayout string
+
+func (d dirLayout) ReadFile(name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(string(d), filepath.FromSlash(name)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", name, err)
+	}
+	return data, nil
+}
+
+func (d dirLayout) Close() error {
+	return nil
+}
+
+// tarLayout is a tarball of an OCI image layout. The offsets of the files are
+// indexed once, so that reading a file does not read the whole tarball again.
+type tarLayout struct {
+	file  *os.File
+	files map[string]tarEntry
+	// tmp is the decompressed copy of a gzip compressed tarball
+	tmp string
+}
+
+type tarEntry struct {
+	offset int64
+	size   int64
+}
+
+func openTarLayout(tarPath string) (*tarLayout, error) {
+	f, err := os.Open(tarPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open tarball: %w", err)
+	}
+	t := &tarLayout{file: f, files: map[string]tarEntry{}}
+
+	compressed, err := isGzip(f)
+	if err != nil {
+		t.Close()
+		return nil, err
+	}
+	if compressed {
+		if err := t.decompress(); err != nil {
+			t.Close()
+			return nil, err
+		}
+	}
+	if err := t.index(); err != nil {
+		t.Close()
+		return nil, fmt.Errorf("unable to read tarball %s: %w", tarPath, err)
+	}
+	return t, nil
+}
+
+func isGzip(f *os.File) (bool, error) {
+	magic, err := bufio.NewReader(f).Peek(2)
+	if _, seekErr := f.Seek(0, io.SeekStart); seekErr != nil {
+		return false, fmt.Errorf("unable to read tarball: %w", seekErr)
+	}
+	if err != nil {
+		// too short to be compressed, the tar reader reports the error
+		return false, nil
+	}
+	return magic[0] == 0x1f && magic[1] == 0x8b, nil
+}
+
+// decompress replaces the file of the layout by a decompressed copy, as the
+// files can only be read at their offset in an uncompressed tarball.
+func (t *tarLayout) decompress() error {
+	gz, err := gzip.NewReader(t.file)
+	if err != nil {
+		return fmt.Errorf("unable to decompress tarball: %w", err)
+	}
+	defer gz.Close()
+	tmp, err := os.CreateTemp("", "guac-oci-layout-*.tar")
+	if err != nil {
+		return fmt.Errorf("unable to decompress tarball: %w", err)
+	}
+	src := t.file
+	defer src.Close()
+	t.file = tmp
+	t.tmp = tmp.Name()
+	if _, err := io.Copy(tmp, gz); err != nil {
+		return fmt.Errorf("unable to decompress tarball: %w", err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("unable to decompress tarball: %w", err)
+	}
+	return nil
+}
+
+func (t *tarLayout) index() error {
+	tr := tar.NewReader(t.file)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		// the tar reader does not buffer, so the file is at the start of the
+		// content of the entry
+		offset, err := t.file.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		name := strings.TrimPrefix(path.Clean("/"+header.Name), "/")
+		t.files[name] = tarEntry{offset: offset, size: header.Size}
+	}
+}
+
+func (t *tarLayout) ReadFile(name string) ([]byte, error) {
+	entry, ok := t.files[name]
+	if !ok {
+		return nil, fmt.Errorf("unable to read %s: %w", name, os.ErrNotExist)
+	}
+	data, err := io.ReadAll(io.NewSectionReader(t.file, entry.offset, entry.size))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", name, err)
+	}
+	return data, nil
+}
+
+func (t *tarLayout) Close() error {
+	err := t.file.Close()
+	if t.tmp != "" {
+		_ = os.Remove(t.tmp)
+	}
+	return err
+}
@@ -0,0 +1,243 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/opencontainers/go-digest"
+	"github.com/regclient/regclient/types/descriptor"
+	"github.com/regclient/regclient/types/mediatype"
+)
+
+// testLayout builds the files of an OCI image layout
+type testLayout map[string][]byte
+
+func (l testLayout) addBlob(mediaType string, data []byte) descriptor.Descriptor {
+	d := digest.FromBytes(data)
+	l[path.Join("blobs", d.Algorithm().String(), d.Encoded())] = data
+	return descriptor.Descriptor{MediaType: mediaType, Digest: d, Size: int64(len(data))}
+}
+
+func (l testLayout) addJSON(t *testing.T, mediaType string, v any) descriptor.Descriptor {
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l.addBlob(mediaType, data)
+}
+
+func (l testLayout) writeDir(t *testing.T) string {
+	dir := t.TempDir()
+	for name, data := range l {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func (l testLayout) writeTar(t *testing.T, compress bool) string {
+	p := filepath.Join(t.TempDir(), "image.tar")
+	f, err := os.Create(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var tw *tar.Writer
+	if compress {
+		gz := gzip.NewWriter(f)
+		defer gz.Close()
+		tw = tar.NewWriter(gz)
+	} else {
+		tw = tar.NewWriter(f)
+	}
+	defer tw.Close()
+	for name, data := range l {
+		if err := tw.WriteHeader(&tar.Header{Name: "./" + name, Mode: 0o644, Size: int64(len(data)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return p
+}
+
+func Test_ociLayoutCollector_RetrieveArtifacts(t *testing.T) {
+	ctx := context.Background()
+
+	spdx := []byte(`{"spdxVersion":"SPDX-2.3","name":"image"}`)
+	provenance := []byte(`{"_type":"https://in-toto.io/Statement/v0.1","predicateType":"https://slsa.dev/provenance/v0.2"}`)
+	cosignSBOM := []byte(`{"bomFormat":"CycloneDX","specVersion":"1.4"}`)
+	signature := []byte(`signature`)
+
+	l := testLayout{}
+	l[ociLayoutFile] = []byte(`{"imageLayoutVersion":"1.0.0"}`)
+	config := l.addBlob(mediatype.OCI1ImageConfig, []byte(`{}`))
+	layer := l.addBlob(mediatype.OCI1LayerGzip, []byte(`layer`))
+	image := l.addJSON(t, mediatype.OCI1Manifest, layoutManifest{MediaType: mediatype.OCI1Manifest, Config: config, Layers: []descriptor.Descriptor{layer}})
+	imageIndex := l.addJSON(t, mediatype.OCI1ManifestList, layoutManifest{MediaType: mediatype.OCI1ManifestList, Manifests: []descriptor.Descriptor{image}})
+	empty := l.addBlob(mediatype.OCI1Empty, []byte(`{}`))
+
+	// referrers of the image
+	spdxReferrer := l.addJSON(t, mediatype.OCI1Manifest, layoutManifest{
+		MediaType:    mediatype.OCI1Manifest,
+		ArtifactType: SpdxJson,
+		Config:       empty,
+		Layers:       []descriptor.Descriptor{l.addBlob(SpdxJson, spdx)},
+		Subject:      &image,
+	})
+	provenanceReferrer := l.addJSON(t, mediatype.OCI1Manifest, layoutManifest{
+		MediaType: mediatype.OCI1Manifest,
+		Config:    descriptor.Descriptor{MediaType: InTotoJson, Digest: empty.Digest, Size: empty.Size},
+		Layers:    []descriptor.Descriptor{l.addBlob(InTotoJson, provenance)},
+		Subject:   &image,
+	})
+	signatureReferrer := l.addJSON(t, mediatype.OCI1Manifest, layoutManifest{
+		MediaType:    mediatype.OCI1Manifest,
+		ArtifactType: "application/vnd.cncf.notary.signature",
+		Config:       empty,
+		Layers:       []descriptor.Descriptor{l.addBlob("application/jose+json", signature)},
+		Subject:      &image,
+	})
+	referrersIndex := l.addJSON(t, mediatype.OCI1ManifestList, layoutManifest{
+		MediaType: mediatype.OCI1ManifestList,
+		Manifests: []descriptor.Descriptor{spdxReferrer, provenanceReferrer, signatureReferrer},
+	})
+
+	// sbom attached with the cosign fallback tag
+	cosignManifest := l.addJSON(t, mediatype.OCI1Manifest, layoutManifest{
+		MediaType: mediatype.OCI1Manifest,
+		Config:    config,
+		Layers:    []descriptor.Descriptor{l.addBlob("text/spdx+json", cosignSBOM)},
+	})
+
+	tagged := func(desc descriptor.Descriptor, tag string) descriptor.Descriptor {
+		desc.Annotations = map[string]string{refNameAnnotation: tag}
+		return desc
+	}
+	fallbackTag := strings.Replace(image.Digest.String(), ":", "-", 1)
+	index, err := json.Marshal(layoutManifest{
+		MediaType: mediatype.OCI1ManifestList,
+		Manifests: []descriptor.Descriptor{
+			tagged(imageIndex, "docker.io/guacsec/image:v1.0.0"),
+			tagged(referrersIndex, fallbackTag),
+			tagged(cosignManifest, "docker.io/guacsec/image:"+fallbackTag+".sbom"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l[ociIndexFile] = index
+
+	want := func(layoutPath string) []*processor.Document {
+		doc := func(blob []byte, docType processor.DocumentType, format processor.FormatType, manifest descriptor.Descriptor) *processor.Document {
+			return &processor.Document{
+				Blob:   blob,
+				Type:   docType,
+				Format: format,
+				SourceInformation: processor.SourceInformation{
+					Collector:   OCILayoutCollector,
+					Source:      layoutPath + "@" + manifest.Digest.String(),
+					DocumentRef: events.GetDocRef(blob),
+					Subject:     image.Digest.String(),
+				},
+			}
+		}
+		return []*processor.Document{
+			doc(spdx, processor.DocumentSPDX, processor.FormatJSON, spdxReferrer),
+			doc(provenance, processor.DocumentITE6SLSA, processor.FormatJSON, provenanceReferrer),
+			doc(cosignSBOM, processor.DocumentUnknown, processor.FormatUnknown, cosignManifest),
+		}
+	}
+
+	dockerArchive := testLayout{
+		dockerManifestFile: []byte(`[{"Config":"a1b2.json","RepoTags":["guacsec/image:v1.0.0"],"Layers":["c3d4/layer.tar"]}]`),
+		"repositories":     []byte(`{"guacsec/image":{"v1.0.0":"c3d4"}}`),
+	}
+
+	tests := []struct {
+		name       string
+		layoutPath string
+		wantErr    string
+	}{{
+		name:       "layout directory",
+		layoutPath: l.writeDir(t),
+	}, {
+		name:       "layout tarball",
+		layoutPath: l.writeTar(t, false),
+	}, {
+		name:       "compressed layout tarball",
+		layoutPath: l.writeTar(t, true),
+	}, {
+		name:       "docker archive without layout",
+		layoutPath: dockerArchive.writeTar(t, false),
+		wantErr:    "legacy docker save archive (manifest.json without index.json)",
+	}, {
+		name:       "missing path",
+		layoutPath: filepath.Join(t.TempDir(), "missing"),
+		wantErr:    "no such file or directory",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewOCILayoutCollector(ctx, []string{tt.layoutPath})
+			if o.Type() != OCILayoutCollector {
+				t.Errorf("o.Type() = %s, want %s", o.Type(), OCILayoutCollector)
+			}
+
+			docChan := make(chan *processor.Document, 10)
+			err := o.RetrieveArtifacts(ctx, docChan)
+			close(docChan)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("o.RetrieveArtifacts() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("o.RetrieveArtifacts() error = %v", err)
+			}
+
+			docs := map[string]*processor.Document{}
+			for d := range docChan {
+				docs[d.SourceInformation.Source] = d
+			}
+			wantDocs := map[string]*processor.Document{}
+			for _, d := range want(tt.layoutPath) {
+				wantDocs[d.SourceInformation.Source] = d
+			}
+			if diff := cmp.Diff(wantDocs, docs); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -124,6 +124,7 @@ func (o *ociRegistryCollector) retrieveRegistryArtifacts(ctx context.Context, do
 
 		// Sync collected digests
 		o.syncCollectedDigests(&ociCollector.checkedDigest)
+		datasource.ReportCollected(ctx, o.collectDataSource, r)
 	}
 
 	return nil
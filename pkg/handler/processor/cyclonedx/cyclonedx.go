@@ -21,6 +21,7 @@ import (
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/misc/jsonstream"
 )
 
 // CycloneDXProcessor processes CycloneDXProcessor documents.
@@ -35,6 +36,18 @@ func (p *CycloneDXProcessor) ValidateSchema(d *processor.Document) error {
 
 	switch d.Format {
 	case processor.FormatJSON:
+		if d.Large() {
+			// too large to be decoded all at once, it is parsed as a stream
+			r, err := d.NewReader()
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+			if !jsonstream.Valid(r) {
+				return fmt.Errorf("invalid JSON CycloneDX document")
+			}
+			return nil
+		}
 		reader := bytes.NewReader(d.Blob)
 		bom := new(cdx.BOM)
 		decoder := cdx.NewBOMDecoder(reader, cdx.BOMFileFormatJSON)
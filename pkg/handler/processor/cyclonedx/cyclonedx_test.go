@@ -145,3 +145,32 @@ func TestCycloneDXProcessor_ValidateSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestCycloneDXProcessor_ValidateLargeSchema(t *testing.T) {
+	largeDocumentSize := processor.LargeDocumentSize
+	processor.LargeDocumentSize = 0
+	t.Cleanup(func() { processor.LargeDocumentSize = largeDocumentSize })
+
+	testCases := []struct {
+		name      string
+		blob      []byte
+		expectErr bool
+	}{{
+		name:      "valid small CycloneDX document",
+		blob:      testdata.CycloneDXBusyboxExample,
+		expectErr: false,
+	}, {
+		name:      "truncated CycloneDX document",
+		blob:      testdata.CycloneDXBusyboxExample[:len(testdata.CycloneDXBusyboxExample)/2],
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := CycloneDXProcessor{}
+			err := d.ValidateSchema(&processor.Document{Blob: tt.blob, Format: processor.FormatJSON, Type: processor.DocumentCycloneDX})
+			if (err != nil) != tt.expectErr {
+				t.Errorf("CycloneDXProcessor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
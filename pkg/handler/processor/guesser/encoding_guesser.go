@@ -19,6 +19,8 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"errors"
+	"io"
 	"net/http"
 
 	"github.com/guacsec/guac/pkg/handler/processor"
@@ -65,13 +67,28 @@ func GuessEncoding(ctx context.Context, d *processor.Document) error {
 // and bzip2 encoding is identified by the initial three-octet string 0x42,
 // 0x5A, 0x68.  as detailed by: https://www.ietf.org/rfc/rfc5655.txt
 func detectFileEncoding(i *processor.Document) (string, error) {
+	blob := i.Blob
+	if i.Open != nil {
+		// http.DetectContentType only looks at the first 512 bytes
+		r, err := i.Open()
+		if err != nil {
+			return "", err
+		}
+		defer r.Close()
+		blob = make([]byte, 512)
+		n, err := io.ReadFull(r, blob)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return "", err
+		}
+		blob = blob[:n]
+	}
 
 	// create a bufio.Reader so we can 'peek' at the first few bytes without
 	// consuming
-	bReader := bytes.NewReader(i.Blob)
+	bReader := bytes.NewReader(blob)
 	reader := bufio.NewReader(bReader)
 
-	if len(i.Blob) < 16 {
+	if len(blob) < 16 {
 		return blankType, nil
 	}
 
@@ -81,7 +98,7 @@ func detectFileEncoding(i *processor.Document) (string, error) {
 	}
 
 	// find the content mime-type from the first few bytes
-	contentType := http.DetectContentType(i.Blob)
+	contentType := http.DetectContentType(blob)
 	// octet-stream is the default meaning that no encoding has been found
 	if contentType == "application/octet-stream" {
 		if testBytes[0] == 0x42 && testBytes[1] == 0x5A && testBytes[2] == 0x68 {
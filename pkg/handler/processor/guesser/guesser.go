@@ -17,13 +17,18 @@ package guesser
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/misc/jsonstream"
 )
 
 func GuessDocument(ctx context.Context, d *processor.Document) (processor.DocumentType, processor.FormatType, error) {
 	logger := logging.FromContext(ctx)
+	if d.Open != nil {
+		return guessLargeDocument(ctx, d)
+	}
 	format := d.Format
 
 	if format == processor.FormatUnknown {
@@ -56,3 +61,45 @@ func GuessDocument(ctx context.Context, d *processor.Document) (processor.Docume
 
 	return documentType, format, nil
 }
+
+// guessLargeDocument guesses the type of a document that is read with Open,
+// which can only be one of the JSON documents that are parsed as a stream.
+// The document is read without being held in memory.
+func guessLargeDocument(ctx context.Context, d *processor.Document) (processor.DocumentType, processor.FormatType, error) {
+	logger := logging.FromContext(ctx)
+	format := d.Format
+	if format == processor.FormatUnknown {
+		r, err := d.Open()
+		if err != nil {
+			return "", "", fmt.Errorf("unable to open document: %w", err)
+		}
+		valid := jsonstream.Valid(r)
+		r.Close()
+		if !valid {
+			return processor.DocumentUnknown, processor.FormatUnknown, nil
+		}
+		format = processor.FormatJSON
+	}
+
+	documentType := d.Type
+	if documentType == processor.DocumentUnknown {
+		for name, g := range documentTypeGuessers {
+			lg, ok := g.(largeDocumentTypeGuesser)
+			if !ok {
+				continue
+			}
+			r, err := d.Open()
+			if err != nil {
+				return "", "", fmt.Errorf("unable to open document: %w", err)
+			}
+			t := lg.guessLargeDocumentType(r, format)
+			r.Close()
+			if t != processor.DocumentUnknown {
+				documentType = t
+				logger.Debugf("DocumentType guesser %v guessed document format %v", name, t)
+				break
+			}
+		}
+	}
+	return documentType, format, nil
+}
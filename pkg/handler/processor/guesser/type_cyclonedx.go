@@ -17,10 +17,12 @@ package guesser
 
 import (
 	"bytes"
+	"io"
 	"strings"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/misc/jsonstream"
 )
 
 type cycloneDXTypeGuesser struct{}
@@ -29,10 +31,13 @@ const (
 	cycloneDXFormat = "CycloneDX"
 )
 
-func (_ *cycloneDXTypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
+func (g *cycloneDXTypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
 	reader := bytes.NewReader(blob)
 	switch format {
 	case processor.FormatJSON:
+		if len(blob) > processor.LargeDocumentSize {
+			return g.guessLargeDocumentType(reader, format)
+		}
 		// Decode the BOM
 		bom := new(cdx.BOM)
 		decoder := cdx.NewBOMDecoder(reader, cdx.BOMFileFormatJSON)
@@ -50,3 +55,16 @@ func (_ *cycloneDXTypeGuesser) GuessDocumentType(blob []byte, format processor.F
 	}
 	return processor.DocumentUnknown
 }
+
+// guessLargeDocumentType only looks at the header of the document, which is
+// parsed as a stream.
+func (_ *cycloneDXTypeGuesser) guessLargeDocumentType(r io.Reader, format processor.FormatType) processor.DocumentType {
+	if format != processor.FormatJSON {
+		return processor.DocumentUnknown
+	}
+	fields, err := jsonstream.StringFields(r, "bomFormat")
+	if err == nil && fields["bomFormat"] == cycloneDXFormat {
+		return processor.DocumentCycloneDX
+	}
+	return processor.DocumentUnknown
+}
@@ -83,3 +83,36 @@ func Test_cyclonedxTypeGuesser_GuessDocumentType(t *testing.T) {
 		})
 	}
 }
+
+func Test_cyclonedxTypeGuesser_GuessLargeDocumentType(t *testing.T) {
+	largeDocumentSize := processor.LargeDocumentSize
+	processor.LargeDocumentSize = 0
+	t.Cleanup(func() { processor.LargeDocumentSize = largeDocumentSize })
+
+	testCases := []struct {
+		name     string
+		blob     []byte
+		expected processor.DocumentType
+	}{{
+		name:     "invalid cyclonedx Document",
+		blob:     []byte(`{"abc": "def"}`),
+		expected: processor.DocumentUnknown,
+	}, {
+		name:     "spdx Document",
+		blob:     testdata.SpdxExampleSmall,
+		expected: processor.DocumentUnknown,
+	}, {
+		name:     "valid small cyclonedx Document",
+		blob:     testdata.CycloneDXBusyboxExample,
+		expected: processor.DocumentCycloneDX,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			guesser := &cycloneDXTypeGuesser{}
+			f := guesser.GuessDocumentType(tt.blob, processor.FormatJSON)
+			if f != tt.expected {
+				t.Errorf("got the wrong format, got %v, expected %v", f, tt.expected)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package guesser
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
@@ -39,6 +40,13 @@ type DocumentTypeGuesser interface {
 	GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType
 }
 
+// largeDocumentTypeGuesser is a DocumentTypeGuesser that can also guess the
+// type of a document that is too large to be held in memory, see
+// processor.Document.Open, from a reader of its blob.
+type largeDocumentTypeGuesser interface {
+	guessLargeDocumentType(r io.Reader, format processor.FormatType) processor.DocumentType
+}
+
 var (
 	documentTypeGuessers = map[string]DocumentTypeGuesser{}
 )
@@ -17,16 +17,28 @@ package guesser
 
 import (
 	"bytes"
+	"io"
+	"slices"
 
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/misc/jsonstream"
 	jsonReader "github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
 )
 
+// spdxVersions are the SPDX versions that can be read.
+var spdxVersions = []string{v2_1.Version, v2_2.Version, v2_3.Version}
+
 type spdxTypeGuesser struct{}
 
-func (_ *spdxTypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
+func (g *spdxTypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
 	switch format {
 	case processor.FormatJSON:
+		if len(blob) > processor.LargeDocumentSize {
+			return g.guessLargeDocumentType(bytes.NewReader(blob), format)
+		}
 		spdxDoc, err := jsonReader.Read(bytes.NewReader(blob))
 		if err == nil {
 			// This is set to check for DocumentNamespace since there seem to
@@ -40,3 +52,16 @@ func (_ *spdxTypeGuesser) GuessDocumentType(blob []byte, format processor.Format
 	}
 	return processor.DocumentUnknown
 }
+
+// guessLargeDocumentType only looks at the header of the document, which is
+// parsed as a stream.
+func (_ *spdxTypeGuesser) guessLargeDocumentType(r io.Reader, format processor.FormatType) processor.DocumentType {
+	if format != processor.FormatJSON {
+		return processor.DocumentUnknown
+	}
+	fields, err := jsonstream.StringFields(r, "spdxVersion", "documentNamespace")
+	if err == nil && slices.Contains(spdxVersions, fields["spdxVersion"]) && fields["documentNamespace"] != "" {
+		return processor.DocumentSPDX
+	}
+	return processor.DocumentUnknown
+}
@@ -56,3 +56,36 @@ func Test_spdxTypeGuesser_GuessDocumentType(t *testing.T) {
 		})
 	}
 }
+
+func Test_spdxTypeGuesser_GuessLargeDocumentType(t *testing.T) {
+	largeDocumentSize := processor.LargeDocumentSize
+	processor.LargeDocumentSize = 0
+	t.Cleanup(func() { processor.LargeDocumentSize = largeDocumentSize })
+
+	testCases := []struct {
+		name     string
+		blob     []byte
+		expected processor.DocumentType
+	}{{
+		name:     "invalid spdx Document",
+		blob:     []byte(`{"abc": "def"}`),
+		expected: processor.DocumentUnknown,
+	}, {
+		name:     "cyclonedx Document",
+		blob:     testdata.CycloneDXBusyboxExample,
+		expected: processor.DocumentUnknown,
+	}, {
+		name:     "valid small spdx Document",
+		blob:     testdata.SpdxExampleSmall,
+		expected: processor.DocumentSPDX,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			guesser := &spdxTypeGuesser{}
+			f := guesser.GuessDocumentType(tt.blob, processor.FormatJSON)
+			if f != tt.expected {
+				t.Errorf("got the wrong format, got %v, expected %v", f, tt.expected)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"compress/bzip2"
 	"context"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -44,6 +45,7 @@ import (
 	"github.com/guacsec/guac/pkg/handler/processor/scorecard"
 	"github.com/guacsec/guac/pkg/handler/processor/spdx"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/misc/jsonstream"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/klauspost/compress/zstd"
 	"gocloud.dev/pubsub"
@@ -80,9 +82,59 @@ func RegisterDocumentProcessor(p processor.DocumentProcessor, d processor.Docume
 	return nil
 }
 
+// DeadLetterQueue records the documents that failed ingestion permanently, so
+// that they can be replayed once the cause of the failure is fixed. It is
+// implemented by *dlq.Queue.
+type DeadLetterQueue interface {
+	// DeadLetter records that the document at key, collected from source,
+	// failed with err, and returns the number of failed attempts.
+	DeadLetter(ctx context.Context, key, source string, err error) (int, error)
+}
+
+// PermanentError is implemented by the errors of the documents that would
+// fail again if they were retried as is, such as *dlq.StageError.
+type PermanentError interface {
+	error
+	Permanent() bool
+}
+
+// DecodeError is the error of a document that could not be decoded from the
+// blob store. It is permanent.
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to decode document: %v", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func (e *DecodeError) Permanent() bool {
+	return true
+}
+
+// IngestionStage names the stage of the pipeline the document failed at, for
+// the dead-letter queue.
+func (e *DecodeError) IngestionStage() string {
+	return "decode"
+}
+
+// isPermanent returns whether err is a PermanentError of a document that
+// would fail again.
+func isPermanent(err error) bool {
+	var permanent PermanentError
+	return errors.As(err, &permanent) && permanent.Permanent()
+}
+
 // Subscribe receives the CD event and decodes the event to obtain the blob store key.
 // The key is used to retrieve the "document" from the blob store to be processed and ingested.
-func Subscribe(ctx context.Context, em collector.Emitter, blobStore *blob.BlobStore, emPubSub *emitter.EmitterPubSub) error {
+// The documents that fail with a PermanentError, such as those that cannot be decoded, are
+// recorded in deadLetter if it is not nil, and acknowledged. The messages of the documents
+// that fail otherwise are not acknowledged, so that they are delivered again.
+func Subscribe(ctx context.Context, em collector.Emitter, blobStore *blob.BlobStore, emPubSub *emitter.EmitterPubSub, deadLetter DeadLetterQueue) error {
 	logger := logging.FromContext(ctx)
 
 	uuid, err := uuid.NewV4()
@@ -114,23 +166,40 @@ func Subscribe(ctx context.Context, em collector.Emitter, blobStore *blob.BlobSt
 
 		childLogger.Debugf("[processor: %s] starting child logger", uuidString)
 
-		documentBytes, err := blobStore.Read(ctx, blobStoreKey)
-		if err != nil {
-			childLogger.Errorf("[processor: %s] failed read document to blob store: %v", uuidString, err)
-			return nil
+		// failFunc acknowledges the message of a document that failed
+		// permanently, once it is recorded in the dead-letter queue. Other
+		// failures are not acknowledged, so that the document is retried.
+		failFunc := func(source string, err error) {
+			if !isPermanent(err) {
+				childLogger.Errorf("[processor: %s] message id: %s not acknowledged in pusbub", uuidString, d.LoggableID)
+				return
+			}
+			if deadLetter == nil {
+				// nothing would change by retrying it
+				d.Ack()
+				return
+			}
+			attempts, dlqErr := deadLetter.DeadLetter(ctx, blobStoreKey, source, err)
+			if dlqErr != nil {
+				childLogger.Errorf("[processor: %s] failed to record document in dead-letter queue: %v", uuidString, dlqErr)
+				return
+			}
+			d.Ack()
+			childLogger.Warnf("[processor: %s] document dead-lettered after %d attempt(s)", uuidString, attempts)
 		}
 
-		doc := processor.Document{}
-		if err = json.Unmarshal(documentBytes, &doc); err != nil {
-			childLogger.Errorf("[processor: %s] failed unmarshal the document bytes: %v", uuidString, err)
+		doc, err := readDocument(ctx, blobStore, blobStoreKey)
+		if err != nil {
+			childLogger.Errorf("[processor: %s] failed read document from blob store: %v", uuidString, err)
+			failFunc("", err)
 			return nil
 		}
 
 		doc.ChildLogger = childLogger
 
-		if err := em(&doc); err != nil {
-			childLogger.Errorf("[processor: %s] failed transportFunc: %v", uuidString, err)
-			childLogger.Errorf("[processor: %s] message id: %s not acknowledged in pusbub", uuidString, d.LoggableID)
+		if err := em(doc); err != nil {
+			childLogger.Errorf("[processor: %s] unable to ingest document %q : %v", uuidString, doc.SourceInformation.Source, err)
+			failFunc(doc.SourceInformation.Source, err)
 			return nil
 		}
 
@@ -226,15 +295,60 @@ func preProcessDocument(ctx context.Context, i *processor.Document) error {
 		return fmt.Errorf("unable to guess document type: %w", err)
 	}
 
+	if i.Open != nil && (format != processor.FormatJSON || !streamedTypes[docType]) {
+		// only the documents that can be parsed as a stream are not read in
+		// memory
+		if err := readBlob(i); err != nil {
+			return err
+		}
+		docType, format, err = guesser.GuessDocument(ctx, i)
+		if err != nil {
+			return fmt.Errorf("unable to guess document type: %w", err)
+		}
+	}
+
 	i.Type = docType
 	i.Format = format
 
 	return nil
 }
 
+// streamedTypes are the types of the JSON documents that are parsed as a
+// stream when they are too large to be held in memory.
+var streamedTypes = map[processor.DocumentType]bool{
+	processor.DocumentSPDX:      true,
+	processor.DocumentCycloneDX: true,
+}
+
+// readBlob reads the blob of the document in memory from Open.
+func readBlob(i *processor.Document) error {
+	r, err := i.Open()
+	if err != nil {
+		return fmt.Errorf("unable to open document: %w", err)
+	}
+	defer r.Close()
+	blob, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("unable to read document: %w", err)
+	}
+	i.Blob, i.Open = blob, nil
+	return nil
+}
+
 func validateFormat(i *processor.Document) error {
 	switch i.Format {
 	case processor.FormatJSON:
+		if i.Open != nil {
+			r, err := i.Open()
+			if err != nil {
+				return fmt.Errorf("unable to open document: %w", err)
+			}
+			defer r.Close()
+			if !jsonstream.Valid(r) {
+				return fmt.Errorf("invalid JSON document")
+			}
+			return nil
+		}
 		if !json.Valid(i.Blob) {
 			return fmt.Errorf("invalid JSON document")
 		}
@@ -291,6 +405,25 @@ func decodeDocument(ctx context.Context, i *processor.Document) error {
 		}
 	}
 	logger.Debugf("Decoding document with encoding:  %v", i.Encoding)
+	if i.Open != nil {
+		// the blob is decompressed as it is read
+		if i.Encoding == processor.EncodingBzip2 || i.Encoding == processor.EncodingZstd {
+			open, encoding := i.Open, i.Encoding
+			i.Open = func() (io.ReadCloser, error) {
+				r, err := open()
+				if err != nil {
+					return nil, err
+				}
+				dr, err := newDecompressor(encoding, r)
+				if err != nil {
+					r.Close()
+					return nil, err
+				}
+				return dr, nil
+			}
+		}
+		return nil
+	}
 	switch i.Encoding {
 	case processor.EncodingBzip2:
 		reader = bzip2.NewReader(bytes.NewReader(i.Blob))
@@ -308,6 +441,37 @@ func decodeDocument(ctx context.Context, i *processor.Document) error {
 	return nil
 }
 
+// newDecompressor returns a reader of the content of r decompressed with
+// the encoding, which closes r when it is closed.
+func newDecompressor(encoding processor.EncodingType, r io.ReadCloser) (io.ReadCloser, error) {
+	switch encoding {
+	case processor.EncodingBzip2:
+		return struct {
+			io.Reader
+			io.Closer
+		}{bzip2.NewReader(r), r}, nil
+	case processor.EncodingZstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create zstd reader: %w", err)
+		}
+		return struct {
+			io.Reader
+			io.Closer
+		}{zr, closerFunc(func() error {
+			zr.Close()
+			return r.Close()
+		})}, nil
+	}
+	return r, nil
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
+
 func decompressDocument(i *processor.Document, reader io.Reader) error {
 	uncompressed, err := io.ReadAll(reader)
 	if err != nil {
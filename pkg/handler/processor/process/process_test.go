@@ -18,13 +18,16 @@ package process
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"io"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/dochelper"
 	nats_test "github.com/guacsec/guac/internal/testing/nats"
 	"github.com/guacsec/guac/internal/testing/simpledoc"
@@ -34,6 +37,7 @@ import (
 	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/handler/processor/guesser"
+	"github.com/guacsec/guac/pkg/ingestor/dlq"
 	"github.com/guacsec/guac/pkg/logging"
 )
 
@@ -651,6 +655,8 @@ func Test_ProcessSubscribe(t *testing.T) {
 		wantErr    bool
 		expected   processor.DocumentTree
 		errMessage string
+		// assembleErr fails the ingestion after the document was processed
+		assembleErr bool
 	}{{
 		name: "simple test",
 		doc: processor.Document{
@@ -739,6 +745,27 @@ func Test_ProcessSubscribe(t *testing.T) {
 		},
 		wantErr:    true,
 		errMessage: "invalid JSON document",
+	}, {
+		name: "assemble failure",
+		doc: processor.Document{
+			Blob: []byte(`{
+						"issuer": "google.com",
+						"info": "this document cannot be assembled yet"
+					}`),
+			Type:              simpledoc.SimpleDocType,
+			Format:            processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expected: dochelper.DocNode(&processor.Document{
+			Blob: []byte(`{
+						"issuer": "google.com",
+						"info": "this document cannot be assembled yet"
+					}`),
+			Type:              simpledoc.SimpleDocType,
+			Format:            processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{},
+		}),
+		assembleErr: true,
 	}}
 
 	// Register
@@ -793,17 +820,28 @@ func Test_ProcessSubscribe(t *testing.T) {
 					if !strings.Contains(err.Error(), tt.errMessage) {
 						t.Errorf("nats emitter Subscribe test errored = %v, want %v", err, tt.errMessage)
 					}
+					return &dlq.StageError{Stage: dlq.StageProcess, Err: err}
 				} else {
 					if !dochelper.DocTreeEqual(processedTree, tt.expected) {
 						t.Errorf("doc tree did not match up, got\n%s, \nexpected\n%s", dochelper.StringTree(processedTree), dochelper.StringTree(tt.expected))
 					}
 				}
+				if tt.assembleErr {
+					// the graph may be unavailable, so the document is retried
+					return &dlq.StageError{Stage: dlq.StageAssemble, Err: errors.New("graphql unavailable")}
+				}
 				return nil
 			}
 
+			deadLetter, err := dlq.Open(ctx, "mem://", "")
+			if err != nil {
+				t.Fatalf("unable to open dead-letter queue: %v", err)
+			}
+			defer deadLetter.Close(context.Background())
+
 			logBuffer.Reset()
 
-			err = Subscribe(ctx, emit, blobStore, pubsub)
+			err = Subscribe(ctx, emit, blobStore, pubsub, deadLetter)
 
 			logOutput := logBuffer.String()
 
@@ -817,6 +855,17 @@ func Test_ProcessSubscribe(t *testing.T) {
 					t.Errorf("nats emitter Subscribe test errored = %v, want %v", err, tt.errMessage)
 				}
 			}
+
+			entry, err := deadLetter.Get(context.Background(), events.GetKey(tt.doc.Blob))
+			if err != nil {
+				t.Fatalf("unable to read dead-letter queue: %v", err)
+			}
+			if (entry != nil) != tt.wantErr {
+				t.Errorf("dead-letter entry = %+v, want one %v", entry, tt.wantErr)
+			}
+			if entry != nil && (entry.Stage != dlq.StageProcess || !strings.Contains(entry.Error, tt.errMessage)) {
+				t.Errorf("unexpected dead-letter entry %+v", entry)
+			}
 		})
 	}
 }
@@ -852,3 +901,79 @@ func testPublish(ctx context.Context, d *processor.Document, blobStore *blob.Blo
 	logger.Debugf("doc published: %+v", d.SourceInformation.Source)
 	return nil
 }
+
+func Test_readDocument(t *testing.T) {
+	ctx := context.Background()
+	largeDocumentSize := processor.LargeDocumentSize
+	t.Cleanup(func() { processor.LargeDocumentSize = largeDocumentSize })
+
+	content := []byte(testdata.SpdxExampleSmall)
+	stored := &processor.Document{
+		Blob:     content,
+		Type:     processor.DocumentSPDX,
+		Format:   processor.FormatJSON,
+		Encoding: processor.EncodingUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   "a-collector",
+			Source:      "a-source",
+			DocumentRef: events.GetDocRef(content),
+		},
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blobStore, err := blob.NewBlobStore(ctx, "mem://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := events.GetKey(content)
+	if err := blobStore.Write(ctx, key, data); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name     string
+		size     int
+		wantOpen bool
+	}{
+		{name: "small document", size: len(data)},
+		{name: "large document", size: len(data) - 1, wantOpen: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			processor.LargeDocumentSize = tt.size
+			doc, err := readDocument(ctx, blobStore, key)
+			if err != nil {
+				t.Fatalf("readDocument() error = %v", err)
+			}
+			if (doc.Open != nil) != tt.wantOpen {
+				t.Fatalf("readDocument() opened = %v, want %v", doc.Open != nil, tt.wantOpen)
+			}
+			r, err := doc.NewReader()
+			if err != nil {
+				t.Fatalf("NewReader() error = %v", err)
+			}
+			defer r.Close()
+			blob, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("unable to read the blob: %v", err)
+			}
+			if !bytes.Equal(blob, content) {
+				t.Errorf("readDocument() blob = %q, want %q", blob, content)
+			}
+			doc.Blob, doc.Open = content, nil
+			if diff := cmp.Diff(stored, doc); diff != "" {
+				t.Errorf("readDocument() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if err := blobStore.Write(ctx, "truncated", data[:len(data)/2]); err != nil {
+		t.Fatal(err)
+	}
+	processor.LargeDocumentSize = 0
+	var decodeErr *DecodeError
+	if _, err := readDocument(ctx, blobStore, "truncated"); !errors.As(err, &decodeErr) {
+		t.Errorf("readDocument() of a truncated document error = %v, want a DecodeError", err)
+	}
+}
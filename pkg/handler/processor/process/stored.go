@@ -0,0 +1,308 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// readDocument reads the document stored in the blob store at key by
+// collector.Publish. A document larger than processor.LargeDocumentSize is
+// not read in memory: its blob is read as a stream from the blob store with
+// the Open function of the document, each time it is needed. The errors of
+// documents that cannot be decoded are *DecodeError.
+func readDocument(ctx context.Context, blobStore *blob.BlobStore, key string) (*processor.Document, error) {
+	size, err := blobStore.Size(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if size <= int64(processor.LargeDocumentSize) {
+		documentBytes, err := blobStore.Read(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		doc := &processor.Document{}
+		if err := json.Unmarshal(documentBytes, doc); err != nil {
+			return nil, &DecodeError{Err: err}
+		}
+		return doc, nil
+	}
+
+	r, err := blobStore.NewReader(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	doc, err := readStoredFields(r)
+	if err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+	doc.Open = func() (io.ReadCloser, error) {
+		r, err := blobStore.NewReader(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		blob, err := openStoredBlob(r)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+		return struct {
+			io.Reader
+			io.Closer
+		}{blob, r}, nil
+	}
+	return doc, nil
+}
+
+// readStoredFields returns the document marshalled in r, without its blob,
+// which is read as a stream and discarded.
+func readStoredFields(r io.Reader) (*processor.Document, error) {
+	fields := map[string]stdjson.RawMessage{}
+	err := scanStoredDocument(bufio.NewReader(r), func(key string, br *bufio.Reader) (bool, error) {
+		if strings.EqualFold(key, "Blob") {
+			_, err := io.Copy(io.Discard, &storedBlobReader{br: br})
+			return false, err
+		}
+		value, err := readValue(br)
+		if err != nil {
+			return false, err
+		}
+		fields[key] = value
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	data, err := stdjson.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	doc := &processor.Document{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// openStoredBlob returns a reader of the blob of the document marshalled in
+// r, which is decoded as it is read.
+func openStoredBlob(r io.Reader) (io.Reader, error) {
+	var blob io.Reader
+	err := scanStoredDocument(bufio.NewReader(r), func(key string, br *bufio.Reader) (bool, error) {
+		if strings.EqualFold(key, "Blob") {
+			blob = base64.NewDecoder(base64.StdEncoding, &storedBlobReader{br: br})
+			return true, nil
+		}
+		_, err := readValue(br)
+		return false, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if blob == nil {
+		return strings.NewReader(""), nil
+	}
+	return blob, nil
+}
+
+// scanStoredDocument reads the JSON object of a marshalled document from br,
+// and calls fn with each of its keys. fn must read the value of the key from
+// br, and returns true to stop the scan, with br at the rest of the value. A
+// null blob is read as an empty one.
+func scanStoredDocument(br *bufio.Reader, fn func(key string, br *bufio.Reader) (bool, error)) error {
+	if err := expect(br, '{'); err != nil {
+		return err
+	}
+	c, err := peek(br)
+	if err != nil {
+		return err
+	}
+	if c == '}' {
+		return nil
+	}
+	for {
+		raw, err := readValue(br)
+		if err != nil {
+			return err
+		}
+		var key string
+		if err := stdjson.Unmarshal(raw, &key); err != nil {
+			return fmt.Errorf("expected a JSON object key: %w", err)
+		}
+		if err := expect(br, ':'); err != nil {
+			return err
+		}
+		if strings.EqualFold(key, "Blob") {
+			if c, err := peek(br); err != nil {
+				return err
+			} else if c == 'n' {
+				if _, err := readValue(br); err != nil {
+					return err
+				}
+			} else if err := expect(br, '"'); err != nil {
+				return err
+			} else if stop, err := fn(key, br); err != nil || stop {
+				return err
+			}
+		} else if stop, err := fn(key, br); err != nil || stop {
+			return err
+		}
+		if c, err := next(br); err != nil {
+			return err
+		} else if c == '}' {
+			return nil
+		} else if c != ',' {
+			return fmt.Errorf("expected ',' or '}' in JSON object, got %q", c)
+		}
+	}
+}
+
+// storedBlobReader reads the content of a JSON string up to its closing
+// quote, which is the base64 blob of a marshalled document.
+type storedBlobReader struct {
+	br   *bufio.Reader
+	done bool
+}
+
+func (s *storedBlobReader) Read(p []byte) (int, error) {
+	if s.done {
+		return 0, io.EOF
+	}
+	n := 0
+	for n < len(p) {
+		c, err := s.br.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return n, err
+		}
+		switch c {
+		case '"':
+			s.done = true
+			return n, nil
+		case '\\':
+			// base64 only has the slash that may be escaped
+			if c, err = s.br.ReadByte(); err != nil {
+				return n, err
+			} else if c != '/' {
+				return n, fmt.Errorf("unexpected escape %q in document blob", c)
+			}
+		}
+		p[n] = c
+		n++
+	}
+	return n, nil
+}
+
+// readValue reads the next JSON value from br, which must be small enough
+// to be held in memory.
+func readValue(br *bufio.Reader) ([]byte, error) {
+	if _, err := peek(br); err != nil {
+		return nil, err
+	}
+	var value []byte
+	depth, inString, escaped := 0, false, false
+	for {
+		if len(value) > 0 && depth == 0 && !inString {
+			switch value[0] {
+			case '{', '[', '"':
+				// the object, array or string is closed
+				return value, nil
+			}
+			c, err := br.Peek(1)
+			if errors.Is(err, io.EOF) || (err == nil && strings.IndexByte(",:]} \t\r\n", c[0]) >= 0) {
+				return value, nil
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		c, err := br.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		value = append(value, c)
+		switch {
+		case inString && escaped:
+			escaped = false
+		case inString && c == '\\':
+			escaped = true
+		case inString && c == '"':
+			inString = false
+		case inString:
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			depth++
+		case c == '}' || c == ']':
+			depth--
+		}
+	}
+}
+
+// peek returns the next byte of br that is not white space, without
+// reading it.
+func peek(br *bufio.Reader) (byte, error) {
+	for {
+		c, err := br.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		switch c {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return c, br.UnreadByte()
+	}
+}
+
+// next reads the next byte of br that is not white space.
+func next(br *bufio.Reader) (byte, error) {
+	if _, err := peek(br); err != nil {
+		return 0, err
+	}
+	return br.ReadByte()
+}
+
+// expect reads the next byte of br that is not white space, which must be
+// want.
+func expect(br *bufio.Reader, want byte) error {
+	c, err := next(br)
+	if err != nil {
+		return err
+	}
+	if c != want {
+		return fmt.Errorf("expected %q in JSON document, got %q", want, c)
+	}
+	return nil
+}
@@ -15,7 +15,18 @@
 
 package processor
 
-import "go.uber.org/zap"
+import (
+	"bytes"
+	"io"
+
+	"go.uber.org/zap"
+)
+
+// LargeDocumentSize is the size in bytes above which SPDX and CycloneDX JSON
+// documents are checked and parsed as a stream, instead of being unmarshalled
+// all at once. The documents stored in the blob store above this size are
+// not read in memory either, but with the Open function of the Document.
+var LargeDocumentSize = 64 << 20
 
 type DocumentProcessor interface {
 	// ValidateSchema validates the schema of the document
@@ -39,6 +50,25 @@ type Document struct {
 	Encoding          EncodingType
 	SourceInformation SourceInformation
 	ChildLogger       *zap.SugaredLogger
+	// Open, if set, opens the blob of a document that is too large to be
+	// held in memory, instead of Blob. It may be called several times, and
+	// each reader must be closed.
+	Open func() (io.ReadCloser, error) `json:"-"`
+}
+
+// Large returns whether the document is too large to be unmarshalled all at
+// once, see LargeDocumentSize.
+func (d *Document) Large() bool {
+	return d.Open != nil || len(d.Blob) > LargeDocumentSize
+}
+
+// NewReader returns a reader of the blob of the document, from Open if it is
+// set.
+func (d *Document) NewReader() (io.ReadCloser, error) {
+	if d.Open != nil {
+		return d.Open()
+	}
+	return io.NopCloser(bytes.NewReader(d.Blob)), nil
 }
 
 // DocumentTree describes the output of a document tree that resulted from
@@ -107,4 +137,14 @@ type SourceInformation struct {
 	Source string
 	// DocumentRef describes the location of the document in the blob store
 	DocumentRef string
+	// Commit describes the commit SHA of the source repository revision the
+	// document was collected from, if the collector got it from a repository
+	Commit string
+	// Tag describes the tag of the source repository revision the document was
+	// collected from, if the revision is tagged
+	Tag string
+	// Subject describes the digest of the artifact the document is attached
+	// to, if the collector found the document attached to an artifact, such
+	// as the sboms and attestations of an OCI image
+	Subject string
 }
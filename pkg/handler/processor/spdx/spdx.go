@@ -18,9 +18,14 @@ package spdx
 import (
 	"bytes"
 	"fmt"
+	"slices"
 
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/misc/jsonstream"
 	"github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
 )
 
 // SPDXProcessor processes SPDX documents.
@@ -35,6 +40,9 @@ func (p *SPDXProcessor) ValidateSchema(d *processor.Document) error {
 
 	switch d.Format {
 	case processor.FormatJSON:
+		if d.Large() {
+			return validateLargeJSON(d)
+		}
 		doc, err := json.Read(bytes.NewReader(d.Blob))
 		if err != nil {
 			return err
@@ -48,6 +56,36 @@ func (p *SPDXProcessor) ValidateSchema(d *processor.Document) error {
 	return fmt.Errorf("unable to support parsing of SPDX document format: %v", d.Format)
 }
 
+// validateLargeJSON checks the syntax and the header of a document that is
+// too large to be unmarshalled all at once.
+func validateLargeJSON(d *processor.Document) error {
+	r, err := d.NewReader()
+	if err != nil {
+		return err
+	}
+	valid := jsonstream.Valid(r)
+	r.Close()
+	if !valid {
+		return fmt.Errorf("invalid JSON SPDX document")
+	}
+	r, err = d.NewReader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	fields, err := jsonstream.StringFields(r, "spdxVersion", "SPDXID")
+	if err != nil {
+		return err
+	}
+	if v := fields["spdxVersion"]; !slices.Contains([]string{v2_1.Version, v2_2.Version, v2_3.Version}, v) {
+		return fmt.Errorf("unsupported SDPX version: %s", v)
+	}
+	if fields["SPDXID"] != "SPDXRef-DOCUMENT" {
+		return fmt.Errorf("document SPDXIdentifier should be DOCUMENT")
+	}
+	return nil
+}
+
 // Unpack takes in the document and tries to unpack it
 // if there is a valid decomposition of sub-documents.
 //
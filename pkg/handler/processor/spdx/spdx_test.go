@@ -131,3 +131,40 @@ func TestSPDXProcessor_ValidateSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestSPDXProcessor_ValidateLargeSchema(t *testing.T) {
+	largeDocumentSize := processor.LargeDocumentSize
+	processor.LargeDocumentSize = 0
+	t.Cleanup(func() { processor.LargeDocumentSize = largeDocumentSize })
+
+	testCases := []struct {
+		name      string
+		blob      []byte
+		expectErr bool
+	}{{
+		name:      "valid small SPDX document",
+		blob:      testdata.SpdxExampleSmall,
+		expectErr: false,
+	}, {
+		name:      "truncated SPDX document",
+		blob:      testdata.SpdxExampleSmall[:len(testdata.SpdxExampleSmall)/2],
+		expectErr: true,
+	}, {
+		name:      "invalid SPDX DocumentIdentifier",
+		blob:      testdata.SpdxInvalidSPDXIdentifierExample,
+		expectErr: true,
+	}, {
+		name:      "unsupported SPDX version",
+		blob:      []byte(`{"spdxVersion": "SPDX-3.0", "SPDXID": "SPDXRef-DOCUMENT"}`),
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := SPDXProcessor{}
+			err := d.ValidateSchema(&processor.Document{Blob: tt.blob, Format: processor.FormatJSON, Type: processor.DocumentSPDX})
+			if (err != nil) != tt.expectErr {
+				t.Errorf("SPDXProcessor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dlq implements the dead-letter queue of the documents that could
+// not be ingested, so that they can be replayed once the cause of the failure,
+// such as a parser bug, is fixed.
+package dlq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/fileblob"
+	_ "gocloud.dev/blob/memblob"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+)
+
+// Stage is the step of the ingestion pipeline at which a document failed.
+type Stage string
+
+const (
+	// StageRead is reading the document from the blob store.
+	StageRead Stage = "read"
+	// StageDecode is decoding the document stored in the blob store.
+	StageDecode Stage = "decode"
+	// StageProcess is determining the format and type of the document.
+	StageProcess Stage = "process"
+	// StageParse is parsing the document into predicates.
+	StageParse Stage = "parse"
+	// StageAssemble is ingesting the predicates into the graph.
+	StageAssemble Stage = "assemble"
+)
+
+// StageError is the error of a document that failed ingestion at Stage.
+type StageError struct {
+	Stage Stage
+	Err   error
+}
+
+func (e *StageError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent returns whether retrying the document as is would fail again, so
+// that it is dead-lettered. Reading the document and assembling its
+// predicates depend on the blob store and the graph being available, and are
+// retried instead.
+func (e *StageError) Permanent() bool {
+	return e.Stage != StageRead && e.Stage != StageAssemble
+}
+
+// IngestionStage returns the stage of the error, see StageOf.
+func (e *StageError) IngestionStage() string {
+	return string(e.Stage)
+}
+
+// Entry records a document that failed ingestion.
+type Entry struct {
+	// Key is the blob store key of the document.
+	Key string `json:"key"`
+	// Source is the source the collector got the document from, if known.
+	Source string `json:"source,omitempty"`
+	// Stage is the step of the pipeline at which the last attempt failed.
+	Stage Stage `json:"stage"`
+	// Error is the error of the last attempt.
+	Error string `json:"error"`
+	// FirstFailedAt is the time of the first failed attempt.
+	FirstFailedAt time.Time `json:"firstFailedAt"`
+	// FailedAt is the time of the last failed attempt.
+	FailedAt time.Time `json:"failedAt"`
+	// Attempts is the number of failed attempts.
+	Attempts int `json:"attempts"`
+}
+
+// Queue stores the dead-lettered entries in a gocloud bucket, keyed by the
+// blob store key of the document, and optionally republishes them on a
+// separate pubsub topic for other services to consume.
+type Queue struct {
+	bucket *blob.Bucket
+	topic  *pubsub.Topic
+	now    func() time.Time
+}
+
+// Open opens the queue stored in the bucket at storeURL, see
+// https://gocloud.dev/howto/blob/. If topicURL is not empty, the entries are
+// also published on that topic, see https://gocloud.dev/howto/pubsub/. The
+// returned queue must be closed.
+func Open(ctx context.Context, storeURL, topicURL string) (*Queue, error) {
+	bucket, err := blob.OpenBucket(ctx, storeURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dead-letter bucket %s: %w", storeURL, err)
+	}
+	q := &Queue{bucket: bucket, now: time.Now}
+	if topicURL != "" {
+		q.topic, err = pubsub.OpenTopic(ctx, topicURL)
+		if err != nil {
+			_ = bucket.Close()
+			return nil, fmt.Errorf("failed to open dead-letter topic %s: %w", topicURL, err)
+		}
+	}
+	return q, nil
+}
+
+// Put records a failed attempt to ingest the document at key. Previous
+// failures of the same document are kept count of.
+func (q *Queue) Put(ctx context.Context, key, source string, stage Stage, cause error) (*Entry, error) {
+	now := q.now().UTC()
+	e, err := q.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if e == nil {
+		e = &Entry{Key: key, FirstFailedAt: now}
+	}
+	if source != "" {
+		e.Source = source
+	}
+	e.Stage = stage
+	e.Error = cause.Error()
+	e.FailedAt = now
+	e.Attempts++
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal dead-letter entry: %w", err)
+	}
+	if err := q.bucket.WriteAll(ctx, key, data, nil); err != nil {
+		return nil, fmt.Errorf("failed to write dead-letter entry %s: %w", key, err)
+	}
+	if q.topic != nil {
+		if err := q.topic.Send(ctx, &pubsub.Message{Body: data}); err != nil {
+			return nil, fmt.Errorf("failed to publish dead-letter entry %s: %w", key, err)
+		}
+	}
+	return e, nil
+}
+
+// Get returns the entry of the document at key, or nil if it is not in the
+// queue.
+func (q *Queue) Get(ctx context.Context, key string) (*Entry, error) {
+	data, err := q.bucket.ReadAll(ctx, key)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read dead-letter entry %s: %w", key, err)
+	}
+	var e Entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal dead-letter entry %s: %w", key, err)
+	}
+	return &e, nil
+}
+
+// List returns the entries in the queue, oldest failure first.
+func (q *Queue) List(ctx context.Context) ([]*Entry, error) {
+	var entries []*Entry
+	it := q.bucket.List(nil)
+	for {
+		obj, err := it.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list dead-letter entries: %w", err)
+		}
+		if obj.IsDir {
+			continue
+		}
+		e, err := q.Get(ctx, obj.Key)
+		if err != nil {
+			return nil, err
+		}
+		if e != nil {
+			entries = append(entries, e)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].FirstFailedAt.Before(entries[j].FirstFailedAt)
+	})
+	return entries, nil
+}
+
+// Remove removes the entry of the document at key, once it was ingested.
+// Removing a document that is not in the queue is not an error.
+func (q *Queue) Remove(ctx context.Context, key string) error {
+	if err := q.bucket.Delete(ctx, key); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		return fmt.Errorf("failed to remove dead-letter entry %s: %w", key, err)
+	}
+	return nil
+}
+
+// Close closes the bucket and the topic of the queue.
+func (q *Queue) Close(ctx context.Context) error {
+	var errs []error
+	if q.topic != nil {
+		errs = append(errs, q.topic.Shutdown(ctx))
+	}
+	errs = append(errs, q.bucket.Close())
+	return errors.Join(errs...)
+}
+
+// DeadLetter records the failure err of the document at key at the stage of
+// err, and returns the number of failed attempts of the document.
+func (q *Queue) DeadLetter(ctx context.Context, key, source string, err error) (int, error) {
+	stage, ok := StageOf(err)
+	if !ok {
+		return 0, fmt.Errorf("failed to dead-letter %s, the error has no ingestion stage: %w", key, err)
+	}
+	e, putErr := q.Put(ctx, key, source, stage, err)
+	if putErr != nil {
+		return 0, putErr
+	}
+	return e.Attempts, nil
+}
+
+// StageOf returns the stage of the ingestion error err, and whether it is
+// one. Besides *StageError, the errors with an IngestionStage method are
+// ingestion errors, so that the packages of the pipeline that do not import
+// dlq can report the stage of their errors.
+func StageOf(err error) (Stage, bool) {
+	var staged interface{ IngestionStage() string }
+	if errors.As(err, &staged) {
+		return Stage(staged.IngestionStage()), true
+	}
+	return "", false
+}
@@ -0,0 +1,172 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dlq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"gocloud.dev/pubsub"
+	_ "gocloud.dev/pubsub/mempubsub"
+)
+
+func TestQueue(t *testing.T) {
+	ctx := context.Background()
+	q, err := Open(ctx, "mem://", "")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer q.Close(ctx)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	q.now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+
+	if _, err := q.Put(ctx, "sha256_b", "file:///b.json", StageParse, errors.New("bad sbom")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if _, err := q.Put(ctx, "sha256_a", "file:///a.json", StageProcess, errors.New("unknown format")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	e, err := q.Put(ctx, "sha256_b", "", StageAssemble, errors.New("bad predicate"))
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if e.Attempts != 2 || e.Stage != StageAssemble || e.Error != "bad predicate" || e.Source != "file:///b.json" || !e.FailedAt.After(e.FirstFailedAt) {
+		t.Errorf("unexpected entry after a second failure: %+v", e)
+	}
+
+	entries, err := q.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(entries) != 2 || entries[0].Key != "sha256_b" || entries[1].Key != "sha256_a" {
+		t.Errorf("List() = %+v, want sha256_b then sha256_a", entries)
+	}
+
+	if err := q.Remove(ctx, "sha256_b"); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if err := q.Remove(ctx, "sha256_missing"); err != nil {
+		t.Errorf("Remove() of a missing entry error = %v", err)
+	}
+	got, err := q.Get(ctx, "sha256_b")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got != nil {
+		t.Errorf("Get() of a removed entry = %+v", got)
+	}
+}
+
+func TestQueuePublish(t *testing.T) {
+	ctx := context.Background()
+	topic, err := pubsub.OpenTopic(ctx, "mem://deadletter")
+	if err != nil {
+		t.Fatalf("OpenTopic() error = %v", err)
+	}
+	defer topic.Shutdown(ctx)
+	sub, err := pubsub.OpenSubscription(ctx, "mem://deadletter")
+	if err != nil {
+		t.Fatalf("OpenSubscription() error = %v", err)
+	}
+	defer sub.Shutdown(ctx)
+
+	q, err := Open(ctx, "mem://", "mem://deadletter")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer q.Close(ctx)
+	if _, err := q.Put(ctx, "sha256_a", "file:///a.json", StageParse, errors.New("bad sbom")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	rctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	msg, err := sub.Receive(rctx)
+	if err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+	msg.Ack()
+	var e Entry
+	if err := json.Unmarshal(msg.Body, &e); err != nil {
+		t.Fatalf("unable to unmarshal published entry: %v", err)
+	}
+	if e.Key != "sha256_a" || e.Stage != StageParse || e.Attempts != 1 {
+		t.Errorf("unexpected published entry %+v", e)
+	}
+}
+
+func TestStageOf(t *testing.T) {
+	err := fmt.Errorf("unable to ingest: %w", &StageError{Stage: StageParse, Err: errors.New("bad sbom")})
+	if stage, ok := StageOf(err); !ok || stage != StageParse {
+		t.Errorf("StageOf() = %q, %v, want %q, true", stage, ok, StageParse)
+	}
+	if _, ok := StageOf(errors.New("connection refused")); ok {
+		t.Errorf("StageOf() of a plain error is a stage error")
+	}
+	if stage, ok := StageOf(decodeError{}); !ok || stage != StageDecode {
+		t.Errorf("StageOf() = %q, %v, want %q, true", stage, ok, StageDecode)
+	}
+}
+
+// decodeError reports its stage without depending on dlq, like the decode
+// errors of process.Subscribe.
+type decodeError struct{}
+
+func (decodeError) Error() string          { return "bad json" }
+func (decodeError) IngestionStage() string { return "decode" }
+
+func TestPermanent(t *testing.T) {
+	for stage, want := range map[Stage]bool{
+		StageRead:     false,
+		StageDecode:   true,
+		StageProcess:  true,
+		StageParse:    true,
+		StageAssemble: false,
+	} {
+		if got := (&StageError{Stage: stage}).Permanent(); got != want {
+			t.Errorf("Permanent() of stage %s = %v, want %v", stage, got, want)
+		}
+	}
+}
+
+func TestDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	q, err := Open(ctx, "mem://", "")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer q.Close(ctx)
+
+	for want := 1; want <= 2; want++ {
+		attempts, err := q.DeadLetter(ctx, "sha256_a", "file:///a.json", &StageError{Stage: StageParse, Err: errors.New("bad sbom")})
+		if err != nil || attempts != want {
+			t.Errorf("DeadLetter() = %d, %v, want %d attempts", attempts, err, want)
+		}
+	}
+	if e, err := q.Get(ctx, "sha256_a"); err != nil || e == nil || e.Stage != StageParse {
+		t.Errorf("Get() = %+v, %v, want an entry at stage %s", e, err, StageParse)
+	}
+	if _, err := q.DeadLetter(ctx, "sha256_b", "", errors.New("connection refused")); err == nil {
+		t.Errorf("DeadLetter() of an error without a stage did not fail")
+	}
+}
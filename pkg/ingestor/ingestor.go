@@ -17,6 +17,8 @@ package ingestor
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"time"
@@ -25,11 +27,15 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/collectsub/collectsub/input"
+	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/handler/processor/process"
+	"github.com/guacsec/guac/pkg/ingestor/dlq"
+	"github.com/guacsec/guac/pkg/ingestor/ledger"
 	"github.com/guacsec/guac/pkg/ingestor/parser"
 	parser_common "github.com/guacsec/guac/pkg/ingestor/parser/common"
 	"github.com/guacsec/guac/pkg/logging"
@@ -46,24 +52,48 @@ func Ingest(
 	scanForLicense bool,
 	scanForEOL bool,
 	scanForDepsDev bool,
+) (*helpers.AssemblerIngestedIDs, error) {
+	assemblerFunc := GetAssembler(ctx, d.ChildLogger, graphqlEndpoint, transport)
+	return IngestWithAssembler(ctx, d, assemblerFunc, csubClient, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
+}
+
+// Synchronously ingest document using the provided assembler, such as the one
+// returned by GetAssembler or the gRPC bulk assembler
+func IngestWithAssembler(
+	ctx context.Context,
+	d *processor.Document,
+	assemblerFunc func([]assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error),
+	csubClient csub_client.Client,
+	scanForVulns bool,
+	scanForLicense bool,
+	scanForEOL bool,
+	scanForDepsDev bool,
 ) (*helpers.AssemblerIngestedIDs, error) {
 	logger := d.ChildLogger
 	// Get pipeline of components
 	processorFunc := GetProcessor(ctx)
 	ingestorFunc := GetIngestor(ctx, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
 	collectSubEmitFunc := GetCollectSubEmit(ctx, csubClient)
-	assemblerFunc := GetAssembler(ctx, d.ChildLogger, graphqlEndpoint, transport)
 
 	start := time.Now()
 
 	docTree, err := processorFunc(d)
 	if err != nil {
-		return nil, fmt.Errorf("unable to process doc: %v, format: %v, document: %v", err, d.Format, d.Type)
+		return nil, &dlq.StageError{Stage: dlq.StageProcess, Err: fmt.Errorf("unable to process doc: %v, format: %v, document: %v", err, d.Format, d.Type)}
+	}
+
+	if parser.CanParseStream(docTree) {
+		ingestedIDs, err := ingestStream(ctx, docTree, assemblerFunc, collectSubEmitFunc, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
+		if err != nil {
+			return nil, err
+		}
+		logger.Infof("[%v] completed doc %+v", time.Since(start), d.SourceInformation)
+		return ingestedIDs, nil
 	}
 
 	predicates, idstrings, err := ingestorFunc(docTree)
 	if err != nil {
-		return nil, fmt.Errorf("unable to ingest doc tree: %v", err)
+		return nil, &dlq.StageError{Stage: dlq.StageParse, Err: fmt.Errorf("unable to ingest doc tree: %v", err)}
 	}
 
 	if err := collectSubEmitFunc(idstrings); err != nil {
@@ -72,7 +102,7 @@ func Ingest(
 
 	ingestedIDs, err := assemblerFunc(predicates)
 	if err != nil {
-		return nil, fmt.Errorf("error assembling graphs for %q : %w", d.SourceInformation.Source, err)
+		return nil, &dlq.StageError{Stage: dlq.StageAssemble, Err: fmt.Errorf("error assembling graphs for %q : %w", d.SourceInformation.Source, err)}
 	}
 
 	t := time.Now()
@@ -81,6 +111,148 @@ func Ingest(
 	return ingestedIDs, nil
 }
 
+// streamChunkSize is the number of predicates assembled at once for a
+// document parsed as a stream.
+const streamChunkSize = 5000
+
+// ingestStream parses a large document as a stream and assembles each chunk
+// of predicates as it is parsed. The HasSBOM of the document, in the last
+// chunk, includes the nodes assembled with the earlier chunks.
+func ingestStream(
+	ctx context.Context,
+	docTree processor.DocumentTree,
+	assemblerFunc func([]assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error),
+	collectSubEmitFunc func([]*parser_common.IdentifierStrings) error,
+	scanForVulns bool,
+	scanForLicense bool,
+	scanForEOL bool,
+	scanForDepsDev bool,
+) (*helpers.AssemblerIngestedIDs, error) {
+	d := docTree.Document
+	logger := d.ChildLogger
+	logger.Infof("parsing large doc %q as a stream", d.SourceInformation.Source)
+
+	ingestedIDs := &helpers.AssemblerIngestedIDs{}
+	var assembleErr error
+	chunks := 0
+	err := parser.ParseDocumentStream(ctx, docTree, streamChunkSize, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev,
+		func(predicates assembler.IngestPredicates, idstrings *parser_common.IdentifierStrings, last bool) error {
+			if err := collectSubEmitFunc([]*parser_common.IdentifierStrings{idstrings}); err != nil {
+				logger.Infof("unable to create entries in collectsub server, but continuing: %v", err)
+			}
+			if last {
+				includes := &generated.HasSBOMIncludesInputSpec{
+					Packages:     ingestedIDs.PackageIDs,
+					Artifacts:    ingestedIDs.ArtifactIDs,
+					Dependencies: ingestedIDs.IsDependencyIDs,
+					Occurrences:  ingestedIDs.IsOccurrenceIDs,
+				}
+				for i := range predicates.HasSBOM {
+					predicates.HasSBOM[i].Includes = includes
+				}
+			}
+			ids, err := assemblerFunc([]assembler.IngestPredicates{predicates})
+			if err != nil {
+				assembleErr = fmt.Errorf("error assembling graphs for %q : %w", d.SourceInformation.Source, err)
+				return assembleErr
+			}
+			if ids != nil {
+				ingestedIDs.HasSBOMIDs = append(ingestedIDs.HasSBOMIDs, ids.HasSBOMIDs...)
+				ingestedIDs.HasSLSAIDs = append(ingestedIDs.HasSLSAIDs, ids.HasSLSAIDs...)
+				ingestedIDs.PackageIDs = append(ingestedIDs.PackageIDs, ids.PackageIDs...)
+				ingestedIDs.ArtifactIDs = append(ingestedIDs.ArtifactIDs, ids.ArtifactIDs...)
+				ingestedIDs.IsDependencyIDs = append(ingestedIDs.IsDependencyIDs, ids.IsDependencyIDs...)
+				ingestedIDs.IsOccurrenceIDs = append(ingestedIDs.IsOccurrenceIDs, ids.IsOccurrenceIDs...)
+			}
+			chunks++
+			return nil
+		})
+	if assembleErr != nil {
+		return nil, &dlq.StageError{Stage: dlq.StageAssemble, Err: assembleErr}
+	}
+	if err != nil {
+		return nil, &dlq.StageError{Stage: dlq.StageParse, Err: fmt.Errorf("unable to ingest doc tree: %v", err)}
+	}
+	logger.Infof("assembled large doc %q in %d chunks", d.SourceInformation.Source, chunks)
+	return ingestedIDs, nil
+}
+
+// IngestOnce ingests the document using the provided assembler, unless the
+// ledger records that the document was already ingested with the same inputs
+// by the same parser.SchemaVersion. The returned bool is true if the document
+// was skipped. force ingests the document anyway, and a nil ledger ingests
+// every document. The ledger is not used either when scanning for
+// vulnerabilities, end-of-life or deps.dev data, as the scan results change
+// even though the document does not.
+func IngestOnce(
+	ctx context.Context,
+	d *processor.Document,
+	l *ledger.Ledger,
+	force bool,
+	assemblerFunc func([]assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error),
+	csubClient csub_client.Client,
+	scanForVulns bool,
+	scanForLicense bool,
+	scanForEOL bool,
+	scanForDepsDev bool,
+) (*helpers.AssemblerIngestedIDs, bool, error) {
+	if l == nil || scanForVulns || scanForEOL || scanForDepsDev {
+		ingestedIDs, err := IngestWithAssembler(ctx, d, assemblerFunc, csubClient, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
+		return ingestedIDs, false, err
+	}
+
+	// the key is computed before processing, which may decode the blob
+	key, err := documentKey(d)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to read doc: %w", err)
+	}
+	inputs := ingestInputs(d, scanForLicense)
+	if !force {
+		entry, err := l.Get(ctx, key, inputs, parser.SchemaVersion)
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to check ledger: %w", err)
+		}
+		if entry != nil {
+			d.ChildLogger.Infof("skipping doc %q, already ingested at %v", d.SourceInformation.Source, entry.IngestedAt)
+			return nil, true, nil
+		}
+	}
+
+	ingestedIDs, err := IngestWithAssembler(ctx, d, assemblerFunc, csubClient, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := l.Record(ctx, key, inputs, parser.SchemaVersion, d.SourceInformation.Source); err != nil {
+		// the document is ingested again next time, which is harmless
+		d.ChildLogger.Warnf("unable to record doc %q in ledger: %v", d.SourceInformation.Source, err)
+	}
+	return ingestedIDs, false, nil
+}
+
+// documentKey returns the key of the blob of the document, see
+// events.GetKey, which is read as a stream if the document is read with Open.
+func documentKey(d *processor.Document) (string, error) {
+	if d.Open == nil {
+		return events.GetKey(d.Blob), nil
+	}
+	r, err := d.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	return events.GetReaderKey(r)
+}
+
+// ingestInputs returns a hash of the inputs of the ingestion of the document
+// besides its blob that the ingested predicates depend on: the artifact and
+// the source revision the document was collected from, and the license scan.
+func ingestInputs(d *processor.Document, scanForLicense bool) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "subject=%s\ncommit=%s\ntag=%s\n", d.SourceInformation.Subject, d.SourceInformation.Commit, d.SourceInformation.Tag)
+	fmt.Fprintf(h, "license=%t\n", scanForLicense)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 func MergedIngest(
 	ctx context.Context,
 	docs []*processor.Document,
@@ -132,6 +304,7 @@ func MergedIngest(
 			predicates[0].VulnMetadata = append(predicates[0].VulnMetadata, preds[i].VulnMetadata...)
 			predicates[0].HasMetadata = append(predicates[0].HasMetadata, preds[i].HasMetadata...)
 			predicates[0].CertifyLegal = append(predicates[0].CertifyLegal, preds[i].CertifyLegal...)
+			predicates[0].Documents = append(predicates[0].Documents, preds[i].Documents...)
 			totalPredicates += 1
 			// enough predicates have been collected, worth sending them to GraphQL server
 			if totalPredicates == 5000 {
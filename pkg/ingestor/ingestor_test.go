@@ -0,0 +1,236 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestor_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/ingestor/ledger"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func TestIngestOnce(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	l, err := ledger.Open(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("unable to open ledger: %v", err)
+	}
+	defer l.Close()
+
+	assembled := 0
+	assemblerFunc := func([]assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error) {
+		assembled++
+		return &helpers.AssemblerIngestedIDs{}, nil
+	}
+	newDoc := func(subject, commit string) *processor.Document {
+		return &processor.Document{
+			Blob:   testdata.SpdxExampleAlpine,
+			Format: processor.FormatUnknown,
+			Type:   processor.DocumentUnknown,
+			SourceInformation: processor.SourceInformation{
+				Collector: "test",
+				Source:    "file:///alpine.spdx.json",
+				Subject:   subject,
+				Commit:    commit,
+			},
+			ChildLogger: logging.FromContext(ctx),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		ledger      *ledger.Ledger
+		force       bool
+		scanForEOL  bool
+		subject     string
+		commit      string
+		wantSkipped bool
+		wantTotal   int
+	}{{
+		name:      "first ingestion",
+		ledger:    l,
+		wantTotal: 1,
+	}, {
+		name:        "unchanged document is skipped",
+		ledger:      l,
+		wantSkipped: true,
+		wantTotal:   1,
+	}, {
+		name:      "force ingests again",
+		ledger:    l,
+		force:     true,
+		wantTotal: 2,
+	}, {
+		name:      "no ledger ingests again",
+		wantTotal: 3,
+	}, {
+		name:      "document attached to another artifact is ingested again",
+		ledger:    l,
+		subject:   "sha256:9e183c89765d92a440f44ac7059385c778cbadad0ee8fe3208360efb07c0ba09",
+		wantTotal: 4,
+	}, {
+		name:        "document attached to the same artifact is skipped",
+		ledger:      l,
+		subject:     "sha256:9e183c89765d92a440f44ac7059385c778cbadad0ee8fe3208360efb07c0ba09",
+		wantSkipped: true,
+		wantTotal:   4,
+	}, {
+		name:      "document at another commit is ingested again",
+		ledger:    l,
+		commit:    "bcd7f8914d47f029d637ce5d779b5cc6d39be872",
+		wantTotal: 5,
+	}, {
+		name:        "document at the same commit is skipped",
+		ledger:      l,
+		commit:      "bcd7f8914d47f029d637ce5d779b5cc6d39be872",
+		wantSkipped: true,
+		wantTotal:   5,
+	}, {
+		name:       "end-of-life scan ingests again",
+		ledger:     l,
+		scanForEOL: true,
+		wantTotal:  6,
+	}, {
+		name:       "end-of-life scan ingests again the next time",
+		ledger:     l,
+		scanForEOL: true,
+		wantTotal:  7,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, skipped, err := ingestor.IngestOnce(ctx, newDoc(tt.subject, tt.commit), tt.ledger, tt.force, assemblerFunc, nil, false, false, tt.scanForEOL, false)
+			if err != nil {
+				t.Fatalf("IngestOnce() error = %v", err)
+			}
+			if skipped != tt.wantSkipped {
+				t.Errorf("IngestOnce() skipped = %v, want %v", skipped, tt.wantSkipped)
+			}
+			if assembled != tt.wantTotal {
+				t.Errorf("assembled %d times, want %d", assembled, tt.wantTotal)
+			}
+		})
+	}
+}
+
+// largeSpdx returns an SPDX JSON document with n packages, each with a
+// checksum and a dependency on the previous package.
+func largeSpdx(n int) []byte {
+	var packages, relationships []map[string]any
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("SPDXRef-Package-%d", i)
+		packages = append(packages, map[string]any{
+			"SPDXID":      id,
+			"name":        fmt.Sprintf("pkg%d", i),
+			"versionInfo": "1.0.0",
+			"checksums": []map[string]string{
+				{"algorithm": "SHA256", "checksumValue": fmt.Sprintf("%064x", i+1)},
+			},
+		})
+		if i > 0 {
+			relationships = append(relationships, map[string]any{
+				"spdxElementId":      id,
+				"relationshipType":   "DEPENDS_ON",
+				"relatedSpdxElement": fmt.Sprintf("SPDXRef-Package-%d", i-1),
+			})
+		}
+	}
+	blob, _ := json.Marshal(map[string]any{
+		"spdxVersion":       "SPDX-2.3",
+		"dataLicense":       "CC0-1.0",
+		"SPDXID":            "SPDXRef-DOCUMENT",
+		"name":              "large",
+		"documentNamespace": "https://example.com/large",
+		"creationInfo":      map[string]any{"created": "2026-01-01T00:00:00Z", "creators": []string{"Tool: test"}},
+		"documentDescribes": []string{fmt.Sprintf("SPDXRef-Package-%d", n-1)},
+		"packages":          packages,
+		"relationships":     relationships,
+	})
+	return blob
+}
+
+func TestIngestWithAssemblerStream(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	largeDocumentSize := processor.LargeDocumentSize
+	processor.LargeDocumentSize = 0
+	t.Cleanup(func() { processor.LargeDocumentSize = largeDocumentSize })
+
+	var calls []assembler.IngestPredicates
+	assemblerFunc := func(preds []assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error) {
+		calls = append(calls, preds...)
+		return &helpers.AssemblerIngestedIDs{
+			PackageIDs: []string{fmt.Sprintf("package-%d", len(calls))},
+		}, nil
+	}
+	d := &processor.Document{
+		Blob:   largeSpdx(5000),
+		Format: processor.FormatUnknown,
+		Type:   processor.DocumentUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   "test",
+			Source:      "file:///large.spdx.json",
+			DocumentRef: "sha256_large",
+		},
+		ChildLogger: logging.FromContext(ctx),
+	}
+	if _, err := ingestor.IngestWithAssembler(ctx, d, assemblerFunc, nil, false, false, false, false); err != nil {
+		t.Fatalf("IngestWithAssembler() error = %v", err)
+	}
+
+	if len(calls) < 2 {
+		t.Fatalf("assembled %d chunks, want several", len(calls))
+	}
+	var isDeps, isOccs int
+	for i, preds := range calls {
+		isDeps += len(preds.IsDependency)
+		isOccs += len(preds.IsOccurrence)
+		if len(preds.Documents) != 0 && i != 0 {
+			t.Errorf("chunk %d has the Document node, want the first one", i)
+		}
+		if len(preds.HasSBOM) != 0 && i != len(calls)-1 {
+			t.Errorf("chunk %d has the HasSBOM, want the last one", i)
+		}
+		if len(preds.IsDependency) > 0 && preds.IsDependency[0].IsDependency.Collector != "test" {
+			t.Errorf("chunk %d is missing the collector metadata", i)
+		}
+	}
+	if isDeps != 4999 || isOccs != 5000 {
+		t.Errorf("assembled %d IsDependency and %d IsOccurrence, want 4999 and 5000", isDeps, isOccs)
+	}
+	if len(calls[0].Documents) != 1 {
+		t.Errorf("first chunk has %d Document nodes, want 1", len(calls[0].Documents))
+	}
+
+	last := calls[len(calls)-1]
+	if len(last.HasSBOM) != 1 {
+		t.Fatalf("last chunk has %d HasSBOM, want 1", len(last.HasSBOM))
+	}
+	var want []string
+	for i := 1; i < len(calls); i++ {
+		want = append(want, fmt.Sprintf("package-%d", i))
+	}
+	if includes := last.HasSBOM[0].Includes; includes == nil || !slices.Equal(includes.Packages, want) {
+		t.Errorf("HasSBOM includes = %+v, want the packages %v of the earlier chunks", includes, want)
+	}
+}
@@ -0,0 +1,154 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ledger implements the ledger of the documents that were ingested,
+// so that re-collected documents that did not change are not ingested again.
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/azureblob"
+	_ "gocloud.dev/blob/fileblob"
+	_ "gocloud.dev/blob/gcsblob"
+	_ "gocloud.dev/blob/memblob"
+	_ "gocloud.dev/blob/s3blob"
+	"gocloud.dev/gcerrors"
+)
+
+// Entry records a document that was ingested.
+type Entry struct {
+	// Key is the key of the document blob, see events.GetKey.
+	Key string `json:"key"`
+	// Inputs is a hash of the inputs of the ingestion besides the document
+	// blob, such as the artifact the document is attached to.
+	Inputs string `json:"inputs,omitempty"`
+	// ParserVersion is the schema version of the parser that parsed the
+	// document, see parser.SchemaVersion.
+	ParserVersion string `json:"parserVersion"`
+	// Source is the source the collector got the document from.
+	Source string `json:"source,omitempty"`
+	// IngestedAt is the time the document was ingested.
+	IngestedAt time.Time `json:"ingestedAt"`
+}
+
+// Ledger stores the entries in a gocloud bucket, keyed by the parser schema
+// version, the key of the document blob and the hash of the other ingestion
+// inputs. A new parser schema version ingests all the documents again, as it
+// may produce different predicates, and so does the same document collected
+// with other inputs, such as attached to another artifact.
+type Ledger struct {
+	bucket *blob.Bucket
+	now    func() time.Time
+}
+
+// Open opens the ledger stored in the bucket at url, see
+// https://gocloud.dev/howto/blob/. The returned ledger must be closed.
+func Open(ctx context.Context, url string) (*Ledger, error) {
+	bucket, err := blob.OpenBucket(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ledger bucket %s: %w", url, err)
+	}
+	return &Ledger{bucket: bucket, now: time.Now}, nil
+}
+
+func entryKey(key, inputs, parserVersion string) string {
+	return parserVersion + "/" + key + "/" + inputs
+}
+
+// Get returns the entry of the document blob key ingested with the inputs by
+// parserVersion, or nil if there is none.
+func (l *Ledger) Get(ctx context.Context, key, inputs, parserVersion string) (*Entry, error) {
+	data, err := l.bucket.ReadAll(ctx, entryKey(key, inputs, parserVersion))
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read ledger entry %s: %w", key, err)
+	}
+	var e Entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ledger entry %s: %w", key, err)
+	}
+	return &e, nil
+}
+
+// Record records that parserVersion ingested the document blob key with the
+// inputs.
+func (l *Ledger) Record(ctx context.Context, key, inputs, parserVersion, source string) error {
+	data, err := json.Marshal(&Entry{
+		Key:           key,
+		Inputs:        inputs,
+		ParserVersion: parserVersion,
+		Source:        source,
+		IngestedAt:    l.now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal ledger entry: %w", err)
+	}
+	if err := l.bucket.WriteAll(ctx, entryKey(key, inputs, parserVersion), data, nil); err != nil {
+		return fmt.Errorf("failed to write ledger entry %s: %w", key, err)
+	}
+	return nil
+}
+
+// Delete removes the entries of the document blob key of all the parser
+// versions and inputs, so that the document is ingested again when it is
+// collected again, such as after it was retracted.
+func (l *Ledger) Delete(ctx context.Context, key string) error {
+	iter := l.bucket.List(&blob.ListOptions{Delimiter: "/"})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to list ledger parser versions: %w", err)
+		}
+		if !obj.IsDir {
+			continue
+		}
+		if err := l.deletePrefix(ctx, obj.Key+key+"/"); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *Ledger) deletePrefix(ctx context.Context, prefix string) error {
+	iter := l.bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to list ledger entries %s: %w", prefix, err)
+		}
+		err = l.bucket.Delete(ctx, obj.Key)
+		if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return fmt.Errorf("failed to delete ledger entry %s: %w", obj.Key, err)
+		}
+	}
+}
+
+// Close closes the bucket of the ledger.
+func (l *Ledger) Close() error {
+	return l.bucket.Close()
+}
@@ -0,0 +1,116 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLedger(t *testing.T) {
+	ctx := context.Background()
+	l, err := Open(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer l.Close()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+
+	got, err := l.Get(ctx, "sha256_a", "inputs_a", "v1.0.0")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got != nil {
+		t.Fatalf("Get() before Record() = %+v, want nil", got)
+	}
+
+	if err := l.Record(ctx, "sha256_a", "inputs_a", "v1.0.0", "file:///a.json"); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	got, err = l.Get(ctx, "sha256_a", "inputs_a", "v1.0.0")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	want := Entry{Key: "sha256_a", Inputs: "inputs_a", ParserVersion: "v1.0.0", Source: "file:///a.json", IngestedAt: now}
+	if got == nil || *got != want {
+		t.Errorf("Get() = %+v, want %+v", got, want)
+	}
+
+	// another parser version has not ingested the document
+	got, err = l.Get(ctx, "sha256_a", "inputs_a", "v1.1.0")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got != nil {
+		t.Errorf("Get() for another parser version = %+v, want nil", got)
+	}
+
+	// nor was the document ingested with other inputs
+	got, err = l.Get(ctx, "sha256_a", "inputs_b", "v1.0.0")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got != nil {
+		t.Errorf("Get() for other inputs = %+v, want nil", got)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	l, err := Open(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer l.Close()
+	for _, e := range []struct{ key, inputs, parserVersion string }{
+		{"sha256_a", "inputs_a", "v1.0.0"},
+		{"sha256_a", "inputs_a", "v1.1.0"},
+		{"sha256_a", "inputs_b", "v1.1.0"},
+		{"sha256_b", "inputs_a", "v1.1.0"},
+	} {
+		if err := l.Record(ctx, e.key, e.inputs, e.parserVersion, ""); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	if err := l.Delete(ctx, "sha256_a"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	// deleting a document that is not in the ledger is not an error
+	if err := l.Delete(ctx, "sha256_c"); err != nil {
+		t.Fatalf("Delete() of a missing document error = %v", err)
+	}
+
+	for _, test := range []struct {
+		key, inputs, parserVersion string
+		want                       bool
+	}{
+		{"sha256_a", "inputs_a", "v1.0.0", false},
+		{"sha256_a", "inputs_a", "v1.1.0", false},
+		{"sha256_a", "inputs_b", "v1.1.0", false},
+		{"sha256_b", "inputs_a", "v1.1.0", true},
+	} {
+		got, err := l.Get(ctx, test.key, test.inputs, test.parserVersion)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if (got != nil) != test.want {
+			t.Errorf("Get(%s, %s, %s) = %+v, want an entry: %v", test.key, test.inputs, test.parserVersion, got, test.want)
+		}
+	}
+}
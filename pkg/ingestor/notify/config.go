@@ -0,0 +1,354 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notify sends structured events to subscribers when predicates that
+// match their filters are ingested. Each subscription has a filter and a sink:
+// a webhook, an outbound NATS subject or a file. Events are deduplicated, so
+// re-ingesting a document does not notify again, and failed deliveries are
+// retried with an exponential backoff.
+//
+// Events are delivered by a pool of workers from a bounded queue, so that slow
+// sinks do not hold up ingestion. Events that do not fit in the queue are
+// dropped and logged.
+//
+// The dedup keys are only kept in memory: after a restart, re-ingesting a
+// document notifies again, and separate ingestion processes sharing a
+// subscription each deliver their events.
+//
+// Subscriptions are written in YAML (or JSON), for example:
+//
+//	dedupWindow: 24h
+//	queueSize: 1000
+//	workers: 4
+//	retry:
+//	  maxAttempts: 5
+//	  initialBackoff: 1s
+//	subscriptions:
+//	  - name: critical-npm-vulns
+//	    filter:
+//	      purl: "pkg:npm/*"
+//	      minSeverity: high
+//	      evidenceTypes: [certifyVuln, vex]
+//	    sink:
+//	      type: webhook
+//	      url: https://example.com/hooks/guac
+//	      headers:
+//	        Authorization: Bearer 0123456789
+//	  - name: release-image
+//	    filter:
+//	      digest: sha256:0123456789abcdef
+//	    sink:
+//	      type: nats
+//	      url: nats://127.0.0.1:4222
+//	      subject: guac.notifications
+//	  - name: audit
+//	    sink:
+//	      type: file
+//	      path: /var/log/guac/events.jsonl
+package notify
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SinkType is the kind of destination that events are delivered to.
+type SinkType string
+
+const (
+	// SinkWebhook POSTs each event as JSON to URL.
+	SinkWebhook SinkType = "webhook"
+	// SinkNATS publishes each event as JSON on Subject of the NATS server at
+	// URL.
+	SinkNATS SinkType = "nats"
+	// SinkFile appends each event as a line of JSON to the file at Path.
+	SinkFile SinkType = "file"
+)
+
+const (
+	defaultDedupWindow    = 24 * time.Hour
+	defaultDedupSize      = 100000
+	defaultMaxAttempts    = 5
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = time.Minute
+	defaultQueueSize      = 1000
+	defaultWorkers        = 4
+)
+
+// Config is the set of subscriptions of a notifier.
+type Config struct {
+	Subscriptions []Subscription `yaml:"subscriptions" json:"subscriptions"`
+	// DedupWindow is how long an event that was delivered to a subscription
+	// is remembered, so that it is not delivered again. Defaults to 24h.
+	DedupWindow Duration `yaml:"dedupWindow,omitempty" json:"dedupWindow,omitempty"`
+	// DedupSize is the maximum number of remembered events. Defaults to
+	// 100000.
+	DedupSize int `yaml:"dedupSize,omitempty" json:"dedupSize,omitempty"`
+	// QueueSize is the number of events waiting for delivery above which new
+	// events are dropped. Defaults to 1000.
+	QueueSize int `yaml:"queueSize,omitempty" json:"queueSize,omitempty"`
+	// Workers is the number of events delivered concurrently. Defaults to 4.
+	Workers int   `yaml:"workers,omitempty" json:"workers,omitempty"`
+	Retry   Retry `yaml:"retry,omitempty" json:"retry,omitempty"`
+}
+
+// Retry configures how failed deliveries are retried. The backoff doubles
+// after each attempt, up to MaxBackoff.
+type Retry struct {
+	// MaxAttempts is the number of delivery attempts of an event, including
+	// the first one. Defaults to 5.
+	MaxAttempts    int      `yaml:"maxAttempts,omitempty" json:"maxAttempts,omitempty"`
+	InitialBackoff Duration `yaml:"initialBackoff,omitempty" json:"initialBackoff,omitempty"`
+	MaxBackoff     Duration `yaml:"maxBackoff,omitempty" json:"maxBackoff,omitempty"`
+}
+
+// Subscription delivers the events of the ingested predicates that match
+// Filter to Sink.
+type Subscription struct {
+	Name   string `yaml:"name" json:"name"`
+	Filter Filter `yaml:"filter,omitempty" json:"filter,omitempty"`
+	Sink   Sink   `yaml:"sink" json:"sink"`
+}
+
+// Filter selects the events of a subscription. An event matches if it matches
+// all of the fields that are set, so an empty filter matches every event.
+type Filter struct {
+	// Purl is a glob matched against the purls of the packages of the
+	// predicate. "*" matches any sequence of characters, including "/", and
+	// "?" matches a single character.
+	Purl string `yaml:"purl,omitempty" json:"purl,omitempty"`
+	// Digest is an "algorithm:digest" matched against the artifacts of the
+	// predicate, ignoring case. It also matches the predicates about the
+	// packages the artifact is an occurrence of or includes in its SBOMs,
+	// such as a new certifyVuln of one of its packages.
+	Digest string `yaml:"digest,omitempty" json:"digest,omitempty"`
+	// MinSeverity is the minimum CVSS score of the vulnerability of the
+	// predicate, either a number or one of the qualitative ratings low,
+	// medium, high and critical. The score is the highest CVSS score of the
+	// vulnerability metadata ingested with the predicate, and predicates
+	// without a score do not match.
+	MinSeverity string `yaml:"minSeverity,omitempty" json:"minSeverity,omitempty"`
+	// EvidenceTypes are the predicate types to notify about, named as in
+	// assembler.IngestPredicates, e.g. certifyVuln, vex or hasSBOM.
+	EvidenceTypes []string `yaml:"evidenceTypes,omitempty" json:"evidenceTypes,omitempty"`
+}
+
+// Sink is the destination of the events of a subscription. Only the fields
+// of the sink Type are used.
+type Sink struct {
+	Type SinkType `yaml:"type" json:"type"`
+	// URL is the endpoint of a webhook, or the NATS server to publish to.
+	URL string `yaml:"url,omitempty" json:"url,omitempty"`
+	// Headers are added to the requests of a webhook.
+	Headers map[string]string `yaml:"headers,omitempty" json:"headers,omitempty"`
+	// Subject is the NATS subject that events are published on.
+	Subject string `yaml:"subject,omitempty" json:"subject,omitempty"`
+	// CredsFile is the NATS user credentials file, if the server requires
+	// authentication.
+	CredsFile string `yaml:"credsFile,omitempty" json:"credsFile,omitempty"`
+	// Path is the file that events are appended to.
+	Path string `yaml:"path,omitempty" json:"path,omitempty"`
+}
+
+// Duration is a time.Duration written as a string such as "30s" or "24h".
+type Duration time.Duration
+
+// UnmarshalYAML parses a duration string.
+func (d *Duration) UnmarshalYAML(value *yaml.Node) error {
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// severityRatings are the lower bounds of the CVSS qualitative ratings.
+var severityRatings = map[string]float64{
+	"none":     0,
+	"low":      0.1,
+	"medium":   4.0,
+	"high":     7.0,
+	"critical": 9.0,
+}
+
+// evidenceTypes are the predicate types of assembler.IngestPredicates.
+var evidenceTypes = map[string]bool{
+	EvidenceCertifyScorecard: true,
+	EvidenceIsDependency:     true,
+	EvidenceIsOccurrence:     true,
+	EvidenceHasSLSA:          true,
+	EvidenceCertifyVuln:      true,
+	EvidenceVulnEqual:        true,
+	EvidenceHasSourceAt:      true,
+	EvidenceCertifyBad:       true,
+	EvidenceCertifyGood:      true,
+	EvidenceHasSBOM:          true,
+	EvidenceHashEqual:        true,
+	EvidencePkgEqual:         true,
+	EvidenceVex:              true,
+	EvidencePointOfContact:   true,
+	EvidenceVulnMetadata:     true,
+	EvidenceHasMetadata:      true,
+	EvidenceCertifyLegal:     true,
+}
+
+// Parse reads a notification config from YAML or JSON and validates it.
+func Parse(data []byte) (*Config, error) {
+	var c Config
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&c); err != nil {
+		return nil, fmt.Errorf("failed to parse notification config: %w", err)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Load reads and validates the notification config in the file at path.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notification config file: %w", err)
+	}
+	return Parse(data)
+}
+
+// Validate checks that all subscriptions are well formed.
+func (c *Config) Validate() error {
+	if len(c.Subscriptions) == 0 {
+		return errors.New("notification config has no subscriptions")
+	}
+	if c.DedupWindow < 0 || c.DedupSize < 0 {
+		return errors.New("dedupWindow and dedupSize must not be negative")
+	}
+	if c.QueueSize < 0 || c.Workers < 0 {
+		return errors.New("queueSize and workers must not be negative")
+	}
+	if c.Retry.MaxAttempts < 0 || c.Retry.InitialBackoff < 0 || c.Retry.MaxBackoff < 0 {
+		return errors.New("retry settings must not be negative")
+	}
+	names := map[string]bool{}
+	for i, s := range c.Subscriptions {
+		if s.Name == "" {
+			return fmt.Errorf("subscription %d has no name", i)
+		}
+		if names[s.Name] {
+			return fmt.Errorf("subscription name %q is used more than once", s.Name)
+		}
+		names[s.Name] = true
+
+		if _, err := parseSeverity(s.Filter.MinSeverity); err != nil {
+			return fmt.Errorf("subscription %q: %w", s.Name, err)
+		}
+		for _, t := range s.Filter.EvidenceTypes {
+			if !evidenceTypes[t] {
+				return fmt.Errorf("subscription %q: unknown evidence type %q", s.Name, t)
+			}
+		}
+
+		switch s.Sink.Type {
+		case SinkWebhook:
+			if !strings.HasPrefix(s.Sink.URL, "http://") && !strings.HasPrefix(s.Sink.URL, "https://") {
+				return fmt.Errorf("subscription %q: webhook url must be an http or https URL, got %q", s.Name, s.Sink.URL)
+			}
+		case SinkNATS:
+			if s.Sink.URL == "" || s.Sink.Subject == "" {
+				return fmt.Errorf("subscription %q: nats sink requires url and subject", s.Name)
+			}
+		case SinkFile:
+			if s.Sink.Path == "" {
+				return fmt.Errorf("subscription %q: file sink requires path", s.Name)
+			}
+		default:
+			return fmt.Errorf("subscription %q: unknown sink type %q", s.Name, s.Sink.Type)
+		}
+	}
+	return nil
+}
+
+func (c *Config) withDefaults() Config {
+	d := *c
+	if d.DedupWindow == 0 {
+		d.DedupWindow = Duration(defaultDedupWindow)
+	}
+	if d.DedupSize == 0 {
+		d.DedupSize = defaultDedupSize
+	}
+	if d.QueueSize == 0 {
+		d.QueueSize = defaultQueueSize
+	}
+	if d.Workers == 0 {
+		d.Workers = defaultWorkers
+	}
+	if d.Retry.MaxAttempts == 0 {
+		d.Retry.MaxAttempts = defaultMaxAttempts
+	}
+	if d.Retry.InitialBackoff == 0 {
+		d.Retry.InitialBackoff = Duration(defaultInitialBackoff)
+	}
+	if d.Retry.MaxBackoff == 0 {
+		d.Retry.MaxBackoff = Duration(defaultMaxBackoff)
+	}
+	return d
+}
+
+// parseSeverity returns the minimum score of a MinSeverity, or nil if it is
+// not set.
+func parseSeverity(s string) (*float64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if v, ok := severityRatings[strings.ToLower(s)]; ok {
+		return &v, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v < 0 || v > 10 {
+		return nil, fmt.Errorf("minSeverity must be a score between 0 and 10 or one of none, low, medium, high and critical, got %q", s)
+	}
+	return &v, nil
+}
+
+// globToRegexp converts a purl glob to an anchored regular expression.
+func globToRegexp(glob string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
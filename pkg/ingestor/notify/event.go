@@ -0,0 +1,304 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// Evidence types, named as the fields of assembler.IngestPredicates.
+const (
+	EvidenceCertifyScorecard = "certifyScorecard"
+	EvidenceIsDependency     = "isDependency"
+	EvidenceIsOccurrence     = "isOccurrence"
+	EvidenceHasSLSA          = "hasSlsa"
+	EvidenceCertifyVuln      = "certifyVuln"
+	EvidenceVulnEqual        = "vulnEqual"
+	EvidenceHasSourceAt      = "hasSourceAt"
+	EvidenceCertifyBad       = "certifyBad"
+	EvidenceCertifyGood      = "certifyGood"
+	EvidenceHasSBOM          = "hasSBOM"
+	EvidenceHashEqual        = "hashEqual"
+	EvidencePkgEqual         = "pkgEqual"
+	EvidenceVex              = "vex"
+	EvidencePointOfContact   = "contact"
+	EvidenceVulnMetadata     = "vulnMetadata"
+	EvidenceHasMetadata      = "hasMetadata"
+	EvidenceCertifyLegal     = "certifyLegal"
+)
+
+const noVulnType = "novuln"
+
+// Event describes an ingested predicate that matched a subscription.
+type Event struct {
+	// ID identifies the predicate, so it is the same each time the predicate
+	// is ingested. Subscribers can use it to deduplicate events themselves.
+	ID           string    `json:"id"`
+	Subscription string    `json:"subscription"`
+	EvidenceType string    `json:"evidenceType"`
+	Time         time.Time `json:"time"`
+	// Source and Collector describe the document the predicate came from.
+	Source    string `json:"source,omitempty"`
+	Collector string `json:"collector,omitempty"`
+	// Packages, Sources and Artifacts are the subjects of the predicate, as
+	// purls, source URIs and "algorithm:digest" strings.
+	Packages      []string `json:"packages,omitempty"`
+	Sources       []string `json:"sources,omitempty"`
+	Artifacts     []string `json:"artifacts,omitempty"`
+	Vulnerability string   `json:"vulnerability,omitempty"`
+	// Severity is the highest CVSS score of Vulnerability.
+	Severity  *float64        `json:"severity,omitempty"`
+	Predicate json.RawMessage `json:"predicate"`
+}
+
+// eventsFromPredicates returns an event, without a subscription, for each of
+// the predicates.
+func eventsFromPredicates(preds []assembler.IngestPredicates, src processor.SourceInformation, now time.Time) ([]Event, error) {
+	severities := vulnSeverities(preds)
+	var events []Event
+	// addWithIdentity derives the ID of the event from identity instead of
+	// the whole predicate, for predicates that carry volatile metadata.
+	addWithIdentity := func(evidenceType string, predicate, identity any, e Event) error {
+		data, err := json.Marshal(predicate)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s predicate: %w", evidenceType, err)
+		}
+		id, err := json.Marshal(identity)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s predicate: %w", evidenceType, err)
+		}
+		sum := sha256.Sum256(append([]byte(evidenceType+"\n"), id...))
+		e.ID = hex.EncodeToString(sum[:])
+		e.EvidenceType = evidenceType
+		e.Time = now
+		e.Source = src.Source
+		e.Collector = src.Collector
+		e.Predicate = data
+		if e.Vulnerability != "" {
+			if s, ok := severities[e.Vulnerability]; ok {
+				e.Severity = &s
+			}
+		}
+		events = append(events, e)
+		return nil
+	}
+	add := func(evidenceType string, predicate any, e Event) error {
+		return addWithIdentity(evidenceType, predicate, predicate, e)
+	}
+
+	for _, p := range preds {
+		for _, v := range p.CertifyScorecard {
+			if err := add(EvidenceCertifyScorecard, v, Event{Sources: sources(v.Source)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.IsDependency {
+			if err := add(EvidenceIsDependency, v, Event{Packages: purls(v.Pkg, v.DepPkg)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.IsOccurrence {
+			if err := add(EvidenceIsOccurrence, v, Event{Packages: purls(v.Pkg), Sources: sources(v.Src), Artifacts: digests(v.Artifact)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.HasSlsa {
+			if err := add(EvidenceHasSLSA, v, Event{Artifacts: digests(v.Artifact)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.CertifyVuln {
+			// a certifyVuln without a vulnerability records a scan that found
+			// nothing, which is not worth a notification
+			if v.Vulnerability == nil || strings.EqualFold(v.Vulnerability.Type, noVulnType) {
+				continue
+			}
+			// the scan metadata changes each time a certifier rescans the
+			// package, so it is not part of the identity of the event
+			identity := assembler.CertifyVulnIngest{Pkg: v.Pkg, Vulnerability: v.Vulnerability}
+			if err := addWithIdentity(EvidenceCertifyVuln, v, identity, Event{Packages: purls(v.Pkg), Vulnerability: vulnID(v.Vulnerability)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.VulnEqual {
+			if err := add(EvidenceVulnEqual, v, Event{Vulnerability: vulnID(v.Vulnerability)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.HasSourceAt {
+			if err := add(EvidenceHasSourceAt, v, Event{Packages: purls(v.Pkg), Sources: sources(v.Src)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.CertifyBad {
+			if err := add(EvidenceCertifyBad, v, Event{Packages: purls(v.Pkg), Sources: sources(v.Src), Artifacts: digests(v.Artifact)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.CertifyGood {
+			if err := add(EvidenceCertifyGood, v, Event{Packages: purls(v.Pkg), Sources: sources(v.Src), Artifacts: digests(v.Artifact)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.HasSBOM {
+			if err := add(EvidenceHasSBOM, v, Event{Packages: purls(v.Pkg), Artifacts: digests(v.Artifact)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.HashEqual {
+			if err := add(EvidenceHashEqual, v, Event{Artifacts: digests(v.Artifact, v.EqualArtifact)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.PkgEqual {
+			if err := add(EvidencePkgEqual, v, Event{Packages: purls(v.Pkg, v.EqualPkg)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.Vex {
+			if err := add(EvidenceVex, v, Event{Packages: purls(v.Pkg), Artifacts: digests(v.Artifact), Vulnerability: vulnID(v.Vulnerability)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.PointOfContact {
+			if err := add(EvidencePointOfContact, v, Event{Packages: purls(v.Pkg), Sources: sources(v.Src), Artifacts: digests(v.Artifact)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.VulnMetadata {
+			if err := add(EvidenceVulnMetadata, v, Event{Vulnerability: vulnID(v.Vulnerability)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.HasMetadata {
+			if err := add(EvidenceHasMetadata, v, Event{Packages: purls(v.Pkg), Sources: sources(v.Src), Artifacts: digests(v.Artifact)}); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range p.CertifyLegal {
+			if err := add(EvidenceCertifyLegal, v, Event{Packages: purls(v.Pkg), Sources: sources(v.Src)}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return events, nil
+}
+
+// vulnSeverities returns the highest CVSS score of each vulnerability in the
+// VulnMetadata of the predicates. EPSS and SSVC scores are not severities.
+func vulnSeverities(preds []assembler.IngestPredicates) map[string]float64 {
+	severities := map[string]float64{}
+	for _, p := range preds {
+		for _, m := range p.VulnMetadata {
+			if m.VulnMetadata == nil || !strings.HasPrefix(string(m.VulnMetadata.ScoreType), "CVSS") {
+				continue
+			}
+			id := vulnID(m.Vulnerability)
+			if s, ok := severities[id]; !ok || m.VulnMetadata.ScoreValue > s {
+				severities[id] = m.VulnMetadata.ScoreValue
+			}
+		}
+	}
+	return severities
+}
+
+// matches reports whether e matches all of the set fields of the filter. The
+// digest filter matches the predicates about the artifact and about the
+// packages artifactPackages resolves it to.
+func (s *subscription) matches(e *Event, artifactPackages func(digest string) map[string]bool) bool {
+	if len(s.evidenceTypes) > 0 && !s.evidenceTypes[e.EvidenceType] {
+		return false
+	}
+	if s.purl != nil && !anyMatch(e.Packages, s.purl.MatchString) {
+		return false
+	}
+	if s.digest != "" && !anyMatch(e.Artifacts, func(a string) bool { return a == s.digest }) {
+		if len(e.Packages) == 0 {
+			return false
+		}
+		pkgs := artifactPackages(s.digest)
+		if !anyMatch(e.Packages, func(p string) bool { return pkgs[p] }) {
+			return false
+		}
+	}
+	if s.minSeverity != nil && (e.Severity == nil || *e.Severity < *s.minSeverity) {
+		return false
+	}
+	return true
+}
+
+func anyMatch(values []string, match func(string) bool) bool {
+	for _, v := range values {
+		if match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func purls(pkgs ...*generated.PkgInputSpec) []string {
+	var out []string
+	for _, p := range pkgs {
+		if p != nil {
+			out = append(out, helpers.PkgInputSpecToPurl(p))
+		}
+	}
+	return out
+}
+
+func sources(srcs ...*generated.SourceInputSpec) []string {
+	var out []string
+	for _, s := range srcs {
+		if s == nil {
+			continue
+		}
+		uri := s.Type + "+" + s.Namespace + "/" + s.Name
+		if s.Tag != nil && *s.Tag != "" {
+			uri += "@" + *s.Tag
+		} else if s.Commit != nil && *s.Commit != "" {
+			uri += "@" + *s.Commit
+		}
+		out = append(out, uri)
+	}
+	return out
+}
+
+func digests(artifacts ...*generated.ArtifactInputSpec) []string {
+	var out []string
+	for _, a := range artifacts {
+		if a != nil {
+			out = append(out, helpers.ArtifactClientKey(a))
+		}
+	}
+	return out
+}
+
+func vulnID(v *generated.VulnerabilityInputSpec) string {
+	if v == nil {
+		return ""
+	}
+	return strings.ToLower(v.VulnerabilityID)
+}
@@ -0,0 +1,263 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+// AssemblerFunc is the signature of the functions that assemble ingested
+// predicates into the graph, such as the one returned by
+// ingestor.GetAssembler.
+type AssemblerFunc func([]assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error)
+
+// Notifier delivers the events of ingested predicates to the sinks of the
+// subscriptions they match.
+type Notifier struct {
+	subs  []*subscription
+	retry Retry
+	// delivered holds the "subscription/event ID" keys of the events that
+	// were delivered within the dedup window.
+	delivered *expirable.LRU[string, struct{}]
+	// sleep waits between delivery attempts, it is replaced in tests.
+	sleep func(context.Context, time.Duration) error
+	// resolvePackages resolves the artifacts of the digest filters to their
+	// packages, if set.
+	resolvePackages PackageResolver
+
+	// queue holds the events waiting for delivery, it is read by the workers.
+	queue   chan delivery
+	workers sync.WaitGroup
+	// pending counts the queued and in-flight deliveries.
+	pending   sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// delivery is an event queued for delivery to a subscription.
+type delivery struct {
+	ctx   context.Context
+	sub   *subscription
+	event Event
+	key   string
+}
+
+type subscription struct {
+	name          string
+	purl          *regexp.Regexp
+	digest        string
+	minSeverity   *float64
+	evidenceTypes map[string]bool
+	sink          sink
+}
+
+// New opens the sinks of the subscriptions of cfg. The returned notifier must
+// be closed to release them.
+func New(cfg *Config, opts ...Option) (*Notifier, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	c := cfg.withDefaults()
+	n := &Notifier{
+		retry:     c.Retry,
+		delivered: expirable.NewLRU[string, struct{}](c.DedupSize, nil, time.Duration(c.DedupWindow)),
+		sleep:     sleep,
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+	for _, s := range c.Subscriptions {
+		sub := &subscription{
+			name:   s.Name,
+			digest: strings.ToLower(s.Filter.Digest),
+		}
+		if s.Filter.Purl != "" {
+			sub.purl = globToRegexp(s.Filter.Purl)
+		}
+		// validated above
+		sub.minSeverity, _ = parseSeverity(s.Filter.MinSeverity)
+		if len(s.Filter.EvidenceTypes) > 0 {
+			sub.evidenceTypes = map[string]bool{}
+			for _, t := range s.Filter.EvidenceTypes {
+				sub.evidenceTypes[t] = true
+			}
+		}
+		var err error
+		sub.sink, err = openSink(s.Sink)
+		if err != nil {
+			_ = n.Close()
+			return nil, fmt.Errorf("subscription %q: %w", s.Name, err)
+		}
+		n.subs = append(n.subs, sub)
+	}
+	n.queue = make(chan delivery, c.QueueSize)
+	for range c.Workers {
+		n.workers.Add(1)
+		go n.work()
+	}
+	return n, nil
+}
+
+// Close waits for the queued events to be delivered and closes the sinks of
+// the notifier.
+func (n *Notifier) Close() error {
+	var errs []error
+	n.closeOnce.Do(func() {
+		if n.queue != nil {
+			close(n.queue)
+			n.workers.Wait()
+		}
+		for _, s := range n.subs {
+			if err := s.sink.close(); err != nil {
+				errs = append(errs, fmt.Errorf("subscription %q: %w", s.name, err))
+			}
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// Notify queues an event for each of the predicates that matches a
+// subscription, unless the event was already delivered to the subscription
+// within the dedup window. The events are delivered in the background and
+// failed deliveries are retried; the events that do not fit in the queue are
+// dropped and returned as an error. Notify must not be called after Close.
+func (n *Notifier) Notify(ctx context.Context, src processor.SourceInformation, preds []assembler.IngestPredicates) error {
+	events, err := eventsFromPredicates(preds, src, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	// the packages of the artifacts are resolved once per call, as they
+	// change when new SBOMs are ingested
+	resolved := map[string]map[string]bool{}
+	artifactPackages := func(digest string) map[string]bool {
+		if pkgs, ok := resolved[digest]; ok || n.resolvePackages == nil {
+			return pkgs
+		}
+		purls, err := n.resolvePackages(ctx, digest)
+		if err != nil {
+			logging.FromContext(ctx).Warnf("failed to resolve the packages of %s: %v", digest, err)
+		}
+		pkgs := map[string]bool{}
+		for _, p := range purls {
+			pkgs[p] = true
+		}
+		resolved[digest] = pkgs
+		return pkgs
+	}
+	var errs []error
+	for _, s := range n.subs {
+		for _, e := range events {
+			if !s.matches(&e, artifactPackages) {
+				continue
+			}
+			key := s.name + "/" + e.ID
+			if n.delivered.Contains(key) {
+				continue
+			}
+			e.Subscription = s.name
+			// the key is added when queueing so that the event is not queued
+			// twice, it is removed again if the delivery fails
+			n.delivered.Add(key, struct{}{})
+			n.pending.Add(1)
+			select {
+			case n.queue <- delivery{ctx: context.WithoutCancel(ctx), sub: s, event: e, key: key}:
+			default:
+				n.pending.Done()
+				n.delivered.Remove(key)
+				errs = append(errs, fmt.Errorf("subscription %q: event %s: notification queue is full, event dropped", s.name, e.ID))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n *Notifier) work() {
+	defer n.workers.Done()
+	for d := range n.queue {
+		if err := n.deliver(d.ctx, d.sub, &d.event); err != nil {
+			n.delivered.Remove(d.key)
+			logging.FromContext(d.ctx).Errorf("failed to deliver event %s to subscription %q: %v", d.event.ID, d.sub.name, err)
+		}
+		n.pending.Done()
+	}
+}
+
+// flush waits for the queued events to be delivered.
+func (n *Notifier) flush() {
+	n.pending.Wait()
+}
+
+// WrapAssembler returns an assembler that notifies about the predicates of
+// the document described by src once assemblerFunc ingested them. Notification
+// errors are logged and do not fail the ingestion. A nil notifier returns
+// assemblerFunc unchanged.
+func (n *Notifier) WrapAssembler(ctx context.Context, src processor.SourceInformation, assemblerFunc AssemblerFunc) AssemblerFunc {
+	if n == nil {
+		return assemblerFunc
+	}
+	return func(preds []assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error) {
+		ids, err := assemblerFunc(preds)
+		if err != nil {
+			return ids, err
+		}
+		if err := n.Notify(ctx, src, preds); err != nil {
+			logging.FromContext(ctx).Errorf("failed to send notifications for %q: %v", src.Source, err)
+		}
+		return ids, nil
+	}
+}
+
+func (n *Notifier) deliver(ctx context.Context, s *subscription, e *Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	backoff := time.Duration(n.retry.InitialBackoff)
+	for attempt := 1; ; attempt++ {
+		err = s.sink.send(ctx, e, data)
+		if err == nil || isPermanent(err) || attempt >= n.retry.MaxAttempts {
+			return err
+		}
+		logging.FromContext(ctx).Debugf("delivery of event %s to subscription %q failed, retrying in %v: %v", e.ID, s.name, backoff, err)
+		if err := n.sleep(ctx, backoff); err != nil {
+			return err
+		}
+		backoff = min(2*backoff, time.Duration(n.retry.MaxBackoff))
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,507 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	nats_test "github.com/guacsec/guac/internal/testing/nats"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	gql_server "github.com/guacsec/guac/pkg/assembler/server"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/nats-io/nats.go"
+)
+
+var (
+	testSource = processor.SourceInformation{Collector: "FileCollector", Source: "file:///sboms/app.json"}
+
+	npmPkg   = &generated.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("@acme"), Name: "left-pad", Version: ptrfrom.String("1.0.0")}
+	pypiPkg  = &generated.PkgInputSpec{Type: "pypi", Name: "requests", Version: ptrfrom.String("2.0.0")}
+	artifact = &generated.ArtifactInputSpec{Algorithm: "sha256", Digest: "ABC123"}
+
+	criticalVuln = &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2024-1"}
+	lowVuln      = &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "CVE-2024-2"}
+)
+
+func testPredicates(timeScanned time.Time) []assembler.IngestPredicates {
+	return []assembler.IngestPredicates{{
+		CertifyVuln: []assembler.CertifyVulnIngest{
+			{Pkg: npmPkg, Vulnerability: criticalVuln, VulnData: &generated.ScanMetadataInput{TimeScanned: timeScanned}},
+			{Pkg: npmPkg, Vulnerability: lowVuln, VulnData: &generated.ScanMetadataInput{TimeScanned: timeScanned}},
+			{Pkg: pypiPkg, Vulnerability: criticalVuln, VulnData: &generated.ScanMetadataInput{TimeScanned: timeScanned}},
+			{Pkg: pypiPkg, Vulnerability: &generated.VulnerabilityInputSpec{Type: "novuln"}, VulnData: &generated.ScanMetadataInput{TimeScanned: timeScanned}},
+		},
+		VulnMetadata: []assembler.VulnMetadataIngest{
+			{Vulnerability: criticalVuln, VulnMetadata: &generated.VulnerabilityMetadataInputSpec{ScoreType: generated.VulnerabilityScoreTypeCvssv3, ScoreValue: 9.8}},
+			{Vulnerability: criticalVuln, VulnMetadata: &generated.VulnerabilityMetadataInputSpec{ScoreType: generated.VulnerabilityScoreTypeEpssv2, ScoreValue: 10}},
+			{Vulnerability: lowVuln, VulnMetadata: &generated.VulnerabilityMetadataInputSpec{ScoreType: generated.VulnerabilityScoreTypeCvssv3, ScoreValue: 2.5}},
+		},
+		HasSBOM: []assembler.HasSBOMIngest{
+			{Artifact: artifact, HasSBOM: &generated.HasSBOMInputSpec{Uri: "file:///sboms/app.json"}},
+		},
+	}}
+}
+
+// summary identifies an event in the assertions.
+type summary struct {
+	Subscription, EvidenceType, Subject, Vulnerability string
+}
+
+func summarize(events []Event) []summary {
+	var out []summary
+	for _, e := range events {
+		subject := strings.Join(append(append(e.Packages, e.Sources...), e.Artifacts...), ",")
+		out = append(out, summary{e.Subscription, e.EvidenceType, subject, e.Vulnerability})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Subscription != b.Subscription {
+			return a.Subscription < b.Subscription
+		}
+		if a.EvidenceType != b.EvidenceType {
+			return a.EvidenceType < b.EvidenceType
+		}
+		if a.Subject != b.Subject {
+			return a.Subject < b.Subject
+		}
+		return a.Vulnerability < b.Vulnerability
+	})
+	return out
+}
+
+func readEvents(t *testing.T, path string) []Event {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open events file: %v", err)
+	}
+	defer f.Close()
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("failed to decode event %q: %v", scanner.Text(), err)
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+func newTestNotifier(t *testing.T, cfg *Config) *Notifier {
+	t.Helper()
+	n, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() returned unexpected error: %v", err)
+	}
+	n.sleep = func(context.Context, time.Duration) error { return nil }
+	t.Cleanup(func() { _ = n.Close() })
+	return n
+}
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse([]byte(`
+dedupWindow: 1h
+retry:
+  maxAttempts: 3
+  initialBackoff: 500ms
+subscriptions:
+  - name: vulns
+    filter:
+      purl: "pkg:npm/*"
+      minSeverity: high
+      evidenceTypes: [certifyVuln, vex]
+    sink:
+      type: webhook
+      url: https://example.com/hook
+`))
+	if err != nil {
+		t.Fatalf("Parse() returned unexpected error: %v", err)
+	}
+	if cfg.DedupWindow != Duration(time.Hour) || cfg.Retry.InitialBackoff != Duration(500*time.Millisecond) {
+		t.Errorf("unexpected durations: %v, %v", cfg.DedupWindow, cfg.Retry.InitialBackoff)
+	}
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:    "no subscriptions",
+			config:  `subscriptions: []`,
+			wantErr: "no subscriptions",
+		},
+		{
+			name:    "unknown field",
+			config:  "subscriptions:\n  - name: a\n    filter: {severity: high}\n    sink: {type: file, path: /tmp/x}",
+			wantErr: "field severity not found",
+		},
+		{
+			name:    "duplicate name",
+			config:  "subscriptions:\n  - {name: a, sink: {type: file, path: /tmp/x}}\n  - {name: a, sink: {type: file, path: /tmp/y}}",
+			wantErr: "used more than once",
+		},
+		{
+			name:    "bad severity",
+			config:  "subscriptions:\n  - {name: a, filter: {minSeverity: severe}, sink: {type: file, path: /tmp/x}}",
+			wantErr: "minSeverity",
+		},
+		{
+			name:    "unknown evidence type",
+			config:  "subscriptions:\n  - {name: a, filter: {evidenceTypes: [certifyVEX]}, sink: {type: file, path: /tmp/x}}",
+			wantErr: `unknown evidence type "certifyVEX"`,
+		},
+		{
+			name:    "webhook without url",
+			config:  "subscriptions:\n  - {name: a, sink: {type: webhook}}",
+			wantErr: "webhook url",
+		},
+		{
+			name:    "nats without subject",
+			config:  "subscriptions:\n  - {name: a, sink: {type: nats, url: 'nats://127.0.0.1:4222'}}",
+			wantErr: "requires url and subject",
+		},
+		{
+			name:    "unknown sink",
+			config:  "subscriptions:\n  - {name: a, sink: {type: email}}",
+			wantErr: `unknown sink type "email"`,
+		},
+		{
+			name:    "bad duration",
+			config:  "dedupWindow: 1 day\nsubscriptions:\n  - {name: a, sink: {type: file, path: /tmp/x}}",
+			wantErr: "invalid duration",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse([]byte(test.config))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("Parse() error = %v, want it to contain %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestNotify_Filters(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	fileSink := Sink{Type: SinkFile, Path: path}
+	n := newTestNotifier(t, &Config{Subscriptions: []Subscription{
+		{Name: "npm-high", Filter: Filter{Purl: "pkg:npm/*", MinSeverity: "high"}, Sink: fileSink},
+		{Name: "image", Filter: Filter{Digest: "sha256:abc123"}, Sink: fileSink},
+		{Name: "severity", Filter: Filter{MinSeverity: "9", EvidenceTypes: []string{EvidenceCertifyVuln}}, Sink: fileSink},
+	}})
+
+	if err := n.Notify(ctx, testSource, testPredicates(time.Now())); err != nil {
+		t.Fatalf("Notify() returned unexpected error: %v", err)
+	}
+	n.flush()
+
+	events := readEvents(t, path)
+	want := []summary{
+		{"image", EvidenceHasSBOM, "sha256:abc123", ""},
+		{"npm-high", EvidenceCertifyVuln, "pkg:npm/%40acme/left-pad@1.0.0", "cve-2024-1"},
+		{"severity", EvidenceCertifyVuln, "pkg:npm/%40acme/left-pad@1.0.0", "cve-2024-1"},
+		{"severity", EvidenceCertifyVuln, "pkg:pypi/requests@2.0.0", "cve-2024-1"},
+	}
+	if diff := cmp.Diff(want, summarize(events)); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+	for _, e := range events {
+		if e.Source != testSource.Source || e.Collector != testSource.Collector {
+			t.Errorf("event %s has source %q from %q", e.ID, e.Source, e.Collector)
+		}
+		if e.EvidenceType == EvidenceCertifyVuln && (e.Severity == nil || *e.Severity != 9.8) {
+			t.Errorf("event %s has severity %v, want 9.8", e.ID, e.Severity)
+		}
+	}
+}
+
+func TestNotify_ArtifactPackages(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	backend, err := backends.Get("keyvalue", ctx, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("failed to get backend: %v", err)
+	}
+	srv := httptest.NewServer(gql_server.GetGraphqlServer(ctx, backend, gql_server.Options{Limits: gql_server.DefaultLimits()}))
+	defer srv.Close()
+	gqlclient := graphql.NewClient(srv.URL, http.DefaultClient)
+
+	// the tracked image was ingested earlier with an SBOM including the npm
+	// package
+	image := &generated.ArtifactInputSpec{Algorithm: "sha256", Digest: "def456"}
+	appPkg := &generated.PkgInputSpec{Type: "npm", Name: "app", Version: ptrfrom.String("2.0.0")}
+	_, err = helpers.GetBulkAssembler(ctx, logging.FromContext(ctx), gqlclient)([]assembler.IngestPredicates{{
+		IsDependency: []assembler.IsDependencyIngest{
+			{Pkg: appPkg, DepPkg: npmPkg, IsDependency: &generated.IsDependencyInputSpec{DependencyType: generated.DependencyTypeDirect}},
+		},
+		HasSBOM: []assembler.HasSBOMIngest{
+			{Artifact: image, HasSBOM: &generated.HasSBOMInputSpec{Uri: "oci://registry/app", KnownSince: time.Now()}},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("failed to ingest the image SBOM: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	n, err := New(&Config{Subscriptions: []Subscription{
+		{Name: "image", Filter: Filter{Digest: "sha256:DEF456", EvidenceTypes: []string{EvidenceCertifyVuln}}, Sink: Sink{Type: SinkFile, Path: path}},
+		{Name: "other-image", Filter: Filter{Digest: "sha256:0ff"}, Sink: Sink{Type: SinkFile, Path: path}},
+	}}, WithPackageResolver(GraphPackageResolver(gqlclient)))
+	if err != nil {
+		t.Fatalf("New() returned unexpected error: %v", err)
+	}
+	defer n.Close()
+
+	// the new certifyVulns of the npm package concern the image
+	if err := n.Notify(ctx, testSource, testPredicates(time.Now())); err != nil {
+		t.Fatalf("Notify() returned unexpected error: %v", err)
+	}
+	n.flush()
+	want := []summary{
+		{"image", EvidenceCertifyVuln, "pkg:npm/%40acme/left-pad@1.0.0", "cve-2024-1"},
+		{"image", EvidenceCertifyVuln, "pkg:npm/%40acme/left-pad@1.0.0", "cve-2024-2"},
+	}
+	if diff := cmp.Diff(want, summarize(readEvents(t, path))); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+}
+
+func TestNotify_Dedup(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	n := newTestNotifier(t, &Config{Subscriptions: []Subscription{
+		{Name: "vulns", Filter: Filter{EvidenceTypes: []string{EvidenceCertifyVuln}}, Sink: Sink{Type: SinkFile, Path: path}},
+	}})
+
+	// a rescan of the same packages only changes the scan metadata
+	for _, scanned := range []time.Time{time.Now(), time.Now().Add(time.Hour)} {
+		if err := n.Notify(ctx, testSource, testPredicates(scanned)); err != nil {
+			t.Fatalf("Notify() returned unexpected error: %v", err)
+		}
+		n.flush()
+	}
+	if got := len(readEvents(t, path)); got != 3 {
+		t.Errorf("got %d events, want 3", got)
+	}
+}
+
+func TestNotify_WebhookRetry(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	var attempts, rejected atomic.Int32
+	var gotEvent Event
+	var gotAuth, gotEventID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/reject"):
+			rejected.Add(1)
+			w.WriteHeader(http.StatusBadRequest)
+		case attempts.Add(1) < 3:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			gotAuth = r.Header.Get("Authorization")
+			gotEventID = r.Header.Get("X-Guac-Event-Id")
+			if err := json.NewDecoder(r.Body).Decode(&gotEvent); err != nil {
+				t.Errorf("failed to decode webhook body: %v", err)
+			}
+		}
+	}))
+	defer srv.Close()
+
+	filter := Filter{Digest: "sha256:abc123"}
+	n := newTestNotifier(t, &Config{Subscriptions: []Subscription{{
+		Name:   "hook",
+		Filter: filter,
+		Sink:   Sink{Type: SinkWebhook, URL: srv.URL + "/hook", Headers: map[string]string{"Authorization": "Bearer token"}},
+	}}})
+	if err := n.Notify(ctx, testSource, testPredicates(time.Now())); err != nil {
+		t.Fatalf("Notify() returned unexpected error: %v", err)
+	}
+	n.flush()
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("webhook got %d attempts, want 3", got)
+	}
+	if gotAuth != "Bearer token" || gotEventID != gotEvent.ID || gotEvent.EvidenceType != EvidenceHasSBOM {
+		t.Errorf("unexpected request: Authorization %q, X-Guac-Event-Id %q, event %+v", gotAuth, gotEventID, gotEvent)
+	}
+
+	// client errors are not retried, and the event is delivered again on the
+	// next ingestion since it was not delivered
+	reject := newTestNotifier(t, &Config{
+		Retry: Retry{MaxAttempts: 2},
+		Subscriptions: []Subscription{{
+			Name:   "reject",
+			Filter: filter,
+			Sink:   Sink{Type: SinkWebhook, URL: srv.URL + "/reject"},
+		}},
+	})
+	for i := 0; i < 2; i++ {
+		if err := reject.Notify(ctx, testSource, testPredicates(time.Now())); err != nil {
+			t.Fatalf("Notify() returned unexpected error: %v", err)
+		}
+		reject.flush()
+	}
+	if got := rejected.Load(); got != 2 {
+		t.Errorf("webhook got %d rejected requests, want 2", got)
+	}
+
+	// server errors are retried up to MaxAttempts
+	attempts.Store(-10)
+	unavailable := newTestNotifier(t, &Config{
+		Retry:         Retry{MaxAttempts: 2},
+		Subscriptions: []Subscription{{Name: "unavailable", Filter: filter, Sink: Sink{Type: SinkWebhook, URL: srv.URL + "/hook"}}},
+	})
+	if err := unavailable.Notify(ctx, testSource, testPredicates(time.Now())); err != nil {
+		t.Fatalf("Notify() returned unexpected error: %v", err)
+	}
+	unavailable.flush()
+	if got := attempts.Load(); got != -8 {
+		t.Errorf("webhook got %d attempts, want 2", got+10)
+	}
+}
+
+func TestNotify_QueueFull(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	release := make(chan struct{})
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+	}))
+	defer srv.Close()
+
+	// the worker blocks on the first event and the queue holds the second one
+	n := newTestNotifier(t, &Config{
+		QueueSize: 1,
+		Workers:   1,
+		Subscriptions: []Subscription{{
+			Name:   "slow",
+			Filter: Filter{EvidenceTypes: []string{EvidenceCertifyVuln}},
+			Sink:   Sink{Type: SinkWebhook, URL: srv.URL},
+		}},
+	})
+	err := n.Notify(ctx, testSource, testPredicates(time.Now()))
+	if err == nil || !strings.Contains(err.Error(), "queue is full") {
+		t.Errorf("Notify() error = %v, want a queue is full error", err)
+	}
+	close(release)
+	n.flush()
+	if got := requests.Load(); got < 1 || got > 2 {
+		t.Errorf("webhook got %d requests, want 1 or 2", got)
+	}
+}
+
+func TestNotify_NATS(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	natsTest := nats_test.NewNatsTestServer()
+	url, err := natsTest.EnableJetStreamForTest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer natsTest.Shutdown()
+
+	nc, err := nats.Connect(url)
+	if err != nil {
+		t.Fatalf("failed to connect to NATS: %v", err)
+	}
+	defer nc.Close()
+	sub, err := nc.SubscribeSync("guac.notifications")
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+
+	n := newTestNotifier(t, &Config{Subscriptions: []Subscription{{
+		Name:   "nats",
+		Filter: Filter{Purl: "pkg:pypi/requests@*", EvidenceTypes: []string{EvidenceCertifyVuln}},
+		Sink:   Sink{Type: SinkNATS, URL: url, Subject: "guac.notifications"},
+	}}})
+	if err := n.Notify(ctx, testSource, testPredicates(time.Now())); err != nil {
+		t.Fatalf("Notify() returned unexpected error: %v", err)
+	}
+
+	msg, err := sub.NextMsg(5 * time.Second)
+	if err != nil {
+		t.Fatalf("failed to receive event: %v", err)
+	}
+	var e Event
+	if err := json.Unmarshal(msg.Data, &e); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	want := []summary{{"nats", EvidenceCertifyVuln, "pkg:pypi/requests@2.0.0", "cve-2024-1"}}
+	if diff := cmp.Diff(want, summarize([]Event{e})); diff != "" {
+		t.Errorf("unexpected event (-want +got):\n%s", diff)
+	}
+	if _, err := sub.NextMsg(100 * time.Millisecond); !errors.Is(err, nats.ErrTimeout) {
+		t.Errorf("got an unexpected second message: %v", err)
+	}
+}
+
+func TestWrapAssembler(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	n := newTestNotifier(t, &Config{Subscriptions: []Subscription{
+		{Name: "all", Filter: Filter{EvidenceTypes: []string{EvidenceHasSBOM}}, Sink: Sink{Type: SinkFile, Path: path}},
+	}})
+
+	assembleErr := errors.New("graphql unavailable")
+	failing := n.WrapAssembler(ctx, testSource, func([]assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error) {
+		return nil, assembleErr
+	})
+	if _, err := failing(testPredicates(time.Now())); !errors.Is(err, assembleErr) {
+		t.Errorf("wrapped assembler error = %v, want %v", err, assembleErr)
+	}
+	if got := len(readEvents(t, path)); got != 0 {
+		t.Errorf("got %d events after a failed ingestion, want 0", got)
+	}
+
+	ok := n.WrapAssembler(ctx, testSource, func([]assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error) {
+		return &helpers.AssemblerIngestedIDs{}, nil
+	})
+	if _, err := ok(testPredicates(time.Now())); err != nil {
+		t.Errorf("wrapped assembler returned unexpected error: %v", err)
+	}
+	n.flush()
+	if got := len(readEvents(t, path)); got != 1 {
+		t.Errorf("got %d events, want 1", got)
+	}
+
+	var nilNotifier *Notifier
+	if _, err := nilNotifier.WrapAssembler(ctx, testSource, failing)(nil); !errors.Is(err, assembleErr) {
+		t.Errorf("nil notifier did not return the assembler unchanged")
+	}
+}
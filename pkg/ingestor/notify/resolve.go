@@ -0,0 +1,79 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// PackageResolver returns the purls of the packages used by the artifact with
+// the "algorithm:digest" digest, so that the events about those packages,
+// such as a new certifyVuln, match the subscriptions filtering on the
+// artifact.
+type PackageResolver func(ctx context.Context, digest string) ([]string, error)
+
+// Option configures a Notifier.
+type Option func(*Notifier)
+
+// WithPackageResolver resolves the artifacts of the digest filters to their
+// packages. Without it, a digest filter only matches the predicates about the
+// artifact itself.
+func WithPackageResolver(r PackageResolver) Option {
+	return func(n *Notifier) {
+		n.resolvePackages = r
+	}
+}
+
+// GraphPackageResolver resolves the packages of an artifact from the graph:
+// the packages it is an occurrence of and the packages included in its SBOMs.
+func GraphPackageResolver(client graphql.Client) PackageResolver {
+	return func(ctx context.Context, digest string) ([]string, error) {
+		algorithm, value, ok := strings.Cut(digest, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid artifact digest %q", digest)
+		}
+		artifact := &generated.ArtifactSpec{Algorithm: &algorithm, Digest: &value}
+
+		var purls []string
+		occurrences, err := generated.Occurrences(ctx, client, generated.IsOccurrenceSpec{Artifact: artifact})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the occurrences of %s: %w", digest, err)
+		}
+		for _, o := range occurrences.IsOccurrence {
+			if p, ok := o.Subject.(*generated.AllIsOccurrencesTreeSubjectPackage); ok {
+				purls = append(purls, helpers.AllPkgTreeToPurl(&p.AllPkgTree))
+			}
+		}
+		sboms, err := generated.HasSBOMs(ctx, client, generated.HasSBOMSpec{Subject: &generated.PackageOrArtifactSpec{Artifact: artifact}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the SBOMs of %s: %w", digest, err)
+		}
+		for _, s := range sboms.HasSBOM {
+			for _, software := range s.IncludedSoftware {
+				if p, ok := software.(*generated.AllHasSBOMTreeIncludedSoftwarePackage); ok {
+					purls = append(purls, helpers.AllPkgTreeToPurl(&p.AllPkgTree))
+				}
+			}
+		}
+		return purls, nil
+	}
+}
@@ -0,0 +1,162 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	webhookTimeout = 30 * time.Second
+	natsTimeout    = 10 * time.Second
+)
+
+// sink delivers encoded events to a destination.
+type sink interface {
+	// send delivers an event. Errors wrapped in a permanentError are not
+	// retried.
+	send(ctx context.Context, e *Event, data []byte) error
+	close() error
+}
+
+// permanentError is a delivery error that retrying will not fix, such as a
+// webhook rejecting the request.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+func openSink(s Sink) (sink, error) {
+	switch s.Type {
+	case SinkWebhook:
+		return &webhookSink{
+			url:     s.URL,
+			headers: s.Headers,
+			client:  &http.Client{Timeout: webhookTimeout},
+		}, nil
+	case SinkNATS:
+		var opts []nats.Option
+		if s.CredsFile != "" {
+			opts = append(opts, nats.UserCredentials(s.CredsFile))
+		}
+		nc, err := nats.Connect(s.URL, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to NATS at %s: %w", s.URL, err)
+		}
+		return &natsSink{conn: nc, subject: s.Subject}, nil
+	case SinkFile:
+		f, err := os.OpenFile(s.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open notification file: %w", err)
+		}
+		return &fileSink{file: f}, nil
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", s.Type)
+	}
+}
+
+type webhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (w *webhookSink) send(ctx context.Context, e *Event, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return &permanentError{fmt.Errorf("failed to create webhook request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Guac-Event-Id", e.ID)
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("webhook returned status %s", resp.Status)
+	default:
+		return &permanentError{fmt.Errorf("webhook returned status %s", resp.Status)}
+	}
+}
+
+func (w *webhookSink) close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
+
+type natsSink struct {
+	conn    *nats.Conn
+	subject string
+}
+
+func (n *natsSink) send(_ context.Context, _ *Event, data []byte) error {
+	if err := n.conn.Publish(n.subject, data); err != nil {
+		return fmt.Errorf("failed to publish to NATS subject %s: %w", n.subject, err)
+	}
+	if err := n.conn.FlushTimeout(natsTimeout); err != nil {
+		return fmt.Errorf("failed to flush NATS connection: %w", err)
+	}
+	return nil
+}
+
+func (n *natsSink) close() error {
+	return n.conn.Drain()
+}
+
+type fileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func (f *fileSink) send(_ context.Context, _ *Event, data []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write to notification file: %w", err)
+	}
+	return nil
+}
+
+func (f *fileSink) close() error {
+	return f.file.Close()
+}
+
+func isPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
@@ -0,0 +1,66 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"github.com/guacsec/guac/pkg/assembler"
+)
+
+// Chunker collects the predicates of a document parsed as a stream, and
+// emits them in chunks of about the same size.
+type Chunker struct {
+	// Predicates and Identifiers are the current chunk, that parsers append
+	// to before calling Added.
+	Predicates  *assembler.IngestPredicates
+	Identifiers *IdentifierStrings
+
+	size  int
+	count int
+	emit  EmitFunc
+}
+
+// NewChunker returns a Chunker that emits chunks of size predicates.
+func NewChunker(size int, emit EmitFunc) *Chunker {
+	return &Chunker{
+		Predicates:  &assembler.IngestPredicates{},
+		Identifiers: &IdentifierStrings{},
+		size:        max(size, 1),
+		emit:        emit,
+	}
+}
+
+// Added records that n predicates were added to the current chunk, and emits
+// the chunk once it is full.
+func (c *Chunker) Added(n int) error {
+	c.count += n
+	if c.count < c.size {
+		return nil
+	}
+	return c.Flush()
+}
+
+// Flush emits the current chunk, unless it is empty, and starts a new one.
+func (c *Chunker) Flush() error {
+	if c.count == 0 && len(c.Identifiers.PurlStrings) == 0 {
+		return nil
+	}
+	RemoveDuplicateIdentifiers(c.Identifiers)
+	preds, ids := c.Predicates, c.Identifiers
+	c.Predicates = &assembler.IngestPredicates{}
+	c.Identifiers = &IdentifierStrings{}
+	c.count = 0
+	return c.emit(preds, ids)
+}
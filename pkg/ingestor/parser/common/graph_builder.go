@@ -43,6 +43,8 @@ func (b *GraphBuilder) CreateAssemblerInput(ctx context.Context, foundIdentities
 	if predicates == nil {
 		predicates = &assembler.IngestPredicates{}
 	}
+	predicates.HasSourceAt = append(predicates.HasSourceAt, CreateSourceRevisionHasSourceAt(predicates, srcInfo)...)
+	AddSubjectHasSBOM(predicates, srcInfo)
 	AddMetadata(predicates, foundIdentities, srcInfo)
 
 	return predicates
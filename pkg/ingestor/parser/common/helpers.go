@@ -19,10 +19,12 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/guacsec/guac/pkg/assembler"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
 
@@ -118,6 +120,123 @@ func createTopLevelHasSBOM(blob []byte, uri string, source string, timestamp tim
 	}
 }
 
+// CreateSourceRevisionHasSourceAt links the packages the SBOMs are about to the
+// source repository revision the SBOM document was collected from, if the
+// collector recorded the commit of the revision. SBOMs about an artifact are
+// linked through the packages that occur as the artifact. A source names
+// either a commit or a tag, so the source is the commit and the tag of the
+// revision, if any, is recorded in the justification.
+func CreateSourceRevisionHasSourceAt(predicates *assembler.IngestPredicates, srcInfo processor.SourceInformation) []assembler.HasSourceAtIngest {
+	if srcInfo.Commit == "" {
+		return nil
+	}
+	src, err := helpers.VcsToSrc(srcInfo.Source)
+	if err != nil {
+		return nil
+	}
+	commit := srcInfo.Commit
+	src.Commit = &commit
+	src.Tag = nil
+	justification := "sbom collected from source repository revision"
+	if srcInfo.Tag != "" {
+		justification += " tagged " + srcInfo.Tag
+	}
+
+	var hasSourceAts []assembler.HasSourceAtIngest
+	linked := map[*model.PkgInputSpec]bool{}
+	link := func(pkg *model.PkgInputSpec, knownSince time.Time) {
+		if linked[pkg] {
+			return
+		}
+		linked[pkg] = true
+		hasSourceAts = append(hasSourceAts, assembler.HasSourceAtIngest{
+			Pkg:          pkg,
+			PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+			Src:          src,
+			HasSourceAt: &model.HasSourceAtInputSpec{
+				KnownSince:    knownSince,
+				Justification: justification,
+			},
+		})
+	}
+	for _, hasSBOM := range predicates.HasSBOM {
+		if hasSBOM.HasSBOM == nil {
+			continue
+		}
+		if hasSBOM.Pkg != nil {
+			link(hasSBOM.Pkg, hasSBOM.HasSBOM.KnownSince)
+		}
+		if hasSBOM.Artifact != nil {
+			for _, isOcc := range predicates.IsOccurrence {
+				if isOcc.Pkg != nil && isOcc.Artifact != nil && *isOcc.Artifact == *hasSBOM.Artifact {
+					link(isOcc.Pkg, hasSBOM.HasSBOM.KnownSince)
+				}
+			}
+		}
+	}
+	return hasSourceAts
+}
+
+// AddSubjectHasSBOM records that the SBOM document is about the artifact it was
+// found attached to, if the collector recorded the digest of the artifact and
+// the SBOM is not about that artifact already. The top level packages of the
+// SBOM are recorded to occur as the artifact.
+func AddSubjectHasSBOM(predicates *assembler.IngestPredicates, srcInfo processor.SourceInformation) {
+	algorithm, digest, ok := strings.Cut(srcInfo.Subject, ":")
+	if !ok || algorithm == "" || digest == "" || len(predicates.HasSBOM) == 0 {
+		return
+	}
+	subject := &model.ArtifactInputSpec{
+		Algorithm: strings.ToLower(algorithm),
+		Digest:    strings.ToLower(digest),
+	}
+
+	var topLevel *assembler.HasSBOMIngest
+	for i, hasSBOM := range predicates.HasSBOM {
+		if hasSBOM.HasSBOM == nil {
+			continue
+		}
+		if hasSBOM.Artifact != nil && *hasSBOM.Artifact == *subject {
+			return
+		}
+		if topLevel == nil {
+			topLevel = &predicates.HasSBOM[i]
+		}
+	}
+	if topLevel == nil {
+		return
+	}
+
+	hasSBOM := *topLevel.HasSBOM
+	predicates.HasSBOM = append(predicates.HasSBOM, assembler.HasSBOMIngest{
+		Artifact: subject,
+		HasSBOM:  &hasSBOM,
+		Includes: topLevel.Includes,
+	})
+	if topLevel.Pkg != nil {
+		predicates.IsOccurrence = append(predicates.IsOccurrence, assembler.IsOccurrenceIngest{
+			Pkg:      topLevel.Pkg,
+			Artifact: subject,
+			IsOccurrence: &model.IsOccurrenceInputSpec{
+				Justification: "sbom attached to artifact",
+			},
+		})
+	}
+}
+
+// PurlsToPkgs converts the purls to package input specs.
+func PurlsToPkgs(purls []string) ([]*model.PkgInputSpec, error) {
+	pkgs := make([]*model.PkgInputSpec, 0, len(purls))
+	for _, purl := range purls {
+		pkg, err := helpers.PurlToPkg(purl)
+		if err != nil {
+			return nil, err
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	return pkgs, nil
+}
+
 func RemoveDuplicateIdentifiers(identifierStrings *IdentifierStrings) {
 	if len(identifierStrings.PurlStrings) > 0 {
 		identifierStrings.PurlStrings = removeDuplicate(identifierStrings.PurlStrings)
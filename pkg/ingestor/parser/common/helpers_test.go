@@ -0,0 +1,165 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func TestCreateSourceRevisionHasSourceAt(t *testing.T) {
+	commit := "a8e4b7b9d9f3c2e1f0a1b2c3d4e5f60718293a4b"
+	tag := "v1.0.0"
+	knownSince := time.Unix(1000, 0)
+	pkg := &model.PkgInputSpec{Type: "golang", Namespace: ptr("github.com/guacsec"), Name: "guac", Version: ptr("v1.0.0")}
+	imagePkg := &model.PkgInputSpec{Type: "oci", Name: "guac", Version: ptr("sha256:abc")}
+	otherPkg := &model.PkgInputSpec{Type: "oci", Name: "other", Version: ptr("sha256:def")}
+	predicates := &assembler.IngestPredicates{
+		HasSBOM: []assembler.HasSBOMIngest{
+			{Pkg: pkg, HasSBOM: &model.HasSBOMInputSpec{KnownSince: knownSince}},
+			{Artifact: &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abc"}, HasSBOM: &model.HasSBOMInputSpec{KnownSince: knownSince}},
+		},
+		IsOccurrence: []assembler.IsOccurrenceIngest{
+			{Pkg: imagePkg, Artifact: &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abc"}},
+			{Pkg: otherPkg, Artifact: &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "def"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		srcInfo processor.SourceInformation
+		want    []assembler.HasSourceAtIngest
+	}{{
+		name: "collected from a tagged revision",
+		srcInfo: processor.SourceInformation{
+			Source: "git+https://github.com/guacsec/guac@" + commit + "#sbom/guac.spdx.json",
+			Commit: commit,
+			Tag:    tag,
+		},
+		want: []assembler.HasSourceAtIngest{
+			hasSourceAt(pkg, commit, "sbom collected from source repository revision tagged v1.0.0", knownSince),
+			hasSourceAt(imagePkg, commit, "sbom collected from source repository revision tagged v1.0.0", knownSince),
+		},
+	}, {
+		name: "collected from an untagged revision",
+		srcInfo: processor.SourceInformation{
+			Source: "git+https://github.com/guacsec/guac@" + commit + "#sbom/guac.spdx.json",
+			Commit: commit,
+		},
+		want: []assembler.HasSourceAtIngest{
+			hasSourceAt(pkg, commit, "sbom collected from source repository revision", knownSince),
+			hasSourceAt(imagePkg, commit, "sbom collected from source repository revision", knownSince),
+		},
+	}, {
+		name:    "not collected from a repository",
+		srcInfo: processor.SourceInformation{Source: "file:///sbom/guac.spdx.json"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CreateSourceRevisionHasSourceAt(predicates, tt.srcInfo)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func hasSourceAt(pkg *model.PkgInputSpec, commit, justification string, knownSince time.Time) assembler.HasSourceAtIngest {
+	return assembler.HasSourceAtIngest{
+		Pkg:          pkg,
+		PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+		Src: &model.SourceInputSpec{
+			Type:      "git",
+			Namespace: "github.com/guacsec",
+			Name:      "guac",
+			Commit:    &commit,
+		},
+		HasSourceAt: &model.HasSourceAtInputSpec{
+			KnownSince:    knownSince,
+			Justification: justification,
+		},
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestAddSubjectHasSBOM(t *testing.T) {
+	knownSince := time.Unix(1000, 0)
+	pkg := &model.PkgInputSpec{Type: "oci", Name: "guac", Version: ptr("v1.0.0")}
+	subject := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abc"}
+	hasSBOM := &model.HasSBOMInputSpec{Uri: "https://guac.sh/sbom", KnownSince: knownSince}
+
+	tests := []struct {
+		name       string
+		predicates *assembler.IngestPredicates
+		srcInfo    processor.SourceInformation
+		want       *assembler.IngestPredicates
+	}{{
+		name: "sbom about a package",
+		predicates: &assembler.IngestPredicates{
+			HasSBOM: []assembler.HasSBOMIngest{{Pkg: pkg, HasSBOM: hasSBOM}},
+		},
+		srcInfo: processor.SourceInformation{Subject: "sha256:ABC"},
+		want: &assembler.IngestPredicates{
+			HasSBOM: []assembler.HasSBOMIngest{
+				{Pkg: pkg, HasSBOM: hasSBOM},
+				{Artifact: subject, HasSBOM: hasSBOM},
+			},
+			IsOccurrence: []assembler.IsOccurrenceIngest{{
+				Pkg:          pkg,
+				Artifact:     subject,
+				IsOccurrence: &model.IsOccurrenceInputSpec{Justification: "sbom attached to artifact"},
+			}},
+		},
+	}, {
+		name: "sbom about the subject already",
+		predicates: &assembler.IngestPredicates{
+			HasSBOM: []assembler.HasSBOMIngest{{Artifact: subject, HasSBOM: hasSBOM}},
+		},
+		srcInfo: processor.SourceInformation{Subject: "sha256:abc"},
+		want: &assembler.IngestPredicates{
+			HasSBOM: []assembler.HasSBOMIngest{{Artifact: subject, HasSBOM: hasSBOM}},
+		},
+	}, {
+		name: "not attached to an artifact",
+		predicates: &assembler.IngestPredicates{
+			HasSBOM: []assembler.HasSBOMIngest{{Pkg: pkg, HasSBOM: hasSBOM}},
+		},
+		want: &assembler.IngestPredicates{
+			HasSBOM: []assembler.HasSBOMIngest{{Pkg: pkg, HasSBOM: hasSBOM}},
+		},
+	}, {
+		name:       "not an sbom",
+		predicates: &assembler.IngestPredicates{},
+		srcInfo:    processor.SourceInformation{Subject: "sha256:abc"},
+		want:       &assembler.IngestPredicates{},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			AddSubjectHasSBOM(tt.predicates, tt.srcInfo)
+			if diff := cmp.Diff(tt.want, tt.predicates); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
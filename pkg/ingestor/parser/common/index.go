@@ -0,0 +1,176 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// IndexMemoryEntries is the number of entries an Index holds in memory before
+// it spills them to disk.
+var IndexMemoryEntries = 1 << 16
+
+var indexBucket = []byte("index")
+
+// Index maps the keys of the elements of a document parsed as a stream, such
+// as SPDX IDs or BOM refs, to what the parser needs to know about them, such
+// as their purls. It holds up to IndexMemoryEntries entries in memory, and
+// spills them to a temporary bbolt database once there are more, so that the
+// memory a parser holds does not grow with the number of elements of the
+// document. The index must be closed, which removes the database.
+type Index[V any] struct {
+	mem map[string]V
+	db  *bolt.DB
+}
+
+// NewIndex returns an empty Index.
+func NewIndex[V any]() *Index[V] {
+	return &Index[V]{mem: map[string]V{}}
+}
+
+// bolt keys cannot be empty, which BOM refs may be
+func indexKey(key string) []byte {
+	return []byte("/" + key)
+}
+
+// Get returns the value of the key, and whether it is in the index.
+func (x *Index[V]) Get(key string) (V, bool, error) {
+	if v, ok := x.mem[key]; ok {
+		return v, true, nil
+	}
+	var v V
+	if x.db == nil {
+		return v, false, nil
+	}
+	var data []byte
+	err := x.db.View(func(tx *bolt.Tx) error {
+		data = tx.Bucket(indexBucket).Get(indexKey(key))
+		if data == nil {
+			return nil
+		}
+		return json.Unmarshal(data, &v)
+	})
+	if err != nil {
+		return v, false, fmt.Errorf("failed to read index: %w", err)
+	}
+	return v, data != nil, nil
+}
+
+// AppendIndex appends values to the slice of the key of the index.
+func AppendIndex[E any](x *Index[[]E], key string, values ...E) error {
+	v, _, err := x.Get(key)
+	if err != nil {
+		return err
+	}
+	return x.Put(key, append(v, values...))
+}
+
+// Has returns whether the key is in the index.
+func (x *Index[V]) Has(key string) (bool, error) {
+	_, ok, err := x.Get(key)
+	return ok, err
+}
+
+// Put sets the value of the key.
+func (x *Index[V]) Put(key string, v V) error {
+	x.mem[key] = v
+	if len(x.mem) < IndexMemoryEntries {
+		return nil
+	}
+	return x.spill()
+}
+
+// spill moves the entries held in memory to the database.
+func (x *Index[V]) spill() error {
+	if x.db == nil {
+		f, err := os.CreateTemp("", "guac-index-*.db")
+		if err != nil {
+			return fmt.Errorf("failed to create index file: %w", err)
+		}
+		f.Close()
+		db, err := bolt.Open(f.Name(), 0600, &bolt.Options{Timeout: time.Second})
+		if err != nil {
+			os.Remove(f.Name())
+			return fmt.Errorf("failed to open index file: %w", err)
+		}
+		// the database is removed once parsed, so it is never synced
+		db.NoSync = true
+		x.db = db
+	}
+	err := x.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(indexBucket)
+		if err != nil {
+			return err
+		}
+		for key, v := range x.mem {
+			data, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			if err := b.Put(indexKey(key), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+	clear(x.mem)
+	return nil
+}
+
+// ForEach calls fn with every key and value of the index, until fn returns
+// an error. fn must not change the index.
+func (x *Index[V]) ForEach(fn func(key string, v V) error) error {
+	if x.db == nil {
+		for key, v := range x.mem {
+			if err := fn(key, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := x.spill(); err != nil {
+		return err
+	}
+	return x.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(indexBucket).ForEach(func(k, data []byte) error {
+			var v V
+			if err := json.Unmarshal(data, &v); err != nil {
+				return fmt.Errorf("failed to read index: %w", err)
+			}
+			return fn(string(k[1:]), v)
+		})
+	})
+}
+
+// Close removes the database of the index, if it spilled to disk.
+func (x *Index[V]) Close() error {
+	if x.db == nil {
+		return nil
+	}
+	path := x.db.Path()
+	err := x.db.Close()
+	x.db = nil
+	return errors.Join(err, os.Remove(path))
+}
@@ -0,0 +1,73 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIndex(t *testing.T) {
+	defer func(n int) { IndexMemoryEntries = n }(IndexMemoryEntries)
+	IndexMemoryEntries = 3
+
+	x := NewIndex[[]string]()
+	want := map[string][]string{}
+	for _, key := range []string{"", "a", "b", "c", "d", "a", "e"} {
+		if err := AppendIndex(x, key, key+"1", key+"2"); err != nil {
+			t.Fatalf("AppendIndex() error = %v", err)
+		}
+		want[key] = append(want[key], key+"1", key+"2")
+	}
+	if x.db == nil {
+		t.Fatalf("index did not spill to disk past %d entries", IndexMemoryEntries)
+	}
+	path := x.db.Path()
+
+	for key, values := range want {
+		got, ok, err := x.Get(key)
+		if err != nil || !ok {
+			t.Fatalf("Get(%q) = %v, %v, %v", key, got, ok, err)
+		}
+		if diff := cmp.Diff(values, got); diff != "" {
+			t.Errorf("Get(%q) mismatch (-want +got):\n%s", key, diff)
+		}
+	}
+	if ok, err := x.Has("f"); err != nil || ok {
+		t.Errorf("Has(%q) = %v, %v, want false", "f", ok, err)
+	}
+
+	got := map[string][]string{}
+	if err := x.ForEach(func(key string, v []string) error {
+		got[key] = v
+		return nil
+	}); err != nil {
+		t.Fatalf("ForEach() error = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ForEach() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := x.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Close() left the index file %s: %v", path, err)
+	}
+}
@@ -40,6 +40,26 @@ type DocumentParser interface {
 	GetIdentifiers(ctx context.Context) (*IdentifierStrings, error)
 }
 
+// StreamingDocumentParser is a DocumentParser that can also parse very large
+// documents as a stream, without holding the decoded document or all of its
+// predicates in memory. The document blob is read with
+// processor.Document.NewReader, so it is not held in memory either if the
+// document is read with Open.
+type StreamingDocumentParser interface {
+	DocumentParser
+
+	// ParseStream parses the document and calls emit with the predicates
+	// found, in chunks of about chunkSize predicates, along with the
+	// identifiers found in each chunk. The HasSBOM predicates, and the
+	// IsOccurrence predicates of the top level packages, are in the last
+	// chunk.
+	ParseStream(ctx context.Context, doc *processor.Document, chunkSize int, emit EmitFunc) error
+}
+
+// EmitFunc receives a chunk of the predicates of a document parsed as a
+// stream, along with the identifiers found in the chunk.
+type EmitFunc func(*assembler.IngestPredicates, *IdentifierStrings) error
+
 // IdentifierStrings represent a set of strings that can be used to a set of
 // identifiers that the parser has found to help provide context for collectors
 // to gather more information around found software identifiers.
@@ -141,18 +141,7 @@ func (c *cyclonedxParser) getTopLevelPackage() error {
 	}
 
 	if c.cdxBom.Metadata.Component != nil {
-		purl := c.cdxBom.Metadata.Component.PackageURL
-		if c.cdxBom.Metadata.Component.PackageURL == "" {
-			if c.cdxBom.Metadata.Component.Type == cdx.ComponentTypeContainer {
-				purl = parseContainerType(c.cdxBom.Metadata.Component.Name, c.cdxBom.Metadata.Component.Version, true)
-			} else if c.cdxBom.Metadata.Component.Type == cdx.ComponentTypeFile {
-				// example: file type ("/home/work/test/build/webserver")
-				purl = guacCDXFilePurl(c.cdxBom.Metadata.Component.Name, c.cdxBom.Metadata.Component.Version, true)
-			} else {
-				purl = guacCDXPkgPurl(c.cdxBom.Metadata.Component.Name, c.cdxBom.Metadata.Component.Version, "", true)
-			}
-		}
-
+		purl := componentPurl(c.cdxBom.Metadata.Component, true)
 		topPackage, err := asmhelpers.PurlToPkg(purl)
 		if err != nil {
 			return err
@@ -163,13 +152,7 @@ func (c *cyclonedxParser) getTopLevelPackage() error {
 
 		// if checksums exists create an artifact for each of them
 		if c.cdxBom.Metadata.Component.Hashes != nil {
-			for _, checksum := range *c.cdxBom.Metadata.Component.Hashes {
-				artifact := &model.ArtifactInputSpec{
-					Algorithm: strings.ToLower(string(checksum.Algorithm)),
-					Digest:    checksum.Value,
-				}
-				c.packageArtifacts[c.cdxBom.Metadata.Component.BOMRef] = append(c.packageArtifacts[c.cdxBom.Metadata.Component.BOMRef], artifact)
-			}
+			c.packageArtifacts[c.cdxBom.Metadata.Component.BOMRef] = append(c.packageArtifacts[c.cdxBom.Metadata.Component.BOMRef], componentArtifacts(c.cdxBom.Metadata.Component)...)
 		}
 
 		// get top level licenses
@@ -227,16 +210,7 @@ func traverseComponents(c cyclonedxParser, components *[]cdx.Component) error {
 				continue
 			}
 
-			purl := comp.PackageURL
-			if purl == "" {
-				if comp.Type == cdx.ComponentTypeContainer {
-					purl = parseContainerType(comp.Name, comp.Version, false)
-				} else if comp.Type == cdx.ComponentTypeFile {
-					purl = guacCDXFilePurl(comp.Name, comp.Version, false)
-				} else {
-					purl = asmhelpers.GuacPkgPurl(comp.Name, &comp.Version)
-				}
-			}
+			purl := componentPurl(&comp, false)
 			pkg, err := asmhelpers.PurlToPkg(purl)
 			if err != nil {
 				return err
@@ -246,13 +220,7 @@ func traverseComponents(c cyclonedxParser, components *[]cdx.Component) error {
 
 			// if checksums exists create an artifact for each of them
 			if comp.Hashes != nil {
-				for _, checksum := range *comp.Hashes {
-					artifact := &model.ArtifactInputSpec{
-						Algorithm: strings.ToLower(string(checksum.Algorithm)),
-						Digest:    checksum.Value,
-					}
-					c.packageArtifacts[comp.BOMRef] = append(c.packageArtifacts[comp.BOMRef], artifact)
-				}
+				c.packageArtifacts[comp.BOMRef] = append(c.packageArtifacts[comp.BOMRef], componentArtifacts(&comp)...)
 			}
 			// get other component packages
 			if err := c.getLicenseInformation(comp); err != nil {
@@ -267,6 +235,41 @@ func traverseComponents(c cyclonedxParser, components *[]cdx.Component) error {
 	return nil
 }
 
+// componentPurl returns the purl of the component, or a GUAC purl if it has
+// none.
+func componentPurl(comp *cdx.Component, topLevel bool) string {
+	if comp.PackageURL != "" {
+		return comp.PackageURL
+	}
+	switch comp.Type {
+	case cdx.ComponentTypeContainer:
+		return parseContainerType(comp.Name, comp.Version, topLevel)
+	case cdx.ComponentTypeFile:
+		// example: file type ("/home/work/test/build/webserver")
+		return guacCDXFilePurl(comp.Name, comp.Version, topLevel)
+	}
+	if topLevel {
+		return guacCDXPkgPurl(comp.Name, comp.Version, "", true)
+	}
+	return asmhelpers.GuacPkgPurl(comp.Name, &comp.Version)
+}
+
+// componentArtifacts returns an artifact for each of the checksums of the
+// component.
+func componentArtifacts(comp *cdx.Component) []*model.ArtifactInputSpec {
+	if comp.Hashes == nil {
+		return nil
+	}
+	var artifacts []*model.ArtifactInputSpec
+	for _, checksum := range *comp.Hashes {
+		artifacts = append(artifacts, &model.ArtifactInputSpec{
+			Algorithm: strings.ToLower(string(checksum.Algorithm)),
+			Digest:    checksum.Value,
+		})
+	}
+	return artifacts
+}
+
 func (c *cyclonedxParser) getLicenseInformation(comp cdx.Component) error {
 	// legal information from CDX component
 	if comp.Licenses != nil {
@@ -0,0 +1,672 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclonedx
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/misc/jsonstream"
+)
+
+const topLevelJustification = "top-level package GUAC heuristic connecting to each file/package"
+
+// cdxIndex is what the first pass over a streamed document collects, which
+// the predicates of any component may need: the metadata, the purls and
+// artifacts of every component by BOM ref and the inline license texts.
+type cdxIndex struct {
+	serialNumber     string
+	metadata         *cdx.Metadata
+	timestamp        time.Time
+	packagePurls     *common.Index[[]string]
+	packageArtifacts *common.Index[[]*model.ArtifactInputSpec]
+	licenseInLine    map[string]string
+	hasDependencies  bool
+}
+
+func (idx *cdxIndex) close() error {
+	return errors.Join(idx.packagePurls.Close(), idx.packageArtifacts.Close())
+}
+
+// ParseStream parses a CycloneDX JSON document in two passes over the reader
+// of its blob. The first one indexes the components and the second one emits
+// the predicates of each component, dependency and vulnerability as they are
+// read, so that neither the blob, the decoded document nor its predicates are
+// ever held in memory at once. The purls and artifacts of the components that
+// resolve the dependencies are in a common.Index, which spills to disk. The
+// predicates are the same as the ones of GetPredicates.
+func (c *cyclonedxParser) ParseStream(ctx context.Context, doc *processor.Document, chunkSize int, emit common.EmitFunc) error {
+	if doc.Format != processor.FormatJSON {
+		return fmt.Errorf("unable to stream CycloneDX document format: %v", doc.Format)
+	}
+	r, err := doc.NewReader()
+	if err != nil {
+		return fmt.Errorf("failed to open cyclonedx BOM: %w", err)
+	}
+	idx, err := indexCdx(r)
+	r.Close()
+	defer idx.close()
+	if err != nil {
+		return fmt.Errorf("failed to parse cyclonedx BOM: %w", err)
+	}
+
+	st := &cdxStream{
+		ctx:       ctx,
+		doc:       doc,
+		idx:       idx,
+		chunker:   common.NewChunker(chunkSize, emit),
+		direct:    common.NewIndex[bool](),
+		indirect:  common.NewIndex[bool](),
+		accounted: common.NewIndex[bool](),
+		legals: &cyclonedxParser{
+			packageLegals: map[string][]*model.CertifyLegalInputSpec{},
+			licenseInLine: idx.licenseInLine,
+			timestamp:     idx.timestamp,
+		},
+	}
+	defer st.close()
+	if err := st.getTopLevel(); err != nil {
+		return err
+	}
+	if idx.metadata != nil && idx.metadata.Component != nil {
+		n, err := st.addLegals(idx.metadata.Component)
+		if err != nil {
+			return err
+		}
+		if err := st.chunker.Added(n); err != nil {
+			return err
+		}
+	}
+	r, err = doc.NewReader()
+	if err != nil {
+		return fmt.Errorf("failed to open cyclonedx BOM: %w", err)
+	}
+	defer r.Close()
+	if err := st.emitElements(r); err != nil {
+		return fmt.Errorf("failed to parse cyclonedx BOM: %w", err)
+	}
+	if err := st.emitOccurrences(); err != nil {
+		return err
+	}
+	if err := st.emitTopLevelDependencies(); err != nil {
+		return err
+	}
+	return st.emitTopLevel()
+}
+
+// indexCdx is the first pass over a streamed document. It also returns the
+// errors that Parse would, so that nothing is emitted for a document that
+// would not parse. The returned index must be closed, even with an error.
+func indexCdx(r io.Reader) (*cdxIndex, error) {
+	idx := &cdxIndex{
+		timestamp:        time.Now(),
+		packagePurls:     common.NewIndex[[]string](),
+		packageArtifacts: common.NewIndex[[]*model.ArtifactInputSpec](),
+		licenseInLine:    map[string]string{},
+	}
+	legals := &cyclonedxParser{
+		packageLegals: map[string][]*model.CertifyLegalInputSpec{},
+		licenseInLine: idx.licenseInLine,
+	}
+
+	var walk func(comp *cdx.Component) error
+	walk = func(comp *cdx.Component) error {
+		// same as traverseComponents
+		if comp.Type == cdx.ComponentTypeOS {
+			return nil
+		}
+		purl := componentPurl(comp, false)
+		if _, err := asmhelpers.PurlToPkg(purl); err != nil {
+			return err
+		}
+		if err := common.AppendIndex(idx.packagePurls, comp.BOMRef, purl); err != nil {
+			return err
+		}
+		if err := common.AppendIndex(idx.packageArtifacts, comp.BOMRef, componentArtifacts(comp)...); err != nil {
+			return err
+		}
+		clear(legals.packageLegals)
+		if err := legals.getLicenseInformation(*comp); err != nil {
+			return fmt.Errorf("failed to get license information for component package with error: %w", err)
+		}
+		if comp.Components != nil {
+			for i := range *comp.Components {
+				if err := walk(&(*comp.Components)[i]); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	dec := stdjson.NewDecoder(r)
+	err := jsonstream.Object(dec, func(key string) error {
+		switch key {
+		case "serialNumber":
+			return dec.Decode(&idx.serialNumber)
+		case "metadata":
+			return dec.Decode(&idx.metadata)
+		case "components":
+			return jsonstream.Array(dec, func() error {
+				var comp cdx.Component
+				if err := dec.Decode(&comp); err != nil {
+					return err
+				}
+				return walk(&comp)
+			})
+		case "dependencies":
+			// GetPredicates only adds the legal predicates and follows the
+			// dependencies if the field is set, even if it is empty
+			tok, err := dec.Token()
+			if err != nil || tok == nil {
+				return err
+			}
+			if tok != stdjson.Delim('[') {
+				return fmt.Errorf("expected a JSON array, got %v", tok)
+			}
+			idx.hasDependencies = true
+			for dec.More() {
+				if err := jsonstream.Skip(dec); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+			return err
+		default:
+			return jsonstream.Skip(dec)
+		}
+	})
+	if err != nil {
+		return idx, err
+	}
+
+	// same as getTopLevelPackage, the top level package is the first one of
+	// its BOM ref
+	if idx.metadata == nil {
+		return idx, nil
+	}
+	if idx.metadata.Timestamp == "" {
+		idx.timestamp = zeroTime
+	} else {
+		timestamp, err := time.Parse(time.RFC3339, idx.metadata.Timestamp)
+		if err != nil {
+			return idx, fmt.Errorf("SPDX document had invalid created time %q : %w", idx.metadata.Timestamp, err)
+		}
+		idx.timestamp = timestamp
+	}
+	comp := idx.metadata.Component
+	if comp == nil {
+		return idx, fmt.Errorf("guac currently does not support CycloneDX component field in metadata or the BOM ref being nil. See issue #976 for more details")
+	}
+	purl := componentPurl(comp, true)
+	if _, err := asmhelpers.PurlToPkg(purl); err != nil {
+		return idx, err
+	}
+	purls, _, err := idx.packagePurls.Get(comp.BOMRef)
+	if err != nil {
+		return idx, err
+	}
+	if err := idx.packagePurls.Put(comp.BOMRef, slices.Insert(purls, 0, purl)); err != nil {
+		return idx, err
+	}
+	arts, _, err := idx.packageArtifacts.Get(comp.BOMRef)
+	if err != nil {
+		return idx, err
+	}
+	if err := idx.packageArtifacts.Put(comp.BOMRef, slices.Insert(arts, 0, componentArtifacts(comp)...)); err != nil {
+		return idx, err
+	}
+	clear(legals.packageLegals)
+	if err := legals.getLicenseInformation(*comp); err != nil {
+		return idx, fmt.Errorf("failed to get license information for top level package with error: %w", err)
+	}
+	return idx, nil
+}
+
+// cdxStream is the state of the second pass over a streamed document.
+type cdxStream struct {
+	ctx     context.Context
+	doc     *processor.Document
+	idx     *cdxIndex
+	chunker *common.Chunker
+	// legals collects the CertifyLegal input specs of one component at a
+	// time
+	legals *cyclonedxParser
+
+	topLevelRef  *string
+	topLevelPkgs []*model.PkgInputSpec
+	topLevelArts []*model.ArtifactInputSpec
+
+	// direct, indirect and accounted are the BOM refs found while following
+	// the dependencies, see GetPredicates
+	direct    *common.Index[bool]
+	indirect  *common.Index[bool]
+	accounted *common.Index[bool]
+}
+
+func (st *cdxStream) close() error {
+	return errors.Join(st.direct.Close(), st.indirect.Close(), st.accounted.Close())
+}
+
+// getTopLevel finds the top level packages and artifacts the same way as
+// GetPredicates.
+func (st *cdxStream) getTopLevel() error {
+	logger := logging.FromContext(st.ctx)
+	if st.idx.metadata == nil || st.idx.metadata.Component == nil {
+		return nil
+	}
+	comp := st.idx.metadata.Component
+	st.topLevelRef = &comp.BOMRef
+	purls, _, err := st.idx.packagePurls.Get(comp.BOMRef)
+	if err != nil {
+		return err
+	}
+	pkgs, err := common.PurlsToPkgs(purls)
+	if err != nil {
+		return err
+	}
+	st.topLevelPkgs = pkgs
+	if comp.Type != cdx.ComponentTypeContainer {
+		st.topLevelArts, _, err = st.idx.packageArtifacts.Get(comp.BOMRef)
+		return err
+	}
+	if st.topLevelPkgs[0].Version != nil && *st.topLevelPkgs[0].Version != "" {
+		artInput, err := getArtifactInput(*st.topLevelPkgs[0].Version)
+		if err != nil {
+			logger.Infof("CDX artifact was not parsable: %v", err)
+		} else {
+			st.topLevelArts = append(st.topLevelArts, artInput)
+			// append to packageArtifacts so that isOccurrence is created
+			if err := common.AppendIndex(st.idx.packageArtifacts, comp.BOMRef, artInput); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (st *cdxStream) isTopLevel(ref string) bool {
+	return st.topLevelRef != nil && *st.topLevelRef == ref
+}
+
+func (st *cdxStream) emitElements(r io.Reader) error {
+	dec := stdjson.NewDecoder(r)
+	return jsonstream.Object(dec, func(key string) error {
+		switch key {
+		case "components":
+			return jsonstream.Array(dec, func() error {
+				var comp cdx.Component
+				if err := dec.Decode(&comp); err != nil {
+					return err
+				}
+				return st.emitComponent(&comp)
+			})
+		case "dependencies":
+			return jsonstream.Array(dec, func() error {
+				var deps cdx.Dependency
+				if err := dec.Decode(&deps); err != nil {
+					return err
+				}
+				return st.emitDependency(&deps)
+			})
+		case "vulnerabilities":
+			return jsonstream.Array(dec, func() error {
+				var vulnerability cdx.Vulnerability
+				if err := dec.Decode(&vulnerability); err != nil {
+					return err
+				}
+				return st.emitVulnerability(&vulnerability)
+			})
+		default:
+			return jsonstream.Skip(dec)
+		}
+	})
+}
+
+func (st *cdxStream) emitComponent(comp *cdx.Component) error {
+	if comp.Type == cdx.ComponentTypeOS {
+		return nil
+	}
+	st.chunker.Identifiers.PurlStrings = append(st.chunker.Identifiers.PurlStrings, componentPurl(comp, false))
+	n, err := st.addLegals(comp)
+	if err != nil {
+		return err
+	}
+	if err := st.chunker.Added(n); err != nil {
+		return err
+	}
+	if comp.Components != nil {
+		for i := range *comp.Components {
+			if err := st.emitComponent(&(*comp.Components)[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addLegals adds the CertifyLegal predicates of the component to the current
+// chunk, for every package of its BOM ref, and returns how many there are.
+func (st *cdxStream) addLegals(comp *cdx.Component) (int, error) {
+	if !st.idx.hasDependencies {
+		return 0, nil
+	}
+	clear(st.legals.packageLegals)
+	if err := st.legals.getLicenseInformation(*comp); err != nil {
+		return 0, err
+	}
+	cls := st.legals.packageLegals[comp.BOMRef]
+	if len(cls) == 0 {
+		return 0, nil
+	}
+	purls, _, err := st.idx.packagePurls.Get(comp.BOMRef)
+	if err != nil {
+		return 0, err
+	}
+	pkgs, err := common.PurlsToPkgs(purls)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, cl := range cls {
+		dec := common.ParseLicenses(cl.DeclaredLicense, nil, st.idx.licenseInLine)
+		dis := common.ParseLicenses(cl.DiscoveredLicense, nil, st.idx.licenseInLine)
+		for _, pkg := range pkgs {
+			st.chunker.Predicates.CertifyLegal = append(st.chunker.Predicates.CertifyLegal, assembler.CertifyLegalIngest{
+				Pkg:          pkg,
+				Declared:     dec,
+				Discovered:   dis,
+				CertifyLegal: cl,
+			})
+			n++
+		}
+	}
+	return n, nil
+}
+
+// firstPkg returns the first package of the BOM ref, which is the only one
+// common.GetIsDep uses as the dependency.
+func (st *cdxStream) firstPkg(ref string) (*model.PkgInputSpec, error) {
+	purls, _, err := st.idx.packagePurls.Get(ref)
+	if err != nil || len(purls) == 0 {
+		return nil, err
+	}
+	return asmhelpers.PurlToPkg(purls[0])
+}
+
+// emitDependency follows one entry of the dependencies the same way as
+// GetPredicates.
+func (st *cdxStream) emitDependency(deps *cdx.Dependency) error {
+	logger := logging.FromContext(st.ctx)
+	preds := st.chunker.Predicates
+
+	currPurls, found, err := st.idx.packagePurls.Get(deps.Ref)
+	if err != nil || !found {
+		return err
+	}
+	currPkg, err := common.PurlsToPkgs(currPurls)
+	if err != nil {
+		return err
+	}
+	if err := st.accounted.Put(deps.Ref, true); err != nil {
+		return err
+	}
+
+	direct, err := st.direct.Has(deps.Ref)
+	if err != nil {
+		return err
+	}
+	indirect, err := st.indirect.Has(deps.Ref)
+	if err != nil {
+		return err
+	}
+	n := 0
+	dependencyType := model.DependencyTypeUnknown
+	if st.isTopLevel(deps.Ref) {
+		dependencyType = model.DependencyTypeDirect
+	} else if direct || indirect {
+		dependencyType = model.DependencyTypeIndirect
+	} else if len(st.topLevelPkgs) > 0 {
+		p, err := common.GetIsDep(st.topLevelPkgs[0], currPkg, []*model.PkgInputSpec{}, topLevelJustification, model.DependencyTypeUnknown)
+		if err != nil {
+			logger.Errorf("error generating CycloneDX edge %v", err)
+			return nil
+		}
+		if p != nil {
+			preds.IsDependency = append(preds.IsDependency, *p)
+			n++
+		}
+	}
+	if deps.Dependencies != nil {
+		for _, depPkgRef := range *deps.Dependencies {
+			depPkg, err := st.firstPkg(depPkgRef)
+			if err != nil {
+				return err
+			}
+			if depPkg == nil {
+				continue
+			}
+			if err := st.accounted.Put(depPkgRef, true); err != nil {
+				return err
+			}
+			for _, packNode := range currPkg {
+				p, err := common.GetIsDep(packNode, []*model.PkgInputSpec{depPkg}, []*model.PkgInputSpec{}, "CDX BOM Dependency", model.DependencyTypeDirect)
+				if err != nil {
+					logger.Errorf("error generating CycloneDX edge %v", err)
+					continue
+				}
+				if p != nil {
+					preds.IsDependency = append(preds.IsDependency, *p)
+					n++
+					switch dependencyType {
+					case model.DependencyTypeDirect:
+						err = st.direct.Put(depPkgRef, true)
+					case model.DependencyTypeIndirect:
+						err = st.indirect.Put(depPkgRef, true)
+					}
+					if err != nil {
+						return err
+					}
+				}
+
+				if !st.isTopLevel(deps.Ref) && len(st.topLevelPkgs) > 0 {
+					justificationStr := "CDX BOM Dependency"
+					if dependencyType == model.DependencyTypeUnknown {
+						justificationStr = topLevelJustification
+					}
+					p, err := common.GetIsDep(st.topLevelPkgs[0], []*model.PkgInputSpec{depPkg}, []*model.PkgInputSpec{}, justificationStr, dependencyType)
+					if err != nil {
+						logger.Errorf("error generating CycloneDX edge %v", err)
+						continue
+					}
+					if p != nil {
+						preds.IsDependency = append(preds.IsDependency, *p)
+						n++
+					}
+				}
+			}
+		}
+	}
+	return st.chunker.Added(n)
+}
+
+// emitVulnerability emits the predicates of one vulnerability with
+// getVulnerabilities, given the packages of the BOM refs it references.
+func (st *cdxStream) emitVulnerability(vulnerability *cdx.Vulnerability) error {
+	refs := []string{vulnerability.BOMRef}
+	if vulnerability.Affects != nil {
+		for _, affect := range *vulnerability.Affects {
+			refs = append(refs, affect.Ref)
+		}
+	}
+	packagePackages := map[string][]*model.PkgInputSpec{}
+	for _, ref := range refs {
+		purls, ok, err := st.idx.packagePurls.Get(ref)
+		if err != nil {
+			return err
+		}
+		if ok {
+			pkgs, err := common.PurlsToPkgs(purls)
+			if err != nil {
+				return err
+			}
+			packagePackages[ref] = pkgs
+		}
+	}
+
+	vp := &cyclonedxParser{
+		doc:               st.doc,
+		packagePackages:   packagePackages,
+		identifierStrings: st.chunker.Identifiers,
+		cdxBom:            &cdx.BOM{Vulnerabilities: &[]cdx.Vulnerability{*vulnerability}},
+	}
+	if err := vp.getVulnerabilities(st.ctx); err != nil {
+		return err
+	}
+	preds := st.chunker.Predicates
+	preds.Vex = append(preds.Vex, vp.vulnData.vex...)
+	preds.CertifyVuln = append(preds.CertifyVuln, vp.vulnData.certifyVuln...)
+	preds.VulnMetadata = append(preds.VulnMetadata, vp.vulnData.vulnMetadata...)
+	return st.chunker.Added(len(vp.vulnData.vex) + len(vp.vulnData.certifyVuln) + len(vp.vulnData.vulnMetadata))
+}
+
+// emitOccurrences emits an IsOccurrence for every package and artifact of
+// each BOM ref, but the top level one's, which are emitted with the HasSBOM
+// predicates.
+func (st *cdxStream) emitOccurrences() error {
+	return st.idx.packageArtifacts.ForEach(func(ref string, arts []*model.ArtifactInputSpec) error {
+		if len(arts) == 0 || st.isTopLevel(ref) {
+			return nil
+		}
+		purls, _, err := st.idx.packagePurls.Get(ref)
+		if err != nil {
+			return err
+		}
+		pkgs, err := common.PurlsToPkgs(purls)
+		if err != nil {
+			return err
+		}
+		for _, pkg := range pkgs {
+			for _, art := range arts {
+				st.chunker.Predicates.IsOccurrence = append(st.chunker.Predicates.IsOccurrence, cdxOccurrence(pkg, art))
+			}
+		}
+		return st.chunker.Added(len(pkgs) * len(arts))
+	})
+}
+
+func cdxOccurrence(pkg *model.PkgInputSpec, art *model.ArtifactInputSpec) assembler.IsOccurrenceIngest {
+	return assembler.IsOccurrenceIngest{
+		Pkg:      pkg,
+		Artifact: art,
+		IsOccurrence: &model.IsOccurrenceInputSpec{
+			Justification: "cdx package with checksum",
+		},
+	}
+}
+
+// emitTopLevelDependencies connects the top level package to every package
+// that the dependencies do not account for, or to every package if the
+// document has none, the same way as GetPredicates.
+func (st *cdxStream) emitTopLevelDependencies() error {
+	if len(st.topLevelPkgs) == 0 {
+		return nil
+	}
+	topLevel := st.topLevelPkgs[0]
+	// the purl is compared rather than the package, whose qualifiers
+	// PurlToPkg returns in map order
+	topLevelPurls, _, err := st.idx.packagePurls.Get(*st.topLevelRef)
+	if err != nil {
+		return err
+	}
+	topLevelPurl := topLevelPurls[0]
+	return st.idx.packagePurls.ForEach(func(ref string, purls []string) error {
+		if accounted, err := st.accounted.Has(ref); err != nil || accounted {
+			return err
+		}
+		n := 0
+		for _, purl := range purls {
+			if purl == topLevelPurl {
+				continue
+			}
+			pkg, err := asmhelpers.PurlToPkg(purl)
+			if err != nil {
+				return err
+			}
+			st.chunker.Predicates.IsDependency = append(st.chunker.Predicates.IsDependency, assembler.IsDependencyIngest{
+				Pkg:    topLevel,
+				DepPkg: pkg,
+				IsDependency: &model.IsDependencyInputSpec{
+					DependencyType: model.DependencyTypeUnknown,
+					Justification:  topLevelJustification,
+				},
+			})
+			n++
+		}
+		return st.chunker.Added(n)
+	})
+}
+
+// emitTopLevel emits the last chunk, with the HasSBOM predicates of the top
+// level component.
+func (st *cdxStream) emitTopLevel() error {
+	preds := st.chunker.Predicates
+	if st.topLevelRef == nil {
+		return st.chunker.Flush()
+	}
+	ref := *st.topLevelRef
+	purls, _, err := st.idx.packagePurls.Get(ref)
+	if err != nil {
+		return err
+	}
+	st.chunker.Identifiers.PurlStrings = append(st.chunker.Identifiers.PurlStrings, purls[0])
+
+	if len(st.topLevelArts) > 0 {
+		for _, art := range st.topLevelArts {
+			preds.HasSBOM = append(preds.HasSBOM, common.CreateTopLevelHasSBOMFromArtifact(art, st.doc, st.idx.serialNumber, st.idx.timestamp))
+		}
+	} else {
+		preds.HasSBOM = append(preds.HasSBOM, common.CreateTopLevelHasSBOMFromPkg(st.topLevelPkgs[0], st.doc, st.idx.serialNumber, st.idx.timestamp))
+	}
+	n := len(preds.HasSBOM)
+	arts, _, err := st.idx.packageArtifacts.Get(ref)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range st.topLevelPkgs {
+		for _, art := range arts {
+			preds.IsOccurrence = append(preds.IsOccurrence, cdxOccurrence(pkg, art))
+			n++
+		}
+	}
+	if err := st.chunker.Added(n); err != nil {
+		return err
+	}
+	return st.chunker.Flush()
+}
@@ -0,0 +1,325 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclonedx
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+// syntheticCdx returns a CycloneDX JSON document with n components, each with
+// a hash, a license and a nested component. If dependencies is true, each
+// component depends on the next one, but the last ten that are left to the
+// top level heuristic, and every tenth component is affected by a
+// vulnerability.
+func syntheticCdx(n int, dependencies bool) []byte {
+	var buf bytes.Buffer
+	if err := writeSyntheticCdx(&buf, n, dependencies); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// writeSyntheticCdx writes the document of syntheticCdx to w one element at a
+// time, so that it is never held in memory.
+func writeSyntheticCdx(w io.Writer, n int, dependencies bool) error {
+	bom := map[string]any{
+		"bomFormat":    "CycloneDX",
+		"specVersion":  "1.5",
+		"serialNumber": "urn:uuid:00000000-0000-0000-0000-000000000000",
+		"metadata": map[string]any{
+			"timestamp": "2026-01-01T00:00:00Z",
+			"component": map[string]any{
+				"type":     "application",
+				"bom-ref":  "root",
+				"name":     "root",
+				"version":  "1.0.0",
+				"purl":     "pkg:npm/root@1.0.0",
+				"hashes":   []map[string]string{{"alg": "SHA-256", "content": fmt.Sprintf("%064x", 0)}},
+				"licenses": []map[string]any{{"expression": "MIT"}},
+			},
+		},
+	}
+	header, err := json.Marshal(bom)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	// the arrays are written in the header object, before its closing brace
+	bw.Write(header[:len(header)-1])
+	writeArray := func(key string, elements func(yield func(any) error) error) error {
+		fmt.Fprintf(bw, ",%q:[", key)
+		first := true
+		err := elements(func(element any) error {
+			if !first {
+				bw.WriteByte(',')
+			}
+			first = false
+			data, err := json.Marshal(element)
+			if err != nil {
+				return err
+			}
+			_, err = bw.Write(data)
+			return err
+		})
+		bw.WriteByte(']')
+		return err
+	}
+	if err := writeArray("components", func(yield func(any) error) error {
+		for i := 0; i < n; i++ {
+			ref := fmt.Sprintf("comp-%d", i)
+			err := yield(map[string]any{
+				"type":    "library",
+				"bom-ref": ref,
+				"name":    fmt.Sprintf("comp%d", i),
+				"version": "1.0.0",
+				"purl":    fmt.Sprintf("pkg:npm/comp%d@1.0.0", i),
+				"hashes": []map[string]string{
+					{"alg": "SHA-256", "content": fmt.Sprintf("%064x", i+1)},
+				},
+				"licenses": []map[string]any{
+					{"license": map[string]any{"name": "Custom", "text": map[string]string{"content": "custom license"}}},
+				},
+				"components": []map[string]any{{
+					"type":    "file",
+					"bom-ref": ref + "-file",
+					"name":    fmt.Sprintf("/usr/lib/comp%d.so", i),
+				}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if dependencies {
+		if err := writeArray("dependencies", func(yield func(any) error) error {
+			if err := yield(map[string]any{"ref": "root", "dependsOn": []string{"comp-0"}}); err != nil {
+				return err
+			}
+			for i := 0; i < n-10; i++ {
+				ref := fmt.Sprintf("comp-%d", i)
+				if err := yield(map[string]any{"ref": ref, "dependsOn": []string{fmt.Sprintf("comp-%d", i+1), ref + "-file"}}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	if err := writeArray("vulnerabilities", func(yield func(any) error) error {
+		for i := 0; i < n; i += 10 {
+			err := yield(map[string]any{
+				"id":      fmt.Sprintf("CVE-2026-%d", 1000+i),
+				"ratings": []map[string]any{{"score": 7.5, "method": "CVSSv31"}},
+				"affects": []map[string]string{{"ref": fmt.Sprintf("comp-%d", i)}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	bw.WriteByte('}')
+	return bw.Flush()
+}
+
+func TestParseStream(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		blob []byte
+	}{
+		{name: "alpine", blob: testdata.CycloneDXExampleAlpine},
+		{name: "quarkus", blob: testdata.CycloneDXExampleQuarkusDeps},
+		{name: "small deps", blob: testdata.CycloneDXExampleSmallDeps},
+		{name: "distroless", blob: testdata.CycloneDXDistrolessExample},
+		{name: "distroless invalid version", blob: testdata.CycloneDXDistrolessInvalidVersionExample},
+		{name: "big mongo", blob: testdata.CycloneDXBigExample},
+		{name: "missing depends on", blob: testdata.CycloneDXDependenciesMissingDependsOn},
+		{name: "no dependent components", blob: testdata.CycloneDXExampleNoDependentComponents},
+		{name: "no top level component", blob: testdata.CycloneDXExampleNoTopLevelComp},
+		{name: "vex unaffected", blob: testdata.CycloneDXVEXUnAffected},
+		{name: "vex affected", blob: testdata.CycloneDXVEXAffected},
+		{name: "vex without analysis", blob: testdata.CycloneDXVEXWithoutAnalysis},
+		{name: "xray vulns", blob: testdata.CyloneDXXRAYExampleVulns},
+		{name: "legal", blob: testdata.CycloneDXLegalExample},
+		{name: "nested components", blob: testdata.CycloneDXComponentsNested},
+		{name: "synthetic", blob: syntheticCdx(50, true)},
+		{name: "synthetic without dependencies", blob: syntheticCdx(50, false)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &processor.Document{
+				Blob:   tt.blob,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentCycloneDX,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    "TestSource",
+				},
+			}
+
+			var chunks []*assembler.IngestPredicates
+			var purls []string
+			emit := func(preds *assembler.IngestPredicates, ids *common.IdentifierStrings) error {
+				if len(chunks) > 0 && len(chunks[len(chunks)-1].HasSBOM) > 0 {
+					t.Errorf("HasSBOM emitted before the last chunk")
+				}
+				chunks = append(chunks, preds)
+				purls = append(purls, ids.PurlStrings...)
+				return nil
+			}
+			sp := NewCycloneDXParser().(common.StreamingDocumentParser)
+			streamErr := sp.ParseStream(ctx, doc, 100, emit)
+
+			p := NewCycloneDXParser()
+			if err := p.Parse(ctx, doc); err != nil {
+				if streamErr == nil {
+					t.Fatalf("ParseStream() succeeded, Parse() error = %v", err)
+				}
+				if len(chunks) > 0 {
+					t.Errorf("ParseStream() emitted %d chunks before failing", len(chunks))
+				}
+				return
+			}
+			if streamErr != nil {
+				t.Fatalf("ParseStream() error = %v", streamErr)
+			}
+			want := p.GetPredicates(ctx)
+			wantIDs, _ := p.GetIdentifiers(ctx)
+
+			got := testdata.MergeChunks(chunks...)
+			if diff := cmp.Diff(testdata.MergeChunks(want), got, testdata.StreamCmpOpts...); diff != "" {
+				t.Errorf("streamed predicates differ (-want +got):\n%s", diff)
+			}
+			slices.Sort(purls)
+			purls = slices.Compact(purls)
+			slices.Sort(wantIDs.PurlStrings)
+			if diff := cmp.Diff(wantIDs.PurlStrings, purls); diff != "" {
+				t.Errorf("streamed purls differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestParseStreamMemory checks that ParseStream fits in a fixed memory
+// budget, on a generated document several times larger than the budget,
+// which is read with Open and never held in memory. Neither its blob nor the
+// index, which spills to disk, may be held at once.
+func TestParseStreamMemory(t *testing.T) {
+	const budget = 16 << 20
+	defer func(n int) { common.IndexMemoryEntries = n }(common.IndexMemoryEntries)
+	common.IndexMemoryEntries = 1000
+
+	ctx := context.Background()
+	write := func(w io.Writer) error {
+		return writeSyntheticCdx(w, 150000, true)
+	}
+	size, err := testdata.WrittenSize(write)
+	if err != nil {
+		t.Fatalf("unable to generate document: %v", err)
+	}
+	if size < 4*budget {
+		t.Fatalf("generated document of %d bytes, want at least %d for the budget to matter", size, 4*budget)
+	}
+	doc := &processor.Document{Open: testdata.OpenWriter(write), Format: processor.FormatJSON, Type: processor.DocumentCycloneDX}
+	base := testdata.LiveHeap()
+
+	var peak uint64
+	emit := func(preds *assembler.IngestPredicates, ids *common.IdentifierStrings) error {
+		// the chunk is held until it is assembled
+		peak = max(peak, testdata.LiveHeap())
+		runtime.KeepAlive(preds)
+		runtime.KeepAlive(ids)
+		return nil
+	}
+	if err := NewCycloneDXParser().(common.StreamingDocumentParser).ParseStream(ctx, doc, 5000, emit); err != nil {
+		t.Fatalf("ParseStream() error = %v", err)
+	}
+	if peak < base {
+		peak = base
+	}
+	if peak-base > budget {
+		t.Errorf("ParseStream() held %d bytes of a document of %d bytes, want at most %d", peak-base, size, budget)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	ctx := context.Background()
+	for _, n := range []int{1000, 10000} {
+		doc := &processor.Document{Blob: syntheticCdx(n, true), Format: processor.FormatJSON, Type: processor.DocumentCycloneDX}
+		b.Run(fmt.Sprintf("components=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(doc.Blob)))
+			var peak uint64
+			for i := 0; i < b.N; i++ {
+				p := NewCycloneDXParser()
+				if err := p.Parse(ctx, doc); err != nil {
+					b.Fatal(err)
+				}
+				preds := p.GetPredicates(ctx)
+				peak = max(peak, testdata.LiveHeap())
+				runtime.KeepAlive(preds)
+			}
+			b.ReportMetric(float64(peak), "peak-heap-B")
+		})
+	}
+}
+
+func BenchmarkParseStream(b *testing.B) {
+	ctx := context.Background()
+	for _, n := range []int{1000, 10000} {
+		doc := &processor.Document{Blob: syntheticCdx(n, true), Format: processor.FormatJSON, Type: processor.DocumentCycloneDX}
+		b.Run(fmt.Sprintf("components=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(doc.Blob)))
+			var peak uint64
+			emit := func(preds *assembler.IngestPredicates, ids *common.IdentifierStrings) error {
+				// the chunk is held until it is assembled
+				peak = max(peak, testdata.LiveHeap())
+				runtime.KeepAlive(preds)
+				runtime.KeepAlive(ids)
+				return nil
+			}
+			for i := 0; i < b.N; i++ {
+				p := NewCycloneDXParser().(common.StreamingDocumentParser)
+				if err := p.ParseStream(ctx, doc, 5000, emit); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(peak), "peak-heap-B")
+		})
+	}
+}
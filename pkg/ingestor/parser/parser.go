@@ -17,10 +17,17 @@ package parser
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/clearlydefined"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
@@ -37,6 +44,7 @@ import (
 	"github.com/guacsec/guac/pkg/ingestor/parser/slsa"
 	"github.com/guacsec/guac/pkg/ingestor/parser/spdx"
 	"github.com/guacsec/guac/pkg/ingestor/parser/vuln"
+	"github.com/guacsec/guac/pkg/version"
 )
 
 func init() {
@@ -55,8 +63,20 @@ func init() {
 	_ = RegisterDocumentParser(opaque.NewOpaqueParser, processor.DocumentOpaque)
 }
 
+// SchemaVersion is the version of the predicates the parsers produce. It must
+// be incremented by the changes that make the parsers produce other predicates
+// from the same document, so that the documents recorded in the ingestion
+// ledger are ingested again.
+const SchemaVersion = "1"
+
 var (
 	documentParser = map[processor.DocumentType]func() common.DocumentParser{}
+
+	// the scanners run by scanIdentifiers, replaced in tests
+	purlsVulnScan    = scanner.PurlsVulnScan
+	purlsDepsDevScan = scanner.PurlsDepsDevScan
+	purlsLicenseScan = scanner.PurlsLicenseScan
+	purlsEOLScan     = scanner.PurlsEOLScan
 )
 
 type docTreeBuilder struct {
@@ -82,8 +102,6 @@ func RegisterDocumentParser(p func() common.DocumentParser, d processor.Document
 
 // ParseDocumentTree takes the DocumentTree and create graph inputs (nodes and edges) per document node.
 func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scanForVulns bool, scanForLicense bool, scanForEOL bool, scanForDepsDev bool) ([]assembler.IngestPredicates, []*common.IdentifierStrings, error) {
-	var wg sync.WaitGroup
-
 	assemblerInputs := []assembler.IngestPredicates{}
 	identifierStrings := []*common.IdentifierStrings{}
 	logger := docTree.Document.ChildLogger
@@ -106,25 +124,123 @@ func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scan
 		}
 	}
 
+	// record where the evidence came from, all of it references the root document
+	if len(assemblerInputs) > 0 && docTree.Document.SourceInformation.DocumentRef != "" {
+		assemblerInputs[0].Documents = append(assemblerInputs[0].Documents, documentInput(docTree.Document))
+	}
+
+	if len(assemblerInputs) > 0 {
+		scanIdentifiers(ctx, logger, &assemblerInputs[0], identifierStrings, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
+	}
+
+	return assemblerInputs, identifierStrings, nil
+}
+
+// ChunkFunc receives the chunks of a document parsed by ParseDocumentStream.
+// last is true for the last chunk, which has the HasSBOM predicates of the
+// document.
+type ChunkFunc func(predicates assembler.IngestPredicates, identifierStrings *common.IdentifierStrings, last bool) error
+
+// CanParseStream returns whether the document tree is a single large JSON
+// document, see processor.Document.Large, whose parser can parse it as a
+// stream with ParseDocumentStream.
+func CanParseStream(docTree processor.DocumentTree) bool {
+	doc := docTree.Document
+	if len(docTree.Children) > 0 || doc.Format != processor.FormatJSON || !doc.Large() {
+		return false
+	}
+	pFunc, ok := documentParser[doc.Type]
+	if !ok {
+		return false
+	}
+	_, ok = pFunc().(common.StreamingDocumentParser)
+	return ok
+}
+
+// ParseDocumentStream parses the root document of the tree as a stream, see
+// CanParseStream, and calls fn with each chunk of about chunkSize
+// predicates, so that neither the blob read with Open nor the predicates of
+// a very large document are ever all held in memory. The chunks get the same
+// metadata and scans as the predicates returned by ParseDocumentTree.
+func ParseDocumentStream(ctx context.Context, docTree processor.DocumentTree, chunkSize int, scanForVulns bool, scanForLicense bool, scanForEOL bool, scanForDepsDev bool, fn ChunkFunc) error {
+	doc := docTree.Document
+	logger := doc.ChildLogger
+	pFunc, ok := documentParser[doc.Type]
+	if !ok {
+		return fmt.Errorf("no document parser registered for type: %s", doc.Type)
+	}
+	p, ok := pFunc().(common.StreamingDocumentParser)
+	if !ok {
+		return fmt.Errorf("document parser for type %s cannot parse a stream", doc.Type)
+	}
+	identities := p.GetIdentities(ctx)
+	srcInfo := doc.SourceInformation
+
+	// a chunk is held back until the next one, to know which one is the last
+	var pending *assembler.IngestPredicates
+	var pendingIDs *common.IdentifierStrings
+	first := true
+	send := func(last bool) error {
+		predicates, idStrings := pending, pendingIDs
+		pending, pendingIDs = nil, nil
+		if first && srcInfo.DocumentRef != "" {
+			// record where the evidence came from
+			predicates.Documents = append(predicates.Documents, documentInput(doc))
+		}
+		first = false
+		if last {
+			// same as CreateAssemblerInput, these need the HasSBOM predicates
+			predicates.HasSourceAt = append(predicates.HasSourceAt, common.CreateSourceRevisionHasSourceAt(predicates, srcInfo)...)
+			common.AddSubjectHasSBOM(predicates, srcInfo)
+		}
+		common.AddMetadata(predicates, identities, srcInfo)
+		scanIdentifiers(ctx, logger, predicates, []*common.IdentifierStrings{idStrings}, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
+		return fn(*predicates, idStrings, last)
+	}
+
+	logger.Debugf("parsing document of type %v as a stream", doc.Type)
+	err := p.ParseStream(ctx, doc, chunkSize, func(predicates *assembler.IngestPredicates, idStrings *common.IdentifierStrings) error {
+		if pending != nil {
+			if err := send(false); err != nil {
+				return err
+			}
+		}
+		pending, pendingIDs = predicates, idStrings
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if pending == nil {
+		pending, pendingIDs = &assembler.IngestPredicates{}, &common.IdentifierStrings{}
+	}
+	return send(true)
+}
+
+// scanIdentifiers scans the purls of the identifier strings concurrently with
+// the enabled scanners, and adds what they find to the predicates.
+func scanIdentifiers(ctx context.Context, logger *zap.SugaredLogger, predicates *assembler.IngestPredicates, identifierStrings []*common.IdentifierStrings, scanForVulns bool, scanForLicense bool, scanForEOL bool, scanForDepsDev bool) {
+	var purls []string
+	for _, idString := range identifierStrings {
+		purls = append(purls, idString.PurlStrings...)
+	}
+
+	// each scan has its own results, which are added once all are done
+	var wg sync.WaitGroup
+	var vulns, depsDev, licenses, eolData assembler.IngestPredicates
+
 	if scanForVulns {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			// scan purls via OSV on initial ingestion to capture vulnerability information
-			var purls []string
-			for _, idString := range identifierStrings {
-				purls = append(purls, idString.PurlStrings...)
-			}
-
-			vulnEquals, certVulns, err := scanner.PurlsVulnScan(ctx, purls)
+			vulnEquals, certVulns, err := purlsVulnScan(ctx, purls)
 			if err != nil {
 				logger.Errorf("error scanning purls for vulnerabilities %v", err)
-			} else {
-				if len(assemblerInputs) > 0 {
-					assemblerInputs[0].VulnEqual = append(assemblerInputs[0].VulnEqual, vulnEquals...)
-					assemblerInputs[0].CertifyVuln = append(assemblerInputs[0].CertifyVuln, certVulns...)
-				}
+				return
 			}
+			vulns.VulnEqual = vulnEquals
+			vulns.CertifyVuln = certVulns
 		}()
 	}
 
@@ -133,20 +249,13 @@ func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scan
 		go func() {
 			defer wg.Done()
 			// scan purls via deps.dev on initial ingestion to capture additional deps.dev information
-			var purls []string
-			for _, idString := range identifierStrings {
-				purls = append(purls, idString.PurlStrings...)
-			}
-
-			certScorecard, hasSrcAt, err := scanner.PurlsDepsDevScan(ctx, purls)
+			certScorecard, hasSrcAt, err := purlsDepsDevScan(ctx, purls)
 			if err != nil {
 				logger.Errorf("error scanning purls for vulnerabilities %v", err)
-			} else {
-				if len(assemblerInputs) > 0 {
-					assemblerInputs[0].CertifyScorecard = append(assemblerInputs[0].CertifyScorecard, certScorecard...)
-					assemblerInputs[0].HasSourceAt = append(assemblerInputs[0].HasSourceAt, hasSrcAt...)
-				}
+				return
 			}
+			depsDev.CertifyScorecard = certScorecard
+			depsDev.HasSourceAt = hasSrcAt
 		}()
 	}
 
@@ -155,20 +264,13 @@ func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scan
 		go func() {
 			defer wg.Done()
 			// scan purls via clearly defined on initial ingestion to capture license information
-			var purls []string
-			for _, idString := range identifierStrings {
-				purls = append(purls, idString.PurlStrings...)
-			}
-
-			certLegal, hasSourceAt, err := scanner.PurlsLicenseScan(ctx, purls)
+			certLegal, hasSourceAt, err := purlsLicenseScan(ctx, purls)
 			if err != nil {
 				logger.Errorf("error scanning purls for licenses %v", err)
-			} else {
-				if len(assemblerInputs) > 0 {
-					assemblerInputs[0].CertifyLegal = append(assemblerInputs[0].CertifyLegal, certLegal...)
-					assemblerInputs[0].HasSourceAt = append(assemblerInputs[0].HasSourceAt, hasSourceAt...)
-				}
+				return
 			}
+			licenses.CertifyLegal = certLegal
+			licenses.HasSourceAt = hasSourceAt
 		}()
 	}
 
@@ -177,24 +279,45 @@ func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scan
 		go func() {
 			defer wg.Done()
 			// scrape EOL information from the EOL API
-			var purls []string
-			for _, idString := range identifierStrings {
-				purls = append(purls, idString.PurlStrings...)
-			}
-
-			eolData, err := scanner.PurlsEOLScan(ctx, purls)
+			hasMetadata, err := purlsEOLScan(ctx, purls)
 			if err != nil {
 				logger.Errorf("error scraping purls for EOL information %v", err)
-			} else {
-				if len(assemblerInputs) > 0 {
-					assemblerInputs[0].HasMetadata = eolData
-				}
+				return
 			}
+			eolData.HasMetadata = hasMetadata
 		}()
 	}
 	wg.Wait()
 
-	return assemblerInputs, identifierStrings, nil
+	predicates.VulnEqual = append(predicates.VulnEqual, vulns.VulnEqual...)
+	predicates.CertifyVuln = append(predicates.CertifyVuln, vulns.CertifyVuln...)
+	predicates.CertifyScorecard = append(predicates.CertifyScorecard, depsDev.CertifyScorecard...)
+	predicates.HasSourceAt = append(predicates.HasSourceAt, depsDev.HasSourceAt...)
+	predicates.CertifyLegal = append(predicates.CertifyLegal, licenses.CertifyLegal...)
+	predicates.HasSourceAt = append(predicates.HasSourceAt, licenses.HasSourceAt...)
+	predicates.HasMetadata = append(predicates.HasMetadata, eolData.HasMetadata...)
+}
+
+// documentInput creates the Document node recording the provenance of the
+// ingested document. The document ref is the blob store key, which already
+// carries the sha256 digest of the collected bytes.
+func documentInput(doc *processor.Document) generated.DocumentInputSpec {
+	docRef := doc.SourceInformation.DocumentRef
+	digest, ok := strings.CutPrefix(docRef, "sha256_")
+	if !ok {
+		sum := sha256.Sum256(doc.Blob)
+		digest = hex.EncodeToString(sum[:])
+	}
+	return generated.DocumentInputSpec{
+		BlobKey:       docRef,
+		Sha256:        digest,
+		DocumentType:  string(doc.Type),
+		Format:        string(doc.Format),
+		Collector:     doc.SourceInformation.Collector,
+		Source:        doc.SourceInformation.Source,
+		ParserVersion: version.Version,
+		IngestedAt:    time.Now().UTC(),
+	}
 }
 
 // visitedKey is used to keep track of the document nodes that have already been visited to avoid infinite loops.
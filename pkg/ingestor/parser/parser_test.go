@@ -19,16 +19,19 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/guacsec/guac/pkg/logging"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
 
 	"github.com/guacsec/guac/internal/testing/mocks"
 
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common/scanner"
 	"go.uber.org/mock/gomock"
 )
 
@@ -308,3 +311,61 @@ func TestParseDocumentTree(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDocumentTreeScans(t *testing.T) {
+	logger := logging.FromContext(context.Background())
+	ctrl := gomock.NewController(t)
+	mockDocumentParser := mocks.NewMockDocumentParser(ctrl)
+	ctx := context.Background()
+
+	mockDocumentParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockDocumentParser.EXPECT().GetIdentities(ctx).Return([]common.TrustInformation{}).AnyTimes()
+	mockDocumentParser.EXPECT().GetPredicates(gomock.Any()).Return(&assembler.IngestPredicates{
+		HasSourceAt: []assembler.HasSourceAtIngest{{HasSourceAt: &generated.HasSourceAtInputSpec{Justification: "document"}}},
+		HasMetadata: []assembler.HasMetadataIngest{{HasMetadata: &generated.HasMetadataInputSpec{Key: "document"}}},
+	}).AnyTimes()
+	mockDocumentParser.EXPECT().GetIdentifiers(gomock.Any()).Return(&common.IdentifierStrings{PurlStrings: []string{"pkg:golang/github.com/guacsec/guac@v1.0.0"}}, nil).AnyTimes()
+	_ = RegisterDocumentParser(func() common.DocumentParser { return mockDocumentParser }, "scans")
+
+	purlsVulnScan = func(context.Context, []string) ([]assembler.VulnEqualIngest, []assembler.CertifyVulnIngest, error) {
+		return nil, nil, nil
+	}
+	purlsDepsDevScan = func(context.Context, []string) ([]assembler.CertifyScorecardIngest, []assembler.HasSourceAtIngest, error) {
+		return nil, []assembler.HasSourceAtIngest{{HasSourceAt: &generated.HasSourceAtInputSpec{Justification: "deps.dev"}}}, nil
+	}
+	purlsLicenseScan = func(context.Context, []string) ([]assembler.CertifyLegalIngest, []assembler.HasSourceAtIngest, error) {
+		return nil, []assembler.HasSourceAtIngest{{HasSourceAt: &generated.HasSourceAtInputSpec{Justification: "clearlydefined"}}}, nil
+	}
+	purlsEOLScan = func(context.Context, []string) ([]assembler.HasMetadataIngest, error) {
+		return []assembler.HasMetadataIngest{{HasMetadata: &generated.HasMetadataInputSpec{Key: "eol"}}}, nil
+	}
+	t.Cleanup(func() {
+		purlsVulnScan = scanner.PurlsVulnScan
+		purlsDepsDevScan = scanner.PurlsDepsDevScan
+		purlsLicenseScan = scanner.PurlsLicenseScan
+		purlsEOLScan = scanner.PurlsEOLScan
+	})
+
+	docTree := &processor.DocumentNode{Document: &processor.Document{Type: "scans", ChildLogger: logger}}
+	got, _, err := ParseDocumentTree(ctx, docTree, true, true, true, true)
+	if err != nil {
+		t.Fatalf("ParseDocumentTree() error = %v", err)
+	}
+
+	var sources, metadata []string
+	for _, hsa := range got[0].HasSourceAt {
+		sources = append(sources, hsa.HasSourceAt.Justification)
+	}
+	for _, hm := range got[0].HasMetadata {
+		metadata = append(metadata, hm.HasMetadata.Key)
+	}
+	slices.Sort(sources)
+	slices.Sort(metadata)
+	// the scans add to the predicates of the document, none replaces them
+	if want := []string{"clearlydefined", "deps.dev", "document"}; !reflect.DeepEqual(sources, want) {
+		t.Errorf("HasSourceAt justifications = %v, want %v", sources, want)
+	}
+	if want := []string{"document", "eol"}; !reflect.DeepEqual(metadata, want) {
+		t.Errorf("HasMetadata keys = %v, want %v", metadata, want)
+	}
+}
@@ -0,0 +1,607 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/misc/jsonstream"
+	spdx "github.com/spdx/tools-golang/spdx"
+	spdx_common "github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// streamPackage holds the fields of an SPDX JSON package that are ingested.
+// tools-golang keeps hasFiles private, so the package is not decoded as a
+// spdx.Package.
+type streamPackage struct {
+	SPDXID           spdx_common.ElementID            `json:"SPDXID"`
+	Name             string                           `json:"name"`
+	VersionInfo      string                           `json:"versionInfo"`
+	Checksums        []spdx_common.Checksum           `json:"checksums"`
+	LicenseConcluded string                           `json:"licenseConcluded"`
+	LicenseDeclared  string                           `json:"licenseDeclared"`
+	LicenseComments  string                           `json:"licenseComments"`
+	CopyrightText    string                           `json:"copyrightText"`
+	ExternalRefs     []*spdx.PackageExternalReference `json:"externalRefs"`
+	HasFiles         []spdx_common.DocElementID       `json:"hasFiles"`
+}
+
+// streamFile holds the fields of an SPDX JSON file that are ingested.
+type streamFile struct {
+	SPDXID    spdx_common.ElementID  `json:"SPDXID"`
+	FileName  string                 `json:"fileName"`
+	Checksums []spdx_common.Checksum `json:"checksums"`
+}
+
+// spdxIndex is what the first pass over a streamed document collects, which
+// the predicates of any element may need: the document header, the top level
+// elements and the purls of every package and file, to resolve relationships.
+type spdxIndex struct {
+	namespace          string
+	name               string
+	created            *time.Time
+	licenseListVersion string
+	topLevelIDs        map[string]bool
+	packagePurls       *common.Index[[]string]
+	filePurls          *common.Index[[]string]
+	licenseInLine      map[string]string
+	// contains are the explicit CONTAINS relationships, which tools-golang
+	// does not duplicate from the hasFiles fields
+	contains *common.Index[bool]
+}
+
+func (idx *spdxIndex) close() error {
+	return errors.Join(idx.packagePurls.Close(), idx.filePurls.Close(), idx.contains.Close())
+}
+
+// ParseStream parses an SPDX JSON document in two passes over the reader of
+// its blob. The first one indexes the document and the second one emits the
+// predicates of each package, file and relationship as they are read, so
+// that neither the blob, the decoded document nor its predicates are ever
+// held in memory at once. The purls of the packages and files that resolve
+// the relationships are in a common.Index, which spills to disk. The
+// predicates are the same as the ones of GetPredicates.
+func (s *spdxParser) ParseStream(ctx context.Context, doc *processor.Document, chunkSize int, emit common.EmitFunc) error {
+	if doc.Format != processor.FormatJSON {
+		return fmt.Errorf("unable to stream SPDX document format: %v", doc.Format)
+	}
+	r, err := doc.NewReader()
+	if err != nil {
+		return fmt.Errorf("failed to open SPDX document: %w", err)
+	}
+	idx, err := indexSpdx(r)
+	r.Close()
+	defer idx.close()
+	if err != nil {
+		return fmt.Errorf("failed to parse SPDX document: %w", err)
+	}
+	if idx.created == nil {
+		return fmt.Errorf("SPDX document missing required \"creationInfo\" section")
+	}
+
+	st := &spdxStream{
+		ctx:               ctx,
+		idx:               idx,
+		chunker:           common.NewChunker(chunkSize, emit),
+		topLevelArtifacts: map[string][]*model.ArtifactInputSpec{},
+		lv:                idx.licenseListVersion,
+	}
+	if st.lv == "" {
+		st.lv = "UNKNOWN"
+	}
+	r, err = doc.NewReader()
+	if err != nil {
+		return fmt.Errorf("failed to open SPDX document: %w", err)
+	}
+	defer r.Close()
+	if err := st.emitElements(r); err != nil {
+		return fmt.Errorf("failed to parse SPDX document: %w", err)
+	}
+	if err := st.emitHeuristicDependencies(); err != nil {
+		return err
+	}
+	return st.emitTopLevel(doc)
+}
+
+// indexSpdx is the first pass over a streamed document. The returned index
+// must be closed, even with an error.
+func indexSpdx(r io.Reader) (*spdxIndex, error) {
+	idx := &spdxIndex{
+		topLevelIDs:   map[string]bool{},
+		packagePurls:  common.NewIndex[[]string](),
+		filePurls:     common.NewIndex[[]string](),
+		licenseInLine: map[string]string{},
+		contains:      common.NewIndex[bool](),
+	}
+	dec := json.NewDecoder(r)
+	err := jsonstream.Object(dec, func(key string) error {
+		switch key {
+		case "name":
+			return dec.Decode(&idx.name)
+		case "documentNamespace":
+			return dec.Decode(&idx.namespace)
+		case "creationInfo":
+			var ci *spdx.CreationInfo
+			if err := dec.Decode(&ci); err != nil || ci == nil {
+				return err
+			}
+			created, err := time.Parse(time.RFC3339, ci.Created)
+			if err != nil {
+				return fmt.Errorf("SPDX document had invalid created time %q : %w", ci.Created, err)
+			}
+			idx.created = &created
+			idx.licenseListVersion = ci.LicenseListVersion
+			return nil
+		case "documentDescribes":
+			var ids []spdx_common.DocElementID
+			if err := dec.Decode(&ids); err != nil {
+				return err
+			}
+			for _, id := range ids {
+				if id.ElementRefID != "DOCUMENT" {
+					idx.topLevelIDs[string(id.ElementRefID)] = true
+				}
+			}
+			return nil
+		case "packages":
+			return jsonstream.Array(dec, func() error {
+				var pac streamPackage
+				if err := dec.Decode(&pac); err != nil {
+					return err
+				}
+				return common.AppendIndex(idx.packagePurls, string(pac.SPDXID), pac.purls()...)
+			})
+		case "files":
+			return jsonstream.Array(dec, func() error {
+				var file streamFile
+				if err := dec.Decode(&file); err != nil {
+					return err
+				}
+				var purls []string
+				for _, checksum := range file.Checksums {
+					if isEmptyChecksum(checksum.Value) {
+						continue
+					}
+					purls = append(purls, asmhelpers.GuacFilePurl(strings.ToLower(string(checksum.Algorithm)), checksum.Value, &file.FileName))
+				}
+				if len(purls) == 0 {
+					return nil
+				}
+				return common.AppendIndex(idx.filePurls, string(file.SPDXID), purls...)
+			})
+		case "relationships":
+			return jsonstream.Array(dec, func() error {
+				var r *spdx.Relationship
+				if err := dec.Decode(&r); err != nil || r == nil {
+					return err
+				}
+				switch r.Relationship {
+				case spdx_common.TypeRelationshipContains:
+					if err := idx.contains.Put(containsKey(r.RefA, r.RefB), true); err != nil {
+						return err
+					}
+				case spdx_common.TypeRelationshipContainedBy:
+					if err := idx.contains.Put(containsKey(r.RefB, r.RefA), true); err != nil {
+						return err
+					}
+				}
+				// same rules as getTopLevelSPDXIDs
+				if r.RefA.ElementRefID == r.RefB.ElementRefID {
+					return nil
+				}
+				if r.RefA.ElementRefID == "DOCUMENT" && r.Relationship == spdx_common.TypeRelationshipDescribe {
+					idx.topLevelIDs[string(r.RefB.ElementRefID)] = true
+				} else if r.Relationship == spdx_common.TypeRelationshipDescribeBy && r.RefB.ElementRefID == "DOCUMENT" {
+					idx.topLevelIDs[string(r.RefA.ElementRefID)] = true
+				}
+				return nil
+			})
+		case "hasExtractedLicensingInfos":
+			return jsonstream.Array(dec, func() error {
+				var o spdx.OtherLicense
+				if err := dec.Decode(&o); err != nil {
+					return err
+				}
+				idx.licenseInLine[o.LicenseIdentifier] = o.ExtractedText
+				return nil
+			})
+		default:
+			return jsonstream.Skip(dec)
+		}
+	})
+	if err != nil {
+		return idx, err
+	}
+	return idx, nil
+}
+
+func containsKey(container, contained spdx_common.DocElementID) string {
+	return spdx_common.RenderDocElementID(container) + ">" + spdx_common.RenderDocElementID(contained)
+}
+
+// purls returns the purls of the package, or a GUAC purl if there are none.
+func (p *streamPackage) purls() []string {
+	var purls []string
+	for _, ext := range p.ExternalRefs {
+		if ext != nil && ext.RefType == spdx_common.TypePackageManagerPURL {
+			purls = append(purls, ext.Locator)
+		}
+	}
+	if len(purls) == 0 {
+		purls = append(purls, asmhelpers.GuacPkgPurl(p.Name, &p.VersionInfo))
+	}
+	return purls
+}
+
+// spdxStream is the state of the second pass over a streamed document.
+type spdxStream struct {
+	ctx     context.Context
+	idx     *spdxIndex
+	chunker *common.Chunker
+	lv      string
+
+	topLevelPackages  []*model.PkgInputSpec
+	topLevelArtifacts map[string][]*model.ArtifactInputSpec
+	// topLevelOccurrences are emitted with the HasSBOM predicates, which
+	// may need them
+	topLevelOccurrences []assembler.IsOccurrenceIngest
+}
+
+func (st *spdxStream) emitElements(r io.Reader) error {
+	dec := json.NewDecoder(r)
+	return jsonstream.Object(dec, func(key string) error {
+		switch key {
+		case "packages":
+			return jsonstream.Array(dec, func() error {
+				var pac streamPackage
+				if err := dec.Decode(&pac); err != nil {
+					return err
+				}
+				return st.emitPackage(&pac)
+			})
+		case "files":
+			return jsonstream.Array(dec, func() error {
+				var file streamFile
+				if err := dec.Decode(&file); err != nil {
+					return err
+				}
+				return st.emitFile(&file)
+			})
+		case "relationships":
+			return jsonstream.Array(dec, func() error {
+				var r *spdx.Relationship
+				if err := dec.Decode(&r); err != nil || r == nil {
+					return err
+				}
+				return st.emitRelationship(r)
+			})
+		default:
+			return jsonstream.Skip(dec)
+		}
+	})
+}
+
+func (st *spdxStream) emitPackage(pac *streamPackage) error {
+	preds := st.chunker.Predicates
+	id := string(pac.SPDXID)
+	topLevel := st.idx.topLevelIDs[id]
+	n := 0
+
+	purls := pac.purls()
+	st.chunker.Identifiers.PurlStrings = append(st.chunker.Identifiers.PurlStrings, purls...)
+	pkgs, err := common.PurlsToPkgs(purls)
+	if err != nil {
+		return err
+	}
+	if topLevel {
+		st.topLevelPackages = append(st.topLevelPackages, pkgs...)
+	}
+
+	for _, checksum := range pac.Checksums {
+		art := &model.ArtifactInputSpec{
+			Algorithm: strings.ToLower(string(checksum.Algorithm)),
+			Digest:    checksum.Value,
+		}
+		if topLevel {
+			st.topLevelArtifacts[id] = append(st.topLevelArtifacts[id], art)
+		}
+		for _, pkg := range pkgs {
+			occ := assembler.IsOccurrenceIngest{
+				Pkg:      pkg,
+				Artifact: art,
+				IsOccurrence: &model.IsOccurrenceInputSpec{
+					Justification: "spdx package with checksum",
+				},
+			}
+			if topLevel {
+				st.topLevelOccurrences = append(st.topLevelOccurrences, occ)
+			} else {
+				preds.IsOccurrence = append(preds.IsOccurrence, occ)
+				n++
+			}
+		}
+	}
+
+	if pac.LicenseDeclared != "" || pac.LicenseConcluded != "" || pac.CopyrightText != "" {
+		cl := &model.CertifyLegalInputSpec{
+			DeclaredLicense:   common.FixSPDXLicenseExpression(pac.LicenseDeclared, st.idx.licenseInLine),
+			DiscoveredLicense: common.FixSPDXLicenseExpression(pac.LicenseConcluded, st.idx.licenseInLine),
+			Attribution:       pac.CopyrightText,
+			Justification:     "Found in SPDX document.",
+			TimeScanned:       *st.idx.created,
+		}
+		if pac.LicenseComments != "" {
+			cl.Justification = fmt.Sprintf("%s : %s", cl.Justification, pac.LicenseComments)
+		}
+		dec := common.ParseLicenses(cl.DeclaredLicense, &st.lv, st.idx.licenseInLine)
+		dis := common.ParseLicenses(cl.DiscoveredLicense, &st.lv, st.idx.licenseInLine)
+		for _, pkg := range pkgs {
+			preds.CertifyLegal = append(preds.CertifyLegal, assembler.CertifyLegalIngest{
+				Pkg:          pkg,
+				Declared:     dec,
+				Discovered:   dis,
+				CertifyLegal: cl,
+			})
+			n++
+		}
+	}
+
+	for _, extRef := range pac.ExternalRefs {
+		if extRef == nil || extRef.Category != spdx_common.CategorySecurity {
+			continue
+		}
+		metadataInputSpec := &model.HasMetadataInputSpec{
+			Key:           "cpe",
+			Value:         extRef.Locator,
+			Timestamp:     time.Now().UTC(),
+			Justification: "spdx cpe external reference",
+			Origin:        "GUAC SPDX",
+			Collector:     "GUAC",
+		}
+		for _, pkg := range pkgs {
+			preds.HasMetadata = append(preds.HasMetadata, assembler.HasMetadataIngest{
+				Pkg:          pkg,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				HasMetadata:  metadataInputSpec,
+			})
+			n++
+		}
+	}
+
+	// the files of the package are CONTAINS relationships
+	for _, f := range pac.HasFiles {
+		r := &spdx.Relationship{
+			RefA:         spdx_common.DocElementID{ElementRefID: pac.SPDXID},
+			RefB:         f,
+			Relationship: spdx_common.TypeRelationshipContains,
+		}
+		if ok, err := st.idx.contains.Has(containsKey(r.RefA, r.RefB)); err != nil {
+			return err
+		} else if ok {
+			continue
+		}
+		m, err := st.relationshipDependencies(r)
+		if err != nil {
+			return err
+		}
+		n += m
+	}
+
+	return st.chunker.Added(n)
+}
+
+func (st *spdxStream) emitFile(file *streamFile) error {
+	id := string(file.SPDXID)
+	topLevel := st.idx.topLevelIDs[id]
+
+	var pkgs []*model.PkgInputSpec
+	var arts []*model.ArtifactInputSpec
+	for _, checksum := range file.Checksums {
+		if isEmptyChecksum(checksum.Value) {
+			continue
+		}
+		purl := asmhelpers.GuacFilePurl(strings.ToLower(string(checksum.Algorithm)), checksum.Value, &file.FileName)
+		pkg, err := asmhelpers.PurlToPkg(purl)
+		if err != nil {
+			return err
+		}
+		pkgs = append(pkgs, pkg)
+		arts = append(arts, &model.ArtifactInputSpec{
+			Algorithm: strings.ToLower(string(checksum.Algorithm)),
+			Digest:    checksum.Value,
+		})
+	}
+	if topLevel {
+		st.topLevelPackages = append(st.topLevelPackages, pkgs...)
+		if len(arts) > 0 {
+			st.topLevelArtifacts[id] = append(st.topLevelArtifacts[id], arts...)
+		}
+	}
+
+	n := 0
+	for _, pkg := range pkgs {
+		for _, art := range arts {
+			occ := assembler.IsOccurrenceIngest{
+				Pkg:      pkg,
+				Artifact: art,
+				IsOccurrence: &model.IsOccurrenceInputSpec{
+					Justification: "spdx file with checksum",
+				},
+			}
+			if topLevel {
+				st.topLevelOccurrences = append(st.topLevelOccurrences, occ)
+			} else {
+				st.chunker.Predicates.IsOccurrence = append(st.chunker.Predicates.IsOccurrence, occ)
+				n++
+			}
+		}
+	}
+	return st.chunker.Added(n)
+}
+
+func (st *spdxStream) emitRelationship(r *spdx.Relationship) error {
+	n, err := st.relationshipDependencies(r)
+	if err != nil {
+		return err
+	}
+	return st.chunker.Added(n)
+}
+
+// relationshipDependencies adds the IsDependency predicates of the
+// relationship to the current chunk, the same way GetPredicates does, and
+// returns how many there are.
+func (st *spdxStream) relationshipDependencies(r *spdx.Relationship) (int, error) {
+	var foundId, relatedId string
+	if isDependency(r.Relationship) {
+		foundId = string(r.RefA.ElementRefID)
+		relatedId = string(r.RefB.ElementRefID)
+	} else if isDependent(r.Relationship) || isPackageOf(r.Relationship) {
+		foundId = string(r.RefB.ElementRefID)
+		relatedId = string(r.RefA.ElementRefID)
+	} else {
+		return 0, nil
+	}
+
+	// common.GetIsDep only depends on the first related file, or else package
+	relatedPurls, _, err := st.idx.filePurls.Get(relatedId)
+	if err != nil {
+		return 0, err
+	}
+	if len(relatedPurls) == 0 {
+		if relatedPurls, _, err = st.idx.packagePurls.Get(relatedId); err != nil {
+			return 0, err
+		}
+	}
+	if len(relatedPurls) == 0 {
+		return 0, nil
+	}
+	related, err := asmhelpers.PurlToPkg(relatedPurls[0])
+	if err != nil {
+		return 0, err
+	}
+	foundPurls, _, err := st.idx.packagePurls.Get(foundId)
+	if err != nil {
+		return 0, err
+	}
+	foundFilePurls, _, err := st.idx.filePurls.Get(foundId)
+	if err != nil {
+		return 0, err
+	}
+	found, err := common.PurlsToPkgs(append(foundPurls, foundFilePurls...))
+	if err != nil {
+		return 0, err
+	}
+
+	justification := getJustification(r)
+	for _, pkg := range found {
+		st.chunker.Predicates.IsDependency = append(st.chunker.Predicates.IsDependency, assembler.IsDependencyIngest{
+			Pkg:    pkg,
+			DepPkg: related,
+			IsDependency: &model.IsDependencyInputSpec{
+				DependencyType: model.DependencyTypeUnknown,
+				Justification:  justification,
+			},
+		})
+	}
+	return len(found), nil
+}
+
+// emitHeuristicDependencies connects a GUAC top level package to every package
+// and file, if the document does not describe any of them, the same way as
+// getPackages and GetPredicates.
+func (st *spdxStream) emitHeuristicDependencies() error {
+	if len(st.topLevelPackages) > 0 {
+		return nil
+	}
+	purl := "pkg:guac/spdx/" + asmhelpers.SanitizeString(st.idx.name)
+	topPackage, err := asmhelpers.PurlToPkg(purl)
+	if err != nil {
+		return err
+	}
+	st.topLevelPackages = append(st.topLevelPackages, topPackage)
+	st.chunker.Identifiers.PurlStrings = append(st.chunker.Identifiers.PurlStrings, purl)
+
+	const justification = "top-level package GUAC heuristic connecting to each file/package"
+	for _, purls := range []*common.Index[[]string]{st.idx.packagePurls, st.idx.filePurls} {
+		err := purls.ForEach(func(_ string, ps []string) error {
+			pkgs, err := common.PurlsToPkgs(ps)
+			if err != nil {
+				return err
+			}
+			n := 0
+			for _, pkg := range pkgs {
+				if reflect.DeepEqual(pkg, topPackage) {
+					continue
+				}
+				st.chunker.Predicates.IsDependency = append(st.chunker.Predicates.IsDependency, assembler.IsDependencyIngest{
+					Pkg:    topPackage,
+					DepPkg: pkg,
+					IsDependency: &model.IsDependencyInputSpec{
+						DependencyType: model.DependencyTypeUnknown,
+						Justification:  justification,
+					},
+				})
+				n++
+			}
+			return st.chunker.Added(n)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emitTopLevel emits the last chunk, with the HasSBOM predicates of the top
+// level elements.
+func (st *spdxStream) emitTopLevel(doc *processor.Document) error {
+	logger := logging.FromContext(st.ctx)
+	preds := st.chunker.Predicates
+
+	if len(st.topLevelArtifacts) > 0 {
+		for _, arts := range st.topLevelArtifacts {
+			for _, art := range arts {
+				preds.HasSBOM = append(preds.HasSBOM, common.CreateTopLevelHasSBOMFromArtifact(art, doc, st.idx.namespace, *st.idx.created))
+			}
+		}
+		if len(st.topLevelArtifacts) != len(st.topLevelPackages) {
+			logger.Warnf("Top-level unique artifact count (%d) and top-level package count (%d) are mismatched. SBOM ingestion may not be as expected.",
+				len(st.topLevelArtifacts), len(st.topLevelPackages))
+		}
+	} else {
+		for _, topLevelPkg := range st.topLevelPackages {
+			preds.HasSBOM = append(preds.HasSBOM, common.CreateTopLevelHasSBOMFromPkg(topLevelPkg, doc, st.idx.namespace, *st.idx.created))
+		}
+	}
+	preds.IsOccurrence = append(preds.IsOccurrence, st.topLevelOccurrences...)
+	if err := st.chunker.Added(len(preds.HasSBOM) + len(st.topLevelOccurrences)); err != nil {
+		return err
+	}
+	return st.chunker.Flush()
+}
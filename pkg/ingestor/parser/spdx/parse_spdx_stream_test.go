@@ -0,0 +1,281 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+// syntheticSpdx returns an SPDX JSON document with n packages, each with a
+// file, a cpe, a license and a dependency on the previous package. If
+// describes is false, the document has no top level package.
+func syntheticSpdx(n int, describes bool) []byte {
+	var buf bytes.Buffer
+	if err := writeSyntheticSpdx(&buf, n, describes); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// writeSyntheticSpdx writes the document of syntheticSpdx to w one element at
+// a time, so that it is never held in memory.
+func writeSyntheticSpdx(w io.Writer, n int, describes bool) error {
+	doc := map[string]any{
+		"spdxVersion":       "SPDX-2.3",
+		"dataLicense":       "CC0-1.0",
+		"SPDXID":            "SPDXRef-DOCUMENT",
+		"name":              "synthetic",
+		"documentNamespace": "https://example.com/synthetic",
+		"creationInfo": map[string]any{
+			"created":            "2026-01-01T00:00:00Z",
+			"creators":           []string{"Tool: synthetic"},
+			"licenseListVersion": "3.22",
+		},
+		"hasExtractedLicensingInfos": []map[string]string{
+			{"licenseId": "LicenseRef-Custom", "extractedText": "custom license"},
+		},
+	}
+	if describes && n > 0 {
+		doc["documentDescribes"] = []string{fmt.Sprintf("SPDXRef-Package-%d", n-1)}
+	}
+	header, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	// the arrays are written in the header object, before its closing brace
+	bw.Write(header[:len(header)-1])
+	writeArray := func(key string, from int, element func(i int) any) error {
+		fmt.Fprintf(bw, ",%q:[", key)
+		for i := from; i < n; i++ {
+			if i > from {
+				bw.WriteByte(',')
+			}
+			data, err := json.Marshal(element(i))
+			if err != nil {
+				return err
+			}
+			bw.Write(data)
+		}
+		bw.WriteByte(']')
+		return nil
+	}
+	if err := writeArray("packages", 0, func(i int) any {
+		return map[string]any{
+			"SPDXID":           fmt.Sprintf("SPDXRef-Package-%d", i),
+			"name":             fmt.Sprintf("pkg%d", i),
+			"versionInfo":      "1.0.0",
+			"downloadLocation": "NOASSERTION",
+			"licenseDeclared":  "MIT OR LicenseRef-Custom",
+			"copyrightText":    "NOASSERTION",
+			"checksums": []map[string]string{
+				{"algorithm": "SHA256", "checksumValue": fmt.Sprintf("%064x", i+1)},
+			},
+			"externalRefs": []map[string]string{
+				{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": fmt.Sprintf("pkg:golang/example.com/pkg%d@v1.0.0", i)},
+				{"referenceCategory": "SECURITY", "referenceType": "cpe23Type", "referenceLocator": fmt.Sprintf("cpe:2.3:a:example:pkg%d:1.0.0:*:*:*:*:*:*:*", i)},
+			},
+			"hasFiles": []string{fmt.Sprintf("SPDXRef-File-%d", i)},
+		}
+	}); err != nil {
+		return err
+	}
+	if err := writeArray("files", 0, func(i int) any {
+		return map[string]any{
+			"SPDXID":   fmt.Sprintf("SPDXRef-File-%d", i),
+			"fileName": fmt.Sprintf("/usr/lib/pkg%d.so", i),
+			"checksums": []map[string]string{
+				{"algorithm": "SHA1", "checksumValue": fmt.Sprintf("%040x", i+1)},
+			},
+		}
+	}); err != nil {
+		return err
+	}
+	if err := writeArray("relationships", 1, func(i int) any {
+		return map[string]any{
+			"spdxElementId":      fmt.Sprintf("SPDXRef-Package-%d", i),
+			"relationshipType":   "DEPENDS_ON",
+			"relatedSpdxElement": fmt.Sprintf("SPDXRef-Package-%d", i-1),
+		}
+	}); err != nil {
+		return err
+	}
+	bw.WriteByte('}')
+	return bw.Flush()
+}
+
+func TestParseStream(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		blob []byte
+	}{
+		{name: "alpine", blob: testdata.SpdxExampleAlpine},
+		{name: "big alpine", blob: testdata.SpdxExampleBig},
+		{name: "synthetic", blob: syntheticSpdx(50, true)},
+		{name: "synthetic without top level package", blob: syntheticSpdx(50, false)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &processor.Document{
+				Blob:   tt.blob,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentSPDX,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    "TestSource",
+				},
+			}
+
+			p := NewSpdxParser()
+			if err := p.Parse(ctx, doc); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			want := p.GetPredicates(ctx)
+			wantIDs, _ := p.GetIdentifiers(ctx)
+
+			var chunks []*assembler.IngestPredicates
+			var purls []string
+			emit := func(preds *assembler.IngestPredicates, ids *common.IdentifierStrings) error {
+				if len(chunks) > 0 && len(chunks[len(chunks)-1].HasSBOM) > 0 {
+					t.Errorf("HasSBOM emitted before the last chunk")
+				}
+				chunks = append(chunks, preds)
+				purls = append(purls, ids.PurlStrings...)
+				return nil
+			}
+			sp := NewSpdxParser().(common.StreamingDocumentParser)
+			if err := sp.ParseStream(ctx, doc, 100, emit); err != nil {
+				t.Fatalf("ParseStream() error = %v", err)
+			}
+			got := testdata.MergeChunks(chunks...)
+			if diff := cmp.Diff(testdata.MergeChunks(want), got, testdata.StreamCmpOpts...); diff != "" {
+				t.Errorf("streamed predicates differ (-want +got):\n%s", diff)
+			}
+			slices.Sort(purls)
+			purls = slices.Compact(purls)
+			slices.Sort(wantIDs.PurlStrings)
+			if diff := cmp.Diff(wantIDs.PurlStrings, purls); diff != "" {
+				t.Errorf("streamed purls differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestParseStreamMemory checks that ParseStream fits in a fixed memory
+// budget, on a generated document several times larger than the budget,
+// which is read with Open and never held in memory. Neither its blob nor the
+// index, which spills to disk, may be held at once.
+func TestParseStreamMemory(t *testing.T) {
+	const budget = 16 << 20
+	defer func(n int) { common.IndexMemoryEntries = n }(common.IndexMemoryEntries)
+	common.IndexMemoryEntries = 1000
+
+	ctx := context.Background()
+	write := func(w io.Writer) error {
+		return writeSyntheticSpdx(w, 80000, true)
+	}
+	size, err := testdata.WrittenSize(write)
+	if err != nil {
+		t.Fatalf("unable to generate document: %v", err)
+	}
+	if size < 4*budget {
+		t.Fatalf("generated document of %d bytes, want at least %d for the budget to matter", size, 4*budget)
+	}
+	doc := &processor.Document{Open: testdata.OpenWriter(write), Format: processor.FormatJSON, Type: processor.DocumentSPDX}
+	base := testdata.LiveHeap()
+
+	var peak uint64
+	emit := func(preds *assembler.IngestPredicates, ids *common.IdentifierStrings) error {
+		// the chunk is held until it is assembled
+		peak = max(peak, testdata.LiveHeap())
+		runtime.KeepAlive(preds)
+		runtime.KeepAlive(ids)
+		return nil
+	}
+	if err := NewSpdxParser().(common.StreamingDocumentParser).ParseStream(ctx, doc, 5000, emit); err != nil {
+		t.Fatalf("ParseStream() error = %v", err)
+	}
+	if peak < base {
+		peak = base
+	}
+	if peak-base > budget {
+		t.Errorf("ParseStream() held %d bytes of a document of %d bytes, want at most %d", peak-base, size, budget)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	ctx := context.Background()
+	for _, n := range []int{1000, 10000} {
+		doc := &processor.Document{Blob: syntheticSpdx(n, true), Format: processor.FormatJSON, Type: processor.DocumentSPDX}
+		b.Run(fmt.Sprintf("packages=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(doc.Blob)))
+			var peak uint64
+			for i := 0; i < b.N; i++ {
+				p := NewSpdxParser()
+				if err := p.Parse(ctx, doc); err != nil {
+					b.Fatal(err)
+				}
+				preds := p.GetPredicates(ctx)
+				peak = max(peak, testdata.LiveHeap())
+				runtime.KeepAlive(preds)
+			}
+			b.ReportMetric(float64(peak), "peak-heap-B")
+		})
+	}
+}
+
+func BenchmarkParseStream(b *testing.B) {
+	ctx := context.Background()
+	for _, n := range []int{1000, 10000} {
+		doc := &processor.Document{Blob: syntheticSpdx(n, true), Format: processor.FormatJSON, Type: processor.DocumentSPDX}
+		b.Run(fmt.Sprintf("packages=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(doc.Blob)))
+			var peak uint64
+			emit := func(preds *assembler.IngestPredicates, ids *common.IdentifierStrings) error {
+				// the chunk is held until it is assembled
+				peak = max(peak, testdata.LiveHeap())
+				runtime.KeepAlive(preds)
+				runtime.KeepAlive(ids)
+				return nil
+			}
+			for i := 0; i < b.N; i++ {
+				p := NewSpdxParser().(common.StreamingDocumentParser)
+				if err := p.ParseStream(ctx, doc, 5000, emit); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(peak), "peak-heap-B")
+		})
+	}
+}
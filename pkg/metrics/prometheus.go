@@ -23,6 +23,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -274,6 +275,13 @@ func (rec *statusRecorder) WriteHeader(statusCode int) {
 // MeasureGraphQLResponseDuration creates a middleware that records the response time and status code
 func (pc *prometheusCollector) MeasureGraphQLResponseDuration(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Websocket upgrades carry no operation and last as long as their
+		// subscriptions, so they are not measured.
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		start := time.Now()
 
 		// Create a copy of the request body
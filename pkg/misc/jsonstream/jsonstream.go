@@ -0,0 +1,145 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonstream walks JSON documents token by token, decoding only the
+// values that are needed, so that very large documents can be read without
+// unmarshalling them all at once.
+package jsonstream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// errDone stops the walk of StringFields once all the keys are found.
+var errDone = errors.New("done")
+
+// Object reads a JSON object from dec and calls fn with each of its keys. fn
+// must read the value of the key from dec, with dec.Decode, Skip, Object or
+// Array. A null value is read as an empty object.
+func Object(dec *json.Decoder, fn func(key string) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("expected a JSON object, got %v", tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("expected a JSON object key, got %v", tok)
+		}
+		if err := fn(key); err != nil {
+			return err
+		}
+	}
+	// closing '}'
+	_, err = dec.Token()
+	return err
+}
+
+// Array reads a JSON array from dec and calls fn for each of its elements. fn
+// must read the element from dec, with dec.Decode, Skip, Object or Array. A
+// null value is read as an empty array.
+func Array(dec *json.Decoder, fn func() error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('[') {
+		return fmt.Errorf("expected a JSON array, got %v", tok)
+	}
+	for dec.More() {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	// closing ']'
+	_, err = dec.Token()
+	return err
+}
+
+// Skip reads the next JSON value from dec without keeping it.
+func Skip(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// Valid returns whether r holds a single valid JSON value, which is read as
+// a stream.
+func Valid(r io.Reader) bool {
+	dec := json.NewDecoder(r)
+	if err := Skip(dec); err != nil {
+		return false
+	}
+	_, err := dec.Token()
+	return errors.Is(err, io.EOF)
+}
+
+// StringFields returns the string values of the given keys of the top level
+// JSON object read from r. It stops reading as soon as all the keys are
+// found. Keys that are missing or that are not strings are not returned.
+func StringFields(r io.Reader, keys ...string) (map[string]string, error) {
+	fields := map[string]string{}
+	dec := json.NewDecoder(r)
+	err := Object(dec, func(key string) error {
+		if !slices.Contains(keys, key) {
+			return Skip(dec)
+		}
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		if s, ok := v.(string); ok {
+			fields[key] = s
+		}
+		if len(fields) == len(keys) {
+			return errDone
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDone) {
+		return nil, err
+	}
+	return fields, nil
+}
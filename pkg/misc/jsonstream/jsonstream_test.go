@@ -0,0 +1,111 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonstream
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestObjectAndArray(t *testing.T) {
+	doc := `{
+		"skipped": {"a": [1, {"b": null}], "c": "d"},
+		"items": [{"name": "x"}, {"name": "y", "extra": [true]}],
+		"empty": null,
+		"last": "z"
+	}`
+	dec := json.NewDecoder(strings.NewReader(doc))
+	var names []string
+	var last string
+	err := Object(dec, func(key string) error {
+		switch key {
+		case "items":
+			return Array(dec, func() error {
+				var item struct{ Name string }
+				if err := dec.Decode(&item); err != nil {
+					return err
+				}
+				names = append(names, item.Name)
+				return nil
+			})
+		case "empty":
+			return Array(dec, func() error {
+				t.Errorf("unexpected element in null array")
+				return Skip(dec)
+			})
+		case "last":
+			return dec.Decode(&last)
+		default:
+			return Skip(dec)
+		}
+	})
+	if err != nil {
+		t.Fatalf("Object() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"x", "y"}, names); diff != "" {
+		t.Errorf("unexpected names (-want +got):\n%s", diff)
+	}
+	if last != "z" {
+		t.Errorf("last = %q, want z", last)
+	}
+}
+
+func TestObjectErrors(t *testing.T) {
+	for _, doc := range []string{`[1]`, `{"a": [1, 2}`, `{"a": `} {
+		dec := json.NewDecoder(strings.NewReader(doc))
+		if err := Object(dec, func(string) error { return Skip(dec) }); err == nil {
+			t.Errorf("Object(%q) expected an error", doc)
+		}
+	}
+}
+
+func TestStringFields(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		keys []string
+		want map[string]string
+	}{{
+		name: "found",
+		doc:  `{"bomFormat": "CycloneDX", "components": [{"name": "a"}], "specVersion": "1.5"}`,
+		keys: []string{"bomFormat", "specVersion"},
+		want: map[string]string{"bomFormat": "CycloneDX", "specVersion": "1.5"},
+	}, {
+		name: "stops once found",
+		doc:  `{"bomFormat": "CycloneDX", "components": [`,
+		keys: []string{"bomFormat"},
+		want: map[string]string{"bomFormat": "CycloneDX"},
+	}, {
+		name: "missing and not strings",
+		doc:  `{"spdxVersion": 2, "name": "doc"}`,
+		keys: []string{"spdxVersion", "documentNamespace"},
+		want: map[string]string{},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringFields(strings.NewReader(tt.doc), tt.keys...)
+			if err != nil {
+				t.Fatalf("StringFields() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected fields (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
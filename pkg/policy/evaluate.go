@@ -0,0 +1,298 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/subject"
+)
+
+// ErrSubjectNotFound is returned by Evaluate when the subject is not in the
+// graph.
+var ErrSubjectNotFound = subject.ErrSubjectNotFound
+
+// Subject is the package or artifact that a policy is evaluated against.
+type Subject = subject.Subject
+
+// pkgVersion is a package version in the scope of an evaluation.
+type pkgVersion struct {
+	id        string
+	purl      string
+	pkgType   string
+	namespace string
+	name      string
+}
+
+// artifact is an artifact in the scope of an evaluation.
+type artifact struct {
+	id     string
+	digest string
+}
+
+// scope holds the software that the rules of a policy check.
+type scope struct {
+	packages  []pkgVersion
+	artifacts []artifact
+}
+
+// evaluator caches the scopes of a subject while evaluating a policy.
+type evaluator struct {
+	gqlClient  graphql.Client
+	subject    Subject
+	direct     *scope
+	transitive *scope
+}
+
+// Evaluate evaluates all the rules of p against subject, querying the graph
+// through gqlClient.
+func Evaluate(ctx context.Context, gqlClient graphql.Client, p *Policy, subject Subject) (*Result, error) {
+	if err := subject.Validate(); err != nil {
+		return nil, err
+	}
+	e := &evaluator{gqlClient: gqlClient, subject: subject}
+
+	result := &Result{
+		Policy:  p.Name,
+		Subject: subject.String(),
+		Pass:    true,
+		Rules:   []RuleResult{},
+	}
+	for _, r := range p.Rules {
+		s, err := e.scope(ctx, r.Transitive)
+		if err != nil {
+			return nil, err
+		}
+		violations, err := evaluateRule(ctx, gqlClient, r, s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate rule %q: %w", r.Name, err)
+		}
+		rr := RuleResult{
+			Rule:       r.Name,
+			Type:       r.Type,
+			Pass:       len(violations) == 0,
+			Violations: violations,
+		}
+		if rr.Violations == nil {
+			rr.Violations = []Violation{}
+		}
+		result.Pass = result.Pass && rr.Pass
+		result.Rules = append(result.Rules, rr)
+	}
+	return result, nil
+}
+
+func evaluateRule(ctx context.Context, gqlClient graphql.Client, r Rule, s *scope) ([]Violation, error) {
+	switch r.Type {
+	case RuleNoCertifyBad:
+		return evalNoCertifyBad(ctx, gqlClient, s)
+	case RuleMinScorecard:
+		return evalMinScorecard(ctx, gqlClient, r, s)
+	case RuleSLSABuildLevel:
+		return evalSLSABuildLevel(ctx, gqlClient, r, s)
+	case RuleDeniedLicenses:
+		return evalDeniedLicenses(ctx, gqlClient, r, s)
+	default:
+		return nil, fmt.Errorf("unknown rule type %q", r.Type)
+	}
+}
+
+// scope returns the packages and artifacts of the subject and, if
+// transitive is set, of all its transitive dependencies.
+func (e *evaluator) scope(ctx context.Context, transitive bool) (*scope, error) {
+	if e.direct == nil {
+		s, err := e.subjectScope(ctx)
+		if err != nil {
+			return nil, err
+		}
+		e.direct = s
+	}
+	if !transitive {
+		return e.direct, nil
+	}
+	if e.transitive == nil {
+		s, err := e.dependencyScope(ctx, e.direct)
+		if err != nil {
+			return nil, err
+		}
+		e.transitive = s
+	}
+	return e.transitive, nil
+}
+
+// subjectScope finds the subject in the graph, together with the packages or
+// artifacts that it is an occurrence of.
+func (e *evaluator) subjectScope(ctx context.Context) (*scope, error) {
+	resolved, err := subject.Resolve(ctx, e.gqlClient, e.subject)
+	if err != nil {
+		return nil, err
+	}
+	s := &scope{}
+	if len(resolved.Packages) > 0 {
+		for _, p := range resolved.Packages {
+			s.packages = append(s.packages, pkgVersions(p)...)
+		}
+		for _, p := range s.packages {
+			arts, err := artifactsOfPackage(ctx, e.gqlClient, p.id)
+			if err != nil {
+				return nil, err
+			}
+			s.artifacts = append(s.artifacts, arts...)
+		}
+		return s, nil
+	}
+
+	for _, a := range resolved.Artifacts {
+		s.artifacts = append(s.artifacts, artifact{id: a.Id, digest: a.Algorithm + ":" + a.Digest})
+		pkgs, err := packagesOfArtifact(ctx, e.gqlClient, a.Id)
+		if err != nil {
+			return nil, err
+		}
+		s.packages = append(s.packages, pkgs...)
+	}
+	return s, nil
+}
+
+// dependencyScope extends direct with all the package versions that are
+// transitively reachable over IsDependency, and their artifacts.
+func (e *evaluator) dependencyScope(ctx context.Context, direct *scope) (*scope, error) {
+	s := &scope{}
+	seenPkgs := map[string]bool{}
+	seenArts := map[string]bool{}
+	for _, a := range direct.artifacts {
+		seenArts[a.id] = true
+		s.artifacts = append(s.artifacts, a)
+	}
+
+	queue := append([]pkgVersion{}, direct.packages...)
+	for _, p := range queue {
+		seenPkgs[p.id] = true
+	}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		s.packages = append(s.packages, p)
+
+		resp, err := model.Dependencies(ctx, e.gqlClient, model.IsDependencySpec{
+			Package: &model.PkgSpec{Id: &p.id},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query dependencies of %s: %w", p.purl, err)
+		}
+		for _, dep := range resp.IsDependency {
+			for _, v := range pkgVersions(dep.DependencyPackage.AllPkgTree) {
+				if seenPkgs[v.id] {
+					continue
+				}
+				seenPkgs[v.id] = true
+				queue = append(queue, v)
+
+				arts, err := artifactsOfPackage(ctx, e.gqlClient, v.id)
+				if err != nil {
+					return nil, err
+				}
+				for _, a := range arts {
+					if !seenArts[a.id] {
+						seenArts[a.id] = true
+						s.artifacts = append(s.artifacts, a)
+					}
+				}
+			}
+		}
+	}
+	return s, nil
+}
+
+func artifactsOfPackage(ctx context.Context, gqlClient graphql.Client, versionID string) ([]artifact, error) {
+	resp, err := model.Occurrences(ctx, gqlClient, model.IsOccurrenceSpec{
+		Subject: &model.PackageOrSourceSpec{Package: &model.PkgSpec{Id: &versionID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query occurrences: %w", err)
+	}
+	var arts []artifact
+	for _, occ := range resp.IsOccurrence {
+		arts = append(arts, artifact{id: occ.Artifact.Id, digest: occ.Artifact.Algorithm + ":" + occ.Artifact.Digest})
+	}
+	return arts, nil
+}
+
+func packagesOfArtifact(ctx context.Context, gqlClient graphql.Client, artifactID string) ([]pkgVersion, error) {
+	resp, err := model.Occurrences(ctx, gqlClient, model.IsOccurrenceSpec{
+		Artifact: &model.ArtifactSpec{Id: &artifactID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query occurrences: %w", err)
+	}
+	var pkgs []pkgVersion
+	for _, occ := range resp.IsOccurrence {
+		if p, ok := occ.Subject.(*model.AllIsOccurrencesTreeSubjectPackage); ok {
+			pkgs = append(pkgs, pkgVersions(p.AllPkgTree)...)
+		}
+	}
+	return pkgs, nil
+}
+
+// pkgVersions returns all the package versions in a package tree.
+func pkgVersions(tree model.AllPkgTree) []pkgVersion {
+	var versions []pkgVersion
+	for _, ns := range tree.Namespaces {
+		for _, name := range ns.Names {
+			for _, v := range name.Versions {
+				versions = append(versions, pkgVersion{
+					id:        v.Id,
+					purl:      v.Purl,
+					pkgType:   tree.Type,
+					namespace: ns.Namespace,
+					name:      name.Name,
+				})
+			}
+		}
+	}
+	return versions
+}
+
+// pkgNameFilter returns a filter matching the package name of p, so that
+// attestations on the package name (for all versions) are also found.
+func pkgNameFilter(p pkgVersion) *model.PkgSpec {
+	return &model.PkgSpec{
+		Type:      &p.pkgType,
+		Namespace: &p.namespace,
+		Name:      &p.name,
+	}
+}
+
+// appliesTo returns whether an attestation on the package tree applies to the
+// package version p: either the tree is for p itself, or it has no version and
+// the attestation is for all versions.
+func appliesTo(tree model.AllPkgTree, p pkgVersion) bool {
+	for _, ns := range tree.Namespaces {
+		for _, name := range ns.Names {
+			if len(name.Versions) == 0 {
+				return true
+			}
+			for _, v := range name.Versions {
+				if v.Id == p.id {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
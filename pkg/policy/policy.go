@@ -0,0 +1,187 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy evaluates declarative rules against the facts in the GUAC
+// graph for a package or an artifact. Each rule denies the subject when the
+// graph contains a violation, and the result of an evaluation lists the IDs
+// of the nodes that caused each violation.
+//
+// Policies are written in YAML (or JSON), for example:
+//
+//	name: release-gate
+//	rules:
+//	  - name: no-bad-dependencies
+//	    type: noCertifyBad
+//	    transitive: true
+//	  - name: scorecard
+//	    type: minScorecard
+//	    minScore: 5
+//	  - name: slsa
+//	    type: slsaBuildLevel
+//	    minBuildLevel: 2
+//	  - name: no-copyleft
+//	    type: deniedLicenses
+//	    licenses: ["GPL-*", "AGPL-*", "LGPL-*"]
+//	    transitive: true
+package policy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RuleType is the kind of check that a rule performs.
+type RuleType string
+
+const (
+	// RuleNoCertifyBad denies the subject if a CertifyBad is attached to it.
+	RuleNoCertifyBad RuleType = "noCertifyBad"
+	// RuleMinScorecard denies the subject if the source of a package has no
+	// scorecard, or its latest scorecard has an aggregate score below
+	// MinScore.
+	RuleMinScorecard RuleType = "minScorecard"
+	// RuleSLSABuildLevel denies the subject if an artifact has no HasSLSA
+	// attestation with a build level of at least MinBuildLevel.
+	RuleSLSABuildLevel RuleType = "slsaBuildLevel"
+	// RuleDeniedLicenses denies the subject if a CertifyLegal declares or
+	// discovers a license that matches one of Licenses.
+	RuleDeniedLicenses RuleType = "deniedLicenses"
+)
+
+// defaultBuildLevelKey is the SLSA predicate key used to find the build level
+// of an attestation when a rule does not set BuildLevelKey.
+const defaultBuildLevelKey = "buildLevel"
+
+// Policy is a named set of rules. A subject passes the policy if it passes
+// all of the rules.
+type Policy struct {
+	Name  string `yaml:"name" json:"name"`
+	Rules []Rule `yaml:"rules" json:"rules"`
+}
+
+// Rule is a single check of a policy. Only the parameters of the rule Type
+// are used.
+type Rule struct {
+	Name        string   `yaml:"name" json:"name"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	Type        RuleType `yaml:"type" json:"type"`
+	// Transitive extends the check from the subject to all of its transitive
+	// dependencies.
+	Transitive bool `yaml:"transitive,omitempty" json:"transitive,omitempty"`
+
+	// MinScore is the minimum aggregate scorecard score, for minScorecard.
+	MinScore float64 `yaml:"minScore,omitempty" json:"minScore,omitempty"`
+	// MinBuildLevel is the minimum SLSA build level, for slsaBuildLevel.
+	MinBuildLevel int `yaml:"minBuildLevel,omitempty" json:"minBuildLevel,omitempty"`
+	// BuildLevelKey is the SLSA predicate key holding the build level, for
+	// slsaBuildLevel. Keys ending in "."+BuildLevelKey also match. Defaults to
+	// "buildLevel".
+	BuildLevelKey string `yaml:"buildLevelKey,omitempty" json:"buildLevelKey,omitempty"`
+	// Licenses are the denied license names, for deniedLicenses. Shell
+	// patterns such as "GPL-*" are supported and matching ignores case.
+	Licenses []string `yaml:"licenses,omitempty" json:"licenses,omitempty"`
+}
+
+// Result is the outcome of evaluating a policy against a subject.
+type Result struct {
+	Policy  string       `json:"policy"`
+	Subject string       `json:"subject"`
+	Pass    bool         `json:"pass"`
+	Rules   []RuleResult `json:"rules"`
+}
+
+// RuleResult is the outcome of evaluating a single rule.
+type RuleResult struct {
+	Rule       string      `json:"rule"`
+	Type       RuleType    `json:"type"`
+	Pass       bool        `json:"pass"`
+	Violations []Violation `json:"violations"`
+}
+
+// Violation describes why a rule failed. Evidence holds the IDs of the graph
+// nodes that caused the violation.
+type Violation struct {
+	Message  string   `json:"message"`
+	Evidence []string `json:"evidence"`
+}
+
+// Parse reads a policy from YAML or JSON and validates it.
+func Parse(data []byte) (*Policy, error) {
+	var p Policy
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Load reads and validates the policy in the file at path.
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+	return Parse(data)
+}
+
+// Validate checks that all rules are well formed.
+func (p *Policy) Validate() error {
+	if len(p.Rules) == 0 {
+		return errors.New("policy has no rules")
+	}
+	names := map[string]bool{}
+	for i, r := range p.Rules {
+		if r.Name == "" {
+			return fmt.Errorf("rule %d has no name", i)
+		}
+		if names[r.Name] {
+			return fmt.Errorf("rule name %q is used more than once", r.Name)
+		}
+		names[r.Name] = true
+
+		switch r.Type {
+		case RuleNoCertifyBad:
+		case RuleMinScorecard:
+			if r.MinScore < 0 || r.MinScore > 10 {
+				return fmt.Errorf("rule %q: minScore must be between 0 and 10, got %v", r.Name, r.MinScore)
+			}
+		case RuleSLSABuildLevel:
+			if r.MinBuildLevel < 0 {
+				return fmt.Errorf("rule %q: minBuildLevel must not be negative, got %d", r.Name, r.MinBuildLevel)
+			}
+		case RuleDeniedLicenses:
+			if len(r.Licenses) == 0 {
+				return fmt.Errorf("rule %q: licenses must not be empty", r.Name)
+			}
+			for _, l := range r.Licenses {
+				if _, err := path.Match(l, ""); err != nil {
+					return fmt.Errorf("rule %q: invalid license pattern %q: %w", r.Name, l, err)
+				}
+			}
+		default:
+			return fmt.Errorf("rule %q: unknown rule type %q", r.Name, r.Type)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	gql "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *policy.Policy
+		wantErr bool
+	}{
+		{
+			name: "yaml",
+			data: `
+name: gate
+rules:
+  - name: bad
+    type: noCertifyBad
+    transitive: true
+  - name: licenses
+    type: deniedLicenses
+    licenses: ["GPL-*"]
+`,
+			want: &policy.Policy{
+				Name: "gate",
+				Rules: []policy.Rule{
+					{Name: "bad", Type: policy.RuleNoCertifyBad, Transitive: true},
+					{Name: "licenses", Type: policy.RuleDeniedLicenses, Licenses: []string{"GPL-*"}},
+				},
+			},
+		},
+		{
+			name: "json",
+			data: `{"name": "gate", "rules": [{"name": "sc", "type": "minScorecard", "minScore": 5}]}`,
+			want: &policy.Policy{
+				Name:  "gate",
+				Rules: []policy.Rule{{Name: "sc", Type: policy.RuleMinScorecard, MinScore: 5}},
+			},
+		},
+		{
+			name:    "no rules",
+			data:    `name: gate`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			data:    `rules: [{name: a, type: allowEverything}]`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    `rules: [{name: a, type: noCertifyBad, minScroe: 5}]`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate names",
+			data:    `rules: [{name: a, type: noCertifyBad}, {name: a, type: noCertifyBad}]`,
+			wantErr: true,
+		},
+		{
+			name:    "score out of range",
+			data:    `rules: [{name: a, type: minScorecard, minScore: 11}]`,
+			wantErr: true,
+		},
+		{
+			name:    "no licenses",
+			data:    `rules: [{name: a, type: deniedLicenses}]`,
+			wantErr: true,
+		},
+		{
+			name:    "bad license pattern",
+			data:    `rules: [{name: a, type: deniedLicenses, licenses: ["GPL-["]}]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policy.Parse([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	data := GuacData{
+		Packages:  []string{"pkg:guac/app@1.0", "pkg:guac/lib@1.0", "pkg:guac/leaf@1.0"},
+		Artifacts: []string{"app-digest", "lib-digest", "material-digest"},
+		Sources:   []string{"app-src", "lib-src"},
+		Builders:  []string{"test-builder"},
+		IsDependencies: []IsDependency{
+			{DependentPkg: "pkg:guac/app@1.0", DependencyPkg: "pkg:guac/lib@1.0"},
+			{DependentPkg: "pkg:guac/lib@1.0", DependencyPkg: "pkg:guac/leaf@1.0"},
+		},
+		IsOccurrences: []IsOccurrence{
+			{Subject: "pkg:guac/app@1.0", Artifact: "app-digest"},
+			{Subject: "pkg:guac/lib@1.0", Artifact: "lib-digest"},
+		},
+		HasSlsas: []HasSlsa{
+			{Subject: "app-digest", BuiltFrom: []string{"material-digest"}, BuiltBy: "test-builder", Spec: &gql.SLSAInputSpec{
+				BuildType:     "test-build-type",
+				SlsaVersion:   "v1",
+				SlsaPredicate: []gql.SLSAPredicateInputSpec{{Key: "slsa.buildLevel", Value: "3"}},
+			}},
+		},
+		CertifyBads:  []CertifyBad{{Subject: "pkg:guac/leaf@1.0"}},
+		HasSourceAts: []HasSourceAt{{Package: "pkg:guac/app@1.0", Source: "app-src"}, {Package: "pkg:guac/lib@1.0", Source: "lib-src"}},
+		Scorecards:   []Scorecard{{Source: "app-src", AggregateScore: 7.5}, {Source: "lib-src", AggregateScore: 3}},
+		CertifyLegals: []CertifyLegal{
+			{Package: "pkg:guac/app@1.0", DeclaredLicenses: []string{"Apache-2.0"}},
+			{Package: "pkg:guac/leaf@1.0", DeclaredLicenses: []string{"GPL-3.0-only"}},
+		},
+	}
+
+	type ruleOutcome struct {
+		pass       bool
+		violations int
+	}
+	tests := []struct {
+		name    string
+		rule    policy.Rule
+		subject policy.Subject
+		want    ruleOutcome
+	}{
+		{
+			name:    "no bad on subject",
+			rule:    policy.Rule{Name: "r", Type: policy.RuleNoCertifyBad},
+			subject: policy.Subject{Purl: "pkg:guac/app@1.0"},
+			want:    ruleOutcome{pass: true},
+		},
+		{
+			name:    "bad transitive dependency",
+			rule:    policy.Rule{Name: "r", Type: policy.RuleNoCertifyBad, Transitive: true},
+			subject: policy.Subject{Purl: "pkg:guac/app@1.0"},
+			want:    ruleOutcome{pass: false, violations: 1},
+		},
+		{
+			name:    "scorecard of subject",
+			rule:    policy.Rule{Name: "r", Type: policy.RuleMinScorecard, MinScore: 5},
+			subject: policy.Subject{Purl: "pkg:guac/app@1.0"},
+			want:    ruleOutcome{pass: true},
+		},
+		{
+			name:    "low scorecard of dependency",
+			rule:    policy.Rule{Name: "r", Type: policy.RuleMinScorecard, MinScore: 5, Transitive: true},
+			subject: policy.Subject{Purl: "pkg:guac/app@1.0"},
+			want:    ruleOutcome{pass: false, violations: 1},
+		},
+		{
+			name:    "slsa build level of artifact",
+			rule:    policy.Rule{Name: "r", Type: policy.RuleSLSABuildLevel, MinBuildLevel: 2},
+			subject: policy.Subject{Digest: "sha256:app-digest"},
+			want:    ruleOutcome{pass: true},
+		},
+		{
+			name:    "slsa build level too low",
+			rule:    policy.Rule{Name: "r", Type: policy.RuleSLSABuildLevel, MinBuildLevel: 4},
+			subject: policy.Subject{Digest: "app-digest"},
+			want:    ruleOutcome{pass: false, violations: 1},
+		},
+		{
+			name:    "dependency artifact without slsa",
+			rule:    policy.Rule{Name: "r", Type: policy.RuleSLSABuildLevel, Transitive: true},
+			subject: policy.Subject{Purl: "pkg:guac/app@1.0"},
+			want:    ruleOutcome{pass: false, violations: 1},
+		},
+		{
+			name:    "allowed license",
+			rule:    policy.Rule{Name: "r", Type: policy.RuleDeniedLicenses, Licenses: []string{"gpl-*"}},
+			subject: policy.Subject{Purl: "pkg:guac/app@1.0"},
+			want:    ruleOutcome{pass: true},
+		},
+		{
+			name:    "copyleft transitive dependency",
+			rule:    policy.Rule{Name: "r", Type: policy.RuleDeniedLicenses, Licenses: []string{"gpl-*"}, Transitive: true},
+			subject: policy.Subject{Digest: "app-digest"},
+			want:    ruleOutcome{pass: false, violations: 1},
+		},
+	}
+
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, data)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &policy.Policy{Name: "test", Rules: []policy.Rule{tt.rule}}
+			res, err := policy.Evaluate(ctx, gqlClient, p, tt.subject)
+			if err != nil {
+				t.Fatalf("Evaluate() returned unexpected error: %v", err)
+			}
+			if res.Pass != tt.want.pass || res.Rules[0].Pass != tt.want.pass {
+				t.Errorf("Evaluate() pass = %v, want %v: %+v", res.Pass, tt.want.pass, res.Rules[0].Violations)
+			}
+			if len(res.Rules[0].Violations) != tt.want.violations {
+				t.Errorf("Evaluate() returned %d violations, want %d: %+v", len(res.Rules[0].Violations), tt.want.violations, res.Rules[0].Violations)
+			}
+			for _, v := range res.Rules[0].Violations {
+				if len(v.Evidence) == 0 {
+					t.Errorf("violation %q has no evidence", v.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestEvaluate_SubjectNotFound(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	p := &policy.Policy{Name: "test", Rules: []policy.Rule{{Name: "r", Type: policy.RuleNoCertifyBad}}}
+
+	_, err := policy.Evaluate(ctx, gqlClient, p, policy.Subject{Purl: "pkg:guac/missing@1.0"})
+	if !errors.Is(err, policy.ErrSubjectNotFound) {
+		t.Errorf("Evaluate() error = %v, want %v", err, policy.ErrSubjectNotFound)
+	}
+}
@@ -0,0 +1,240 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+func evalNoCertifyBad(ctx context.Context, gqlClient graphql.Client, s *scope) ([]Violation, error) {
+	var violations []Violation
+	for _, p := range s.packages {
+		resp, err := model.CertifyBad(ctx, gqlClient, model.CertifyBadSpec{
+			Subject: &model.PackageSourceOrArtifactSpec{Package: pkgNameFilter(p)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query CertifyBad for %s: %w", p.purl, err)
+		}
+		for _, bad := range resp.CertifyBad {
+			subject, ok := bad.Subject.(*model.AllCertifyBadSubjectPackage)
+			if !ok || !appliesTo(subject.AllPkgTree, p) {
+				continue
+			}
+			violations = append(violations, Violation{
+				Message:  fmt.Sprintf("package %s is certified bad: %s", p.purl, bad.Justification),
+				Evidence: []string{bad.Id, p.id},
+			})
+		}
+	}
+	for _, a := range s.artifacts {
+		resp, err := model.CertifyBad(ctx, gqlClient, model.CertifyBadSpec{
+			Subject: &model.PackageSourceOrArtifactSpec{Artifact: &model.ArtifactSpec{Id: &a.id}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query CertifyBad for %s: %w", a.digest, err)
+		}
+		for _, bad := range resp.CertifyBad {
+			violations = append(violations, Violation{
+				Message:  fmt.Sprintf("artifact %s is certified bad: %s", a.digest, bad.Justification),
+				Evidence: []string{bad.Id, a.id},
+			})
+		}
+	}
+	return violations, nil
+}
+
+func evalMinScorecard(ctx context.Context, gqlClient graphql.Client, r Rule, s *scope) ([]Violation, error) {
+	var violations []Violation
+	seenSources := map[string]bool{}
+	for _, p := range s.packages {
+		resp, err := model.HasSourceAt(ctx, gqlClient, model.HasSourceAtSpec{Package: pkgNameFilter(p)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query HasSourceAt for %s: %w", p.purl, err)
+		}
+		for _, hsa := range resp.HasSourceAt {
+			if !appliesTo(hsa.Package.AllPkgTree, p) {
+				continue
+			}
+			for _, srcName := range sourceNames(hsa.Source.AllSourceTree) {
+				if seenSources[srcName.id] {
+					continue
+				}
+				seenSources[srcName.id] = true
+
+				scResp, err := model.Scorecards(ctx, gqlClient, model.CertifyScorecardSpec{
+					Source: &model.SourceSpec{Id: &srcName.id},
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to query scorecards for %s: %w", srcName.uri, err)
+				}
+				if len(scResp.Scorecards) == 0 {
+					violations = append(violations, Violation{
+						Message:  fmt.Sprintf("source %s of package %s has no scorecard", srcName.uri, p.purl),
+						Evidence: []string{hsa.Id, srcName.id},
+					})
+					continue
+				}
+				// only the most recent scan counts
+				latest := scResp.Scorecards[0]
+				for _, sc := range scResp.Scorecards[1:] {
+					if sc.Scorecard.TimeScanned.After(latest.Scorecard.TimeScanned) {
+						latest = sc
+					}
+				}
+				if latest.Scorecard.AggregateScore < r.MinScore {
+					violations = append(violations, Violation{
+						Message: fmt.Sprintf("source %s of package %s has a scorecard score of %.1f, below %.1f",
+							srcName.uri, p.purl, latest.Scorecard.AggregateScore, r.MinScore),
+						Evidence: []string{latest.Id, hsa.Id},
+					})
+				}
+			}
+		}
+	}
+	return violations, nil
+}
+
+func evalSLSABuildLevel(ctx context.Context, gqlClient graphql.Client, r Rule, s *scope) ([]Violation, error) {
+	key := r.BuildLevelKey
+	if key == "" {
+		key = defaultBuildLevelKey
+	}
+	var violations []Violation
+	for _, a := range s.artifacts {
+		resp, err := model.HasSLSA(ctx, gqlClient, model.HasSLSASpec{Subject: &model.ArtifactSpec{Id: &a.id}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query HasSLSA for %s: %w", a.digest, err)
+		}
+		if len(resp.HasSLSA) == 0 {
+			violations = append(violations, Violation{
+				Message:  fmt.Sprintf("artifact %s has no SLSA attestation", a.digest),
+				Evidence: []string{a.id},
+			})
+			continue
+		}
+		if r.MinBuildLevel == 0 {
+			continue
+		}
+		best := -1
+		var ids []string
+		for _, slsa := range resp.HasSLSA {
+			ids = append(ids, slsa.Id)
+			if level := buildLevel(slsa.Slsa.SlsaPredicate, key); level > best {
+				best = level
+			}
+		}
+		if best < r.MinBuildLevel {
+			level := "unknown"
+			if best >= 0 {
+				level = strconv.Itoa(best)
+			}
+			violations = append(violations, Violation{
+				Message:  fmt.Sprintf("artifact %s has SLSA build level %s, below %d", a.digest, level, r.MinBuildLevel),
+				Evidence: append(ids, a.id),
+			})
+		}
+	}
+	return violations, nil
+}
+
+func evalDeniedLicenses(ctx context.Context, gqlClient graphql.Client, r Rule, s *scope) ([]Violation, error) {
+	var violations []Violation
+	for _, p := range s.packages {
+		resp, err := model.CertifyLegal(ctx, gqlClient, model.CertifyLegalSpec{
+			Subject: &model.PackageOrSourceSpec{Package: pkgNameFilter(p)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query CertifyLegal for %s: %w", p.purl, err)
+		}
+		for _, legal := range resp.CertifyLegal {
+			subject, ok := legal.Subject.(*model.AllCertifyLegalTreeSubjectPackage)
+			if !ok || !appliesTo(subject.AllPkgTree, p) {
+				continue
+			}
+			var licenses []model.AllLicenseTree
+			for _, l := range legal.DeclaredLicenses {
+				licenses = append(licenses, l.AllLicenseTree)
+			}
+			for _, l := range legal.DiscoveredLicenses {
+				licenses = append(licenses, l.AllLicenseTree)
+			}
+			seen := map[string]bool{}
+			for _, l := range licenses {
+				if seen[l.Id] || !licenseDenied(l.Name, r.Licenses) {
+					continue
+				}
+				seen[l.Id] = true
+				violations = append(violations, Violation{
+					Message:  fmt.Sprintf("package %s has denied license %s", p.purl, l.Name),
+					Evidence: []string{legal.Id, l.Id},
+				})
+			}
+		}
+	}
+	return violations, nil
+}
+
+type sourceName struct {
+	id  string
+	uri string
+}
+
+func sourceNames(tree model.AllSourceTree) []sourceName {
+	var names []sourceName
+	for _, ns := range tree.Namespaces {
+		for _, n := range ns.Names {
+			names = append(names, sourceName{
+				id:  n.Id,
+				uri: tree.Type + "+" + ns.Namespace + "/" + n.Name,
+			})
+		}
+	}
+	return names
+}
+
+// buildLevel returns the highest build level found in the predicate values of
+// key, or -1 if there is none. Values such as "3", "L3" or
+// "SLSA_BUILD_LEVEL_3" are understood.
+func buildLevel(predicates []model.AllHasSLSATreeSlsaSLSASlsaPredicateSLSAPredicate, key string) int {
+	level := -1
+	for _, pred := range predicates {
+		if pred.Key != key && !strings.HasSuffix(pred.Key, "."+key) {
+			continue
+		}
+		digits := strings.TrimLeftFunc(pred.Value, func(r rune) bool { return r < '0' || r > '9' })
+		if l, err := strconv.Atoi(digits); err == nil && l > level {
+			level = l
+		}
+	}
+	return level
+}
+
+func licenseDenied(name string, patterns []string) bool {
+	name = strings.ToLower(name)
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package subject resolves the package or artifact that a policy is evaluated
+// against or that a document is exported for.
+package subject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// ErrSubjectNotFound is returned by Resolve when the subject is not in the
+// graph.
+var ErrSubjectNotFound = errors.New("subject not found")
+
+// Subject is a package or an artifact in the graph. Exactly one of the fields
+// must be set.
+type Subject struct {
+	// Purl selects all the package versions matching the purl.
+	Purl string
+	// Digest selects an artifact, in "algorithm:digest" form or only the
+	// digest.
+	Digest string
+}
+
+func (s Subject) String() string {
+	if s.Purl != "" {
+		return s.Purl
+	}
+	return s.Digest
+}
+
+// Validate checks that exactly one of the fields of s is set.
+func (s Subject) Validate() error {
+	if (s.Purl == "") == (s.Digest == "") {
+		return errors.New("exactly one of purl or digest must be specified")
+	}
+	return nil
+}
+
+// Resolved holds the nodes matched by a subject: the package trees matched by
+// its purl, or the artifacts matched by its digest.
+type Resolved struct {
+	Packages  []model.AllPkgTree
+	Artifacts []model.AllArtifactTree
+}
+
+// Resolve finds s in the graph. It returns an error wrapping
+// ErrSubjectNotFound if no package version or artifact matches.
+func Resolve(ctx context.Context, gqlClient graphql.Client, s Subject) (*Resolved, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	r := &Resolved{}
+	if s.Purl != "" {
+		filter, err := helpers.PurlToPkgFilter(s.Purl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse purl %q: %w", s.Purl, err)
+		}
+		resp, err := model.Packages(ctx, gqlClient, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query packages: %w", err)
+		}
+		for _, p := range resp.Packages {
+			if hasVersion(p.AllPkgTree) {
+				r.Packages = append(r.Packages, p.AllPkgTree)
+			}
+		}
+		if len(r.Packages) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrSubjectNotFound, s.Purl)
+		}
+		return r, nil
+	}
+
+	resp, err := model.Artifacts(ctx, gqlClient, ArtifactFilter(s.Digest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query artifacts: %w", err)
+	}
+	for _, a := range resp.Artifacts {
+		r.Artifacts = append(r.Artifacts, a.AllArtifactTree)
+	}
+	if len(r.Artifacts) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrSubjectNotFound, s.Digest)
+	}
+	return r, nil
+}
+
+// ArtifactFilter returns the filter matching digest, which is either in
+// "algorithm:digest" form or only the digest. Both are matched case
+// insensitively.
+func ArtifactFilter(digest string) model.ArtifactSpec {
+	filter := model.ArtifactSpec{}
+	algorithm, value, found := strings.Cut(digest, ":")
+	if found {
+		algorithm = strings.ToLower(algorithm)
+		filter.Algorithm = &algorithm
+	} else {
+		value = algorithm
+	}
+	value = strings.ToLower(value)
+	filter.Digest = &value
+	return filter
+}
+
+func hasVersion(tree model.AllPkgTree) bool {
+	for _, ns := range tree.Namespaces {
+		for _, name := range ns.Names {
+			if len(name.Versions) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
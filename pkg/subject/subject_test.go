@@ -0,0 +1,61 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subject
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject Subject
+		wantErr bool
+	}{
+		{name: "purl", subject: Subject{Purl: "pkg:guac/app@1.0"}},
+		{name: "digest", subject: Subject{Digest: "sha256:abc"}},
+		{name: "none", subject: Subject{}, wantErr: true},
+		{name: "both", subject: Subject{Purl: "pkg:guac/app@1.0", Digest: "sha256:abc"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.subject.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestArtifactFilter(t *testing.T) {
+	tests := []struct {
+		digest string
+		want   model.ArtifactSpec
+	}{
+		{digest: "SHA256:ABC", want: model.ArtifactSpec{Algorithm: ptrfrom.String("sha256"), Digest: ptrfrom.String("abc")}},
+		{digest: "ABC", want: model.ArtifactSpec{Digest: ptrfrom.String("abc")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.digest, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, ArtifactFilter(tt.digest)); diff != "" {
+				t.Errorf("ArtifactFilter() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}